@@ -3,14 +3,19 @@ package application
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"os/signal"
 	"syscall"
 	"time"
 
+	attendanceHandler "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/handler"
+	attendanceService "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/service"
 	authHandler "github.com/HDR3604/HelpDeskApp/internal/domain/auth/handler"
 	authService "github.com/HDR3604/HelpDeskApp/internal/domain/auth/service"
+	budgetHandler "github.com/HDR3604/HelpDeskApp/internal/domain/budget/handler"
+	budgetService "github.com/HDR3604/HelpDeskApp/internal/domain/budget/service"
 	consentHandler "github.com/HDR3604/HelpDeskApp/internal/domain/consent/handler"
 	payrollHandler "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/handler"
 	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
@@ -18,14 +23,21 @@ import (
 	scheduleService "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
 	studentHandler "github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
 	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	timelogHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler"
 	timelogService "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	timeoffHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/handler"
+	timeoffService "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/service"
+	timesheetHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/handler"
+	timesheetService "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
 	transcriptHandler "github.com/HDR3604/HelpDeskApp/internal/domain/transcript/handler"
 	userHandler "github.com/HDR3604/HelpDeskApp/internal/domain/user/handler"
 	userService "github.com/HDR3604/HelpDeskApp/internal/domain/user/service"
 	verificationHandler "github.com/HDR3604/HelpDeskApp/internal/domain/verification/handler"
 	verificationService "github.com/HDR3604/HelpDeskApp/internal/domain/verification/service"
 	authRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/auth"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/bankfile"
+	budgetRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/budget"
 	consentRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/consent"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
 	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
@@ -35,8 +47,11 @@ import (
 	payrollRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/payroll"
 	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/schedule"
 	schedulerService "github.com/HDR3604/HelpDeskApp/internal/infrastructure/scheduler/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/storage"
 	studentRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/student"
 	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/timelog"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/timeoff"
+	timesheetRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/timesheet"
 	transcriptsService "github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/service"
 	userRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/user"
 	verificationInfra "github.com/HDR3604/HelpDeskApp/internal/infrastructure/verification"
@@ -99,20 +114,49 @@ func NewApp(cfg Config) (*App, error) {
 	scheduleRepository := scheduleRepo.NewScheduleRepository(logger)
 	scheduleGenerationRepository := scheduleRepo.NewScheduleGenerationRepository(logger)
 	shiftTemplateRepo := scheduleRepo.NewShiftTemplateRepository(logger)
+	locationRepo := scheduleRepo.NewLocationRepository(logger)
+	courseRepo := scheduleRepo.NewCourseRepository(logger)
 	schedulerConfigRepo := scheduleRepo.NewSchedulerConfigRepository(logger)
 	bankingDetailsRepository := studentRepo.NewBankingDetailsRepository(logger, cfg.EncryptionKey)
 	consentRepository := consentRepo.NewConsentRepository(logger)
 	studentRepository := studentRepo.NewStudentRepository(logger)
+	courseEligibilityRepository := studentRepo.NewCourseEligibilityRepository(logger)
+	applicationReviewRepository := studentRepo.NewApplicationReviewRepository(logger)
+	interviewSlotRepository := studentRepo.NewInterviewSlotRepository(logger)
+	studentDocumentRepository := studentRepo.NewStudentDocumentRepository(logger)
+	transcriptSubmissionRepository := studentRepo.NewTranscriptSubmissionRepository(logger)
+	profileChangeRequestRepository := studentRepo.NewProfileChangeRequestRepository(logger)
+	studentTimetableRepository := studentRepo.NewStudentTimetableRepository(logger)
+	trainingRepository := studentRepo.NewTrainingRepository(logger)
 	verificationRepository := verificationInfra.NewVerificationRepository(logger)
 	timeLogRepository := timelogRepo.NewTimeLogRepository(logger)
 	clockInCodeRepository := timelogRepo.NewClockInCodeRepository(logger)
+	kioskRepository := timelogRepo.NewKioskRepository(logger, cfg.EncryptionKey)
+	clockInAttemptRepository := timelogRepo.NewClockInAttemptRepository(logger)
+	clockInLockoutRepository := timelogRepo.NewClockInLockoutRepository(logger)
+	timeLogBreakRepository := timelogRepo.NewTimeLogBreakRepository(logger)
+	payRuleRepository := timelogRepo.NewPayRuleRepository(logger)
 	paymentRepository := payrollRepo.NewPaymentRepository(logger)
+	paymentAdjustmentRepository := payrollRepo.NewPaymentAdjustmentRepository(logger)
+	disbursementFileRepository := payrollRepo.NewDisbursementFileRepository(logger, cfg.EncryptionKey)
+	payCalendarRepository := payrollRepo.NewPayCalendarRepository(logger)
+	payPeriodRepository := payrollRepo.NewPayPeriodRepository(logger)
+	timeOffRequestRepository := timeoffRepo.NewTimeOffRequestRepository(logger)
+	timesheetRepository := timesheetRepo.NewTimesheetRepository(logger)
+	budgetRepository := budgetRepo.NewBudgetRepository(logger)
 
 	// Seed default admin (idempotent, skipped if env vars not set)
 	if err := seedDefaultAdmin(context.Background(), cfg, logger, txManager, userRepository); err != nil {
 		logger.Warn("failed to seed default admin", zap.Error(err))
 	}
 
+	// Document store — files are encrypted before they reach the backend
+	documentStore, err := newDocumentStore(cfg)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	// Email sender
 	var emailSenderSvc emailInterfaces.EmailSenderInterface
 	if cfg.Environment == "production" {
@@ -124,6 +168,7 @@ func NewApp(cfg Config) (*App, error) {
 	// Services
 	userSvc := userService.NewUserService(logger, txManager, userRepository)
 	transcriptsSvc := transcriptsService.NewTranscriptsService(logger)
+	studentDocumentSvc := studentService.NewStudentDocumentService(logger, txManager, studentDocumentRepository, studentRepository, documentStore)
 
 	authSvc := authService.NewAuthService(
 		logger,
@@ -143,7 +188,9 @@ func NewApp(cfg Config) (*App, error) {
 
 	scheduleGenerationSvc := scheduleService.NewScheduleGenerationService(logger, scheduleGenerationRepository, txManager)
 	schedulerSvc := schedulerService.NewSchedulerService(logger)
-	shiftTemplateSvc := scheduleService.NewShiftTemplateService(logger, shiftTemplateRepo, txManager)
+	shiftTemplateSvc := scheduleService.NewShiftTemplateService(logger, shiftTemplateRepo, locationRepo, courseRepo, txManager)
+	locationSvc := scheduleService.NewLocationService(logger, locationRepo, txManager)
+	courseSvc := scheduleService.NewCourseService(logger, courseRepo, shiftTemplateRepo, studentRepository, txManager)
 	schedulerConfigSvc := scheduleService.NewSchedulerConfigService(logger, schedulerConfigRepo, txManager)
 
 	// Job queue — register workers
@@ -157,6 +204,22 @@ func NewApp(cfg Config) (*App, error) {
 	emailNotifWorker := jobs.NewEmailNotificationWorker(logger, emailSenderSvc)
 	river.AddWorker(workers, emailNotifWorker)
 
+	payslipSvc := payrollService.NewPayslipService(logger, txManager, paymentRepository, paymentAdjustmentRepository, studentRepository, bankingDetailsRepository, emailSenderSvc, cfg.FromEmail)
+	river.AddWorker(workers, jobs.NewPayslipEmailWorker(logger, payslipSvc))
+
+	// The payroll service gets its payslip enqueuer once the client exists.
+	payrollSvc := payrollService.NewPayrollService(logger, txManager, paymentRepository, paymentAdjustmentRepository, studentRepository, bankingDetailsRepository, nil)
+	payCalendarSvc := payrollService.NewPayCalendarService(logger, txManager, payCalendarRepository, payPeriodRepository, paymentRepository, userRepository, payrollSvc, emailSenderSvc, cfg.FromEmail, cfg.FrontendURL)
+	river.AddWorker(workers, jobs.NewPayCalendarWorker(logger, payCalendarSvc))
+	river.AddWorker(workers, jobs.NewDocumentRetentionWorker(logger, studentDocumentSvc))
+	trainingSvc := studentService.NewTrainingService(logger, txManager, trainingRepository, studentRepository, studentDocumentSvc, emailSenderSvc, cfg.FromEmail, cfg.FrontendURL)
+	river.AddWorker(workers, jobs.NewTrainingReminderWorker(logger, trainingSvc))
+	timesheetSvc := timesheetService.NewTimesheetService(logger, txManager, timesheetRepository, timeLogRepository, scheduleRepository, timeOffRequestRepository, studentRepository, emailSenderSvc, cfg.FromEmail, cfg.FrontendURL)
+	river.AddWorker(workers, jobs.NewTimesheetReminderWorker(logger, timesheetSvc))
+	// The review service gets its invitation enqueuer once the client exists.
+	applicationReviewSvc := studentService.NewApplicationReviewService(logger, txManager, applicationReviewRepository, interviewSlotRepository, studentRepository, userRepository, locationRepo, emailSenderSvc, cfg.FromEmail)
+	river.AddWorker(workers, jobs.NewInterviewInvitationWorker(logger, applicationReviewSvc))
+
 	jobQueueClient, err := jobqueue.NewClient(db, logger, workers)
 	if err != nil {
 		db.Close()
@@ -164,28 +227,66 @@ func NewApp(cfg Config) (*App, error) {
 	}
 
 	enqueuer := jobqueue.NewEnqueuer(jobQueueClient)
+	payrollSvc.SetPayslipEnqueuer(enqueuer)
+	applicationReviewSvc.SetInvitationEnqueuer(enqueuer)
+
+	budgetSvc := budgetService.NewBudgetService(logger, txManager, budgetRepository, scheduleRepository, paymentRepository)
 
 	// Schedule service now enqueues jobs instead of calling scheduler directly
-	scheduleSvc := scheduleService.NewScheduleService(logger, scheduleRepository, txManager, scheduleGenerationSvc, enqueuer, shiftTemplateSvc, schedulerConfigSvc)
+	scheduleSvc := scheduleService.NewScheduleService(logger, scheduleRepository, txManager, scheduleGenerationSvc, enqueuer, shiftTemplateSvc, schedulerConfigSvc, timeOffRequestRepository, budgetSvc)
 	bankingDetailsSvc := studentService.NewBankingDetailsService(logger, txManager, bankingDetailsRepository, consentRepository)
-	studentSvc := studentService.NewStudentService(logger, studentRepository, txManager)
+	studentSvc := studentService.NewStudentService(logger, studentRepository, courseRepo, applicationReviewRepository, courseEligibilityRepository, studentDocumentRepository, txManager)
+	courseEligibilitySvc := studentService.NewCourseEligibilityService(logger, txManager, courseEligibilityRepository, studentRepository)
+	transcriptSubmissionSvc := studentService.NewTranscriptSubmissionService(logger, txManager, transcriptSubmissionRepository, studentRepository, courseEligibilityRepository, courseRepo, scheduleRepository, shiftTemplateRepo, transcriptsSvc, studentDocumentSvc)
+	profileChangeSvc := studentService.NewProfileChangeService(logger, txManager, profileChangeRequestRepository, studentRepository, scheduleRepository, courseRepo)
+	timetableSvc := studentService.NewTimetableService(logger, txManager, studentTimetableRepository, studentRepository, shiftTemplateRepo, profileChangeSvc)
 	verificationSvc := verificationService.NewVerificationService(logger, txManager, verificationRepository, emailSenderSvc, cfg.FromEmail)
-	timeLogSvc := timelogService.NewTimeLogService(logger, txManager, timeLogRepository, clockInCodeRepository, scheduleRepository, cfg.HelpDeskLongitude, cfg.HelpDeskLatitude)
-	payrollSvc := payrollService.NewPayrollService(logger, txManager, paymentRepository, studentRepository, bankingDetailsRepository)
+	clockInLockoutSvc := timelogService.NewClockInLockoutService(logger, txManager, clockInAttemptRepository, clockInLockoutRepository, userRepository, emailSenderSvc, cfg.FromEmail)
+	breakPolicy, err := timelogAggregate.NewBreakPolicy(
+		time.Duration(cfg.BreakRequiredAfter*float64(time.Hour)),
+		time.Duration(cfg.BreakMinMinutes)*time.Minute,
+	)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("invalid break policy: %w", err)
+	}
+	timeLogSvc := timelogService.NewTimeLogService(logger, txManager, timeLogRepository, clockInCodeRepository, kioskRepository, scheduleRepository, locationRepo, clockInLockoutSvc, timeLogBreakRepository, payRuleRepository, timeOffRequestRepository, breakPolicy, timelogService.DefaultFraudDetector(), cfg.HelpDeskLongitude, cfg.HelpDeskLatitude)
+	kioskSvc := timelogService.NewKioskService(logger, txManager, kioskRepository)
+	payRuleSvc := timelogService.NewPayRuleService(logger, txManager, payRuleRepository, locationRepo)
+	disbursementSvc := payrollService.NewDisbursementService(logger, txManager, paymentRepository, disbursementFileRepository, studentRepository, bankingDetailsRepository, bankfile.DefaultRegistry())
+	reconciliationSvc := payrollService.NewReconciliationService(logger, txManager, paymentRepository, studentRepository, scheduleRepository, locationRepo, timeLogRepository, timesheetRepository, timeOffRequestRepository)
+	timeOffSvc := timeoffService.NewTimeOffService(logger, txManager, timeOffRequestRepository, scheduleRepository)
+	attendanceSvc := attendanceService.NewAttendanceService(logger, txManager, scheduleRepository, locationRepo, timeLogRepository, payRuleRepository, timeOffRequestRepository, studentRepository)
 
 	// Handlers
 	consentHdl := consentHandler.NewConsentHandler(logger)
 	authHdl := authHandler.NewAuthHandler(logger, authSvc, cfg.AccessTokenTTL)
-	transcriptHdl := transcriptHandler.NewTranscriptHandler(logger, transcriptsSvc)
-	scheduleHdl := scheduleHandler.NewScheduleHandler(logger, scheduleSvc, studentSvc, shiftTemplateSvc, enqueuer, cfg.FromEmail)
+	transcriptHdl := transcriptHandler.NewTranscriptHandler(logger, transcriptsSvc, studentDocumentSvc)
+	scheduleHdl := scheduleHandler.NewScheduleHandler(logger, scheduleSvc, studentSvc, courseEligibilitySvc, trainingSvc, shiftTemplateSvc, enqueuer, cfg.FromEmail)
 	scheduleGenerationHdl := scheduleHandler.NewScheduleGenerationHandler(logger, scheduleGenerationSvc)
 	shiftTemplateHdl := scheduleHandler.NewShiftTemplateHandler(logger, shiftTemplateSvc)
+	locationHdl := scheduleHandler.NewLocationHandler(logger, locationSvc)
+	courseHdl := scheduleHandler.NewCourseHandler(logger, courseSvc)
 	schedulerConfigHdl := scheduleHandler.NewSchedulerConfigHandler(logger, schedulerConfigSvc)
-	studentHdl := studentHandler.NewStudentHandler(logger, bankingDetailsSvc, studentSvc, authSvc, emailSenderSvc, cfg.FromEmail, cfg.FrontendURL)
+	studentHdl := studentHandler.NewStudentHandler(logger, bankingDetailsSvc, studentSvc, profileChangeSvc, authSvc, emailSenderSvc, cfg.FromEmail, cfg.FrontendURL)
+	courseEligibilityHdl := studentHandler.NewCourseEligibilityHandler(logger, courseEligibilitySvc)
+	applicationReviewHdl := studentHandler.NewApplicationReviewHandler(logger, applicationReviewSvc)
+	studentDocumentHdl := studentHandler.NewStudentDocumentHandler(logger, studentDocumentSvc)
+	transcriptSubmissionHdl := studentHandler.NewTranscriptSubmissionHandler(logger, transcriptSubmissionSvc)
+	profileChangeHdl := studentHandler.NewProfileChangeHandler(logger, profileChangeSvc)
+	timetableHdl := studentHandler.NewTimetableHandler(logger, timetableSvc)
+	trainingHdl := studentHandler.NewTrainingHandler(logger, trainingSvc)
 	userHdl := userHandler.NewUserHandler(logger, userSvc)
 	verificationHdl := verificationHandler.NewVerificationHandler(logger, verificationSvc)
 	timeLogHdl := timelogHandler.NewTimeLogHandler(logger, timeLogSvc)
-	payrollHdl := payrollHandler.NewPayrollHandler(logger, payrollSvc)
+	kioskHdl := timelogHandler.NewKioskHandler(logger, kioskSvc, cfg.FrontendURL)
+	clockInLockoutHdl := timelogHandler.NewClockInLockoutHandler(logger, clockInLockoutSvc)
+	payRuleHdl := timelogHandler.NewPayRuleHandler(logger, payRuleSvc)
+	payrollHdl := payrollHandler.NewPayrollHandler(logger, payrollSvc, payslipSvc, disbursementSvc, reconciliationSvc, payCalendarSvc)
+	timeOffHdl := timeoffHandler.NewTimeOffHandler(logger, timeOffSvc)
+	timesheetHdl := timesheetHandler.NewTimesheetHandler(logger, timesheetSvc)
+	attendanceHdl := attendanceHandler.NewAttendanceHandler(logger, attendanceSvc)
+	budgetHdl := budgetHandler.NewBudgetHandler(logger, budgetSvc)
 
 	// Router
 	r := chi.NewRouter()
@@ -210,7 +311,7 @@ func NewApp(cfg Config) (*App, error) {
 		})
 	})
 
-	registerRoutes(r, cfg, authHdl, authSvc, consentHdl, transcriptHdl, scheduleHdl, scheduleGenerationHdl, shiftTemplateHdl, schedulerConfigHdl, studentHdl, userHdl, verificationHdl, timeLogHdl, payrollHdl)
+	registerRoutes(r, cfg, authHdl, authSvc, consentHdl, transcriptHdl, scheduleHdl, scheduleGenerationHdl, shiftTemplateHdl, locationHdl, courseHdl, schedulerConfigHdl, studentHdl, courseEligibilityHdl, applicationReviewHdl, studentDocumentHdl, transcriptSubmissionHdl, profileChangeHdl, timetableHdl, trainingHdl, userHdl, verificationHdl, timeLogHdl, kioskHdl, clockInLockoutHdl, payRuleHdl, payrollHdl, timeOffHdl, timesheetHdl, attendanceHdl, budgetHdl)
 
 	app := &App{
 		config:   cfg,
@@ -303,6 +404,28 @@ func (a *App) runTokenCleanup(ctx context.Context) {
 	}
 }
 
+// newDocumentStore builds the configured document store backend and wraps it
+// so everything written is encrypted with ENCRYPTION_KEY.
+func newDocumentStore(cfg Config) (storage.ObjectStore, error) {
+	var backend storage.ObjectStore
+	switch cfg.DocumentStorage {
+	case "local":
+		local, err := storage.NewLocalStore(cfg.DocumentStoragePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open document store: %w", err)
+		}
+		backend = local
+	default:
+		return nil, fmt.Errorf("unsupported document storage backend %q", cfg.DocumentStorage)
+	}
+
+	key, err := hex.DecodeString(cfg.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ENCRYPTION_KEY: %w", err)
+	}
+	return storage.NewEncryptedStore(backend, key)
+}
+
 func devAuthMiddleware(userID string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -27,7 +27,11 @@ type Config struct {
 	SeedAdminPassword    string
 	HelpDeskLongitude    float64
 	HelpDeskLatitude     float64
-	TimeLogRateLimitRPM  int // requests per minute per IP for time-log endpoints
+	TimeLogRateLimitRPM  int     // requests per minute per IP for time-log endpoints
+	BreakRequiredAfter   float64 // hours of continuous work before a break is required (0 disables)
+	BreakMinMinutes      int     // shortest break that satisfies the break rule
+	DocumentStorage      string  // document store backend; only "local" for now
+	DocumentStoragePath  string  // root directory of the local document store
 }
 
 func LoadConfig() (Config, error) {
@@ -166,5 +170,41 @@ func LoadConfig() (Config, error) {
 		cfg.TimeLogRateLimitRPM = parsed
 	}
 
+	// Break rule (disabled unless BREAK_REQUIRED_AFTER_HOURS is set)
+	if v := os.Getenv("BREAK_REQUIRED_AFTER_HOURS"); v != "" {
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid BREAK_REQUIRED_AFTER_HOURS %q: %w", v, err)
+		}
+		if parsed < 0 {
+			return Config{}, fmt.Errorf("BREAK_REQUIRED_AFTER_HOURS must not be negative, got %f", parsed)
+		}
+		cfg.BreakRequiredAfter = parsed
+	}
+	cfg.BreakMinMinutes = 30
+	if v := os.Getenv("BREAK_MIN_MINUTES"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid BREAK_MIN_MINUTES %q: %w", v, err)
+		}
+		if parsed <= 0 {
+			return Config{}, fmt.Errorf("BREAK_MIN_MINUTES must be positive, got %d", parsed)
+		}
+		cfg.BreakMinMinutes = parsed
+	}
+
+	// Document storage (files are encrypted with ENCRYPTION_KEY before they are written)
+	cfg.DocumentStorage = "local"
+	if v := os.Getenv("DOCUMENT_STORAGE_BACKEND"); v != "" {
+		if v != "local" {
+			return Config{}, fmt.Errorf("DOCUMENT_STORAGE_BACKEND must be \"local\", got %q", v)
+		}
+		cfg.DocumentStorage = v
+	}
+	cfg.DocumentStoragePath = os.Getenv("DOCUMENT_STORAGE_PATH")
+	if cfg.DocumentStoragePath == "" {
+		cfg.DocumentStoragePath = "./data/documents"
+	}
+
 	return cfg, nil
 }
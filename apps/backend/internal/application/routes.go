@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"net/http"
 
+	attendanceHandler "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/handler"
 	authHandler "github.com/HDR3604/HelpDeskApp/internal/domain/auth/handler"
 	authService "github.com/HDR3604/HelpDeskApp/internal/domain/auth/service"
+	budgetHandler "github.com/HDR3604/HelpDeskApp/internal/domain/budget/handler"
 	consentHandler "github.com/HDR3604/HelpDeskApp/internal/domain/consent/handler"
 	payrollHandler "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/handler"
 	scheduleHandler "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/handler"
 	studentHandler "github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
 	timelogHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler"
+	timeoffHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/handler"
+	timesheetHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/handler"
 	transcriptHandler "github.com/HDR3604/HelpDeskApp/internal/domain/transcript/handler"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
 	userHandler "github.com/HDR3604/HelpDeskApp/internal/domain/user/handler"
@@ -29,12 +33,28 @@ func registerRoutes(
 	scheduleHdl *scheduleHandler.ScheduleHandler,
 	scheduleGenerationHdl *scheduleHandler.ScheduleGenerationHandler,
 	shiftTemplateHdl *scheduleHandler.ShiftTemplateHandler,
+	locationHdl *scheduleHandler.LocationHandler,
+	courseHdl *scheduleHandler.CourseHandler,
 	schedulerConfigHdl *scheduleHandler.SchedulerConfigHandler,
 	studentHdl *studentHandler.StudentHandler,
+	courseEligibilityHdl *studentHandler.CourseEligibilityHandler,
+	applicationReviewHdl *studentHandler.ApplicationReviewHandler,
+	studentDocumentHdl *studentHandler.StudentDocumentHandler,
+	transcriptSubmissionHdl *studentHandler.TranscriptSubmissionHandler,
+	profileChangeHdl *studentHandler.ProfileChangeHandler,
+	timetableHdl *studentHandler.TimetableHandler,
+	trainingHdl *studentHandler.TrainingHandler,
 	userHdl *userHandler.UserHandler,
 	verificationHdl *verificationHandler.VerificationHandler,
 	timeLogHdl *timelogHandler.TimeLogHandler,
+	kioskHdl *timelogHandler.KioskHandler,
+	clockInLockoutHdl *timelogHandler.ClockInLockoutHandler,
+	payRuleHdl *timelogHandler.PayRuleHandler,
 	payrollHdl *payrollHandler.PayrollHandler,
+	timeOffHdl *timeoffHandler.TimeOffHandler,
+	timesheetHdl *timesheetHandler.TimesheetHandler,
+	attendanceHdl *attendanceHandler.AttendanceHandler,
+	budgetHdl *budgetHandler.BudgetHandler,
 ) {
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -50,6 +70,8 @@ func registerRoutes(
 			transcriptHdl.RegisterRoutes(r)
 			studentHdl.RegisterPublicRoutes(r)
 			verificationHdl.RegisterRoutes(r)
+			// Kiosk devices authenticate with their own token, not a user JWT
+			kioskHdl.RegisterKioskRoutes(r)
 		})
 
 		// Protected routes (JWT middleware)
@@ -64,8 +86,18 @@ func registerRoutes(
 			authHdl.RegisterAuthenticatedRoutes(r)
 			scheduleHdl.RegisterRoutes(r)
 			shiftTemplateHdl.RegisterReadRoutes(r)
+			locationHdl.RegisterReadRoutes(r)
+			courseHdl.RegisterReadRoutes(r)
 			studentHdl.RegisterRoutes(r)
+			studentDocumentHdl.RegisterRoutes(r)
+			transcriptSubmissionHdl.RegisterRoutes(r)
+			profileChangeHdl.RegisterRoutes(r)
+			timetableHdl.RegisterRoutes(r)
+			trainingHdl.RegisterRoutes(r)
 			userHdl.RegisterAuthenticatedRoutes(r)
+			timeOffHdl.RegisterRoutes(r)
+			timesheetHdl.RegisterRoutes(r)
+			payrollHdl.RegisterRoutes(r)
 
 			// Time log routes — rate limited to prevent clock-in code brute-forcing
 			r.Group(func(r chi.Router) {
@@ -80,12 +112,28 @@ func registerRoutes(
 				scheduleHdl.RegisterAdminRoutes(r)
 				scheduleGenerationHdl.RegisterRoutes(r)
 				shiftTemplateHdl.RegisterRoutes(r)
+				locationHdl.RegisterRoutes(r)
+				courseHdl.RegisterRoutes(r)
 				schedulerConfigHdl.RegisterRoutes(r)
 				studentHdl.RegisterAdminRoutes(r)
+				courseEligibilityHdl.RegisterAdminRoutes(r)
+				applicationReviewHdl.RegisterAdminRoutes(r)
+				studentDocumentHdl.RegisterAdminRoutes(r)
+				transcriptSubmissionHdl.RegisterAdminRoutes(r)
+				profileChangeHdl.RegisterAdminRoutes(r)
+				timetableHdl.RegisterAdminRoutes(r)
+				trainingHdl.RegisterAdminRoutes(r)
 				userHdl.RegisterAdminRoutes(r)
 				userHdl.RegisterRoutes(r)
 				timeLogHdl.RegisterAdminRoutes(r)
+				kioskHdl.RegisterAdminRoutes(r)
+				clockInLockoutHdl.RegisterAdminRoutes(r)
+				payRuleHdl.RegisterAdminRoutes(r)
 				payrollHdl.RegisterAdminRoutes(r)
+				timeOffHdl.RegisterAdminRoutes(r)
+				timesheetHdl.RegisterAdminRoutes(r)
+				attendanceHdl.RegisterAdminRoutes(r)
+				budgetHdl.RegisterAdminRoutes(r)
 			})
 		})
 	})
@@ -0,0 +1,316 @@
+package aggregate
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+)
+
+const (
+	// MaxReportDays bounds the date range of a single report.
+	MaxReportDays = 366
+
+	// earlyDepartureTolerance is how far before the scheduled end a student
+	// may clock out without it counting as an early departure.
+	earlyDepartureTolerance = time.Minute
+)
+
+// Interval groups report rows into periods. The zero value reports the whole
+// date range as a single period.
+type Interval string
+
+const (
+	Interval_None  Interval = ""
+	Interval_Week  Interval = "week"
+	Interval_Month Interval = "month"
+)
+
+// SortField is a column the report can be ordered by.
+type SortField string
+
+const (
+	SortField_StudentID       SortField = "student_id"
+	SortField_OnTimeRate      SortField = "on_time_rate"
+	SortField_AvgMinutesLate  SortField = "avg_minutes_late"
+	SortField_MissedShifts    SortField = "missed_shifts"
+	SortField_EarlyDepartures SortField = "early_departures"
+	SortField_FlaggedLogs     SortField = "flagged_logs"
+	SortField_HoursWorked     SortField = "hours_worked"
+	SortField_HoursScheduled  SortField = "hours_scheduled"
+)
+
+// ReportQuery selects the students, dates and grouping of a report. From and
+// To are inclusive calendar dates.
+type ReportQuery struct {
+	From       time.Time
+	To         time.Time
+	Interval   Interval
+	StudentID  *int32
+	SortBy     SortField
+	Descending bool
+}
+
+// Validate normalises the dates and checks the interval and sort field. An
+// empty SortBy defaults to student ID.
+func (q *ReportQuery) Validate() error {
+	q.From, q.To = dateOnly(q.From), dateOnly(q.To)
+	if q.To.Before(q.From) {
+		return errors.ErrInvalidDateRange
+	}
+	if q.To.Sub(q.From) >= MaxReportDays*24*time.Hour {
+		return errors.ErrDateRangeTooLong
+	}
+	switch q.Interval {
+	case Interval_None, Interval_Week, Interval_Month:
+	default:
+		return errors.ErrInvalidInterval
+	}
+	if q.SortBy == "" {
+		q.SortBy = SortField_StudentID
+	}
+	switch q.SortBy {
+	case SortField_StudentID, SortField_OnTimeRate, SortField_AvgMinutesLate, SortField_MissedShifts,
+		SortField_EarlyDepartures, SortField_FlaggedLogs, SortField_HoursWorked, SortField_HoursScheduled:
+	default:
+		return errors.ErrInvalidSortField
+	}
+	return nil
+}
+
+// Shift is one dated occurrence of a scheduled shift. Date is the local
+// calendar date of the shift; Start and End are absolute instants.
+type Shift struct {
+	StudentID        int32
+	ShiftID          string
+	Date             time.Time
+	Start            time.Time
+	End              time.Time
+	LateGraceMinutes int32 // lateness within the grace still counts as on time
+}
+
+// StudentAttendance is one student's punctuality over one period. PeriodEnd
+// is inclusive.
+type StudentAttendance struct {
+	StudentID        int32
+	StudentName      string
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	ScheduledShifts  int
+	OnTimeShifts     int
+	LateShifts       int
+	MissedShifts     int
+	EarlyDepartures  int
+	TotalMinutesLate float64
+	FlaggedLogs      int
+	HoursScheduled   float64
+	HoursWorked      float64
+}
+
+// OnTimeRate is the share of scheduled shifts started on time, between 0 and
+// 1. Missed shifts count against it. ok is false when nothing was scheduled.
+func (a *StudentAttendance) OnTimeRate() (rate float64, ok bool) {
+	if a.ScheduledShifts == 0 {
+		return 0, false
+	}
+	return round2(float64(a.OnTimeShifts) / float64(a.ScheduledShifts)), true
+}
+
+// AverageMinutesLate averages lateness over the shifts that were attended,
+// counting on-time arrivals as zero.
+func (a *StudentAttendance) AverageMinutesLate() float64 {
+	attended := a.ScheduledShifts - a.MissedShifts
+	if attended <= 0 {
+		return 0
+	}
+	return round2(a.TotalMinutesLate / float64(attended))
+}
+
+// BuildReport computes per-student, per-period attendance. Each shift is
+// matched against the student's logs that overlap it: a shift with no log is
+// missed, lateness is measured from the earliest clock-in and an early
+// departure from the latest clock-out. Hours worked count closed, unflagged
+// logs only. Logs are assigned to periods by their clock-in date in tz.
+func BuildReport(shifts []Shift, logs []*timelogAggregate.TimeLog, query ReportQuery, tz *time.Location) []*StudentAttendance {
+	rows := make(map[rowKey]*StudentAttendance)
+	row := func(studentID int32, day time.Time) *StudentAttendance {
+		start, end := periodOf(day, query)
+		key := rowKey{studentID: studentID, periodStart: start}
+		if r, ok := rows[key]; ok {
+			return r
+		}
+		r := &StudentAttendance{StudentID: studentID, PeriodStart: start, PeriodEnd: end}
+		rows[key] = r
+		return r
+	}
+
+	logsByStudent := make(map[int32][]*timelogAggregate.TimeLog)
+	for _, l := range logs {
+		logsByStudent[l.StudentID] = append(logsByStudent[l.StudentID], l)
+	}
+
+	for _, sh := range shifts {
+		r := row(sh.StudentID, sh.Date)
+		r.ScheduledShifts++
+		r.HoursScheduled += sh.End.Sub(sh.Start).Hours()
+
+		var firstEntry, lastExit *time.Time
+		open := false
+		for _, l := range logsByStudent[sh.StudentID] {
+			if !overlaps(l, sh) {
+				continue
+			}
+			if firstEntry == nil || l.EntryAt.Before(*firstEntry) {
+				firstEntry = &l.EntryAt
+			}
+			if l.ExitAt == nil {
+				open = true
+			} else if lastExit == nil || l.ExitAt.After(*lastExit) {
+				lastExit = l.ExitAt
+			}
+		}
+
+		if firstEntry == nil {
+			r.MissedShifts++
+			continue
+		}
+
+		late := max(firstEntry.Sub(sh.Start).Minutes(), 0)
+		r.TotalMinutesLate += late
+		if late > float64(sh.LateGraceMinutes) {
+			r.LateShifts++
+		} else {
+			r.OnTimeShifts++
+		}
+
+		if !open && lastExit != nil && lastExit.Before(sh.End.Add(-earlyDepartureTolerance)) {
+			r.EarlyDepartures++
+		}
+	}
+
+	for _, l := range logs {
+		r := row(l.StudentID, l.EntryAt.In(tz))
+		if l.IsFlagged {
+			r.FlaggedLogs++
+			continue
+		}
+		if minutes := workedMinutes(l); minutes != nil {
+			r.HoursWorked += *minutes / 60
+		}
+	}
+
+	result := make([]*StudentAttendance, 0, len(rows))
+	for _, r := range rows {
+		r.TotalMinutesLate = round2(r.TotalMinutesLate)
+		r.HoursScheduled = round2(r.HoursScheduled)
+		r.HoursWorked = round2(r.HoursWorked)
+		result = append(result, r)
+	}
+	SortReport(result, SortField_StudentID, false)
+	return result
+}
+
+// SortReport orders rows by field. Ties, and rows with no on-time rate when
+// sorting by it, fall back to student ID then period, ascending.
+func SortReport(rows []*StudentAttendance, field SortField, descending bool) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if field == SortField_OnTimeRate {
+			ra, okA := a.OnTimeRate()
+			rb, okB := b.OnTimeRate()
+			if okA != okB {
+				return okA
+			}
+			if ra != rb {
+				return (ra < rb) != descending
+			}
+			return tieBreak(a, b)
+		}
+		if va, vb := sortValue(a, field), sortValue(b, field); va != vb {
+			return (va < vb) != descending
+		}
+		return tieBreak(a, b)
+	})
+}
+
+func sortValue(r *StudentAttendance, field SortField) float64 {
+	switch field {
+	case SortField_AvgMinutesLate:
+		return r.AverageMinutesLate()
+	case SortField_MissedShifts:
+		return float64(r.MissedShifts)
+	case SortField_EarlyDepartures:
+		return float64(r.EarlyDepartures)
+	case SortField_FlaggedLogs:
+		return float64(r.FlaggedLogs)
+	case SortField_HoursWorked:
+		return r.HoursWorked
+	case SortField_HoursScheduled:
+		return r.HoursScheduled
+	}
+	return float64(r.StudentID)
+}
+
+func tieBreak(a, b *StudentAttendance) bool {
+	if a.StudentID != b.StudentID {
+		return a.StudentID < b.StudentID
+	}
+	return a.PeriodStart.Before(b.PeriodStart)
+}
+
+type rowKey struct {
+	studentID   int32
+	periodStart time.Time
+}
+
+// periodOf returns the inclusive period containing day, clipped to the
+// query's date range.
+func periodOf(day time.Time, query ReportQuery) (time.Time, time.Time) {
+	d := dateOnly(day)
+	var start, end time.Time
+	switch query.Interval {
+	case Interval_Week:
+		start = d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+		end = start.AddDate(0, 0, 6)
+	case Interval_Month:
+		start = time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 1, -1)
+	default:
+		return query.From, query.To
+	}
+	if start.Before(query.From) {
+		start = query.From
+	}
+	if end.After(query.To) {
+		end = query.To
+	}
+	return start, end
+}
+
+// overlaps reports whether the log was open at any point during the shift.
+// Open logs are treated as still running.
+func overlaps(l *timelogAggregate.TimeLog, sh Shift) bool {
+	if !l.EntryAt.Before(sh.End) {
+		return false
+	}
+	return l.ExitAt == nil || l.ExitAt.After(sh.Start)
+}
+
+// workedMinutes prefers the paid minutes recorded at clock-out and falls back
+// to raw minutes. Open logs have none.
+func workedMinutes(l *timelogAggregate.TimeLog) *float64 {
+	if l.PaidMinutes != nil {
+		return l.PaidMinutes
+	}
+	return l.RawMinutes()
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
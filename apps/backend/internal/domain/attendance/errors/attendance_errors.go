@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidDateRange   = errors.New("to must not be before from")
+	ErrDateRangeTooLong   = errors.New("date range must not exceed 366 days")
+	ErrInvalidInterval    = errors.New("interval must be week or month")
+	ErrInvalidSortField   = errors.New("invalid sort field")
+	ErrMissingAuthContext = errors.New("missing authentication context")
+	ErrNotAuthorized      = errors.New("not authorized to perform this action")
+)
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	attendanceErrors "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/service"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+type AttendanceHandler struct {
+	logger  *zap.Logger
+	service service.AttendanceServiceInterface
+}
+
+func NewAttendanceHandler(logger *zap.Logger, service service.AttendanceServiceInterface) *AttendanceHandler {
+	return &AttendanceHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *AttendanceHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/attendance", func(r chi.Router) {
+		r.Get("/report", h.GetReport)
+		r.Get("/report/export", h.ExportReport)
+	})
+}
+
+func (h *AttendanceHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	query, ok := parseReportQuery(w, r)
+	if !ok {
+		return
+	}
+
+	rows, err := h.service.GetReport(r.Context(), query)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.AttendanceReportToResponse(rows))
+}
+
+func (h *AttendanceHandler) ExportReport(w http.ResponseWriter, r *http.Request) {
+	query, ok := parseReportQuery(w, r)
+	if !ok {
+		return
+	}
+
+	rows, err := h.service.GetReport(r.Context(), query)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("attendance_%s_%s.csv", query.From.Format("2006-01-02"), query.To.Format("2006-01-02"))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	header := []string{
+		"Student ID",
+		"Student Name",
+		"Period Start",
+		"Period End",
+		"Scheduled Shifts",
+		"On Time",
+		"Late",
+		"Missed",
+		"Early Departures",
+		"On-Time Rate",
+		"Avg Minutes Late",
+		"Flagged Logs",
+		"Hours Scheduled",
+		"Hours Worked",
+	}
+	if err := writer.Write(header); err != nil {
+		h.logger.Error("failed to write CSV header", zap.Error(err))
+		return
+	}
+
+	for _, row := range rows {
+		onTimeRate := ""
+		if rate, ok := row.OnTimeRate(); ok {
+			onTimeRate = fmt.Sprintf("%.2f", rate)
+		}
+
+		record := []string{
+			strconv.Itoa(int(row.StudentID)),
+			row.StudentName,
+			row.PeriodStart.Format("2006-01-02"),
+			row.PeriodEnd.Format("2006-01-02"),
+			strconv.Itoa(row.ScheduledShifts),
+			strconv.Itoa(row.OnTimeShifts),
+			strconv.Itoa(row.LateShifts),
+			strconv.Itoa(row.MissedShifts),
+			strconv.Itoa(row.EarlyDepartures),
+			onTimeRate,
+			fmt.Sprintf("%.2f", row.AverageMinutesLate()),
+			strconv.Itoa(row.FlaggedLogs),
+			fmt.Sprintf("%.2f", row.HoursScheduled),
+			fmt.Sprintf("%.2f", row.HoursWorked),
+		}
+		if err := writer.Write(record); err != nil {
+			h.logger.Error("failed to write CSV row", zap.Error(err))
+			return
+		}
+	}
+}
+
+// parseReportQuery reads from, to, interval, student_id, sort and order. It
+// writes a 400 and returns false when a parameter is malformed; ranges and
+// enum values are validated by the service.
+func parseReportQuery(w http.ResponseWriter, r *http.Request) (aggregate.ReportQuery, bool) {
+	q := r.URL.Query()
+	var query aggregate.ReportQuery
+
+	from, to := q.Get("from"), q.Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return query, false
+	}
+	var err error
+	if query.From, err = time.Parse("2006-01-02", from); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid from format (expected YYYY-MM-DD)")
+		return query, false
+	}
+	if query.To, err = time.Parse("2006-01-02", to); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid to format (expected YYYY-MM-DD)")
+		return query, false
+	}
+
+	if v := q.Get("student_id"); v != "" {
+		sid, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid student_id")
+			return query, false
+		}
+		id := int32(sid)
+		query.StudentID = &id
+	}
+
+	query.Interval = aggregate.Interval(q.Get("interval"))
+	query.SortBy = aggregate.SortField(q.Get("sort"))
+	switch q.Get("order") {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		writeError(w, http.StatusBadRequest, "order must be asc or desc")
+		return query, false
+	}
+	return query, true
+}
+
+func (h *AttendanceHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, attendanceErrors.ErrInvalidDateRange),
+		errors.Is(err, attendanceErrors.ErrDateRangeTooLong),
+		errors.Is(err, attendanceErrors.ErrInvalidInterval),
+		errors.Is(err, attendanceErrors.ErrInvalidSortField):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, attendanceErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, attendanceErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("failed to encode JSON response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,56 @@
+package dtos
+
+import (
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+)
+
+// --- Responses ---
+
+type StudentAttendanceResponse struct {
+	StudentID       int32    `json:"student_id"`
+	StudentName     string   `json:"student_name"`
+	PeriodStart     string   `json:"period_start"`
+	PeriodEnd       string   `json:"period_end"`
+	ScheduledShifts int      `json:"scheduled_shifts"`
+	OnTimeShifts    int      `json:"on_time_shifts"`
+	LateShifts      int      `json:"late_shifts"`
+	MissedShifts    int      `json:"missed_shifts"`
+	EarlyDepartures int      `json:"early_departures"`
+	OnTimeRate      *float64 `json:"on_time_rate"` // null when nothing was scheduled
+	AvgMinutesLate  float64  `json:"avg_minutes_late"`
+	FlaggedLogs     int      `json:"flagged_logs"`
+	HoursScheduled  float64  `json:"hours_scheduled"`
+	HoursWorked     float64  `json:"hours_worked"`
+}
+
+// --- Converters ---
+
+func StudentAttendanceToResponse(a *aggregate.StudentAttendance) StudentAttendanceResponse {
+	resp := StudentAttendanceResponse{
+		StudentID:       a.StudentID,
+		StudentName:     a.StudentName,
+		PeriodStart:     a.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:       a.PeriodEnd.Format("2006-01-02"),
+		ScheduledShifts: a.ScheduledShifts,
+		OnTimeShifts:    a.OnTimeShifts,
+		LateShifts:      a.LateShifts,
+		MissedShifts:    a.MissedShifts,
+		EarlyDepartures: a.EarlyDepartures,
+		AvgMinutesLate:  a.AverageMinutesLate(),
+		FlaggedLogs:     a.FlaggedLogs,
+		HoursScheduled:  a.HoursScheduled,
+		HoursWorked:     a.HoursWorked,
+	}
+	if rate, ok := a.OnTimeRate(); ok {
+		resp.OnTimeRate = &rate
+	}
+	return resp
+}
+
+func AttendanceReportToResponse(rows []*aggregate.StudentAttendance) []StudentAttendanceResponse {
+	out := make([]StudentAttendanceResponse, len(rows))
+	for i, r := range rows {
+		out[i] = StudentAttendanceToResponse(r)
+	}
+	return out
+}
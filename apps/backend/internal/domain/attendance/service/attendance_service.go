@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	attendanceErrors "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AttendanceServiceInterface defines the service contract.
+type AttendanceServiceInterface interface {
+	// GetReport computes punctuality per student and period from the active
+	// and archived schedules and the time logs in the query's date range.
+	GetReport(ctx context.Context, query aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error)
+}
+
+// AttendanceService implements AttendanceServiceInterface.
+type AttendanceService struct {
+	logger          *zap.Logger
+	txManager       database.TxManagerInterface
+	scheduleRepo    scheduleRepo.ScheduleRepositoryInterface
+	locationRepo    scheduleRepo.LocationRepositoryInterface
+	timeLogRepo     timelogRepo.TimeLogRepositoryInterface
+	payRuleRepo     timelogRepo.PayRuleRepositoryInterface
+	timeOffRepo     timeoffRepo.TimeOffRequestRepositoryInterface
+	studentRepo     studentRepo.StudentRepositoryInterface
+	defaultLocation *scheduleAggregate.Location
+	nowFn           func() time.Time
+}
+
+var _ AttendanceServiceInterface = (*AttendanceService)(nil)
+
+func NewAttendanceService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+	locationRepo scheduleRepo.LocationRepositoryInterface,
+	timeLogRepo timelogRepo.TimeLogRepositoryInterface,
+	payRuleRepo timelogRepo.PayRuleRepositoryInterface,
+	timeOffRepo timeoffRepo.TimeOffRequestRepositoryInterface,
+	studentRepo studentRepo.StudentRepositoryInterface,
+) *AttendanceService {
+	return &AttendanceService{
+		logger:       logger,
+		txManager:    txManager,
+		scheduleRepo: scheduleRepo,
+		locationRepo: locationRepo,
+		timeLogRepo:  timeLogRepo,
+		payRuleRepo:  payRuleRepo,
+		timeOffRepo:  timeOffRepo,
+		studentRepo:  studentRepo,
+		// Shifts whose template has no location use the help desk timezone,
+		// as at clock-in.
+		defaultLocation: &scheduleAggregate.Location{Timezone: scheduleAggregate.DefaultLocationTimezone},
+		nowFn:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *AttendanceService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+// GetReport only counts shifts that have already ended, so the current day
+// fills in as shifts finish. Shifts on approved time off are not scheduled
+// and are never counted as missed.
+func (s *AttendanceService) GetReport(ctx context.Context, query aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.StudentAttendance
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		shifts, err := s.scheduledShifts(ctx, tx, query)
+		if err != nil {
+			return err
+		}
+
+		tz := s.defaultLocation.TimeLocation()
+		from := time.Date(query.From.Year(), query.From.Month(), query.From.Day(), 0, 0, 0, 0, tz)
+		to := time.Date(query.To.Year(), query.To.Month(), query.To.Day()+1, 0, 0, 0, 0, tz)
+		logs, err := s.timeLogRepo.ListAll(ctx, tx, timelogRepo.TimeLogFilter{
+			StudentID: query.StudentID,
+			From:      &from,
+			To:        &to,
+		})
+		if err != nil {
+			return err
+		}
+
+		result = aggregate.BuildReport(shifts, logs, query, tz)
+		if err := s.attachNames(ctx, tx, result); err != nil {
+			return err
+		}
+		aggregate.SortReport(result, query.SortBy, query.Descending)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// datedSchedule is a schedule with the dates it was in force and its parsed
+// assignments.
+type datedSchedule struct {
+	from        time.Time
+	to          *time.Time
+	assignments scheduleAggregate.DatedAssignments
+}
+
+// scheduledShifts expands the active and archived schedules into the shifts
+// that ended within the query's range. When schedules overlap, the one that
+// took effect most recently governs the day. An archived schedule stops
+// applying on the day it was archived.
+func (s *AttendanceService) scheduledShifts(ctx context.Context, tx *sql.Tx, query aggregate.ReportQuery) ([]aggregate.Shift, error) {
+	var schedules []*scheduleAggregate.Schedule
+
+	active, err := s.scheduleRepo.GetActive(ctx, tx)
+	if err != nil && !errors.Is(err, scheduleErrors.ErrNotFound) {
+		return nil, err
+	}
+	if active != nil {
+		schedules = append(schedules, active)
+	}
+	archived, err := s.scheduleRepo.ListArchived(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	schedules = append(schedules, archived...)
+
+	var dated []datedSchedule
+	var shiftIDs []uuid.UUID
+	for _, sched := range schedules {
+		d := datedSchedule{from: dateOnly(sched.EffectiveFrom)}
+		if sched.EffectiveTo != nil {
+			to := dateOnly(*sched.EffectiveTo)
+			d.to = &to
+		}
+		if sched.ArchivedAt != nil {
+			archivedOn := dateOnly(*sched.ArchivedAt)
+			if d.to == nil || archivedOn.Before(*d.to) {
+				d.to = &archivedOn
+			}
+		}
+		if d.from.After(query.To) || (d.to != nil && d.to.Before(query.From)) {
+			continue
+		}
+		var err error
+		if d.assignments, err = sched.DatedAssignments(); err != nil {
+			s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err), zap.String("schedule_id", sched.ScheduleID.String()))
+			return nil, fmt.Errorf("malformed schedule assignments: %w", err)
+		}
+		entries := append([]scheduleAggregate.Assignment{}, d.assignments.Weekly...)
+		for _, o := range d.assignments.Overrides {
+			entries = append(entries, o.Assignments...)
+		}
+		for _, e := range entries {
+			if id, err := uuid.Parse(e.ShiftID); err == nil {
+				shiftIDs = append(shiftIDs, id)
+			}
+		}
+		dated = append(dated, d)
+	}
+	if len(dated) == 0 {
+		return []aggregate.Shift{}, nil
+	}
+
+	locations, err := s.locationRepo.ListByShiftTemplateIDs(ctx, tx, shiftIDs)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.payRuleRepo.ListActive(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	timeOff, err := s.timeOffRepo.List(ctx, tx, timeoffRepo.TimeOffFilter{
+		StudentID: query.StudentID,
+		Statuses:  []timeoffAggregate.Status{timeoffAggregate.Status_Approved},
+		From:      &query.From,
+		To:        &query.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.nowFn()
+	var shifts []aggregate.Shift
+	for i := range dated {
+		d := &dated[i]
+		skip := func(studentID int32, day time.Time) bool {
+			return governing(dated, day) != d ||
+				(query.StudentID != nil && *query.StudentID != studentID) ||
+				onTimeOff(timeOff, studentID, day)
+		}
+
+		for _, e := range d.assignments.Expand(d.from, d.to, query.From, query.To, skip) {
+			location := s.defaultLocation
+			var locationID *uuid.UUID
+			if id, err := uuid.Parse(e.ShiftID); err == nil {
+				if loc, ok := locations[id]; ok {
+					location = loc
+					locationID = &loc.ID
+				}
+			}
+			start, end, ok := e.At(e.Date, location.TimeLocation())
+			if !ok || end.After(now) {
+				continue
+			}
+
+			rule := timelogAggregate.SelectPayRule(rules, locationID, e.Date)
+			if rule == nil {
+				rule = timelogAggregate.DefaultPayRule()
+			}
+
+			shifts = append(shifts, aggregate.Shift{
+				StudentID:        e.StudentID,
+				ShiftID:          e.ShiftID,
+				Date:             e.Date,
+				Start:            start,
+				End:              end,
+				LateGraceMinutes: rule.LateGraceMinutes,
+			})
+		}
+	}
+	return shifts, nil
+}
+
+func (s *AttendanceService) attachNames(ctx context.Context, tx *sql.Tx, rows []*aggregate.StudentAttendance) error {
+	seen := make(map[int32]bool)
+	var ids []int32
+	for _, r := range rows {
+		if !seen[r.StudentID] {
+			seen[r.StudentID] = true
+			ids = append(ids, r.StudentID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	students, err := s.studentRepo.ListByIDs(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
+	names := make(map[int32]string, len(students))
+	for _, st := range students {
+		names[st.StudentID] = st.FirstName + " " + st.LastName
+	}
+	for _, r := range rows {
+		r.StudentName = names[r.StudentID]
+	}
+	return nil
+}
+
+// governing returns the schedule in force on day, preferring the one with the
+// latest effective date. Ties go to the earlier entry, which is the active
+// schedule when there is one.
+func governing(schedules []datedSchedule, day time.Time) *datedSchedule {
+	var best *datedSchedule
+	for i := range schedules {
+		d := &schedules[i]
+		if day.Before(d.from) || (d.to != nil && day.After(*d.to)) {
+			continue
+		}
+		if best == nil || d.from.After(best.from) {
+			best = d
+		}
+	}
+	return best
+}
+
+func onTimeOff(requests []*timeoffAggregate.TimeOffRequest, studentID int32, day time.Time) bool {
+	for _, r := range requests {
+		if r.StudentID == studentID && r.CoversDate(day) {
+			return true
+		}
+	}
+	return false
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (s *AttendanceService) requireAdmin(ctx context.Context) error {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return attendanceErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return attendanceErrors.ErrNotAuthorized
+	}
+	return nil
+}
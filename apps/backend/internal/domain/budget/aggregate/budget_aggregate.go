@@ -0,0 +1,151 @@
+package aggregate
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+type PeriodType string
+
+const (
+	PeriodType_Term  PeriodType = "term"
+	PeriodType_Month PeriodType = "month"
+)
+
+const (
+	maxBudgetNameLength = 100
+	maxBudgetAmount     = 99999999.99
+	maxTermDays         = 366
+)
+
+// Budget is the amount the help desk may spend on assistants over a term or
+// a calendar month. PeriodEnd is inclusive.
+type Budget struct {
+	ID          uuid.UUID
+	Name        string
+	PeriodType  PeriodType
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Amount      float64
+	CreatedBy   *uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// BudgetParams holds the configurable fields of a budget. PeriodEnd is
+// ignored for monthly budgets, which always cover the calendar month
+// containing PeriodStart.
+type BudgetParams struct {
+	Name        string
+	PeriodType  PeriodType
+	PeriodStart time.Time
+	PeriodEnd   *time.Time
+	Amount      float64
+}
+
+func NewBudget(params BudgetParams, createdBy *uuid.UUID) (*Budget, error) {
+	b := &Budget{
+		ID:        uuid.New(),
+		CreatedBy: createdBy,
+	}
+	if err := b.Update(params); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Update validates and replaces the budget's name, period and amount.
+func (b *Budget) Update(params BudgetParams) error {
+	name := strings.TrimSpace(params.Name)
+	if name == "" || len(name) > maxBudgetNameLength {
+		return errors.ErrInvalidBudgetName
+	}
+	if params.Amount <= 0 || params.Amount > maxBudgetAmount || roundCents(params.Amount) == 0 {
+		return errors.ErrInvalidBudgetAmount
+	}
+
+	start := dateOnly(params.PeriodStart)
+	var end time.Time
+	switch params.PeriodType {
+	case PeriodType_Month:
+		start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 1, -1)
+	case PeriodType_Term:
+		if params.PeriodEnd == nil {
+			return errors.ErrInvalidBudgetPeriod
+		}
+		end = dateOnly(*params.PeriodEnd)
+		if end.Before(start) || end.Sub(start) > maxTermDays*24*time.Hour {
+			return errors.ErrInvalidBudgetPeriod
+		}
+	default:
+		return errors.ErrInvalidPeriodType
+	}
+
+	b.Name = name
+	b.PeriodType = params.PeriodType
+	b.PeriodStart = start
+	b.PeriodEnd = end
+	b.Amount = roundCents(params.Amount)
+	return nil
+}
+
+// Period returns the budget's dates as a range.
+func (b *Budget) Period() DateRange {
+	return DateRange{From: b.PeriodStart, To: b.PeriodEnd}
+}
+
+// WeeklyCeiling spreads what is left of the budget after actual spending
+// evenly over the weeks of window, rounded down to the cent.
+func (b *Budget) WeeklyCeiling(actual float64, window DateRange) (float64, error) {
+	weeks := window.Weeks()
+	if weeks <= 0 {
+		return 0, errors.ErrScheduleOutsideBudget
+	}
+	remaining := b.Amount - actual
+	if remaining <= 0 {
+		return 0, errors.ErrBudgetExhausted
+	}
+	return math.Floor(remaining/weeks*100) / 100, nil
+}
+
+func BudgetFromModel(m model.Budgets) Budget {
+	return Budget{
+		ID:          m.ID,
+		Name:        m.Name,
+		PeriodType:  PeriodType(m.PeriodType),
+		PeriodStart: m.PeriodStart,
+		PeriodEnd:   m.PeriodEnd,
+		Amount:      m.Amount,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (b *Budget) ToModel() model.Budgets {
+	return model.Budgets{
+		ID:          b.ID,
+		Name:        b.Name,
+		PeriodType:  string(b.PeriodType),
+		PeriodStart: b.PeriodStart,
+		PeriodEnd:   b.PeriodEnd,
+		Amount:      b.Amount,
+		CreatedBy:   b.CreatedBy,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
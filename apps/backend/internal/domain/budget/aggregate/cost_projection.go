@@ -0,0 +1,165 @@
+package aggregate
+
+import (
+	"sort"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/google/uuid"
+)
+
+// DateRange is an inclusive range of dates.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Weeks is the number of weeks the range covers, counting both ends, or zero
+// if it is empty.
+func (r DateRange) Weeks() float64 {
+	if r.To.Before(r.From) {
+		return 0
+	}
+	days := r.To.Sub(r.From).Hours()/24 + 1
+	return days / 7
+}
+
+// ProjectionWindow is the part of a schedule's effective period that is still
+// to be paid for: from today (or the schedule start, if later) to the
+// schedule end. With a budget the window is also clipped to the budget
+// period. It returns nil for an open-ended schedule without a budget.
+func ProjectionWindow(effectiveFrom time.Time, effectiveTo *time.Time, budget *Budget, today time.Time) *DateRange {
+	from := dateOnly(effectiveFrom)
+	if t := dateOnly(today); t.After(from) {
+		from = t
+	}
+
+	var to *time.Time
+	if effectiveTo != nil {
+		end := dateOnly(*effectiveTo)
+		to = &end
+	}
+
+	if budget != nil {
+		if budget.PeriodStart.After(from) {
+			from = budget.PeriodStart
+		}
+		if to == nil || budget.PeriodEnd.Before(*to) {
+			end := budget.PeriodEnd
+			to = &end
+		}
+	}
+
+	if to == nil {
+		return nil
+	}
+	return &DateRange{From: from, To: *to}
+}
+
+// WeeklyHours totals the hours each student is assigned in a week. Entries
+// with a non-numeric assistant ID or unparseable times are skipped.
+func WeeklyHours(assignments []scheduleAggregate.Assignment) map[int32]float64 {
+	hours := make(map[int32]float64)
+	for _, a := range assignments {
+		id, ok := a.StudentID()
+		if !ok {
+			continue
+		}
+		if h := a.Hours(); h > 0 {
+			hours[id] += h
+		}
+	}
+	return hours
+}
+
+// RateBasis says how a projection prices assigned hours.
+type RateBasis string
+
+// RateBasis_Flat prices every student at the payroll hourly rate. Payroll has
+// no per-student rates, and pay rules change how worked time is rounded and
+// capped rather than what an hour is paid.
+const RateBasis_Flat RateBasis = "flat"
+
+// StudentCost is one student's share of a projection.
+type StudentCost struct {
+	StudentID     int32
+	WeeklyHours   float64
+	WeeklyCost    float64
+	ProjectedCost float64
+}
+
+// CostProjection is the projected cost of a schedule: assigned hours x hourly
+// rate x weeks remaining in the projection window. The rate is flat; see
+// RateBasis_Flat.
+type CostProjection struct {
+	ScheduleID    uuid.UUID
+	ScheduleTitle string
+	RateBasis     RateBasis
+	HourlyRate    float64
+	WeeklyHours   float64
+	WeeklyCost    float64
+	Window        *DateRange // nil for an open-ended schedule without a budget
+	Weeks         float64
+	ProjectedCost float64
+	Students      []StudentCost
+	Budget        *BudgetComparison
+}
+
+// BudgetComparison compares a projection with a budget and the payroll
+// generated so far in the budget period.
+type BudgetComparison struct {
+	Budget             *Budget
+	ActualToDate       float64
+	ProjectedTotal     float64 // actual to date plus the projected cost
+	Remaining          float64 // negative when the projection is over budget
+	OverBudget         bool
+	UtilisationPercent float64
+}
+
+// ProjectCost projects the cost of the given weekly hours at rate over
+// window. Without a window only the weekly figures are set.
+func ProjectCost(hours map[int32]float64, rate float64, window *DateRange) *CostProjection {
+	p := &CostProjection{
+		RateBasis:  RateBasis_Flat,
+		HourlyRate: rate,
+		Window:     window,
+		Students:   make([]StudentCost, 0, len(hours)),
+	}
+	if window != nil {
+		p.Weeks = roundCents(window.Weeks())
+	}
+
+	for studentID, h := range hours {
+		sc := StudentCost{
+			StudentID:   studentID,
+			WeeklyHours: roundCents(h),
+			WeeklyCost:  roundCents(h * rate),
+		}
+		if window != nil {
+			sc.ProjectedCost = roundCents(h * rate * window.Weeks())
+		}
+		p.WeeklyHours += h
+		p.Students = append(p.Students, sc)
+	}
+	sort.Slice(p.Students, func(i, j int) bool { return p.Students[i].StudentID < p.Students[j].StudentID })
+
+	p.WeeklyCost = roundCents(p.WeeklyHours * rate)
+	if window != nil {
+		p.ProjectedCost = roundCents(p.WeeklyHours * rate * window.Weeks())
+	}
+	p.WeeklyHours = roundCents(p.WeeklyHours)
+	return p
+}
+
+// CompareToBudget sets the projection's budget comparison.
+func (p *CostProjection) CompareToBudget(budget *Budget, actualToDate float64) {
+	total := roundCents(actualToDate + p.ProjectedCost)
+	p.Budget = &BudgetComparison{
+		Budget:             budget,
+		ActualToDate:       roundCents(actualToDate),
+		ProjectedTotal:     total,
+		Remaining:          roundCents(budget.Amount - total),
+		OverBudget:         total > budget.Amount,
+		UtilisationPercent: roundCents(total / budget.Amount * 100),
+	}
+}
@@ -0,0 +1,15 @@
+package errors
+
+import "errors"
+
+var (
+	ErrBudgetNotFound        = errors.New("budget not found")
+	ErrInvalidBudgetName     = errors.New("budget name is required (at most 100 characters)")
+	ErrInvalidPeriodType     = errors.New("period_type must be term or month")
+	ErrInvalidBudgetPeriod   = errors.New("term budgets need a period_end on or after period_start, at most a year later")
+	ErrInvalidBudgetAmount   = errors.New("budget amount must be greater than zero")
+	ErrScheduleOutsideBudget = errors.New("schedule does not run during the rest of the budget period")
+	ErrBudgetExhausted       = errors.New("budget has no funds remaining")
+	ErrMissingAuthContext    = errors.New("missing authentication context")
+	ErrNotAuthorized         = errors.New("not authorized to perform this action")
+)
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/service"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type BudgetHandler struct {
+	logger  *zap.Logger
+	service service.BudgetServiceInterface
+}
+
+func NewBudgetHandler(logger *zap.Logger, service service.BudgetServiceInterface) *BudgetHandler {
+	return &BudgetHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *BudgetHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/budgets", func(r chi.Router) {
+		r.Post("/", h.CreateBudget)
+		r.Get("/", h.ListBudgets)
+		r.Get("/{id}", h.GetBudget)
+		r.Put("/{id}", h.UpdateBudget)
+		r.Delete("/{id}", h.DeleteBudget)
+	})
+	r.Get("/schedules/{id}/cost-projection", h.ProjectScheduleCost)
+}
+
+func (h *BudgetHandler) CreateBudget(w http.ResponseWriter, r *http.Request) {
+	var req dtos.BudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	params, err := req.ToParams()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	budget, err := h.service.CreateBudget(r.Context(), params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.BudgetToResponse(budget))
+}
+
+func (h *BudgetHandler) ListBudgets(w http.ResponseWriter, r *http.Request) {
+	budgets, err := h.service.ListBudgets(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.BudgetsToResponse(budgets))
+}
+
+func (h *BudgetHandler) GetBudget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid budget ID")
+		return
+	}
+
+	budget, err := h.service.GetBudget(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.BudgetToResponse(budget))
+}
+
+func (h *BudgetHandler) UpdateBudget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid budget ID")
+		return
+	}
+
+	var req dtos.BudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	params, err := req.ToParams()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	budget, err := h.service.UpdateBudget(r.Context(), id, params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.BudgetToResponse(budget))
+}
+
+func (h *BudgetHandler) DeleteBudget(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid budget ID")
+		return
+	}
+
+	if err := h.service.DeleteBudget(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ProjectScheduleCost projects a schedule's cost, compared with a budget when
+// the budget_id query parameter is given.
+func (h *BudgetHandler) ProjectScheduleCost(w http.ResponseWriter, r *http.Request) {
+	scheduleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid schedule ID")
+		return
+	}
+
+	var budgetID *uuid.UUID
+	if v := r.URL.Query().Get("budget_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid budget_id")
+			return
+		}
+		budgetID = &id
+	}
+
+	projection, err := h.service.ProjectScheduleCost(r.Context(), scheduleID, budgetID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.CostProjectionToResponse(projection))
+}
+
+func (h *BudgetHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, budgetErrors.ErrBudgetNotFound):
+		writeError(w, http.StatusNotFound, "budget not found")
+	case errors.Is(err, scheduleErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "schedule not found")
+	case errors.Is(err, budgetErrors.ErrInvalidBudgetName),
+		errors.Is(err, budgetErrors.ErrInvalidPeriodType),
+		errors.Is(err, budgetErrors.ErrInvalidBudgetPeriod),
+		errors.Is(err, budgetErrors.ErrInvalidBudgetAmount):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, budgetErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, budgetErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("failed to encode JSON response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
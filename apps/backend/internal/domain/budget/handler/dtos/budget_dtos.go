@@ -0,0 +1,146 @@
+package dtos
+
+import (
+	"errors"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+)
+
+type BudgetRequest struct {
+	Name        string  `json:"name"`
+	PeriodType  string  `json:"period_type"`  // "term" or "month"
+	PeriodStart string  `json:"period_start"` // YYYY-MM-DD; any day in the month for monthly budgets
+	PeriodEnd   *string `json:"period_end"`   // YYYY-MM-DD, inclusive; required for terms
+	Amount      float64 `json:"amount"`
+}
+
+// ToParams parses the request dates.
+func (r BudgetRequest) ToParams() (aggregate.BudgetParams, error) {
+	params := aggregate.BudgetParams{
+		Name:       r.Name,
+		PeriodType: aggregate.PeriodType(r.PeriodType),
+		Amount:     r.Amount,
+	}
+	var err error
+	if params.PeriodStart, err = time.Parse(time.DateOnly, r.PeriodStart); err != nil {
+		return params, errors.New("invalid period_start format, expected YYYY-MM-DD")
+	}
+	if r.PeriodEnd != nil && *r.PeriodEnd != "" {
+		end, err := time.Parse(time.DateOnly, *r.PeriodEnd)
+		if err != nil {
+			return params, errors.New("invalid period_end format, expected YYYY-MM-DD")
+		}
+		params.PeriodEnd = &end
+	}
+	return params, nil
+}
+
+type BudgetResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	PeriodType  string     `json:"period_type"`
+	PeriodStart string     `json:"period_start"`
+	PeriodEnd   string     `json:"period_end"`
+	Amount      float64    `json:"amount"`
+	CreatedBy   *string    `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type StudentCostResponse struct {
+	StudentID     int32   `json:"student_id"`
+	WeeklyHours   float64 `json:"weekly_hours"`
+	WeeklyCost    float64 `json:"weekly_cost"`
+	ProjectedCost float64 `json:"projected_cost"`
+}
+
+type BudgetComparisonResponse struct {
+	Budget             BudgetResponse `json:"budget"`
+	ActualToDate       float64        `json:"actual_to_date"`
+	ProjectedTotal     float64        `json:"projected_total"`
+	Remaining          float64        `json:"remaining"`
+	OverBudget         bool           `json:"over_budget"`
+	UtilisationPercent float64        `json:"utilisation_percent"`
+}
+
+type CostProjectionResponse struct {
+	ScheduleID     string                    `json:"schedule_id"`
+	ScheduleTitle  string                    `json:"schedule_title"`
+	RateBasis      string                    `json:"rate_basis"`
+	HourlyRate     float64                   `json:"hourly_rate"`
+	WeeklyHours    float64                   `json:"weekly_hours"`
+	WeeklyCost     float64                   `json:"weekly_cost"`
+	ProjectionFrom *string                   `json:"projection_from"`
+	ProjectionTo   *string                   `json:"projection_to"`
+	Weeks          float64                   `json:"weeks"`
+	ProjectedCost  float64                   `json:"projected_cost"`
+	Students       []StudentCostResponse     `json:"students"`
+	Budget         *BudgetComparisonResponse `json:"budget"`
+}
+
+func BudgetToResponse(b *aggregate.Budget) BudgetResponse {
+	var createdBy *string
+	if b.CreatedBy != nil {
+		id := b.CreatedBy.String()
+		createdBy = &id
+	}
+	return BudgetResponse{
+		ID:          b.ID.String(),
+		Name:        b.Name,
+		PeriodType:  string(b.PeriodType),
+		PeriodStart: b.PeriodStart.Format(time.DateOnly),
+		PeriodEnd:   b.PeriodEnd.Format(time.DateOnly),
+		Amount:      b.Amount,
+		CreatedBy:   createdBy,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
+
+func BudgetsToResponse(budgets []*aggregate.Budget) []BudgetResponse {
+	responses := make([]BudgetResponse, len(budgets))
+	for i, b := range budgets {
+		responses[i] = BudgetToResponse(b)
+	}
+	return responses
+}
+
+func CostProjectionToResponse(p *aggregate.CostProjection) CostProjectionResponse {
+	resp := CostProjectionResponse{
+		ScheduleID:    p.ScheduleID.String(),
+		ScheduleTitle: p.ScheduleTitle,
+		RateBasis:     string(p.RateBasis),
+		HourlyRate:    p.HourlyRate,
+		WeeklyHours:   p.WeeklyHours,
+		WeeklyCost:    p.WeeklyCost,
+		Weeks:         p.Weeks,
+		ProjectedCost: p.ProjectedCost,
+		Students:      make([]StudentCostResponse, len(p.Students)),
+	}
+	if p.Window != nil {
+		from := p.Window.From.Format(time.DateOnly)
+		to := p.Window.To.Format(time.DateOnly)
+		resp.ProjectionFrom = &from
+		resp.ProjectionTo = &to
+	}
+	for i, sc := range p.Students {
+		resp.Students[i] = StudentCostResponse{
+			StudentID:     sc.StudentID,
+			WeeklyHours:   sc.WeeklyHours,
+			WeeklyCost:    sc.WeeklyCost,
+			ProjectedCost: sc.ProjectedCost,
+		}
+	}
+	if c := p.Budget; c != nil {
+		resp.Budget = &BudgetComparisonResponse{
+			Budget:             BudgetToResponse(c.Budget),
+			ActualToDate:       c.ActualToDate,
+			ProjectedTotal:     c.ProjectedTotal,
+			Remaining:          c.Remaining,
+			OverBudget:         c.OverBudget,
+			UtilisationPercent: c.UtilisationPercent,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	"github.com/google/uuid"
+)
+
+type BudgetRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Budget, error)
+	// List returns budgets ordered by period start, most recent first.
+	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Budget, error)
+	Update(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error)
+	Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/repository"
+	payrollRepo "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BudgetServiceInterface defines the budget and cost projection contract.
+type BudgetServiceInterface interface {
+	CreateBudget(ctx context.Context, params aggregate.BudgetParams) (*aggregate.Budget, error)
+	ListBudgets(ctx context.Context) ([]*aggregate.Budget, error)
+	GetBudget(ctx context.Context, id uuid.UUID) (*aggregate.Budget, error)
+	UpdateBudget(ctx context.Context, id uuid.UUID, params aggregate.BudgetParams) (*aggregate.Budget, error)
+	DeleteBudget(ctx context.Context, id uuid.UUID) error
+	// ProjectScheduleCost projects what a schedule will cost over the rest of
+	// its effective period and, given a budget, compares it with the budget
+	// and the payroll generated so far in the budget period.
+	ProjectScheduleCost(ctx context.Context, scheduleID uuid.UUID, budgetID *uuid.UUID) (*aggregate.CostProjection, error)
+	// CostCeiling returns the hourly rate and the weekly cost ceiling for
+	// generating a schedule within a budget: what is left of the budget spread
+	// over the weeks the schedule will run in the budget period.
+	CostCeiling(ctx context.Context, budgetID uuid.UUID, effectiveFrom time.Time, effectiveTo *time.Time) (float64, float64, error)
+}
+
+// BudgetService implements BudgetServiceInterface.
+type BudgetService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	budgetRepo   repository.BudgetRepositoryInterface
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface
+	paymentRepo  payrollRepo.PaymentRepositoryInterface
+	hourlyRate   float64
+	nowFn        func() time.Time
+}
+
+var _ BudgetServiceInterface = (*BudgetService)(nil)
+
+func NewBudgetService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	budgetRepo repository.BudgetRepositoryInterface,
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+	paymentRepo payrollRepo.PaymentRepositoryInterface,
+) *BudgetService {
+	return &BudgetService{
+		logger:       logger,
+		txManager:    txManager,
+		budgetRepo:   budgetRepo,
+		scheduleRepo: scheduleRepo,
+		paymentRepo:  paymentRepo,
+		// Projections use the same rate payroll pays.
+		hourlyRate: payrollService.HourlyRate,
+		nowFn:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *BudgetService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *BudgetService) CreateBudget(ctx context.Context, params aggregate.BudgetParams) (*aggregate.Budget, error) {
+	authCtx, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		createdBy = &id
+	}
+
+	budget, err := aggregate.NewBudget(params, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Budget
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.budgetRepo.Create(ctx, tx, budget)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *BudgetService) ListBudgets(ctx context.Context) ([]*aggregate.Budget, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var budgets []*aggregate.Budget
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		budgets, txErr = s.budgetRepo.List(ctx, tx)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return budgets, nil
+}
+
+func (s *BudgetService) GetBudget(ctx context.Context, id uuid.UUID) (*aggregate.Budget, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var budget *aggregate.Budget
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		budget, txErr = s.budgetRepo.GetByID(ctx, tx, id)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return budget, nil
+}
+
+func (s *BudgetService) UpdateBudget(ctx context.Context, id uuid.UUID, params aggregate.BudgetParams) (*aggregate.Budget, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Budget
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		budget, txErr := s.budgetRepo.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr := budget.Update(params); txErr != nil {
+			return txErr
+		}
+		result, txErr = s.budgetRepo.Update(ctx, tx, budget)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *BudgetService) DeleteBudget(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		return s.budgetRepo.Delete(ctx, tx, id)
+	})
+}
+
+// ProjectScheduleCost counts from today, so the projection shrinks as the
+// schedule runs and the payroll for past weeks moves into the actual total.
+func (s *BudgetService) ProjectScheduleCost(ctx context.Context, scheduleID uuid.UUID, budgetID *uuid.UUID) (*aggregate.CostProjection, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var projection *aggregate.CostProjection
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		schedule, err := s.scheduleRepo.GetByID(ctx, tx, scheduleID)
+		if err != nil {
+			return err
+		}
+
+		assignments, err := scheduleAggregate.ParseAssignments(schedule.Assignments)
+		if err != nil {
+			s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err), zap.String("schedule_id", scheduleID.String()))
+			return fmt.Errorf("malformed schedule assignments: %w", err)
+		}
+
+		var budget *aggregate.Budget
+		if budgetID != nil {
+			if budget, err = s.budgetRepo.GetByID(ctx, tx, *budgetID); err != nil {
+				return err
+			}
+		}
+
+		window := aggregate.ProjectionWindow(schedule.EffectiveFrom, schedule.EffectiveTo, budget, s.nowFn())
+		projection = aggregate.ProjectCost(aggregate.WeeklyHours(assignments), s.hourlyRate, window)
+		projection.ScheduleID = schedule.ScheduleID
+		projection.ScheduleTitle = schedule.Title
+
+		if budget != nil {
+			actual, err := s.paymentRepo.TotalGrossAmount(ctx, tx, budget.PeriodStart, budget.PeriodEnd)
+			if err != nil {
+				return err
+			}
+			projection.CompareToBudget(budget, actual)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return projection, nil
+}
+
+func (s *BudgetService) CostCeiling(ctx context.Context, budgetID uuid.UUID, effectiveFrom time.Time, effectiveTo *time.Time) (float64, float64, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	var ceiling float64
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		budget, err := s.budgetRepo.GetByID(ctx, tx, budgetID)
+		if err != nil {
+			return err
+		}
+
+		actual, err := s.paymentRepo.TotalGrossAmount(ctx, tx, budget.PeriodStart, budget.PeriodEnd)
+		if err != nil {
+			return err
+		}
+
+		window := aggregate.ProjectionWindow(effectiveFrom, effectiveTo, budget, s.nowFn())
+		ceiling, err = budget.WeeklyCeiling(actual, *window)
+		return err
+	})
+
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.hourlyRate, ceiling, nil
+}
+
+func (s *BudgetService) requireAdmin(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return database.AuthContext{}, budgetErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return database.AuthContext{}, budgetErrors.ErrNotAuthorized
+	}
+	return authCtx, nil
+}
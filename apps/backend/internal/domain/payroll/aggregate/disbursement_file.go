@@ -0,0 +1,129 @@
+package aggregate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+// DisbursementFile is a bank file generated for one bank and pay period. The
+// record count, control total and checksum are kept so the bursary can
+// reconcile what the bank received against what was generated. PaymentIDs
+// are the payments the file pays out; no payment is in more than one file.
+// Content is nil when the file is loaded for listing.
+type DisbursementFile struct {
+	ID           uuid.UUID
+	BankName     string
+	Format       string
+	Filename     string
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	RecordCount  int32
+	ControlTotal float64
+	Checksum     string
+	Content      []byte
+	GeneratedBy  *uuid.UUID
+	CreatedAt    time.Time
+	PaymentIDs   []uuid.UUID
+}
+
+func NewDisbursementFile(
+	bankName, format, extension string,
+	periodStart, periodEnd time.Time,
+	recordCount int,
+	controlTotalCents int64,
+	content []byte,
+	generatedBy *uuid.UUID,
+	paymentIDs []uuid.UUID,
+) *DisbursementFile {
+	return &DisbursementFile{
+		ID:           uuid.New(),
+		BankName:     bankName,
+		Format:       format,
+		Filename:     disbursementFilename(bankName, periodStart, periodEnd, extension),
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		RecordCount:  int32(recordCount),
+		ControlTotal: float64(controlTotalCents) / 100,
+		Checksum:     Checksum(content),
+		Content:      content,
+		GeneratedBy:  generatedBy,
+		PaymentIDs:   paymentIDs,
+	}
+}
+
+// Checksum is the hex SHA-256 of a file's content.
+func Checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyChecksum reports whether Content still matches the recorded checksum.
+func (f *DisbursementFile) VerifyChecksum() bool {
+	return Checksum(f.Content) == f.Checksum
+}
+
+// disbursementFilename builds e.g. "disbursement_republic_bank_2026-03-01_2026-03-15.csv".
+func disbursementFilename(bankName string, periodStart, periodEnd time.Time, extension string) string {
+	slug := make([]byte, 0, len(bankName))
+	for _, r := range bankName {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			slug = append(slug, byte(r))
+		case r >= 'A' && r <= 'Z':
+			slug = append(slug, byte(r-'A'+'a'))
+		case len(slug) > 0 && slug[len(slug)-1] != '_':
+			slug = append(slug, '_')
+		}
+	}
+	for len(slug) > 0 && slug[len(slug)-1] == '_' {
+		slug = slug[:len(slug)-1]
+	}
+	if len(slug) == 0 {
+		slug = []byte("bank")
+	}
+	return fmt.Sprintf("disbursement_%s_%s_%s.%s",
+		slug, periodStart.Format("2006-01-02"), periodEnd.Format("2006-01-02"), extension)
+}
+
+// DisbursementFileFromModel builds a file from its row, the already decrypted
+// content, which may be nil, and the IDs of the payments it pays out.
+func DisbursementFileFromModel(m *model.DisbursementFiles, content []byte, paymentIDs []uuid.UUID) *DisbursementFile {
+	return &DisbursementFile{
+		ID:           m.ID,
+		BankName:     m.BankName,
+		Format:       m.Format,
+		Filename:     m.Filename,
+		PeriodStart:  m.PeriodStart,
+		PeriodEnd:    m.PeriodEnd,
+		RecordCount:  m.RecordCount,
+		ControlTotal: m.ControlTotal,
+		Checksum:     m.Checksum,
+		Content:      content,
+		GeneratedBy:  m.GeneratedBy,
+		CreatedAt:    m.CreatedAt,
+		PaymentIDs:   paymentIDs,
+	}
+}
+
+// ToModel converts the file to its row, using the encrypted form of Content.
+func (f *DisbursementFile) ToModel(encryptedContent []byte) model.DisbursementFiles {
+	return model.DisbursementFiles{
+		ID:           f.ID,
+		BankName:     f.BankName,
+		Format:       f.Format,
+		Filename:     f.Filename,
+		PeriodStart:  f.PeriodStart,
+		PeriodEnd:    f.PeriodEnd,
+		RecordCount:  f.RecordCount,
+		ControlTotal: f.ControlTotal,
+		Checksum:     f.Checksum,
+		Content:      encryptedContent,
+		GeneratedBy:  f.GeneratedBy,
+		CreatedAt:    f.CreatedAt,
+	}
+}
@@ -0,0 +1,292 @@
+package aggregate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+type PayFrequency string
+
+const (
+	PayFrequency_Weekly      PayFrequency = "weekly"
+	PayFrequency_Biweekly    PayFrequency = "biweekly"
+	PayFrequency_SemiMonthly PayFrequency = "semimonthly" // 1st–15th and 16th–end of month
+	PayFrequency_Monthly     PayFrequency = "monthly"
+)
+
+func (f PayFrequency) IsValid() bool {
+	switch f {
+	case PayFrequency_Weekly, PayFrequency_Biweekly, PayFrequency_SemiMonthly, PayFrequency_Monthly:
+		return true
+	}
+	return false
+}
+
+// PeriodRange is a pay period. Both dates are inclusive.
+type PeriodRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Overlaps reports whether the two ranges share at least one day.
+func (p PeriodRange) Overlaps(other PeriodRange) bool {
+	return !p.End.Before(other.Start) && !other.End.Before(p.Start)
+}
+
+func (p PeriodRange) String() string {
+	return p.Start.Format(time.DateOnly) + " to " + p.End.Format(time.DateOnly)
+}
+
+// PayCalendar divides time into consecutive pay periods starting at
+// AnchorDate. Semi-monthly calendars anchor on the 1st or 16th; monthly
+// calendars run from the anchor's day of month to the day before it in the
+// following month, so the anchor must fall on or before the 28th.
+type PayCalendar struct {
+	ID         uuid.UUID
+	Frequency  PayFrequency
+	AnchorDate time.Time
+	IsActive   bool
+	CreatedBy  *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+func NewPayCalendar(frequency PayFrequency, anchorDate time.Time, createdBy *uuid.UUID) (*PayCalendar, error) {
+	if !frequency.IsValid() {
+		return nil, payrollErrors.ErrInvalidPayFrequency
+	}
+	anchorDate = truncateDate(anchorDate)
+	switch frequency {
+	case PayFrequency_SemiMonthly:
+		if anchorDate.Day() != 1 && anchorDate.Day() != 16 {
+			return nil, payrollErrors.ErrInvalidPayAnchorDate
+		}
+	case PayFrequency_Monthly:
+		if anchorDate.Day() > 28 {
+			return nil, payrollErrors.ErrInvalidPayAnchorDate
+		}
+	}
+
+	return &PayCalendar{
+		ID:         uuid.New(),
+		Frequency:  frequency,
+		AnchorDate: anchorDate,
+		IsActive:   true,
+		CreatedBy:  createdBy,
+	}, nil
+}
+
+// PeriodContaining returns the period that day falls in, or false if day is
+// before the anchor date.
+func (c *PayCalendar) PeriodContaining(day time.Time) (PeriodRange, bool) {
+	day = truncateDate(day)
+	if day.Before(c.AnchorDate) {
+		return PeriodRange{}, false
+	}
+
+	var start time.Time
+	switch c.Frequency {
+	case PayFrequency_Weekly, PayFrequency_Biweekly:
+		length := c.periodDays()
+		elapsed := int(day.Sub(c.AnchorDate).Hours() / 24)
+		start = c.AnchorDate.AddDate(0, 0, elapsed/length*length)
+	case PayFrequency_SemiMonthly:
+		start = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if day.Day() >= 16 {
+			start = start.AddDate(0, 0, 15)
+		}
+	default:
+		start = time.Date(day.Year(), day.Month(), c.AnchorDate.Day(), 0, 0, 0, 0, time.UTC)
+		if start.After(day) {
+			start = start.AddDate(0, -1, 0)
+		}
+	}
+	return PeriodRange{Start: start, End: c.periodEnd(start)}, true
+}
+
+// Next returns the period following p.
+func (c *PayCalendar) Next(p PeriodRange) PeriodRange {
+	start := p.End.AddDate(0, 0, 1)
+	return PeriodRange{Start: start, End: c.periodEnd(start)}
+}
+
+// ClosedPeriods returns up to limit periods that ended before today, in
+// order. With a last generated period they continue from the day after it;
+// without one only the most recently closed period is returned, so enabling a
+// calendar does not backfill its whole history.
+func (c *PayCalendar) ClosedPeriods(lastEnd *time.Time, today time.Time, limit int) []PeriodRange {
+	today = truncateDate(today)
+
+	var next PeriodRange
+	if lastEnd != nil {
+		from := truncateDate(*lastEnd).AddDate(0, 0, 1)
+		if from.Before(c.AnchorDate) {
+			from = c.AnchorDate
+		}
+		p, ok := c.PeriodContaining(from)
+		if !ok {
+			return nil
+		}
+		next = p
+	} else {
+		p, ok := c.PeriodContaining(today.AddDate(0, 0, -1))
+		if !ok {
+			return nil
+		}
+		if !p.End.Before(today) {
+			if p, ok = c.PeriodContaining(p.Start.AddDate(0, 0, -1)); !ok {
+				return nil
+			}
+		}
+		next = p
+	}
+
+	var periods []PeriodRange
+	for ; next.End.Before(today) && len(periods) < limit; next = c.Next(next) {
+		periods = append(periods, next)
+	}
+	return periods
+}
+
+func (c *PayCalendar) periodDays() int {
+	if c.Frequency == PayFrequency_Biweekly {
+		return 14
+	}
+	return 7
+}
+
+func (c *PayCalendar) periodEnd(start time.Time) time.Time {
+	switch c.Frequency {
+	case PayFrequency_Weekly, PayFrequency_Biweekly:
+		return start.AddDate(0, 0, c.periodDays()-1)
+	case PayFrequency_SemiMonthly:
+		if start.Day() < 16 {
+			return time.Date(start.Year(), start.Month(), 15, 0, 0, 0, 0, time.UTC)
+		}
+		return time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	default:
+		return start.AddDate(0, 1, -1)
+	}
+}
+
+func PayCalendarFromModel(m *model.PayCalendars) *PayCalendar {
+	return &PayCalendar{
+		ID:         m.ID,
+		Frequency:  PayFrequency(m.Frequency),
+		AnchorDate: m.AnchorDate,
+		IsActive:   m.IsActive,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func (c *PayCalendar) ToModel() model.PayCalendars {
+	return model.PayCalendars{
+		ID:         c.ID,
+		Frequency:  string(c.Frequency),
+		AnchorDate: c.AnchorDate,
+		IsActive:   c.IsActive,
+		CreatedBy:  c.CreatedBy,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+type PayPeriodStatus string
+
+const (
+	// PayPeriodStatus_Generated periods have draft payments awaiting review.
+	PayPeriodStatus_Generated PayPeriodStatus = "generated"
+	// PayPeriodStatus_Blocked periods overlap payments generated for a
+	// different period and were skipped.
+	PayPeriodStatus_Blocked PayPeriodStatus = "blocked"
+)
+
+// PayPeriod records the pay calendar job's run for one period.
+type PayPeriod struct {
+	ID           uuid.UUID
+	CalendarID   *uuid.UUID
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	Status       PayPeriodStatus
+	PaymentCount int32
+	GrossTotal   float64
+	Note         *string
+	NotifiedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+// NewGeneratedPayPeriod records the payments generated for a period.
+func NewGeneratedPayPeriod(calendarID uuid.UUID, period PeriodRange, payments []*Payment) *PayPeriod {
+	var gross int64
+	for _, p := range payments {
+		gross += toCents(p.GrossAmount)
+	}
+	return &PayPeriod{
+		ID:           uuid.New(),
+		CalendarID:   &calendarID,
+		PeriodStart:  period.Start,
+		PeriodEnd:    period.End,
+		Status:       PayPeriodStatus_Generated,
+		PaymentCount: int32(len(payments)),
+		GrossTotal:   fromCents(gross),
+	}
+}
+
+// NewBlockedPayPeriod records a period skipped because it overlaps payments
+// generated for other periods.
+func NewBlockedPayPeriod(calendarID uuid.UUID, period PeriodRange, overlapping []PeriodRange) *PayPeriod {
+	ranges := make([]string, len(overlapping))
+	for i, o := range overlapping {
+		ranges[i] = o.String()
+	}
+	note := fmt.Sprintf("overlaps payments already generated for %s", strings.Join(ranges, ", "))
+	return &PayPeriod{
+		ID:          uuid.New(),
+		CalendarID:  &calendarID,
+		PeriodStart: period.Start,
+		PeriodEnd:   period.End,
+		Status:      PayPeriodStatus_Blocked,
+		Note:        &note,
+	}
+}
+
+func (p *PayPeriod) Range() PeriodRange {
+	return PeriodRange{Start: p.PeriodStart, End: p.PeriodEnd}
+}
+
+func PayPeriodFromModel(m *model.PayPeriods) *PayPeriod {
+	return &PayPeriod{
+		ID:           m.ID,
+		CalendarID:   m.CalendarID,
+		PeriodStart:  m.PeriodStart,
+		PeriodEnd:    m.PeriodEnd,
+		Status:       PayPeriodStatus(m.Status),
+		PaymentCount: m.PaymentCount,
+		GrossTotal:   m.GrossTotal,
+		Note:         m.Note,
+		NotifiedAt:   m.NotifiedAt,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func (p *PayPeriod) ToModel() model.PayPeriods {
+	return model.PayPeriods{
+		ID:           p.ID,
+		CalendarID:   p.CalendarID,
+		PeriodStart:  p.PeriodStart,
+		PeriodEnd:    p.PeriodEnd,
+		Status:       string(p.Status),
+		PaymentCount: p.PaymentCount,
+		GrossTotal:   p.GrossTotal,
+		Note:         p.Note,
+		NotifiedAt:   p.NotifiedAt,
+		CreatedAt:    p.CreatedAt,
+	}
+}
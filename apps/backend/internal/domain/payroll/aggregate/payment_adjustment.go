@@ -0,0 +1,157 @@
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+type AdjustmentType string
+
+const (
+	AdjustmentType_Bonus               AdjustmentType = "bonus"
+	AdjustmentType_Stipend             AdjustmentType = "stipend"
+	AdjustmentType_BackPay             AdjustmentType = "back_pay"
+	AdjustmentType_Deduction           AdjustmentType = "deduction"
+	AdjustmentType_OverpaymentRecovery AdjustmentType = "overpayment_recovery"
+)
+
+const (
+	maxAdjustmentAmount       = 999999.99
+	maxAdjustmentReasonLength = 500
+)
+
+func (t AdjustmentType) IsValid() bool {
+	switch t {
+	case AdjustmentType_Bonus, AdjustmentType_Stipend, AdjustmentType_BackPay,
+		AdjustmentType_Deduction, AdjustmentType_OverpaymentRecovery:
+		return true
+	}
+	return false
+}
+
+// IsDeduction reports whether the adjustment reduces net pay rather than
+// adding to gross pay.
+func (t AdjustmentType) IsDeduction() bool {
+	return t == AdjustmentType_Deduction || t == AdjustmentType_OverpaymentRecovery
+}
+
+// PaymentAdjustment is a line item on a payment. Amount is always positive;
+// the type decides whether it is a credit or a deduction.
+//
+// An adjustment raised against a processed payment is carried forward:
+// OriginalPaymentID records the processed payment and PaymentID is the later
+// payment it was applied to, or nil while it waits for one to be generated.
+type PaymentAdjustment struct {
+	ID                uuid.UUID
+	StudentID         int32
+	PaymentID         *uuid.UUID
+	OriginalPaymentID *uuid.UUID
+	OriginalPeriodEnd *time.Time
+	Type              AdjustmentType
+	Amount            float64
+	Reason            string
+	CreatedBy         *uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         *time.Time
+}
+
+func NewPaymentAdjustment(studentID int32, adjustmentType AdjustmentType, amount float64, reason string, createdBy *uuid.UUID) (*PaymentAdjustment, error) {
+	if !adjustmentType.IsValid() {
+		return nil, payrollErrors.ErrInvalidAdjustmentType
+	}
+	if amount <= 0 || amount > maxAdjustmentAmount || toCents(amount) == 0 {
+		return nil, payrollErrors.ErrInvalidAdjustmentAmount
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > maxAdjustmentReasonLength {
+		return nil, payrollErrors.ErrAdjustmentReasonRequired
+	}
+
+	return &PaymentAdjustment{
+		ID:        uuid.New(),
+		StudentID: studentID,
+		Type:      adjustmentType,
+		Amount:    fromCents(toCents(amount)),
+		Reason:    reason,
+		CreatedBy: createdBy,
+	}, nil
+}
+
+// ApplyTo attaches the adjustment to payment.
+func (a *PaymentAdjustment) ApplyTo(payment *Payment) {
+	id := payment.PaymentID
+	a.PaymentID = &id
+}
+
+// CarryForwardFrom marks the adjustment as raised against a processed payment.
+// It stays pending until ApplyTo attaches it to a later payment.
+func (a *PaymentAdjustment) CarryForwardFrom(processed *Payment) {
+	id := processed.PaymentID
+	periodEnd := processed.PeriodEnd
+	a.OriginalPaymentID = &id
+	a.OriginalPeriodEnd = &periodEnd
+	a.PaymentID = nil
+}
+
+// IsPending reports whether a carried-forward adjustment is still waiting for
+// a payment.
+func (a *PaymentAdjustment) IsPending() bool {
+	return a.PaymentID == nil
+}
+
+// CanApplyTo reports whether a pending adjustment may be applied to payment:
+// it must be the same student's unprocessed payment for a period starting on
+// or after the end of the period the adjustment was raised against.
+func (a *PaymentAdjustment) CanApplyTo(payment *Payment) bool {
+	if payment.StudentID != a.StudentID || payment.ProcessedAt != nil {
+		return false
+	}
+	if a.OriginalPaymentID != nil && *a.OriginalPaymentID == payment.PaymentID {
+		return false
+	}
+	return a.OriginalPeriodEnd == nil || !payment.PeriodStart.Before(*a.OriginalPeriodEnd)
+}
+
+// SignedAmount is the amount as it affects net pay.
+func (a *PaymentAdjustment) SignedAmount() float64 {
+	if a.Type.IsDeduction() {
+		return -a.Amount
+	}
+	return a.Amount
+}
+
+func PaymentAdjustmentFromModel(m *model.PaymentAdjustments) *PaymentAdjustment {
+	return &PaymentAdjustment{
+		ID:                m.ID,
+		StudentID:         m.StudentID,
+		PaymentID:         m.PaymentID,
+		OriginalPaymentID: m.OriginalPaymentID,
+		OriginalPeriodEnd: m.OriginalPeriodEnd,
+		Type:              AdjustmentType(m.Type),
+		Amount:            m.Amount,
+		Reason:            m.Reason,
+		CreatedBy:         m.CreatedBy,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func (a *PaymentAdjustment) ToModel() model.PaymentAdjustments {
+	return model.PaymentAdjustments{
+		ID:                a.ID,
+		StudentID:         a.StudentID,
+		PaymentID:         a.PaymentID,
+		OriginalPaymentID: a.OriginalPaymentID,
+		OriginalPeriodEnd: a.OriginalPeriodEnd,
+		Type:              string(a.Type),
+		Amount:            a.Amount,
+		Reason:            a.Reason,
+		CreatedBy:         a.CreatedBy,
+		CreatedAt:         a.CreatedAt,
+		UpdatedAt:         a.UpdatedAt,
+	}
+}
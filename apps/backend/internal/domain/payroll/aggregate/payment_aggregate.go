@@ -1,6 +1,7 @@
 package aggregate
 
 import (
+	"math"
 	"time"
 
 	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
@@ -8,16 +9,21 @@ import (
 	"github.com/google/uuid"
 )
 
+// Payment is a student's pay for one period. GrossAmount is hours worked at
+// the hourly rate plus any credit adjustments; NetAmount is what is paid out
+// after deductions.
 type Payment struct {
-	PaymentID   uuid.UUID
-	StudentID   int32
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	HoursWorked float64
-	GrossAmount float64
-	ProcessedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   *time.Time
+	PaymentID        uuid.UUID
+	StudentID        int32
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	HoursWorked      float64
+	GrossAmount      float64
+	DeductionsAmount float64
+	NetAmount        float64
+	ProcessedAt      *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        *time.Time
 }
 
 func NewPayment(studentID int32, periodStart, periodEnd time.Time, hoursWorked, grossAmount float64) (*Payment, error) {
@@ -32,6 +38,7 @@ func NewPayment(studentID int32, periodStart, periodEnd time.Time, hoursWorked,
 		PeriodEnd:   periodEnd,
 		HoursWorked: hoursWorked,
 		GrossAmount: grossAmount,
+		NetAmount:   grossAmount,
 	}, nil
 }
 
@@ -52,30 +59,69 @@ func (p *Payment) RevertProcessed() error {
 	return nil
 }
 
+// ApplyAdjustments recalculates the payment's amounts from its base pay
+// (hours at the hourly rate) and the adjustments applied to it. The payment is
+// left unchanged if it has been processed or deductions would exceed gross pay.
+func (p *Payment) ApplyAdjustments(basePay float64, adjustments []*PaymentAdjustment) error {
+	if p.ProcessedAt != nil {
+		return payrollErrors.ErrAlreadyProcessed
+	}
+
+	gross := toCents(basePay)
+	var deductions int64
+	for _, a := range adjustments {
+		if a.Type.IsDeduction() {
+			deductions += toCents(a.Amount)
+		} else {
+			gross += toCents(a.Amount)
+		}
+	}
+	if deductions > gross {
+		return payrollErrors.ErrNegativeNetAmount
+	}
+
+	p.GrossAmount = fromCents(gross)
+	p.DeductionsAmount = fromCents(deductions)
+	p.NetAmount = fromCents(gross - deductions)
+	return nil
+}
+
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
 func PaymentFromModel(m model.Payments) Payment {
 	return Payment{
-		PaymentID:   m.PaymentID,
-		StudentID:   m.StudentID,
-		PeriodStart: m.PeriodStart,
-		PeriodEnd:   m.PeriodEnd,
-		HoursWorked: m.HoursWorked,
-		GrossAmount: m.GrossAmount,
-		ProcessedAt: m.ProcessedAt,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		PaymentID:        m.PaymentID,
+		StudentID:        m.StudentID,
+		PeriodStart:      m.PeriodStart,
+		PeriodEnd:        m.PeriodEnd,
+		HoursWorked:      m.HoursWorked,
+		GrossAmount:      m.GrossAmount,
+		DeductionsAmount: m.DeductionsAmount,
+		NetAmount:        m.NetAmount,
+		ProcessedAt:      m.ProcessedAt,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
 	}
 }
 
 func (p *Payment) ToModel() model.Payments {
 	return model.Payments{
-		PaymentID:   p.PaymentID,
-		StudentID:   p.StudentID,
-		PeriodStart: p.PeriodStart,
-		PeriodEnd:   p.PeriodEnd,
-		HoursWorked: p.HoursWorked,
-		GrossAmount: p.GrossAmount,
-		ProcessedAt: p.ProcessedAt,
-		CreatedAt:   p.CreatedAt,
-		UpdatedAt:   p.UpdatedAt,
+		PaymentID:        p.PaymentID,
+		StudentID:        p.StudentID,
+		PeriodStart:      p.PeriodStart,
+		PeriodEnd:        p.PeriodEnd,
+		HoursWorked:      p.HoursWorked,
+		GrossAmount:      p.GrossAmount,
+		DeductionsAmount: p.DeductionsAmount,
+		NetAmount:        p.NetAmount,
+		ProcessedAt:      p.ProcessedAt,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
 	}
 }
@@ -0,0 +1,31 @@
+package aggregate
+
+import "fmt"
+
+// Payslip is everything shown on a student's payslip for one payment.
+// Banking fields are empty when the student has no details on file, and the
+// account number is always masked.
+type Payslip struct {
+	Payment             *Payment
+	Adjustments         []*PaymentAdjustment
+	StudentName         string
+	StudentEmail        string
+	HourlyRate          float64
+	BankName            string
+	BranchName          string
+	AccountType         string
+	MaskedAccountNumber string
+}
+
+// Filename is the name the payslip PDF is downloaded and attached as.
+func (p *Payslip) Filename() string {
+	return fmt.Sprintf("payslip_%d_%s.pdf", p.Payment.StudentID, p.Payment.PeriodEnd.Format("2006-01-02"))
+}
+
+// MaskAccountNumber keeps only the last four digits of an account number.
+func MaskAccountNumber(accountNumber string) string {
+	if len(accountNumber) <= 4 {
+		return "****"
+	}
+	return "****" + accountNumber[len(accountNumber)-4:]
+}
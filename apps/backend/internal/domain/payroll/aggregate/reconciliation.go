@@ -0,0 +1,362 @@
+package aggregate
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timesheetAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	"github.com/google/uuid"
+)
+
+// MaxReconciliationDays bounds the period of a single reconciliation report.
+const MaxReconciliationDays = 366
+
+// LogStatus is how payroll treats a time log.
+type LogStatus string
+
+const (
+	LogStatus_Counted        LogStatus = "counted"
+	LogStatus_Open           LogStatus = "open"
+	LogStatus_Flagged        LogStatus = "flagged"
+	LogStatus_UnapprovedWeek LogStatus = "unapproved_week"
+)
+
+// DiscrepancyCode says why paid hours differ from the roster.
+type DiscrepancyCode string
+
+const (
+	// DiscrepancyCode_Variance is a day whose payable hours differ from its
+	// scheduled hours by more than the tolerance.
+	DiscrepancyCode_Variance DiscrepancyCode = "variance"
+	// DiscrepancyCode_Flagged, _UnapprovedWeek and _Open are days with logs
+	// payroll leaves out.
+	DiscrepancyCode_Flagged        DiscrepancyCode = "flagged"
+	DiscrepancyCode_UnapprovedWeek DiscrepancyCode = "unapproved_week"
+	DiscrepancyCode_Open           DiscrepancyCode = "open_log"
+	// DiscrepancyCode_PaymentMismatch is a generated payment whose hours no
+	// longer match the payable hours, e.g. because logs changed afterwards.
+	DiscrepancyCode_PaymentMismatch DiscrepancyCode = "payment_mismatch"
+)
+
+// ReconciliationQuery selects the period and students of a report. Period
+// dates are inclusive and, like payment periods, in UTC.
+type ReconciliationQuery struct {
+	PeriodStart       time.Time
+	PeriodEnd         time.Time
+	StudentID         *int32
+	ToleranceMinutes  int
+	DiscrepanciesOnly bool
+}
+
+func (q *ReconciliationQuery) Validate() error {
+	q.PeriodStart, q.PeriodEnd = truncateDate(q.PeriodStart), truncateDate(q.PeriodEnd)
+	if !q.PeriodEnd.After(q.PeriodStart) {
+		return payrollErrors.ErrInvalidPeriod
+	}
+	if q.PeriodEnd.Sub(q.PeriodStart) >= MaxReconciliationDays*24*time.Hour {
+		return payrollErrors.ErrPeriodTooLong
+	}
+	if q.ToleranceMinutes < 0 || q.ToleranceMinutes > 24*60 {
+		return payrollErrors.ErrInvalidTolerance
+	}
+	return nil
+}
+
+// ScheduledShift is one dated occurrence of a scheduled shift.
+type ScheduledShift struct {
+	StudentID int32
+	Date      time.Time
+	ShiftID   string
+	Start     string
+	End       string
+	Hours     float64
+}
+
+// ScheduledShiftsFrom converts a schedule's dated shifts for reconciliation.
+func ScheduledShiftsFrom(dated []scheduleAggregate.DatedShift) []ScheduledShift {
+	shifts := make([]ScheduledShift, len(dated))
+	for i, d := range dated {
+		shifts[i] = ScheduledShift{
+			StudentID: d.StudentID,
+			Date:      d.Date,
+			ShiftID:   d.ShiftID,
+			Start:     d.Start,
+			End:       d.End,
+			Hours:     d.Hours(),
+		}
+	}
+	return shifts
+}
+
+// ApprovedWeeks records the weeks each student has an approved timesheet for,
+// keyed by week start.
+type ApprovedWeeks map[int32]map[time.Time]bool
+
+func (a ApprovedWeeks) Add(studentID int32, weekStart time.Time) {
+	if a[studentID] == nil {
+		a[studentID] = make(map[time.Time]bool)
+	}
+	a[studentID][truncateDate(weekStart)] = true
+}
+
+// Covers reports whether t, at a location in tz, falls in a week the student
+// has an approved timesheet for.
+func (a ApprovedWeeks) Covers(studentID int32, t time.Time, tz *time.Location) bool {
+	return a[studentID][timesheetAggregate.WeekStartAt(t, tz)]
+}
+
+// Timezones maps location IDs to their time zones, so each time log is placed
+// in the week it started in locally.
+type Timezones map[uuid.UUID]*time.Location
+
+// TimezonesFrom indexes the locations' time zones by ID.
+func TimezonesFrom(locations []*scheduleAggregate.Location) Timezones {
+	zones := make(Timezones, len(locations))
+	for _, l := range locations {
+		zones[l.ID] = l.TimeLocation()
+	}
+	return zones
+}
+
+// Of returns the time zone of the location, falling back to the default
+// location's for logs without a known location.
+func (z Timezones) Of(locationID *uuid.UUID) *time.Location {
+	if locationID != nil {
+		if tz, ok := z[*locationID]; ok {
+			return tz
+		}
+	}
+	return (&scheduleAggregate.Location{Timezone: scheduleAggregate.DefaultLocationTimezone}).TimeLocation()
+}
+
+// ReconciledLog is a time log with the hours it represents and how payroll
+// treats it.
+type ReconciledLog struct {
+	TimeLogID  uuid.UUID
+	Date       time.Time
+	EntryAt    time.Time
+	ExitAt     *time.Time
+	Hours      float64
+	Status     LogStatus
+	FlagReason *string
+}
+
+// Discrepancy is one reason a student's paid hours differ from the roster,
+// with the logs behind it. Date is nil for period-wide discrepancies.
+type Discrepancy struct {
+	Code       DiscrepancyCode
+	Date       *time.Time
+	Hours      float64 // signed for variances: payable minus scheduled
+	TimeLogIDs []uuid.UUID
+}
+
+// StudentReconciliation compares one student's scheduled hours with the
+// hours payroll pays for a period.
+type StudentReconciliation struct {
+	StudentID       int32
+	StudentName     string
+	ScheduledHours  float64
+	LoggedHours     float64 // closed logs, whatever their status
+	PayableHours    float64 // closed, unflagged logs in approved weeks
+	FlaggedHours    float64
+	UnapprovedHours float64
+	OpenLogs        int32
+	Variance        float64 // payable minus scheduled
+	Payment         *Payment
+	Shifts          []ScheduledShift
+	Logs            []ReconciledLog
+	Discrepancies   []Discrepancy
+}
+
+// HasDiscrepancies reports whether anything needs an admin's attention.
+func (r *StudentReconciliation) HasDiscrepancies() bool {
+	return len(r.Discrepancies) > 0
+}
+
+// Reconcile builds a report row for every student with a scheduled shift, a
+// time log or a payment in the period. Logs are classified with the same
+// rules payroll uses: open logs, flagged logs and logs in weeks without an
+// approved timesheet are not paid; a log's week is taken in the local time of
+// its location, looked up in zones. Each day whose payable hours differ from
+// its scheduled hours by more than tolerance is reported as a variance.
+func Reconcile(
+	shifts []ScheduledShift,
+	logs []*timelogAggregate.TimeLog,
+	approved ApprovedWeeks,
+	zones Timezones,
+	payments []*Payment,
+	tolerance time.Duration,
+) []*StudentReconciliation {
+	rows := make(map[int32]*StudentReconciliation)
+	row := func(studentID int32) *StudentReconciliation {
+		r, ok := rows[studentID]
+		if !ok {
+			r = &StudentReconciliation{
+				StudentID:     studentID,
+				Shifts:        []ScheduledShift{},
+				Logs:          []ReconciledLog{},
+				Discrepancies: []Discrepancy{},
+			}
+			rows[studentID] = r
+		}
+		return r
+	}
+
+	for _, s := range shifts {
+		r := row(s.StudentID)
+		r.ScheduledHours += s.Hours
+		r.Shifts = append(r.Shifts, s)
+	}
+
+	for _, l := range logs {
+		r := row(l.StudentID)
+		rl := ReconciledLog{
+			TimeLogID:  l.ID,
+			Date:       truncateDate(l.EntryAt),
+			EntryAt:    l.EntryAt,
+			ExitAt:     l.ExitAt,
+			Hours:      logHours(l),
+			FlagReason: l.FlagReason,
+		}
+		switch {
+		case l.ExitAt == nil:
+			rl.Status = LogStatus_Open
+			r.OpenLogs++
+		case l.IsFlagged:
+			rl.Status = LogStatus_Flagged
+			r.FlaggedHours += rl.Hours
+		case !approved.Covers(l.StudentID, l.EntryAt, zones.Of(l.LocationID)):
+			rl.Status = LogStatus_UnapprovedWeek
+			r.UnapprovedHours += rl.Hours
+		default:
+			rl.Status = LogStatus_Counted
+			r.PayableHours += rl.Hours
+		}
+		r.LoggedHours += rl.Hours
+		r.Logs = append(r.Logs, rl)
+	}
+
+	for _, p := range payments {
+		row(p.StudentID).Payment = p
+	}
+
+	result := make([]*StudentReconciliation, 0, len(rows))
+	for _, r := range rows {
+		r.ScheduledHours = roundHours(r.ScheduledHours)
+		r.LoggedHours = roundHours(r.LoggedHours)
+		r.PayableHours = roundHours(r.PayableHours)
+		r.FlaggedHours = roundHours(r.FlaggedHours)
+		r.UnapprovedHours = roundHours(r.UnapprovedHours)
+		r.Variance = roundHours(r.PayableHours - r.ScheduledHours)
+		r.Discrepancies = findDiscrepancies(r, tolerance)
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StudentID < result[j].StudentID })
+	return result
+}
+
+func findDiscrepancies(r *StudentReconciliation, tolerance time.Duration) []Discrepancy {
+	type day struct {
+		scheduled float64
+		payable   float64
+		counted   []uuid.UUID
+		excluded  map[LogStatus]*Discrepancy
+	}
+	days := make(map[time.Time]*day)
+	dayOf := func(date time.Time) *day {
+		d, ok := days[date]
+		if !ok {
+			d = &day{excluded: make(map[LogStatus]*Discrepancy)}
+			days[date] = d
+		}
+		return d
+	}
+
+	for _, s := range r.Shifts {
+		dayOf(s.Date).scheduled += s.Hours
+	}
+	for _, l := range r.Logs {
+		d := dayOf(l.Date)
+		if l.Status == LogStatus_Counted {
+			d.payable += l.Hours
+			d.counted = append(d.counted, l.TimeLogID)
+			continue
+		}
+		disc, ok := d.excluded[l.Status]
+		if !ok {
+			date := l.Date
+			disc = &Discrepancy{Code: discrepancyCodeFor(l.Status), Date: &date}
+			d.excluded[l.Status] = disc
+		}
+		disc.Hours += l.Hours
+		disc.TimeLogIDs = append(disc.TimeLogIDs, l.TimeLogID)
+	}
+
+	dates := make([]time.Time, 0, len(days))
+	for date := range days {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	result := []Discrepancy{}
+	for _, date := range dates {
+		d := days[date]
+		diff := roundHours(d.payable - d.scheduled)
+		if math.Abs(diff) > tolerance.Hours() {
+			ids := d.counted
+			if ids == nil {
+				ids = []uuid.UUID{}
+			}
+			result = append(result, Discrepancy{Code: DiscrepancyCode_Variance, Date: &date, Hours: diff, TimeLogIDs: ids})
+		}
+		for _, status := range []LogStatus{LogStatus_Flagged, LogStatus_UnapprovedWeek, LogStatus_Open} {
+			if disc, ok := d.excluded[status]; ok {
+				disc.Hours = roundHours(disc.Hours)
+				result = append(result, *disc)
+			}
+		}
+	}
+
+	if r.Payment != nil {
+		if diff := roundHours(r.Payment.HoursWorked - r.PayableHours); diff != 0 {
+			result = append(result, Discrepancy{Code: DiscrepancyCode_PaymentMismatch, Hours: diff, TimeLogIDs: []uuid.UUID{}})
+		}
+	}
+	return result
+}
+
+func discrepancyCodeFor(status LogStatus) DiscrepancyCode {
+	switch status {
+	case LogStatus_Flagged:
+		return DiscrepancyCode_Flagged
+	case LogStatus_UnapprovedWeek:
+		return DiscrepancyCode_UnapprovedWeek
+	default:
+		return DiscrepancyCode_Open
+	}
+}
+
+// logHours is a closed log's paid time in hours, falling back to its raw
+// duration for logs closed before pay rules existed. Open logs have none.
+func logHours(l *timelogAggregate.TimeLog) float64 {
+	if l.PaidMinutes != nil {
+		return *l.PaidMinutes / 60
+	}
+	if raw := l.RawMinutes(); raw != nil {
+		return *raw / 60
+	}
+	return 0
+}
+
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
+
+func truncateDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -8,6 +8,23 @@ var (
 	ErrAlreadyProcessed   = errors.New("payment has already been processed")
 	ErrNotProcessed       = errors.New("payment has not been processed")
 	ErrInvalidPeriod      = errors.New("invalid payment period")
+	ErrPeriodTooLong      = errors.New("period must be at most 366 days")
+	ErrInvalidTolerance   = errors.New("tolerance_minutes must be between 0 and 1440")
 	ErrMissingAuthContext = errors.New("missing authentication context")
 	ErrNotAuthorized      = errors.New("not authorized to perform this action")
+
+	ErrAdjustmentNotFound       = errors.New("payment adjustment not found")
+	ErrInvalidAdjustmentType    = errors.New("invalid adjustment type")
+	ErrInvalidAdjustmentAmount  = errors.New("adjustment amount must be greater than zero and at most 999999.99")
+	ErrAdjustmentReasonRequired = errors.New("adjustment reason is required (at most 500 characters)")
+	ErrNegativeNetAmount        = errors.New("deductions would exceed the payment's gross amount")
+
+	ErrDisbursementFileNotFound = errors.New("disbursement file not found")
+	ErrChecksumMismatch         = errors.New("disbursement file does not match its recorded checksum")
+
+	ErrPeriodOverlap        = errors.New("period overlaps payments generated for a different period")
+	ErrPayCalendarNotFound  = errors.New("no active pay calendar")
+	ErrInvalidPayFrequency  = errors.New("frequency must be one of weekly, biweekly, semimonthly or monthly")
+	ErrInvalidPayAnchorDate = errors.New("anchor_date must be the 1st or 16th for semimonthly calendars and at most the 28th for monthly calendars")
+	ErrPayPeriodNotFound    = errors.New("pay period not found")
 )
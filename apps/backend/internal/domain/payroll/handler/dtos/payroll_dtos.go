@@ -1,9 +1,12 @@
 package dtos
 
 import (
+	"math"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
 )
 
 // --- Requests ---
@@ -13,37 +16,180 @@ type GeneratePaymentsRequest struct {
 	PeriodEnd   string `json:"period_end"`
 }
 
+type SetPayCalendarRequest struct {
+	Frequency  string `json:"frequency"`
+	AnchorDate string `json:"anchor_date"`
+}
+
 type BulkProcessRequest struct {
 	PaymentIDs []string `json:"payment_ids"`
 }
 
+type AddAdjustmentRequest struct {
+	Type   string  `json:"type"`
+	Amount float64 `json:"amount"`
+	Reason string  `json:"reason"`
+}
+
+type GenerateDisbursementsRequest struct {
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+}
+
 // --- Responses ---
 
 type PaymentResponse struct {
-	PaymentID   string     `json:"payment_id"`
-	StudentID   int32      `json:"student_id"`
-	PeriodStart string     `json:"period_start"`
-	PeriodEnd   string     `json:"period_end"`
-	HoursWorked float64    `json:"hours_worked"`
-	GrossAmount float64    `json:"gross_amount"`
-	ProcessedAt *time.Time `json:"processed_at"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	PaymentID        string     `json:"payment_id"`
+	StudentID        int32      `json:"student_id"`
+	PeriodStart      string     `json:"period_start"`
+	PeriodEnd        string     `json:"period_end"`
+	HoursWorked      float64    `json:"hours_worked"`
+	GrossAmount      float64    `json:"gross_amount"`
+	DeductionsAmount float64    `json:"deductions_amount"`
+	NetAmount        float64    `json:"net_amount"`
+	ProcessedAt      *time.Time `json:"processed_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        *time.Time `json:"updated_at"`
+}
+
+type AdjustmentResponse struct {
+	ID                string     `json:"id"`
+	StudentID         int32      `json:"student_id"`
+	PaymentID         *string    `json:"payment_id"`
+	OriginalPaymentID *string    `json:"original_payment_id"`
+	Type              string     `json:"type"`
+	Amount            float64    `json:"amount"`
+	SignedAmount      float64    `json:"signed_amount"`
+	Reason            string     `json:"reason"`
+	Pending           bool       `json:"pending"`
+	CreatedBy         *string    `json:"created_by"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at"`
+}
+
+type AddAdjustmentResponse struct {
+	Adjustment AdjustmentResponse `json:"adjustment"`
+	Payment    *PaymentResponse   `json:"payment"`
+}
+
+type DisbursementFileResponse struct {
+	ID           string    `json:"id"`
+	BankName     string    `json:"bank_name"`
+	Format       string    `json:"format"`
+	Filename     string    `json:"filename"`
+	PeriodStart  string    `json:"period_start"`
+	PeriodEnd    string    `json:"period_end"`
+	RecordCount  int32     `json:"record_count"`
+	ControlTotal float64   `json:"control_total"`
+	Checksum     string    `json:"checksum"`
+	GeneratedBy  *string   `json:"generated_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	PaymentIDs   []string  `json:"payment_ids"`
+}
+
+type SkippedPaymentResponse struct {
+	PaymentID   string  `json:"payment_id"`
+	StudentID   int32   `json:"student_id"`
+	GrossAmount float64 `json:"gross_amount"`
+	Reason      string  `json:"reason"`
+}
+
+type DisbursementRunResponse struct {
+	Files        []DisbursementFileResponse `json:"files"`
+	Skipped      []SkippedPaymentResponse   `json:"skipped"`
+	RecordCount  int32                      `json:"record_count"`
+	ControlTotal float64                    `json:"control_total"`
+}
+
+type BankFormatsResponse struct {
+	Formats  []string          `json:"formats"`
+	Banks    map[string]string `json:"banks"`
+	Fallback string            `json:"fallback"`
+}
+
+type ScheduledShiftResponse struct {
+	Date    string  `json:"date"`
+	ShiftID string  `json:"shift_id"`
+	Start   string  `json:"start"`
+	End     string  `json:"end"`
+	Hours   float64 `json:"hours"`
+}
+
+type ReconciledLogResponse struct {
+	TimeLogID  string     `json:"time_log_id"`
+	Date       string     `json:"date"`
+	EntryAt    time.Time  `json:"entry_at"`
+	ExitAt     *time.Time `json:"exit_at"`
+	Hours      float64    `json:"hours"`
+	Status     string     `json:"status"`
+	FlagReason *string    `json:"flag_reason"`
+}
+
+type DiscrepancyResponse struct {
+	Code       string   `json:"code"`
+	Date       *string  `json:"date"`
+	Hours      float64  `json:"hours"`
+	TimeLogIDs []string `json:"time_log_ids"`
+}
+
+type ReconciliationResponse struct {
+	StudentID       int32                    `json:"student_id"`
+	StudentName     string                   `json:"student_name"`
+	ScheduledHours  float64                  `json:"scheduled_hours"`
+	LoggedHours     float64                  `json:"logged_hours"`
+	PayableHours    float64                  `json:"payable_hours"`
+	FlaggedHours    float64                  `json:"flagged_hours"`
+	UnapprovedHours float64                  `json:"unapproved_hours"`
+	OpenLogs        int32                    `json:"open_logs"`
+	Variance        float64                  `json:"variance"`
+	PaymentID       *string                  `json:"payment_id"`
+	PaymentHours    *float64                 `json:"payment_hours"`
+	Shifts          []ScheduledShiftResponse `json:"shifts"`
+	Logs            []ReconciledLogResponse  `json:"logs"`
+	Discrepancies   []DiscrepancyResponse    `json:"discrepancies"`
+}
+
+type PeriodRangeResponse struct {
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+}
+
+type PayCalendarResponse struct {
+	ID         string                `json:"id"`
+	Frequency  string                `json:"frequency"`
+	AnchorDate string                `json:"anchor_date"`
+	CreatedBy  *string               `json:"created_by"`
+	CreatedAt  time.Time             `json:"created_at"`
+	Upcoming   []PeriodRangeResponse `json:"upcoming_periods"`
+}
+
+type PayPeriodResponse struct {
+	ID           string     `json:"id"`
+	PeriodStart  string     `json:"period_start"`
+	PeriodEnd    string     `json:"period_end"`
+	Status       string     `json:"status"`
+	PaymentCount int32      `json:"payment_count"`
+	GrossTotal   float64    `json:"gross_total"`
+	Note         *string    `json:"note"`
+	NotifiedAt   *time.Time `json:"notified_at"`
+	CreatedAt    time.Time  `json:"created_at"`
 }
 
 // --- Converters ---
 
 func PaymentToResponse(p *aggregate.Payment) PaymentResponse {
 	return PaymentResponse{
-		PaymentID:   p.PaymentID.String(),
-		StudentID:   p.StudentID,
-		PeriodStart: p.PeriodStart.Format("2006-01-02"),
-		PeriodEnd:   p.PeriodEnd.Format("2006-01-02"),
-		HoursWorked: p.HoursWorked,
-		GrossAmount: p.GrossAmount,
-		ProcessedAt: p.ProcessedAt,
-		CreatedAt:   p.CreatedAt,
-		UpdatedAt:   p.UpdatedAt,
+		PaymentID:        p.PaymentID.String(),
+		StudentID:        p.StudentID,
+		PeriodStart:      p.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:        p.PeriodEnd.Format("2006-01-02"),
+		HoursWorked:      p.HoursWorked,
+		GrossAmount:      p.GrossAmount,
+		DeductionsAmount: p.DeductionsAmount,
+		NetAmount:        p.NetAmount,
+		ProcessedAt:      p.ProcessedAt,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
 	}
 }
 
@@ -54,3 +200,213 @@ func PaymentsToResponse(payments []*aggregate.Payment) []PaymentResponse {
 	}
 	return responses
 }
+
+func AdjustmentToResponse(a *aggregate.PaymentAdjustment) AdjustmentResponse {
+	return AdjustmentResponse{
+		ID:                a.ID.String(),
+		StudentID:         a.StudentID,
+		PaymentID:         uuidString(a.PaymentID),
+		OriginalPaymentID: uuidString(a.OriginalPaymentID),
+		Type:              string(a.Type),
+		Amount:            a.Amount,
+		SignedAmount:      a.SignedAmount(),
+		Reason:            a.Reason,
+		Pending:           a.IsPending(),
+		CreatedBy:         uuidString(a.CreatedBy),
+		CreatedAt:         a.CreatedAt,
+		UpdatedAt:         a.UpdatedAt,
+	}
+}
+
+func AdjustmentsToResponse(adjustments []*aggregate.PaymentAdjustment) []AdjustmentResponse {
+	responses := make([]AdjustmentResponse, len(adjustments))
+	for i, a := range adjustments {
+		responses[i] = AdjustmentToResponse(a)
+	}
+	return responses
+}
+
+func AdjustmentResultToResponse(r *service.AdjustmentResult) AddAdjustmentResponse {
+	resp := AddAdjustmentResponse{Adjustment: AdjustmentToResponse(r.Adjustment)}
+	if r.Payment != nil {
+		payment := PaymentToResponse(r.Payment)
+		resp.Payment = &payment
+	}
+	return resp
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func DisbursementFileToResponse(f *aggregate.DisbursementFile) DisbursementFileResponse {
+	return DisbursementFileResponse{
+		ID:           f.ID.String(),
+		BankName:     f.BankName,
+		Format:       f.Format,
+		Filename:     f.Filename,
+		PeriodStart:  f.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:    f.PeriodEnd.Format("2006-01-02"),
+		RecordCount:  f.RecordCount,
+		ControlTotal: f.ControlTotal,
+		Checksum:     f.Checksum,
+		GeneratedBy:  uuidString(f.GeneratedBy),
+		CreatedAt:    f.CreatedAt,
+		PaymentIDs:   paymentIDStrings(f.PaymentIDs),
+	}
+}
+
+func paymentIDStrings(ids []uuid.UUID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
+
+func DisbursementFilesToResponse(files []*aggregate.DisbursementFile) []DisbursementFileResponse {
+	responses := make([]DisbursementFileResponse, len(files))
+	for i, f := range files {
+		responses[i] = DisbursementFileToResponse(f)
+	}
+	return responses
+}
+
+// DisbursementRunToResponse also totals the run across every bank file.
+func DisbursementRunToResponse(run *service.DisbursementRun) DisbursementRunResponse {
+	resp := DisbursementRunResponse{
+		Files:   DisbursementFilesToResponse(run.Files),
+		Skipped: make([]SkippedPaymentResponse, len(run.Skipped)),
+	}
+	var totalCents int64
+	for _, f := range run.Files {
+		resp.RecordCount += f.RecordCount
+		totalCents += int64(math.Round(f.ControlTotal * 100))
+	}
+	resp.ControlTotal = float64(totalCents) / 100
+	for i, sp := range run.Skipped {
+		resp.Skipped[i] = SkippedPaymentResponse{
+			PaymentID:   sp.Payment.PaymentID.String(),
+			StudentID:   sp.Payment.StudentID,
+			GrossAmount: sp.Payment.GrossAmount,
+			Reason:      sp.Reason,
+		}
+	}
+	return resp
+}
+
+func BankFormatsToResponse(f service.BankFormats) BankFormatsResponse {
+	return BankFormatsResponse{
+		Formats:  f.Formats,
+		Banks:    f.Banks,
+		Fallback: f.Fallback,
+	}
+}
+
+func ReconciliationToResponse(r *aggregate.StudentReconciliation) ReconciliationResponse {
+	resp := ReconciliationResponse{
+		StudentID:       r.StudentID,
+		StudentName:     r.StudentName,
+		ScheduledHours:  r.ScheduledHours,
+		LoggedHours:     r.LoggedHours,
+		PayableHours:    r.PayableHours,
+		FlaggedHours:    r.FlaggedHours,
+		UnapprovedHours: r.UnapprovedHours,
+		OpenLogs:        r.OpenLogs,
+		Variance:        r.Variance,
+		Shifts:          make([]ScheduledShiftResponse, len(r.Shifts)),
+		Logs:            make([]ReconciledLogResponse, len(r.Logs)),
+		Discrepancies:   make([]DiscrepancyResponse, len(r.Discrepancies)),
+	}
+	if r.Payment != nil {
+		id := r.Payment.PaymentID.String()
+		hours := r.Payment.HoursWorked
+		resp.PaymentID = &id
+		resp.PaymentHours = &hours
+	}
+	for i, sh := range r.Shifts {
+		resp.Shifts[i] = ScheduledShiftResponse{
+			Date:    sh.Date.Format("2006-01-02"),
+			ShiftID: sh.ShiftID,
+			Start:   sh.Start,
+			End:     sh.End,
+			Hours:   sh.Hours,
+		}
+	}
+	for i, l := range r.Logs {
+		resp.Logs[i] = ReconciledLogResponse{
+			TimeLogID:  l.TimeLogID.String(),
+			Date:       l.Date.Format("2006-01-02"),
+			EntryAt:    l.EntryAt,
+			ExitAt:     l.ExitAt,
+			Hours:      math.Round(l.Hours*100) / 100,
+			Status:     string(l.Status),
+			FlagReason: l.FlagReason,
+		}
+	}
+	for i, d := range r.Discrepancies {
+		dr := DiscrepancyResponse{
+			Code:       string(d.Code),
+			Hours:      d.Hours,
+			TimeLogIDs: make([]string, len(d.TimeLogIDs)),
+		}
+		if d.Date != nil {
+			date := d.Date.Format("2006-01-02")
+			dr.Date = &date
+		}
+		for j, id := range d.TimeLogIDs {
+			dr.TimeLogIDs[j] = id.String()
+		}
+		resp.Discrepancies[i] = dr
+	}
+	return resp
+}
+
+func ReconciliationsToResponse(rows []*aggregate.StudentReconciliation) []ReconciliationResponse {
+	responses := make([]ReconciliationResponse, len(rows))
+	for i, r := range rows {
+		responses[i] = ReconciliationToResponse(r)
+	}
+	return responses
+}
+
+func PayCalendarToResponse(o *service.PayCalendarOverview) PayCalendarResponse {
+	c := o.Calendar
+	resp := PayCalendarResponse{
+		ID:         c.ID.String(),
+		Frequency:  string(c.Frequency),
+		AnchorDate: c.AnchorDate.Format("2006-01-02"),
+		CreatedBy:  uuidString(c.CreatedBy),
+		CreatedAt:  c.CreatedAt,
+		Upcoming:   make([]PeriodRangeResponse, len(o.Upcoming)),
+	}
+	for i, p := range o.Upcoming {
+		resp.Upcoming[i] = PeriodRangeResponse{
+			PeriodStart: p.Start.Format("2006-01-02"),
+			PeriodEnd:   p.End.Format("2006-01-02"),
+		}
+	}
+	return resp
+}
+
+func PayPeriodsToResponse(periods []*aggregate.PayPeriod) []PayPeriodResponse {
+	responses := make([]PayPeriodResponse, len(periods))
+	for i, p := range periods {
+		responses[i] = PayPeriodResponse{
+			ID:           p.ID.String(),
+			PeriodStart:  p.PeriodStart.Format("2006-01-02"),
+			PeriodEnd:    p.PeriodEnd.Format("2006-01-02"),
+			Status:       string(p.Status),
+			PaymentCount: p.PaymentCount,
+			GrossTotal:   p.GrossTotal,
+			Note:         p.Note,
+			NotifiedAt:   p.NotifiedAt,
+			CreatedAt:    p.CreatedAt,
+		}
+	}
+	return responses
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
 	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/handler/dtos"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
@@ -18,26 +21,92 @@ import (
 )
 
 type PayrollHandler struct {
-	logger  *zap.Logger
-	service service.PayrollServiceInterface
+	logger            *zap.Logger
+	service           service.PayrollServiceInterface
+	payslipSvc        service.PayslipServiceInterface
+	disbursementSvc   service.DisbursementServiceInterface
+	reconciliationSvc service.ReconciliationServiceInterface
+	payCalendarSvc    service.PayCalendarServiceInterface
 }
 
-func NewPayrollHandler(logger *zap.Logger, service service.PayrollServiceInterface) *PayrollHandler {
+func NewPayrollHandler(
+	logger *zap.Logger,
+	service service.PayrollServiceInterface,
+	payslipSvc service.PayslipServiceInterface,
+	disbursementSvc service.DisbursementServiceInterface,
+	reconciliationSvc service.ReconciliationServiceInterface,
+	payCalendarSvc service.PayCalendarServiceInterface,
+) *PayrollHandler {
 	return &PayrollHandler{
-		logger:  logger,
-		service: service,
+		logger:            logger,
+		service:           service,
+		payslipSvc:        payslipSvc,
+		disbursementSvc:   disbursementSvc,
+		reconciliationSvc: reconciliationSvc,
+		payCalendarSvc:    payCalendarSvc,
 	}
 }
 
+func (h *PayrollHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/payments/me", h.ListMyPayments)
+	r.Get("/payments/me/{paymentId}/payslip", h.DownloadMyPayslip)
+}
+
 func (h *PayrollHandler) RegisterAdminRoutes(r chi.Router) {
-	r.Route("/payments", func(r chi.Router) {
-		r.Get("/", h.ListPayments)
-		r.Post("/generate", h.GeneratePayments)
-		r.Post("/{paymentId}/process", h.ProcessPayment)
-		r.Post("/{paymentId}/revert", h.RevertPayment)
-		r.Post("/bulk-process", h.BulkProcessPayments)
-		r.Get("/export", h.ExportPayments)
-	})
+	// Individual routes (not r.Route) to avoid chi mount conflict with the
+	// student routes above.
+	r.Get("/payments", h.ListPayments)
+	r.Post("/payments/generate", h.GeneratePayments)
+	r.Post("/payments/{paymentId}/process", h.ProcessPayment)
+	r.Post("/payments/{paymentId}/revert", h.RevertPayment)
+	r.Post("/payments/bulk-process", h.BulkProcessPayments)
+	r.Get("/payments/adjustments/pending", h.ListPendingAdjustments)
+	r.Get("/payments/{paymentId}/adjustments", h.ListAdjustments)
+	r.Post("/payments/{paymentId}/adjustments", h.AddAdjustment)
+	r.Delete("/payments/{paymentId}/adjustments/{adjustmentId}", h.RemoveAdjustment)
+	r.Get("/payments/export", h.ExportPayments)
+	r.Get("/payments/reconciliation", h.GetReconciliation)
+	r.Get("/payments/calendar", h.GetPayCalendar)
+	r.Put("/payments/calendar", h.SetPayCalendar)
+	r.Delete("/payments/calendar", h.DisablePayCalendar)
+	r.Get("/payments/periods", h.ListPayPeriods)
+	r.Get("/payments/disbursements", h.ListDisbursementFiles)
+	r.Post("/payments/disbursements", h.GenerateDisbursementFiles)
+	r.Get("/payments/disbursements/formats", h.ListBankFormats)
+	r.Get("/payments/disbursements/{fileId}/download", h.DownloadDisbursementFile)
+}
+
+func (h *PayrollHandler) ListMyPayments(w http.ResponseWriter, r *http.Request) {
+	payments, err := h.service.ListMyPayments(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PaymentsToResponse(payments))
+}
+
+func (h *PayrollHandler) DownloadMyPayslip(w http.ResponseWriter, r *http.Request) {
+	paymentID, err := uuid.Parse(chi.URLParam(r, "paymentId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	payslip, err := h.payslipSvc.GetMyPayslip(r.Context(), paymentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	body := service.RenderPayslipPDF(payslip)
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, payslip.Filename()))
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		h.logger.Error("failed to write payslip", zap.Error(err))
+	}
 }
 
 func (h *PayrollHandler) ListPayments(w http.ResponseWriter, r *http.Request) {
@@ -161,6 +230,79 @@ func (h *PayrollHandler) BulkProcessPayments(w http.ResponseWriter, r *http.Requ
 	writeJSON(w, http.StatusOK, dtos.PaymentsToResponse(payments))
 }
 
+func (h *PayrollHandler) ListAdjustments(w http.ResponseWriter, r *http.Request) {
+	paymentID, err := uuid.Parse(chi.URLParam(r, "paymentId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	adjustments, err := h.service.ListAdjustments(r.Context(), paymentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.AdjustmentsToResponse(adjustments))
+}
+
+func (h *PayrollHandler) AddAdjustment(w http.ResponseWriter, r *http.Request) {
+	paymentID, err := uuid.Parse(chi.URLParam(r, "paymentId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	var req dtos.AddAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := h.service.AddAdjustment(r.Context(), paymentID, service.AdjustmentInput{
+		Type:   aggregate.AdjustmentType(req.Type),
+		Amount: req.Amount,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.AdjustmentResultToResponse(result))
+}
+
+func (h *PayrollHandler) RemoveAdjustment(w http.ResponseWriter, r *http.Request) {
+	paymentID, err := uuid.Parse(chi.URLParam(r, "paymentId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid payment ID")
+		return
+	}
+
+	adjustmentID, err := uuid.Parse(chi.URLParam(r, "adjustmentId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid adjustment ID")
+		return
+	}
+
+	if _, err := h.service.RemoveAdjustment(r.Context(), paymentID, adjustmentID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *PayrollHandler) ListPendingAdjustments(w http.ResponseWriter, r *http.Request) {
+	adjustments, err := h.service.ListPendingAdjustments(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.AdjustmentsToResponse(adjustments))
+}
+
 func (h *PayrollHandler) ExportPayments(w http.ResponseWriter, r *http.Request) {
 	periodStart := r.URL.Query().Get("period_start")
 	periodEnd := r.URL.Query().Get("period_end")
@@ -205,7 +347,10 @@ func (h *PayrollHandler) ExportPayments(w http.ResponseWriter, r *http.Request)
 		"Period End",
 		"Hours Worked",
 		"Rate",
+		"Adjustments",
 		"Gross Amount",
+		"Deductions",
+		"Net Amount",
 		"Status",
 		"Processed At",
 		"Bank Name",
@@ -255,7 +400,10 @@ func (h *PayrollHandler) ExportPayments(w http.ResponseWriter, r *http.Request)
 			row.Payment.PeriodEnd.Format("2006-01-02"),
 			fmt.Sprintf("%.2f", row.Payment.HoursWorked),
 			fmt.Sprintf("%.2f", service.HourlyRate),
+			formatAdjustments(row.Adjustments),
 			fmt.Sprintf("%.2f", row.Payment.GrossAmount),
+			fmt.Sprintf("%.2f", row.Payment.DeductionsAmount),
+			fmt.Sprintf("%.2f", row.Payment.NetAmount),
 			status,
 			processedAt,
 			bankName,
@@ -270,6 +418,213 @@ func (h *PayrollHandler) ExportPayments(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func (h *PayrollHandler) GenerateDisbursementFiles(w http.ResponseWriter, r *http.Request) {
+	var req dtos.GenerateDisbursementsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", req.PeriodStart)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_start format (expected YYYY-MM-DD)")
+		return
+	}
+
+	end, err := time.Parse("2006-01-02", req.PeriodEnd)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_end format (expected YYYY-MM-DD)")
+		return
+	}
+
+	run, err := h.disbursementSvc.GenerateDisbursementFiles(r.Context(), start, end)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.DisbursementRunToResponse(run))
+}
+
+func (h *PayrollHandler) ListDisbursementFiles(w http.ResponseWriter, r *http.Request) {
+	periodStart := r.URL.Query().Get("period_start")
+	periodEnd := r.URL.Query().Get("period_end")
+
+	if periodStart == "" || periodEnd == "" {
+		writeError(w, http.StatusBadRequest, "period_start and period_end are required")
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", periodStart)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_start format (expected YYYY-MM-DD)")
+		return
+	}
+
+	end, err := time.Parse("2006-01-02", periodEnd)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_end format (expected YYYY-MM-DD)")
+		return
+	}
+
+	files, err := h.disbursementSvc.ListDisbursementFiles(r.Context(), start, end)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.DisbursementFilesToResponse(files))
+}
+
+// GetReconciliation compares scheduled and paid hours per student for a
+// period. Optional: student_id, tolerance_minutes (default 0) and
+// discrepancies_only=true.
+func (h *PayrollHandler) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	periodStart := q.Get("period_start")
+	periodEnd := q.Get("period_end")
+
+	if periodStart == "" || periodEnd == "" {
+		writeError(w, http.StatusBadRequest, "period_start and period_end are required")
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", periodStart)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_start format (expected YYYY-MM-DD)")
+		return
+	}
+
+	end, err := time.Parse("2006-01-02", periodEnd)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid period_end format (expected YYYY-MM-DD)")
+		return
+	}
+
+	query := aggregate.ReconciliationQuery{PeriodStart: start, PeriodEnd: end}
+	if v := q.Get("student_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid student_id")
+			return
+		}
+		studentID := int32(id)
+		query.StudentID = &studentID
+	}
+	if v := q.Get("tolerance_minutes"); v != "" {
+		if query.ToleranceMinutes, err = strconv.Atoi(v); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid tolerance_minutes")
+			return
+		}
+	}
+	if v := q.Get("discrepancies_only"); v != "" {
+		if query.DiscrepanciesOnly, err = strconv.ParseBool(v); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid discrepancies_only")
+			return
+		}
+	}
+
+	rows, err := h.reconciliationSvc.GetReconciliation(r.Context(), query)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ReconciliationsToResponse(rows))
+}
+
+func (h *PayrollHandler) ListBankFormats(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, dtos.BankFormatsToResponse(h.disbursementSvc.ListBankFormats()))
+}
+
+func (h *PayrollHandler) DownloadDisbursementFile(w http.ResponseWriter, r *http.Request) {
+	fileID, err := uuid.Parse(chi.URLParam(r, "fileId"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid disbursement file ID")
+		return
+	}
+
+	file, err := h.disbursementSvc.GetDisbursementFile(r.Context(), fileID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	contentType := "text/plain; charset=utf-8"
+	if strings.HasSuffix(file.Filename, ".csv") {
+		contentType = "text/csv; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.Filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(file.Content)))
+	w.Header().Set("X-Checksum-SHA256", file.Checksum)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(file.Content); err != nil {
+		h.logger.Error("failed to write disbursement file", zap.Error(err))
+	}
+}
+
+// formatAdjustments lists a payment's adjustments in one CSV cell, e.g.
+// "bonus +50.00 (Training stipend); deduction -10.00 (Overpaid hours)".
+func formatAdjustments(adjustments []*aggregate.PaymentAdjustment) string {
+	parts := make([]string, len(adjustments))
+	for i, a := range adjustments {
+		parts[i] = fmt.Sprintf("%s %+.2f (%s)", a.Type, a.SignedAmount(), a.Reason)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (h *PayrollHandler) GetPayCalendar(w http.ResponseWriter, r *http.Request) {
+	overview, err := h.payCalendarSvc.GetCalendar(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayCalendarToResponse(overview))
+}
+
+func (h *PayrollHandler) SetPayCalendar(w http.ResponseWriter, r *http.Request) {
+	var req dtos.SetPayCalendarRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	anchor, err := time.Parse("2006-01-02", req.AnchorDate)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid anchor_date format (expected YYYY-MM-DD)")
+		return
+	}
+
+	overview, err := h.payCalendarSvc.SetCalendar(r.Context(), aggregate.PayFrequency(req.Frequency), anchor)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayCalendarToResponse(overview))
+}
+
+func (h *PayrollHandler) DisablePayCalendar(w http.ResponseWriter, r *http.Request) {
+	if err := h.payCalendarSvc.DisableCalendar(r.Context()); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *PayrollHandler) ListPayPeriods(w http.ResponseWriter, r *http.Request) {
+	periods, err := h.payCalendarSvc.ListPayPeriods(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayPeriodsToResponse(periods))
+}
+
 func (h *PayrollHandler) handleServiceError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, payrollErrors.ErrPaymentNotFound):
@@ -280,10 +635,34 @@ func (h *PayrollHandler) handleServiceError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusConflict, "payment has already been processed")
 	case errors.Is(err, payrollErrors.ErrNotProcessed):
 		writeError(w, http.StatusConflict, "payment has not been processed")
+	case errors.Is(err, payrollErrors.ErrAdjustmentNotFound):
+		writeError(w, http.StatusNotFound, "payment adjustment not found")
+	case errors.Is(err, payrollErrors.ErrInvalidAdjustmentType),
+		errors.Is(err, payrollErrors.ErrInvalidAdjustmentAmount),
+		errors.Is(err, payrollErrors.ErrAdjustmentReasonRequired):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, payrollErrors.ErrNegativeNetAmount):
+		writeError(w, http.StatusConflict, "deductions would exceed the payment's gross amount")
+	case errors.Is(err, payrollErrors.ErrDisbursementFileNotFound):
+		writeError(w, http.StatusNotFound, "disbursement file not found")
+	case errors.Is(err, payrollErrors.ErrChecksumMismatch):
+		writeError(w, http.StatusInternalServerError, "disbursement file does not match its recorded checksum")
 	case errors.Is(err, payrollErrors.ErrInvalidPeriod):
 		writeError(w, http.StatusBadRequest, "invalid payment period")
+	case errors.Is(err, payrollErrors.ErrPeriodOverlap):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, payrollErrors.ErrPayCalendarNotFound):
+		writeError(w, http.StatusNotFound, "no active pay calendar")
+	case errors.Is(err, payrollErrors.ErrInvalidPayFrequency),
+		errors.Is(err, payrollErrors.ErrInvalidPayAnchorDate):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, payrollErrors.ErrPeriodTooLong),
+		errors.Is(err, payrollErrors.ErrInvalidTolerance):
+		writeError(w, http.StatusBadRequest, err.Error())
 	case errors.Is(err, payrollErrors.ErrMissingAuthContext):
 		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, payrollErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
 	default:
 		h.logger.Error("unhandled service error", zap.Error(err))
 		writeError(w, http.StatusInternalServerError, "internal server error")
@@ -14,13 +14,75 @@ type PaymentFilter struct {
 	PeriodEnd     *time.Time
 	StudentID     *int32
 	ProcessedOnly bool
+	// UnprocessedOnly limits the list to payments not yet marked as paid.
+	UnprocessedOnly bool
+	// UndisbursedOnly limits the list to payments not yet in a disbursement
+	// file.
+	UndisbursedOnly bool
 }
 
 type PaymentRepositoryInterface interface {
 	Upsert(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error)
 	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Payment, error)
+	// ListByStudentID returns a student's payments, newest period first.
+	ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.Payment, error)
 	ListByPeriod(ctx context.Context, tx *sql.Tx, filter PaymentFilter) ([]*aggregate.Payment, error)
 	Update(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error)
 	CalculateHoursForPeriod(ctx context.Context, tx *sql.Tx, studentID int32, periodStart, periodEnd time.Time) (float64, error)
 	CalculateHoursBatch(ctx context.Context, tx *sql.Tx, studentIDs []int32, periodStart, periodEnd time.Time) (map[int32]float64, error)
+	// TotalGrossAmount sums the gross amount of payments whose period ends
+	// between from and to inclusive, processed or not.
+	TotalGrossAmount(ctx context.Context, tx *sql.Tx, from, to time.Time) (float64, error)
+	// ListOverlappingPeriods returns the distinct periods of payments that
+	// share a day with [periodStart, periodEnd] but differ from it.
+	ListOverlappingPeriods(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]aggregate.PeriodRange, error)
+}
+
+type PaymentAdjustmentRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, adjustment *aggregate.PaymentAdjustment) (*aggregate.PaymentAdjustment, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PaymentAdjustment, error)
+	// ListByPaymentID returns the adjustments applied to a payment and those
+	// carried forward from it, oldest first.
+	ListByPaymentID(ctx context.Context, tx *sql.Tx, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	// ListAppliedByPaymentIDs returns the adjustments applied to any of the
+	// given payments, oldest first.
+	ListAppliedByPaymentIDs(ctx context.Context, tx *sql.Tx, paymentIDs []uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	// ListPending returns carried-forward adjustments not yet applied to a
+	// payment, optionally limited to some students, oldest first.
+	ListPending(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.PaymentAdjustment, error)
+	// SetPayment records the payment an adjustment is applied to; nil returns
+	// a carried-forward adjustment to pending.
+	SetPayment(ctx context.Context, tx *sql.Tx, id uuid.UUID, paymentID *uuid.UUID) error
+	Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
+
+type DisbursementFileRepositoryInterface interface {
+	// Create records the file and the payments it pays out.
+	Create(ctx context.Context, tx *sql.Tx, file *aggregate.DisbursementFile) (*aggregate.DisbursementFile, error)
+	// GetByID returns the file with its decrypted content.
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.DisbursementFile, error)
+	// ListByPeriod returns the files generated for a period, newest first,
+	// without their content.
+	ListByPeriod(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error)
+}
+
+type PayCalendarRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, calendar *aggregate.PayCalendar) (*aggregate.PayCalendar, error)
+	// GetActive returns the active calendar or ErrPayCalendarNotFound.
+	GetActive(ctx context.Context, tx *sql.Tx) (*aggregate.PayCalendar, error)
+	// DeactivateAll marks every calendar inactive.
+	DeactivateAll(ctx context.Context, tx *sql.Tx) error
+}
+
+type PayPeriodRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, period *aggregate.PayPeriod) (*aggregate.PayPeriod, error)
+	// GetLatest returns the period ending last, generated or blocked, or
+	// ErrPayPeriodNotFound.
+	GetLatest(ctx context.Context, tx *sql.Tx) (*aggregate.PayPeriod, error)
+	// List returns the most recent periods, newest first.
+	List(ctx context.Context, tx *sql.Tx, limit int) ([]*aggregate.PayPeriod, error)
+	// ListUnnotified returns periods whose summary email has not been sent,
+	// oldest first.
+	ListUnnotified(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayPeriod, error)
+	MarkNotified(ctx context.Context, tx *sql.Tx, id uuid.UUID, at time.Time) error
 }
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/bankfile"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Reasons a payment is left out of a disbursement run.
+const (
+	SkipReason_ZeroAmount       = "zero_amount"
+	SkipReason_StudentNotFound  = "student_not_found"
+	SkipReason_NoBankingDetails = "no_banking_details"
+)
+
+// SkippedPayment is an unprocessed payment that could not go into a bank file.
+type SkippedPayment struct {
+	Payment *aggregate.Payment
+	Reason  string
+}
+
+// DisbursementRun is the result of generating bank files for a period: one
+// file per bank, plus the payments that were left out.
+type DisbursementRun struct {
+	Files   []*aggregate.DisbursementFile
+	Skipped []SkippedPayment
+}
+
+// BankFormats describes the registered bank file layouts.
+type BankFormats struct {
+	Formats  []string
+	Banks    map[string]string // normalised bank name -> format
+	Fallback string
+}
+
+type DisbursementServiceInterface interface {
+	// GenerateDisbursementFiles writes one bank file per bank for the period's
+	// unprocessed payments that are not already in a file, and records each
+	// file with its checksum and payments.
+	GenerateDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) (*DisbursementRun, error)
+	ListDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error)
+	// GetDisbursementFile returns a recorded file with its content, after
+	// checking the content still matches the recorded checksum.
+	GetDisbursementFile(ctx context.Context, id uuid.UUID) (*aggregate.DisbursementFile, error)
+	ListBankFormats() BankFormats
+}
+
+var _ DisbursementServiceInterface = (*DisbursementService)(nil)
+
+type DisbursementService struct {
+	logger             *zap.Logger
+	txManager          database.TxManagerInterface
+	paymentRepo        repository.PaymentRepositoryInterface
+	fileRepo           repository.DisbursementFileRepositoryInterface
+	studentRepo        studentRepo.StudentRepositoryInterface
+	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface
+	registry           *bankfile.Registry
+}
+
+func NewDisbursementService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	paymentRepo repository.PaymentRepositoryInterface,
+	fileRepo repository.DisbursementFileRepositoryInterface,
+	studentRepo studentRepo.StudentRepositoryInterface,
+	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface,
+	registry *bankfile.Registry,
+) *DisbursementService {
+	return &DisbursementService{
+		logger:             logger,
+		txManager:          txManager,
+		paymentRepo:        paymentRepo,
+		fileRepo:           fileRepo,
+		studentRepo:        studentRepo,
+		bankingDetailsRepo: bankingDetailsRepo,
+		registry:           registry,
+	}
+}
+
+// GenerateDisbursementFiles uses InSystemTx because only the internal role
+// can read decrypted banking details and write auth.disbursement_files and
+// auth.disbursement_file_payments. Only unprocessed payments are paid out, at
+// their net amount; their hours already come from approved timesheets only.
+// Payments already in an earlier file are left out, so running it again for
+// the same period only picks up payments that were skipped or added since.
+func (s *DisbursementService) GenerateDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) (*DisbursementRun, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+	if !periodEnd.After(periodStart) {
+		return nil, payrollErrors.ErrInvalidPeriod
+	}
+
+	var generatedBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		generatedBy = &id
+	}
+
+	run := &DisbursementRun{
+		Files:   []*aggregate.DisbursementFile{},
+		Skipped: []SkippedPayment{},
+	}
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		payments, txErr := s.paymentRepo.ListByPeriod(ctx, tx, repository.PaymentFilter{
+			PeriodStart:     &periodStart,
+			PeriodEnd:       &periodEnd,
+			UnprocessedOnly: true,
+			UndisbursedOnly: true,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		payable := make([]*aggregate.Payment, 0, len(payments))
+		studentIDs := make([]int32, 0, len(payments))
+		for _, p := range payments {
+			if amountCents(p.NetAmount) <= 0 {
+				run.Skipped = append(run.Skipped, SkippedPayment{Payment: p, Reason: SkipReason_ZeroAmount})
+				continue
+			}
+			payable = append(payable, p)
+			studentIDs = append(studentIDs, p.StudentID)
+		}
+		if len(payable) == 0 {
+			return nil
+		}
+
+		students, txErr := s.studentRepo.ListByIDs(ctx, tx, studentIDs)
+		if txErr != nil {
+			return txErr
+		}
+		studentMap := make(map[int32]*studentAggregate.Student, len(students))
+		for _, st := range students {
+			studentMap[st.StudentID] = st
+		}
+
+		bankingList, txErr := s.bankingDetailsRepo.ListByStudentIDs(ctx, tx, studentIDs)
+		if txErr != nil {
+			return txErr
+		}
+		bankingMap := make(map[int32]*studentAggregate.BankingDetails, len(bankingList))
+		for _, bd := range bankingList {
+			bankingMap[bd.StudentID] = bd
+		}
+
+		// Group by bank, treating differently typed spellings of the same
+		// bank name as one bank.
+		batches := make(map[string]*bankfile.Batch)
+		batchPayments := make(map[string][]uuid.UUID)
+		for _, p := range payable {
+			student := studentMap[p.StudentID]
+			if student == nil {
+				run.Skipped = append(run.Skipped, SkippedPayment{Payment: p, Reason: SkipReason_StudentNotFound})
+				continue
+			}
+			banking := bankingMap[p.StudentID]
+			if banking == nil {
+				run.Skipped = append(run.Skipped, SkippedPayment{Payment: p, Reason: SkipReason_NoBankingDetails})
+				continue
+			}
+
+			key := bankfile.NormaliseBankName(banking.BankName)
+			batch, ok := batches[key]
+			if !ok {
+				batch = &bankfile.Batch{
+					BankName:    strings.TrimSpace(banking.BankName),
+					PeriodStart: periodStart,
+					PeriodEnd:   periodEnd,
+				}
+				batches[key] = batch
+			}
+			batch.Entries = append(batch.Entries, bankfile.Entry{
+				Reference:     p.PaymentID.String(),
+				StudentID:     p.StudentID,
+				PayeeName:     student.FirstName + " " + student.LastName,
+				BranchName:    banking.BranchName,
+				AccountType:   string(banking.AccountType),
+				AccountNumber: banking.AccountNumber,
+				AmountCents:   amountCents(p.NetAmount),
+			})
+			batchPayments[key] = append(batchPayments[key], p.PaymentID)
+		}
+
+		keys := make([]string, 0, len(batches))
+		for key := range batches {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			batch := batches[key]
+			format := s.registry.ForBank(batch.BankName)
+			content, txErr := format.Write(*batch)
+			if txErr != nil {
+				s.logger.Error("failed to write bank file",
+					zap.String("bank", batch.BankName),
+					zap.String("format", format.Name()),
+					zap.Error(txErr),
+				)
+				return txErr
+			}
+
+			file := aggregate.NewDisbursementFile(
+				batch.BankName, format.Name(), format.Extension(),
+				periodStart, periodEnd,
+				batch.RecordCount(), batch.ControlTotalCents(),
+				content, generatedBy, batchPayments[key],
+			)
+			created, txErr := s.fileRepo.Create(ctx, tx, file)
+			if txErr != nil {
+				return txErr
+			}
+			run.Files = append(run.Files, created)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// ListDisbursementFiles uses InSystemTx because only the internal role can
+// read auth.disbursement_files.
+func (s *DisbursementService) ListDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error) {
+	if _, ok := database.GetAuthContextFromContext(ctx); !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+
+	var files []*aggregate.DisbursementFile
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		files, txErr = s.fileRepo.ListByPeriod(ctx, tx, periodStart, periodEnd)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// GetDisbursementFile uses InSystemTx because only the internal role can read
+// auth.disbursement_files.
+func (s *DisbursementService) GetDisbursementFile(ctx context.Context, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+	if _, ok := database.GetAuthContextFromContext(ctx); !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+
+	var file *aggregate.DisbursementFile
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		file, txErr = s.fileRepo.GetByID(ctx, tx, id)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !file.VerifyChecksum() {
+		s.logger.Error("disbursement file checksum mismatch", zap.String("id", id.String()))
+		return nil, payrollErrors.ErrChecksumMismatch
+	}
+	return file, nil
+}
+
+func (s *DisbursementService) ListBankFormats() BankFormats {
+	return BankFormats{
+		Formats:  s.registry.Formats(),
+		Banks:    s.registry.Banks(),
+		Fallback: s.registry.FallbackFormat(),
+	}
+}
+
+// amountCents converts a dollar amount to whole cents.
+func amountCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
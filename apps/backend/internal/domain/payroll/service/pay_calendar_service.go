@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	userRepo "github.com/HDR3604/HelpDeskApp/internal/domain/user/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxPeriodsPerRun bounds how many closed periods one job run catches up on.
+	maxPeriodsPerRun = 12
+	// upcomingPeriodCount is how many periods a calendar preview lists.
+	upcomingPeriodCount = 3
+	// maxListedPayPeriods bounds the period history returned to admins.
+	maxListedPayPeriods = 100
+)
+
+// PeriodPaymentGenerator generates a period's draft payments inside an
+// existing system transaction. PayrollService implements it.
+type PeriodPaymentGenerator interface {
+	GeneratePaymentsInTx(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
+}
+
+// PayCalendarOverview is the active calendar with the period in progress
+// and the ones after it.
+type PayCalendarOverview struct {
+	Calendar *aggregate.PayCalendar
+	Upcoming []aggregate.PeriodRange
+}
+
+type PayCalendarServiceInterface interface {
+	// GetCalendar returns the active calendar or ErrPayCalendarNotFound.
+	GetCalendar(ctx context.Context) (*PayCalendarOverview, error)
+	// SetCalendar replaces the active calendar. Periods already generated
+	// are kept; the job continues from the day after the last one.
+	SetCalendar(ctx context.Context, frequency aggregate.PayFrequency, anchorDate time.Time) (*PayCalendarOverview, error)
+	// DisableCalendar stops automatic payment generation.
+	DisableCalendar(ctx context.Context) error
+	// ListPayPeriods returns the periods the job has run for, newest first.
+	ListPayPeriods(ctx context.Context) ([]*aggregate.PayPeriod, error)
+	// GenerateDuePeriods generates draft payments for every period of the
+	// active calendar that has closed since the last run, then emails admins
+	// a summary of each period not yet reported. It is run by the pay
+	// calendar job and needs no auth context.
+	GenerateDuePeriods(ctx context.Context) ([]*aggregate.PayPeriod, error)
+}
+
+var _ PayCalendarServiceInterface = (*PayCalendarService)(nil)
+
+type PayCalendarService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	calendarRepo repository.PayCalendarRepositoryInterface
+	periodRepo   repository.PayPeriodRepositoryInterface
+	paymentRepo  repository.PaymentRepositoryInterface
+	userRepo     userRepo.UserRepositoryInterface
+	generator    PeriodPaymentGenerator
+	emailSender  emailInterfaces.EmailSenderInterface
+	fromEmail    string
+	frontendURL  string
+	nowFn        func() time.Time
+}
+
+func NewPayCalendarService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	calendarRepo repository.PayCalendarRepositoryInterface,
+	periodRepo repository.PayPeriodRepositoryInterface,
+	paymentRepo repository.PaymentRepositoryInterface,
+	userRepo userRepo.UserRepositoryInterface,
+	generator PeriodPaymentGenerator,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+	frontendURL string,
+) *PayCalendarService {
+	return &PayCalendarService{
+		logger:       logger,
+		txManager:    txManager,
+		calendarRepo: calendarRepo,
+		periodRepo:   periodRepo,
+		paymentRepo:  paymentRepo,
+		userRepo:     userRepo,
+		generator:    generator,
+		emailSender:  emailSender,
+		fromEmail:    fromEmail,
+		frontendURL:  frontendURL,
+		nowFn:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *PayCalendarService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+// GetCalendar uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *PayCalendarService) GetCalendar(ctx context.Context) (*PayCalendarOverview, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+
+	var calendar *aggregate.PayCalendar
+
+	err := s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		calendar, txErr = s.calendarRepo.GetActive(ctx, tx)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return s.overview(calendar), nil
+}
+
+// SetCalendar uses InSystemTx because writes to auth.pay_calendars are only
+// granted to the internal role.
+func (s *PayCalendarService) SetCalendar(ctx context.Context, frequency aggregate.PayFrequency, anchorDate time.Time) (*PayCalendarOverview, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		createdBy = &id
+	}
+
+	calendar, err := aggregate.NewPayCalendar(frequency, anchorDate, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if txErr := s.calendarRepo.DeactivateAll(ctx, tx); txErr != nil {
+			return txErr
+		}
+		var txErr error
+		calendar, txErr = s.calendarRepo.Create(ctx, tx, calendar)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return s.overview(calendar), nil
+}
+
+// DisableCalendar uses InSystemTx because writes to auth.pay_calendars are
+// only granted to the internal role.
+func (s *PayCalendarService) DisableCalendar(ctx context.Context) error {
+	if _, ok := database.GetAuthContextFromContext(ctx); !ok {
+		return payrollErrors.ErrMissingAuthContext
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, err := s.calendarRepo.GetActive(ctx, tx); err != nil {
+			return err
+		}
+		return s.calendarRepo.DeactivateAll(ctx, tx)
+	})
+}
+
+// ListPayPeriods uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *PayCalendarService) ListPayPeriods(ctx context.Context) ([]*aggregate.PayPeriod, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+
+	var periods []*aggregate.PayPeriod
+
+	err := s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		periods, txErr = s.periodRepo.List(ctx, tx, maxListedPayPeriods)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+// GenerateDuePeriods records every period it runs for, so each is generated
+// once. A period that overlaps payments already generated for a different
+// range, e.g. by hand, is recorded as blocked instead.
+func (s *PayCalendarService) GenerateDuePeriods(ctx context.Context) ([]*aggregate.PayPeriod, error) {
+	var created []*aggregate.PayPeriod
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		calendar, err := s.calendarRepo.GetActive(ctx, tx)
+		if err != nil {
+			if errors.Is(err, payrollErrors.ErrPayCalendarNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		var lastEnd *time.Time
+		latest, err := s.periodRepo.GetLatest(ctx, tx)
+		switch {
+		case err == nil:
+			lastEnd = &latest.PeriodEnd
+		case !errors.Is(err, payrollErrors.ErrPayPeriodNotFound):
+			return err
+		}
+
+		for _, period := range calendar.ClosedPeriods(lastEnd, s.nowFn(), maxPeriodsPerRun) {
+			overlapping, err := s.paymentRepo.ListOverlappingPeriods(ctx, tx, period.Start, period.End)
+			if err != nil {
+				return err
+			}
+
+			var record *aggregate.PayPeriod
+			if len(overlapping) > 0 {
+				record = aggregate.NewBlockedPayPeriod(calendar.ID, period, overlapping)
+			} else {
+				payments, err := s.generator.GeneratePaymentsInTx(ctx, tx, period.Start, period.End)
+				if err != nil {
+					return err
+				}
+				record = aggregate.NewGeneratedPayPeriod(calendar.ID, period, payments)
+			}
+
+			saved, err := s.periodRepo.Create(ctx, tx, record)
+			if err != nil {
+				return err
+			}
+			created = append(created, saved)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyAdmins(ctx); err != nil {
+		return created, err
+	}
+	return created, nil
+}
+
+// notifyAdmins emails active admins a summary of each period not yet
+// reported and marks it notified. A failed send is returned so the job is
+// retried; periods already reported are not sent again.
+func (s *PayCalendarService) notifyAdmins(ctx context.Context) error {
+	var (
+		periods    []*aggregate.PayPeriod
+		recipients []string
+	)
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		periods, err = s.periodRepo.ListUnnotified(ctx, tx)
+		if err != nil || len(periods) == 0 {
+			return err
+		}
+
+		admins, err := s.userRepo.ListByRole(ctx, tx, string(userAggregate.Role_Admin))
+		if err != nil {
+			return err
+		}
+		for _, a := range admins {
+			if a.IsActive {
+				recipients = append(recipients, a.Email)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(periods) == 0 {
+		return nil
+	}
+	if len(recipients) == 0 {
+		s.logger.Warn("no active admins to notify of pay periods", zap.Int("periods", len(periods)))
+		return nil
+	}
+
+	for _, p := range periods {
+		if _, err := s.emailSender.Send(ctx, s.summaryEmail(p, recipients)); err != nil {
+			s.logger.Error("failed to send pay period summary", zap.Error(err), zap.String("pay_period_id", p.ID.String()))
+			return fmt.Errorf("failed to send pay period summary: %w", err)
+		}
+
+		err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+			return s.periodRepo.MarkNotified(ctx, tx, p.ID, s.nowFn())
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *PayCalendarService) summaryEmail(p *aggregate.PayPeriod, recipients []string) dtos.SendEmailRequest {
+	label := fmt.Sprintf("%s – %s", p.PeriodStart.Format("2 Jan"), p.PeriodEnd.Format("2 Jan 2006"))
+	reviewURL := fmt.Sprintf("%s/payroll?period_start=%s&period_end=%s",
+		s.frontendURL, p.PeriodStart.Format(time.DateOnly), p.PeriodEnd.Format(time.DateOnly))
+
+	heading := "Pay period ready for review"
+	message := fmt.Sprintf("Draft payments for %d students have been generated. Review and process them in the admin dashboard.", p.PaymentCount)
+	if p.Status == aggregate.PayPeriodStatus_Blocked {
+		heading = "Pay period blocked"
+		message = "No payments were generated because this period " + *p.Note + ". Generate the missing dates by hand or adjust the pay calendar."
+	}
+
+	return dtos.SendEmailRequest{
+		From:    s.fromEmail,
+		To:      recipients,
+		Subject: heading + " - DCIT Help Desk",
+		Template: &types.EmailTemplate{
+			ID: templates.TemplateID_PayPeriodSummary,
+			Variables: map[string]any{
+				"HEADING":       heading,
+				"MESSAGE":       message,
+				"PERIOD_LABEL":  label,
+				"PAYMENT_COUNT": p.PaymentCount,
+				"GROSS_TOTAL":   fmt.Sprintf("$%.2f", p.GrossTotal),
+				"REVIEW_URL":    reviewURL,
+			},
+		},
+	}
+}
+
+func (s *PayCalendarService) overview(calendar *aggregate.PayCalendar) *PayCalendarOverview {
+	o := &PayCalendarOverview{Calendar: calendar, Upcoming: []aggregate.PeriodRange{}}
+
+	current, ok := calendar.PeriodContaining(s.nowFn())
+	if !ok {
+		current, _ = calendar.PeriodContaining(calendar.AnchorDate)
+	}
+	for range upcomingPeriodCount {
+		o.Upcoming = append(o.Upcoming, current)
+		current = calendar.Next(current)
+	}
+	return o
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
@@ -23,41 +25,91 @@ type ExportRow struct {
 	Payment        *aggregate.Payment
 	Student        *studentAggregate.Student
 	BankingDetails *studentAggregate.BankingDetails // nil if not on file
+	Adjustments    []*aggregate.PaymentAdjustment
+}
+
+// AdjustmentInput is an adjustment to add to a payment.
+type AdjustmentInput struct {
+	Type   aggregate.AdjustmentType
+	Amount float64
+	Reason string
+}
+
+// AdjustmentResult is a newly added adjustment and the payment it was applied
+// to. Payment is nil when the adjustment targets a processed payment and is
+// waiting to be carried forward to the student's next payment.
+type AdjustmentResult struct {
+	Adjustment *aggregate.PaymentAdjustment
+	Payment    *aggregate.Payment
+}
+
+// PayslipJobEnqueuer abstracts job enqueue operations so the service
+// does not depend on the jobqueue infrastructure package directly.
+type PayslipJobEnqueuer interface {
+	EnqueuePayslipEmail(ctx context.Context, paymentID uuid.UUID) error
 }
 
 type PayrollServiceInterface interface {
+	// ListMyPayments returns the caller's own payments, newest period first.
+	ListMyPayments(ctx context.Context) ([]*aggregate.Payment, error)
 	ListPayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
 	GeneratePayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
 	ProcessPayment(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error)
 	RevertPayment(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error)
 	BulkProcessPayments(ctx context.Context, paymentIDs []uuid.UUID) ([]*aggregate.Payment, error)
 	ExportPayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*ExportRow, error)
+	// ListAdjustments returns the adjustments applied to a payment and those
+	// carried forward from it.
+	ListAdjustments(ctx context.Context, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	// AddAdjustment applies an adjustment to an unprocessed payment. For a
+	// processed payment it is carried forward to the student's next
+	// unprocessed payment, or left pending until one is generated.
+	AddAdjustment(ctx context.Context, paymentID uuid.UUID, input AdjustmentInput) (*AdjustmentResult, error)
+	// RemoveAdjustment deletes an adjustment that has not been paid out and
+	// returns the recalculated payment, or nil for a pending adjustment.
+	RemoveAdjustment(ctx context.Context, paymentID, adjustmentID uuid.UUID) (*aggregate.Payment, error)
+	ListPendingAdjustments(ctx context.Context) ([]*aggregate.PaymentAdjustment, error)
 }
 
+var _ PayrollServiceInterface = (*PayrollService)(nil)
+
 type PayrollService struct {
 	logger             *zap.Logger
 	txManager          database.TxManagerInterface
 	paymentRepo        repository.PaymentRepositoryInterface
+	adjustmentRepo     repository.PaymentAdjustmentRepositoryInterface
 	studentRepo        studentRepo.StudentRepositoryInterface
 	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface
+	payslipEnqueuer    PayslipJobEnqueuer
 }
 
 func NewPayrollService(
 	logger *zap.Logger,
 	txManager database.TxManagerInterface,
 	paymentRepo repository.PaymentRepositoryInterface,
+	adjustmentRepo repository.PaymentAdjustmentRepositoryInterface,
 	studentRepo studentRepo.StudentRepositoryInterface,
 	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface,
-) PayrollServiceInterface {
+	payslipEnqueuer PayslipJobEnqueuer,
+) *PayrollService {
 	return &PayrollService{
 		logger:             logger,
 		txManager:          txManager,
 		paymentRepo:        paymentRepo,
+		adjustmentRepo:     adjustmentRepo,
 		studentRepo:        studentRepo,
 		bankingDetailsRepo: bankingDetailsRepo,
+		payslipEnqueuer:    payslipEnqueuer,
 	}
 }
 
+// SetPayslipEnqueuer sets the enqueuer after construction. The job queue
+// client needs its workers up front and the pay calendar worker generates
+// payments through this service, so the service is built before the client.
+func (s *PayrollService) SetPayslipEnqueuer(enqueuer PayslipJobEnqueuer) {
+	s.payslipEnqueuer = enqueuer
+}
+
 func (s *PayrollService) authCtx(ctx context.Context) (database.AuthContext, error) {
 	authCtx, ok := database.GetAuthContextFromContext(ctx)
 	if !ok {
@@ -67,6 +119,27 @@ func (s *PayrollService) authCtx(ctx context.Context) (database.AuthContext, err
 	return authCtx, nil
 }
 
+// ListMyPayments uses InAuthTx so RLS limits the SELECT to the caller's rows.
+func (s *PayrollService) ListMyPayments(ctx context.Context) ([]*aggregate.Payment, error) {
+	authCtx, studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []*aggregate.Payment
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		payments, txErr = s.paymentRepo.ListByStudentID(ctx, tx, studentID)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
 // ListPayments uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
 func (s *PayrollService) ListPayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error) {
 	authCtx, err := s.authCtx(ctx)
@@ -105,50 +178,192 @@ func (s *PayrollService) GeneratePayments(ctx context.Context, periodStart, peri
 	var payments []*aggregate.Payment
 
 	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
-		// Get accepted students
-		students, txErr := s.studentRepo.ListByStatus(ctx, tx, "accepted")
+		overlapping, txErr := s.paymentRepo.ListOverlappingPeriods(ctx, tx, periodStart, periodEnd)
 		if txErr != nil {
 			return txErr
 		}
+		if len(overlapping) > 0 {
+			return overlapError(overlapping)
+		}
 
-		if len(students) == 0 {
-			return nil
+		payments, txErr = s.GeneratePaymentsInTx(ctx, tx, periodStart, periodEnd)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// GeneratePaymentsInTx creates or refreshes the draft payment of every
+// accepted student for a period inside the caller's system transaction. It
+// does not check for overlapping periods.
+func (s *PayrollService) GeneratePaymentsInTx(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error) {
+	// Get accepted students
+	students, err := s.studentRepo.ListByStatus(ctx, tx, "accepted")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(students) == 0 {
+		return nil, nil
+	}
+
+	// Batch calculate hours for all students in one query
+	studentIDs := make([]int32, len(students))
+	for i, st := range students {
+		studentIDs[i] = st.StudentID
+	}
+
+	hoursMap, err := s.paymentRepo.CalculateHoursBatch(ctx, tx, studentIDs, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*aggregate.Payment, 0, len(students))
+	for _, student := range students {
+		hours := hoursMap[student.StudentID] // defaults to 0 if not in map
+		grossAmount := hours * HourlyRate
+
+		payment, err := aggregate.NewPayment(student.StudentID, periodStart, periodEnd, hours, grossAmount)
+		if err != nil {
+			return nil, err
 		}
 
-		// Batch calculate hours for all students in one query
-		studentIDs := make([]int32, len(students))
-		for i, st := range students {
-			studentIDs[i] = st.StudentID
+		upserted, err := s.paymentRepo.Upsert(ctx, tx, payment)
+		if err != nil {
+			return nil, err
 		}
+		payments = append(payments, upserted)
+	}
 
-		hoursMap, txErr := s.paymentRepo.CalculateHoursBatch(ctx, tx, studentIDs, periodStart, periodEnd)
-		if txErr != nil {
-			return txErr
+	if err := s.applyAdjustments(ctx, tx, payments, studentIDs); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// overlapError names the periods a requested period overlaps.
+func overlapError(overlapping []aggregate.PeriodRange) error {
+	ranges := make([]string, len(overlapping))
+	for i, p := range overlapping {
+		ranges[i] = p.String()
+	}
+	return fmt.Errorf("%w: %s", payrollErrors.ErrPeriodOverlap, strings.Join(ranges, ", "))
+}
+
+// applyAdjustments recalculates the amounts of freshly generated unprocessed
+// payments from their adjustments, first carrying forward any pending
+// adjustments that can now be applied. A pending deduction that would take a
+// payment below zero stays pending for a later period.
+func (s *PayrollService) applyAdjustments(ctx context.Context, tx *sql.Tx, payments []*aggregate.Payment, studentIDs []int32) error {
+	unprocessed := make([]uuid.UUID, 0, len(payments))
+	for _, p := range payments {
+		if p.ProcessedAt == nil {
+			unprocessed = append(unprocessed, p.PaymentID)
 		}
+	}
+	if len(unprocessed) == 0 {
+		return nil
+	}
 
-		for _, student := range students {
-			hours := hoursMap[student.StudentID] // defaults to 0 if not in map
-			grossAmount := hours * HourlyRate
+	applied, err := s.adjustmentRepo.ListAppliedByPaymentIDs(ctx, tx, unprocessed)
+	if err != nil {
+		return err
+	}
+	byPayment := make(map[uuid.UUID][]*aggregate.PaymentAdjustment, len(applied))
+	for _, a := range applied {
+		byPayment[*a.PaymentID] = append(byPayment[*a.PaymentID], a)
+	}
 
-			payment, txErr := aggregate.NewPayment(student.StudentID, periodStart, periodEnd, hours, grossAmount)
-			if txErr != nil {
-				return txErr
-			}
+	pending, err := s.adjustmentRepo.ListPending(ctx, tx, studentIDs)
+	if err != nil {
+		return err
+	}
+	pendingByStudent := make(map[int32][]*aggregate.PaymentAdjustment, len(pending))
+	for _, a := range pending {
+		pendingByStudent[a.StudentID] = append(pendingByStudent[a.StudentID], a)
+	}
 
-			upserted, txErr := s.paymentRepo.Upsert(ctx, tx, payment)
-			if txErr != nil {
-				return txErr
+	for i, p := range payments {
+		adjustments := byPayment[p.PaymentID]
+		candidates := pendingByStudent[p.StudentID]
+		if p.ProcessedAt != nil || (len(adjustments) == 0 && len(candidates) == 0) {
+			continue
+		}
+
+		base := basePay(p)
+		remaining := candidates[:0:0]
+		for _, a := range candidates {
+			if !a.CanApplyTo(p) {
+				remaining = append(remaining, a)
+				continue
+			}
+			if err := p.ApplyAdjustments(base, append(adjustments, a)); err != nil {
+				s.logger.Warn("pending adjustment left for a later period",
+					zap.String("adjustment_id", a.ID.String()),
+					zap.String("payment_id", p.PaymentID.String()),
+					zap.Error(err),
+				)
+				remaining = append(remaining, a)
+				continue
 			}
-			payments = append(payments, upserted)
+			a.ApplyTo(p)
+			if err := s.adjustmentRepo.SetPayment(ctx, tx, a.ID, a.PaymentID); err != nil {
+				return err
+			}
+			adjustments = append(adjustments, a)
 		}
+		pendingByStudent[p.StudentID] = remaining
 
-		return nil
-	})
+		adjustments, err = s.fitAdjustments(ctx, tx, p, base, adjustments)
+		if err != nil {
+			return err
+		}
+		updated, err := s.paymentRepo.Update(ctx, tx, p)
+		if err != nil {
+			return err
+		}
+		payments[i] = updated
+	}
+	return nil
+}
 
-	if err != nil {
-		return nil, err
+// fitAdjustments applies adjustments to p. If fewer hours were worked than
+// when the adjustments were applied and deductions now exceed gross pay, the
+// most recent carried-forward adjustments go back to pending until they fit.
+// It returns the adjustments left applied.
+func (s *PayrollService) fitAdjustments(ctx context.Context, tx *sql.Tx, p *aggregate.Payment, base float64, adjustments []*aggregate.PaymentAdjustment) ([]*aggregate.PaymentAdjustment, error) {
+	for {
+		err := p.ApplyAdjustments(base, adjustments)
+		if !errors.Is(err, payrollErrors.ErrNegativeNetAmount) {
+			return adjustments, err
+		}
+
+		last := -1
+		for i := len(adjustments) - 1; i >= 0; i-- {
+			if adjustments[i].OriginalPaymentID != nil {
+				last = i
+				break
+			}
+		}
+		if last < 0 {
+			return nil, fmt.Errorf("payment %s for student %d: %w", p.PaymentID, p.StudentID, err)
+		}
+
+		a := adjustments[last]
+		a.PaymentID = nil
+		if err := s.adjustmentRepo.SetPayment(ctx, tx, a.ID, nil); err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments[:last:last], adjustments[last+1:]...)
 	}
-	return payments, nil
+}
+
+// basePay is a payment's pay before adjustments.
+func basePay(p *aggregate.Payment) float64 {
+	return p.HoursWorked * HourlyRate
 }
 
 // ProcessPayment uses InSystemTx because UPDATE on auth.payments
@@ -181,6 +396,8 @@ func (s *PayrollService) ProcessPayment(ctx context.Context, paymentID uuid.UUID
 	if err != nil {
 		return nil, err
 	}
+
+	s.enqueuePayslips(ctx, result.PaymentID)
 	return result, nil
 }
 
@@ -225,6 +442,7 @@ func (s *PayrollService) BulkProcessPayments(ctx context.Context, paymentIDs []u
 	}
 
 	var results []*aggregate.Payment
+	var processed []uuid.UUID
 
 	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
 		for _, id := range paymentIDs {
@@ -246,6 +464,7 @@ func (s *PayrollService) BulkProcessPayments(ctx context.Context, paymentIDs []u
 				return txErr
 			}
 			results = append(results, updated)
+			processed = append(processed, updated.PaymentID)
 		}
 		return nil
 	})
@@ -253,9 +472,24 @@ func (s *PayrollService) BulkProcessPayments(ctx context.Context, paymentIDs []u
 	if err != nil {
 		return nil, err
 	}
+
+	s.enqueuePayslips(ctx, processed...)
 	return results, nil
 }
 
+// enqueuePayslips queues the payslip email for newly processed payments. The
+// payments are already committed, so a failure is logged rather than returned.
+func (s *PayrollService) enqueuePayslips(ctx context.Context, paymentIDs ...uuid.UUID) {
+	for _, id := range paymentIDs {
+		if err := s.payslipEnqueuer.EnqueuePayslipEmail(ctx, id); err != nil {
+			s.logger.Error("failed to enqueue payslip email",
+				zap.String("payment_id", id.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 // ExportPayments fetches payments for a period and enriches each with student
 // profile and decrypted banking details. Uses InSystemTx to read across
 // auth.payments, auth.students, and auth.banking_details.
@@ -311,6 +545,20 @@ func (s *PayrollService) ExportPayments(ctx context.Context, periodStart, period
 			bankingMap[bd.StudentID] = bd
 		}
 
+		// Batch fetch adjustments
+		paymentIDs := make([]uuid.UUID, len(payments))
+		for i, p := range payments {
+			paymentIDs[i] = p.PaymentID
+		}
+		adjustments, txErr := s.adjustmentRepo.ListAppliedByPaymentIDs(ctx, tx, paymentIDs)
+		if txErr != nil {
+			return txErr
+		}
+		adjustmentMap := make(map[uuid.UUID][]*aggregate.PaymentAdjustment, len(adjustments))
+		for _, a := range adjustments {
+			adjustmentMap[*a.PaymentID] = append(adjustmentMap[*a.PaymentID], a)
+		}
+
 		// Assemble rows
 		rows = make([]*ExportRow, len(payments))
 		for i, payment := range payments {
@@ -318,6 +566,7 @@ func (s *PayrollService) ExportPayments(ctx context.Context, periodStart, period
 				Payment:        payment,
 				Student:        studentMap[payment.StudentID],
 				BankingDetails: bankingMap[payment.StudentID],
+				Adjustments:    adjustmentMap[payment.PaymentID],
 			}
 		}
 
@@ -329,3 +578,202 @@ func (s *PayrollService) ExportPayments(ctx context.Context, periodStart, period
 	}
 	return rows, nil
 }
+
+// ListAdjustments uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *PayrollService) ListAdjustments(ctx context.Context, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var adjustments []*aggregate.PaymentAdjustment
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		if _, txErr := s.paymentRepo.GetByID(ctx, tx, paymentID); txErr != nil {
+			return txErr
+		}
+
+		var txErr error
+		adjustments, txErr = s.adjustmentRepo.ListByPaymentID(ctx, tx, paymentID)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return adjustments, nil
+}
+
+// AddAdjustment uses InSystemTx because writes to auth.payments and
+// auth.payment_adjustments are only granted to the internal role.
+func (s *PayrollService) AddAdjustment(ctx context.Context, paymentID uuid.UUID, input AdjustmentInput) (*AdjustmentResult, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		createdBy = &id
+	}
+
+	var result *AdjustmentResult
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		target, txErr := s.paymentRepo.GetByID(ctx, tx, paymentID)
+		if txErr != nil {
+			return txErr
+		}
+
+		adjustment, txErr := aggregate.NewPaymentAdjustment(target.StudentID, input.Type, input.Amount, input.Reason, createdBy)
+		if txErr != nil {
+			return txErr
+		}
+
+		applyTo := target
+		if target.ProcessedAt != nil {
+			adjustment.CarryForwardFrom(target)
+			applyTo, txErr = s.nextPaymentFor(ctx, tx, adjustment)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		var existing []*aggregate.PaymentAdjustment
+		if applyTo != nil {
+			existing, txErr = s.adjustmentRepo.ListAppliedByPaymentIDs(ctx, tx, []uuid.UUID{applyTo.PaymentID})
+			if txErr != nil {
+				return txErr
+			}
+			txErr = applyTo.ApplyAdjustments(basePay(applyTo), append(existing, adjustment))
+			switch {
+			case txErr == nil:
+				adjustment.ApplyTo(applyTo)
+			case errors.Is(txErr, payrollErrors.ErrNegativeNetAmount) && applyTo != target:
+				// Too large for the next payment; wait for a later one.
+				applyTo = nil
+			default:
+				return txErr
+			}
+		}
+
+		created, txErr := s.adjustmentRepo.Create(ctx, tx, adjustment)
+		if txErr != nil {
+			return txErr
+		}
+		result = &AdjustmentResult{Adjustment: created}
+
+		if applyTo != nil {
+			updated, txErr := s.paymentRepo.Update(ctx, tx, applyTo)
+			if txErr != nil {
+				return txErr
+			}
+			result.Payment = updated
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// nextPaymentFor returns the student's earliest unprocessed payment that a
+// carried-forward adjustment may be applied to, or nil if there is none yet.
+func (s *PayrollService) nextPaymentFor(ctx context.Context, tx *sql.Tx, adjustment *aggregate.PaymentAdjustment) (*aggregate.Payment, error) {
+	payments, err := s.paymentRepo.ListByStudentID(ctx, tx, adjustment.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *aggregate.Payment
+	for _, p := range payments {
+		if adjustment.CanApplyTo(p) && (next == nil || p.PeriodStart.Before(next.PeriodStart)) {
+			next = p
+		}
+	}
+	return next, nil
+}
+
+// RemoveAdjustment uses InSystemTx because writes to auth.payments and
+// auth.payment_adjustments are only granted to the internal role.
+func (s *PayrollService) RemoveAdjustment(ctx context.Context, paymentID, adjustmentID uuid.UUID) (*aggregate.Payment, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Payment
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		adjustment, txErr := s.adjustmentRepo.GetByID(ctx, tx, adjustmentID)
+		if txErr != nil {
+			return txErr
+		}
+
+		appliedHere := adjustment.PaymentID != nil && *adjustment.PaymentID == paymentID
+		raisedHere := adjustment.OriginalPaymentID != nil && *adjustment.OriginalPaymentID == paymentID
+		if !appliedHere && !raisedHere {
+			return payrollErrors.ErrAdjustmentNotFound
+		}
+
+		if adjustment.IsPending() {
+			return s.adjustmentRepo.Delete(ctx, tx, adjustmentID)
+		}
+
+		payment, txErr := s.paymentRepo.GetByID(ctx, tx, *adjustment.PaymentID)
+		if txErr != nil {
+			return txErr
+		}
+		if payment.ProcessedAt != nil {
+			return payrollErrors.ErrAlreadyProcessed
+		}
+
+		applied, txErr := s.adjustmentRepo.ListAppliedByPaymentIDs(ctx, tx, []uuid.UUID{payment.PaymentID})
+		if txErr != nil {
+			return txErr
+		}
+		remaining := make([]*aggregate.PaymentAdjustment, 0, len(applied))
+		for _, a := range applied {
+			if a.ID != adjustmentID {
+				remaining = append(remaining, a)
+			}
+		}
+		// Removing a credit can leave deductions larger than gross pay.
+		if txErr := payment.ApplyAdjustments(basePay(payment), remaining); txErr != nil {
+			return txErr
+		}
+
+		if txErr := s.adjustmentRepo.Delete(ctx, tx, adjustmentID); txErr != nil {
+			return txErr
+		}
+		result, txErr = s.paymentRepo.Update(ctx, tx, payment)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListPendingAdjustments uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *PayrollService) ListPendingAdjustments(ctx context.Context) ([]*aggregate.PaymentAdjustment, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var adjustments []*aggregate.PaymentAdjustment
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		adjustments, txErr = s.adjustmentRepo.ListPending(ctx, tx, nil)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return adjustments, nil
+}
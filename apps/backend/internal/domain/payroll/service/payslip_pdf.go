@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/pdf"
+)
+
+// RenderPayslipPDF lays out a one-page payslip.
+func RenderPayslipPDF(p *aggregate.Payslip) []byte {
+	doc := pdf.NewDocument()
+	const left, right = 56.0, pdf.PageWidth - 56.0
+	y := pdf.PageHeight - 72.0
+
+	doc.Text(left, y, pdf.Font_Bold, 11, "DCIT HELP DESK")
+	doc.TextRight(right, y, pdf.Font_Bold, 20, "PAYSLIP")
+	y -= 16
+	doc.Text(left, y, pdf.Font_Regular, 9, "The University of the West Indies, St. Augustine")
+	y -= 14
+	doc.Line(left, y, right, y)
+
+	field := func(label, value string) {
+		y -= 20
+		doc.Text(left, y, pdf.Font_Bold, 10, label)
+		doc.Text(left+130, y, pdf.Font_Regular, 10, value)
+	}
+
+	y -= 10
+	field("Student", p.StudentName)
+	field("Student ID", fmt.Sprintf("%d", p.Payment.StudentID))
+	field("Pay period", fmt.Sprintf("%s to %s",
+		p.Payment.PeriodStart.Format("2 Jan 2006"), p.Payment.PeriodEnd.Format("2 Jan 2006")))
+	if p.Payment.ProcessedAt != nil {
+		field("Processed", p.Payment.ProcessedAt.UTC().Format("2 Jan 2006"))
+	} else {
+		field("Status", "Pending")
+	}
+	field("Payment reference", p.Payment.PaymentID.String())
+
+	y -= 30
+	doc.Text(left, y, pdf.Font_Bold, 10, "Description")
+	doc.TextRight(right-220, y, pdf.Font_Bold, 10, "Hours")
+	doc.TextRight(right-110, y, pdf.Font_Bold, 10, "Rate")
+	doc.TextRight(right, y, pdf.Font_Bold, 10, "Amount")
+	y -= 8
+	doc.Line(left, y, right, y)
+	y -= 18
+	doc.Text(left, y, pdf.Font_Regular, 10, "Help desk hours")
+	doc.TextRight(right-220, y, pdf.Font_Regular, 10, fmt.Sprintf("%.2f", p.Payment.HoursWorked))
+	doc.TextRight(right-110, y, pdf.Font_Regular, 10, fmt.Sprintf("$%.2f", p.HourlyRate))
+	doc.TextRight(right, y, pdf.Font_Regular, 10, fmt.Sprintf("$%.2f", p.Payment.HoursWorked*p.HourlyRate))
+
+	adjustment := func(a *aggregate.PaymentAdjustment) {
+		y -= 18
+		doc.Text(left, y, pdf.Font_Regular, 10, truncate(adjustmentLabels[a.Type]+": "+a.Reason, 52))
+		doc.TextRight(right, y, pdf.Font_Regular, 10, fmt.Sprintf("%+.2f", a.SignedAmount()))
+	}
+	total := func(label string, amount float64, font pdf.Font) {
+		y -= 10
+		doc.Line(left, y, right, y)
+		y -= 18
+		doc.Text(left, y, font, 11, label)
+		doc.TextRight(right, y, font, 11, fmt.Sprintf("$%.2f", amount))
+	}
+
+	for _, a := range p.Adjustments {
+		if !a.Type.IsDeduction() {
+			adjustment(a)
+		}
+	}
+	total("Gross pay", p.Payment.GrossAmount, pdf.Font_Bold)
+	if p.Payment.DeductionsAmount > 0 {
+		for _, a := range p.Adjustments {
+			if a.Type.IsDeduction() {
+				adjustment(a)
+			}
+		}
+		total("Deductions", p.Payment.DeductionsAmount, pdf.Font_Regular)
+	}
+	total("Net pay", p.Payment.NetAmount, pdf.Font_Bold)
+
+	y -= 40
+	doc.Text(left, y, pdf.Font_Bold, 10, "Paid to")
+	if p.MaskedAccountNumber == "" {
+		y -= 16
+		doc.Text(left, y, pdf.Font_Regular, 10, "No banking details on file.")
+	} else {
+		field("Bank", p.BankName)
+		field("Branch", p.BranchName)
+		field("Account type", p.AccountType)
+		field("Account number", p.MaskedAccountNumber)
+	}
+
+	doc.Text(left, 56, pdf.Font_Regular, 8, "Generated "+time.Now().UTC().Format("2 Jan 2006 15:04 MST")+". Hours are paid time from approved timesheets.")
+	return doc.Bytes()
+}
+
+var adjustmentLabels = map[aggregate.AdjustmentType]string{
+	aggregate.AdjustmentType_Bonus:               "Bonus",
+	aggregate.AdjustmentType_Stipend:             "Stipend",
+	aggregate.AdjustmentType_BackPay:             "Back pay",
+	aggregate.AdjustmentType_Deduction:           "Deduction",
+	aggregate.AdjustmentType_OverpaymentRecovery: "Overpayment recovery",
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max-3]) + "..."
+}
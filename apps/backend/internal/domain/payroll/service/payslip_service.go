@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PayslipServiceInterface builds payslips for processed payments.
+type PayslipServiceInterface interface {
+	// GetMyPayslip returns the payslip for one of the caller's own processed
+	// payments.
+	GetMyPayslip(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payslip, error)
+	// SendPayslipEmail emails the payslip PDF to the student. It is called by
+	// the job queue, so it runs without an auth context and skips payments
+	// that were reverted after the job was enqueued.
+	SendPayslipEmail(ctx context.Context, paymentID uuid.UUID) error
+}
+
+var _ PayslipServiceInterface = (*PayslipService)(nil)
+
+type PayslipService struct {
+	logger             *zap.Logger
+	txManager          database.TxManagerInterface
+	paymentRepo        repository.PaymentRepositoryInterface
+	adjustmentRepo     repository.PaymentAdjustmentRepositoryInterface
+	studentRepo        studentRepo.StudentRepositoryInterface
+	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface
+	emailSender        emailInterfaces.EmailSenderInterface
+	fromEmail          string
+}
+
+func NewPayslipService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	paymentRepo repository.PaymentRepositoryInterface,
+	adjustmentRepo repository.PaymentAdjustmentRepositoryInterface,
+	studentRepo studentRepo.StudentRepositoryInterface,
+	bankingDetailsRepo studentRepo.BankingDetailsRepositoryInterface,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+) *PayslipService {
+	return &PayslipService{
+		logger:             logger,
+		txManager:          txManager,
+		paymentRepo:        paymentRepo,
+		adjustmentRepo:     adjustmentRepo,
+		studentRepo:        studentRepo,
+		bankingDetailsRepo: bankingDetailsRepo,
+		emailSender:        emailSender,
+		fromEmail:          fromEmail,
+	}
+}
+
+// GetMyPayslip uses InAuthTx so RLS hides other students' payments and
+// banking details; a payment the caller cannot see is reported as not found.
+func (s *PayslipService) GetMyPayslip(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payslip, error) {
+	authCtx, studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Payslip
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		payment, txErr := s.paymentRepo.GetByID(ctx, tx, paymentID)
+		if txErr != nil {
+			return txErr
+		}
+		if payment.StudentID != studentID {
+			return payrollErrors.ErrPaymentNotFound
+		}
+		if payment.ProcessedAt == nil {
+			return payrollErrors.ErrNotProcessed
+		}
+
+		result, txErr = s.buildPayslip(ctx, tx, payment)
+		return txErr
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendPayslipEmail uses InSystemTx to read the student's profile and banking
+// details outside any request.
+func (s *PayslipService) SendPayslipEmail(ctx context.Context, paymentID uuid.UUID) error {
+	var payslip *aggregate.Payslip
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		payment, txErr := s.paymentRepo.GetByID(ctx, tx, paymentID)
+		if txErr != nil {
+			return txErr
+		}
+		if payment.ProcessedAt == nil {
+			return nil
+		}
+
+		payslip, txErr = s.buildPayslip(ctx, tx, payment)
+		return txErr
+	})
+	if err != nil {
+		return err
+	}
+	if payslip == nil {
+		s.logger.Info("payment no longer processed, skipping payslip email", zap.String("payment_id", paymentID.String()))
+		return nil
+	}
+	if payslip.StudentEmail == "" {
+		s.logger.Warn("student has no email address, skipping payslip email", zap.Int32("student_id", payslip.Payment.StudentID))
+		return nil
+	}
+
+	payment := payslip.Payment
+	periodLabel := fmt.Sprintf("%s – %s", payment.PeriodStart.Format("2 Jan"), payment.PeriodEnd.Format("2 Jan 2006"))
+	_, err = s.emailSender.Send(ctx, emailDtos.SendEmailRequest{
+		From:    s.fromEmail,
+		To:      []string{payslip.StudentEmail},
+		Subject: "Your payslip - DCIT Help Desk",
+		Template: &types.EmailTemplate{
+			ID: templates.TemplateID_Payslip,
+			Variables: map[string]any{
+				"STUDENT_NAME":      payslip.StudentName,
+				"PERIOD_LABEL":      periodLabel,
+				"GROSS_AMOUNT":      fmt.Sprintf("$%.2f", payment.GrossAmount),
+				"DEDUCTIONS_AMOUNT": fmt.Sprintf("$%.2f", payment.DeductionsAmount),
+				"NET_AMOUNT":        fmt.Sprintf("$%.2f", payment.NetAmount),
+				"HOURS_WORKED":      fmt.Sprintf("%.2f", payment.HoursWorked),
+			},
+		},
+		Attachments: []types.EmailAttachment{{
+			Content:     base64.StdEncoding.EncodeToString(RenderPayslipPDF(payslip)),
+			Filename:    payslip.Filename(),
+			ContentType: "application/pdf",
+		}},
+	})
+	if err != nil {
+		s.logger.Error("failed to send payslip email", zap.Error(err), zap.String("payment_id", paymentID.String()))
+		return fmt.Errorf("failed to send payslip email: %w", err)
+	}
+	return nil
+}
+
+func (s *PayslipService) buildPayslip(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payslip, error) {
+	payslip := &aggregate.Payslip{
+		Payment:    payment,
+		HourlyRate: HourlyRate,
+	}
+
+	adjustments, err := s.adjustmentRepo.ListAppliedByPaymentIDs(ctx, tx, []uuid.UUID{payment.PaymentID})
+	if err != nil {
+		return nil, err
+	}
+	payslip.Adjustments = adjustments
+
+	student, err := s.studentRepo.GetByID(ctx, tx, payment.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	payslip.StudentName = student.FirstName + " " + student.LastName
+	payslip.StudentEmail = student.EmailAddress
+
+	banking, err := s.bankingDetailsRepo.GetByStudentID(ctx, tx, payment.StudentID)
+	if err != nil && !errors.Is(err, studentErrors.ErrBankingDetailsNotFound) {
+		return nil, err
+	}
+	if banking != nil {
+		payslip.BankName = banking.BankName
+		payslip.BranchName = banking.BranchName
+		payslip.AccountType = string(banking.AccountType)
+		payslip.MaskedAccountNumber = aggregate.MaskAccountNumber(banking.AccountNumber)
+	}
+
+	return payslip, nil
+}
+
+// studentFromContext returns the caller's student ID. Staff without one are
+// not authorized for the student endpoints.
+func studentFromContext(ctx context.Context) (database.AuthContext, int32, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return database.AuthContext{}, 0, payrollErrors.ErrMissingAuthContext
+	}
+	if authCtx.StudentID == nil {
+		return database.AuthContext{}, 0, payrollErrors.ErrNotAuthorized
+	}
+
+	studentID, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		return database.AuthContext{}, 0, payrollErrors.ErrMissingAuthContext
+	}
+	return authCtx, int32(studentID), nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	timesheetAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"go.uber.org/zap"
+)
+
+type ReconciliationServiceInterface interface {
+	// GetReconciliation compares each student's hours on the active schedule
+	// with the hours payroll pays for the period, listing the time logs
+	// payroll leaves out and the days where the two disagree.
+	GetReconciliation(ctx context.Context, query aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error)
+}
+
+var _ ReconciliationServiceInterface = (*ReconciliationService)(nil)
+
+type ReconciliationService struct {
+	logger        *zap.Logger
+	txManager     database.TxManagerInterface
+	paymentRepo   repository.PaymentRepositoryInterface
+	studentRepo   studentRepo.StudentRepositoryInterface
+	scheduleRepo  scheduleRepo.ScheduleRepositoryInterface
+	locationRepo  scheduleRepo.LocationRepositoryInterface
+	timeLogRepo   timelogRepo.TimeLogRepositoryInterface
+	timesheetRepo timesheetRepo.TimesheetRepositoryInterface
+	timeOffRepo   timeoffRepo.TimeOffRequestRepositoryInterface
+}
+
+func NewReconciliationService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	paymentRepo repository.PaymentRepositoryInterface,
+	studentRepo studentRepo.StudentRepositoryInterface,
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+	locationRepo scheduleRepo.LocationRepositoryInterface,
+	timeLogRepo timelogRepo.TimeLogRepositoryInterface,
+	timesheetRepo timesheetRepo.TimesheetRepositoryInterface,
+	timeOffRepo timeoffRepo.TimeOffRequestRepositoryInterface,
+) *ReconciliationService {
+	return &ReconciliationService{
+		logger:        logger,
+		txManager:     txManager,
+		paymentRepo:   paymentRepo,
+		studentRepo:   studentRepo,
+		scheduleRepo:  scheduleRepo,
+		locationRepo:  locationRepo,
+		timeLogRepo:   timeLogRepo,
+		timesheetRepo: timesheetRepo,
+		timeOffRepo:   timeOffRepo,
+	}
+}
+
+// GetReconciliation uses the same rules as payment generation: time logs are
+// assigned to the UTC date they started on, and only closed, unflagged logs
+// in weeks with an approved timesheet are paid, taking each log's week in the
+// local time of its location. Scheduled hours come from
+// the active schedule, skipping days on approved time off.
+func (s *ReconciliationService) GetReconciliation(ctx context.Context, query aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error) {
+	if _, ok := database.GetAuthContextFromContext(ctx); !ok {
+		return nil, payrollErrors.ErrMissingAuthContext
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.StudentReconciliation
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		shifts, err := s.scheduledShifts(ctx, tx, query)
+		if err != nil {
+			return err
+		}
+
+		from := query.PeriodStart
+		to := query.PeriodEnd.AddDate(0, 0, 1)
+		logs, err := s.timeLogRepo.ListAll(ctx, tx, timelogRepo.TimeLogFilter{
+			StudentID: query.StudentID,
+			From:      &from,
+			To:        &to,
+		})
+		if err != nil {
+			return err
+		}
+
+		weekFrom := timesheetAggregate.WeekStartOf(query.PeriodStart)
+		timesheets, err := s.timesheetRepo.List(ctx, tx, timesheetRepo.TimesheetFilter{
+			StudentID: query.StudentID,
+			WeekFrom:  &weekFrom,
+			WeekTo:    &query.PeriodEnd,
+			Statuses:  []timesheetAggregate.Status{timesheetAggregate.Status_Approved},
+		})
+		if err != nil {
+			return err
+		}
+		approved := aggregate.ApprovedWeeks{}
+		for _, ts := range timesheets {
+			approved.Add(ts.StudentID, ts.WeekStart)
+		}
+
+		locations, err := s.locationRepo.ListAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		payments, err := s.paymentRepo.ListByPeriod(ctx, tx, repository.PaymentFilter{
+			PeriodStart: &query.PeriodStart,
+			PeriodEnd:   &query.PeriodEnd,
+			StudentID:   query.StudentID,
+		})
+		if err != nil {
+			return err
+		}
+
+		rows := aggregate.Reconcile(shifts, logs, approved, aggregate.TimezonesFrom(locations), payments, time.Duration(query.ToleranceMinutes)*time.Minute)
+		if query.DiscrepanciesOnly {
+			filtered := rows[:0]
+			for _, r := range rows {
+				if r.HasDiscrepancies() {
+					filtered = append(filtered, r)
+				}
+			}
+			rows = filtered
+		}
+
+		if err := s.attachNames(ctx, tx, rows); err != nil {
+			return err
+		}
+		result = rows
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ReconciliationService) scheduledShifts(ctx context.Context, tx *sql.Tx, query aggregate.ReconciliationQuery) ([]aggregate.ScheduledShift, error) {
+	active, err := s.scheduleRepo.GetActive(ctx, tx)
+	if err != nil {
+		if errors.Is(err, scheduleErrors.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if active == nil {
+		return nil, nil
+	}
+
+	timeOff, err := s.timeOffRepo.List(ctx, tx, timeoffRepo.TimeOffFilter{
+		StudentID: query.StudentID,
+		Statuses:  []timeoffAggregate.Status{timeoffAggregate.Status_Approved},
+		From:      &query.PeriodStart,
+		To:        &query.PeriodEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	skip := func(studentID int32, day time.Time) bool {
+		if query.StudentID != nil && *query.StudentID != studentID {
+			return true
+		}
+		for _, r := range timeOff {
+			if r.StudentID == studentID && r.CoversDate(day) {
+				return true
+			}
+		}
+		return false
+	}
+	dated, err := active.Expand(query.PeriodStart, query.PeriodEnd, skip)
+	if err != nil {
+		s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err), zap.String("schedule_id", active.ScheduleID.String()))
+		return nil, fmt.Errorf("malformed schedule assignments: %w", err)
+	}
+	return aggregate.ScheduledShiftsFrom(dated), nil
+}
+
+func (s *ReconciliationService) attachNames(ctx context.Context, tx *sql.Tx, rows []*aggregate.StudentReconciliation) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	ids := make([]int32, len(rows))
+	for i, r := range rows {
+		ids[i] = r.StudentID
+	}
+
+	students, err := s.studentRepo.ListByIDs(ctx, tx, ids)
+	if err != nil {
+		return err
+	}
+	names := make(map[int32]string, len(students))
+	for _, st := range students {
+		names[st.StudentID] = st.FirstName + " " + st.LastName
+	}
+	for _, r := range rows {
+		r.StudentName = names[r.StudentID]
+	}
+	return nil
+}
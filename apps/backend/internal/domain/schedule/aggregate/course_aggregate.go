@@ -0,0 +1,202 @@
+package aggregate
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
+)
+
+var courseCodePattern = regexp.MustCompile(`^[A-Z]{2,8}[0-9]{3,5}[A-Z]?$`)
+
+// NormalizeCourseCode upper-cases a course code and removes whitespace, so
+// "comp 1601" and "COMP1601" compare equal.
+func NormalizeCourseCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, code)
+}
+
+// CourseLevelFromCode returns the level of a course, the first digit of its
+// course number: 1 for COMP1601, 3 for INFO3604.
+func CourseLevelFromCode(code string) (int32, bool) {
+	for _, r := range NormalizeCourseCode(code) {
+		if r >= '1' && r <= '9' {
+			return r - '0', true
+		}
+		if unicode.IsDigit(r) {
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// Course is an entry in the course catalogue. Code is stored normalised.
+type Course struct {
+	ID         uuid.UUID
+	Code       string
+	Title      string
+	Department string
+	Level      int32
+	IsActive   bool
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+// NewCourse creates an active course. A zero level is taken from the course
+// number.
+func NewCourse(code, title, department string, level int32) (*Course, error) {
+	code = NormalizeCourseCode(code)
+	if !courseCodePattern.MatchString(code) {
+		return nil, errors.ErrInvalidCourseCode
+	}
+
+	c := &Course{
+		ID:       uuid.New(),
+		Code:     code,
+		IsActive: true,
+	}
+	if err := c.Update(title, department, level); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Update changes the course details. The code cannot change; create a new
+// course and deactivate this one instead.
+func (c *Course) Update(title, department string, level int32) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.ErrInvalidCourseTitle
+	}
+	if level == 0 {
+		level, _ = CourseLevelFromCode(c.Code)
+	}
+	if level < 1 || level > 9 {
+		return errors.ErrInvalidCourseLevel
+	}
+
+	c.Title = title
+	c.Department = strings.TrimSpace(department)
+	c.Level = level
+	return nil
+}
+
+func (c *Course) Activate() {
+	c.IsActive = true
+}
+
+func (c *Course) Deactivate() {
+	c.IsActive = false
+}
+
+func (c *Course) ToModel() model.Courses {
+	return model.Courses{
+		ID:         c.ID,
+		Code:       c.Code,
+		Title:      c.Title,
+		Department: c.Department,
+		Level:      c.Level,
+		IsActive:   c.IsActive,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func CourseFromModel(m model.Courses) Course {
+	return Course{
+		ID:         m.ID,
+		Code:       m.Code,
+		Title:      m.Title,
+		Department: m.Department,
+		Level:      m.Level,
+		IsActive:   m.IsActive,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+// CourseCatalogue looks up courses by code, ignoring case and whitespace.
+type CourseCatalogue struct {
+	byCode map[string]*Course
+}
+
+func NewCourseCatalogue(courses []*Course) *CourseCatalogue {
+	c := &CourseCatalogue{byCode: make(map[string]*Course, len(courses))}
+	for _, course := range courses {
+		c.byCode[NormalizeCourseCode(course.Code)] = course
+	}
+	return c
+}
+
+// Lookup returns the catalogue entry for code, active or not.
+func (c *CourseCatalogue) Lookup(code string) (*Course, bool) {
+	course, ok := c.byCode[NormalizeCourseCode(code)]
+	return course, ok
+}
+
+// ResolveDemands rewrites demand course codes to their catalogue codes. It
+// returns the codes that are not in the catalogue or belong to inactive
+// courses, sorted; the demands are only usable when that list is empty.
+func (c *CourseCatalogue) ResolveDemands(demands []CourseDemand) ([]CourseDemand, []string) {
+	resolved := make([]CourseDemand, len(demands))
+	unknown := map[string]struct{}{}
+	for i, d := range demands {
+		resolved[i] = d
+		course, ok := c.Lookup(d.CourseCode)
+		if !ok || !course.IsActive {
+			unknown[d.CourseCode] = struct{}{}
+			continue
+		}
+		resolved[i].CourseCode = course.Code
+	}
+	return resolved, sortedKeys(unknown)
+}
+
+// NormalizeTranscript rewrites transcript course codes to their catalogue
+// codes and fills in missing titles. Courses not in the catalogue keep their
+// code with whitespace removed and are returned as unknown, sorted. Transcripts
+// list every course a student took, so unknown codes are reported rather than
+// rejected.
+func (c *CourseCatalogue) NormalizeTranscript(courses []types.CourseResult) ([]types.CourseResult, []string) {
+	if courses == nil {
+		return nil, nil
+	}
+	normalized := make([]types.CourseResult, len(courses))
+	unknown := map[string]struct{}{}
+	for i, cr := range courses {
+		normalized[i] = cr
+		course, ok := c.Lookup(cr.Code)
+		if !ok {
+			code := NormalizeCourseCode(cr.Code)
+			normalized[i].Code = code
+			if code != "" {
+				unknown[code] = struct{}{}
+			}
+			continue
+		}
+		normalized[i].Code = course.Code
+		if strings.TrimSpace(cr.Title) == "" {
+			normalized[i].Title = course.Title
+		}
+	}
+	return normalized, sortedKeys(unknown)
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
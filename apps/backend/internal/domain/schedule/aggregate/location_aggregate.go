@@ -0,0 +1,181 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultGeofenceRadiusMeters is used when a location does not specify a radius.
+	DefaultGeofenceRadiusMeters = 100
+	// DefaultLocationTimezone is the timezone shift times are interpreted in
+	// when a location does not specify one.
+	DefaultLocationTimezone = "America/Port_of_Spain"
+)
+
+// GeoPoint is a polygon vertex stored as [longitude, latitude].
+type GeoPoint [2]float64
+
+func (p GeoPoint) Longitude() float64 { return p[0] }
+func (p GeoPoint) Latitude() float64  { return p[1] }
+
+// Location is a physical help desk. Clock-ins are checked against the geofence
+// of the location linked to the matched shift: the polygon when one is set,
+// otherwise a circle of RadiusMeters around (Latitude, Longitude).
+type Location struct {
+	ID           uuid.UUID
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Geofence     []GeoPoint
+	Timezone     string
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
+
+func NewLocation(name string, latitude, longitude, radiusMeters float64, geofence []GeoPoint, timezone string) (*Location, error) {
+	if radiusMeters == 0 {
+		radiusMeters = DefaultGeofenceRadiusMeters
+	}
+	if timezone == "" {
+		timezone = DefaultLocationTimezone
+	}
+	if err := validateLocation(name, latitude, longitude, radiusMeters, geofence, timezone); err != nil {
+		return nil, err
+	}
+
+	return &Location{
+		ID:           uuid.New(),
+		Name:         strings.TrimSpace(name),
+		Latitude:     latitude,
+		Longitude:    longitude,
+		RadiusMeters: radiusMeters,
+		Geofence:     geofence,
+		Timezone:     timezone,
+		IsActive:     true,
+	}, nil
+}
+
+func (l *Location) Update(name string, latitude, longitude, radiusMeters float64, geofence []GeoPoint, timezone string) error {
+	if radiusMeters == 0 {
+		radiusMeters = DefaultGeofenceRadiusMeters
+	}
+	if timezone == "" {
+		timezone = DefaultLocationTimezone
+	}
+	if err := validateLocation(name, latitude, longitude, radiusMeters, geofence, timezone); err != nil {
+		return err
+	}
+
+	l.Name = strings.TrimSpace(name)
+	l.Latitude = latitude
+	l.Longitude = longitude
+	l.RadiusMeters = radiusMeters
+	l.Geofence = geofence
+	l.Timezone = timezone
+	return nil
+}
+
+func (l *Location) Activate() {
+	l.IsActive = true
+}
+
+func (l *Location) Deactivate() {
+	l.IsActive = false
+}
+
+// HasPolygon reports whether the location uses a polygon geofence instead of a radius.
+func (l *Location) HasPolygon() bool {
+	return len(l.Geofence) >= 3
+}
+
+// TimeLocation returns the location's timezone. Timezones are validated on
+// write, so the fallback only applies when tzdata is unavailable at runtime.
+func (l *Location) TimeLocation() *time.Location {
+	tz, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		return time.FixedZone("AST", -4*60*60)
+	}
+	return tz
+}
+
+func validateLocation(name string, latitude, longitude, radiusMeters float64, geofence []GeoPoint, timezone string) error {
+	if strings.TrimSpace(name) == "" || len(name) > 100 {
+		return errors.ErrInvalidLocationName
+	}
+	if !validCoordinates(latitude, longitude) {
+		return errors.ErrInvalidCoordinates
+	}
+	if radiusMeters <= 0 {
+		return errors.ErrInvalidGeofenceRadius
+	}
+	if len(geofence) > 0 {
+		if len(geofence) < 3 {
+			return errors.ErrInvalidGeofencePolygon
+		}
+		for _, p := range geofence {
+			if !validCoordinates(p.Latitude(), p.Longitude()) {
+				return errors.ErrInvalidGeofencePolygon
+			}
+		}
+	}
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+		return errors.ErrInvalidLocationTimezone
+	}
+	return nil
+}
+
+func validCoordinates(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+func (l *Location) ToModel() model.Locations {
+	var geofence *string
+	if len(l.Geofence) > 0 {
+		b, _ := json.Marshal(l.Geofence)
+		s := string(b)
+		geofence = &s
+	}
+
+	return model.Locations{
+		ID:           l.ID,
+		Name:         l.Name,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		RadiusMeters: l.RadiusMeters,
+		Geofence:     geofence,
+		Timezone:     l.Timezone,
+		IsActive:     l.IsActive,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+func LocationFromModel(m model.Locations) Location {
+	var geofence []GeoPoint
+	if m.Geofence != nil {
+		if err := json.Unmarshal([]byte(*m.Geofence), &geofence); err != nil {
+			geofence = nil
+		}
+	}
+
+	return Location{
+		ID:           m.ID,
+		Name:         m.Name,
+		Latitude:     m.Latitude,
+		Longitude:    m.Longitude,
+		RadiusMeters: m.RadiusMeters,
+		Geofence:     geofence,
+		Timezone:     m.Timezone,
+		IsActive:     m.IsActive,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
@@ -34,6 +34,9 @@ type Schedule struct {
 	EffectiveTo          *time.Time
 	GenerationID         *uuid.UUID
 	SchedulerMetadata    *string
+	// AssignmentOverrides replaces Assignments on the dates it covers; see
+	// AssignmentOverride.
+	AssignmentOverrides json.RawMessage
 }
 
 // NewSchedule creates a new schedule with validation
@@ -53,6 +56,7 @@ func NewSchedule(title string, effectiveFrom time.Time, effectiveTo *time.Time)
 		Title:                title,
 		Assignments:          json.RawMessage("[]"),
 		AvailabilityMetadata: json.RawMessage("{}"),
+		AssignmentOverrides:  json.RawMessage("[]"),
 		EffectiveFrom:        effectiveFrom,
 		EffectiveTo:          effectiveTo,
 	}, nil
@@ -146,6 +150,7 @@ func (a *Schedule) ToModel() model.Schedules {
 		EffectiveTo:          a.EffectiveTo,
 		GenerationID:         a.GenerationID,
 		SchedulerMetadata:    a.SchedulerMetadata,
+		AssignmentOverrides:  string(a.AssignmentOverrides),
 	}
 }
 
@@ -165,5 +170,6 @@ func ScheduleFromModel(m model.Schedules) Schedule {
 		EffectiveTo:          m.EffectiveTo,
 		GenerationID:         m.GenerationID,
 		SchedulerMetadata:    m.SchedulerMetadata,
+		AssignmentOverrides:  json.RawMessage(m.AssignmentOverrides),
 	}
 }
@@ -0,0 +1,226 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Assignment is one weekly entry in a schedule's assignments JSON, as written
+// by the scheduler. Times are local wall-clock times at the shift's location.
+type Assignment struct {
+	AssistantID string `json:"assistant_id"`
+	ShiftID     string `json:"shift_id"`
+	DayOfWeek   int    `json:"day_of_week"` // Monday=0
+	Start       string `json:"start"`       // "HH:MM:SS" or "HH:MM"
+	End         string `json:"end"`
+}
+
+// ParseAssignments decodes a schedule's assignments JSON. Empty input yields
+// no assignments.
+func ParseAssignments(raw json.RawMessage) ([]Assignment, error) {
+	assignments := []Assignment{}
+	if len(raw) == 0 {
+		return assignments, nil
+	}
+	if err := json.Unmarshal(raw, &assignments); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// StudentID is the assignment's assistant ID as a student ID. The scheduler
+// stores assistant IDs as strings; ok is false for any other ID.
+func (a Assignment) StudentID() (int32, bool) {
+	id, err := strconv.ParseInt(a.AssistantID, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// Clock returns the assignment's start and end as offsets from midnight. ok
+// is false if either time is malformed or the shift does not end after it
+// starts; shifts spanning midnight are not supported.
+func (a Assignment) Clock() (start, end time.Duration, ok bool) {
+	start, okStart := ParseClock(a.Start)
+	end, okEnd := ParseClock(a.End)
+	if !okStart || !okEnd || end <= start {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// Hours is the length of the shift, or 0 if its times cannot be read.
+func (a Assignment) Hours() float64 {
+	start, end, ok := a.Clock()
+	if !ok {
+		return 0
+	}
+	return (end - start).Hours()
+}
+
+// At places the shift on the given date in tz, returning its start and end as
+// UTC instants.
+func (a Assignment) At(day time.Time, tz *time.Location) (start, end time.Time, ok bool) {
+	startOffset, endOffset, ok := a.Clock()
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, tz)
+	return midnight.Add(startOffset).UTC(), midnight.Add(endOffset).UTC(), true
+}
+
+// ParseClock parses an "HH:MM:SS" or "HH:MM" time of day into an offset from
+// midnight.
+func ParseClock(v string) (time.Duration, bool) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, true
+		}
+	}
+	return 0, false
+}
+
+// Weekday maps a date to the schedule's weekday numbering, Monday=0.
+func Weekday(day time.Time) int {
+	return (int(day.Weekday()) + 6) % 7
+}
+
+// DatedShift is one dated occurrence of an assignment.
+type DatedShift struct {
+	Assignment
+	StudentID int32
+	Date      time.Time
+}
+
+// AssignmentOverride replaces a schedule's weekly assignments on the dates
+// From to To inclusive, both "2006-01-02". Generation writes one for each run
+// of days on which approved time off changes who can work.
+type AssignmentOverride struct {
+	From        string       `json:"from"`
+	To          string       `json:"to"`
+	Assignments []Assignment `json:"assignments"`
+}
+
+// Covers reports whether day falls within the override. An override with a
+// malformed date covers nothing.
+func (o AssignmentOverride) Covers(day time.Time) bool {
+	from, errFrom := time.Parse(time.DateOnly, o.From)
+	to, errTo := time.Parse(time.DateOnly, o.To)
+	if errFrom != nil || errTo != nil {
+		return false
+	}
+	d := calendarDate(day)
+	return !d.Before(from) && !d.After(to)
+}
+
+// ParseAssignmentOverrides decodes a schedule's assignment overrides JSON.
+// Empty input yields no overrides.
+func ParseAssignmentOverrides(raw json.RawMessage) ([]AssignmentOverride, error) {
+	overrides := []AssignmentOverride{}
+	if len(raw) == 0 {
+		return overrides, nil
+	}
+	if err := json.Unmarshal(raw, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// DatedAssignments is a schedule's weekly assignments together with the
+// overrides that replace them on particular dates.
+type DatedAssignments struct {
+	Weekly    []Assignment
+	Overrides []AssignmentOverride
+}
+
+// On returns the assignments that apply on day: those of the first override
+// covering it, or else the weekly ones.
+func (d DatedAssignments) On(day time.Time) []Assignment {
+	for _, o := range d.Overrides {
+		if o.Covers(day) {
+			return o.Assignments
+		}
+	}
+	return d.Weekly
+}
+
+// Expand dates the assignments over [from, to], keeping to the effective
+// period [effectiveFrom, effectiveTo]. Dates are calendar dates; only their
+// year, month and day are used. Entries whose assistant is not a student, and
+// those for which skip returns true, such as days on approved time off, are
+// left out. Shifts are ordered by date, then in assignment order.
+func (d DatedAssignments) Expand(
+	effectiveFrom time.Time,
+	effectiveTo *time.Time,
+	from, to time.Time,
+	skip func(studentID int32, day time.Time) bool,
+) []DatedShift {
+	start := calendarDate(from)
+	if ef := calendarDate(effectiveFrom); ef.After(start) {
+		start = ef
+	}
+	end := calendarDate(to)
+	if effectiveTo != nil {
+		if et := calendarDate(*effectiveTo); et.Before(end) {
+			end = et
+		}
+	}
+
+	var shifts []DatedShift
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		weekday := Weekday(day)
+		for _, a := range d.On(day) {
+			if a.DayOfWeek != weekday {
+				continue
+			}
+			studentID, ok := a.StudentID()
+			if !ok {
+				continue
+			}
+			if skip != nil && skip(studentID, day) {
+				continue
+			}
+			shifts = append(shifts, DatedShift{Assignment: a, StudentID: studentID, Date: day})
+		}
+	}
+	return shifts
+}
+
+// DatedAssignments decodes the schedule's weekly assignments and overrides.
+func (a *Schedule) DatedAssignments() (DatedAssignments, error) {
+	weekly, err := ParseAssignments(a.Assignments)
+	if err != nil {
+		return DatedAssignments{}, err
+	}
+	overrides, err := ParseAssignmentOverrides(a.AssignmentOverrides)
+	if err != nil {
+		return DatedAssignments{}, err
+	}
+	return DatedAssignments{Weekly: weekly, Overrides: overrides}, nil
+}
+
+// AssignmentsOn returns the schedule's assignments that apply on day.
+func (a *Schedule) AssignmentsOn(day time.Time) ([]Assignment, error) {
+	dated, err := a.DatedAssignments()
+	if err != nil {
+		return nil, err
+	}
+	return dated.On(day), nil
+}
+
+// Expand dates the schedule's assignments over [from, to], honouring its
+// overrides; see DatedAssignments.Expand.
+func (a *Schedule) Expand(from, to time.Time, skip func(studentID int32, day time.Time) bool) ([]DatedShift, error) {
+	dated, err := a.DatedAssignments()
+	if err != nil {
+		return nil, err
+	}
+	return dated.Expand(a.EffectiveFrom, a.EffectiveTo, from, to, skip), nil
+}
+
+// calendarDate is t's calendar date at midnight UTC.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -25,6 +25,7 @@ type ShiftTemplate struct {
 	MinStaff      int32
 	MaxStaff      *int32
 	CourseDemands []CourseDemand
+	LocationID    *uuid.UUID
 	IsActive      bool
 	CreatedAt     time.Time
 	UpdatedAt     *time.Time
@@ -71,6 +72,12 @@ func (s *ShiftTemplate) Update(name string, dayOfWeek int32, startTime, endTime
 	return nil
 }
 
+// SetLocation links the template to the help desk it is staffed at. A nil ID
+// clears the link, falling back to the default help desk.
+func (s *ShiftTemplate) SetLocation(locationID *uuid.UUID) {
+	s.LocationID = locationID
+}
+
 func (s *ShiftTemplate) Activate() {
 	if s.IsActive {
 		return
@@ -116,6 +123,7 @@ func (s *ShiftTemplate) ToModel() model.ShiftTemplates {
 		MinStaff:      s.MinStaff,
 		MaxStaff:      s.MaxStaff,
 		CourseDemands: string(demandsJSON),
+		LocationID:    s.LocationID,
 		IsActive:      s.IsActive,
 		CreatedAt:     s.CreatedAt,
 		UpdatedAt:     s.UpdatedAt,
@@ -137,6 +145,7 @@ func ShiftTemplateFromModel(m model.ShiftTemplates) ShiftTemplate {
 		MinStaff:      m.MinStaff,
 		MaxStaff:      m.MaxStaff,
 		CourseDemands: demands,
+		LocationID:    m.LocationID,
 		IsActive:      m.IsActive,
 		CreatedAt:     m.CreatedAt,
 		UpdatedAt:     m.UpdatedAt,
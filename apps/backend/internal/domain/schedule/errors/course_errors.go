@@ -0,0 +1,13 @@
+package errors
+
+import "errors"
+
+var (
+	ErrCourseNotFound      = errors.New("course not found")
+	ErrCourseExists        = errors.New("a course with this code already exists")
+	ErrInvalidCourseCode   = errors.New("course code must be letters followed by a course number, e.g. COMP1601")
+	ErrInvalidCourseTitle  = errors.New("invalid course title")
+	ErrInvalidCourseLevel  = errors.New("course level must be between 1 and 9")
+	ErrUnknownCourseCode   = errors.New("course demands reference courses that are not in the catalogue or are inactive")
+	ErrInvalidCourseImport = errors.New("invalid course CSV")
+)
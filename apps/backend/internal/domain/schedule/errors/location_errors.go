@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrLocationNotFound        = errors.New("location not found")
+	ErrInvalidLocationName     = errors.New("invalid location name")
+	ErrInvalidCoordinates      = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrInvalidGeofenceRadius   = errors.New("geofence radius must be greater than 0")
+	ErrInvalidGeofencePolygon  = errors.New("geofence polygon must have at least 3 valid points")
+	ErrInvalidLocationTimezone = errors.New("invalid IANA timezone")
+)
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxCourseImportSize = 2 << 20 // 2 MB
+
+type CourseHandler struct {
+	logger  *zap.Logger
+	service service.CourseServiceInterface
+}
+
+func NewCourseHandler(logger *zap.Logger, service service.CourseServiceInterface) *CourseHandler {
+	return &CourseHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *CourseHandler) RegisterReadRoutes(r chi.Router) {
+	r.Get("/courses", h.List)
+}
+
+func (h *CourseHandler) RegisterRoutes(r chi.Router) {
+	r.Route("/courses", func(r chi.Router) {
+		r.Post("/", h.Create)
+		r.Get("/all", h.ListAll)
+		r.Post("/import", h.Import)
+		r.Get("/unknown-codes", h.UnknownCodes)
+		r.Post("/normalize-transcripts", h.NormalizeTranscripts)
+		r.Get("/{id}", h.GetByID)
+		r.Put("/{id}", h.Update)
+		r.Patch("/{id}/activate", h.Activate)
+		r.Patch("/{id}/deactivate", h.Deactivate)
+	})
+}
+
+func (h *CourseHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CourseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	course, err := aggregate.NewCourse(req.Code, req.Title, req.Department, req.Level)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	created, err := h.service.Create(r.Context(), course)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.CourseToResponse(created))
+}
+
+func (h *CourseHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid course ID")
+		return
+	}
+
+	course, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.CourseToResponse(course))
+}
+
+func (h *CourseHandler) List(w http.ResponseWriter, r *http.Request) {
+	courses, err := h.service.List(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.CoursesToResponse(courses))
+}
+
+func (h *CourseHandler) ListAll(w http.ResponseWriter, r *http.Request) {
+	courses, err := h.service.ListAll(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.CoursesToResponse(courses))
+}
+
+func (h *CourseHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid course ID")
+		return
+	}
+
+	var req dtos.CourseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, service.UpdateCourseParams{
+		Title:      req.Title,
+		Department: req.Department,
+		Level:      req.Level,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.CourseToResponse(updated))
+}
+
+func (h *CourseHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid course ID")
+		return
+	}
+
+	if err := h.service.Activate(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CourseHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid course ID")
+		return
+	}
+
+	if err := h.service.Deactivate(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Import reads a CSV upload from the "file" form field. Row errors reject the
+// whole file with 422 and are listed in the response.
+func (h *CourseHandler) Import(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxCourseImportSize)
+
+	if err := r.ParseMultipartForm(maxCourseImportSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	result, err := h.service.Import(r.Context(), file)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	if len(result.Errors) > 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	writeJSON(w, status, dtos.CourseImportToResponse(result))
+}
+
+func (h *CourseHandler) UnknownCodes(w http.ResponseWriter, r *http.Request) {
+	codes, err := h.service.UnknownCodes(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.UnknownCourseCodesToResponse(codes))
+}
+
+func (h *CourseHandler) NormalizeTranscripts(w http.ResponseWriter, r *http.Request) {
+	updated, err := h.service.NormalizeTranscripts(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.NormalizeTranscriptsResponse{Updated: updated})
+}
+
+func (h *CourseHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, scheduleErrors.ErrCourseNotFound):
+		writeError(w, http.StatusNotFound, "course not found")
+	case errors.Is(err, scheduleErrors.ErrCourseExists):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, scheduleErrors.ErrInvalidCourseCode),
+		errors.Is(err, scheduleErrors.ErrInvalidCourseTitle),
+		errors.Is(err, scheduleErrors.ErrInvalidCourseLevel),
+		errors.Is(err, scheduleErrors.ErrInvalidCourseImport):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, scheduleErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,97 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+)
+
+// CourseRequest is used for both create and update. The code is ignored on
+// update. A zero level is taken from the course number.
+type CourseRequest struct {
+	Code       string `json:"code"`
+	Title      string `json:"title"`
+	Department string `json:"department"`
+	Level      int32  `json:"level"`
+}
+
+type CourseResponse struct {
+	ID         string     `json:"id"`
+	Code       string     `json:"code"`
+	Title      string     `json:"title"`
+	Department string     `json:"department"`
+	Level      int32      `json:"level"`
+	IsActive   bool       `json:"is_active"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+type CourseImportErrorResponse struct {
+	Line  int    `json:"line"`
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+type CourseImportResponse struct {
+	Created   int                         `json:"created"`
+	Updated   int                         `json:"updated"`
+	Unchanged int                         `json:"unchanged"`
+	Errors    []CourseImportErrorResponse `json:"errors"`
+}
+
+type UnknownCourseCodeResponse struct {
+	Code           string   `json:"code"`
+	StudentIDs     []int32  `json:"student_ids"`
+	ShiftTemplates []string `json:"shift_templates"`
+}
+
+type NormalizeTranscriptsResponse struct {
+	Updated int `json:"updated"`
+}
+
+func CourseToResponse(c *aggregate.Course) CourseResponse {
+	return CourseResponse{
+		ID:         c.ID.String(),
+		Code:       c.Code,
+		Title:      c.Title,
+		Department: c.Department,
+		Level:      c.Level,
+		IsActive:   c.IsActive,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func CoursesToResponse(courses []*aggregate.Course) []CourseResponse {
+	responses := make([]CourseResponse, len(courses))
+	for i, c := range courses {
+		responses[i] = CourseToResponse(c)
+	}
+	return responses
+}
+
+func CourseImportToResponse(r *service.CourseImportResult) CourseImportResponse {
+	errs := make([]CourseImportErrorResponse, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = CourseImportErrorResponse{Line: e.Line, Code: e.Code, Error: e.Error}
+	}
+	return CourseImportResponse{
+		Created:   r.Created,
+		Updated:   r.Updated,
+		Unchanged: r.Unchanged,
+		Errors:    errs,
+	}
+}
+
+func UnknownCourseCodesToResponse(codes []service.UnknownCourseCode) []UnknownCourseCodeResponse {
+	responses := make([]UnknownCourseCodeResponse, len(codes))
+	for i, c := range codes {
+		responses[i] = UnknownCourseCodeResponse{
+			Code:           c.Code,
+			StudentIDs:     c.StudentIDs,
+			ShiftTemplates: c.ShiftTemplates,
+		}
+	}
+	return responses
+}
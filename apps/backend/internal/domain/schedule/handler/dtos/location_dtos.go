@@ -0,0 +1,70 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+)
+
+// LocationRequest is used for both create and update. Geofence is an optional
+// polygon of [longitude, latitude] pairs; when omitted RadiusMeters is used.
+type LocationRequest struct {
+	Name         string       `json:"name"`
+	Latitude     float64      `json:"latitude"`
+	Longitude    float64      `json:"longitude"`
+	RadiusMeters float64      `json:"radius_meters"`
+	Geofence     [][2]float64 `json:"geofence,omitempty"`
+	Timezone     string       `json:"timezone"`
+}
+
+type LocationResponse struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	Latitude     float64      `json:"latitude"`
+	Longitude    float64      `json:"longitude"`
+	RadiusMeters float64      `json:"radius_meters"`
+	Geofence     [][2]float64 `json:"geofence"`
+	Timezone     string       `json:"timezone"`
+	IsActive     bool         `json:"is_active"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    *time.Time   `json:"updated_at,omitempty"`
+}
+
+func LocationToResponse(l *aggregate.Location) LocationResponse {
+	geofence := make([][2]float64, len(l.Geofence))
+	for i, p := range l.Geofence {
+		geofence[i] = p
+	}
+
+	return LocationResponse{
+		ID:           l.ID.String(),
+		Name:         l.Name,
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		RadiusMeters: l.RadiusMeters,
+		Geofence:     geofence,
+		Timezone:     l.Timezone,
+		IsActive:     l.IsActive,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+	}
+}
+
+func LocationsToResponse(locations []*aggregate.Location) []LocationResponse {
+	responses := make([]LocationResponse, len(locations))
+	for i, l := range locations {
+		responses[i] = LocationToResponse(l)
+	}
+	return responses
+}
+
+func GeofenceToAggregate(points [][2]float64) []aggregate.GeoPoint {
+	if len(points) == 0 {
+		return nil
+	}
+	geofence := make([]aggregate.GeoPoint, len(points))
+	for i, p := range points {
+		geofence[i] = aggregate.GeoPoint(p)
+	}
+	return geofence
+}
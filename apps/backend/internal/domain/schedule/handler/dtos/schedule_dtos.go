@@ -19,6 +19,10 @@ type GenerateScheduleRequest struct {
 	EffectiveFrom string   `json:"effective_from"` // format: "2006-01-02"
 	EffectiveTo   *string  `json:"effective_to"`   // format: "2006-01-02"
 	StudentIDs    []string `json:"student_ids"`
+	BudgetID      *string  `json:"budget_id,omitempty"` // generate within this budget's cost ceiling
+	// IncludeUntrained keeps students missing blocking training in the run,
+	// with a warning, instead of excluding them.
+	IncludeUntrained bool `json:"include_untrained,omitempty"`
 }
 
 type UpdateScheduleRequest struct {
@@ -32,6 +36,7 @@ type ScheduleResponse struct {
 	Status               string          `json:"status"`
 	IsActive             bool            `json:"is_active"`
 	Assignments          json.RawMessage `json:"assignments"`
+	AssignmentOverrides  json.RawMessage `json:"assignment_overrides"`
 	AvailabilityMetadata json.RawMessage `json:"availability_metadata"`
 	CreatedAt            time.Time       `json:"created_at"`
 	CreatedBy            string          `json:"created_by"`
@@ -50,6 +55,7 @@ func ScheduleToResponse(s *aggregate.Schedule) ScheduleResponse {
 		Status:               string(s.Status()),
 		IsActive:             s.IsActive,
 		Assignments:          s.Assignments,
+		AssignmentOverrides:  s.AssignmentOverrides,
 		AvailabilityMetadata: s.AvailabilityMetadata,
 		CreatedAt:            s.CreatedAt,
 		CreatedBy:            s.CreatedBy.String(),
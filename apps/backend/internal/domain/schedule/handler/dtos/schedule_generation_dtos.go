@@ -21,6 +21,21 @@ type ScheduleGenerationResponse struct {
 	CreatedBy       string          `json:"created_by"`
 }
 
+// TrainingWarning names a requested student missing blocking training and
+// whether they were left out of the generation.
+type TrainingWarning struct {
+	StudentID       string   `json:"student_id"`
+	MissingTraining []string `json:"missing_training"`
+	Excluded        bool     `json:"excluded"`
+}
+
+// GenerateScheduleResponse is the queued generation plus any training
+// warnings about the requested students.
+type GenerateScheduleResponse struct {
+	ScheduleGenerationResponse
+	TrainingWarnings []TrainingWarning `json:"training_warnings,omitempty"`
+}
+
 func ScheduleGenerationToResponse(g *aggregate.ScheduleGeneration) ScheduleGenerationResponse {
 	resp := ScheduleGenerationResponse{
 		ID:           g.ID.String(),
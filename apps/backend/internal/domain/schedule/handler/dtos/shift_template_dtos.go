@@ -20,6 +20,7 @@ type CreateShiftTemplateRequest struct {
 	MinStaff      int32             `json:"min_staff"`
 	MaxStaff      *int32            `json:"max_staff,omitempty"`
 	CourseDemands []CourseDemandDTO `json:"course_demands"`
+	LocationID    *string           `json:"location_id,omitempty"`
 }
 
 type BulkCreateShiftTemplatesRequest struct {
@@ -34,6 +35,7 @@ type UpdateShiftTemplateRequest struct {
 	MinStaff      int32             `json:"min_staff"`
 	MaxStaff      *int32            `json:"max_staff,omitempty"`
 	CourseDemands []CourseDemandDTO `json:"course_demands"`
+	LocationID    *string           `json:"location_id,omitempty"`
 }
 
 type ShiftTemplateResponse struct {
@@ -45,6 +47,7 @@ type ShiftTemplateResponse struct {
 	MinStaff      int32             `json:"min_staff"`
 	MaxStaff      *int32            `json:"max_staff,omitempty"`
 	CourseDemands []CourseDemandDTO `json:"course_demands"`
+	LocationID    *string           `json:"location_id"`
 	IsActive      bool              `json:"is_active"`
 	CreatedAt     time.Time         `json:"created_at"`
 	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
@@ -60,6 +63,12 @@ func ShiftTemplateToResponse(t *aggregate.ShiftTemplate) ShiftTemplateResponse {
 		}
 	}
 
+	var locationID *string
+	if t.LocationID != nil {
+		id := t.LocationID.String()
+		locationID = &id
+	}
+
 	return ShiftTemplateResponse{
 		ID:            t.ID.String(),
 		Name:          t.Name,
@@ -69,6 +78,7 @@ func ShiftTemplateToResponse(t *aggregate.ShiftTemplate) ShiftTemplateResponse {
 		MinStaff:      t.MinStaff,
 		MaxStaff:      t.MaxStaff,
 		CourseDemands: demands,
+		LocationID:    locationID,
 		IsActive:      t.IsActive,
 		CreatedAt:     t.CreatedAt,
 		UpdatedAt:     t.UpdatedAt,
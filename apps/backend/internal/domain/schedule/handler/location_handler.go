@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type LocationHandler struct {
+	logger  *zap.Logger
+	service service.LocationServiceInterface
+}
+
+func NewLocationHandler(logger *zap.Logger, service service.LocationServiceInterface) *LocationHandler {
+	return &LocationHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *LocationHandler) RegisterReadRoutes(r chi.Router) {
+	r.Get("/locations", h.List)
+}
+
+func (h *LocationHandler) RegisterRoutes(r chi.Router) {
+	r.Route("/locations", func(r chi.Router) {
+		r.Post("/", h.Create)
+		r.Get("/all", h.ListAll)
+		r.Get("/{id}", h.GetByID)
+		r.Put("/{id}", h.Update)
+		r.Patch("/{id}/activate", h.Activate)
+		r.Patch("/{id}/deactivate", h.Deactivate)
+	})
+}
+
+func (h *LocationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dtos.LocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	location, err := aggregate.NewLocation(req.Name, req.Latitude, req.Longitude, req.RadiusMeters, dtos.GeofenceToAggregate(req.Geofence), req.Timezone)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	created, err := h.service.Create(r.Context(), location)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.LocationToResponse(created))
+}
+
+func (h *LocationHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location ID")
+		return
+	}
+
+	location, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.LocationToResponse(location))
+}
+
+func (h *LocationHandler) List(w http.ResponseWriter, r *http.Request) {
+	locations, err := h.service.List(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.LocationsToResponse(locations))
+}
+
+func (h *LocationHandler) ListAll(w http.ResponseWriter, r *http.Request) {
+	locations, err := h.service.ListAll(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.LocationsToResponse(locations))
+}
+
+func (h *LocationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location ID")
+		return
+	}
+
+	var req dtos.LocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	params := service.UpdateLocationParams{
+		Name:         req.Name,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+		Geofence:     dtos.GeofenceToAggregate(req.Geofence),
+		Timezone:     req.Timezone,
+	}
+
+	updated, err := h.service.Update(r.Context(), id, params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.LocationToResponse(updated))
+}
+
+func (h *LocationHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location ID")
+		return
+	}
+
+	if err := h.service.Activate(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *LocationHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location ID")
+		return
+	}
+
+	if err := h.service.Deactivate(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *LocationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, scheduleErrors.ErrLocationNotFound):
+		writeError(w, http.StatusNotFound, "location not found")
+	case errors.Is(err, scheduleErrors.ErrInvalidLocationName):
+		writeError(w, http.StatusBadRequest, "location name is required and must be at most 100 characters")
+	case errors.Is(err, scheduleErrors.ErrInvalidCoordinates):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, scheduleErrors.ErrInvalidGeofenceRadius):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, scheduleErrors.ErrInvalidGeofencePolygon):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, scheduleErrors.ErrInvalidLocationTimezone):
+		writeError(w, http.StatusBadRequest, "timezone must be a valid IANA name, e.g. America/Port_of_Spain")
+	case errors.Is(err, scheduleErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/handler/dtos"
@@ -36,6 +37,8 @@ type ScheduleHandler struct {
 	logger        *zap.Logger
 	service       service.ScheduleServiceInterface
 	studentSvc    studentService.StudentServiceInterface
+	eligibility   studentService.CourseEligibilityServiceInterface
+	training      studentService.TrainingServiceInterface
 	shiftTplSvc   service.ShiftTemplateServiceInterface
 	emailEnqueuer EmailJobEnqueuer
 	fromEmail     string
@@ -45,6 +48,8 @@ func NewScheduleHandler(
 	logger *zap.Logger,
 	service service.ScheduleServiceInterface,
 	studentSvc studentService.StudentServiceInterface,
+	eligibility studentService.CourseEligibilityServiceInterface,
+	training studentService.TrainingServiceInterface,
 	shiftTplSvc service.ShiftTemplateServiceInterface,
 	emailEnqueuer EmailJobEnqueuer,
 	fromEmail string,
@@ -53,6 +58,8 @@ func NewScheduleHandler(
 		logger:        logger,
 		service:       service,
 		studentSvc:    studentSvc,
+		eligibility:   eligibility,
+		training:      training,
 		shiftTplSvc:   shiftTplSvc,
 		emailEnqueuer: emailEnqueuer,
 		fromEmail:     fromEmail,
@@ -174,8 +181,18 @@ func (h *ScheduleHandler) GenerateSchedule(w http.ResponseWriter, r *http.Reques
 		effectiveTo = &parsed
 	}
 
+	var budgetID *uuid.UUID
+	if req.BudgetID != nil && *req.BudgetID != "" {
+		id, err := uuid.Parse(*req.BudgetID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid budget_id")
+			return
+		}
+		budgetID = &id
+	}
+
 	// Fetch students by ID and convert to scheduler assistants
-	assistants := make([]schedulerTypes.Assistant, 0, len(req.StudentIDs))
+	students := make([]*studentAggregate.Student, 0, len(req.StudentIDs))
 	for _, sid := range req.StudentIDs {
 		studentID, err := strconv.ParseInt(sid, 10, 32)
 		if err != nil {
@@ -190,7 +207,34 @@ func (h *ScheduleHandler) GenerateSchedule(w http.ResponseWriter, r *http.Reques
 			return
 		}
 
-		assistants = append(assistants, studentToAssistant(student))
+		students = append(students, student)
+	}
+
+	students, warnings, err := h.applyTrainingGate(r.Context(), students, effectiveFrom, req.IncludeUntrained)
+	if err != nil {
+		h.logger.Error("failed to check training", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	if len(students) == 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+			"error":             "no requested student has completed the blocking training",
+			"training_warnings": warnings,
+		})
+		return
+	}
+
+	// Only courses the student is eligible to tutor are sent to the solver
+	eligibility, err := h.eligibility.Evaluate(r.Context(), students)
+	if err != nil {
+		h.logger.Error("failed to evaluate course eligibility", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	assistants := make([]schedulerTypes.Assistant, len(students))
+	for i, student := range students {
+		assistants[i] = studentToAssistant(student, eligibility[i].EligibleCourses())
 	}
 
 	params := service.GenerateScheduleParams{
@@ -199,6 +243,7 @@ func (h *ScheduleHandler) GenerateSchedule(w http.ResponseWriter, r *http.Reques
 		EffectiveFrom: effectiveFrom,
 		EffectiveTo:   effectiveTo,
 		Assistants:    assistants,
+		BudgetID:      budgetID,
 	}
 
 	generation, err := h.service.GenerateSchedule(r.Context(), params)
@@ -207,17 +252,60 @@ func (h *ScheduleHandler) GenerateSchedule(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	writeJSON(w, http.StatusAccepted, dtos.ScheduleGenerationToResponse(generation))
+	writeJSON(w, http.StatusAccepted, dtos.GenerateScheduleResponse{
+		ScheduleGenerationResponse: dtos.ScheduleGenerationToResponse(generation),
+		TrainingWarnings:           warnings,
+	})
 }
 
-// studentToAssistant converts a Student aggregate into a scheduler Assistant.
-func studentToAssistant(s *studentAggregate.Student) schedulerTypes.Assistant {
-	// Collect course codes from transcript
-	courses := make([]string, len(s.TranscriptMetadata.Courses))
-	for i, c := range s.TranscriptMetadata.Courses {
-		courses[i] = c.Code
+// applyTrainingGate checks the students against the blocking training
+// requirements as of the schedule's start. Students missing any are dropped
+// unless includeUntrained is set; either way each gets a warning.
+func (h *ScheduleHandler) applyTrainingGate(ctx context.Context, students []*studentAggregate.Student, asOf time.Time, includeUntrained bool) ([]*studentAggregate.Student, []dtos.TrainingWarning, error) {
+	ids := make([]int32, len(students))
+	for i, st := range students {
+		ids[i] = st.StudentID
+	}
+	missing, err := h.training.MissingBlocking(ctx, ids, asOf)
+	if err != nil {
+		return nil, nil, err
 	}
+	if len(missing) == 0 {
+		return students, nil, nil
+	}
+
+	kept := make([]*studentAggregate.Student, 0, len(students))
+	var warnings []dtos.TrainingWarning
+	for _, st := range students {
+		requirements, ok := missing[st.StudentID]
+		if !ok {
+			kept = append(kept, st)
+			continue
+		}
 
+		names := make([]string, len(requirements))
+		for i, req := range requirements {
+			names[i] = req.Name
+		}
+		warnings = append(warnings, dtos.TrainingWarning{
+			StudentID:       strconv.Itoa(int(st.StudentID)),
+			MissingTraining: names,
+			Excluded:        !includeUntrained,
+		})
+		if includeUntrained {
+			kept = append(kept, st)
+		}
+	}
+
+	h.logger.Info("students missing blocking training",
+		zap.Int("count", len(warnings)),
+		zap.Bool("included", includeUntrained))
+	return kept, warnings, nil
+}
+
+// studentToAssistant converts a Student aggregate into a scheduler Assistant
+// qualified for the given courses.
+func studentToAssistant(s *studentAggregate.Student, courses []string) schedulerTypes.Assistant {
 	// Convert availability: map[string][]int → []AvailabilityWindow
 	// Each key is a day index ("0"–"4"), values are available hours (e.g. [9,10,11,14,15]).
 	// Contiguous hours are grouped into windows: [9,10,11] → {start: "09:00:00", end: "12:00:00"}.
@@ -396,13 +484,8 @@ func (h *ScheduleHandler) NotifyStudents(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Parse assignments from the schedule's JSON
-	// The scheduler stores assistant_id and shift_id as strings.
-	type assignmentEntry struct {
-		AssistantID string `json:"assistant_id"`
-		ShiftID     string `json:"shift_id"`
-	}
-	var assignments []assignmentEntry
-	if err := json.Unmarshal(schedule.Assignments, &assignments); err != nil {
+	assignments, err := aggregate.ParseAssignments(schedule.Assignments)
+	if err != nil {
 		h.logger.Error("failed to parse assignments", zap.Error(err))
 		writeError(w, http.StatusInternalServerError, "failed to parse schedule assignments")
 		return
@@ -427,8 +510,14 @@ func (h *ScheduleHandler) NotifyStudents(w http.ResponseWriter, r *http.Request)
 		ss.shiftIDs = append(ss.shiftIDs, a.ShiftID)
 	}
 
-	// Get all students
-	students, err := h.studentSvc.List(ctx, "")
+	// Get the assigned students
+	studentIDs := make([]int32, 0, len(studentShiftMap))
+	for id := range studentShiftMap {
+		if n, err := strconv.ParseInt(id, 10, 32); err == nil {
+			studentIDs = append(studentIDs, int32(n))
+		}
+	}
+	students, err := h.studentSvc.ListByIDs(ctx, studentIDs)
 	if err != nil {
 		h.logger.Error("failed to list students", zap.Error(err))
 		writeError(w, http.StatusInternalServerError, "failed to fetch students")
@@ -565,6 +654,11 @@ func (h *ScheduleHandler) handleServiceError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusConflict, "a schedule generation is already in progress")
 	case errors.Is(err, scheduleErrors.ErrSchedulerConfigNotFound):
 		writeError(w, http.StatusNotFound, "scheduler config not found")
+	case errors.Is(err, budgetErrors.ErrBudgetNotFound):
+		writeError(w, http.StatusNotFound, "budget not found")
+	case errors.Is(err, budgetErrors.ErrScheduleOutsideBudget),
+		errors.Is(err, budgetErrors.ErrBudgetExhausted):
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
 	default:
 		h.logger.Error("unhandled service error", zap.Error(err))
 		writeError(w, http.StatusInternalServerError, "internal server error")
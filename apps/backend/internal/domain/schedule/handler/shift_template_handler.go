@@ -64,6 +64,12 @@ func (h *ShiftTemplateHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	locationID, err := parseLocationID(req.LocationID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location_id")
+		return
+	}
+
 	demands := dtos.CourseDemandDTOsToAggregate(req.CourseDemands)
 
 	template, err := aggregate.NewShiftTemplate(req.Name, req.DayOfWeek, startTime, endTime, req.MinStaff, req.MaxStaff, demands)
@@ -71,6 +77,7 @@ func (h *ShiftTemplateHandler) Create(w http.ResponseWriter, r *http.Request) {
 		h.handleServiceError(w, err)
 		return
 	}
+	template.SetLocation(locationID)
 
 	created, err := h.service.Create(r.Context(), template)
 	if err != nil {
@@ -108,6 +115,12 @@ func (h *ShiftTemplateHandler) BulkCreate(w http.ResponseWriter, r *http.Request
 			return
 		}
 
+		locationID, err := parseLocationID(item.LocationID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid location_id")
+			return
+		}
+
 		demands := dtos.CourseDemandDTOsToAggregate(item.CourseDemands)
 
 		t, err := aggregate.NewShiftTemplate(item.Name, item.DayOfWeek, startTime, endTime, item.MinStaff, item.MaxStaff, demands)
@@ -115,6 +128,7 @@ func (h *ShiftTemplateHandler) BulkCreate(w http.ResponseWriter, r *http.Request
 			h.handleServiceError(w, err)
 			return
 		}
+		t.SetLocation(locationID)
 
 		templates = append(templates, t)
 	}
@@ -190,6 +204,12 @@ func (h *ShiftTemplateHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	locationID, err := parseLocationID(req.LocationID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid location_id")
+		return
+	}
+
 	params := service.UpdateShiftTemplateParams{
 		Name:          req.Name,
 		DayOfWeek:     req.DayOfWeek,
@@ -198,6 +218,7 @@ func (h *ShiftTemplateHandler) Update(w http.ResponseWriter, r *http.Request) {
 		MinStaff:      req.MinStaff,
 		MaxStaff:      req.MaxStaff,
 		CourseDemands: dtos.CourseDemandDTOsToAggregate(req.CourseDemands),
+		LocationID:    locationID,
 	}
 
 	updated, err := h.service.Update(r.Context(), id, params)
@@ -251,6 +272,10 @@ func (h *ShiftTemplateHandler) handleServiceError(w http.ResponseWriter, err err
 		writeError(w, http.StatusBadRequest, "start time must be before end time")
 	case errors.Is(err, scheduleErrors.ErrInvalidStaffing):
 		writeError(w, http.StatusBadRequest, "invalid staffing: min staff must be at least 1 and max staff must be >= min staff")
+	case errors.Is(err, scheduleErrors.ErrLocationNotFound):
+		writeError(w, http.StatusBadRequest, "location not found")
+	case errors.Is(err, scheduleErrors.ErrUnknownCourseCode):
+		writeError(w, http.StatusBadRequest, err.Error())
 	case errors.Is(err, scheduleErrors.ErrMissingAuthContext):
 		writeError(w, http.StatusUnauthorized, "unauthorized")
 	default:
@@ -258,3 +283,15 @@ func (h *ShiftTemplateHandler) handleServiceError(w http.ResponseWriter, err err
 		writeError(w, http.StatusInternalServerError, "internal server error")
 	}
 }
+
+// parseLocationID parses an optional location ID from a request body.
+func parseLocationID(raw *string) (*uuid.UUID, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*raw)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/google/uuid"
+)
+
+type CourseRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, c *aggregate.Course) (*aggregate.Course, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Course, error)
+	// GetByCode looks a course up by its normalised code.
+	GetByCode(ctx context.Context, tx *sql.Tx, code string) (*aggregate.Course, error)
+	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error)    // active only
+	ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error) // including inactive
+	Update(ctx context.Context, tx *sql.Tx, c *aggregate.Course) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/google/uuid"
+)
+
+type LocationRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, l *aggregate.Location) (*aggregate.Location, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Location, error)
+	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error)    // active only
+	ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error) // including inactive
+	Update(ctx context.Context, tx *sql.Tx, l *aggregate.Location) error
+	// ListByShiftTemplateIDs returns the location of each given shift template,
+	// keyed by shift template ID. Templates without a location are omitted.
+	ListByShiftTemplateIDs(ctx context.Context, tx *sql.Tx, shiftTemplateIDs []uuid.UUID) (map[uuid.UUID]*aggregate.Location, error)
+}
@@ -0,0 +1,524 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	studentRepository "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type UpdateCourseParams struct {
+	Title      string
+	Department string
+	Level      int32
+}
+
+// CourseImportError describes a CSV row that could not be imported. Line is
+// the 1-based line number in the file, counting the header.
+type CourseImportError struct {
+	Line  int
+	Code  string
+	Error string
+}
+
+// CourseImportResult summarises a catalogue import. When Errors is non-empty
+// nothing was written.
+type CourseImportResult struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	Errors    []CourseImportError
+}
+
+// UnknownCourseCode is a course code used by transcripts or shift templates
+// that does not match an active catalogue course. Transcript codes only count
+// as unknown when they are missing from the catalogue entirely.
+type UnknownCourseCode struct {
+	Code           string
+	StudentIDs     []int32
+	ShiftTemplates []string
+}
+
+type CourseServiceInterface interface {
+	Create(ctx context.Context, c *aggregate.Course) (*aggregate.Course, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*aggregate.Course, error)
+	List(ctx context.Context) ([]*aggregate.Course, error)
+	ListAll(ctx context.Context) ([]*aggregate.Course, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdateCourseParams) (*aggregate.Course, error)
+	Activate(ctx context.Context, id uuid.UUID) error
+	Deactivate(ctx context.Context, id uuid.UUID) error
+	// Import upserts courses from a CSV with a header row. The code and title
+	// columns are required; department, level and is_active are optional.
+	Import(ctx context.Context, r io.Reader) (*CourseImportResult, error)
+	// UnknownCodes reports course codes that do not match the catalogue.
+	UnknownCodes(ctx context.Context) ([]UnknownCourseCode, error)
+	// NormalizeTranscripts rewrites stored transcript course codes to their
+	// catalogue codes and returns the number of students updated.
+	NormalizeTranscripts(ctx context.Context) (int, error)
+}
+
+type CourseService struct {
+	logger            *zap.Logger
+	repository        repository.CourseRepositoryInterface
+	shiftTemplateRepo repository.ShiftTemplateRepositoryInterface
+	studentRepo       studentRepository.StudentRepositoryInterface
+	txManager         database.TxManagerInterface
+}
+
+var _ CourseServiceInterface = (*CourseService)(nil)
+
+func NewCourseService(
+	logger *zap.Logger,
+	repository repository.CourseRepositoryInterface,
+	shiftTemplateRepo repository.ShiftTemplateRepositoryInterface,
+	studentRepo studentRepository.StudentRepositoryInterface,
+	txManager database.TxManagerInterface,
+) *CourseService {
+	return &CourseService{
+		logger:            logger,
+		repository:        repository,
+		shiftTemplateRepo: shiftTemplateRepo,
+		studentRepo:       studentRepo,
+		txManager:         txManager,
+	}
+}
+
+func (s *CourseService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, scheduleErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *CourseService) Create(ctx context.Context, c *aggregate.Course) (*aggregate.Course, error) {
+	s.logger.Info("creating course", zap.String("code", c.Code))
+
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Course
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		_, txErr := s.repository.GetByCode(ctx, tx, c.Code)
+		if txErr == nil {
+			return scheduleErrors.ErrCourseExists
+		}
+		if !errors.Is(txErr, scheduleErrors.ErrCourseNotFound) {
+			return txErr
+		}
+		result, txErr = s.repository.Create(ctx, tx, c)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create course", zap.String("code", c.Code), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("course created", zap.String("id", result.ID.String()))
+	return result, nil
+}
+
+func (s *CourseService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.Course, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Course
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.GetByID(ctx, tx, id)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *CourseService) List(ctx context.Context) ([]*aggregate.Course, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Course
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.List(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to list courses", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *CourseService) ListAll(ctx context.Context) ([]*aggregate.Course, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Course
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.ListAll(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to list all courses", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *CourseService) Update(ctx context.Context, id uuid.UUID, params UpdateCourseParams) (*aggregate.Course, error) {
+	s.logger.Info("updating course", zap.String("id", id.String()))
+
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Course
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		c, txErr := s.repository.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr = c.Update(params.Title, params.Department, params.Level); txErr != nil {
+			return txErr
+		}
+		if txErr = s.repository.Update(ctx, tx, c); txErr != nil {
+			return txErr
+		}
+		result = c
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to update course", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("course updated", zap.String("id", id.String()))
+	return result, nil
+}
+
+func (s *CourseService) Activate(ctx context.Context, id uuid.UUID) error {
+	return s.setActive(ctx, id, true)
+}
+
+// Deactivate hides a course from new shift template demands. Existing
+// templates keep their demands until they are next edited.
+func (s *CourseService) Deactivate(ctx context.Context, id uuid.UUID) error {
+	return s.setActive(ctx, id, false)
+}
+
+func (s *CourseService) setActive(ctx context.Context, id uuid.UUID, active bool) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		c, txErr := s.repository.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if active {
+			c.Activate()
+		} else {
+			c.Deactivate()
+		}
+		return s.repository.Update(ctx, tx, c)
+	})
+	if err != nil {
+		s.logger.Error("failed to set course active state", zap.String("id", id.String()), zap.Bool("active", active), zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("course active state changed", zap.String("id", id.String()), zap.Bool("active", active))
+	return nil
+}
+
+// courseImportRow is a parsed CSV row. Active is nil when the file has no
+// is_active column, leaving existing courses' state unchanged.
+type courseImportRow struct {
+	course *aggregate.Course
+	active *bool
+}
+
+func (s *CourseService) Import(ctx context.Context, r io.Reader) (*CourseImportResult, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseCourseCSV(r)
+	if err != nil {
+		return nil, err
+	}
+	result := &CourseImportResult{Errors: rowErrors}
+	if len(rowErrors) > 0 {
+		return result, nil
+	}
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		existing, txErr := s.repository.ListAll(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		catalogue := aggregate.NewCourseCatalogue(existing)
+
+		for _, row := range rows {
+			current, ok := catalogue.Lookup(row.course.Code)
+			if !ok {
+				if row.active != nil && !*row.active {
+					row.course.Deactivate()
+				}
+				if _, txErr := s.repository.Create(ctx, tx, row.course); txErr != nil {
+					return txErr
+				}
+				result.Created++
+				continue
+			}
+
+			before := *current
+			if txErr := current.Update(row.course.Title, row.course.Department, row.course.Level); txErr != nil {
+				return txErr
+			}
+			if row.active != nil {
+				current.IsActive = *row.active
+			}
+			if current.Title == before.Title && current.Department == before.Department &&
+				current.Level == before.Level && current.IsActive == before.IsActive {
+				result.Unchanged++
+				continue
+			}
+			if txErr := s.repository.Update(ctx, tx, current); txErr != nil {
+				return txErr
+			}
+			result.Updated++
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to import courses", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("courses imported",
+		zap.Int("created", result.Created),
+		zap.Int("updated", result.Updated),
+		zap.Int("unchanged", result.Unchanged),
+	)
+	return result, nil
+}
+
+// parseCourseCSV reads the import file. Malformed CSV or a missing required
+// column fails the whole file; invalid rows are returned as row errors.
+func parseCourseCSV(r io.Reader) ([]courseImportRow, []CourseImportError, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: missing header row", scheduleErrors.ErrInvalidCourseImport)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"code", "title"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("%w: missing %q column", scheduleErrors.ErrInvalidCourseImport, required)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []courseImportRow
+	var rowErrors []CourseImportError
+	seen := make(map[string]int)
+	reader.FieldsPerRecord = -1
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", scheduleErrors.ErrInvalidCourseImport, err)
+		}
+
+		code := field(record, "code")
+		if code == "" && field(record, "title") == "" {
+			continue
+		}
+		fail := func(msg string) {
+			rowErrors = append(rowErrors, CourseImportError{Line: line, Code: code, Error: msg})
+		}
+
+		var level int64
+		if v := field(record, "level"); v != "" {
+			if level, err = strconv.ParseInt(v, 10, 32); err != nil {
+				fail(scheduleErrors.ErrInvalidCourseLevel.Error())
+				continue
+			}
+		}
+
+		var active *bool
+		if _, ok := columns["is_active"]; ok {
+			if v := field(record, "is_active"); v != "" {
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					fail("is_active must be true or false")
+					continue
+				}
+				active = &b
+			}
+		}
+
+		course, err := aggregate.NewCourse(code, field(record, "title"), field(record, "department"), int32(level))
+		if err != nil {
+			fail(err.Error())
+			continue
+		}
+		if first, ok := seen[course.Code]; ok {
+			fail(fmt.Sprintf("duplicate of line %d", first))
+			continue
+		}
+		seen[course.Code] = line
+
+		rows = append(rows, courseImportRow{course: course, active: active})
+	}
+
+	return rows, rowErrors, nil
+}
+
+func (s *CourseService) UnknownCodes(ctx context.Context) ([]UnknownCourseCode, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	byCode := make(map[string]*UnknownCourseCode)
+	entry := func(code string) *UnknownCourseCode {
+		u, ok := byCode[code]
+		if !ok {
+			u = &UnknownCourseCode{Code: code, StudentIDs: []int32{}, ShiftTemplates: []string{}}
+			byCode[code] = u
+		}
+		return u
+	}
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		courses, txErr := s.repository.ListAll(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		catalogue := aggregate.NewCourseCatalogue(courses)
+
+		students, txErr := s.studentRepo.List(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, st := range students {
+			_, unknown := catalogue.NormalizeTranscript(st.TranscriptMetadata.Courses)
+			for _, code := range unknown {
+				u := entry(code)
+				u.StudentIDs = append(u.StudentIDs, st.StudentID)
+			}
+		}
+
+		templates, txErr := s.shiftTemplateRepo.ListAll(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, t := range templates {
+			_, unknown := catalogue.ResolveDemands(t.CourseDemands)
+			for _, code := range unknown {
+				u := entry(aggregate.NormalizeCourseCode(code))
+				u.ShiftTemplates = append(u.ShiftTemplates, t.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to report unknown course codes", zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]UnknownCourseCode, 0, len(byCode))
+	for _, u := range byCode {
+		result = append(result, *u)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Code < result[j].Code })
+	return result, nil
+}
+
+func (s *CourseService) NormalizeTranscripts(ctx context.Context) (int, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		courses, txErr := s.repository.ListAll(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		catalogue := aggregate.NewCourseCatalogue(courses)
+
+		students, txErr := s.studentRepo.List(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, st := range students {
+			normalized, _ := catalogue.NormalizeTranscript(st.TranscriptMetadata.Courses)
+			if !transcriptChanged(st.TranscriptMetadata.Courses, normalized) {
+				continue
+			}
+			st.TranscriptMetadata.Courses = normalized
+			if txErr := s.studentRepo.Update(ctx, tx, st); txErr != nil {
+				return txErr
+			}
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to normalise transcripts", zap.Error(err))
+		return 0, err
+	}
+
+	s.logger.Info("transcripts normalised", zap.Int("updated", updated))
+	return updated, nil
+}
+
+func transcriptChanged(before, after []types.CourseResult) bool {
+	for i := range before {
+		if before[i].Code != after[i].Code || before[i].Title != after[i].Title {
+			return true
+		}
+	}
+	return false
+}
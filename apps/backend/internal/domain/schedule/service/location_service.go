@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type UpdateLocationParams struct {
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Geofence     []aggregate.GeoPoint
+	Timezone     string
+}
+
+type LocationServiceInterface interface {
+	Create(ctx context.Context, l *aggregate.Location) (*aggregate.Location, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*aggregate.Location, error)
+	List(ctx context.Context) ([]*aggregate.Location, error)
+	ListAll(ctx context.Context) ([]*aggregate.Location, error)
+	Update(ctx context.Context, id uuid.UUID, params UpdateLocationParams) (*aggregate.Location, error)
+	Activate(ctx context.Context, id uuid.UUID) error
+	Deactivate(ctx context.Context, id uuid.UUID) error
+}
+
+type LocationService struct {
+	logger     *zap.Logger
+	repository repository.LocationRepositoryInterface
+	txManager  database.TxManagerInterface
+}
+
+func NewLocationService(
+	logger *zap.Logger,
+	repository repository.LocationRepositoryInterface,
+	txManager database.TxManagerInterface,
+) *LocationService {
+	return &LocationService{
+		logger:     logger,
+		repository: repository,
+		txManager:  txManager,
+	}
+}
+
+func (s *LocationService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, scheduleErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *LocationService) Create(ctx context.Context, l *aggregate.Location) (*aggregate.Location, error) {
+	s.logger.Info("creating location", zap.String("name", l.Name))
+
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Location
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.Create(ctx, tx, l)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create location", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("location created", zap.String("id", result.ID.String()))
+	return result, nil
+}
+
+func (s *LocationService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.Location, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Location
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.GetByID(ctx, tx, id)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *LocationService) List(ctx context.Context) ([]*aggregate.Location, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Location
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.List(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to list locations", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *LocationService) ListAll(ctx context.Context) ([]*aggregate.Location, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Location
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.ListAll(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to list all locations", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *LocationService) Update(ctx context.Context, id uuid.UUID, params UpdateLocationParams) (*aggregate.Location, error) {
+	s.logger.Info("updating location", zap.String("id", id.String()))
+
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Location
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		l, txErr := s.repository.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = l.Update(params.Name, params.Latitude, params.Longitude, params.RadiusMeters, params.Geofence, params.Timezone); txErr != nil {
+			return txErr
+		}
+
+		if txErr = s.repository.Update(ctx, tx, l); txErr != nil {
+			return txErr
+		}
+
+		result = l
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to update location", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("location updated", zap.String("id", id.String()))
+	return result, nil
+}
+
+func (s *LocationService) Activate(ctx context.Context, id uuid.UUID) error {
+	return s.setActive(ctx, id, true)
+}
+
+func (s *LocationService) Deactivate(ctx context.Context, id uuid.UUID) error {
+	return s.setActive(ctx, id, false)
+}
+
+func (s *LocationService) setActive(ctx context.Context, id uuid.UUID, active bool) error {
+	s.logger.Info("setting location active state", zap.String("id", id.String()), zap.Bool("active", active))
+
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		l, txErr := s.repository.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if active {
+			l.Activate()
+		} else {
+			l.Deactivate()
+		}
+		return s.repository.Update(ctx, tx, l)
+	})
+	if err != nil {
+		s.logger.Error("failed to set location active state", zap.String("id", id.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
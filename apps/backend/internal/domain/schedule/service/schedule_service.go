@@ -5,11 +5,16 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/scheduler/types"
 	"github.com/google/uuid"
@@ -30,6 +35,22 @@ type ScheduleGenerationJobArgs struct {
 	EffectiveTo    *string
 	CreatedBy      uuid.UUID
 	RequestPayload types.GenerateScheduleRequest
+	Overrides      []ScheduleOverrideRequest
+}
+
+// ScheduleOverrideRequest asks the solver for the assignments on the dates
+// From to To ("2006-01-02"), on which approved time off leaves a different set
+// of assistants available than the weekly request assumes.
+type ScheduleOverrideRequest struct {
+	From           string
+	To             string
+	RequestPayload types.GenerateScheduleRequest
+}
+
+// CostCeilingProvider supplies the hourly rate and weekly cost ceiling for
+// generating a schedule within a budget.
+type CostCeilingProvider interface {
+	CostCeiling(ctx context.Context, budgetID uuid.UUID, effectiveFrom time.Time, effectiveTo *time.Time) (hourlyRate, weeklyCeiling float64, err error)
 }
 
 // GenerateScheduleParams holds the parameters for schedule generation.
@@ -39,6 +60,9 @@ type GenerateScheduleParams struct {
 	EffectiveFrom time.Time
 	EffectiveTo   *time.Time
 	Assistants    []types.Assistant
+	// BudgetID, when set, passes real per-hour costs to the solver and caps
+	// the schedule's weekly cost at the budget's remaining weekly allowance.
+	BudgetID *uuid.UUID
 }
 
 type ScheduleServiceInterface interface {
@@ -63,6 +87,8 @@ type ScheduleService struct {
 	jobEnqueuer        ScheduleJobEnqueuer
 	shiftTemplateSvc   ShiftTemplateServiceInterface
 	schedulerConfigSvc SchedulerConfigServiceInterface
+	timeOffRepo        timeoffRepo.TimeOffRequestRepositoryInterface
+	costCeilings       CostCeilingProvider
 }
 
 func NewScheduleService(
@@ -73,6 +99,8 @@ func NewScheduleService(
 	jobEnqueuer ScheduleJobEnqueuer,
 	shiftTemplateSvc ShiftTemplateServiceInterface,
 	schedulerConfigSvc SchedulerConfigServiceInterface,
+	timeOffRepo timeoffRepo.TimeOffRequestRepositoryInterface,
+	costCeilings CostCeilingProvider,
 ) *ScheduleService {
 	return &ScheduleService{
 		logger:             logger,
@@ -82,6 +110,8 @@ func NewScheduleService(
 		jobEnqueuer:        jobEnqueuer,
 		shiftTemplateSvc:   shiftTemplateSvc,
 		schedulerConfigSvc: schedulerConfigSvc,
+		timeOffRepo:        timeOffRepo,
+		costCeilings:       costCeilings,
 	}
 }
 
@@ -426,13 +456,42 @@ func (s *ScheduleService) GenerateSchedule(ctx context.Context, params GenerateS
 		return nil, err
 	}
 
+	// Find the runs of days on which approved time off keeps assistants away
+	runs, err := s.timeOffRuns(ctx, params.Assistants, params.EffectiveFrom, params.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build scheduler request from DB data + client-provided assistants
 	schedulerRequest := types.GenerateScheduleRequest{
-		Assistants:      params.Assistants,
+		Assistants:      normalizeAssistantCourses(params.Assistants),
 		Shifts:          shiftTemplatesToSchedulerShifts(shiftTemplates),
 		SchedulerConfig: schedulerConfigToSchedulerConfig(schedulerConfig),
 	}
 
+	// Price assistants and cap weekly cost when generating within a budget
+	if params.BudgetID != nil {
+		rate, ceiling, err := s.costCeilings.CostCeiling(ctx, *params.BudgetID, params.EffectiveFrom, params.EffectiveTo)
+		if err != nil {
+			s.logger.Error("failed to compute cost ceiling", zap.String("budget_id", params.BudgetID.String()), zap.Error(err))
+			return nil, err
+		}
+		for i := range schedulerRequest.Assistants {
+			schedulerRequest.Assistants[i].CostPerHour = float32(rate)
+		}
+		weeklyCeiling := float32(ceiling)
+		schedulerRequest.SchedulerConfig.WeeklyCostCeiling = &weeklyCeiling
+	}
+
+	overrides := make([]ScheduleOverrideRequest, len(runs))
+	for i, run := range runs {
+		overrides[i] = ScheduleOverrideRequest{
+			From:           run.from.Format("2006-01-02"),
+			To:             run.to.Format("2006-01-02"),
+			RequestPayload: run.request(schedulerRequest),
+		}
+	}
+
 	// Marshal request payload for audit
 	requestPayload, err := json.Marshal(schedulerRequest)
 	if err != nil {
@@ -461,6 +520,7 @@ func (s *ScheduleService) GenerateSchedule(ctx context.Context, params GenerateS
 		EffectiveTo:    effectiveTo,
 		CreatedBy:      userID,
 		RequestPayload: schedulerRequest,
+		Overrides:      overrides,
 	}); err != nil {
 		s.logger.Error("failed to enqueue schedule generation job",
 			zap.String("generation_id", generation.ID.String()),
@@ -478,13 +538,156 @@ func (s *ScheduleService) GenerateSchedule(ctx context.Context, params GenerateS
 	return generation, nil
 }
 
+// timeOffRun is a run of consecutive days on which the same assistants are
+// away on approved time off.
+type timeOffRun struct {
+	from   time.Time
+	to     time.Time
+	absent map[string]bool
+}
+
+// request derives the solver request for the run from the weekly one: the
+// absent assistants are left out and, when the run is shorter than a week,
+// only the shifts on its weekdays are offered and no assistant is held to a
+// weekly minimum. Weekly maximums and the cost ceiling still apply, as upper
+// bounds on the run's share of the week.
+func (r timeOffRun) request(weekly types.GenerateScheduleRequest) types.GenerateScheduleRequest {
+	partialWeek := r.to.Sub(r.from) < 6*24*time.Hour
+	weekdays := make(map[int]bool)
+	for day := r.from; !day.After(r.to); day = day.AddDate(0, 0, 1) {
+		weekdays[aggregate.Weekday(day)] = true
+	}
+
+	req := weekly
+	req.Assistants = make([]types.Assistant, 0, len(weekly.Assistants))
+	for _, a := range weekly.Assistants {
+		if r.absent[a.ID] {
+			continue
+		}
+		if partialWeek {
+			a.MinHours = 0
+		}
+		req.Assistants = append(req.Assistants, a)
+	}
+	req.Shifts = make([]types.Shift, 0, len(weekly.Shifts))
+	for _, shift := range weekly.Shifts {
+		if weekdays[shift.DayOfWeek] {
+			req.Shifts = append(req.Shifts, shift)
+		}
+	}
+	return req
+}
+
+// timeOffRuns finds the runs of days within the schedule period on which
+// approved time off keeps some of the assistants away. The solver builds one
+// weekly template, so each run is solved on its own and its assignments
+// replace the template on those dates. An open-ended schedule is searched up
+// to the last day of approved time off. Time off approved after generation is
+// not reflected here; it is reported as a conflict against the active
+// schedule instead.
+func (s *ScheduleService) timeOffRuns(ctx context.Context, assistants []types.Assistant, effectiveFrom time.Time, effectiveTo *time.Time) ([]timeOffRun, error) {
+	var approved []*timeoffAggregate.TimeOffRequest
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		approved, err = s.timeOffRepo.List(ctx, tx, timeoffRepo.TimeOffFilter{
+			Statuses: []timeoffAggregate.Status{timeoffAggregate.Status_Approved},
+			From:     &effectiveFrom,
+			To:       effectiveTo,
+		})
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to fetch approved time off", zap.Error(err))
+		return nil, err
+	}
+
+	return splitByTimeOff(assistants, approved, effectiveFrom, effectiveTo), nil
+}
+
+func splitByTimeOff(assistants []types.Assistant, requests []*timeoffAggregate.TimeOffRequest, from time.Time, to *time.Time) []timeOffRun {
+	scheduled := make(map[string]bool, len(assistants))
+	for _, a := range assistants {
+		scheduled[a.ID] = true
+	}
+	var relevant []*timeoffAggregate.TimeOffRequest
+	for _, r := range requests {
+		if scheduled[strconv.Itoa(int(r.StudentID))] {
+			relevant = append(relevant, r)
+		}
+	}
+	if len(relevant) == 0 {
+		return nil
+	}
+
+	start := dateOnly(from)
+	var end time.Time
+	if to != nil {
+		end = dateOnly(*to)
+	} else {
+		for _, r := range relevant {
+			if r.EndDate.After(end) {
+				end = dateOnly(r.EndDate)
+			}
+		}
+	}
+
+	var runs []timeOffRun
+	var currentKey string
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		absent := make(map[string]bool)
+		var ids []string
+		for _, r := range relevant {
+			id := strconv.Itoa(int(r.StudentID))
+			if r.CoversDate(day) && !absent[id] {
+				absent[id] = true
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		key := strings.Join(ids, ",")
+
+		switch {
+		case key == "":
+		case key == currentKey:
+			runs[len(runs)-1].to = day
+		default:
+			runs = append(runs, timeOffRun{from: day, to: day, absent: absent})
+		}
+		currentKey = key
+	}
+	return runs
+}
+
+// dateOnly is t's calendar date at midnight UTC.
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// normalizeAssistantCourses returns a copy of assistants with their course
+// codes in catalogue form. The solver matches codes exactly, so "COMP 1601"
+// on a transcript would otherwise never meet a "COMP1601" demand.
+func normalizeAssistantCourses(assistants []types.Assistant) []types.Assistant {
+	result := make([]types.Assistant, len(assistants))
+	for i, a := range assistants {
+		result[i] = a
+		if a.Courses == nil {
+			continue
+		}
+		result[i].Courses = make([]string, len(a.Courses))
+		for j, code := range a.Courses {
+			result[i].Courses[j] = aggregate.NormalizeCourseCode(code)
+		}
+	}
+	return result
+}
+
 func shiftTemplatesToSchedulerShifts(templates []*aggregate.ShiftTemplate) []types.Shift {
 	shifts := make([]types.Shift, len(templates))
 	for i, t := range templates {
 		demands := make([]types.CourseDemand, len(t.CourseDemands))
 		for j, d := range t.CourseDemands {
 			demands[j] = types.CourseDemand{
-				CourseCode:     d.CourseCode,
+				CourseCode:     aggregate.NormalizeCourseCode(d.CourseCode),
 				TutorsRequired: d.TutorsRequired,
 				Weight:         float32(d.Weight),
 			}
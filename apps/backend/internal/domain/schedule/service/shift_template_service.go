@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
@@ -21,6 +23,7 @@ type UpdateShiftTemplateParams struct {
 	MinStaff      int32
 	MaxStaff      *int32
 	CourseDemands []aggregate.CourseDemand
+	LocationID    *uuid.UUID
 }
 
 type ShiftTemplateServiceInterface interface {
@@ -35,20 +38,26 @@ type ShiftTemplateServiceInterface interface {
 }
 
 type ShiftTemplateService struct {
-	logger     *zap.Logger
-	repository repository.ShiftTemplateRepositoryInterface
-	txManager  database.TxManagerInterface
+	logger       *zap.Logger
+	repository   repository.ShiftTemplateRepositoryInterface
+	locationRepo repository.LocationRepositoryInterface
+	courseRepo   repository.CourseRepositoryInterface
+	txManager    database.TxManagerInterface
 }
 
 func NewShiftTemplateService(
 	logger *zap.Logger,
 	repository repository.ShiftTemplateRepositoryInterface,
+	locationRepo repository.LocationRepositoryInterface,
+	courseRepo repository.CourseRepositoryInterface,
 	txManager database.TxManagerInterface,
 ) *ShiftTemplateService {
 	return &ShiftTemplateService{
-		logger:     logger,
-		repository: repository,
-		txManager:  txManager,
+		logger:       logger,
+		repository:   repository,
+		locationRepo: locationRepo,
+		courseRepo:   courseRepo,
+		txManager:    txManager,
 	}
 }
 
@@ -70,7 +79,16 @@ func (s *ShiftTemplateService) Create(ctx context.Context, t *aggregate.ShiftTem
 
 	var result *aggregate.ShiftTemplate
 	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
-		var txErr error
+		if txErr := s.ensureLocation(ctx, tx, t.LocationID); txErr != nil {
+			return txErr
+		}
+		catalogue, txErr := s.catalogue(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr = resolveCourseDemands(catalogue, t); txErr != nil {
+			return txErr
+		}
 		result, txErr = s.repository.Create(ctx, tx, t)
 		return txErr
 	})
@@ -92,7 +110,18 @@ func (s *ShiftTemplateService) BulkCreate(ctx context.Context, templates []*aggr
 
 	var results []*aggregate.ShiftTemplate
 	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
-		var txErr error
+		catalogue, txErr := s.catalogue(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, t := range templates {
+			if txErr := s.ensureLocation(ctx, tx, t.LocationID); txErr != nil {
+				return txErr
+			}
+			if txErr := resolveCourseDemands(catalogue, t); txErr != nil {
+				return txErr
+			}
+		}
 		results, txErr = s.repository.BulkCreate(ctx, tx, templates)
 		return txErr
 	})
@@ -191,6 +220,19 @@ func (s *ShiftTemplateService) Update(ctx context.Context, id uuid.UUID, params
 			return txErr
 		}
 
+		catalogue, txErr := s.catalogue(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr = resolveCourseDemands(catalogue, t); txErr != nil {
+			return txErr
+		}
+
+		if txErr = s.ensureLocation(ctx, tx, params.LocationID); txErr != nil {
+			return txErr
+		}
+		t.SetLocation(params.LocationID)
+
 		if txErr = s.repository.Update(ctx, tx, t); txErr != nil {
 			return txErr
 		}
@@ -254,3 +296,32 @@ func (s *ShiftTemplateService) Deactivate(ctx context.Context, id uuid.UUID) err
 	s.logger.Info("shift template deactivated", zap.String("id", id.String()))
 	return nil
 }
+
+// ensureLocation checks that a referenced location exists. A nil ID is valid
+// and means the template uses the default help desk.
+func (s *ShiftTemplateService) ensureLocation(ctx context.Context, tx *sql.Tx, locationID *uuid.UUID) error {
+	if locationID == nil {
+		return nil
+	}
+	_, err := s.locationRepo.GetByID(ctx, tx, *locationID)
+	return err
+}
+
+func (s *ShiftTemplateService) catalogue(ctx context.Context, tx *sql.Tx) (*aggregate.CourseCatalogue, error) {
+	courses, err := s.courseRepo.List(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return aggregate.NewCourseCatalogue(courses), nil
+}
+
+// resolveCourseDemands replaces the template's demand course codes with their
+// catalogue codes, rejecting codes that are not active catalogue courses.
+func resolveCourseDemands(catalogue *aggregate.CourseCatalogue, t *aggregate.ShiftTemplate) error {
+	demands, unknown := catalogue.ResolveDemands(t.CourseDemands)
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", scheduleErrors.ErrUnknownCourseCode, strings.Join(unknown, ", "))
+	}
+	t.CourseDemands = demands
+	return nil
+}
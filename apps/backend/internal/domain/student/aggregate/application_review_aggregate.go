@@ -0,0 +1,599 @@
+package aggregate
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+const maxReviewNoteLength = 5000
+
+// ApplicationDecision is the outcome recorded when an application is accepted
+// or rejected.
+type ApplicationDecision string
+
+const (
+	ApplicationDecision_Accepted ApplicationDecision = "accepted"
+	ApplicationDecision_Rejected ApplicationDecision = "rejected"
+)
+
+// ReviewStage is one step of the application review pipeline. Stages are
+// ordered by Position; new applications start in the first active stage.
+type ReviewStage struct {
+	ID        uuid.UUID
+	Name      string
+	Position  int32
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func NewReviewStage(name string, position int32) (*ReviewStage, error) {
+	stage := &ReviewStage{ID: uuid.New(), IsActive: true}
+	if err := stage.Update(name, position); err != nil {
+		return nil, err
+	}
+	return stage, nil
+}
+
+func (s *ReviewStage) Update(name string, position int32) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		return studentErrors.ErrInvalidReviewStageName
+	}
+	if position < 1 {
+		return studentErrors.ErrInvalidReviewPosition
+	}
+	s.Name = name
+	s.Position = position
+	return nil
+}
+
+func ReviewStageFromModel(m *model.ReviewStages) *ReviewStage {
+	return &ReviewStage{
+		ID:        m.ID,
+		Name:      m.Name,
+		Position:  m.Position,
+		IsActive:  m.IsActive,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (s *ReviewStage) ToModel() model.ReviewStages {
+	return model.ReviewStages{
+		ID:        s.ID,
+		Name:      s.Name,
+		Position:  s.Position,
+		IsActive:  s.IsActive,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// ReviewCriterion is one line of the scoring rubric. Reviewers score each
+// active criterion from 0 to MaxScore.
+type ReviewCriterion struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	MaxScore    int32
+	Position    int32
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+func NewReviewCriterion(name, description string, maxScore, position int32) (*ReviewCriterion, error) {
+	criterion := &ReviewCriterion{ID: uuid.New(), IsActive: true}
+	if err := criterion.Update(name, description, maxScore, position); err != nil {
+		return nil, err
+	}
+	return criterion, nil
+}
+
+func (c *ReviewCriterion) Update(name, description string, maxScore, position int32) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return studentErrors.ErrInvalidReviewCriterion
+	}
+	if maxScore < 1 || maxScore > 10 {
+		return studentErrors.ErrInvalidMaxScore
+	}
+	if position < 1 {
+		return studentErrors.ErrInvalidReviewPosition
+	}
+	c.Name = name
+	c.Description = strings.TrimSpace(description)
+	c.MaxScore = maxScore
+	c.Position = position
+	return nil
+}
+
+func ReviewCriterionFromModel(m *model.ReviewCriteria) *ReviewCriterion {
+	return &ReviewCriterion{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		MaxScore:    m.MaxScore,
+		Position:    m.Position,
+		IsActive:    m.IsActive,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (c *ReviewCriterion) ToModel() model.ReviewCriteria {
+	return model.ReviewCriteria{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		MaxScore:    c.MaxScore,
+		Position:    c.Position,
+		IsActive:    c.IsActive,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+// StageChange is one entry of an application's stage history: either a move
+// to ToStageID or, with Decision set, the accept/reject outcome.
+type StageChange struct {
+	ID          uuid.UUID
+	StudentID   int32
+	FromStageID *uuid.UUID
+	ToStageID   *uuid.UUID
+	Decision    *ApplicationDecision
+	Note        string
+	ChangedBy   *uuid.UUID
+	CreatedAt   time.Time
+}
+
+func NewStageChange(studentID int32, from *uuid.UUID, to uuid.UUID, note string, changedBy *uuid.UUID) (*StageChange, error) {
+	note, err := normalizeOptionalNote(note)
+	if err != nil {
+		return nil, err
+	}
+	return &StageChange{
+		ID:          uuid.New(),
+		StudentID:   studentID,
+		FromStageID: from,
+		ToStageID:   &to,
+		Note:        note,
+		ChangedBy:   changedBy,
+	}, nil
+}
+
+func NewDecisionRecord(studentID int32, from *uuid.UUID, decision ApplicationDecision, changedBy *uuid.UUID) *StageChange {
+	return &StageChange{
+		ID:          uuid.New(),
+		StudentID:   studentID,
+		FromStageID: from,
+		Decision:    &decision,
+		ChangedBy:   changedBy,
+	}
+}
+
+func StageChangeFromModel(m *model.ApplicationStageHistory) *StageChange {
+	c := &StageChange{
+		ID:          m.ID,
+		StudentID:   m.StudentID,
+		FromStageID: m.FromStageID,
+		ToStageID:   m.ToStageID,
+		Note:        m.Note,
+		ChangedBy:   m.ChangedBy,
+		CreatedAt:   m.CreatedAt,
+	}
+	if m.Decision != nil {
+		d := ApplicationDecision(*m.Decision)
+		c.Decision = &d
+	}
+	return c
+}
+
+func (c *StageChange) ToModel() model.ApplicationStageHistory {
+	m := model.ApplicationStageHistory{
+		ID:          c.ID,
+		StudentID:   c.StudentID,
+		FromStageID: c.FromStageID,
+		ToStageID:   c.ToStageID,
+		Note:        c.Note,
+		ChangedBy:   c.ChangedBy,
+		CreatedAt:   c.CreatedAt,
+	}
+	if c.Decision != nil {
+		d := string(*c.Decision)
+		m.Decision = &d
+	}
+	return m
+}
+
+// ApplicationReviewer is an admin assigned to review an application.
+type ApplicationReviewer struct {
+	StudentID  int32
+	ReviewerID uuid.UUID
+	AssignedBy *uuid.UUID
+	CreatedAt  time.Time
+}
+
+func ApplicationReviewerFromModel(m *model.ApplicationReviewers) *ApplicationReviewer {
+	return &ApplicationReviewer{
+		StudentID:  m.StudentID,
+		ReviewerID: m.ReviewerID,
+		AssignedBy: m.AssignedBy,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func (r *ApplicationReviewer) ToModel() model.ApplicationReviewers {
+	return model.ApplicationReviewers{
+		StudentID:  r.StudentID,
+		ReviewerID: r.ReviewerID,
+		AssignedBy: r.AssignedBy,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+// ApplicationNote is a reviewer's comment on an application, tagged with the
+// stage the application was in when it was written.
+type ApplicationNote struct {
+	ID        uuid.UUID
+	StudentID int32
+	StageID   *uuid.UUID
+	AuthorID  *uuid.UUID
+	Body      string
+	CreatedAt time.Time
+}
+
+func NewApplicationNote(studentID int32, stageID *uuid.UUID, authorID *uuid.UUID, body string) (*ApplicationNote, error) {
+	body, err := normalizeOptionalNote(body)
+	if err != nil {
+		return nil, err
+	}
+	if body == "" {
+		return nil, studentErrors.ErrInvalidReviewNote
+	}
+	return &ApplicationNote{
+		ID:        uuid.New(),
+		StudentID: studentID,
+		StageID:   stageID,
+		AuthorID:  authorID,
+		Body:      body,
+	}, nil
+}
+
+func ApplicationNoteFromModel(m *model.ApplicationNotes) *ApplicationNote {
+	return &ApplicationNote{
+		ID:        m.ID,
+		StudentID: m.StudentID,
+		StageID:   m.StageID,
+		AuthorID:  m.AuthorID,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (n *ApplicationNote) ToModel() model.ApplicationNotes {
+	return model.ApplicationNotes{
+		ID:        n.ID,
+		StudentID: n.StudentID,
+		StageID:   n.StageID,
+		AuthorID:  n.AuthorID,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+// ApplicationScore is one reviewer's score for one rubric criterion.
+type ApplicationScore struct {
+	StudentID   int32
+	CriterionID uuid.UUID
+	ReviewerID  uuid.UUID
+	Score       int32
+	Comment     string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+func NewApplicationScore(studentID int32, criterion *ReviewCriterion, reviewerID uuid.UUID, score int32, comment string) (*ApplicationScore, error) {
+	if !criterion.IsActive {
+		return nil, studentErrors.ErrReviewCriterionInactive
+	}
+	if score < 0 || score > criterion.MaxScore {
+		return nil, studentErrors.ErrInvalidScore
+	}
+	comment, err := normalizeOptionalNote(comment)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationScore{
+		StudentID:   studentID,
+		CriterionID: criterion.ID,
+		ReviewerID:  reviewerID,
+		Score:       score,
+		Comment:     comment,
+	}, nil
+}
+
+func ApplicationScoreFromModel(m *model.ApplicationScores) *ApplicationScore {
+	return &ApplicationScore{
+		StudentID:   m.StudentID,
+		CriterionID: m.CriterionID,
+		ReviewerID:  m.ReviewerID,
+		Score:       m.Score,
+		Comment:     m.Comment,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (s *ApplicationScore) ToModel() model.ApplicationScores {
+	return model.ApplicationScores{
+		StudentID:   s.StudentID,
+		CriterionID: s.CriterionID,
+		ReviewerID:  s.ReviewerID,
+		Score:       s.Score,
+		Comment:     s.Comment,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// InterviewSlot is a time an admin has set aside to interview one applicant.
+type InterviewSlot struct {
+	ID            uuid.UUID
+	StartsAt      time.Time
+	EndsAt        time.Time
+	Location      string
+	LocationID    *uuid.UUID // help desk location whose timezone the slot is shown in
+	InterviewerID *uuid.UUID
+	StudentID     *int32
+	BookedAt      *time.Time
+	InvitedAt     *time.Time
+	CreatedBy     *uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+func NewInterviewSlot(startsAt, endsAt time.Time, location string, locationID, interviewerID, createdBy *uuid.UUID) (*InterviewSlot, error) {
+	if !endsAt.After(startsAt) {
+		return nil, studentErrors.ErrInvalidInterviewSlot
+	}
+	location = strings.TrimSpace(location)
+	if location == "" || utf8.RuneCountInString(location) > 255 {
+		return nil, studentErrors.ErrInvalidInterviewLocation
+	}
+	return &InterviewSlot{
+		ID:            uuid.New(),
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		Location:      location,
+		LocationID:    locationID,
+		InterviewerID: interviewerID,
+		CreatedBy:     createdBy,
+	}, nil
+}
+
+// Book reserves the slot for an applicant. Slots that have started cannot be
+// booked.
+func (s *InterviewSlot) Book(studentID int32, now time.Time) error {
+	if s.StudentID != nil {
+		return studentErrors.ErrInterviewSlotBooked
+	}
+	if !s.StartsAt.After(now) {
+		return studentErrors.ErrInterviewSlotInPast
+	}
+	s.StudentID = &studentID
+	s.BookedAt = &now
+	s.InvitedAt = nil
+	return nil
+}
+
+// Cancel frees a booked slot so it can be offered to another applicant.
+func (s *InterviewSlot) Cancel() error {
+	if s.StudentID == nil {
+		return studentErrors.ErrInterviewSlotNotBooked
+	}
+	s.StudentID = nil
+	s.BookedAt = nil
+	s.InvitedAt = nil
+	return nil
+}
+
+func InterviewSlotFromModel(m *model.InterviewSlots) *InterviewSlot {
+	return &InterviewSlot{
+		ID:            m.ID,
+		StartsAt:      m.StartsAt,
+		EndsAt:        m.EndsAt,
+		Location:      m.Location,
+		LocationID:    m.LocationID,
+		InterviewerID: m.InterviewerID,
+		StudentID:     m.StudentID,
+		BookedAt:      m.BookedAt,
+		InvitedAt:     m.InvitedAt,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func (s *InterviewSlot) ToModel() model.InterviewSlots {
+	return model.InterviewSlots{
+		ID:            s.ID,
+		StartsAt:      s.StartsAt,
+		EndsAt:        s.EndsAt,
+		Location:      s.Location,
+		LocationID:    s.LocationID,
+		InterviewerID: s.InterviewerID,
+		StudentID:     s.StudentID,
+		BookedAt:      s.BookedAt,
+		InvitedAt:     s.InvitedAt,
+		CreatedBy:     s.CreatedBy,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+// ReviewPipeline resolves the stage an application is in from its history.
+type ReviewPipeline struct {
+	stages []*ReviewStage
+	byID   map[uuid.UUID]*ReviewStage
+}
+
+func NewReviewPipeline(stages []*ReviewStage) *ReviewPipeline {
+	sorted := make([]*ReviewStage, len(stages))
+	copy(sorted, stages)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	p := &ReviewPipeline{stages: sorted, byID: make(map[uuid.UUID]*ReviewStage, len(sorted))}
+	for _, s := range sorted {
+		p.byID[s.ID] = s
+	}
+	return p
+}
+
+// Stage returns the stage with the given ID, active or not.
+func (p *ReviewPipeline) Stage(id uuid.UUID) (*ReviewStage, bool) {
+	s, ok := p.byID[id]
+	return s, ok
+}
+
+// First returns the active stage with the lowest position.
+func (p *ReviewPipeline) First() (*ReviewStage, bool) {
+	for _, s := range p.stages {
+		if s.IsActive {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Current returns the target of the latest stage move in history, or the
+// first active stage when the application has not been moved. history must be
+// in chronological order. A stage that has since been deactivated is still
+// returned so the application does not silently jump stages.
+func (p *ReviewPipeline) Current(history []*StageChange) (*ReviewStage, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ToStageID == nil {
+			continue
+		}
+		if s, ok := p.byID[*history[i].ToStageID]; ok {
+			return s, true
+		}
+	}
+	return p.First()
+}
+
+// Move validates a move to the stage with the given ID and returns the
+// history entry for it. Applications may move forwards or back.
+func (p *ReviewPipeline) Move(student *Student, history []*StageChange, to uuid.UUID, note string, changedBy *uuid.UUID) (*StageChange, error) {
+	if student.AcceptedAt != nil || student.RejectedAt != nil {
+		return nil, studentErrors.ErrApplicationDecided
+	}
+	target, ok := p.byID[to]
+	if !ok {
+		return nil, studentErrors.ErrReviewStageNotFound
+	}
+	if !target.IsActive {
+		return nil, studentErrors.ErrReviewStageInactive
+	}
+
+	var from *uuid.UUID
+	if current, ok := p.Current(history); ok {
+		if current.ID == target.ID {
+			return nil, studentErrors.ErrAlreadyInReviewStage
+		}
+		id := current.ID
+		from = &id
+	}
+	return NewStageChange(student.StudentID, from, to, note, changedBy)
+}
+
+// CriterionScore summarises the scores given for one rubric criterion.
+type CriterionScore struct {
+	CriterionID uuid.UUID
+	Name        string
+	MaxScore    int32
+	Average     float64
+	Count       int
+}
+
+// ScoreSummary is the rubric result for an application. Overall is the mean
+// of the criterion averages as a percentage of their max scores, nil until
+// someone has scored the application.
+type ScoreSummary struct {
+	Criteria []CriterionScore
+	Overall  *float64
+}
+
+// SummarizeScores averages the scores per criterion across reviewers.
+// Criteria are listed in rubric order; inactive criteria are listed only if
+// they were scored.
+func SummarizeScores(criteria []*ReviewCriterion, scores []*ApplicationScore) ScoreSummary {
+	sorted := make([]*ReviewCriterion, len(criteria))
+	copy(sorted, criteria)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	totals := make(map[uuid.UUID]int32)
+	counts := make(map[uuid.UUID]int)
+	for _, s := range scores {
+		totals[s.CriterionID] += s.Score
+		counts[s.CriterionID]++
+	}
+
+	summary := ScoreSummary{Criteria: []CriterionScore{}}
+	var percent float64
+	var scored int
+	for _, c := range sorted {
+		n := counts[c.ID]
+		if !c.IsActive && n == 0 {
+			continue
+		}
+		cs := CriterionScore{CriterionID: c.ID, Name: c.Name, MaxScore: c.MaxScore, Count: n}
+		if n > 0 {
+			cs.Average = float64(totals[c.ID]) / float64(n)
+			percent += cs.Average / float64(c.MaxScore) * 100
+			scored++
+		}
+		summary.Criteria = append(summary.Criteria, cs)
+	}
+	if scored > 0 {
+		overall := percent / float64(scored)
+		summary.Overall = &overall
+	}
+	return summary
+}
+
+// ApplicationReview is everything reviewers have recorded about one
+// application.
+type ApplicationReview struct {
+	Student   *Student
+	Stage     *ReviewStage
+	Reviewers []*ApplicationReviewer
+	Notes     []*ApplicationNote
+	Scores    []*ApplicationScore
+	Summary   ScoreSummary
+	History   []*StageChange
+	Interview *InterviewSlot
+}
+
+// ApplicationReviewSummary is an application's row on the pipeline board.
+type ApplicationReviewSummary struct {
+	Student     *Student
+	Stage       *ReviewStage
+	ReviewerIDs []uuid.UUID
+	EnteredAt   *time.Time
+}
+
+func normalizeOptionalNote(note string) (string, error) {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxReviewNoteLength {
+		return "", studentErrors.ErrInvalidReviewNote
+	}
+	return note, nil
+}
@@ -0,0 +1,337 @@
+package aggregate
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+// DefaultMinGrade is the minimum grade for courses without a course or level rule.
+const DefaultMinGrade = "C"
+
+// gradeRanks orders the UWI letter grades. Anything else (ungraded, in
+// progress, pass/fail or exempt) cannot be compared and never meets a minimum.
+var gradeRanks = map[string]int{
+	"A+": 13, "A": 12, "A-": 11,
+	"B+": 10, "B": 9, "B-": 8,
+	"C+": 7, "C": 6,
+	"D+": 5, "D": 4,
+	"F1": 3, "F2": 2, "F3": 1, "F": 0,
+}
+
+func normalizeGrade(grade string) string {
+	return strings.ToUpper(strings.TrimSpace(grade))
+}
+
+// IsValidGrade reports whether grade is a letter grade on the UWI scale.
+func IsValidGrade(grade string) bool {
+	_, ok := gradeRanks[normalizeGrade(grade)]
+	return ok
+}
+
+// GradeAtLeast reports whether grade is min or better.
+func GradeAtLeast(grade, min string) bool {
+	g, ok := gradeRanks[normalizeGrade(grade)]
+	if !ok {
+		return false
+	}
+	m, ok := gradeRanks[normalizeGrade(min)]
+	return ok && g >= m
+}
+
+// NormalizeCourseCode upper-cases a course code and removes whitespace, so
+// "comp 1601" and "COMP1601" compare equal.
+func NormalizeCourseCode(code string) string {
+	return scheduleAggregate.NormalizeCourseCode(code)
+}
+
+// CourseLevel returns the level of a course, the first digit of its course
+// number: 1 for COMP1601, 3 for INFO3604.
+func CourseLevel(code string) (int32, bool) {
+	return scheduleAggregate.CourseLevelFromCode(code)
+}
+
+// EligibilityRule sets the minimum grade for one course or for every course
+// at a level. Exactly one of CourseCode and CourseLevel is set.
+type EligibilityRule struct {
+	ID          uuid.UUID
+	CourseCode  *string
+	CourseLevel *int32
+	MinGrade    string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+func NewEligibilityRule(courseCode *string, courseLevel *int32, minGrade string) (*EligibilityRule, error) {
+	if (courseCode == nil) == (courseLevel == nil) {
+		return nil, studentErrors.ErrInvalidEligibilityRuleTarget
+	}
+
+	rule := &EligibilityRule{ID: uuid.New()}
+	if courseCode != nil {
+		code := NormalizeCourseCode(*courseCode)
+		if _, ok := CourseLevel(code); !ok {
+			return nil, studentErrors.ErrInvalidCourseCode
+		}
+		rule.CourseCode = &code
+	} else {
+		if *courseLevel < 1 || *courseLevel > 9 {
+			return nil, studentErrors.ErrInvalidCourseLevel
+		}
+		level := *courseLevel
+		rule.CourseLevel = &level
+	}
+
+	if err := rule.SetMinGrade(minGrade); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *EligibilityRule) SetMinGrade(minGrade string) error {
+	minGrade = normalizeGrade(minGrade)
+	if !IsValidGrade(minGrade) {
+		return studentErrors.ErrInvalidGrade
+	}
+	r.MinGrade = minGrade
+	return nil
+}
+
+// SameTarget reports whether both rules apply to the same course or level.
+func (r *EligibilityRule) SameTarget(other *EligibilityRule) bool {
+	if r.CourseCode != nil {
+		return other.CourseCode != nil && *r.CourseCode == *other.CourseCode
+	}
+	return other.CourseLevel != nil && *r.CourseLevel == *other.CourseLevel
+}
+
+func EligibilityRuleFromModel(m *model.CourseEligibilityRules) *EligibilityRule {
+	return &EligibilityRule{
+		ID:          m.ID,
+		CourseCode:  m.CourseCode,
+		CourseLevel: m.CourseLevel,
+		MinGrade:    m.MinGrade,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (r *EligibilityRule) ToModel() model.CourseEligibilityRules {
+	return model.CourseEligibilityRules{
+		ID:          r.ID,
+		CourseCode:  r.CourseCode,
+		CourseLevel: r.CourseLevel,
+		MinGrade:    r.MinGrade,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// EligibilityOverride is an admin's decision that a student is or is not
+// eligible for a course, whatever their grade. CourseCode is normalised.
+type EligibilityOverride struct {
+	StudentID  int32
+	CourseCode string
+	Eligible   bool
+	Reason     string
+	CreatedBy  *uuid.UUID
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+func NewEligibilityOverride(studentID int32, courseCode string, eligible bool, reason string, createdBy *uuid.UUID) (*EligibilityOverride, error) {
+	courseCode = NormalizeCourseCode(courseCode)
+	if _, ok := CourseLevel(courseCode); !ok {
+		return nil, studentErrors.ErrInvalidCourseCode
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, studentErrors.ErrOverrideReasonRequired
+	}
+
+	return &EligibilityOverride{
+		StudentID:  studentID,
+		CourseCode: courseCode,
+		Eligible:   eligible,
+		Reason:     reason,
+		CreatedBy:  createdBy,
+	}, nil
+}
+
+func EligibilityOverrideFromModel(m *model.CourseEligibilityOverrides) *EligibilityOverride {
+	return &EligibilityOverride{
+		StudentID:  m.StudentID,
+		CourseCode: m.CourseCode,
+		Eligible:   m.Eligible,
+		Reason:     m.Reason,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func (o *EligibilityOverride) ToModel() model.CourseEligibilityOverrides {
+	return model.CourseEligibilityOverrides{
+		StudentID:  o.StudentID,
+		CourseCode: o.CourseCode,
+		Eligible:   o.Eligible,
+		Reason:     o.Reason,
+		CreatedBy:  o.CreatedBy,
+		CreatedAt:  o.CreatedAt,
+		UpdatedAt:  o.UpdatedAt,
+	}
+}
+
+// EligibilitySource says what decided a course's eligibility.
+type EligibilitySource string
+
+const (
+	EligibilitySource_Override   EligibilitySource = "override"
+	EligibilitySource_CourseRule EligibilitySource = "course_rule"
+	EligibilitySource_LevelRule  EligibilitySource = "level_rule"
+	EligibilitySource_Default    EligibilitySource = "default"
+)
+
+// CourseEligibility is one cell of the eligibility matrix. MinGrade is the
+// grade the rules require, even when an override decided the outcome.
+type CourseEligibility struct {
+	CourseCode     string
+	Title          string
+	Grade          *string
+	MinGrade       string
+	Eligible       bool
+	Source         EligibilitySource
+	OverrideReason *string
+}
+
+// StudentEligibility is a student's row of the eligibility matrix.
+type StudentEligibility struct {
+	StudentID   int32
+	StudentName string
+	Courses     []CourseEligibility
+}
+
+// EligibleCourses returns the codes of the courses the student may tutor, in
+// catalogue form whatever form the transcript used.
+func (e *StudentEligibility) EligibleCourses() []string {
+	courses := []string{}
+	for _, c := range e.Courses {
+		if c.Eligible {
+			courses = append(courses, NormalizeCourseCode(c.CourseCode))
+		}
+	}
+	return courses
+}
+
+// EligibilityPolicy evaluates transcripts against the eligibility rules.
+type EligibilityPolicy struct {
+	courseRules map[string]string
+	levelRules  map[int32]string
+}
+
+func NewEligibilityPolicy(rules []*EligibilityRule) *EligibilityPolicy {
+	p := &EligibilityPolicy{
+		courseRules: make(map[string]string),
+		levelRules:  make(map[int32]string),
+	}
+	for _, r := range rules {
+		switch {
+		case r.CourseCode != nil:
+			p.courseRules[*r.CourseCode] = r.MinGrade
+		case r.CourseLevel != nil:
+			p.levelRules[*r.CourseLevel] = r.MinGrade
+		}
+	}
+	return p
+}
+
+// MinGradeFor returns the minimum grade for a course: its course rule, else
+// its level's rule, else DefaultMinGrade.
+func (p *EligibilityPolicy) MinGradeFor(courseCode string) (string, EligibilitySource) {
+	code := NormalizeCourseCode(courseCode)
+	if g, ok := p.courseRules[code]; ok {
+		return g, EligibilitySource_CourseRule
+	}
+	if level, ok := CourseLevel(code); ok {
+		if g, ok := p.levelRules[level]; ok {
+			return g, EligibilitySource_LevelRule
+		}
+	}
+	return DefaultMinGrade, EligibilitySource_Default
+}
+
+// QualifyingGrades returns the grades that meet the course's minimum, best
+// first.
+func (p *EligibilityPolicy) QualifyingGrades(courseCode string) []string {
+	minGrade, _ := p.MinGradeFor(courseCode)
+	grades := []string{}
+	for g := range gradeRanks {
+		if GradeAtLeast(g, minGrade) {
+			grades = append(grades, g)
+		}
+	}
+	sort.Slice(grades, func(i, j int) bool { return gradeRanks[grades[i]] > gradeRanks[grades[j]] })
+	return grades
+}
+
+// Evaluate returns the student's eligibility for every course on their
+// transcript plus any course with an override. A course taken more than once
+// is judged on its best grade. Overrides decide the outcome for their course.
+func (p *EligibilityPolicy) Evaluate(student *Student, overrides []*EligibilityOverride) *StudentEligibility {
+	result := &StudentEligibility{
+		StudentID:   student.StudentID,
+		StudentName: student.FirstName + " " + student.LastName,
+		Courses:     []CourseEligibility{},
+	}
+
+	index := make(map[string]int)
+	for _, c := range student.TranscriptMetadata.Courses {
+		key := NormalizeCourseCode(c.Code)
+		if key == "" {
+			continue
+		}
+		if i, ok := index[key]; ok {
+			existing := &result.Courses[i]
+			if c.Grade != nil && IsValidGrade(*c.Grade) && (existing.Grade == nil || GradeAtLeast(*c.Grade, *existing.Grade) || !IsValidGrade(*existing.Grade)) {
+				existing.Grade = c.Grade
+				existing.Eligible = GradeAtLeast(*c.Grade, existing.MinGrade)
+			}
+			continue
+		}
+
+		minGrade, source := p.MinGradeFor(key)
+		index[key] = len(result.Courses)
+		result.Courses = append(result.Courses, CourseEligibility{
+			CourseCode: c.Code,
+			Title:      c.Title,
+			Grade:      c.Grade,
+			MinGrade:   minGrade,
+			Eligible:   c.Grade != nil && GradeAtLeast(*c.Grade, minGrade),
+			Source:     source,
+		})
+	}
+
+	for _, o := range overrides {
+		if o.StudentID != student.StudentID {
+			continue
+		}
+		i, ok := index[o.CourseCode]
+		if !ok {
+			minGrade, _ := p.MinGradeFor(o.CourseCode)
+			index[o.CourseCode] = len(result.Courses)
+			i = len(result.Courses)
+			result.Courses = append(result.Courses, CourseEligibility{CourseCode: o.CourseCode, MinGrade: minGrade})
+		}
+		reason := o.Reason
+		result.Courses[i].Eligible = o.Eligible
+		result.Courses[i].Source = EligibilitySource_Override
+		result.Courses[i].OverrideReason = &reason
+	}
+
+	return result
+}
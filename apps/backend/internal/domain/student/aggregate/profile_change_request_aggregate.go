@@ -0,0 +1,276 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/helpers/validation"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+type ProfileChangeStatus string
+
+const (
+	ProfileChangeStatus_Pending    ProfileChangeStatus = "pending"
+	ProfileChangeStatus_Approved   ProfileChangeStatus = "approved"
+	ProfileChangeStatus_Rejected   ProfileChangeStatus = "rejected"
+	ProfileChangeStatus_Superseded ProfileChangeStatus = "superseded"
+)
+
+// ParseProfileChangeStatus validates a status filter.
+func ParseProfileChangeStatus(status string) (ProfileChangeStatus, error) {
+	switch s := ProfileChangeStatus(strings.ToLower(strings.TrimSpace(status))); s {
+	case ProfileChangeStatus_Pending, ProfileChangeStatus_Approved,
+		ProfileChangeStatus_Rejected, ProfileChangeStatus_Superseded:
+		return s, nil
+	}
+	return "", studentErrors.ErrInvalidChangeRequestStatus
+}
+
+// ProfileChangeRequest is a student's request to change the profile fields
+// the active schedule depends on. Nil fields are left unchanged. The change
+// is applied only once an admin approves it.
+type ProfileChangeRequest struct {
+	ID             uuid.UUID
+	StudentID      int32
+	Status         ProfileChangeStatus
+	Availability   Availability
+	MinWeeklyHours *float64
+	MaxWeeklyHours *float64
+	// Conflicts are the student's assignments in the active schedule that
+	// fall outside the requested availability.
+	Conflicts  []StudentAssignment
+	ReviewedBy *uuid.UUID
+	ReviewedAt *time.Time
+	ReviewNote *string
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+// NewProfileChangeRequest keeps only the fields that differ from the
+// student's profile and checks the hour bounds they would end up with.
+func NewProfileChangeRequest(student *Student, availability *json.RawMessage, minWeeklyHours, maxWeeklyHours *float64) (*ProfileChangeRequest, error) {
+	r := &ProfileChangeRequest{
+		ID:        uuid.New(),
+		StudentID: student.StudentID,
+		Status:    ProfileChangeStatus_Pending,
+		Conflicts: []StudentAssignment{},
+	}
+
+	if availability != nil {
+		if err := validation.ValidateAvailability(*availability); err != nil {
+			return nil, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAvailability, err)
+		}
+		var avail Availability
+		if err := json.Unmarshal(*availability, &avail); err != nil {
+			return nil, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAvailability, err)
+		}
+		if !sameAvailability(avail, student.Availability) {
+			r.Availability = avail
+		}
+	}
+	if minWeeklyHours != nil && *minWeeklyHours != student.MinWeeklyHours {
+		r.MinWeeklyHours = minWeeklyHours
+	}
+	if maxWeeklyHours != nil && (student.MaxWeeklyHours == nil || *maxWeeklyHours != *student.MaxWeeklyHours) {
+		r.MaxWeeklyHours = maxWeeklyHours
+	}
+
+	if r.Availability == nil && r.MinWeeklyHours == nil && r.MaxWeeklyHours == nil {
+		return nil, studentErrors.ErrNoProfileChanges
+	}
+	if err := r.checkHours(student); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// FindConflicts returns the assignments outside the requested availability.
+// A request that leaves availability unchanged has none.
+func (r *ProfileChangeRequest) FindConflicts(assignments []StudentAssignment) []StudentAssignment {
+	if r.Availability == nil {
+		return []StudentAssignment{}
+	}
+	return AvailabilityConflicts(r.Availability, assignments)
+}
+
+func (r *ProfileChangeRequest) IsPending() bool {
+	return r.Status == ProfileChangeStatus_Pending
+}
+
+// ApplyTo writes the requested fields to the student. The hour bounds are
+// checked again, as an admin may have edited the profile since the request.
+func (r *ProfileChangeRequest) ApplyTo(student *Student) error {
+	if err := r.checkHours(student); err != nil {
+		return err
+	}
+	if r.Availability != nil {
+		raw, err := json.Marshal(r.Availability)
+		if err != nil {
+			return err
+		}
+		if err := student.UpdateAvailability(raw); err != nil {
+			return fmt.Errorf("%w: %w", studentErrors.ErrInvalidAvailability, err)
+		}
+	}
+	if r.MinWeeklyHours != nil {
+		student.MinWeeklyHours = *r.MinWeeklyHours
+	}
+	if r.MaxWeeklyHours != nil {
+		student.MaxWeeklyHours = r.MaxWeeklyHours
+	}
+	return nil
+}
+
+func (r *ProfileChangeRequest) checkHours(student *Student) error {
+	minHours := student.MinWeeklyHours
+	if r.MinWeeklyHours != nil {
+		minHours = *r.MinWeeklyHours
+	}
+	maxHours := student.MaxWeeklyHours
+	if r.MaxWeeklyHours != nil {
+		maxHours = r.MaxWeeklyHours
+	}
+	if minHours < 0 || (maxHours != nil && (*maxHours < 0 || *maxHours < minHours)) {
+		return studentErrors.ErrInvalidWeeklyHours
+	}
+	return nil
+}
+
+func (r *ProfileChangeRequest) Approve(reviewedBy *uuid.UUID, note string, now time.Time) error {
+	return r.review(ProfileChangeStatus_Approved, reviewedBy, note, now)
+}
+
+func (r *ProfileChangeRequest) Reject(reviewedBy *uuid.UUID, note string, now time.Time) error {
+	return r.review(ProfileChangeStatus_Rejected, reviewedBy, note, now)
+}
+
+// Supersede closes a pending request replaced by a newer one.
+func (r *ProfileChangeRequest) Supersede(now time.Time) error {
+	if !r.IsPending() {
+		return studentErrors.ErrProfileChangeNotPending
+	}
+	r.Status = ProfileChangeStatus_Superseded
+	r.ReviewedAt = &now
+	return nil
+}
+
+func (r *ProfileChangeRequest) review(status ProfileChangeStatus, reviewedBy *uuid.UUID, note string, now time.Time) error {
+	if !r.IsPending() {
+		return studentErrors.ErrProfileChangeNotPending
+	}
+	note, err := normalizeOptionalNote(note)
+	if err != nil {
+		return err
+	}
+	r.Status = status
+	r.ReviewedBy = reviewedBy
+	r.ReviewedAt = &now
+	if note != "" {
+		r.ReviewNote = &note
+	}
+	return nil
+}
+
+// sameAvailability compares availability as sets of hours per day, so order,
+// duplicates and empty days do not count as changes.
+func sameAvailability(a, b Availability) bool {
+	normalize := func(avail Availability) map[string][]int {
+		result := make(map[string][]int)
+		for day, hours := range avail {
+			seen := make(map[int]bool)
+			var unique []int
+			for _, h := range hours {
+				if !seen[h] {
+					seen[h] = true
+					unique = append(unique, h)
+				}
+			}
+			if len(unique) == 0 {
+				continue
+			}
+			sort.Ints(unique)
+			result[day] = unique
+		}
+		return result
+	}
+
+	na, nb := normalize(a), normalize(b)
+	if len(na) != len(nb) {
+		return false
+	}
+	for day, hours := range na {
+		other, ok := nb[day]
+		if !ok || len(other) != len(hours) {
+			return false
+		}
+		for i := range hours {
+			if hours[i] != other[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func ProfileChangeRequestFromModel(m *model.ProfileChangeRequests) (*ProfileChangeRequest, error) {
+	var avail Availability
+	if m.Availability != nil {
+		if err := json.Unmarshal([]byte(*m.Availability), &avail); err != nil {
+			return nil, err
+		}
+	}
+	conflicts := []StudentAssignment{}
+	if err := json.Unmarshal([]byte(m.Conflicts), &conflicts); err != nil {
+		return nil, err
+	}
+
+	return &ProfileChangeRequest{
+		ID:             m.ID,
+		StudentID:      m.StudentID,
+		Status:         ProfileChangeStatus(m.Status),
+		Availability:   avail,
+		MinWeeklyHours: m.MinWeeklyHours,
+		MaxWeeklyHours: m.MaxWeeklyHours,
+		Conflicts:      conflicts,
+		ReviewedBy:     m.ReviewedBy,
+		ReviewedAt:     m.ReviewedAt,
+		ReviewNote:     m.ReviewNote,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}, nil
+}
+
+func (r *ProfileChangeRequest) ToModel() model.ProfileChangeRequests {
+	var availability *string
+	if r.Availability != nil {
+		raw, _ := json.Marshal(r.Availability)
+		s := string(raw)
+		availability = &s
+	}
+	conflicts := r.Conflicts
+	if conflicts == nil {
+		conflicts = []StudentAssignment{}
+	}
+	conflictsJSON, _ := json.Marshal(conflicts)
+
+	return model.ProfileChangeRequests{
+		ID:             r.ID,
+		StudentID:      r.StudentID,
+		Status:         string(r.Status),
+		Availability:   availability,
+		MinWeeklyHours: r.MinWeeklyHours,
+		MaxWeeklyHours: r.MaxWeeklyHours,
+		Conflicts:      string(conflictsJSON),
+		ReviewedBy:     r.ReviewedBy,
+		ReviewedAt:     r.ReviewedAt,
+		ReviewNote:     r.ReviewNote,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
@@ -123,6 +123,21 @@ func (s *Student) Activate() error {
 	return nil
 }
 
+// Status is the student's place in the application lifecycle: pending,
+// accepted, rejected or deactivated.
+func (s *Student) Status() string {
+	if s.DeletedAt != nil {
+		return "deactivated"
+	}
+	if s.AcceptedAt != nil {
+		return "accepted"
+	}
+	if s.RejectedAt != nil {
+		return "rejected"
+	}
+	return "pending"
+}
+
 func (s *Student) UpdatePhoneNumber(phoneNumber string) error {
 	phoneNumber = strings.TrimSpace(phoneNumber)
 	if err := validation.ValidatePhoneNumber(phoneNumber); err != nil {
@@ -146,7 +161,7 @@ type TranscriptIdentity struct {
 // then updates courses, GPA, year, programme, and major in transcript_metadata.
 // All other fields (name, student_id, term) are preserved.
 func (s *Student) UpdateTranscript(identity TranscriptIdentity, courses []types.CourseResult, overallGPA *float64, degreeGPA *float64, currentYear *int, currentProgramme *string, major *string) error {
-	if !s.transcriptMatchesStudent(identity) {
+	if !s.MatchesTranscript(identity) {
 		return studentErrors.ErrTranscriptMismatch
 	}
 
@@ -167,10 +182,10 @@ func (s *Student) UpdateTranscript(identity TranscriptIdentity, courses []types.
 	return nil
 }
 
-// transcriptMatchesStudent checks extracted identity against the student's
+// MatchesTranscript checks extracted identity against the student's
 // existing transcript metadata. If the student has no prior transcript data
 // (first upload), the check is skipped.
-func (s *Student) transcriptMatchesStudent(identity TranscriptIdentity) bool {
+func (s *Student) MatchesTranscript(identity TranscriptIdentity) bool {
 	existing := s.TranscriptMetadata
 
 	// If no prior identity on record, allow the upload
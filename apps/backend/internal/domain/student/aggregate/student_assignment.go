@@ -0,0 +1,108 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+)
+
+// StudentAssignment is one of a student's shifts in a schedule's assignments.
+type StudentAssignment struct {
+	ShiftID   string `json:"shift_id"`
+	DayOfWeek int    `json:"day_of_week"` // 0 = Monday
+	Start     string `json:"start"`
+	End       string `json:"end"`
+}
+
+// StudentAssignments picks the student's entries out of a schedule's
+// assignments JSON.
+func StudentAssignments(assignments json.RawMessage, studentID int32) ([]StudentAssignment, error) {
+	byStudent, err := AssignmentsByStudent(assignments)
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := byStudent[studentID]; ok {
+		return result, nil
+	}
+	return []StudentAssignment{}, nil
+}
+
+// AssignmentsByStudent groups a schedule's assignments JSON by student. The
+// scheduler stores assistant IDs as strings; entries whose ID is not a
+// student ID are skipped.
+func AssignmentsByStudent(assignments json.RawMessage) (map[int32][]StudentAssignment, error) {
+	entries, err := scheduleAggregate.ParseAssignments(assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int32][]StudentAssignment)
+	for _, e := range entries {
+		id, ok := e.StudentID()
+		if !ok {
+			continue
+		}
+		result[id] = append(result[id], StudentAssignment{
+			ShiftID:   e.ShiftID,
+			DayOfWeek: e.DayOfWeek,
+			Start:     e.Start,
+			End:       e.End,
+		})
+	}
+	return result, nil
+}
+
+// WithinAvailability reports whether every hour the assignment touches is an
+// available hour on its day. An hour h in Availability covers h:00 to h+1:00.
+// An assignment whose times cannot be read is treated as outside it.
+func (a StudentAssignment) WithinAvailability(avail Availability) bool {
+	start, ok := clockMinutes(a.Start)
+	if !ok {
+		return false
+	}
+	end, ok := clockMinutes(a.End)
+	if !ok || end <= start {
+		return false
+	}
+
+	available := make(map[int]bool)
+	for _, h := range avail[strconv.Itoa(a.DayOfWeek)] {
+		available[h] = true
+	}
+	for h := start / 60; h*60 < end; h++ {
+		if !available[h] {
+			return false
+		}
+	}
+	return true
+}
+
+// AvailabilityConflicts returns the assignments outside avail.
+func AvailabilityConflicts(avail Availability, assignments []StudentAssignment) []StudentAssignment {
+	conflicts := []StudentAssignment{}
+	for _, a := range assignments {
+		if !a.WithinAvailability(avail) {
+			conflicts = append(conflicts, a)
+		}
+	}
+	return conflicts
+}
+
+// clockMinutes parses "HH:MM" or "HH:MM:SS" into minutes past midnight.
+func clockMinutes(clock string) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(clock), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil || h < 0 || h > 24 {
+		return 0, false
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || m < 0 || m > 59 {
+		return 0, false
+	}
+	return h*60 + m, true
+}
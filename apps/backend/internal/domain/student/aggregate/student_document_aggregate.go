@@ -0,0 +1,205 @@
+package aggregate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+type DocumentType string
+
+const (
+	DocumentType_Transcript DocumentType = "transcript"
+	DocumentType_ID         DocumentType = "id"
+	DocumentType_WorkPermit DocumentType = "work_permit"
+	// DocumentType_TrainingCertificate is evidence of a completed training
+	// requirement.
+	DocumentType_TrainingCertificate DocumentType = "training_certificate"
+)
+
+const (
+	// MaxDocumentSize is the largest file accepted for storage.
+	MaxDocumentSize = 10 << 20
+
+	// PendingDocumentRetention is how long a document uploaded before its
+	// student exists (a transcript sent for extraction) is kept unless an
+	// application links it.
+	PendingDocumentRetention = 24 * time.Hour
+	// DocumentRetention is how long a linked document is kept by default.
+	DocumentRetention = 2 * 365 * 24 * time.Hour
+)
+
+// allowedDocumentContentTypes lists the sniffed content types each document
+// type accepts.
+var allowedDocumentContentTypes = map[DocumentType][]string{
+	DocumentType_Transcript:          {"application/pdf"},
+	DocumentType_ID:                  {"application/pdf", "image/png", "image/jpeg"},
+	DocumentType_WorkPermit:          {"application/pdf", "image/png", "image/jpeg"},
+	DocumentType_TrainingCertificate: {"application/pdf", "image/png", "image/jpeg"},
+}
+
+// StudentDocument is the metadata of a stored file. The file itself lives
+// encrypted in the document store under StorageKey.
+type StudentDocument struct {
+	ID           uuid.UUID
+	StudentID    *int32 // nil until an application links a pending upload
+	DocumentType DocumentType
+	Filename     string
+	ContentType  string
+	SizeBytes    int64
+	Checksum     string // hex SHA-256 of the plaintext
+	StorageKey   string
+	UploadedBy   *uuid.UUID
+	RetainUntil  time.Time
+	PurgedAt     *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
+
+// NewStudentDocument validates content against the document type and fills in
+// the checksum, storage key and retention date. The content type is sniffed
+// from the bytes rather than trusted from the client.
+func NewStudentDocument(studentID *int32, documentType, filename string, content []byte, uploadedBy *uuid.UUID, now time.Time) (*StudentDocument, error) {
+	dt := DocumentType(documentType)
+	allowed, ok := allowedDocumentContentTypes[dt]
+	if !ok {
+		return nil, studentErrors.ErrInvalidDocumentType
+	}
+	if len(content) == 0 {
+		return nil, studentErrors.ErrInvalidDocumentFile
+	}
+	if len(content) > MaxDocumentSize {
+		return nil, studentErrors.ErrDocumentTooLarge
+	}
+
+	contentType := http.DetectContentType(content)
+	accepted := false
+	for _, t := range allowed {
+		if contentType == t {
+			accepted = true
+			break
+		}
+	}
+	if !accepted {
+		return nil, studentErrors.ErrInvalidDocumentFile
+	}
+
+	sum := sha256.Sum256(content)
+	id := uuid.New()
+	retention := DocumentRetention
+	if studentID == nil {
+		retention = PendingDocumentRetention
+	}
+
+	return &StudentDocument{
+		ID:           id,
+		StudentID:    studentID,
+		DocumentType: dt,
+		Filename:     documentFilename(filename, id),
+		ContentType:  contentType,
+		SizeBytes:    int64(len(content)),
+		Checksum:     hex.EncodeToString(sum[:]),
+		StorageKey:   "documents/" + id.String(),
+		UploadedBy:   uploadedBy,
+		RetainUntil:  now.Add(retention),
+	}, nil
+}
+
+// documentFilename keeps only the base name of the client's filename so it is
+// safe to echo in a Content-Disposition header.
+func documentFilename(filename string, id uuid.UUID) string {
+	name := filepath.Base(strings.ReplaceAll(strings.TrimSpace(filename), "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == '"' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return id.String()
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
+
+func (d *StudentDocument) IsPurged() bool {
+	return d.PurgedAt != nil
+}
+
+// LinkTo attaches a pending upload to the student who submitted it and
+// extends its retention to the default for linked documents.
+func (d *StudentDocument) LinkTo(studentID int32, now time.Time) error {
+	if d.IsPurged() {
+		return studentErrors.ErrDocumentPurged
+	}
+	if d.StudentID != nil {
+		return studentErrors.ErrDocumentAlreadyLinked
+	}
+	d.StudentID = &studentID
+	d.RetainUntil = now.Add(DocumentRetention)
+	return nil
+}
+
+func (d *StudentDocument) SetRetention(until, now time.Time) error {
+	if d.IsPurged() {
+		return studentErrors.ErrDocumentPurged
+	}
+	if !until.After(now) {
+		return studentErrors.ErrInvalidRetention
+	}
+	d.RetainUntil = until
+	return nil
+}
+
+// MarkPurged records that the stored file has been deleted. The metadata row
+// is kept as a record that the document existed.
+func (d *StudentDocument) MarkPurged(now time.Time) {
+	if d.PurgedAt == nil {
+		d.PurgedAt = &now
+	}
+}
+
+func StudentDocumentFromModel(m *model.StudentDocuments) *StudentDocument {
+	return &StudentDocument{
+		ID:           m.ID,
+		StudentID:    m.StudentID,
+		DocumentType: DocumentType(m.DocumentType),
+		Filename:     m.Filename,
+		ContentType:  m.ContentType,
+		SizeBytes:    m.SizeBytes,
+		Checksum:     m.Checksum,
+		StorageKey:   m.StorageKey,
+		UploadedBy:   m.UploadedBy,
+		RetainUntil:  m.RetainUntil,
+		PurgedAt:     m.PurgedAt,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func (d *StudentDocument) ToModel() model.StudentDocuments {
+	return model.StudentDocuments{
+		ID:           d.ID,
+		StudentID:    d.StudentID,
+		DocumentType: string(d.DocumentType),
+		Filename:     d.Filename,
+		ContentType:  d.ContentType,
+		SizeBytes:    d.SizeBytes,
+		Checksum:     d.Checksum,
+		StorageKey:   d.StorageKey,
+		UploadedBy:   d.UploadedBy,
+		RetainUntil:  d.RetainUntil,
+		PurgedAt:     d.PurgedAt,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
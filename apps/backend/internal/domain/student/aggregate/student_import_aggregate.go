@@ -0,0 +1,193 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HDR3604/HelpDeskApp/internal/helpers/validation"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+)
+
+// Spreadsheet cells use a compact text form for availability and courses so
+// rosters can be edited by hand and round-trip through export and import:
+//
+//	availability  Mon 9-12, 14-16; Wed 10-12
+//	courses       COMP1601:A; COMP1602:B+; INFO1600
+//
+// Availability ranges are whole hours with an exclusive end, so "9-12" is the
+// 9, 10 and 11 o'clock slots. A JSON availability object is also accepted.
+
+var (
+	availabilityDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri"}
+	fullDayNames     = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+)
+
+// parseDay accepts a weekday name or any prefix of it of at least three
+// letters, such as "Wed" or "Thurs".
+func parseDay(name string) (int, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < 3 {
+		return 0, false
+	}
+	for i, full := range fullDayNames {
+		if strings.HasPrefix(full, name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ParseAvailability reads availability from its spreadsheet text form.
+func ParseAvailability(text string) (Availability, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("availability is required")
+	}
+	if strings.HasPrefix(text, "{") {
+		if err := validation.ValidateAvailability(json.RawMessage(text)); err != nil {
+			return nil, err
+		}
+		var avail Availability
+		if err := json.Unmarshal([]byte(text), &avail); err != nil {
+			return nil, err
+		}
+		return avail, nil
+	}
+
+	avail := Availability{}
+	for _, entry := range strings.Split(text, ";") {
+		fields := strings.FieldsFunc(entry, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		if len(fields) == 0 {
+			continue
+		}
+		day, ok := parseDay(fields[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q: use Mon to Fri", fields[0])
+		}
+		if len(fields) == 1 {
+			return nil, fmt.Errorf("no hours given for %s", availabilityDays[day])
+		}
+
+		key := strconv.Itoa(day)
+		hours := map[int]bool{}
+		for _, h := range avail[key] {
+			hours[h] = true
+		}
+		for _, span := range fields[1:] {
+			start, end, err := parseHourSpan(span)
+			if err != nil {
+				return nil, err
+			}
+			for h := start; h < end; h++ {
+				hours[h] = true
+			}
+		}
+		avail[key] = sortedHours(hours)
+	}
+	if len(avail) == 0 {
+		return nil, fmt.Errorf("availability is required")
+	}
+	return avail, nil
+}
+
+func parseHourSpan(span string) (int, int, error) {
+	from, to, isRange := strings.Cut(span, "-")
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid hours %q", span)
+	}
+	end := start + 1
+	if isRange {
+		if end, err = strconv.Atoi(to); err != nil {
+			return 0, 0, fmt.Errorf("invalid hours %q", span)
+		}
+	}
+	if start < 0 || end > 24 || start >= end {
+		return 0, 0, fmt.Errorf("invalid hours %q: must be within 0-24 with the start before the end", span)
+	}
+	return start, end, nil
+}
+
+func sortedHours(set map[int]bool) []int {
+	hours := make([]int, 0, len(set))
+	for h := range set {
+		hours = append(hours, h)
+	}
+	sort.Ints(hours)
+	return hours
+}
+
+// FormatAvailability writes availability in its spreadsheet text form,
+// merging consecutive hours into ranges.
+func FormatAvailability(avail Availability) string {
+	var entries []string
+	for day, name := range availabilityDays {
+		hours := avail[strconv.Itoa(day)]
+		if len(hours) == 0 {
+			continue
+		}
+		set := make(map[int]bool, len(hours))
+		for _, h := range hours {
+			set[h] = true
+		}
+		sorted := sortedHours(set)
+
+		var spans []string
+		start := sorted[0]
+		for i := 1; i <= len(sorted); i++ {
+			if i < len(sorted) && sorted[i] == sorted[i-1]+1 {
+				continue
+			}
+			spans = append(spans, fmt.Sprintf("%d-%d", start, sorted[i-1]+1))
+			if i < len(sorted) {
+				start = sorted[i]
+			}
+		}
+		entries = append(entries, name+" "+strings.Join(spans, ", "))
+	}
+	return strings.Join(entries, "; ")
+}
+
+// ParseCourseList reads courses from their spreadsheet text form. Grades are
+// optional; titles are filled in from the course catalogue on import.
+func ParseCourseList(text string) ([]types.CourseResult, error) {
+	courses := []types.CourseResult{}
+	seen := map[string]bool{}
+	for _, item := range strings.FieldsFunc(text, func(r rune) bool { return r == ';' || r == ',' }) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		code, grade, _ := strings.Cut(item, ":")
+		code = NormalizeCourseCode(code)
+		if code == "" {
+			return nil, fmt.Errorf("invalid course %q", item)
+		}
+		if seen[code] {
+			return nil, fmt.Errorf("course %s is listed twice", code)
+		}
+		seen[code] = true
+
+		course := types.CourseResult{Code: code}
+		if g := normalizeGrade(grade); g != "" {
+			course.Grade = &g
+		}
+		courses = append(courses, course)
+	}
+	return courses, nil
+}
+
+// FormatCourseList writes transcript courses in their spreadsheet text form.
+func FormatCourseList(courses []types.CourseResult) string {
+	items := make([]string, len(courses))
+	for i, c := range courses {
+		items[i] = c.Code
+		if c.Grade != nil && *c.Grade != "" {
+			items[i] += ":" + *c.Grade
+		}
+	}
+	return strings.Join(items, "; ")
+}
@@ -0,0 +1,457 @@
+package aggregate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+)
+
+type TimetableSource string
+
+const (
+	TimetableSource_ICS TimetableSource = "ics"
+	TimetableSource_CSV TimetableSource = "csv"
+)
+
+// TimeBlock is a weekly block of time. Days run 0 (Mon) to 4 (Fri); times
+// are "HH:MM".
+type TimeBlock struct {
+	DayOfWeek int    `json:"day_of_week"`
+	Start     string `json:"start"`
+	End       string `json:"end"`
+}
+
+// ClassSession is one weekly class meeting from a student's timetable.
+type ClassSession struct {
+	CourseCode string `json:"course_code"`
+	TimeBlock
+}
+
+// AvailabilityOverlap is an hour of a student's availability taken by a class.
+type AvailabilityOverlap struct {
+	DayOfWeek  int    `json:"day_of_week"`
+	Hour       int    `json:"hour"`
+	CourseCode string `json:"course_code"`
+}
+
+// StudentTimetable is the class timetable a student imported. It is kept so
+// the proposed availability can be recomputed against current shift times.
+type StudentTimetable struct {
+	StudentID int32
+	Source    TimetableSource
+	Classes   []ClassSession
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func NewStudentTimetable(studentID int32, source TimetableSource, classes []ClassSession) (*StudentTimetable, error) {
+	if len(classes) == 0 {
+		return nil, studentErrors.ErrEmptyTimetable
+	}
+	return &StudentTimetable{
+		StudentID: studentID,
+		Source:    source,
+		Classes:   dedupeClasses(classes),
+	}, nil
+}
+
+// ProposeAvailability returns the hours the help desk is open, per openings,
+// that no class touches. An hour h is the slot from h:00 to h+1:00.
+func (t *StudentTimetable) ProposeAvailability(openings []TimeBlock) Availability {
+	avail := Availability{}
+	for day := range availabilityDays {
+		hours := map[int]bool{}
+		for _, o := range openings {
+			if o.DayOfWeek != day {
+				continue
+			}
+			start, end, ok := o.minutes()
+			if !ok {
+				continue
+			}
+			for h := start / 60; h*60 < end && h < 24; h++ {
+				if !t.busy(day, h) {
+					hours[h] = true
+				}
+			}
+		}
+		if len(hours) > 0 {
+			avail[strconv.Itoa(day)] = sortedHours(hours)
+		}
+	}
+	return avail
+}
+
+// Overlaps returns the hours of avail taken by a class, ordered by day and
+// hour.
+func (t *StudentTimetable) Overlaps(avail Availability) []AvailabilityOverlap {
+	overlaps := []AvailabilityOverlap{}
+	for day := range availabilityDays {
+		hours := map[int]bool{}
+		for _, h := range avail[strconv.Itoa(day)] {
+			hours[h] = true
+		}
+		for _, h := range sortedHours(hours) {
+			for _, c := range t.Classes {
+				if c.DayOfWeek == day && c.overlapsHour(h) {
+					overlaps = append(overlaps, AvailabilityOverlap{DayOfWeek: day, Hour: h, CourseCode: c.CourseCode})
+				}
+			}
+		}
+	}
+	return overlaps
+}
+
+func (t *StudentTimetable) busy(day, hour int) bool {
+	for _, c := range t.Classes {
+		if c.DayOfWeek == day && c.overlapsHour(hour) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b TimeBlock) minutes() (int, int, bool) {
+	start, ok := clockMinutes(b.Start)
+	if !ok {
+		return 0, 0, false
+	}
+	end, ok := clockMinutes(b.End)
+	if !ok || end <= start {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func (b TimeBlock) overlapsHour(hour int) bool {
+	start, end, ok := b.minutes()
+	return ok && start < (hour+1)*60 && end > hour*60
+}
+
+// dedupeClasses drops repeated sessions, as calendar exports often list every
+// week's meeting separately, and orders the rest by day and time.
+func dedupeClasses(classes []ClassSession) []ClassSession {
+	seen := map[ClassSession]bool{}
+	result := make([]ClassSession, 0, len(classes))
+	for _, c := range classes {
+		if !seen[c] {
+			seen[c] = true
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.DayOfWeek != b.DayOfWeek {
+			return a.DayOfWeek < b.DayOfWeek
+		}
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		return a.CourseCode < b.CourseCode
+	})
+	return result
+}
+
+// newClassSession validates and normalizes a session read from a file.
+func newClassSession(course string, day int, start, end string) (ClassSession, error) {
+	course = NormalizeCourseCode(course)
+	if course == "" {
+		return ClassSession{}, fmt.Errorf("course is required")
+	}
+	startMin, ok := clockMinutes(start)
+	if !ok {
+		return ClassSession{}, fmt.Errorf("invalid start time %q (expected HH:MM)", start)
+	}
+	endMin, ok := clockMinutes(end)
+	if !ok {
+		return ClassSession{}, fmt.Errorf("invalid end time %q (expected HH:MM)", end)
+	}
+	if endMin <= startMin || endMin > 24*60 {
+		return ClassSession{}, fmt.Errorf("class must end after it starts")
+	}
+	return ClassSession{
+		CourseCode: course,
+		TimeBlock:  TimeBlock{DayOfWeek: day, Start: formatClock(startMin), End: formatClock(endMin)},
+	}, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// ParseTimetableCSV reads classes from spreadsheet rows of course, day, start
+// and end, such as "COMP1601, Mon, 09:00, 11:00". The times may instead share
+// one cell as "09:00-11:00". A header row starting with "course" is skipped.
+func ParseTimetableCSV(rows [][]string) ([]ClassSession, error) {
+	var classes []ClassSession
+	for i, row := range rows {
+		cells := make([]string, 0, len(row))
+		for _, cell := range row {
+			if cell = strings.TrimSpace(cell); cell != "" {
+				cells = append(cells, cell)
+			}
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		if i == 0 && strings.EqualFold(cells[0], "course") {
+			continue
+		}
+
+		if len(cells) == 3 {
+			start, end, ok := strings.Cut(cells[2], "-")
+			if !ok {
+				return nil, fmt.Errorf("%w: row %d: expected times as start-end", studentErrors.ErrInvalidTimetable, i+1)
+			}
+			cells = []string{cells[0], cells[1], start, end}
+		}
+		if len(cells) != 4 {
+			return nil, fmt.Errorf("%w: row %d: expected course, day, start and end", studentErrors.ErrInvalidTimetable, i+1)
+		}
+
+		day, ok := parseDay(cells[1])
+		if !ok {
+			return nil, fmt.Errorf("%w: row %d: invalid day %q: use Mon to Fri", studentErrors.ErrInvalidTimetable, i+1, cells[1])
+		}
+		class, err := newClassSession(cells[0], day, strings.TrimSpace(cells[2]), strings.TrimSpace(cells[3]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: %w", studentErrors.ErrInvalidTimetable, i+1, err)
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+var icsCourseCode = regexp.MustCompile(`(?i)\b[A-Z]{2,8}\s?[0-9]{3,5}[A-Z]?\b`)
+
+var icsWeekdays = map[string]int{"MO": 0, "TU": 1, "WE": 2, "TH": 3, "FR": 4}
+
+// ParseTimetableICS reads classes from the events of an iCalendar file. The
+// course is the course code in the event summary, or the summary itself.
+// Times are converted to loc; floating times are taken as written. A weekly
+// RRULE with BYDAY adds a session for each listed day. Weekend and all-day
+// events are skipped.
+func ParseTimetableICS(data []byte, loc *time.Location) ([]ClassSession, error) {
+	lines := unfoldICSLines(data)
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, fmt.Errorf("%w: not an iCalendar file", studentErrors.ErrInvalidTimetable)
+	}
+
+	var classes []ClassSession
+	var event map[string]icsProperty
+	nested := 0 // components inside the event, such as VALARM
+	for _, line := range lines {
+		upper := strings.ToUpper(line)
+		switch {
+		case upper == "BEGIN:VEVENT":
+			event = map[string]icsProperty{}
+			nested = 0
+		case upper == "END:VEVENT":
+			if event == nil {
+				continue
+			}
+			sessions, err := icsEventClasses(event, loc)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", studentErrors.ErrInvalidTimetable, err)
+			}
+			classes = append(classes, sessions...)
+			event = nil
+		case event == nil:
+		case strings.HasPrefix(upper, "BEGIN:"):
+			nested++
+		case strings.HasPrefix(upper, "END:"):
+			nested--
+		case nested == 0:
+			prop := parseICSProperty(line)
+			event[prop.name] = prop
+		}
+	}
+	return classes, nil
+}
+
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// unfoldICSLines splits the file into logical lines, joining continuation
+// lines, which start with a space or tab.
+func unfoldICSLines(data []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func parseICSProperty(line string) icsProperty {
+	head, value, _ := strings.Cut(line, ":")
+	parts := strings.Split(head, ";")
+	prop := icsProperty{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: value}
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		prop.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return prop
+}
+
+func icsEventClasses(event map[string]icsProperty, loc *time.Location) ([]ClassSession, error) {
+	startProp, ok := event["DTSTART"]
+	if !ok || startProp.params["VALUE"] == "DATE" {
+		return nil, nil
+	}
+	start, err := parseICSTime(startProp, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var end time.Time
+	if endProp, ok := event["DTEND"]; ok {
+		if end, err = parseICSTime(endProp, loc); err != nil {
+			return nil, err
+		}
+	} else if durProp, ok := event["DURATION"]; ok {
+		d, err := parseICSDuration(durProp.value)
+		if err != nil {
+			return nil, err
+		}
+		end = start.Add(d)
+	} else {
+		return nil, nil
+	}
+
+	summary := strings.TrimSpace(event["SUMMARY"].value)
+	course := icsCourseCode.FindString(summary)
+	if course == "" {
+		course = summary
+	}
+
+	days := []int{}
+	if rule, ok := event["RRULE"]; ok {
+		for _, part := range strings.Split(rule.value, ";") {
+			k, v, _ := strings.Cut(part, "=")
+			if !strings.EqualFold(k, "BYDAY") {
+				continue
+			}
+			for _, d := range strings.Split(v, ",") {
+				// Drop ordinals such as the 1 in 1MO.
+				d = strings.TrimLeft(strings.ToUpper(d), "+-0123456789")
+				if day, ok := icsWeekdays[d]; ok {
+					days = append(days, day)
+				}
+			}
+		}
+	}
+	if len(days) == 0 {
+		wd := int(start.Weekday())
+		if wd == 0 || wd == 6 {
+			return nil, nil
+		}
+		days = append(days, wd-1)
+	}
+
+	startClock := start.Hour()*60 + start.Minute()
+	endClock := end.Hour()*60 + end.Minute()
+	if end.YearDay() != start.YearDay() {
+		endClock = 24 * 60
+	}
+
+	sessions := make([]ClassSession, 0, len(days))
+	for _, day := range days {
+		class, err := newClassSession(course, day, formatClock(startClock), formatClock(endClock))
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", summary, err)
+		}
+		sessions = append(sessions, class)
+	}
+	return sessions, nil
+}
+
+func parseICSTime(prop icsProperty, loc *time.Location) (time.Time, error) {
+	value := prop.value
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q", value)
+		}
+		return t.In(loc), nil
+	}
+
+	zone := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if z, err := time.LoadLocation(tzid); err == nil {
+			zone = z
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", value)
+	}
+	if zone == time.UTC {
+		// Floating time: the clock time as written.
+		return t, nil
+	}
+	return t.In(loc), nil
+}
+
+// parseICSDuration reads the time part of a duration such as PT1H30M.
+func parseICSDuration(value string) (time.Duration, error) {
+	_, rest, ok := strings.Cut(strings.ToUpper(value), "PT")
+	if !ok {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	d, err := time.ParseDuration(strings.ToLower(rest))
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+func StudentTimetableFromModel(m *model.StudentTimetables) (*StudentTimetable, error) {
+	classes := []ClassSession{}
+	if err := json.Unmarshal([]byte(m.Classes), &classes); err != nil {
+		return nil, err
+	}
+	return &StudentTimetable{
+		StudentID: m.StudentID,
+		Source:    TimetableSource(m.Source),
+		Classes:   classes,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
+
+func (t *StudentTimetable) ToModel() model.StudentTimetables {
+	classes := t.Classes
+	if classes == nil {
+		classes = []ClassSession{}
+	}
+	classesJSON, _ := json.Marshal(classes)
+
+	return model.StudentTimetables{
+		StudentID: t.StudentID,
+		Source:    string(t.Source),
+		Classes:   string(classesJSON),
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
@@ -0,0 +1,267 @@
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/google/uuid"
+)
+
+// TrainingReminderDays is how many days before a completion expires its
+// student is reminded to renew it.
+const TrainingReminderDays = 30
+
+// TrainingRequirement is a course or module students must complete, such as
+// orientation or the privacy module. A blocking requirement keeps students
+// who have not completed it out of generated schedules.
+type TrainingRequirement struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	IsBlocking  bool
+	// ValidityMonths is how long a completion lasts; nil means it never
+	// expires.
+	ValidityMonths *int32
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
+
+func NewTrainingRequirement(name string, description *string, isBlocking bool, validityMonths *int32) (*TrainingRequirement, error) {
+	r := &TrainingRequirement{
+		ID:         uuid.New(),
+		IsBlocking: isBlocking,
+		IsActive:   true,
+	}
+	if err := r.SetName(name); err != nil {
+		return nil, err
+	}
+	if err := r.SetValidity(validityMonths); err != nil {
+		return nil, err
+	}
+	r.SetDescription(description)
+	return r, nil
+}
+
+func (r *TrainingRequirement) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return studentErrors.ErrInvalidTrainingName
+	}
+	r.Name = name
+	return nil
+}
+
+func (r *TrainingRequirement) SetDescription(description *string) {
+	r.Description = nil
+	if description != nil {
+		if d := strings.TrimSpace(*description); d != "" {
+			r.Description = &d
+		}
+	}
+}
+
+func (r *TrainingRequirement) SetValidity(validityMonths *int32) error {
+	if validityMonths != nil && *validityMonths <= 0 {
+		return studentErrors.ErrInvalidTrainingValidity
+	}
+	r.ValidityMonths = validityMonths
+	return nil
+}
+
+// ExpiryFor returns when a completion on completedOn expires, or nil if
+// completions never expire.
+func (r *TrainingRequirement) ExpiryFor(completedOn time.Time) *time.Time {
+	if r.ValidityMonths == nil {
+		return nil
+	}
+	expires := dateOf(completedOn).AddDate(0, int(*r.ValidityMonths), 0)
+	return &expires
+}
+
+// TrainingRecord is a student's completion of a requirement. A student has
+// one record per requirement; a renewal replaces it.
+type TrainingRecord struct {
+	ID            uuid.UUID
+	StudentID     int32
+	RequirementID uuid.UUID
+	CompletedOn   time.Time
+	// ExpiresOn is the first day the completion no longer counts; nil if it
+	// never expires.
+	ExpiresOn          *time.Time
+	EvidenceDocumentID *uuid.UUID
+	RecordedBy         *uuid.UUID
+	ReminderSentAt     *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          *time.Time
+}
+
+// NewTrainingRecord records a completion of requirement. expiresOn overrides
+// the expiry computed from the requirement's validity, for certificates that
+// state their own date.
+func NewTrainingRecord(requirement *TrainingRequirement, studentID int32, completedOn time.Time, expiresOn *time.Time, recordedBy *uuid.UUID, now time.Time) (*TrainingRecord, error) {
+	if !requirement.IsActive {
+		return nil, studentErrors.ErrTrainingRequirementInactive
+	}
+
+	completedOn = dateOf(completedOn)
+	if completedOn.After(dateOf(now)) {
+		return nil, studentErrors.ErrInvalidTrainingDates
+	}
+
+	if expiresOn != nil {
+		e := dateOf(*expiresOn)
+		if !e.After(completedOn) {
+			return nil, studentErrors.ErrInvalidTrainingDates
+		}
+		expiresOn = &e
+	} else {
+		expiresOn = requirement.ExpiryFor(completedOn)
+	}
+
+	return &TrainingRecord{
+		ID:            uuid.New(),
+		StudentID:     studentID,
+		RequirementID: requirement.ID,
+		CompletedOn:   completedOn,
+		ExpiresOn:     expiresOn,
+		RecordedBy:    recordedBy,
+	}, nil
+}
+
+// IsValidOn reports whether the completion still counts on date.
+func (r *TrainingRecord) IsValidOn(date time.Time) bool {
+	return r.ExpiresOn == nil || dateOf(date).Before(*r.ExpiresOn)
+}
+
+// NeedsReminder reports whether the completion expires within
+// TrainingReminderDays of now and its student has not been reminded yet.
+func (r *TrainingRecord) NeedsReminder(now time.Time) bool {
+	if r.ReminderSentAt != nil || r.ExpiresOn == nil || !r.IsValidOn(now) {
+		return false
+	}
+	return r.ExpiresOn.Before(dateOf(now).AddDate(0, 0, TrainingReminderDays+1))
+}
+
+type TrainingState string
+
+const (
+	TrainingState_Complete TrainingState = "complete"
+	TrainingState_Expiring TrainingState = "expiring"
+	TrainingState_Expired  TrainingState = "expired"
+	TrainingState_Missing  TrainingState = "missing"
+)
+
+// TrainingStatus is where a student stands on one requirement.
+type TrainingStatus struct {
+	Requirement *TrainingRequirement
+	Record      *TrainingRecord // nil when the student has no record
+	State       TrainingState
+}
+
+// TrainingStatuses returns a student's status on each active requirement, in
+// the order given. records are the student's own records.
+func TrainingStatuses(requirements []*TrainingRequirement, records []*TrainingRecord, asOf time.Time) []TrainingStatus {
+	byRequirement := make(map[uuid.UUID]*TrainingRecord, len(records))
+	for _, rec := range records {
+		byRequirement[rec.RequirementID] = rec
+	}
+
+	remindFrom := dateOf(asOf).AddDate(0, 0, TrainingReminderDays+1)
+	statuses := make([]TrainingStatus, 0, len(requirements))
+	for _, req := range requirements {
+		if !req.IsActive {
+			continue
+		}
+		status := TrainingStatus{Requirement: req, Record: byRequirement[req.ID], State: TrainingState_Missing}
+		switch {
+		case status.Record == nil:
+		case !status.Record.IsValidOn(asOf):
+			status.State = TrainingState_Expired
+		case status.Record.ExpiresOn != nil && status.Record.ExpiresOn.Before(remindFrom):
+			status.State = TrainingState_Expiring
+		default:
+			status.State = TrainingState_Complete
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// MissingBlockingTraining returns the active blocking requirements a student
+// has no completion valid on asOf for. records are the student's own records.
+func MissingBlockingTraining(requirements []*TrainingRequirement, records []*TrainingRecord, asOf time.Time) []*TrainingRequirement {
+	var missing []*TrainingRequirement
+	for _, status := range TrainingStatuses(requirements, records, asOf) {
+		if !status.Requirement.IsBlocking {
+			continue
+		}
+		if status.State == TrainingState_Missing || status.State == TrainingState_Expired {
+			missing = append(missing, status.Requirement)
+		}
+	}
+	return missing
+}
+
+// dateOf drops the time of day, keeping the calendar date.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func TrainingRequirementFromModel(m *model.TrainingRequirements) *TrainingRequirement {
+	return &TrainingRequirement{
+		ID:             m.ID,
+		Name:           m.Name,
+		Description:    m.Description,
+		IsBlocking:     m.IsBlocking,
+		ValidityMonths: m.ValidityMonths,
+		IsActive:       m.IsActive,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func (r *TrainingRequirement) ToModel() model.TrainingRequirements {
+	return model.TrainingRequirements{
+		ID:             r.ID,
+		Name:           r.Name,
+		Description:    r.Description,
+		IsBlocking:     r.IsBlocking,
+		ValidityMonths: r.ValidityMonths,
+		IsActive:       r.IsActive,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func TrainingRecordFromModel(m *model.TrainingRecords) *TrainingRecord {
+	return &TrainingRecord{
+		ID:                 m.ID,
+		StudentID:          m.StudentID,
+		RequirementID:      m.RequirementID,
+		CompletedOn:        m.CompletedOn,
+		ExpiresOn:          m.ExpiresOn,
+		EvidenceDocumentID: m.EvidenceDocumentID,
+		RecordedBy:         m.RecordedBy,
+		ReminderSentAt:     m.ReminderSentAt,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
+
+func (r *TrainingRecord) ToModel() model.TrainingRecords {
+	return model.TrainingRecords{
+		ID:                 r.ID,
+		StudentID:          r.StudentID,
+		RequirementID:      r.RequirementID,
+		CompletedOn:        r.CompletedOn,
+		ExpiresOn:          r.ExpiresOn,
+		EvidenceDocumentID: r.EvidenceDocumentID,
+		RecordedBy:         r.RecordedBy,
+		ReminderSentAt:     r.ReminderSentAt,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+}
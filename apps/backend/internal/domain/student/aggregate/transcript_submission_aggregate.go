@@ -0,0 +1,300 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
+)
+
+type TranscriptSubmissionStatus string
+
+const (
+	TranscriptSubmissionStatus_Pending    TranscriptSubmissionStatus = "pending"
+	TranscriptSubmissionStatus_Approved   TranscriptSubmissionStatus = "approved"
+	TranscriptSubmissionStatus_Rejected   TranscriptSubmissionStatus = "rejected"
+	TranscriptSubmissionStatus_Superseded TranscriptSubmissionStatus = "superseded"
+)
+
+// ParseTranscriptSubmissionStatus validates a status filter.
+func ParseTranscriptSubmissionStatus(status string) (TranscriptSubmissionStatus, error) {
+	switch s := TranscriptSubmissionStatus(strings.ToLower(strings.TrimSpace(status))); s {
+	case TranscriptSubmissionStatus_Pending, TranscriptSubmissionStatus_Approved,
+		TranscriptSubmissionStatus_Rejected, TranscriptSubmissionStatus_Superseded:
+		return s, nil
+	}
+	return "", studentErrors.ErrInvalidSubmissionStatus
+}
+
+// GradeChange is a course whose best grade differs between transcripts.
+type GradeChange struct {
+	Code  string  `json:"code"`
+	Title string  `json:"title"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
+
+type GPAChange struct {
+	From *float64 `json:"from"`
+	To   *float64 `json:"to"`
+}
+
+type YearChange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type TextChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// TranscriptDiff lists what a re-submitted transcript changes on the one on
+// record. Courses are compared on their best grade, as eligibility is.
+type TranscriptDiff struct {
+	NewCourses       []types.CourseResult `json:"new_courses"`
+	ChangedGrades    []GradeChange        `json:"changed_grades"`
+	RemovedCourses   []types.CourseResult `json:"removed_courses"`
+	OverallGPA       *GPAChange           `json:"overall_gpa,omitempty"`
+	DegreeGPA        *GPAChange           `json:"degree_gpa,omitempty"`
+	CurrentYear      *YearChange          `json:"current_year,omitempty"`
+	CurrentProgramme *TextChange          `json:"current_programme,omitempty"`
+	Major            *TextChange          `json:"major,omitempty"`
+}
+
+// DiffTranscripts compares a newly extracted transcript with the one on
+// record. Year, programme and major only count as changed when the new
+// transcript has a value, matching what UpdateTranscript applies.
+func DiffTranscripts(current, next types.TranscriptMetadata) TranscriptDiff {
+	diff := TranscriptDiff{
+		NewCourses:     []types.CourseResult{},
+		ChangedGrades:  []GradeChange{},
+		RemovedCourses: []types.CourseResult{},
+	}
+
+	before := bestGrades(current.Courses)
+	after := bestGrades(next.Courses)
+	for code, c := range after {
+		prev, ok := before[code]
+		switch {
+		case !ok:
+			diff.NewCourses = append(diff.NewCourses, c)
+		case gradeKey(prev.Grade) != gradeKey(c.Grade):
+			diff.ChangedGrades = append(diff.ChangedGrades, GradeChange{Code: c.Code, Title: c.Title, From: prev.Grade, To: c.Grade})
+		}
+	}
+	for code, c := range before {
+		if _, ok := after[code]; !ok {
+			diff.RemovedCourses = append(diff.RemovedCourses, c)
+		}
+	}
+	sort.Slice(diff.NewCourses, func(i, j int) bool { return diff.NewCourses[i].Code < diff.NewCourses[j].Code })
+	sort.Slice(diff.ChangedGrades, func(i, j int) bool { return diff.ChangedGrades[i].Code < diff.ChangedGrades[j].Code })
+	sort.Slice(diff.RemovedCourses, func(i, j int) bool { return diff.RemovedCourses[i].Code < diff.RemovedCourses[j].Code })
+
+	if !sameGPA(current.OverallGPA, next.OverallGPA) {
+		diff.OverallGPA = &GPAChange{From: current.OverallGPA, To: next.OverallGPA}
+	}
+	if !sameGPA(current.DegreeGPA, next.DegreeGPA) {
+		diff.DegreeGPA = &GPAChange{From: current.DegreeGPA, To: next.DegreeGPA}
+	}
+	if next.CurrentYear != 0 && next.CurrentYear != current.CurrentYear {
+		diff.CurrentYear = &YearChange{From: current.CurrentYear, To: next.CurrentYear}
+	}
+	if p := strings.TrimSpace(next.CurrentProgramme); p != "" && p != strings.TrimSpace(current.CurrentProgramme) {
+		diff.CurrentProgramme = &TextChange{From: current.CurrentProgramme, To: next.CurrentProgramme}
+	}
+	if m := strings.TrimSpace(next.Major); m != "" && m != strings.TrimSpace(current.Major) {
+		diff.Major = &TextChange{From: current.Major, To: next.Major}
+	}
+	return diff
+}
+
+// IsEmpty reports whether the new transcript changes nothing.
+func (d TranscriptDiff) IsEmpty() bool {
+	return len(d.NewCourses) == 0 && len(d.ChangedGrades) == 0 && len(d.RemovedCourses) == 0 &&
+		d.OverallGPA == nil && d.DegreeGPA == nil && d.CurrentYear == nil &&
+		d.CurrentProgramme == nil && d.Major == nil
+}
+
+// bestGrades keys courses by normalized code, keeping the best letter grade
+// of a course taken more than once.
+func bestGrades(courses []types.CourseResult) map[string]types.CourseResult {
+	best := make(map[string]types.CourseResult)
+	for _, c := range courses {
+		key := NormalizeCourseCode(c.Code)
+		if key == "" {
+			continue
+		}
+		existing, ok := best[key]
+		if !ok ||
+			(c.Grade != nil && IsValidGrade(*c.Grade) && (existing.Grade == nil || !IsValidGrade(*existing.Grade) || GradeAtLeast(*c.Grade, *existing.Grade))) ||
+			(existing.Grade == nil && c.Grade != nil) {
+			best[key] = c
+		}
+	}
+	return best
+}
+
+func gradeKey(grade *string) string {
+	if grade == nil {
+		return ""
+	}
+	return normalizeGrade(*grade)
+}
+
+// sameGPA compares GPAs to the two decimal places transcripts print.
+func sameGPA(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return math.Round(*a*100) == math.Round(*b*100)
+}
+
+// TranscriptSubmission is a transcript a student re-submitted after applying.
+// It is applied to the student only once an admin approves it.
+type TranscriptSubmission struct {
+	ID         uuid.UUID
+	StudentID  int32
+	DocumentID *uuid.UUID
+	Status     TranscriptSubmissionStatus
+	Transcript types.TranscriptMetadata
+	Diff       TranscriptDiff // against the transcript on record when submitted
+	ReviewedBy *uuid.UUID
+	ReviewedAt *time.Time
+	ReviewNote *string
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+// NewTranscriptSubmission checks that the transcript belongs to the student
+// and records what it would change.
+func NewTranscriptSubmission(student *Student, transcript types.TranscriptMetadata, documentID *uuid.UUID) (*TranscriptSubmission, error) {
+	if !student.MatchesTranscript(transcriptIdentity(transcript)) {
+		return nil, studentErrors.ErrTranscriptMismatch
+	}
+
+	diff := DiffTranscripts(student.TranscriptMetadata, transcript)
+	if diff.IsEmpty() {
+		return nil, studentErrors.ErrTranscriptUnchanged
+	}
+
+	return &TranscriptSubmission{
+		ID:         uuid.New(),
+		StudentID:  student.StudentID,
+		DocumentID: documentID,
+		Status:     TranscriptSubmissionStatus_Pending,
+		Transcript: transcript,
+		Diff:       diff,
+	}, nil
+}
+
+func transcriptIdentity(t types.TranscriptMetadata) TranscriptIdentity {
+	return TranscriptIdentity{FirstName: t.FirstName, LastName: t.LastName, StudentID: t.StudentID}
+}
+
+func (s *TranscriptSubmission) IsPending() bool {
+	return s.Status == TranscriptSubmissionStatus_Pending
+}
+
+// ApplyTo updates the student's transcript from the submission.
+func (s *TranscriptSubmission) ApplyTo(student *Student) error {
+	t := s.Transcript
+	var year *int
+	if t.CurrentYear != 0 {
+		year = &t.CurrentYear
+	}
+	var programme, major *string
+	if strings.TrimSpace(t.CurrentProgramme) != "" {
+		programme = &t.CurrentProgramme
+	}
+	if strings.TrimSpace(t.Major) != "" {
+		major = &t.Major
+	}
+	return student.UpdateTranscript(transcriptIdentity(t), t.Courses, t.OverallGPA, t.DegreeGPA, year, programme, major)
+}
+
+func (s *TranscriptSubmission) Approve(reviewedBy *uuid.UUID, note string, now time.Time) error {
+	return s.review(TranscriptSubmissionStatus_Approved, reviewedBy, note, now)
+}
+
+func (s *TranscriptSubmission) Reject(reviewedBy *uuid.UUID, note string, now time.Time) error {
+	return s.review(TranscriptSubmissionStatus_Rejected, reviewedBy, note, now)
+}
+
+// Supersede closes a pending submission replaced by a newer one.
+func (s *TranscriptSubmission) Supersede(now time.Time) error {
+	if !s.IsPending() {
+		return studentErrors.ErrTranscriptSubmissionNotPending
+	}
+	s.Status = TranscriptSubmissionStatus_Superseded
+	s.ReviewedAt = &now
+	return nil
+}
+
+func (s *TranscriptSubmission) review(status TranscriptSubmissionStatus, reviewedBy *uuid.UUID, note string, now time.Time) error {
+	if !s.IsPending() {
+		return studentErrors.ErrTranscriptSubmissionNotPending
+	}
+	note, err := normalizeOptionalNote(note)
+	if err != nil {
+		return err
+	}
+	s.Status = status
+	s.ReviewedBy = reviewedBy
+	s.ReviewedAt = &now
+	if note != "" {
+		s.ReviewNote = &note
+	}
+	return nil
+}
+
+func TranscriptSubmissionFromModel(m *model.TranscriptSubmissions) (*TranscriptSubmission, error) {
+	var transcript types.TranscriptMetadata
+	if err := json.Unmarshal([]byte(m.TranscriptMetadata), &transcript); err != nil {
+		return nil, err
+	}
+	var diff TranscriptDiff
+	if err := json.Unmarshal([]byte(m.Diff), &diff); err != nil {
+		return nil, err
+	}
+
+	return &TranscriptSubmission{
+		ID:         m.ID,
+		StudentID:  m.StudentID,
+		DocumentID: m.DocumentID,
+		Status:     TranscriptSubmissionStatus(m.Status),
+		Transcript: transcript,
+		Diff:       diff,
+		ReviewedBy: m.ReviewedBy,
+		ReviewedAt: m.ReviewedAt,
+		ReviewNote: m.ReviewNote,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}, nil
+}
+
+func (s *TranscriptSubmission) ToModel() model.TranscriptSubmissions {
+	transcriptJSON, _ := json.Marshal(s.Transcript)
+	diffJSON, _ := json.Marshal(s.Diff)
+
+	return model.TranscriptSubmissions{
+		ID:                 s.ID,
+		StudentID:          s.StudentID,
+		DocumentID:         s.DocumentID,
+		Status:             string(s.Status),
+		TranscriptMetadata: string(transcriptJSON),
+		Diff:               string(diffJSON),
+		ReviewedBy:         s.ReviewedBy,
+		ReviewedAt:         s.ReviewedAt,
+		ReviewNote:         s.ReviewNote,
+		CreatedAt:          s.CreatedAt,
+		UpdatedAt:          s.UpdatedAt,
+	}
+}
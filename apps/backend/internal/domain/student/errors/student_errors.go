@@ -24,4 +24,80 @@ var (
 	ErrInvalidPhone           = errors.New("invalid phone number")
 	ErrInvalidStudentID       = errors.New("invalid student ID")
 	ErrTranscriptMismatch     = errors.New("transcript does not belong to this student")
+
+	ErrInvalidGrade                 = errors.New("invalid grade (expected a letter grade such as A+, B- or C)")
+	ErrInvalidCourseCode            = errors.New("invalid course code (expected a code such as COMP1601)")
+	ErrInvalidCourseLevel           = errors.New("invalid course level (must be 1-9)")
+	ErrInvalidEligibilityRuleTarget = errors.New("eligibility rule must set exactly one of course_code or course_level")
+	ErrEligibilityRuleNotFound      = errors.New("eligibility rule not found")
+	ErrEligibilityRuleExists        = errors.New("an eligibility rule already exists for this course or level")
+	ErrOverrideReasonRequired       = errors.New("override reason is required")
+	ErrEligibilityOverrideNotFound  = errors.New("eligibility override not found")
+
+	ErrReviewStageNotFound      = errors.New("review stage not found")
+	ErrReviewStageExists        = errors.New("a review stage with this name already exists")
+	ErrInvalidReviewStageName   = errors.New("invalid review stage name (must be 1-50 characters)")
+	ErrInvalidReviewPosition    = errors.New("invalid position (must be at least 1)")
+	ErrReviewStageInactive      = errors.New("review stage is not active")
+	ErrNoActiveReviewStages     = errors.New("no active review stages are configured")
+	ErrAlreadyInReviewStage     = errors.New("application is already in this review stage")
+	ErrApplicationDecided       = errors.New("application has already been accepted or rejected")
+	ErrReviewCriterionNotFound  = errors.New("review criterion not found")
+	ErrReviewCriterionExists    = errors.New("a review criterion with this name already exists")
+	ErrInvalidReviewCriterion   = errors.New("invalid review criterion name (must be 1-100 characters)")
+	ErrInvalidMaxScore          = errors.New("invalid max score (must be 1-10)")
+	ErrReviewCriterionInactive  = errors.New("review criterion is not active")
+	ErrInvalidScore             = errors.New("score must be between 0 and the criterion's max score")
+	ErrInvalidReviewNote        = errors.New("note must be 1-5000 characters")
+	ErrReviewerNotFound         = errors.New("reviewer not found")
+	ErrInvalidReviewer          = errors.New("reviewer must be an active admin")
+	ErrInterviewSlotNotFound    = errors.New("interview slot not found")
+	ErrInvalidInterviewSlot     = errors.New("interview slot must end after it starts")
+	ErrInvalidInterviewLocation = errors.New("interview location is required (max 255 characters)")
+	ErrUnknownInterviewLocation = errors.New("interview location_id does not match a location")
+	ErrInterviewSlotInPast      = errors.New("interview slot has already started")
+	ErrInterviewSlotBooked      = errors.New("interview slot is already booked")
+	ErrInterviewSlotNotBooked   = errors.New("interview slot is not booked")
+	ErrInterviewAlreadyBooked   = errors.New("applicant already has an interview booked")
+
+	ErrInvalidStudentImport = errors.New("invalid student import file")
+	ErrInvalidExportColumn  = errors.New("unknown export column")
+	ErrInvalidExportFormat  = errors.New("invalid export format (expected csv or xlsx)")
+
+	ErrInvalidStudentStatus = errors.New("invalid status (expected pending, accepted, rejected or deactivated)")
+	ErrInvalidStudentSort   = errors.New("invalid sort (expected applied_at, name, student_id or gpa)")
+	ErrInvalidStudentCursor = errors.New("invalid or expired cursor")
+	ErrInvalidStudentFilter = errors.New("invalid student filter")
+
+	ErrDocumentNotFound      = errors.New("document not found")
+	ErrInvalidDocumentType   = errors.New("invalid document type (expected transcript, id, work_permit or training_certificate)")
+	ErrInvalidDocumentFile   = errors.New("invalid document file (transcripts must be PDF; other documents PDF, PNG or JPEG)")
+	ErrDocumentTooLarge      = errors.New("document exceeds the 10 MB limit")
+	ErrDocumentAlreadyLinked = errors.New("document is already linked to a student")
+	ErrDocumentPurged        = errors.New("document has been purged under the retention policy")
+	ErrInvalidRetention      = errors.New("retain_until must be in the future")
+
+	ErrTranscriptSubmissionNotFound   = errors.New("transcript submission not found")
+	ErrTranscriptUnchanged            = errors.New("transcript has no changes from the one on record")
+	ErrTranscriptSubmissionNotPending = errors.New("transcript submission has already been reviewed")
+	ErrInvalidSubmissionStatus        = errors.New("invalid status (expected pending, approved, rejected or superseded)")
+
+	ErrProfileChangeNotFound      = errors.New("profile change request not found")
+	ErrProfileChangeNotPending    = errors.New("profile change request has already been reviewed")
+	ErrNoProfileChanges           = errors.New("request does not change availability or weekly hours")
+	ErrInvalidAvailability        = errors.New("invalid availability")
+	ErrInvalidWeeklyHours         = errors.New("invalid weekly hours (must be non-negative, with min_weekly_hours at most max_weekly_hours)")
+	ErrInvalidChangeRequestStatus = errors.New("invalid status (expected pending, approved, rejected or superseded)")
+
+	ErrTimetableNotFound = errors.New("no timetable has been imported")
+	ErrInvalidTimetable  = errors.New("invalid timetable file")
+	ErrEmptyTimetable    = errors.New("timetable has no weekday classes")
+
+	ErrTrainingRequirementNotFound = errors.New("training requirement not found")
+	ErrTrainingRecordNotFound      = errors.New("training record not found")
+	ErrDuplicateTrainingName       = errors.New("a training requirement with this name already exists")
+	ErrInvalidTrainingName         = errors.New("training name is required (max 100 characters)")
+	ErrInvalidTrainingValidity     = errors.New("validity_months must be positive")
+	ErrInvalidTrainingDates        = errors.New("completed_on cannot be in the future and expires_on must be after it")
+	ErrTrainingRequirementInactive = errors.New("training requirement is inactive")
 )
@@ -0,0 +1,496 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ApplicationReviewHandler struct {
+	logger  *zap.Logger
+	service service.ApplicationReviewServiceInterface
+}
+
+func NewApplicationReviewHandler(logger *zap.Logger, service service.ApplicationReviewServiceInterface) *ApplicationReviewHandler {
+	return &ApplicationReviewHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *ApplicationReviewHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/applications", func(r chi.Router) {
+		r.Get("/", h.ListQueue)
+		r.Get("/stages", h.ListStages)
+		r.Post("/stages", h.CreateStage)
+		r.Put("/stages/{id}", h.UpdateStage)
+		r.Get("/criteria", h.ListCriteria)
+		r.Post("/criteria", h.CreateCriterion)
+		r.Put("/criteria/{id}", h.UpdateCriterion)
+		r.Get("/{studentID}", h.GetReview)
+		r.Post("/{studentID}/stage", h.MoveStage)
+		r.Put("/{studentID}/reviewers/{userID}", h.AssignReviewer)
+		r.Delete("/{studentID}/reviewers/{userID}", h.UnassignReviewer)
+		r.Post("/{studentID}/notes", h.AddNote)
+		r.Put("/{studentID}/scores/{criterionID}", h.Score)
+	})
+	r.Route("/interview-slots", func(r chi.Router) {
+		r.Get("/", h.ListInterviewSlots)
+		r.Post("/", h.CreateInterviewSlot)
+		r.Delete("/{id}", h.DeleteInterviewSlot)
+		r.Put("/{id}/booking", h.BookInterview)
+		r.Delete("/{id}/booking", h.CancelInterview)
+	})
+}
+
+// --- Stages and rubric ---
+
+func (h *ApplicationReviewHandler) ListStages(w http.ResponseWriter, r *http.Request) {
+	stages, err := h.service.ListStages(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ReviewStagesToResponse(stages))
+}
+
+func (h *ApplicationReviewHandler) CreateStage(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateReviewStageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	stage, err := h.service.CreateStage(r.Context(), req.Name, req.Position)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.ReviewStageToResponse(stage))
+}
+
+func (h *ApplicationReviewHandler) UpdateStage(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid stage ID")
+		return
+	}
+
+	var req dtos.UpdateReviewStageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	stage, err := h.service.UpdateStage(r.Context(), id, service.UpdateReviewStageParams{
+		Name:     req.Name,
+		Position: req.Position,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ReviewStageToResponse(stage))
+}
+
+func (h *ApplicationReviewHandler) ListCriteria(w http.ResponseWriter, r *http.Request) {
+	criteria, err := h.service.ListCriteria(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ReviewCriteriaToResponse(criteria))
+}
+
+func (h *ApplicationReviewHandler) CreateCriterion(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateReviewCriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	criterion, err := h.service.CreateCriterion(r.Context(), req.Name, req.Description, req.MaxScore, req.Position)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.ReviewCriterionToResponse(criterion))
+}
+
+func (h *ApplicationReviewHandler) UpdateCriterion(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid criterion ID")
+		return
+	}
+
+	var req dtos.UpdateReviewCriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	criterion, err := h.service.UpdateCriterion(r.Context(), id, service.UpdateReviewCriterionParams{
+		Name:        req.Name,
+		Description: req.Description,
+		MaxScore:    req.MaxScore,
+		Position:    req.Position,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ReviewCriterionToResponse(criterion))
+}
+
+// --- Applications ---
+
+// ListQueue accepts stage_id and reviewer_id filters; reviewer_id=me selects
+// the caller's own assignments.
+func (h *ApplicationReviewHandler) ListQueue(w http.ResponseWriter, r *http.Request) {
+	var filter service.ReviewQueueFilter
+
+	if v := r.URL.Query().Get("stage_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid stage_id")
+			return
+		}
+		filter.StageID = &id
+	}
+
+	if v := r.URL.Query().Get("reviewer_id"); v != "" {
+		if v == "me" {
+			if ac, ok := database.GetAuthContextFromContext(r.Context()); ok {
+				v = ac.UserID
+			}
+		}
+		id, err := uuid.Parse(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid reviewer_id")
+			return
+		}
+		filter.ReviewerID = &id
+	}
+
+	rows, err := h.service.ListQueue(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ApplicationReviewSummariesToResponse(rows))
+}
+
+func (h *ApplicationReviewHandler) GetReview(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+
+	review, err := h.service.GetReview(r.Context(), studentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ApplicationReviewToResponse(review))
+}
+
+func (h *ApplicationReviewHandler) MoveStage(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dtos.MoveStageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	stageID, err := uuid.Parse(req.StageID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid stage_id")
+		return
+	}
+
+	change, err := h.service.MoveStage(r.Context(), studentID, stageID, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.StageChangeToResponse(change))
+}
+
+func (h *ApplicationReviewHandler) AssignReviewer(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+	reviewerID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	if err := h.service.AssignReviewer(r.Context(), studentID, reviewerID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ApplicationReviewHandler) UnassignReviewer(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+	reviewerID, err := uuid.Parse(chi.URLParam(r, "userID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	if err := h.service.UnassignReviewer(r.Context(), studentID, reviewerID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ApplicationReviewHandler) AddNote(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dtos.AddApplicationNoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	note, err := h.service.AddNote(r.Context(), studentID, req.Body)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.ApplicationNoteToResponse(note))
+}
+
+func (h *ApplicationReviewHandler) Score(w http.ResponseWriter, r *http.Request) {
+	studentID, ok := h.parseStudentID(w, r)
+	if !ok {
+		return
+	}
+	criterionID, err := uuid.Parse(chi.URLParam(r, "criterionID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid criterion ID")
+		return
+	}
+
+	var req dtos.ScoreApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Score == nil {
+		writeError(w, http.StatusBadRequest, "score is required")
+		return
+	}
+
+	score, err := h.service.Score(r.Context(), studentID, criterionID, *req.Score, req.Comment)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ApplicationScoreToResponse(score))
+}
+
+// --- Interviews ---
+
+// ListInterviewSlots lists slots starting on or after from (RFC 3339),
+// defaulting to now.
+func (h *ApplicationReviewHandler) ListInterviewSlots(w http.ResponseWriter, r *http.Request) {
+	from := time.Now()
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid from (expected RFC 3339)")
+			return
+		}
+		from = t
+	}
+
+	slots, err := h.service.ListInterviewSlots(r.Context(), from)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.InterviewSlotsToResponse(slots))
+}
+
+func (h *ApplicationReviewHandler) CreateInterviewSlot(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateInterviewSlotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var interviewerID *uuid.UUID
+	if req.InterviewerID != nil {
+		id, err := uuid.Parse(*req.InterviewerID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid interviewer_id")
+			return
+		}
+		interviewerID = &id
+	}
+
+	var locationID *uuid.UUID
+	if req.LocationID != nil {
+		id, err := uuid.Parse(*req.LocationID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid location_id")
+			return
+		}
+		locationID = &id
+	}
+
+	slot, err := h.service.CreateInterviewSlot(r.Context(), req.StartsAt, req.EndsAt, req.Location, locationID, interviewerID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.InterviewSlotToResponse(slot))
+}
+
+func (h *ApplicationReviewHandler) DeleteInterviewSlot(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot ID")
+		return
+	}
+
+	if err := h.service.DeleteInterviewSlot(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ApplicationReviewHandler) BookInterview(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot ID")
+		return
+	}
+
+	var req dtos.BookInterviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	slot, err := h.service.BookInterview(r.Context(), id, req.StudentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.InterviewSlotToResponse(slot))
+}
+
+func (h *ApplicationReviewHandler) CancelInterview(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid slot ID")
+		return
+	}
+
+	slot, err := h.service.CancelInterview(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.InterviewSlotToResponse(slot))
+}
+
+func (h *ApplicationReviewHandler) parseStudentID(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return 0, false
+	}
+	return int32(id), true
+}
+
+func (h *ApplicationReviewHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrReviewStageNotFound),
+		errors.Is(err, studentErrors.ErrReviewCriterionNotFound),
+		errors.Is(err, studentErrors.ErrReviewerNotFound),
+		errors.Is(err, studentErrors.ErrInterviewSlotNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, studentErrors.ErrReviewStageExists),
+		errors.Is(err, studentErrors.ErrReviewCriterionExists),
+		errors.Is(err, studentErrors.ErrAlreadyInReviewStage),
+		errors.Is(err, studentErrors.ErrApplicationDecided),
+		errors.Is(err, studentErrors.ErrInterviewSlotBooked),
+		errors.Is(err, studentErrors.ErrInterviewSlotNotBooked),
+		errors.Is(err, studentErrors.ErrInterviewAlreadyBooked):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidReviewStageName),
+		errors.Is(err, studentErrors.ErrInvalidReviewPosition),
+		errors.Is(err, studentErrors.ErrReviewStageInactive),
+		errors.Is(err, studentErrors.ErrNoActiveReviewStages),
+		errors.Is(err, studentErrors.ErrInvalidReviewCriterion),
+		errors.Is(err, studentErrors.ErrInvalidMaxScore),
+		errors.Is(err, studentErrors.ErrReviewCriterionInactive),
+		errors.Is(err, studentErrors.ErrInvalidScore),
+		errors.Is(err, studentErrors.ErrInvalidReviewNote),
+		errors.Is(err, studentErrors.ErrInvalidReviewer),
+		errors.Is(err, studentErrors.ErrInvalidInterviewSlot),
+		errors.Is(err, studentErrors.ErrInvalidInterviewLocation),
+		errors.Is(err, studentErrors.ErrUnknownInterviewLocation),
+		errors.Is(err, studentErrors.ErrInterviewSlotInPast):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	case errors.Is(err, studentErrors.ErrInvalidAuthContext):
+		h.logger.Error("invalid auth context data", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
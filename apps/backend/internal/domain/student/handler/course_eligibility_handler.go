@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CourseEligibilityHandler struct {
+	logger  *zap.Logger
+	service service.CourseEligibilityServiceInterface
+}
+
+func NewCourseEligibilityHandler(logger *zap.Logger, service service.CourseEligibilityServiceInterface) *CourseEligibilityHandler {
+	return &CourseEligibilityHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *CourseEligibilityHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/course-eligibility", func(r chi.Router) {
+		r.Get("/", h.GetMatrix)
+		r.Get("/rules", h.ListRules)
+		r.Post("/rules", h.CreateRule)
+		r.Put("/rules/{id}", h.UpdateRule)
+		r.Delete("/rules/{id}", h.DeleteRule)
+		r.Put("/overrides/{studentID}/{courseCode}", h.SetOverride)
+		r.Delete("/overrides/{studentID}/{courseCode}", h.DeleteOverride)
+	})
+}
+
+func (h *CourseEligibilityHandler) GetMatrix(w http.ResponseWriter, r *http.Request) {
+	var studentID *int32
+	if v := r.URL.Query().Get("student_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid student_id")
+			return
+		}
+		sid := int32(id)
+		studentID = &sid
+	}
+
+	rows, err := h.service.GetMatrix(r.Context(), studentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.EligibilityMatrixToResponse(rows))
+}
+
+func (h *CourseEligibilityHandler) ListRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.service.ListRules(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.EligibilityRulesToResponse(rules))
+}
+
+func (h *CourseEligibilityHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateEligibilityRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rule, err := h.service.CreateRule(r.Context(), req.CourseCode, req.CourseLevel, req.MinGrade)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.EligibilityRuleToResponse(rule))
+}
+
+func (h *CourseEligibilityHandler) UpdateRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	var req dtos.UpdateEligibilityRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	rule, err := h.service.UpdateRule(r.Context(), id, req.MinGrade)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.EligibilityRuleToResponse(rule))
+}
+
+func (h *CourseEligibilityHandler) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	if err := h.service.DeleteRule(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CourseEligibilityHandler) SetOverride(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	var req dtos.SetEligibilityOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Eligible == nil {
+		writeError(w, http.StatusBadRequest, "eligible is required")
+		return
+	}
+
+	override, err := h.service.SetOverride(r.Context(), int32(studentID), chi.URLParam(r, "courseCode"), *req.Eligible, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.EligibilityOverrideToResponse(override))
+}
+
+func (h *CourseEligibilityHandler) DeleteOverride(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	if err := h.service.DeleteOverride(r.Context(), int32(studentID), chi.URLParam(r, "courseCode")); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CourseEligibilityHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrEligibilityRuleNotFound):
+		writeError(w, http.StatusNotFound, "eligibility rule not found")
+	case errors.Is(err, studentErrors.ErrEligibilityOverrideNotFound):
+		writeError(w, http.StatusNotFound, "eligibility override not found")
+	case errors.Is(err, studentErrors.ErrEligibilityRuleExists):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidGrade),
+		errors.Is(err, studentErrors.ErrInvalidCourseCode),
+		errors.Is(err, studentErrors.ErrInvalidCourseLevel),
+		errors.Is(err, studentErrors.ErrInvalidEligibilityRuleTarget),
+		errors.Is(err, studentErrors.ErrOverrideReasonRequired):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,339 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+type CreateReviewStageRequest struct {
+	Name     string `json:"name"`
+	Position int32  `json:"position"`
+}
+
+type UpdateReviewStageRequest struct {
+	Name     *string `json:"name"`
+	Position *int32  `json:"position"`
+	IsActive *bool   `json:"is_active"`
+}
+
+type CreateReviewCriterionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MaxScore    int32  `json:"max_score"`
+	Position    int32  `json:"position"`
+}
+
+type UpdateReviewCriterionRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	MaxScore    *int32  `json:"max_score"`
+	Position    *int32  `json:"position"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+type MoveStageRequest struct {
+	StageID string `json:"stage_id"`
+	Note    string `json:"note"`
+}
+
+type AddApplicationNoteRequest struct {
+	Body string `json:"body"`
+}
+
+type ScoreApplicationRequest struct {
+	Score   *int32 `json:"score"`
+	Comment string `json:"comment"`
+}
+
+type CreateInterviewSlotRequest struct {
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+	Location      string    `json:"location"`
+	LocationID    *string   `json:"location_id"`
+	InterviewerID *string   `json:"interviewer_id"`
+}
+
+type BookInterviewRequest struct {
+	StudentID int32 `json:"student_id"`
+}
+
+type ReviewStageResponse struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Position  int32      `json:"position"`
+	IsActive  bool       `json:"is_active"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type ReviewCriterionResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	MaxScore    int32      `json:"max_score"`
+	Position    int32      `json:"position"`
+	IsActive    bool       `json:"is_active"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+type StageChangeResponse struct {
+	ID          string    `json:"id"`
+	FromStageID *string   `json:"from_stage_id"`
+	ToStageID   *string   `json:"to_stage_id"`
+	Decision    *string   `json:"decision"`
+	Note        string    `json:"note"`
+	ChangedBy   *string   `json:"changed_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ApplicationReviewerResponse struct {
+	ReviewerID string    `json:"reviewer_id"`
+	AssignedBy *string   `json:"assigned_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ApplicationNoteResponse struct {
+	ID        string    `json:"id"`
+	StageID   *string   `json:"stage_id"`
+	AuthorID  *string   `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ApplicationScoreResponse struct {
+	CriterionID string     `json:"criterion_id"`
+	ReviewerID  string     `json:"reviewer_id"`
+	Score       int32      `json:"score"`
+	Comment     string     `json:"comment"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+type CriterionScoreResponse struct {
+	CriterionID string  `json:"criterion_id"`
+	Name        string  `json:"name"`
+	MaxScore    int32   `json:"max_score"`
+	Average     float64 `json:"average"`
+	Count       int     `json:"count"`
+}
+
+type ScoreSummaryResponse struct {
+	Criteria []CriterionScoreResponse `json:"criteria"`
+	Overall  *float64                 `json:"overall"`
+}
+
+type InterviewSlotResponse struct {
+	ID            string     `json:"id"`
+	StartsAt      time.Time  `json:"starts_at"`
+	EndsAt        time.Time  `json:"ends_at"`
+	Location      string     `json:"location"`
+	LocationID    *string    `json:"location_id"`
+	InterviewerID *string    `json:"interviewer_id"`
+	StudentID     *int32     `json:"student_id"`
+	BookedAt      *time.Time `json:"booked_at"`
+	InvitedAt     *time.Time `json:"invited_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type ApplicationReviewResponse struct {
+	Student   StudentResponse               `json:"student"`
+	Stage     *ReviewStageResponse          `json:"stage"`
+	Reviewers []ApplicationReviewerResponse `json:"reviewers"`
+	Notes     []ApplicationNoteResponse     `json:"notes"`
+	Scores    []ApplicationScoreResponse    `json:"scores"`
+	Summary   ScoreSummaryResponse          `json:"summary"`
+	History   []StageChangeResponse         `json:"history"`
+	Interview *InterviewSlotResponse        `json:"interview"`
+}
+
+type ApplicationReviewSummaryResponse struct {
+	StudentID   int32                `json:"student_id"`
+	StudentName string               `json:"student_name"`
+	Email       string               `json:"email"`
+	AppliedAt   time.Time            `json:"applied_at"`
+	Stage       *ReviewStageResponse `json:"stage"`
+	EnteredAt   *time.Time           `json:"entered_stage_at"`
+	ReviewerIDs []string             `json:"reviewer_ids"`
+}
+
+func ReviewStageToResponse(s *aggregate.ReviewStage) ReviewStageResponse {
+	return ReviewStageResponse{
+		ID:        s.ID.String(),
+		Name:      s.Name,
+		Position:  s.Position,
+		IsActive:  s.IsActive,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func ReviewStagesToResponse(stages []*aggregate.ReviewStage) []ReviewStageResponse {
+	responses := make([]ReviewStageResponse, len(stages))
+	for i, s := range stages {
+		responses[i] = ReviewStageToResponse(s)
+	}
+	return responses
+}
+
+func ReviewCriterionToResponse(c *aggregate.ReviewCriterion) ReviewCriterionResponse {
+	return ReviewCriterionResponse{
+		ID:          c.ID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		MaxScore:    c.MaxScore,
+		Position:    c.Position,
+		IsActive:    c.IsActive,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func ReviewCriteriaToResponse(criteria []*aggregate.ReviewCriterion) []ReviewCriterionResponse {
+	responses := make([]ReviewCriterionResponse, len(criteria))
+	for i, c := range criteria {
+		responses[i] = ReviewCriterionToResponse(c)
+	}
+	return responses
+}
+
+func StageChangeToResponse(c *aggregate.StageChange) StageChangeResponse {
+	resp := StageChangeResponse{
+		ID:          c.ID.String(),
+		FromStageID: uuidString(c.FromStageID),
+		ToStageID:   uuidString(c.ToStageID),
+		Note:        c.Note,
+		ChangedBy:   uuidString(c.ChangedBy),
+		CreatedAt:   c.CreatedAt,
+	}
+	if c.Decision != nil {
+		d := string(*c.Decision)
+		resp.Decision = &d
+	}
+	return resp
+}
+
+func ApplicationNoteToResponse(n *aggregate.ApplicationNote) ApplicationNoteResponse {
+	return ApplicationNoteResponse{
+		ID:        n.ID.String(),
+		StageID:   uuidString(n.StageID),
+		AuthorID:  uuidString(n.AuthorID),
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func ApplicationScoreToResponse(s *aggregate.ApplicationScore) ApplicationScoreResponse {
+	return ApplicationScoreResponse{
+		CriterionID: s.CriterionID.String(),
+		ReviewerID:  s.ReviewerID.String(),
+		Score:       s.Score,
+		Comment:     s.Comment,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+func InterviewSlotToResponse(s *aggregate.InterviewSlot) InterviewSlotResponse {
+	return InterviewSlotResponse{
+		ID:            s.ID.String(),
+		StartsAt:      s.StartsAt,
+		EndsAt:        s.EndsAt,
+		Location:      s.Location,
+		LocationID:    uuidString(s.LocationID),
+		InterviewerID: uuidString(s.InterviewerID),
+		StudentID:     s.StudentID,
+		BookedAt:      s.BookedAt,
+		InvitedAt:     s.InvitedAt,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func InterviewSlotsToResponse(slots []*aggregate.InterviewSlot) []InterviewSlotResponse {
+	responses := make([]InterviewSlotResponse, len(slots))
+	for i, s := range slots {
+		responses[i] = InterviewSlotToResponse(s)
+	}
+	return responses
+}
+
+func ApplicationReviewToResponse(r *aggregate.ApplicationReview) ApplicationReviewResponse {
+	resp := ApplicationReviewResponse{
+		Student:   StudentToResponse(r.Student),
+		Reviewers: make([]ApplicationReviewerResponse, len(r.Reviewers)),
+		Notes:     make([]ApplicationNoteResponse, len(r.Notes)),
+		Scores:    make([]ApplicationScoreResponse, len(r.Scores)),
+		History:   make([]StageChangeResponse, len(r.History)),
+		Summary: ScoreSummaryResponse{
+			Criteria: make([]CriterionScoreResponse, len(r.Summary.Criteria)),
+			Overall:  r.Summary.Overall,
+		},
+	}
+	if r.Stage != nil {
+		stage := ReviewStageToResponse(r.Stage)
+		resp.Stage = &stage
+	}
+	for i, rv := range r.Reviewers {
+		resp.Reviewers[i] = ApplicationReviewerResponse{
+			ReviewerID: rv.ReviewerID.String(),
+			AssignedBy: uuidString(rv.AssignedBy),
+			CreatedAt:  rv.CreatedAt,
+		}
+	}
+	for i, n := range r.Notes {
+		resp.Notes[i] = ApplicationNoteToResponse(n)
+	}
+	for i, s := range r.Scores {
+		resp.Scores[i] = ApplicationScoreToResponse(s)
+	}
+	for i, c := range r.Summary.Criteria {
+		resp.Summary.Criteria[i] = CriterionScoreResponse{
+			CriterionID: c.CriterionID.String(),
+			Name:        c.Name,
+			MaxScore:    c.MaxScore,
+			Average:     c.Average,
+			Count:       c.Count,
+		}
+	}
+	for i, c := range r.History {
+		resp.History[i] = StageChangeToResponse(c)
+	}
+	if r.Interview != nil {
+		interview := InterviewSlotToResponse(r.Interview)
+		resp.Interview = &interview
+	}
+	return resp
+}
+
+func ApplicationReviewSummariesToResponse(rows []*aggregate.ApplicationReviewSummary) []ApplicationReviewSummaryResponse {
+	responses := make([]ApplicationReviewSummaryResponse, len(rows))
+	for i, row := range rows {
+		resp := ApplicationReviewSummaryResponse{
+			StudentID:   row.Student.StudentID,
+			StudentName: row.Student.FirstName + " " + row.Student.LastName,
+			Email:       row.Student.EmailAddress,
+			AppliedAt:   row.Student.CreatedAt,
+			EnteredAt:   row.EnteredAt,
+			ReviewerIDs: make([]string, len(row.ReviewerIDs)),
+		}
+		if row.Stage != nil {
+			stage := ReviewStageToResponse(row.Stage)
+			resp.Stage = &stage
+		}
+		for j, id := range row.ReviewerIDs {
+			resp.ReviewerIDs[j] = id.String()
+		}
+		responses[i] = resp
+	}
+	return responses
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,118 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+)
+
+type CreateEligibilityRuleRequest struct {
+	CourseCode  *string `json:"course_code"`
+	CourseLevel *int32  `json:"course_level"`
+	MinGrade    string  `json:"min_grade"`
+}
+
+type UpdateEligibilityRuleRequest struct {
+	MinGrade string `json:"min_grade"`
+}
+
+type SetEligibilityOverrideRequest struct {
+	Eligible *bool  `json:"eligible"`
+	Reason   string `json:"reason"`
+}
+
+type EligibilityRuleResponse struct {
+	ID          string     `json:"id"`
+	CourseCode  *string    `json:"course_code"`
+	CourseLevel *int32     `json:"course_level"`
+	MinGrade    string     `json:"min_grade"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+type EligibilityOverrideResponse struct {
+	StudentID  int32      `json:"student_id"`
+	CourseCode string     `json:"course_code"`
+	Eligible   bool       `json:"eligible"`
+	Reason     string     `json:"reason"`
+	CreatedBy  *string    `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+type CourseEligibilityResponse struct {
+	CourseCode     string  `json:"course_code"`
+	Title          string  `json:"title"`
+	Grade          *string `json:"grade"`
+	MinGrade       string  `json:"min_grade"`
+	Eligible       bool    `json:"eligible"`
+	Source         string  `json:"source"`
+	OverrideReason *string `json:"override_reason,omitempty"`
+}
+
+type StudentEligibilityResponse struct {
+	StudentID       int32                       `json:"student_id"`
+	StudentName     string                      `json:"student_name"`
+	EligibleCourses []string                    `json:"eligible_courses"`
+	Courses         []CourseEligibilityResponse `json:"courses"`
+}
+
+func EligibilityRuleToResponse(r *aggregate.EligibilityRule) EligibilityRuleResponse {
+	return EligibilityRuleResponse{
+		ID:          r.ID.String(),
+		CourseCode:  r.CourseCode,
+		CourseLevel: r.CourseLevel,
+		MinGrade:    r.MinGrade,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func EligibilityRulesToResponse(rules []*aggregate.EligibilityRule) []EligibilityRuleResponse {
+	responses := make([]EligibilityRuleResponse, len(rules))
+	for i, r := range rules {
+		responses[i] = EligibilityRuleToResponse(r)
+	}
+	return responses
+}
+
+func EligibilityOverrideToResponse(o *aggregate.EligibilityOverride) EligibilityOverrideResponse {
+	resp := EligibilityOverrideResponse{
+		StudentID:  o.StudentID,
+		CourseCode: o.CourseCode,
+		Eligible:   o.Eligible,
+		Reason:     o.Reason,
+		CreatedAt:  o.CreatedAt,
+		UpdatedAt:  o.UpdatedAt,
+	}
+	if o.CreatedBy != nil {
+		createdBy := o.CreatedBy.String()
+		resp.CreatedBy = &createdBy
+	}
+	return resp
+}
+
+func EligibilityMatrixToResponse(rows []*aggregate.StudentEligibility) []StudentEligibilityResponse {
+	responses := make([]StudentEligibilityResponse, len(rows))
+	for i, row := range rows {
+		courses := make([]CourseEligibilityResponse, len(row.Courses))
+		for j, c := range row.Courses {
+			courses[j] = CourseEligibilityResponse{
+				CourseCode:     c.CourseCode,
+				Title:          c.Title,
+				Grade:          c.Grade,
+				MinGrade:       c.MinGrade,
+				Eligible:       c.Eligible,
+				Source:         string(c.Source),
+				OverrideReason: c.OverrideReason,
+			}
+		}
+		responses[i] = StudentEligibilityResponse{
+			StudentID:       row.StudentID,
+			StudentName:     row.StudentName,
+			EligibleCourses: row.EligibleCourses(),
+			Courses:         courses,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,79 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+)
+
+type ReviewProfileChangeRequest struct {
+	Note string `json:"note"`
+}
+
+type AssignmentConflictResponse struct {
+	ShiftID   string `json:"shift_id"`
+	DayOfWeek int    `json:"day_of_week"`
+	Start     string `json:"start"`
+	End       string `json:"end"`
+}
+
+type ProfileChangeRequestResponse struct {
+	ID             string                       `json:"id"`
+	StudentID      int32                        `json:"student_id"`
+	Status         string                       `json:"status"`
+	Availability   aggregate.Availability       `json:"availability,omitempty"`
+	MinWeeklyHours *float64                     `json:"min_weekly_hours,omitempty"`
+	MaxWeeklyHours *float64                     `json:"max_weekly_hours,omitempty"`
+	Conflicts      []AssignmentConflictResponse `json:"conflicts"`
+	ReviewedBy     *string                      `json:"reviewed_by"`
+	ReviewedAt     *time.Time                   `json:"reviewed_at"`
+	ReviewNote     *string                      `json:"review_note"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      *time.Time                   `json:"updated_at,omitempty"`
+}
+
+// UpdateMeResponse is the student's profile after PUT /students/me, with the
+// change request created for availability or hour changes, if any.
+type UpdateMeResponse struct {
+	StudentResponse
+	PendingChangeRequest *ProfileChangeRequestResponse `json:"pending_change_request,omitempty"`
+}
+
+func ProfileChangeRequestToResponse(r *aggregate.ProfileChangeRequest) ProfileChangeRequestResponse {
+	var reviewedBy *string
+	if r.ReviewedBy != nil {
+		id := r.ReviewedBy.String()
+		reviewedBy = &id
+	}
+	conflicts := make([]AssignmentConflictResponse, len(r.Conflicts))
+	for i, c := range r.Conflicts {
+		conflicts[i] = AssignmentConflictResponse{
+			ShiftID:   c.ShiftID,
+			DayOfWeek: c.DayOfWeek,
+			Start:     c.Start,
+			End:       c.End,
+		}
+	}
+	return ProfileChangeRequestResponse{
+		ID:             r.ID.String(),
+		StudentID:      r.StudentID,
+		Status:         string(r.Status),
+		Availability:   r.Availability,
+		MinWeeklyHours: r.MinWeeklyHours,
+		MaxWeeklyHours: r.MaxWeeklyHours,
+		Conflicts:      conflicts,
+		ReviewedBy:     reviewedBy,
+		ReviewedAt:     r.ReviewedAt,
+		ReviewNote:     r.ReviewNote,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func ProfileChangeRequestsToResponse(requests []*aggregate.ProfileChangeRequest) []ProfileChangeRequestResponse {
+	result := make([]ProfileChangeRequestResponse, len(requests))
+	for i, r := range requests {
+		result[i] = ProfileChangeRequestToResponse(r)
+	}
+	return result
+}
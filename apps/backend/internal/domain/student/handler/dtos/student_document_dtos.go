@@ -0,0 +1,56 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+)
+
+type SetDocumentRetentionRequest struct {
+	RetainUntil time.Time `json:"retain_until"`
+}
+
+type StudentDocumentResponse struct {
+	ID           string     `json:"id"`
+	StudentID    *int32     `json:"student_id"`
+	DocumentType string     `json:"document_type"`
+	Filename     string     `json:"filename"`
+	ContentType  string     `json:"content_type"`
+	SizeBytes    int64      `json:"size_bytes"`
+	Checksum     string     `json:"checksum"`
+	UploadedBy   *string    `json:"uploaded_by"`
+	RetainUntil  time.Time  `json:"retain_until"`
+	PurgedAt     *time.Time `json:"purged_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+func StudentDocumentToResponse(d *aggregate.StudentDocument) StudentDocumentResponse {
+	var uploadedBy *string
+	if d.UploadedBy != nil {
+		s := d.UploadedBy.String()
+		uploadedBy = &s
+	}
+	return StudentDocumentResponse{
+		ID:           d.ID.String(),
+		StudentID:    d.StudentID,
+		DocumentType: string(d.DocumentType),
+		Filename:     d.Filename,
+		ContentType:  d.ContentType,
+		SizeBytes:    d.SizeBytes,
+		Checksum:     d.Checksum,
+		UploadedBy:   uploadedBy,
+		RetainUntil:  d.RetainUntil,
+		PurgedAt:     d.PurgedAt,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
+
+func StudentDocumentsToResponse(documents []*aggregate.StudentDocument) []StudentDocumentResponse {
+	out := make([]StudentDocumentResponse, len(documents))
+	for i, d := range documents {
+		out[i] = StudentDocumentToResponse(d)
+	}
+	return out
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
 )
 
 type ApplyRequest struct {
@@ -21,6 +22,8 @@ type ApplyRequest struct {
 	DegreeGPA       *float64        `json:"degree_gpa"`
 	Courses         json.RawMessage `json:"courses"`
 	Availability    json.RawMessage `json:"availability"`
+	// TranscriptDocumentID is the document_id returned by /transcripts/extract.
+	TranscriptDocumentID *uuid.UUID `json:"transcript_document_id,omitempty"`
 }
 
 type BulkStudentRequest struct {
@@ -78,7 +81,7 @@ func StudentToResponse(s *aggregate.Student) StudentResponse {
 		CreatedAt:          s.CreatedAt.Format("2006-01-02 15:04:05"),
 		MinWeeklyHours:     s.MinWeeklyHours,
 		MaxWeeklyHours:     s.MaxWeeklyHours,
-		Status:             studentStatus(s),
+		Status:             s.Status(),
 	}
 
 	if s.UpdatedAt != nil {
@@ -105,19 +108,6 @@ func StudentsToResponse(students []*aggregate.Student) []StudentResponse {
 	return responses
 }
 
-func studentStatus(s *aggregate.Student) string {
-	if s.DeletedAt != nil {
-		return "deactivated"
-	}
-	if s.AcceptedAt != nil {
-		return "accepted"
-	}
-	if s.RejectedAt != nil {
-		return "rejected"
-	}
-	return "pending"
-}
-
 // ToTranscriptMetadata converts the flat ApplyRequest fields into a TranscriptMetadata struct.
 func (r *ApplyRequest) ToTranscriptMetadata() (types.TranscriptMetadata, error) {
 	var courses []types.CourseResult
@@ -0,0 +1,38 @@
+package dtos
+
+import (
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+)
+
+type StudentImportErrorResponse struct {
+	Line      int    `json:"line"`
+	StudentID string `json:"student_id"`
+	Email     string `json:"email"`
+	Error     string `json:"error"`
+}
+
+type StudentInvitationFailureResponse struct {
+	StudentID int32  `json:"student_id"`
+	Error     string `json:"error"`
+}
+
+type StudentImportResponse struct {
+	Students           []StudentResponse                  `json:"students"`
+	Accepted           bool                               `json:"accepted"`
+	InvitationsSent    int                                `json:"invitations_sent"`
+	InvitationFailures []StudentInvitationFailureResponse `json:"invitation_failures"`
+	Errors             []StudentImportErrorResponse       `json:"errors"`
+}
+
+func StudentImportToResponse(r *service.StudentImportResult) StudentImportResponse {
+	errs := make([]StudentImportErrorResponse, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = StudentImportErrorResponse{Line: e.Line, StudentID: e.StudentID, Email: e.Email, Error: e.Error}
+	}
+	return StudentImportResponse{
+		Students:           StudentsToResponse(r.Students),
+		Accepted:           r.Accepted,
+		InvitationFailures: []StudentInvitationFailureResponse{},
+		Errors:             errs,
+	}
+}
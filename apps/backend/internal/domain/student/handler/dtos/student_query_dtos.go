@@ -0,0 +1,22 @@
+package dtos
+
+import (
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+)
+
+type StudentPageResponse struct {
+	Students   []StudentResponse `json:"students"`
+	Total      int               `json:"total"`
+	NextCursor *string           `json:"next_cursor"`
+}
+
+func StudentPageToResponse(p *service.StudentPage) StudentPageResponse {
+	resp := StudentPageResponse{
+		Students: StudentsToResponse(p.Students),
+		Total:    p.Total,
+	}
+	if p.NextCursor != "" {
+		resp.NextCursor = &p.NextCursor
+	}
+	return resp
+}
@@ -0,0 +1,64 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+)
+
+type ClassSessionResponse struct {
+	CourseCode string `json:"course_code"`
+	DayOfWeek  int    `json:"day_of_week"`
+	Start      string `json:"start"`
+	End        string `json:"end"`
+}
+
+type AvailabilityOverlapResponse struct {
+	DayOfWeek  int    `json:"day_of_week"`
+	Hour       int    `json:"hour"`
+	CourseCode string `json:"course_code"`
+}
+
+type TimetableResponse struct {
+	StudentID            int32                         `json:"student_id"`
+	Source               string                        `json:"source"`
+	Classes              []ClassSessionResponse        `json:"classes"`
+	ProposedAvailability aggregate.Availability        `json:"proposed_availability"`
+	Overlaps             []AvailabilityOverlapResponse `json:"overlaps"`
+	CreatedAt            time.Time                     `json:"created_at"`
+	UpdatedAt            *time.Time                    `json:"updated_at,omitempty"`
+}
+
+func TimetableProposalToResponse(p *service.TimetableProposal) TimetableResponse {
+	classes := make([]ClassSessionResponse, len(p.Timetable.Classes))
+	for i, c := range p.Timetable.Classes {
+		classes[i] = ClassSessionResponse{
+			CourseCode: c.CourseCode,
+			DayOfWeek:  c.DayOfWeek,
+			Start:      c.Start,
+			End:        c.End,
+		}
+	}
+	overlaps := make([]AvailabilityOverlapResponse, len(p.Overlaps))
+	for i, o := range p.Overlaps {
+		overlaps[i] = AvailabilityOverlapResponse{
+			DayOfWeek:  o.DayOfWeek,
+			Hour:       o.Hour,
+			CourseCode: o.CourseCode,
+		}
+	}
+	proposed := p.ProposedAvailability
+	if proposed == nil {
+		proposed = aggregate.Availability{}
+	}
+	return TimetableResponse{
+		StudentID:            p.Timetable.StudentID,
+		Source:               string(p.Timetable.Source),
+		Classes:              classes,
+		ProposedAvailability: proposed,
+		Overlaps:             overlaps,
+		CreatedAt:            p.Timetable.CreatedAt,
+		UpdatedAt:            p.Timetable.UpdatedAt,
+	}
+}
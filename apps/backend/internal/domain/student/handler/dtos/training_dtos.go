@@ -0,0 +1,108 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+)
+
+// TrainingRequirementRequest creates or replaces a requirement. IsActive
+// defaults to true.
+type TrainingRequirementRequest struct {
+	Name           string  `json:"name"`
+	Description    *string `json:"description"`
+	IsBlocking     bool    `json:"is_blocking"`
+	ValidityMonths *int32  `json:"validity_months"`
+	IsActive       *bool   `json:"is_active"`
+}
+
+type TrainingRequirementResponse struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Description    *string    `json:"description"`
+	IsBlocking     bool       `json:"is_blocking"`
+	ValidityMonths *int32     `json:"validity_months"`
+	IsActive       bool       `json:"is_active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+type TrainingRecordResponse struct {
+	ID                 string     `json:"id"`
+	StudentID          int32      `json:"student_id"`
+	RequirementID      string     `json:"requirement_id"`
+	CompletedOn        string     `json:"completed_on"`
+	ExpiresOn          *string    `json:"expires_on"`
+	EvidenceDocumentID *string    `json:"evidence_document_id"`
+	RecordedBy         *string    `json:"recorded_by"`
+	ReminderSentAt     *time.Time `json:"reminder_sent_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
+type TrainingStatusResponse struct {
+	Requirement TrainingRequirementResponse `json:"requirement"`
+	Record      *TrainingRecordResponse     `json:"record"`
+	State       string                      `json:"state"`
+}
+
+func TrainingRequirementToResponse(r *aggregate.TrainingRequirement) TrainingRequirementResponse {
+	return TrainingRequirementResponse{
+		ID:             r.ID.String(),
+		Name:           r.Name,
+		Description:    r.Description,
+		IsBlocking:     r.IsBlocking,
+		ValidityMonths: r.ValidityMonths,
+		IsActive:       r.IsActive,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func TrainingRequirementsToResponse(requirements []*aggregate.TrainingRequirement) []TrainingRequirementResponse {
+	responses := make([]TrainingRequirementResponse, len(requirements))
+	for i, r := range requirements {
+		responses[i] = TrainingRequirementToResponse(r)
+	}
+	return responses
+}
+
+func TrainingRecordToResponse(r *aggregate.TrainingRecord) TrainingRecordResponse {
+	resp := TrainingRecordResponse{
+		ID:             r.ID.String(),
+		StudentID:      r.StudentID,
+		RequirementID:  r.RequirementID.String(),
+		CompletedOn:    r.CompletedOn.Format("2006-01-02"),
+		ReminderSentAt: r.ReminderSentAt,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+	if r.ExpiresOn != nil {
+		e := r.ExpiresOn.Format("2006-01-02")
+		resp.ExpiresOn = &e
+	}
+	if r.EvidenceDocumentID != nil {
+		id := r.EvidenceDocumentID.String()
+		resp.EvidenceDocumentID = &id
+	}
+	if r.RecordedBy != nil {
+		id := r.RecordedBy.String()
+		resp.RecordedBy = &id
+	}
+	return resp
+}
+
+func TrainingStatusesToResponse(statuses []aggregate.TrainingStatus) []TrainingStatusResponse {
+	responses := make([]TrainingStatusResponse, len(statuses))
+	for i, st := range statuses {
+		responses[i] = TrainingStatusResponse{
+			Requirement: TrainingRequirementToResponse(st.Requirement),
+			State:       string(st.State),
+		}
+		if st.Record != nil {
+			rec := TrainingRecordToResponse(st.Record)
+			responses[i].Record = &rec
+		}
+	}
+	return responses
+}
@@ -0,0 +1,94 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+)
+
+type ReviewTranscriptSubmissionRequest struct {
+	Note string `json:"note"`
+}
+
+type TranscriptSubmissionResponse struct {
+	ID         string                   `json:"id"`
+	StudentID  int32                    `json:"student_id"`
+	DocumentID *string                  `json:"document_id"`
+	Status     string                   `json:"status"`
+	Transcript types.TranscriptMetadata `json:"transcript"`
+	Diff       aggregate.TranscriptDiff `json:"diff"`
+	ReviewedBy *string                  `json:"reviewed_by"`
+	ReviewedAt *time.Time               `json:"reviewed_at"`
+	ReviewNote *string                  `json:"review_note"`
+	CreatedAt  time.Time                `json:"created_at"`
+	UpdatedAt  *time.Time               `json:"updated_at,omitempty"`
+}
+
+type AffectedAssignmentResponse struct {
+	ShiftID     string   `json:"shift_id"`
+	DayOfWeek   int      `json:"day_of_week"`
+	Start       string   `json:"start"`
+	End         string   `json:"end"`
+	LostCourses []string `json:"lost_courses"`
+}
+
+type TranscriptReviewResponse struct {
+	Submission          TranscriptSubmissionResponse `json:"submission"`
+	GainedCourses       []string                     `json:"gained_courses"`
+	LostCourses         []string                     `json:"lost_courses"`
+	AffectedAssignments []AffectedAssignmentResponse `json:"affected_assignments"`
+}
+
+func TranscriptSubmissionToResponse(s *aggregate.TranscriptSubmission) TranscriptSubmissionResponse {
+	var documentID, reviewedBy *string
+	if s.DocumentID != nil {
+		id := s.DocumentID.String()
+		documentID = &id
+	}
+	if s.ReviewedBy != nil {
+		id := s.ReviewedBy.String()
+		reviewedBy = &id
+	}
+	return TranscriptSubmissionResponse{
+		ID:         s.ID.String(),
+		StudentID:  s.StudentID,
+		DocumentID: documentID,
+		Status:     string(s.Status),
+		Transcript: s.Transcript,
+		Diff:       s.Diff,
+		ReviewedBy: reviewedBy,
+		ReviewedAt: s.ReviewedAt,
+		ReviewNote: s.ReviewNote,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func TranscriptSubmissionsToResponse(submissions []*aggregate.TranscriptSubmission) []TranscriptSubmissionResponse {
+	result := make([]TranscriptSubmissionResponse, len(submissions))
+	for i, s := range submissions {
+		result[i] = TranscriptSubmissionToResponse(s)
+	}
+	return result
+}
+
+func TranscriptReviewToResponse(r *service.TranscriptReviewResult) TranscriptReviewResponse {
+	affected := make([]AffectedAssignmentResponse, len(r.AffectedAssignments))
+	for i, a := range r.AffectedAssignments {
+		affected[i] = AffectedAssignmentResponse{
+			ShiftID:     a.ShiftID,
+			DayOfWeek:   a.DayOfWeek,
+			Start:       a.Start,
+			End:         a.End,
+			LostCourses: a.LostCourses,
+		}
+	}
+	return TranscriptReviewResponse{
+		Submission:          TranscriptSubmissionToResponse(r.Submission),
+		GainedCourses:       r.GainedCourses,
+		LostCourses:         r.LostCourses,
+		AffectedAssignments: affected,
+	}
+}
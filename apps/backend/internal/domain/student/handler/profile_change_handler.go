@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ProfileChangeHandler struct {
+	logger  *zap.Logger
+	service service.ProfileChangeServiceInterface
+}
+
+func NewProfileChangeHandler(logger *zap.Logger, service service.ProfileChangeServiceInterface) *ProfileChangeHandler {
+	return &ProfileChangeHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// RegisterRoutes registers authenticated routes (any role). Students create
+// change requests through PUT /students/me.
+func (h *ProfileChangeHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/students/me/change-requests", h.ListMine)
+}
+
+func (h *ProfileChangeHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Get("/profile-change-requests", h.List)
+	r.Get("/profile-change-requests/{id}", h.Get)
+	r.Post("/profile-change-requests/{id}/approve", h.Approve)
+	r.Post("/profile-change-requests/{id}/reject", h.Reject)
+}
+
+func (h *ProfileChangeHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	requests, err := h.service.ListMine(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ProfileChangeRequestsToResponse(requests))
+}
+
+func (h *ProfileChangeHandler) List(w http.ResponseWriter, r *http.Request) {
+	requests, err := h.service.List(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ProfileChangeRequestsToResponse(requests))
+}
+
+func (h *ProfileChangeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid change request ID")
+		return
+	}
+
+	request, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ProfileChangeRequestToResponse(request))
+}
+
+func (h *ProfileChangeHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	id, req, ok := h.readReview(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.service.Approve(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ProfileChangeRequestToResponse(request))
+}
+
+func (h *ProfileChangeHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	id, req, ok := h.readReview(w, r)
+	if !ok {
+		return
+	}
+
+	request, err := h.service.Reject(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ProfileChangeRequestToResponse(request))
+}
+
+// readReview parses the change request ID and the optional review note. An
+// empty body means no note.
+func (h *ProfileChangeHandler) readReview(w http.ResponseWriter, r *http.Request) (uuid.UUID, dtos.ReviewProfileChangeRequest, bool) {
+	var req dtos.ReviewProfileChangeRequest
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid change request ID")
+		return id, req, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return id, req, false
+	}
+	return id, req, true
+}
+
+func (h *ProfileChangeHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrProfileChangeNotFound):
+		writeError(w, http.StatusNotFound, "change request not found")
+	case errors.Is(err, studentErrors.ErrProfileChangeNotPending):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidChangeRequestStatus),
+		errors.Is(err, studentErrors.ErrInvalidReviewNote),
+		errors.Is(err, studentErrors.ErrInvalidAvailability),
+		errors.Is(err, studentErrors.ErrInvalidWeeklyHours):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "only students have change requests")
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type StudentDocumentHandler struct {
+	logger  *zap.Logger
+	service service.StudentDocumentServiceInterface
+}
+
+func NewStudentDocumentHandler(logger *zap.Logger, service service.StudentDocumentServiceInterface) *StudentDocumentHandler {
+	return &StudentDocumentHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// RegisterRoutes registers authenticated routes (any role). Download is here
+// rather than under the admin routes because RLS already limits students to
+// their own documents.
+func (h *StudentDocumentHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/students/me/documents", h.ListMine)
+	r.Post("/students/me/documents", h.UploadMine)
+	r.Get("/student-documents/{id}/content", h.Download)
+}
+
+func (h *StudentDocumentHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Get("/students/{studentID}/documents", h.ListByStudent)
+	r.Post("/students/{studentID}/documents", h.UploadForStudent)
+	r.Patch("/student-documents/{id}/retention", h.SetRetention)
+	r.Delete("/student-documents/{id}", h.Delete)
+}
+
+// readDocument reads the multipart "file" field and the "document_type"
+// form value.
+func (h *StudentDocumentHandler) readDocument(w http.ResponseWriter, r *http.Request) (documentType, filename string, content []byte, ok bool) {
+	// Leave room for the multipart framing around a file at the limit.
+	r.Body = http.MaxBytesReader(w, r.Body, aggregate.MaxDocumentSize+1<<20)
+
+	if err := r.ParseMultipartForm(aggregate.MaxDocumentSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return "", "", nil, false
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return "", "", nil, false
+	}
+	defer file.Close()
+
+	content, err = io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file")
+		return "", "", nil, false
+	}
+	return r.FormValue("document_type"), header.Filename, content, true
+}
+
+func (h *StudentDocumentHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	documents, err := h.service.ListMyDocuments(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentDocumentsToResponse(documents))
+}
+
+func (h *StudentDocumentHandler) UploadMine(w http.ResponseWriter, r *http.Request) {
+	documentType, filename, content, ok := h.readDocument(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := h.service.UploadMyDocument(r.Context(), documentType, filename, content)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.StudentDocumentToResponse(document))
+}
+
+func (h *StudentDocumentHandler) ListByStudent(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	documents, err := h.service.ListDocumentsByStudentID(r.Context(), int32(studentID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentDocumentsToResponse(documents))
+}
+
+func (h *StudentDocumentHandler) UploadForStudent(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	documentType, filename, content, ok := h.readDocument(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := h.service.UploadDocumentByStudentID(r.Context(), int32(studentID), documentType, filename, content)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.StudentDocumentToResponse(document))
+}
+
+func (h *StudentDocumentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	document, content, err := h.service.Download(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", document.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, document.Filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("X-Checksum-SHA256", document.Checksum)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		h.logger.Error("failed to write document", zap.Error(err))
+	}
+}
+
+func (h *StudentDocumentHandler) SetRetention(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	var req dtos.SetDocumentRetentionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	document, err := h.service.SetRetention(r.Context(), id, req.RetainUntil)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentDocumentToResponse(document))
+}
+
+func (h *StudentDocumentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid document ID")
+		return
+	}
+
+	document, err := h.service.Delete(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentDocumentToResponse(document))
+}
+
+func (h *StudentDocumentHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrDocumentNotFound):
+		writeError(w, http.StatusNotFound, "document not found")
+	case errors.Is(err, studentErrors.ErrDocumentPurged):
+		writeError(w, http.StatusGone, err.Error())
+	case errors.Is(err, studentErrors.ErrDocumentTooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidDocumentType),
+		errors.Is(err, studentErrors.ErrInvalidDocumentFile),
+		errors.Is(err, studentErrors.ErrInvalidRetention):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "only students can manage their own documents")
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
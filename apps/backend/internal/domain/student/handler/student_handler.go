@@ -29,6 +29,7 @@ type StudentHandler struct {
 	logger         *zap.Logger
 	bankingService service.BankingDetailsServiceInterface
 	studentService service.StudentServiceInterface
+	profileChanges service.ProfileChangeServiceInterface
 	authSvc        authService.AuthServiceInterface
 	emailSender    emailInterfaces.EmailSenderInterface
 	fromEmail      string
@@ -39,6 +40,7 @@ func NewStudentHandler(
 	logger *zap.Logger,
 	bankingSvc service.BankingDetailsServiceInterface,
 	studentSvc service.StudentServiceInterface,
+	profileChangeSvc service.ProfileChangeServiceInterface,
 	authSvc authService.AuthServiceInterface,
 	emailSender emailInterfaces.EmailSenderInterface,
 	fromEmail string,
@@ -48,6 +50,7 @@ func NewStudentHandler(
 		logger:         logger,
 		bankingService: bankingSvc,
 		studentService: studentSvc,
+		profileChanges: profileChangeSvc,
 		authSvc:        authSvc,
 		emailSender:    emailSender,
 		fromEmail:      fromEmail,
@@ -63,6 +66,10 @@ func (h *StudentHandler) RegisterPublicRoutes(r chi.Router) {
 // RegisterAdminRoutes registers admin-only routes.
 func (h *StudentHandler) RegisterAdminRoutes(r chi.Router) {
 	r.Get("/students", h.List)
+	r.Get("/students/search", h.Search)
+	r.Post("/students/import/preview", h.PreviewImport)
+	r.Post("/students/import", h.Import)
+	r.Get("/students/export", h.Export)
 	r.Get("/students/{id}", h.GetByID)
 	r.Patch("/students/{id}/accept", h.Accept)
 	r.Patch("/students/{id}/reject", h.Reject)
@@ -99,10 +106,11 @@ func (h *StudentHandler) Apply(w http.ResponseWriter, r *http.Request) {
 	}
 
 	student, err := h.studentService.Apply(r.Context(), service.ApplyInput{
-		Email:              req.Email,
-		PhoneNumber:        req.PhoneNumber,
-		TranscriptMetadata: metadata,
-		Availability:       req.Availability,
+		Email:                req.Email,
+		PhoneNumber:          req.PhoneNumber,
+		TranscriptMetadata:   metadata,
+		Availability:         req.Availability,
+		TranscriptDocumentID: req.TranscriptDocumentID,
 	})
 	if err != nil {
 		h.handleStudentError(w, err)
@@ -280,8 +288,7 @@ func (h *StudentHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *StudentHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
-	studentID, err := h.studentIDFromContext(r)
-	if err != nil {
+	if _, err := h.studentIDFromContext(r); err != nil {
 		writeError(w, http.StatusUnauthorized, "authentication required")
 		return
 	}
@@ -293,13 +300,19 @@ func (h *StudentHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	input := service.UpdateStudentInput{
-		PhoneNumber:    req.PhoneNumber,
+	// Availability and hour bounds feed the active schedule, so changes to
+	// them become a change request for an admin to approve. Values equal to
+	// the current profile are not a change.
+	change := service.ProfileChangeInput{
 		Availability:   req.Availability,
 		MinWeeklyHours: req.MinWeeklyHours,
 		MaxWeeklyHours: req.MaxWeeklyHours,
 	}
 
+	input := service.UpdateStudentInput{
+		PhoneNumber: req.PhoneNumber,
+	}
+
 	if req.Courses != nil {
 		courses := make([]transcriptTypes.CourseResult, len(req.Courses))
 		for i, c := range req.Courses {
@@ -331,13 +344,21 @@ func (h *StudentHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	updated, err := h.studentService.Update(r.Context(), studentID, input)
+	updated, changeRequest, err := h.profileChanges.UpdateMine(r.Context(), input, change)
 	if err != nil {
 		h.handleStudentError(w, err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dtos.StudentToResponse(updated))
+	if changeRequest == nil {
+		writeJSON(w, http.StatusOK, dtos.StudentToResponse(updated))
+		return
+	}
+	pending := dtos.ProfileChangeRequestToResponse(changeRequest)
+	writeJSON(w, http.StatusAccepted, dtos.UpdateMeResponse{
+		StudentResponse:      dtos.StudentToResponse(updated),
+		PendingChangeRequest: &pending,
+	})
 }
 
 // --- Banking details handlers ---
@@ -562,6 +583,15 @@ func (h *StudentHandler) handleStudentError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusBadRequest, "invalid student ID")
 	case errors.Is(err, studentErrors.ErrTranscriptMismatch):
 		writeError(w, http.StatusBadRequest, "transcript does not belong to this student")
+	case errors.Is(err, studentErrors.ErrDocumentNotFound),
+		errors.Is(err, studentErrors.ErrInvalidDocumentType),
+		errors.Is(err, studentErrors.ErrDocumentPurged):
+		writeError(w, http.StatusBadRequest, "invalid transcript_document_id")
+	case errors.Is(err, studentErrors.ErrDocumentAlreadyLinked):
+		writeError(w, http.StatusConflict, "transcript document is already linked to a student")
+	case errors.Is(err, studentErrors.ErrInvalidAvailability),
+		errors.Is(err, studentErrors.ErrInvalidWeeklyHours):
+		writeError(w, http.StatusBadRequest, err.Error())
 	case errors.Is(err, studentErrors.ErrMissingAuthContext):
 		writeError(w, http.StatusUnauthorized, "authentication required")
 	default:
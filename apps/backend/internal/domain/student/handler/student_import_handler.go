@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"go.uber.org/zap"
+)
+
+const maxStudentImportSize = 5 << 20 // 5 MB
+
+// readImportFile reads the multipart "file" field and picks its format from
+// the file name.
+func (h *StudentHandler) readImportFile(w http.ResponseWriter, r *http.Request) (*bytes.Reader, spreadsheet.Format, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxStudentImportSize)
+
+	if err := r.ParseMultipartForm(maxStudentImportSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return nil, "", false
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return nil, "", false
+	}
+	defer file.Close()
+
+	format, err := spreadsheet.FormatFromFilename(header.Filename)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file must be a .csv or .xlsx file")
+		return nil, "", false
+	}
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(file); err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file")
+		return nil, "", false
+	}
+	return bytes.NewReader(buf.Bytes()), format, true
+}
+
+func (h *StudentHandler) PreviewImport(w http.ResponseWriter, r *http.Request) {
+	file, format, ok := h.readImportFile(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.studentService.PreviewImport(r.Context(), file, format)
+	if err != nil {
+		h.handleImportError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentImportToResponse(result))
+}
+
+// Import creates the students in the uploaded file. With auto_accept=true in
+// the form each student is accepted and sent an onboarding invitation, as
+// for a single accept.
+func (h *StudentHandler) Import(w http.ResponseWriter, r *http.Request) {
+	file, format, ok := h.readImportFile(w, r)
+	if !ok {
+		return
+	}
+
+	autoAccept := false
+	if v := r.FormValue("auto_accept"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "auto_accept must be true or false")
+			return
+		}
+		autoAccept = b
+	}
+
+	result, err := h.studentService.Import(r.Context(), file, format, autoAccept)
+	if err != nil {
+		h.handleImportError(w, err)
+		return
+	}
+
+	resp := dtos.StudentImportToResponse(result)
+	if len(result.Errors) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	if result.Accepted {
+		for _, student := range result.Students {
+			if err := h.inviteStudent(r.Context(), student); err != nil {
+				resp.InvitationFailures = append(resp.InvitationFailures, dtos.StudentInvitationFailureResponse{
+					StudentID: student.StudentID,
+					Error:     "failed to create onboarding invitation",
+				})
+				continue
+			}
+			resp.InvitationsSent++
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// inviteStudent creates the student's user account and onboarding token and
+// sends the acceptance email in the background.
+func (h *StudentHandler) inviteStudent(ctx context.Context, student *aggregate.Student) error {
+	rawToken, err := h.authSvc.InitiateOnboarding(ctx, student.EmailAddress, student.FirstName, student.LastName)
+	if err != nil {
+		h.logger.Error("failed to initiate onboarding", zap.Int32("studentID", student.StudentID), zap.Error(err))
+		return err
+	}
+
+	go h.sendAcceptedEmail(context.WithoutCancel(ctx), student, rawToken)
+	return nil
+}
+
+func (h *StudentHandler) Export(w http.ResponseWriter, r *http.Request) {
+	params := service.StudentExportParams{
+		Status: r.URL.Query().Get("status"),
+		Format: spreadsheet.Format_CSV,
+	}
+	if v := r.URL.Query().Get("format"); v != "" {
+		format, err := spreadsheet.ParseFormat(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "format must be csv or xlsx")
+			return
+		}
+		params.Format = format
+	}
+	if v := r.URL.Query().Get("columns"); v != "" {
+		for _, c := range strings.Split(v, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				params.Columns = append(params.Columns, c)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := h.studentService.Export(r.Context(), &buf, params); err != nil {
+		h.handleImportError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("students_%s.%s", time.Now().Format("2006-01-02"), params.Format.Extension())
+	w.Header().Set("Content-Type", params.Format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		h.logger.Error("failed to write student export", zap.Error(err))
+	}
+}
+
+func (h *StudentHandler) handleImportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrInvalidStudentImport),
+		errors.Is(err, studentErrors.ErrInvalidExportColumn),
+		errors.Is(err, studentErrors.ErrInvalidExportFormat):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.handleStudentError(w, err)
+	}
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+)
+
+// Search returns one page of the student directory. Malformed numeric
+// parameters are rejected rather than ignored, so a typo cannot silently
+// widen the results.
+func (h *StudentHandler) Search(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	params := service.StudentQueryParams{
+		Search:         q.Get("q"),
+		Status:         q.Get("status"),
+		Programme:      q.Get("programme"),
+		EligibleCourse: q.Get("eligible_course"),
+		Sort:           q.Get("sort"),
+		Cursor:         q.Get("cursor"),
+	}
+
+	switch q.Get("order") {
+	case "":
+	case "asc", "desc":
+		desc := q.Get("order") == "desc"
+		params.Descending = &desc
+	default:
+		writeError(w, http.StatusBadRequest, "order must be asc or desc")
+		return
+	}
+
+	var err error
+	if params.Year, err = queryInt32(q.Get("year")); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid year")
+		return
+	}
+	if params.MinGPA, err = queryFloat(q.Get("min_gpa")); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid min_gpa")
+		return
+	}
+	if params.MaxGPA, err = queryFloat(q.Get("max_gpa")); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid max_gpa")
+		return
+	}
+	if params.AvailableDay, err = queryInt(q.Get("day")); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid day")
+		return
+	}
+	if params.AvailableHour, err = queryInt(q.Get("hour")); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid hour")
+		return
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		params.Limit = limit
+	}
+
+	page, err := h.studentService.Query(r.Context(), params)
+	if err != nil {
+		h.handleQueryError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.StudentPageToResponse(page))
+}
+
+func (h *StudentHandler) handleQueryError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrInvalidStudentStatus),
+		errors.Is(err, studentErrors.ErrInvalidStudentSort),
+		errors.Is(err, studentErrors.ErrInvalidStudentCursor),
+		errors.Is(err, studentErrors.ErrInvalidStudentFilter):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidCourseCode):
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("eligible_course: %s", err.Error()))
+	default:
+		h.handleStudentError(w, err)
+	}
+}
+
+func queryInt(v string) (*int, error) {
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func queryInt32(v string) (*int32, error) {
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	n32 := int32(n)
+	return &n32, nil
+}
+
+func queryFloat(v string) (*float64, error) {
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
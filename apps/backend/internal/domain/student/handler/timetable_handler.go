@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+const maxTimetableSize = 1 << 20 // 1 MB
+
+type TimetableHandler struct {
+	logger  *zap.Logger
+	service service.TimetableServiceInterface
+}
+
+func NewTimetableHandler(logger *zap.Logger, service service.TimetableServiceInterface) *TimetableHandler {
+	return &TimetableHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// RegisterRoutes registers authenticated routes (any role).
+func (h *TimetableHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/students/me/timetable", h.GetMine)
+	r.Post("/students/me/timetable", h.ImportMine)
+	r.Post("/students/me/timetable/apply", h.ApplyMine)
+}
+
+func (h *TimetableHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Get("/students/{studentID}/timetable", h.GetByStudentID)
+}
+
+func (h *TimetableHandler) ImportMine(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxTimetableSize+1<<20)
+
+	if err := r.ParseMultipartForm(maxTimetableSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	proposal, err := h.service.ImportMine(r.Context(), header.Filename, data)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimetableProposalToResponse(proposal))
+}
+
+func (h *TimetableHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	proposal, err := h.service.GetMine(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimetableProposalToResponse(proposal))
+}
+
+func (h *TimetableHandler) ApplyMine(w http.ResponseWriter, r *http.Request) {
+	request, err := h.service.ApplyMine(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, dtos.ProfileChangeRequestToResponse(request))
+}
+
+func (h *TimetableHandler) GetByStudentID(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	proposal, err := h.service.GetByStudentID(r.Context(), int32(studentID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimetableProposalToResponse(proposal))
+}
+
+func (h *TimetableHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrTimetableNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, studentErrors.ErrNoProfileChanges):
+		writeError(w, http.StatusConflict, "availability already matches the timetable")
+	case errors.Is(err, studentErrors.ErrInvalidTimetable),
+		errors.Is(err, studentErrors.ErrEmptyTimetable),
+		errors.Is(err, studentErrors.ErrInvalidAvailability):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "only students have timetables")
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TrainingHandler struct {
+	logger  *zap.Logger
+	service service.TrainingServiceInterface
+}
+
+func NewTrainingHandler(logger *zap.Logger, service service.TrainingServiceInterface) *TrainingHandler {
+	return &TrainingHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// RegisterRoutes registers authenticated routes (any role).
+func (h *TrainingHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/training-requirements", h.ListRequirements)
+	r.Get("/students/me/training", h.GetMine)
+}
+
+func (h *TrainingHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Post("/training-requirements", h.CreateRequirement)
+	r.Put("/training-requirements/{id}", h.UpdateRequirement)
+	r.Get("/students/{studentID}/training", h.GetByStudent)
+	r.Post("/students/{studentID}/training", h.RecordCompletion)
+	r.Delete("/training-records/{id}", h.DeleteRecord)
+}
+
+func (h *TrainingHandler) ListRequirements(w http.ResponseWriter, r *http.Request) {
+	requirements, err := h.service.ListRequirements(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TrainingRequirementsToResponse(requirements))
+}
+
+func (h *TrainingHandler) CreateRequirement(w http.ResponseWriter, r *http.Request) {
+	var req dtos.TrainingRequirementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	requirement, err := h.service.CreateRequirement(r.Context(), requirementInput(req))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.TrainingRequirementToResponse(requirement))
+}
+
+func (h *TrainingHandler) UpdateRequirement(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid requirement ID")
+		return
+	}
+
+	var req dtos.TrainingRequirementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	requirement, err := h.service.UpdateRequirement(r.Context(), id, requirementInput(req))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TrainingRequirementToResponse(requirement))
+}
+
+func requirementInput(req dtos.TrainingRequirementRequest) service.TrainingRequirementInput {
+	return service.TrainingRequirementInput{
+		Name:           req.Name,
+		Description:    req.Description,
+		IsBlocking:     req.IsBlocking,
+		ValidityMonths: req.ValidityMonths,
+		IsActive:       req.IsActive,
+	}
+}
+
+func (h *TrainingHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	statuses, err := h.service.GetMine(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TrainingStatusesToResponse(statuses))
+}
+
+func (h *TrainingHandler) GetByStudent(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	statuses, err := h.service.GetByStudentID(r.Context(), int32(studentID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TrainingStatusesToResponse(statuses))
+}
+
+// RecordCompletion reads a multipart form of requirement_id, completed_on,
+// an optional expires_on (both YYYY-MM-DD) and an optional evidence "file".
+func (h *TrainingHandler) RecordCompletion(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	// Leave room for the multipart framing around a file at the limit.
+	r.Body = http.MaxBytesReader(w, r.Body, aggregate.MaxDocumentSize+1<<20)
+	if err := r.ParseMultipartForm(aggregate.MaxDocumentSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return
+	}
+
+	var input service.TrainingCompletionInput
+	input.RequirementID, err = uuid.Parse(r.FormValue("requirement_id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid requirement_id")
+		return
+	}
+	input.CompletedOn, err = time.Parse("2006-01-02", r.FormValue("completed_on"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid completed_on date format, expected YYYY-MM-DD")
+		return
+	}
+	if v := r.FormValue("expires_on"); v != "" {
+		expiresOn, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid expires_on date format, expected YYYY-MM-DD")
+			return
+		}
+		input.ExpiresOn = &expiresOn
+	}
+
+	file, header, err := r.FormFile("file")
+	switch {
+	case err == nil:
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read file")
+			return
+		}
+		input.Evidence = &service.TrainingEvidence{Filename: header.Filename, Content: content}
+	case !errors.Is(err, http.ErrMissingFile):
+		writeError(w, http.StatusBadRequest, "invalid file")
+		return
+	}
+
+	record, err := h.service.RecordCompletion(r.Context(), int32(studentID), input)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.TrainingRecordToResponse(record))
+}
+
+func (h *TrainingHandler) DeleteRecord(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid record ID")
+		return
+	}
+
+	if err := h.service.DeleteRecord(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *TrainingHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrTrainingRequirementNotFound),
+		errors.Is(err, studentErrors.ErrTrainingRecordNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, studentErrors.ErrDuplicateTrainingName),
+		errors.Is(err, studentErrors.ErrTrainingRequirementInactive):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, studentErrors.ErrDocumentTooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidTrainingName),
+		errors.Is(err, studentErrors.ErrInvalidTrainingValidity),
+		errors.Is(err, studentErrors.ErrInvalidTrainingDates),
+		errors.Is(err, studentErrors.ErrInvalidDocumentFile):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "only students have training records")
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
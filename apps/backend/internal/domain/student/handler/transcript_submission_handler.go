@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	transcriptErrors "github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TranscriptSubmissionHandler struct {
+	logger  *zap.Logger
+	service service.TranscriptSubmissionServiceInterface
+}
+
+func NewTranscriptSubmissionHandler(logger *zap.Logger, service service.TranscriptSubmissionServiceInterface) *TranscriptSubmissionHandler {
+	return &TranscriptSubmissionHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+// RegisterRoutes registers authenticated routes (any role).
+func (h *TranscriptSubmissionHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/students/me/transcript-submissions", h.ListMine)
+	r.Post("/students/me/transcript-submissions", h.Submit)
+}
+
+func (h *TranscriptSubmissionHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Get("/transcript-submissions", h.List)
+	r.Get("/transcript-submissions/{id}", h.Get)
+	r.Post("/transcript-submissions/{id}/approve", h.Approve)
+	r.Post("/transcript-submissions/{id}/reject", h.Reject)
+}
+
+func (h *TranscriptSubmissionHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, aggregate.MaxDocumentSize+1<<20)
+
+	if err := r.ParseMultipartForm(aggregate.MaxDocumentSize); err != nil {
+		writeError(w, http.StatusBadRequest, "file too large or invalid form data")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	pdf, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+	if len(pdf) < 5 || string(pdf[:5]) != "%PDF-" {
+		writeError(w, http.StatusBadRequest, "only PDF files are accepted")
+		return
+	}
+
+	submission, err := h.service.Submit(r.Context(), header.Filename, pdf)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.TranscriptSubmissionToResponse(submission))
+}
+
+func (h *TranscriptSubmissionHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	submissions, err := h.service.ListMine(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TranscriptSubmissionsToResponse(submissions))
+}
+
+func (h *TranscriptSubmissionHandler) List(w http.ResponseWriter, r *http.Request) {
+	submissions, err := h.service.List(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TranscriptSubmissionsToResponse(submissions))
+}
+
+func (h *TranscriptSubmissionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid submission ID")
+		return
+	}
+
+	submission, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TranscriptSubmissionToResponse(submission))
+}
+
+func (h *TranscriptSubmissionHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	id, req, ok := h.readReview(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Approve(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TranscriptReviewToResponse(result))
+}
+
+func (h *TranscriptSubmissionHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	id, req, ok := h.readReview(w, r)
+	if !ok {
+		return
+	}
+
+	submission, err := h.service.Reject(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TranscriptSubmissionToResponse(submission))
+}
+
+// readReview parses the submission ID and the optional review note. An empty
+// body means no note.
+func (h *TranscriptSubmissionHandler) readReview(w http.ResponseWriter, r *http.Request) (uuid.UUID, dtos.ReviewTranscriptSubmissionRequest, bool) {
+	var req dtos.ReviewTranscriptSubmissionRequest
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid submission ID")
+		return id, req, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return id, req, false
+	}
+	return id, req, true
+}
+
+func (h *TranscriptSubmissionHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, studentErrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "student not found")
+	case errors.Is(err, studentErrors.ErrTranscriptSubmissionNotFound):
+		writeError(w, http.StatusNotFound, "transcript submission not found")
+	case errors.Is(err, studentErrors.ErrTranscriptUnchanged),
+		errors.Is(err, studentErrors.ErrTranscriptSubmissionNotPending):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, studentErrors.ErrTranscriptMismatch):
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, studentErrors.ErrInvalidSubmissionStatus),
+		errors.Is(err, studentErrors.ErrInvalidReviewNote),
+		errors.Is(err, studentErrors.ErrInvalidDocumentFile):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, studentErrors.ErrDocumentTooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, transcriptErrors.ErrTranscriptInvalid):
+		writeError(w, http.StatusUnprocessableEntity, "could not extract data from the uploaded transcript")
+	case errors.Is(err, transcriptErrors.ErrTranscriptsUnavailable):
+		writeError(w, http.StatusServiceUnavailable, "transcript service is temporarily unavailable")
+	case errors.Is(err, transcriptErrors.ErrTranscriptsInternal),
+		errors.Is(err, transcriptErrors.ErrUnmarshalResponse):
+		writeError(w, http.StatusBadGateway, "transcript processing failed")
+	case errors.Is(err, studentErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "only students can submit transcripts")
+	case errors.Is(err, studentErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "authentication required")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
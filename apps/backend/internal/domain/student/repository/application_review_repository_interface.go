@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+type ApplicationReviewRepositoryInterface interface {
+	CreateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error)
+	GetStageByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewStage, error)
+	UpdateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error)
+	// ListStages returns every stage, active or not, ordered by position.
+	ListStages(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewStage, error)
+
+	CreateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error)
+	GetCriterionByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewCriterion, error)
+	UpdateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error)
+	// ListCriteria returns every criterion, active or not, ordered by position.
+	ListCriteria(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewCriterion, error)
+
+	AddStageChange(ctx context.Context, tx *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error)
+	// ListStageChanges returns the stage history of the given students, or of
+	// every student when studentIDs is empty, oldest first.
+	ListStageChanges(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.StageChange, error)
+
+	// AddReviewer is a no-op when the reviewer is already assigned.
+	AddReviewer(ctx context.Context, tx *sql.Tx, reviewer *aggregate.ApplicationReviewer) error
+	RemoveReviewer(ctx context.Context, tx *sql.Tx, studentID int32, reviewerID uuid.UUID) error
+	// ListReviewers returns the reviewers of the given students, or of every
+	// student when studentIDs is empty.
+	ListReviewers(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.ApplicationReviewer, error)
+
+	CreateNote(ctx context.Context, tx *sql.Tx, note *aggregate.ApplicationNote) (*aggregate.ApplicationNote, error)
+	ListNotes(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationNote, error)
+
+	// UpsertScore replaces the reviewer's existing score for the criterion.
+	UpsertScore(ctx context.Context, tx *sql.Tx, score *aggregate.ApplicationScore) (*aggregate.ApplicationScore, error)
+	ListScores(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationScore, error)
+}
+
+type InterviewSlotRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.InterviewSlot, error)
+	// GetByStudentID returns the slot booked for the student, or ErrInterviewSlotNotFound.
+	GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.InterviewSlot, error)
+	// ListFrom returns the slots starting at or after from, earliest first.
+	ListFrom(ctx context.Context, tx *sql.Tx, from time.Time) ([]*aggregate.InterviewSlot, error)
+	// Update saves the booking and invitation fields.
+	Update(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	// Book saves the booking only while the slot is still free, so of two
+	// concurrent bookings the later one gets ErrInterviewSlotBooked.
+	Book(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	// MarkInvited records when the applicant was emailed. It returns
+	// ErrInterviewSlotNotFound once the slot is no longer booked for them.
+	MarkInvited(ctx context.Context, tx *sql.Tx, id uuid.UUID, studentID int32, at time.Time) (*aggregate.InterviewSlot, error)
+	Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
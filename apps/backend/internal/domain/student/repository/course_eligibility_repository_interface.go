@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+type CourseEligibilityRepositoryInterface interface {
+	CreateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error)
+	GetRuleByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.EligibilityRule, error)
+	UpdateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error)
+	DeleteRule(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+	ListRules(ctx context.Context, tx *sql.Tx) ([]*aggregate.EligibilityRule, error)
+
+	UpsertOverride(ctx context.Context, tx *sql.Tx, override *aggregate.EligibilityOverride) (*aggregate.EligibilityOverride, error)
+	DeleteOverride(ctx context.Context, tx *sql.Tx, studentID int32, courseCode string) error
+	// ListOverrides returns the overrides for the given students, or for
+	// every student when studentIDs is empty.
+	ListOverrides(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.EligibilityOverride, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+// ProfileChangeFilter narrows List. Nil fields match all.
+type ProfileChangeFilter struct {
+	StudentID *int32
+	Status    *aggregate.ProfileChangeStatus
+}
+
+type ProfileChangeRequestRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ProfileChangeRequest, error)
+	// GetPendingByStudentID returns the student's pending request, or
+	// ErrProfileChangeNotFound if there is none.
+	GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ProfileChangeRequest, error)
+	// List returns the matching requests, newest first.
+	List(ctx context.Context, tx *sql.Tx, filter ProfileChangeFilter) ([]*aggregate.ProfileChangeRequest, error)
+	// Update saves the status, review fields and conflicts.
+	Update(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+type StudentDocumentRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.StudentDocument, error)
+	// ListByStudentID returns the student's documents, purged ones included,
+	// newest first.
+	ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.StudentDocument, error)
+	// Update saves the student link, retention date and purge time.
+	Update(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error)
+	// ListExpired returns unpurged documents whose retain_until is before now.
+	ListExpired(ctx context.Context, tx *sql.Tx, now time.Time) ([]*aggregate.StudentDocument, error)
+}
@@ -7,6 +7,48 @@ import (
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
 )
 
+// StudentSort is a column the student directory can be ordered by.
+type StudentSort string
+
+const (
+	StudentSort_AppliedAt StudentSort = "applied_at"
+	StudentSort_Name      StudentSort = "name"
+	StudentSort_StudentID StudentSort = "student_id"
+	StudentSort_GPA       StudentSort = "gpa"
+)
+
+// StudentCursor is the sort key of the last student on a page; the next page
+// starts after it. Value is empty when sorting by student ID.
+type StudentCursor struct {
+	Value     string
+	StudentID int32
+}
+
+// StudentQuery filters, orders and pages the student directory. Zero values
+// leave a filter off.
+type StudentQuery struct {
+	// Search matches every whitespace-separated term against the name,
+	// email and student ID.
+	Search    string
+	Status    string
+	Programme string
+	Year      *int32
+	MinGPA    *float64
+	MaxGPA    *float64
+	// EligibleCourse keeps students with an eligible override for the
+	// course, or a grade for it in EligibleGrades and no ineligible override.
+	EligibleCourse string
+	EligibleGrades []string
+	// AvailableDay keeps students free at some time that day, or at
+	// AvailableHour when set.
+	AvailableDay  *int
+	AvailableHour *int
+	Sort          StudentSort
+	Descending    bool
+	After         *StudentCursor
+	Limit         int
+}
+
 type StudentRepositoryInterface interface {
 	Create(ctx context.Context, tx *sql.Tx, student *aggregate.Student) (*aggregate.Student, error)
 	GetByID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.Student, error)
@@ -16,4 +58,7 @@ type StudentRepositoryInterface interface {
 	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Student, error)
 	ListByStatus(ctx context.Context, tx *sql.Tx, status string) ([]*aggregate.Student, error)
 	ListByIDs(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.Student, error)
+	// Query returns up to query.Limit students after the cursor and the
+	// number of students matching the filters on any page.
+	Query(ctx context.Context, tx *sql.Tx, query StudentQuery) ([]*aggregate.Student, int, error)
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+)
+
+type StudentTimetableRepositoryInterface interface {
+	// GetByStudentID returns the student's timetable, or ErrTimetableNotFound
+	// if none has been imported.
+	GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.StudentTimetable, error)
+	// Upsert saves the timetable, replacing the student's previous one.
+	Upsert(ctx context.Context, tx *sql.Tx, timetable *aggregate.StudentTimetable) (*aggregate.StudentTimetable, error)
+}
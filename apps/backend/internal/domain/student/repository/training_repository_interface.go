@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+type TrainingRepositoryInterface interface {
+	CreateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error)
+	GetRequirementByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TrainingRequirement, error)
+	UpdateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error)
+	ListRequirements(ctx context.Context, tx *sql.Tx) ([]*aggregate.TrainingRequirement, error)
+
+	// UpsertRecord replaces the student's record for the requirement, clearing
+	// reminder_sent_at.
+	UpsertRecord(ctx context.Context, tx *sql.Tx, record *aggregate.TrainingRecord) (*aggregate.TrainingRecord, error)
+	DeleteRecord(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+	// ListRecords returns the records of the given students.
+	ListRecords(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.TrainingRecord, error)
+	// ListUnremindedExpiring returns the records expiring on or before the
+	// given date that no reminder has been sent for.
+	ListUnremindedExpiring(ctx context.Context, tx *sql.Tx, before time.Time) ([]*aggregate.TrainingRecord, error)
+	MarkReminderSent(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, at time.Time) error
+}
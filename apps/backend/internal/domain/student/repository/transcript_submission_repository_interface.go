@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/google/uuid"
+)
+
+// TranscriptSubmissionFilter narrows ListSubmissions. Nil fields match all.
+type TranscriptSubmissionFilter struct {
+	StudentID *int32
+	Status    *aggregate.TranscriptSubmissionStatus
+}
+
+type TranscriptSubmissionRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TranscriptSubmission, error)
+	// GetPendingByStudentID returns the student's pending submission, or
+	// ErrTranscriptSubmissionNotFound if there is none.
+	GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TranscriptSubmission, error)
+	// List returns the matching submissions, newest first.
+	List(ctx context.Context, tx *sql.Tx, filter TranscriptSubmissionFilter) ([]*aggregate.TranscriptSubmission, error)
+	// Update saves the status and review fields.
+	Update(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error)
+}
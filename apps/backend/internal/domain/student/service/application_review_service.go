@@ -0,0 +1,947 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepository "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	userErrors "github.com/HDR3604/HelpDeskApp/internal/domain/user/errors"
+	userRepository "github.com/HDR3604/HelpDeskApp/internal/domain/user/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// UpdateReviewStageParams holds the stage fields to change; nil fields are kept.
+type UpdateReviewStageParams struct {
+	Name     *string
+	Position *int32
+	IsActive *bool
+}
+
+// UpdateReviewCriterionParams holds the criterion fields to change; nil fields are kept.
+type UpdateReviewCriterionParams struct {
+	Name        *string
+	Description *string
+	MaxScore    *int32
+	Position    *int32
+	IsActive    *bool
+}
+
+// ReviewQueueFilter narrows the pipeline board. ReviewerID limits it to
+// applications assigned to that reviewer.
+type ReviewQueueFilter struct {
+	StageID    *uuid.UUID
+	ReviewerID *uuid.UUID
+}
+
+// InterviewInvitationEnqueuer abstracts job enqueue operations so the service
+// does not depend on the jobqueue infrastructure package directly.
+type InterviewInvitationEnqueuer interface {
+	EnqueueInterviewInvitation(ctx context.Context, slotID uuid.UUID) error
+}
+
+type ApplicationReviewServiceInterface interface {
+	ListStages(ctx context.Context) ([]*aggregate.ReviewStage, error)
+	CreateStage(ctx context.Context, name string, position int32) (*aggregate.ReviewStage, error)
+	UpdateStage(ctx context.Context, id uuid.UUID, params UpdateReviewStageParams) (*aggregate.ReviewStage, error)
+
+	ListCriteria(ctx context.Context) ([]*aggregate.ReviewCriterion, error)
+	CreateCriterion(ctx context.Context, name, description string, maxScore, position int32) (*aggregate.ReviewCriterion, error)
+	UpdateCriterion(ctx context.Context, id uuid.UUID, params UpdateReviewCriterionParams) (*aggregate.ReviewCriterion, error)
+
+	// ListQueue returns the pending applications with their current stage.
+	ListQueue(ctx context.Context, filter ReviewQueueFilter) ([]*aggregate.ApplicationReviewSummary, error)
+	GetReview(ctx context.Context, studentID int32) (*aggregate.ApplicationReview, error)
+	MoveStage(ctx context.Context, studentID int32, stageID uuid.UUID, note string) (*aggregate.StageChange, error)
+	AssignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error
+	UnassignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error
+	AddNote(ctx context.Context, studentID int32, body string) (*aggregate.ApplicationNote, error)
+	// Score records the calling admin's score for one rubric criterion.
+	Score(ctx context.Context, studentID int32, criterionID uuid.UUID, score int32, comment string) (*aggregate.ApplicationScore, error)
+
+	ListInterviewSlots(ctx context.Context, from time.Time) ([]*aggregate.InterviewSlot, error)
+	// CreateInterviewSlot offers a new slot. locationID, if set, links it to
+	// the help desk location whose timezone invitations use.
+	CreateInterviewSlot(ctx context.Context, startsAt, endsAt time.Time, location string, locationID, interviewerID *uuid.UUID) (*aggregate.InterviewSlot, error)
+	DeleteInterviewSlot(ctx context.Context, id uuid.UUID) error
+	// BookInterview books the slot for the applicant and queues their
+	// invitation email. A slot booked concurrently by someone else returns
+	// ErrInterviewSlotBooked.
+	BookInterview(ctx context.Context, slotID uuid.UUID, studentID int32) (*aggregate.InterviewSlot, error)
+	CancelInterview(ctx context.Context, slotID uuid.UUID) (*aggregate.InterviewSlot, error)
+	// SendInterviewInvitation emails the applicant booked into the slot and
+	// sets InvitedAt. It is run by the interview invitation job.
+	SendInterviewInvitation(ctx context.Context, slotID uuid.UUID) error
+}
+
+var _ ApplicationReviewServiceInterface = (*ApplicationReviewService)(nil)
+
+type ApplicationReviewService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	reviewRepo   repository.ApplicationReviewRepositoryInterface
+	slotRepo     repository.InterviewSlotRepositoryInterface
+	studentRepo  repository.StudentRepositoryInterface
+	userRepo     userRepository.UserRepositoryInterface
+	locationRepo scheduleRepository.LocationRepositoryInterface
+	emailSender  emailInterfaces.EmailSenderInterface
+	enqueuer     InterviewInvitationEnqueuer
+	fromEmail    string
+	nowFn        func() time.Time
+}
+
+func NewApplicationReviewService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	reviewRepo repository.ApplicationReviewRepositoryInterface,
+	slotRepo repository.InterviewSlotRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	userRepo userRepository.UserRepositoryInterface,
+	locationRepo scheduleRepository.LocationRepositoryInterface,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+) *ApplicationReviewService {
+	return &ApplicationReviewService{
+		logger:       logger,
+		txManager:    txManager,
+		reviewRepo:   reviewRepo,
+		slotRepo:     slotRepo,
+		studentRepo:  studentRepo,
+		userRepo:     userRepo,
+		locationRepo: locationRepo,
+		emailSender:  emailSender,
+		fromEmail:    fromEmail,
+		nowFn:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *ApplicationReviewService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+// SetInvitationEnqueuer sets the enqueuer after construction. The job queue
+// client needs its workers up front and the invitation worker sends through
+// this service, so the service is built before the client.
+func (s *ApplicationReviewService) SetInvitationEnqueuer(enqueuer InterviewInvitationEnqueuer) {
+	s.enqueuer = enqueuer
+}
+
+func (s *ApplicationReviewService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+// actor returns the calling user's ID, or nil when the auth context has none.
+func (s *ApplicationReviewService) actor(ctx context.Context) (*uuid.UUID, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(authCtx.UserID)
+	if err != nil {
+		return nil, nil
+	}
+	return &id, nil
+}
+
+// --- Stages ---
+
+// ListStages uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *ApplicationReviewService) ListStages(ctx context.Context) ([]*aggregate.ReviewStage, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var stages []*aggregate.ReviewStage
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		stages, txErr = s.reviewRepo.ListStages(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
+
+func (s *ApplicationReviewService) CreateStage(ctx context.Context, name string, position int32) (*aggregate.ReviewStage, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	stage, err := aggregate.NewReviewStage(name, position)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ReviewStage
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		existing, txErr := s.reviewRepo.ListStages(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, st := range existing {
+			if strings.EqualFold(st.Name, stage.Name) {
+				return studentErrors.ErrReviewStageExists
+			}
+		}
+
+		result, txErr = s.reviewRepo.CreateStage(ctx, tx, stage)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create review stage", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("review stage created", zap.String("stage_id", result.ID.String()), zap.String("name", result.Name))
+	return result, nil
+}
+
+func (s *ApplicationReviewService) UpdateStage(ctx context.Context, id uuid.UUID, params UpdateReviewStageParams) (*aggregate.ReviewStage, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ReviewStage
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		stages, txErr := s.reviewRepo.ListStages(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+
+		var stage *aggregate.ReviewStage
+		for _, st := range stages {
+			if st.ID == id {
+				stage = st
+			}
+		}
+		if stage == nil {
+			return studentErrors.ErrReviewStageNotFound
+		}
+
+		name, position := stage.Name, stage.Position
+		if params.Name != nil {
+			name = *params.Name
+		}
+		if params.Position != nil {
+			position = *params.Position
+		}
+		if err := stage.Update(name, position); err != nil {
+			return err
+		}
+		if params.IsActive != nil {
+			stage.IsActive = *params.IsActive
+		}
+
+		active := 0
+		for _, st := range stages {
+			if st.ID != stage.ID && strings.EqualFold(st.Name, stage.Name) {
+				return studentErrors.ErrReviewStageExists
+			}
+			if st.IsActive {
+				active++
+			}
+		}
+		if active == 0 {
+			return studentErrors.ErrNoActiveReviewStages
+		}
+
+		result, txErr = s.reviewRepo.UpdateStage(ctx, tx, stage)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to update review stage", zap.String("stage_id", id.String()), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// --- Rubric ---
+
+func (s *ApplicationReviewService) ListCriteria(ctx context.Context) ([]*aggregate.ReviewCriterion, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var criteria []*aggregate.ReviewCriterion
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		criteria, txErr = s.reviewRepo.ListCriteria(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return criteria, nil
+}
+
+func (s *ApplicationReviewService) CreateCriterion(ctx context.Context, name, description string, maxScore, position int32) (*aggregate.ReviewCriterion, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	criterion, err := aggregate.NewReviewCriterion(name, description, maxScore, position)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ReviewCriterion
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		existing, txErr := s.reviewRepo.ListCriteria(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, c := range existing {
+			if strings.EqualFold(c.Name, criterion.Name) {
+				return studentErrors.ErrReviewCriterionExists
+			}
+		}
+
+		result, txErr = s.reviewRepo.CreateCriterion(ctx, tx, criterion)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create review criterion", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("review criterion created", zap.String("criterion_id", result.ID.String()), zap.String("name", result.Name))
+	return result, nil
+}
+
+// UpdateCriterion changes a rubric line. Lowering MaxScore does not rescale
+// scores already given.
+func (s *ApplicationReviewService) UpdateCriterion(ctx context.Context, id uuid.UUID, params UpdateReviewCriterionParams) (*aggregate.ReviewCriterion, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ReviewCriterion
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		criteria, txErr := s.reviewRepo.ListCriteria(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+
+		var criterion *aggregate.ReviewCriterion
+		for _, c := range criteria {
+			if c.ID == id {
+				criterion = c
+			}
+		}
+		if criterion == nil {
+			return studentErrors.ErrReviewCriterionNotFound
+		}
+
+		name, description, maxScore, position := criterion.Name, criterion.Description, criterion.MaxScore, criterion.Position
+		if params.Name != nil {
+			name = *params.Name
+		}
+		if params.Description != nil {
+			description = *params.Description
+		}
+		if params.MaxScore != nil {
+			maxScore = *params.MaxScore
+		}
+		if params.Position != nil {
+			position = *params.Position
+		}
+		if err := criterion.Update(name, description, maxScore, position); err != nil {
+			return err
+		}
+		if params.IsActive != nil {
+			criterion.IsActive = *params.IsActive
+		}
+
+		for _, c := range criteria {
+			if c.ID != criterion.ID && strings.EqualFold(c.Name, criterion.Name) {
+				return studentErrors.ErrReviewCriterionExists
+			}
+		}
+
+		result, txErr = s.reviewRepo.UpdateCriterion(ctx, tx, criterion)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to update review criterion", zap.String("criterion_id", id.String()), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// --- Applications ---
+
+func (s *ApplicationReviewService) ListQueue(ctx context.Context, filter ReviewQueueFilter) ([]*aggregate.ApplicationReviewSummary, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*aggregate.ApplicationReviewSummary{}
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		students, txErr := s.studentRepo.ListByStatus(ctx, tx, "pending")
+		if txErr != nil {
+			return txErr
+		}
+		if len(students) == 0 {
+			return nil
+		}
+
+		stages, txErr := s.reviewRepo.ListStages(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		history, txErr := s.reviewRepo.ListStageChanges(ctx, tx, nil)
+		if txErr != nil {
+			return txErr
+		}
+		reviewers, txErr := s.reviewRepo.ListReviewers(ctx, tx, nil)
+		if txErr != nil {
+			return txErr
+		}
+
+		historyByStudent := make(map[int32][]*aggregate.StageChange)
+		for _, c := range history {
+			historyByStudent[c.StudentID] = append(historyByStudent[c.StudentID], c)
+		}
+		reviewersByStudent := make(map[int32][]uuid.UUID)
+		for _, r := range reviewers {
+			reviewersByStudent[r.StudentID] = append(reviewersByStudent[r.StudentID], r.ReviewerID)
+		}
+
+		pipeline := aggregate.NewReviewPipeline(stages)
+		for _, st := range students {
+			summary := &aggregate.ApplicationReviewSummary{
+				Student:     st,
+				ReviewerIDs: reviewersByStudent[st.StudentID],
+			}
+			if summary.ReviewerIDs == nil {
+				summary.ReviewerIDs = []uuid.UUID{}
+			}
+			if stage, ok := pipeline.Current(historyByStudent[st.StudentID]); ok {
+				summary.Stage = stage
+			}
+			summary.EnteredAt = enteredAt(historyByStudent[st.StudentID])
+
+			if filter.StageID != nil && (summary.Stage == nil || summary.Stage.ID != *filter.StageID) {
+				continue
+			}
+			if filter.ReviewerID != nil && !containsUUID(summary.ReviewerIDs, *filter.ReviewerID) {
+				continue
+			}
+			result = append(result, summary)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to list review queue", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ApplicationReviewService) GetReview(ctx context.Context, studentID int32) (*aggregate.ApplicationReview, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ApplicationReview
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		student, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+		stages, txErr := s.reviewRepo.ListStages(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		history, txErr := s.reviewRepo.ListStageChanges(ctx, tx, []int32{studentID})
+		if txErr != nil {
+			return txErr
+		}
+		reviewers, txErr := s.reviewRepo.ListReviewers(ctx, tx, []int32{studentID})
+		if txErr != nil {
+			return txErr
+		}
+		notes, txErr := s.reviewRepo.ListNotes(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+		criteria, txErr := s.reviewRepo.ListCriteria(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		scores, txErr := s.reviewRepo.ListScores(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+		interview, txErr := s.slotRepo.GetByStudentID(ctx, tx, studentID)
+		if txErr != nil && !errors.Is(txErr, studentErrors.ErrInterviewSlotNotFound) {
+			return txErr
+		}
+
+		result = &aggregate.ApplicationReview{
+			Student:   student,
+			Reviewers: reviewers,
+			Notes:     notes,
+			Scores:    scores,
+			Summary:   aggregate.SummarizeScores(criteria, scores),
+			History:   history,
+			Interview: interview,
+		}
+		if stage, ok := aggregate.NewReviewPipeline(stages).Current(history); ok {
+			result.Stage = stage
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to get application review", zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ApplicationReviewService) MoveStage(ctx context.Context, studentID int32, stageID uuid.UUID, note string) (*aggregate.StageChange, error) {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.StageChange
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		student, txErr := s.studentRepo.GetByID(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+		stages, txErr := s.reviewRepo.ListStages(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		history, txErr := s.reviewRepo.ListStageChanges(ctx, tx, []int32{studentID})
+		if txErr != nil {
+			return txErr
+		}
+
+		change, err := aggregate.NewReviewPipeline(stages).Move(student, history, stageID, note, actor)
+		if err != nil {
+			return err
+		}
+		result, txErr = s.reviewRepo.AddStageChange(ctx, tx, change)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to move application stage", zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("application stage changed", zap.Int32("studentID", studentID), zap.String("stage_id", stageID.String()))
+	return result, nil
+}
+
+func (s *ApplicationReviewService) AssignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+
+		reviewer, txErr := s.userRepo.GetByID(ctx, tx, reviewerID.String())
+		if txErr != nil {
+			if errors.Is(txErr, userErrors.ErrUserNotFound) {
+				return studentErrors.ErrInvalidReviewer
+			}
+			return txErr
+		}
+		if reviewer.Role != userAggregate.Role_Admin || !reviewer.IsActive {
+			return studentErrors.ErrInvalidReviewer
+		}
+
+		return s.reviewRepo.AddReviewer(ctx, tx, &aggregate.ApplicationReviewer{
+			StudentID:  studentID,
+			ReviewerID: reviewerID,
+			AssignedBy: actor,
+		})
+	})
+	if err != nil {
+		s.logger.Error("failed to assign reviewer", zap.Int32("studentID", studentID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *ApplicationReviewService) UnassignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		return s.reviewRepo.RemoveReviewer(ctx, tx, studentID, reviewerID)
+	})
+}
+
+func (s *ApplicationReviewService) AddNote(ctx context.Context, studentID int32, body string) (*aggregate.ApplicationNote, error) {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ApplicationNote
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+		stageID, txErr := s.currentStageID(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+
+		note, err := aggregate.NewApplicationNote(studentID, stageID, actor, body)
+		if err != nil {
+			return err
+		}
+		result, txErr = s.reviewRepo.CreateNote(ctx, tx, note)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to add application note", zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ApplicationReviewService) Score(ctx context.Context, studentID int32, criterionID uuid.UUID, score int32, comment string) (*aggregate.ApplicationScore, error) {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if actor == nil {
+		return nil, studentErrors.ErrInvalidAuthContext
+	}
+
+	var result *aggregate.ApplicationScore
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+		criterion, txErr := s.reviewRepo.GetCriterionByID(ctx, tx, criterionID)
+		if txErr != nil {
+			return txErr
+		}
+
+		entry, err := aggregate.NewApplicationScore(studentID, criterion, *actor, score, comment)
+		if err != nil {
+			return err
+		}
+		result, txErr = s.reviewRepo.UpsertScore(ctx, tx, entry)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to score application", zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// currentStageID returns the ID of the stage the application is in, or nil
+// when no stages are configured.
+func (s *ApplicationReviewService) currentStageID(ctx context.Context, tx *sql.Tx, studentID int32) (*uuid.UUID, error) {
+	stages, err := s.reviewRepo.ListStages(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.reviewRepo.ListStageChanges(ctx, tx, []int32{studentID})
+	if err != nil {
+		return nil, err
+	}
+	stage, ok := aggregate.NewReviewPipeline(stages).Current(history)
+	if !ok {
+		return nil, nil
+	}
+	id := stage.ID
+	return &id, nil
+}
+
+// --- Interviews ---
+
+func (s *ApplicationReviewService) ListInterviewSlots(ctx context.Context, from time.Time) ([]*aggregate.InterviewSlot, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var slots []*aggregate.InterviewSlot
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		slots, txErr = s.slotRepo.ListFrom(ctx, tx, from)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+func (s *ApplicationReviewService) CreateInterviewSlot(ctx context.Context, startsAt, endsAt time.Time, location string, locationID, interviewerID *uuid.UUID) (*aggregate.InterviewSlot, error) {
+	actor, err := s.actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := aggregate.NewInterviewSlot(startsAt, endsAt, location, locationID, interviewerID, actor)
+	if err != nil {
+		return nil, err
+	}
+	if !slot.StartsAt.After(s.nowFn()) {
+		return nil, studentErrors.ErrInterviewSlotInPast
+	}
+
+	var result *aggregate.InterviewSlot
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if interviewerID != nil {
+			interviewer, txErr := s.userRepo.GetByID(ctx, tx, interviewerID.String())
+			if txErr != nil {
+				if errors.Is(txErr, userErrors.ErrUserNotFound) {
+					return studentErrors.ErrInvalidReviewer
+				}
+				return txErr
+			}
+			if interviewer.Role != userAggregate.Role_Admin || !interviewer.IsActive {
+				return studentErrors.ErrInvalidReviewer
+			}
+		}
+		if locationID != nil {
+			if _, txErr := s.locationRepo.GetByID(ctx, tx, *locationID); txErr != nil {
+				if errors.Is(txErr, scheduleErrors.ErrLocationNotFound) {
+					return studentErrors.ErrUnknownInterviewLocation
+				}
+				return txErr
+			}
+		}
+
+		var txErr error
+		result, txErr = s.slotRepo.Create(ctx, tx, slot)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create interview slot", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteInterviewSlot removes an unbooked slot. Booked slots must be
+// cancelled first so the applicant is not left holding a deleted interview.
+func (s *ApplicationReviewService) DeleteInterviewSlot(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		slot, txErr := s.slotRepo.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if slot.StudentID != nil {
+			return studentErrors.ErrInterviewSlotBooked
+		}
+		return s.slotRepo.Delete(ctx, tx, id)
+	})
+}
+
+// BookInterview books the slot with a conditional update, so two admins
+// booking the same slot cannot both succeed. The invitation is sent by a job
+// queued after the booking commits.
+func (s *ApplicationReviewService) BookInterview(ctx context.Context, slotID uuid.UUID, studentID int32) (*aggregate.InterviewSlot, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.InterviewSlot
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		student, txErr := s.studentRepo.GetByID(ctx, tx, studentID)
+		if txErr != nil {
+			return txErr
+		}
+		if student.AcceptedAt != nil || student.RejectedAt != nil {
+			return studentErrors.ErrApplicationDecided
+		}
+
+		if _, txErr := s.slotRepo.GetByStudentID(ctx, tx, studentID); txErr == nil {
+			return studentErrors.ErrInterviewAlreadyBooked
+		} else if !errors.Is(txErr, studentErrors.ErrInterviewSlotNotFound) {
+			return txErr
+		}
+
+		slot, txErr := s.slotRepo.GetByID(ctx, tx, slotID)
+		if txErr != nil {
+			return txErr
+		}
+		if err := slot.Book(studentID, s.nowFn()); err != nil {
+			return err
+		}
+		result, txErr = s.slotRepo.Book(ctx, tx, slot)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to book interview", zap.String("slot_id", slotID.String()), zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("interview booked", zap.String("slot_id", slotID.String()), zap.Int32("studentID", studentID))
+
+	// The booking is already committed, so a failure is logged rather than
+	// returned; InvitedAt stays nil and the admin can see no email went out.
+	if err := s.enqueuer.EnqueueInterviewInvitation(ctx, result.ID); err != nil {
+		s.logger.Error("failed to enqueue interview invitation", zap.String("slot_id", slotID.String()), zap.Error(err))
+	}
+	return result, nil
+}
+
+func (s *ApplicationReviewService) CancelInterview(ctx context.Context, slotID uuid.UUID) (*aggregate.InterviewSlot, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.InterviewSlot
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		slot, txErr := s.slotRepo.GetByID(ctx, tx, slotID)
+		if txErr != nil {
+			return txErr
+		}
+		if err := slot.Cancel(); err != nil {
+			return err
+		}
+		result, txErr = s.slotRepo.Update(ctx, tx, slot)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to cancel interview", zap.String("slot_id", slotID.String()), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendInterviewInvitation uses InSystemTx; it runs from the invitation job
+// with no caller. A slot that was cancelled or already invited is skipped, so
+// a job queued for an earlier booking does not email the wrong applicant.
+func (s *ApplicationReviewService) SendInterviewInvitation(ctx context.Context, slotID uuid.UUID) error {
+	var (
+		slot     *aggregate.InterviewSlot
+		student  *aggregate.Student
+		location = &scheduleAggregate.Location{Timezone: scheduleAggregate.DefaultLocationTimezone}
+	)
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		slot, txErr = s.slotRepo.GetByID(ctx, tx, slotID)
+		if txErr != nil {
+			return txErr
+		}
+		if slot.StudentID == nil || slot.InvitedAt != nil {
+			return nil
+		}
+		student, txErr = s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, *slot.StudentID)
+		if txErr != nil || slot.LocationID == nil {
+			return txErr
+		}
+		linked, txErr := s.locationRepo.GetByID(ctx, tx, *slot.LocationID)
+		if txErr != nil {
+			return txErr
+		}
+		location = linked
+		return nil
+	})
+	if errors.Is(err, studentErrors.ErrInterviewSlotNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if student == nil {
+		return nil
+	}
+
+	if _, err := s.emailSender.Send(ctx, s.invitationEmail(slot, student, location.TimeLocation())); err != nil {
+		return fmt.Errorf("failed to send interview invitation: %w", err)
+	}
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		_, txErr := s.slotRepo.MarkInvited(ctx, tx, slot.ID, student.StudentID, s.nowFn())
+		return txErr
+	})
+	if errors.Is(err, studentErrors.ErrInterviewSlotNotFound) {
+		// Cancelled while the email was being sent.
+		return nil
+	}
+	return err
+}
+
+// invitationEmail shows the interview time in tz, the timezone of the slot's
+// location, or the default location's for slots not linked to one.
+func (s *ApplicationReviewService) invitationEmail(slot *aggregate.InterviewSlot, student *aggregate.Student, tz *time.Location) dtos.SendEmailRequest {
+	start, end := slot.StartsAt.In(tz), slot.EndsAt.In(tz)
+
+	return dtos.SendEmailRequest{
+		From:    s.fromEmail,
+		To:      []string{student.EmailAddress},
+		Subject: "Interview Invitation - DCIT Help Desk",
+		Template: &types.EmailTemplate{
+			ID: templates.TemplateID_InterviewInvitation,
+			Variables: map[string]any{
+				"STUDENT_NAME":   fmt.Sprintf("%s %s", student.FirstName, student.LastName),
+				"INTERVIEW_DATE": start.Format("Monday, 2 January 2006"),
+				"INTERVIEW_TIME": fmt.Sprintf("%s – %s", start.Format("3:04 PM"), end.Format("3:04 PM")),
+				"LOCATION":       slot.Location,
+				"CONTACT_EMAIL":  s.fromEmail,
+			},
+		},
+	}
+}
+
+// enteredAt returns when the application entered its current stage, or nil
+// if it has never been moved.
+func enteredAt(history []*aggregate.StageChange) *time.Time {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ToStageID != nil {
+			t := history[i].CreatedAt
+			return &t
+		}
+	}
+	return nil
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type CourseEligibilityServiceInterface interface {
+	ListRules(ctx context.Context) ([]*aggregate.EligibilityRule, error)
+	CreateRule(ctx context.Context, courseCode *string, courseLevel *int32, minGrade string) (*aggregate.EligibilityRule, error)
+	UpdateRule(ctx context.Context, id uuid.UUID, minGrade string) (*aggregate.EligibilityRule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	SetOverride(ctx context.Context, studentID int32, courseCode string, eligible bool, reason string) (*aggregate.EligibilityOverride, error)
+	DeleteOverride(ctx context.Context, studentID int32, courseCode string) error
+	// GetMatrix returns the eligibility of one student, or of every accepted
+	// student when studentID is nil.
+	GetMatrix(ctx context.Context, studentID *int32) ([]*aggregate.StudentEligibility, error)
+	// Evaluate returns the eligibility of the given students, in order. It is
+	// used to build the course lists sent to the scheduler.
+	Evaluate(ctx context.Context, students []*aggregate.Student) ([]*aggregate.StudentEligibility, error)
+}
+
+var _ CourseEligibilityServiceInterface = (*CourseEligibilityService)(nil)
+
+type CourseEligibilityService struct {
+	logger          *zap.Logger
+	txManager       database.TxManagerInterface
+	eligibilityRepo repository.CourseEligibilityRepositoryInterface
+	studentRepo     repository.StudentRepositoryInterface
+}
+
+func NewCourseEligibilityService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	eligibilityRepo repository.CourseEligibilityRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+) *CourseEligibilityService {
+	return &CourseEligibilityService{
+		logger:          logger,
+		txManager:       txManager,
+		eligibilityRepo: eligibilityRepo,
+		studentRepo:     studentRepo,
+	}
+}
+
+func (s *CourseEligibilityService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+// ListRules uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *CourseEligibilityService) ListRules(ctx context.Context) ([]*aggregate.EligibilityRule, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*aggregate.EligibilityRule
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		rules, txErr = s.eligibilityRepo.ListRules(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *CourseEligibilityService) CreateRule(ctx context.Context, courseCode *string, courseLevel *int32, minGrade string) (*aggregate.EligibilityRule, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	rule, err := aggregate.NewEligibilityRule(courseCode, courseLevel, minGrade)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.EligibilityRule
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		existing, txErr := s.eligibilityRepo.ListRules(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		for _, r := range existing {
+			if r.SameTarget(rule) {
+				return studentErrors.ErrEligibilityRuleExists
+			}
+		}
+
+		result, txErr = s.eligibilityRepo.CreateRule(ctx, tx, rule)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create eligibility rule", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("eligibility rule created", zap.String("rule_id", result.ID.String()), zap.String("min_grade", result.MinGrade))
+	return result, nil
+}
+
+func (s *CourseEligibilityService) UpdateRule(ctx context.Context, id uuid.UUID, minGrade string) (*aggregate.EligibilityRule, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.EligibilityRule
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		rule, txErr := s.eligibilityRepo.GetRuleByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if err := rule.SetMinGrade(minGrade); err != nil {
+			return err
+		}
+		result, txErr = s.eligibilityRepo.UpdateRule(ctx, tx, rule)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to update eligibility rule", zap.String("rule_id", id.String()), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *CourseEligibilityService) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		return s.eligibilityRepo.DeleteRule(ctx, tx, id)
+	})
+}
+
+// SetOverride replaces any existing override for the student and course.
+func (s *CourseEligibilityService) SetOverride(ctx context.Context, studentID int32, courseCode string, eligible bool, reason string) (*aggregate.EligibilityOverride, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		createdBy = &id
+	}
+
+	override, err := aggregate.NewEligibilityOverride(studentID, courseCode, eligible, reason, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.EligibilityOverride
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+		var txErr error
+		result, txErr = s.eligibilityRepo.UpsertOverride(ctx, tx, override)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to set eligibility override", zap.Int32("studentID", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("eligibility override set",
+		zap.Int32("studentID", studentID),
+		zap.String("course_code", result.CourseCode),
+		zap.Bool("eligible", result.Eligible),
+	)
+	return result, nil
+}
+
+func (s *CourseEligibilityService) DeleteOverride(ctx context.Context, studentID int32, courseCode string) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		return s.eligibilityRepo.DeleteOverride(ctx, tx, studentID, aggregate.NormalizeCourseCode(courseCode))
+	})
+}
+
+// GetMatrix uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *CourseEligibilityService) GetMatrix(ctx context.Context, studentID *int32) ([]*aggregate.StudentEligibility, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.StudentEligibility
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var students []*aggregate.Student
+		if studentID != nil {
+			student, txErr := s.studentRepo.GetByID(ctx, tx, *studentID)
+			if txErr != nil {
+				return txErr
+			}
+			students = []*aggregate.Student{student}
+		} else {
+			var txErr error
+			students, txErr = s.studentRepo.ListByStatus(ctx, tx, "accepted")
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		var txErr error
+		result, txErr = s.evaluate(ctx, tx, students, studentID != nil)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Evaluate uses InAuthTx so RLS scopes the SELECT (admins see all via policy).
+func (s *CourseEligibilityService) Evaluate(ctx context.Context, students []*aggregate.Student) ([]*aggregate.StudentEligibility, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.StudentEligibility
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.evaluate(ctx, tx, students, true)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// evaluate loads the rules and overrides and applies them to students. With
+// filterOverrides false every override is loaded in one query, which is
+// cheaper than an IN list covering all accepted students.
+func (s *CourseEligibilityService) evaluate(ctx context.Context, tx *sql.Tx, students []*aggregate.Student, filterOverrides bool) ([]*aggregate.StudentEligibility, error) {
+	result := make([]*aggregate.StudentEligibility, 0, len(students))
+	if len(students) == 0 {
+		return result, nil
+	}
+
+	rules, err := s.eligibilityRepo.ListRules(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int32
+	if filterOverrides {
+		ids = make([]int32, len(students))
+		for i, st := range students {
+			ids[i] = st.StudentID
+		}
+	}
+	overrides, err := s.eligibilityRepo.ListOverrides(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byStudent := make(map[int32][]*aggregate.EligibilityOverride)
+	for _, o := range overrides {
+		byStudent[o.StudentID] = append(byStudent[o.StudentID], o)
+	}
+
+	policy := aggregate.NewEligibilityPolicy(rules)
+	for _, st := range students {
+		result = append(result, policy.Evaluate(st, byStudent[st.StudentID]))
+	}
+	return result, nil
+}
@@ -0,0 +1,433 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepository "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ProfileChangeServiceInterface interface {
+	// Request records the caller's change to availability or weekly hours
+	// for an admin to approve, superseding their pending request, if any.
+	// It returns ErrNoProfileChanges when nothing differs from the profile.
+	Request(ctx context.Context, input ProfileChangeInput) (*aggregate.ProfileChangeRequest, error)
+	// UpdateMine saves the caller's phone number and transcript and requests
+	// the change, if any, in one transaction, so neither is kept without the
+	// other. The request is nil when change matches the profile.
+	UpdateMine(ctx context.Context, input UpdateStudentInput, change ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error)
+	ListMine(ctx context.Context) ([]*aggregate.ProfileChangeRequest, error)
+
+	// List and GetByID recompute the conflicts of pending requests against
+	// the current active schedule.
+	List(ctx context.Context, status string) ([]*aggregate.ProfileChangeRequest, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*aggregate.ProfileChangeRequest, error)
+	Approve(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error)
+	Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error)
+}
+
+// ProfileChangeInput holds the schedule-critical profile fields. Nil fields
+// are left unchanged.
+type ProfileChangeInput struct {
+	Availability   *json.RawMessage
+	MinWeeklyHours *float64
+	MaxWeeklyHours *float64
+}
+
+// IsEmpty reports whether the input sets no field.
+func (i ProfileChangeInput) IsEmpty() bool {
+	return i.Availability == nil && i.MinWeeklyHours == nil && i.MaxWeeklyHours == nil
+}
+
+type ProfileChangeService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	requestRepo  repository.ProfileChangeRequestRepositoryInterface
+	studentRepo  repository.StudentRepositoryInterface
+	scheduleRepo scheduleRepository.ScheduleRepositoryInterface
+	courseRepo   scheduleRepository.CourseRepositoryInterface
+	nowFn        func() time.Time
+}
+
+var _ ProfileChangeServiceInterface = (*ProfileChangeService)(nil)
+
+func NewProfileChangeService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	requestRepo repository.ProfileChangeRequestRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	scheduleRepo scheduleRepository.ScheduleRepositoryInterface,
+	courseRepo scheduleRepository.CourseRepositoryInterface,
+) *ProfileChangeService {
+	return &ProfileChangeService{
+		logger:       logger,
+		txManager:    txManager,
+		requestRepo:  requestRepo,
+		studentRepo:  studentRepo,
+		scheduleRepo: scheduleRepo,
+		courseRepo:   courseRepo,
+		nowFn:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *ProfileChangeService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *ProfileChangeService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *ProfileChangeService) myStudentID(authCtx database.AuthContext) (int32, error) {
+	if authCtx.StudentID == nil {
+		return 0, studentErrors.ErrNotAuthorized
+	}
+	id, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		s.logger.Error("invalid student ID in auth context", zap.String("student_id", *authCtx.StudentID), zap.Error(err))
+		return 0, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAuthContext, err)
+	}
+	return int32(id), nil
+}
+
+// Request uses InSystemTx because it closes the previous pending request,
+// which students cannot update, and reads the active schedule; the student
+// ID comes from the auth context.
+func (s *ProfileChangeService) Request(ctx context.Context, input ProfileChangeInput) (*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ProfileChangeRequest
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		student, err := s.studentRepo.GetByID(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+		result, err = s.request(ctx, tx, student, input)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, studentErrors.ErrNoProfileChanges) {
+			s.logger.Error("failed to request profile change", zap.Int32("student_id", studentID), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("profile change requested",
+		zap.Int32("student_id", studentID),
+		zap.String("id", result.ID.String()),
+		zap.Int("conflicts", len(result.Conflicts)))
+	return result, nil
+}
+
+// UpdateMine uses InSystemTx for the same reasons as Request; students cannot
+// update their own row either.
+func (s *ProfileChangeService) UpdateMine(ctx context.Context, input UpdateStudentInput, change ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		student *aggregate.Student
+		request *aggregate.ProfileChangeRequest
+	)
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		student, err = s.studentRepo.GetByID(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+
+		if !change.IsEmpty() {
+			request, err = s.request(ctx, tx, student, change)
+			if err != nil && !errors.Is(err, studentErrors.ErrNoProfileChanges) {
+				return err
+			}
+		}
+
+		if input.PhoneNumber == nil && input.Courses == nil {
+			return nil
+		}
+		if err := applyStudentUpdate(ctx, tx, s.courseRepo, s.logger, student, input); err != nil {
+			return err
+		}
+		return s.studentRepo.Update(ctx, tx, student)
+	})
+	if err != nil {
+		s.logger.Error("failed to update own profile", zap.Int32("student_id", studentID), zap.Error(err))
+		return nil, nil, err
+	}
+
+	s.logger.Info("own profile updated", zap.Int32("student_id", studentID), zap.Bool("change_requested", request != nil))
+	return student, request, nil
+}
+
+// request records input as the student's pending change, superseding the
+// previous one.
+func (s *ProfileChangeService) request(ctx context.Context, tx *sql.Tx, student *aggregate.Student, input ProfileChangeInput) (*aggregate.ProfileChangeRequest, error) {
+	request, err := aggregate.NewProfileChangeRequest(student, input.Availability, input.MinWeeklyHours, input.MaxWeeklyHours)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.activeAssignments(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	request.Conflicts = request.FindConflicts(assignments[student.StudentID])
+
+	pending, err := s.requestRepo.GetPendingByStudentID(ctx, tx, student.StudentID)
+	switch {
+	case err == nil:
+		if err := pending.Supersede(s.nowFn()); err != nil {
+			return nil, err
+		}
+		if _, err := s.requestRepo.Update(ctx, tx, pending); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, studentErrors.ErrProfileChangeNotFound):
+		return nil, err
+	}
+
+	return s.requestRepo.Create(ctx, tx, request)
+}
+
+// ListMine uses InAuthTx so RLS applies. Conflicts are as of the request.
+func (s *ProfileChangeService) ListMine(ctx context.Context) ([]*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.ProfileChangeRequest
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.requestRepo.List(ctx, tx, repository.ProfileChangeFilter{StudentID: &studentID})
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// List uses InAuthTx so RLS applies.
+func (s *ProfileChangeService) List(ctx context.Context, status string) ([]*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.ProfileChangeFilter{}
+	if status != "" {
+		st, err := aggregate.ParseProfileChangeStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &st
+	}
+
+	var result []*aggregate.ProfileChangeRequest
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		requests, txErr := s.requestRepo.List(ctx, tx, filter)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr := s.refreshConflicts(ctx, tx, requests); txErr != nil {
+			return txErr
+		}
+		result = requests
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetByID uses InAuthTx so RLS applies.
+func (s *ProfileChangeService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ProfileChangeRequest
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		request, txErr := s.requestRepo.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr := s.refreshConflicts(ctx, tx, []*aggregate.ProfileChangeRequest{request}); txErr != nil {
+			return txErr
+		}
+		result = request
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Approve uses InSystemTx because UPDATE on auth.profile_change_requests is
+// only granted to the internal role. The conflicts saved with the request are
+// those at approval, i.e. the assignments that now need to be reassigned.
+func (s *ProfileChangeService) Approve(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ProfileChangeRequest
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		request, err := s.requestRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := request.Approve(reviewerID(authCtx), note, s.nowFn()); err != nil {
+			return err
+		}
+
+		student, err := s.studentRepo.GetByID(ctx, tx, request.StudentID)
+		if err != nil {
+			return err
+		}
+		if err := request.ApplyTo(student); err != nil {
+			return err
+		}
+		if err := s.studentRepo.Update(ctx, tx, student); err != nil {
+			return err
+		}
+
+		assignments, err := s.activeAssignments(ctx, tx)
+		if err != nil {
+			return err
+		}
+		request.Conflicts = request.FindConflicts(assignments[request.StudentID])
+
+		result, err = s.requestRepo.Update(ctx, tx, request)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to approve profile change", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	if len(result.Conflicts) > 0 {
+		s.logger.Warn("approved profile change conflicts with active schedule",
+			zap.Int32("student_id", result.StudentID),
+			zap.Int("conflicts", len(result.Conflicts)))
+	}
+	s.logger.Info("profile change approved", zap.String("id", id.String()))
+	return result, nil
+}
+
+// Reject uses InSystemTx because UPDATE on auth.profile_change_requests is
+// only granted to the internal role.
+func (s *ProfileChangeService) Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ProfileChangeRequest
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		request, err := s.requestRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := request.Reject(reviewerID(authCtx), note, s.nowFn()); err != nil {
+			return err
+		}
+		result, err = s.requestRepo.Update(ctx, tx, request)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to reject profile change", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("profile change rejected", zap.String("id", id.String()))
+	return result, nil
+}
+
+// refreshConflicts recomputes the conflicts of pending requests against the
+// active schedule. Reviewed requests keep the conflicts they were saved with.
+func (s *ProfileChangeService) refreshConflicts(ctx context.Context, tx *sql.Tx, requests []*aggregate.ProfileChangeRequest) error {
+	pending := false
+	for _, r := range requests {
+		if r.IsPending() {
+			pending = true
+			break
+		}
+	}
+	if !pending {
+		return nil
+	}
+
+	assignments, err := s.activeAssignments(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, r := range requests {
+		if r.IsPending() {
+			r.Conflicts = r.FindConflicts(assignments[r.StudentID])
+		}
+	}
+	return nil
+}
+
+// activeAssignments returns the active schedule's assignments by student.
+// There are none when no schedule is active.
+func (s *ProfileChangeService) activeAssignments(ctx context.Context, tx *sql.Tx) (map[int32][]aggregate.StudentAssignment, error) {
+	schedule, err := s.scheduleRepo.GetActive(ctx, tx)
+	if err != nil {
+		if errors.Is(err, scheduleErrors.ErrNotFound) {
+			return map[int32][]aggregate.StudentAssignment{}, nil
+		}
+		return nil, err
+	}
+	if schedule == nil {
+		return map[int32][]aggregate.StudentAssignment{}, nil
+	}
+
+	byStudent, err := aggregate.AssignmentsByStudent(schedule.Assignments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schedule assignments: %w", err)
+	}
+	return byStudent, nil
+}
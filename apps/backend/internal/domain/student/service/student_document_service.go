@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/storage"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type StudentDocumentServiceInterface interface {
+	// StorePending keeps a transcript uploaded before its applicant exists.
+	// It is purged after a day unless an application links it.
+	StorePending(ctx context.Context, filename string, content []byte) (*aggregate.StudentDocument, error)
+
+	UploadMyDocument(ctx context.Context, documentType, filename string, content []byte) (*aggregate.StudentDocument, error)
+	ListMyDocuments(ctx context.Context) ([]*aggregate.StudentDocument, error)
+
+	UploadDocumentByStudentID(ctx context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error)
+	ListDocumentsByStudentID(ctx context.Context, studentID int32) ([]*aggregate.StudentDocument, error)
+
+	// Download returns the document and its decrypted content. RLS limits
+	// students to their own documents.
+	Download(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, []byte, error)
+	SetRetention(ctx context.Context, id uuid.UUID, retainUntil time.Time) (*aggregate.StudentDocument, error)
+	// Delete purges the stored file now, keeping the metadata.
+	Delete(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, error)
+	// PurgeExpired purges every document past its retain_until date and
+	// returns how many were purged.
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type StudentDocumentService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	documentRepo repository.StudentDocumentRepositoryInterface
+	studentRepo  repository.StudentRepositoryInterface
+	store        storage.ObjectStore
+	nowFn        func() time.Time
+}
+
+var _ StudentDocumentServiceInterface = (*StudentDocumentService)(nil)
+
+func NewStudentDocumentService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	documentRepo repository.StudentDocumentRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	store storage.ObjectStore,
+) *StudentDocumentService {
+	return &StudentDocumentService{
+		logger:       logger,
+		txManager:    txManager,
+		documentRepo: documentRepo,
+		studentRepo:  studentRepo,
+		store:        store,
+		nowFn:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *StudentDocumentService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *StudentDocumentService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *StudentDocumentService) myStudentID(authCtx database.AuthContext) (int32, error) {
+	if authCtx.StudentID == nil {
+		return 0, studentErrors.ErrNotAuthorized
+	}
+	id, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		s.logger.Error("invalid student ID in auth context", zap.String("student_id", *authCtx.StudentID), zap.Error(err))
+		return 0, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAuthContext, err)
+	}
+	return int32(id), nil
+}
+
+// StorePending uses InSystemTx; the caller is an anonymous applicant, so
+// there is no auth context to apply RLS with.
+func (s *StudentDocumentService) StorePending(ctx context.Context, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	doc, err := aggregate.NewStudentDocument(nil, string(aggregate.DocumentType_Transcript), filename, content, nil, s.nowFn())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, doc, content, func(fn func(tx *sql.Tx) error) error {
+		return s.txManager.InSystemTx(ctx, fn)
+	})
+}
+
+func (s *StudentDocumentService) UploadMyDocument(ctx context.Context, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+	return s.upload(ctx, authCtx, studentID, documentType, filename, content)
+}
+
+func (s *StudentDocumentService) UploadDocumentByStudentID(ctx context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.upload(ctx, authCtx, studentID, documentType, filename, content)
+}
+
+// upload uses InAuthTx so the insert policy decides whose documents the
+// caller may add.
+func (s *StudentDocumentService) upload(ctx context.Context, authCtx database.AuthContext, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	var uploadedBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		uploadedBy = &id
+	}
+
+	doc, err := aggregate.NewStudentDocument(&studentID, documentType, filename, content, uploadedBy, s.nowFn())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, doc, content, func(fn func(tx *sql.Tx) error) error {
+		return s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+			if _, err := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); err != nil {
+				return err
+			}
+			return fn(tx)
+		})
+	})
+}
+
+// save writes the encrypted file and then its metadata row. The file is
+// removed again if the row cannot be written, so nothing is stored without
+// metadata pointing at it.
+func (s *StudentDocumentService) save(ctx context.Context, doc *aggregate.StudentDocument, content []byte, inTx func(fn func(tx *sql.Tx) error) error) (*aggregate.StudentDocument, error) {
+	if err := s.store.Put(ctx, doc.StorageKey, content); err != nil {
+		s.logger.Error("failed to store document", zap.String("id", doc.ID.String()), zap.Error(err))
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+
+	var result *aggregate.StudentDocument
+	err := inTx(func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.documentRepo.Create(ctx, tx, doc)
+		return txErr
+	})
+	if err != nil {
+		if delErr := s.store.Delete(ctx, doc.StorageKey); delErr != nil {
+			s.logger.Error("failed to remove orphaned document", zap.String("storage_key", doc.StorageKey), zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	s.logger.Info("document stored",
+		zap.String("id", result.ID.String()),
+		zap.String("type", string(result.DocumentType)),
+		zap.Int64("size_bytes", result.SizeBytes),
+	)
+	return result, nil
+}
+
+func (s *StudentDocumentService) ListMyDocuments(ctx context.Context) ([]*aggregate.StudentDocument, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, authCtx, studentID)
+}
+
+func (s *StudentDocumentService) ListDocumentsByStudentID(ctx context.Context, studentID int32) ([]*aggregate.StudentDocument, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, authCtx, studentID)
+}
+
+// list uses InAuthTx so RLS applies.
+func (s *StudentDocumentService) list(ctx context.Context, authCtx database.AuthContext, studentID int32) ([]*aggregate.StudentDocument, error) {
+	var result []*aggregate.StudentDocument
+	err := s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.documentRepo.ListByStudentID(ctx, tx, studentID)
+		return txErr
+	})
+	return result, err
+}
+
+// Download uses InAuthTx so RLS applies: another student's document reads as
+// not found.
+func (s *StudentDocumentService) Download(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, []byte, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc *aggregate.StudentDocument
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		doc, txErr = s.documentRepo.GetByID(ctx, tx, id)
+		return txErr
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if doc.IsPurged() {
+		return nil, nil, studentErrors.ErrDocumentPurged
+	}
+
+	content, err := s.store.Get(ctx, doc.StorageKey)
+	if err != nil {
+		s.logger.Error("failed to read stored document", zap.String("id", id.String()), zap.Error(err))
+		return nil, nil, fmt.Errorf("failed to read stored document: %w", err)
+	}
+	return doc, content, nil
+}
+
+// SetRetention uses InSystemTx because UPDATE on auth.student_documents is
+// only granted to the internal role.
+func (s *StudentDocumentService) SetRetention(ctx context.Context, id uuid.UUID, retainUntil time.Time) (*aggregate.StudentDocument, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.StudentDocument
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		doc, txErr := s.documentRepo.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr := doc.SetRetention(retainUntil, s.nowFn()); txErr != nil {
+			return txErr
+		}
+		result, txErr = s.documentRepo.Update(ctx, tx, doc)
+		return txErr
+	})
+	return result, err
+}
+
+// Delete uses InSystemTx because purging a document updates its row, and
+// UPDATE on auth.student_documents is only granted to the internal role.
+func (s *StudentDocumentService) Delete(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.StudentDocument
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		doc, txErr := s.documentRepo.GetByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if doc.IsPurged() {
+			return studentErrors.ErrDocumentPurged
+		}
+		result, txErr = s.purge(ctx, tx, doc)
+		return txErr
+	})
+	return result, err
+}
+
+// PurgeExpired uses InSystemTx; it runs from the retention job. Each document
+// is purged in its own transaction so one failure does not hold back the rest.
+func (s *StudentDocumentService) PurgeExpired(ctx context.Context) (int, error) {
+	var expired []*aggregate.StudentDocument
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		expired, txErr = s.documentRepo.ListExpired(ctx, tx, s.nowFn())
+		return txErr
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, doc := range expired {
+		err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+			_, txErr := s.purge(ctx, tx, doc)
+			return txErr
+		})
+		if err != nil {
+			s.logger.Error("failed to purge expired document", zap.String("id", doc.ID.String()), zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
+
+// purge deletes the stored file before marking the row. If the update then
+// fails the file is already gone, and a retry only repeats the idempotent
+// delete.
+func (s *StudentDocumentService) purge(ctx context.Context, tx *sql.Tx, doc *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+	if err := s.store.Delete(ctx, doc.StorageKey); err != nil {
+		return nil, fmt.Errorf("failed to delete stored document: %w", err)
+	}
+	doc.MarkPurged(s.nowFn())
+	result, err := s.documentRepo.Update(ctx, tx, doc)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("document purged", zap.String("id", doc.ID.String()))
+	return result, nil
+}
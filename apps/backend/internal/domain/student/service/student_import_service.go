@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"go.uber.org/zap"
+)
+
+// StudentImportColumns are the columns of the import template, in order.
+// courses is optional; a single name column may replace first_name and
+// last_name.
+var StudentImportColumns = []string{
+	"student_id", "first_name", "last_name", "email", "phone",
+	"availability", "courses", "programme", "year",
+}
+
+// studentExportColumns maps each exportable column to its cell value. The
+// import columns come first so a default export can be re-imported.
+var studentExportColumns = map[string]func(*aggregate.Student) string{
+	"student_id":   func(s *aggregate.Student) string { return strconv.Itoa(int(s.StudentID)) },
+	"first_name":   func(s *aggregate.Student) string { return s.FirstName },
+	"last_name":    func(s *aggregate.Student) string { return s.LastName },
+	"email":        func(s *aggregate.Student) string { return s.EmailAddress },
+	"phone":        func(s *aggregate.Student) string { return s.PhoneNumber },
+	"availability": func(s *aggregate.Student) string { return aggregate.FormatAvailability(s.Availability) },
+	"courses":      func(s *aggregate.Student) string { return aggregate.FormatCourseList(s.TranscriptMetadata.Courses) },
+	"programme":    func(s *aggregate.Student) string { return s.TranscriptMetadata.CurrentProgramme },
+	"year":         func(s *aggregate.Student) string { return strconv.Itoa(s.TranscriptMetadata.CurrentYear) },
+	"major":        func(s *aggregate.Student) string { return s.TranscriptMetadata.Major },
+	"status":       func(s *aggregate.Student) string { return s.Status() },
+	"min_weekly_hours": func(s *aggregate.Student) string {
+		return strconv.FormatFloat(s.MinWeeklyHours, 'f', -1, 64)
+	},
+	"max_weekly_hours": func(s *aggregate.Student) string {
+		if s.MaxWeeklyHours == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*s.MaxWeeklyHours, 'f', -1, 64)
+	},
+	"applied_at": func(s *aggregate.Student) string { return s.CreatedAt.Format("2006-01-02") },
+	"accepted_at": func(s *aggregate.Student) string {
+		if s.AcceptedAt == nil {
+			return ""
+		}
+		return s.AcceptedAt.Format("2006-01-02")
+	},
+}
+
+// StudentExportColumns lists every column Export accepts.
+var StudentExportColumns = append(append([]string{}, StudentImportColumns...),
+	"major", "status", "min_weekly_hours", "max_weekly_hours", "applied_at", "accepted_at")
+
+// StudentImportRowError describes a row that cannot be imported. Line is the
+// 1-based row number in the file, counting the header.
+type StudentImportRowError struct {
+	Line      int
+	StudentID string
+	Email     string
+	Error     string
+}
+
+// StudentImportResult is the outcome of an import or its preview. Students
+// holds the parsed rows for a preview and the created students for an
+// import. When Errors is non-empty nothing was written.
+type StudentImportResult struct {
+	Students []*aggregate.Student
+	Accepted bool
+	Errors   []StudentImportRowError
+}
+
+type StudentExportParams struct {
+	Status  string
+	Columns []string
+	Format  spreadsheet.Format
+}
+
+// PreviewImport validates an import file against the roster without writing
+// anything. It uses InAuthTx so RLS scopes the SELECT.
+func (s *StudentService) PreviewImport(ctx context.Context, r io.Reader, format spreadsheet.Format) (*StudentImportResult, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseStudentImport(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StudentImportResult{Errors: rowErrors}
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		valid, txErr := s.validateImport(ctx, tx, rows, result)
+		if txErr != nil {
+			return txErr
+		}
+		result.Students = make([]*aggregate.Student, len(valid))
+		for i, row := range valid {
+			result.Students[i] = row.student
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to preview student import", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// Import creates the students in an import file. The file is all-or-nothing:
+// any row error leaves the roster unchanged. With autoAccept the students are
+// accepted straight away; sending their onboarding invitations is left to the
+// caller, as for Accept.
+func (s *StudentService) Import(ctx context.Context, r io.Reader, format spreadsheet.Format, autoAccept bool) (*StudentImportResult, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseStudentImport(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StudentImportResult{Students: []*aggregate.Student{}, Errors: rowErrors}
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		valid, txErr := s.validateImport(ctx, tx, rows, result)
+		if txErr != nil || len(result.Errors) > 0 {
+			return txErr
+		}
+
+		created := make([]*aggregate.Student, 0, len(valid))
+		for _, row := range valid {
+			saved, txErr := s.repository.Create(ctx, tx, row.student)
+			if txErr != nil {
+				return txErr
+			}
+			if autoAccept {
+				if err := saved.Accept(); err != nil {
+					return err
+				}
+				if txErr := s.repository.Update(ctx, tx, saved); txErr != nil {
+					return txErr
+				}
+				if txErr := s.recordDecision(ctx, tx, saved.StudentID, aggregate.ApplicationDecision_Accepted); txErr != nil {
+					return txErr
+				}
+			}
+			created = append(created, saved)
+		}
+		result.Students = created
+		result.Accepted = autoAccept
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to import students", zap.Error(err))
+		return nil, err
+	}
+
+	if len(result.Errors) == 0 {
+		s.logger.Info("students imported", zap.Int("count", len(result.Students)), zap.Bool("accepted", autoAccept))
+	}
+	return result, nil
+}
+
+// studentImportRow is a parsed row and the file line it came from.
+type studentImportRow struct {
+	line    int
+	student *aggregate.Student
+}
+
+// validateImport records rows that clash with students already on the
+// roster as errors on result, maps course codes to the catalogue and returns
+// the remaining rows.
+func (s *StudentService) validateImport(ctx context.Context, tx *sql.Tx, rows []studentImportRow, result *StudentImportResult) ([]studentImportRow, error) {
+	existing, err := s.repository.List(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[int32]bool, len(existing))
+	emails := make(map[string]bool, len(existing))
+	for _, st := range existing {
+		ids[st.StudentID] = true
+		emails[strings.ToLower(st.EmailAddress)] = true
+	}
+
+	valid := make([]studentImportRow, 0, len(rows))
+	for _, row := range rows {
+		fail := func(msg string) {
+			result.Errors = append(result.Errors, StudentImportRowError{
+				Line:      row.line,
+				StudentID: strconv.Itoa(int(row.student.StudentID)),
+				Email:     row.student.EmailAddress,
+				Error:     msg,
+			})
+		}
+		if ids[row.student.StudentID] {
+			fail("a student with this ID already exists")
+			continue
+		}
+		if emails[strings.ToLower(row.student.EmailAddress)] {
+			fail("a student with this email already exists")
+			continue
+		}
+
+		courses, err := s.normalizeCourses(ctx, tx, row.student.TranscriptMetadata.Courses)
+		if err != nil {
+			return nil, err
+		}
+		row.student.TranscriptMetadata.Courses = courses
+		valid = append(valid, row)
+	}
+
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Line < result.Errors[j].Line })
+	return valid, nil
+}
+
+// Export writes the roster, optionally filtered by status, with the chosen
+// columns. It defaults to the import columns as CSV.
+func (s *StudentService) Export(ctx context.Context, w io.Writer, params StudentExportParams) error {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	columns := params.Columns
+	if len(columns) == 0 {
+		columns = StudentImportColumns
+	}
+	for _, c := range columns {
+		if _, ok := studentExportColumns[c]; !ok {
+			return fmt.Errorf("%w: %q", studentErrors.ErrInvalidExportColumn, c)
+		}
+	}
+	format := params.Format
+	if format == "" {
+		format = spreadsheet.Format_CSV
+	}
+	if format != spreadsheet.Format_CSV && format != spreadsheet.Format_XLSX {
+		return studentErrors.ErrInvalidExportFormat
+	}
+
+	var students []*aggregate.Student
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		if params.Status != "" {
+			students, txErr = s.repository.ListByStatus(ctx, tx, params.Status)
+		} else {
+			students, txErr = s.repository.List(ctx, tx)
+		}
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to export students", zap.Error(err))
+		return err
+	}
+	sort.Slice(students, func(i, j int) bool { return students[i].StudentID < students[j].StudentID })
+
+	rows := make([][]string, 0, len(students)+1)
+	rows = append(rows, columns)
+	for _, st := range students {
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			row[i] = studentExportColumns[c](st)
+		}
+		rows = append(rows, row)
+	}
+	return spreadsheet.Write(w, format, rows)
+}
+
+// parseStudentImport reads the import file. An unreadable file or a missing
+// required column fails the whole import; invalid rows become row errors.
+func parseStudentImport(r io.Reader, format spreadsheet.Format) ([]studentImportRow, []StudentImportRowError, error) {
+	rows, err := spreadsheet.Read(r, format)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", studentErrors.ErrInvalidStudentImport, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("%w: missing header row", studentErrors.ErrInvalidStudentImport)
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	required := []string{"student_id", "email", "phone", "availability", "programme", "year"}
+	if _, ok := columns["name"]; !ok {
+		required = append(required, "first_name", "last_name")
+	}
+	for _, c := range required {
+		if _, ok := columns[c]; !ok {
+			return nil, nil, fmt.Errorf("%w: missing %q column", studentErrors.ErrInvalidStudentImport, c)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var parsed []studentImportRow
+	var rowErrors []StudentImportRowError
+	seenIDs := map[string]int{}
+	seenEmails := map[string]int{}
+	for i, record := range rows[1:] {
+		line := i + 2
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		studentID, email := field(record, "student_id"), field(record, "email")
+		fail := func(msg string) {
+			rowErrors = append(rowErrors, StudentImportRowError{Line: line, StudentID: studentID, Email: email, Error: msg})
+		}
+
+		firstName, lastName := field(record, "first_name"), field(record, "last_name")
+		if firstName == "" && lastName == "" {
+			if name := strings.Fields(field(record, "name")); len(name) > 1 {
+				firstName = strings.Join(name[:len(name)-1], " ")
+				lastName = name[len(name)-1]
+			}
+		}
+		if firstName == "" || lastName == "" {
+			fail("first and last name are required")
+			continue
+		}
+		if field(record, "programme") == "" {
+			fail("programme is required")
+			continue
+		}
+		year, err := strconv.Atoi(field(record, "year"))
+		if err != nil || year < 1 {
+			fail("year must be a whole number of at least 1")
+			continue
+		}
+
+		availability, err := aggregate.ParseAvailability(field(record, "availability"))
+		if err != nil {
+			fail(err.Error())
+			continue
+		}
+		courses, err := aggregate.ParseCourseList(field(record, "courses"))
+		if err != nil {
+			fail(err.Error())
+			continue
+		}
+
+		availabilityJSON, err := json.Marshal(availability)
+		if err != nil {
+			return nil, nil, err
+		}
+		student, err := aggregate.NewStudent(email, field(record, "phone"), types.TranscriptMetadata{
+			FirstName:        firstName,
+			LastName:         lastName,
+			StudentID:        studentID,
+			CurrentProgramme: field(record, "programme"),
+			CurrentYear:      year,
+			Courses:          courses,
+		}, availabilityJSON)
+		if err != nil {
+			fail(err.Error())
+			continue
+		}
+
+		if first, ok := seenIDs[studentID]; ok {
+			fail(fmt.Sprintf("student ID repeats line %d", first))
+			continue
+		}
+		if first, ok := seenEmails[strings.ToLower(email)]; ok {
+			fail(fmt.Sprintf("email repeats line %d", first))
+			continue
+		}
+		seenIDs[studentID] = line
+		seenEmails[strings.ToLower(email)] = line
+
+		parsed = append(parsed, studentImportRow{line: line, student: student})
+	}
+
+	return parsed, rowErrors, nil
+}
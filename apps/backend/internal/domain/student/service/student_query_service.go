@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultStudentPageSize = 25
+	maxStudentPageSize     = 100
+)
+
+// StudentQueryParams filters, orders and pages the student directory. Sort
+// defaults to applied_at, newest first; the other sorts default to ascending.
+// Cursor is the NextCursor of the previous page and is only valid with the
+// same sort and order.
+type StudentQueryParams struct {
+	Search         string
+	Status         string
+	Programme      string
+	Year           *int32
+	MinGPA         *float64
+	MaxGPA         *float64
+	EligibleCourse string
+	AvailableDay   *int
+	AvailableHour  *int
+	Sort           string
+	Descending     *bool
+	Cursor         string
+	Limit          int
+}
+
+// StudentPage is one page of the directory. Total counts the matching
+// students on every page; NextCursor is empty on the last page.
+type StudentPage struct {
+	Students   []*aggregate.Student
+	Total      int
+	NextCursor string
+}
+
+// studentCursorToken is the decoded form of StudentPage.NextCursor.
+type studentCursorToken struct {
+	Sort       repository.StudentSort `json:"s"`
+	Descending bool                   `json:"d"`
+	Value      string                 `json:"v,omitempty"`
+	StudentID  int32                  `json:"id"`
+}
+
+// Query uses InAuthTx so RLS applies; only admins can see other students.
+func (s *StudentService) Query(ctx context.Context, params StudentQueryParams) (*StudentPage, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := buildStudentQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var students []*aggregate.Student
+	var total int
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		if query.EligibleCourse != "" {
+			rules, txErr := s.eligibilityRepo.ListRules(ctx, tx)
+			if txErr != nil {
+				return txErr
+			}
+			query.EligibleGrades = aggregate.NewEligibilityPolicy(rules).QualifyingGrades(query.EligibleCourse)
+		}
+
+		var txErr error
+		students, total, txErr = s.repository.Query(ctx, tx, query)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to query students", zap.Error(err))
+		return nil, err
+	}
+
+	// The repository was asked for one extra row to tell whether another
+	// page follows.
+	page := &StudentPage{Students: students, Total: total}
+	if limit := query.Limit - 1; len(students) > limit {
+		page.Students = students[:limit]
+		page.NextCursor = encodeStudentCursor(query.Sort, query.Descending, page.Students[limit-1])
+	}
+	return page, nil
+}
+
+// ListByIDs uses InAuthTx so RLS applies; only admins can see other students.
+func (s *StudentService) ListByIDs(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Student
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repository.ListByIDs(ctx, tx, studentIDs)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to list students by IDs", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// buildStudentQuery validates params and converts them to a repository query
+// with Limit one above the page size.
+func buildStudentQuery(params StudentQueryParams) (repository.StudentQuery, error) {
+	query := repository.StudentQuery{
+		Search:        strings.TrimSpace(params.Search),
+		Programme:     strings.TrimSpace(params.Programme),
+		Year:          params.Year,
+		MinGPA:        params.MinGPA,
+		MaxGPA:        params.MaxGPA,
+		AvailableDay:  params.AvailableDay,
+		AvailableHour: params.AvailableHour,
+	}
+
+	switch params.Status {
+	case "", "pending", "accepted", "rejected", "deactivated":
+		query.Status = params.Status
+	default:
+		return query, studentErrors.ErrInvalidStudentStatus
+	}
+
+	switch sort := repository.StudentSort(params.Sort); sort {
+	case "", repository.StudentSort_AppliedAt:
+		query.Sort = repository.StudentSort_AppliedAt
+		query.Descending = true
+	case repository.StudentSort_Name, repository.StudentSort_StudentID, repository.StudentSort_GPA:
+		query.Sort = sort
+	default:
+		return query, studentErrors.ErrInvalidStudentSort
+	}
+	if params.Descending != nil {
+		query.Descending = *params.Descending
+	}
+
+	if params.Year != nil && *params.Year < 1 {
+		return query, fmt.Errorf("%w: year must be at least 1", studentErrors.ErrInvalidStudentFilter)
+	}
+	if params.MinGPA != nil && params.MaxGPA != nil && *params.MinGPA > *params.MaxGPA {
+		return query, fmt.Errorf("%w: min_gpa is above max_gpa", studentErrors.ErrInvalidStudentFilter)
+	}
+	if params.AvailableDay != nil && (*params.AvailableDay < 0 || *params.AvailableDay > 4) {
+		return query, fmt.Errorf("%w: day must be 0 (Mon) to 4 (Fri)", studentErrors.ErrInvalidStudentFilter)
+	}
+	if params.AvailableHour != nil {
+		if params.AvailableDay == nil {
+			return query, fmt.Errorf("%w: hour requires day", studentErrors.ErrInvalidStudentFilter)
+		}
+		if *params.AvailableHour < 0 || *params.AvailableHour > 23 {
+			return query, fmt.Errorf("%w: hour must be 0-23", studentErrors.ErrInvalidStudentFilter)
+		}
+	}
+
+	if params.EligibleCourse != "" {
+		code := aggregate.NormalizeCourseCode(params.EligibleCourse)
+		if _, ok := aggregate.CourseLevel(code); !ok {
+			return query, studentErrors.ErrInvalidCourseCode
+		}
+		query.EligibleCourse = code
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultStudentPageSize
+	}
+	query.Limit = min(limit, maxStudentPageSize) + 1
+
+	if params.Cursor != "" {
+		after, err := decodeStudentCursor(params.Cursor, query.Sort, query.Descending)
+		if err != nil {
+			return query, err
+		}
+		query.After = after
+	}
+
+	return query, nil
+}
+
+// studentSortValue is the student's sort key as the repository compares it.
+func studentSortValue(student *aggregate.Student, sort repository.StudentSort) string {
+	switch sort {
+	case repository.StudentSort_AppliedAt:
+		return student.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case repository.StudentSort_Name:
+		return strings.ToLower(student.LastName + ", " + student.FirstName)
+	case repository.StudentSort_GPA:
+		if student.TranscriptMetadata.OverallGPA == nil {
+			return "-1"
+		}
+		return strconv.FormatFloat(*student.TranscriptMetadata.OverallGPA, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func encodeStudentCursor(sort repository.StudentSort, descending bool, last *aggregate.Student) string {
+	data, _ := json.Marshal(studentCursorToken{
+		Sort:       sort,
+		Descending: descending,
+		Value:      studentSortValue(last, sort),
+		StudentID:  last.StudentID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeStudentCursor(cursor string, sort repository.StudentSort, descending bool) (*repository.StudentCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, studentErrors.ErrInvalidStudentCursor
+	}
+	var token studentCursorToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, studentErrors.ErrInvalidStudentCursor
+	}
+	if token.Sort != sort || token.Descending != descending {
+		return nil, studentErrors.ErrInvalidStudentCursor
+	}
+
+	switch sort {
+	case repository.StudentSort_AppliedAt:
+		_, err = time.Parse(time.RFC3339Nano, token.Value)
+	case repository.StudentSort_GPA:
+		_, err = strconv.ParseFloat(token.Value, 64)
+	}
+	if err != nil {
+		return nil, studentErrors.ErrInvalidStudentCursor
+	}
+
+	return &repository.StudentCursor{Value: token.Value, StudentID: token.StudentID}, nil
+}
@@ -5,12 +5,18 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"io"
+	"time"
 
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleRepository "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
 	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -27,6 +33,16 @@ type StudentServiceInterface interface {
 	BulkDeactivate(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 	BulkActivate(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 	Update(ctx context.Context, studentID int32, input UpdateStudentInput) (*aggregate.Student, error)
+	// PreviewImport validates a CSV or XLSX roster file without writing it.
+	PreviewImport(ctx context.Context, r io.Reader, format spreadsheet.Format) (*StudentImportResult, error)
+	// Import creates the students in a roster file, optionally accepting them.
+	Import(ctx context.Context, r io.Reader, format spreadsheet.Format, autoAccept bool) (*StudentImportResult, error)
+	// Export writes the roster with the selected columns.
+	Export(ctx context.Context, w io.Writer, params StudentExportParams) error
+	// Query searches, filters and pages the student directory.
+	Query(ctx context.Context, params StudentQueryParams) (*StudentPage, error)
+	// ListByIDs returns the active students with the given IDs.
+	ListByIDs(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 }
 
 type ApplyInput struct {
@@ -34,6 +50,9 @@ type ApplyInput struct {
 	PhoneNumber        string
 	TranscriptMetadata types.TranscriptMetadata
 	Availability       json.RawMessage
+	// TranscriptDocumentID links the transcript stored by /transcripts/extract
+	// to the new student.
+	TranscriptDocumentID *uuid.UUID
 }
 
 type UpdateStudentInput struct {
@@ -51,9 +70,13 @@ type UpdateStudentInput struct {
 }
 
 type StudentService struct {
-	logger     *zap.Logger
-	repository repository.StudentRepositoryInterface
-	txManager  database.TxManagerInterface
+	logger          *zap.Logger
+	repository      repository.StudentRepositoryInterface
+	courseRepo      scheduleRepository.CourseRepositoryInterface
+	reviewRepo      repository.ApplicationReviewRepositoryInterface
+	eligibilityRepo repository.CourseEligibilityRepositoryInterface
+	documentRepo    repository.StudentDocumentRepositoryInterface
+	txManager       database.TxManagerInterface
 }
 
 var _ StudentServiceInterface = (*StudentService)(nil)
@@ -61,12 +84,20 @@ var _ StudentServiceInterface = (*StudentService)(nil)
 func NewStudentService(
 	logger *zap.Logger,
 	repository repository.StudentRepositoryInterface,
+	courseRepo scheduleRepository.CourseRepositoryInterface,
+	reviewRepo repository.ApplicationReviewRepositoryInterface,
+	eligibilityRepo repository.CourseEligibilityRepositoryInterface,
+	documentRepo repository.StudentDocumentRepositoryInterface,
 	txManager database.TxManagerInterface,
 ) *StudentService {
 	return &StudentService{
-		logger:     logger,
-		repository: repository,
-		txManager:  txManager,
+		logger:          logger,
+		repository:      repository,
+		courseRepo:      courseRepo,
+		reviewRepo:      reviewRepo,
+		eligibilityRepo: eligibilityRepo,
+		documentRepo:    documentRepo,
+		txManager:       txManager,
 	}
 }
 
@@ -99,9 +130,22 @@ func (s *StudentService) Apply(ctx context.Context, input ApplyInput) (*aggregat
 			return studentErrors.ErrAlreadyExists
 		}
 
+		courses, txErr := s.normalizeCourses(ctx, tx, student.TranscriptMetadata.Courses)
+		if txErr != nil {
+			return txErr
+		}
+		student.TranscriptMetadata.Courses = courses
+
 		var createErr error
 		result, createErr = s.repository.Create(ctx, tx, student)
-		return createErr
+		if createErr != nil {
+			return createErr
+		}
+
+		if input.TranscriptDocumentID != nil {
+			return s.linkTranscript(ctx, tx, *input.TranscriptDocumentID, result.StudentID)
+		}
+		return nil
 	})
 	if err != nil {
 		s.logger.Error("failed to apply student", zap.String("email", input.Email), zap.Error(err))
@@ -112,6 +156,22 @@ func (s *StudentService) Apply(ctx context.Context, input ApplyInput) (*aggregat
 	return result, nil
 }
 
+// linkTranscript attaches a pending transcript upload to the new student.
+func (s *StudentService) linkTranscript(ctx context.Context, tx *sql.Tx, documentID uuid.UUID, studentID int32) error {
+	doc, err := s.documentRepo.GetByID(ctx, tx, documentID)
+	if err != nil {
+		return err
+	}
+	if doc.DocumentType != aggregate.DocumentType_Transcript {
+		return studentErrors.ErrInvalidDocumentType
+	}
+	if err := doc.LinkTo(studentID, time.Now().UTC()); err != nil {
+		return err
+	}
+	_, err = s.documentRepo.Update(ctx, tx, doc)
+	return err
+}
+
 func (s *StudentService) GetByID(ctx context.Context, studentID int32) (*aggregate.Student, error) {
 	s.logger.Debug("getting student by ID", zap.Int32("studentID", studentID))
 
@@ -223,6 +283,10 @@ func (s *StudentService) Accept(ctx context.Context, studentID int32) (*aggregat
 			return err
 		}
 
+		if err := s.recordDecision(ctx, tx, student.StudentID, aggregate.ApplicationDecision_Accepted); err != nil {
+			return err
+		}
+
 		result = student
 		return nil
 	})
@@ -253,6 +317,10 @@ func (s *StudentService) Reject(ctx context.Context, studentID int32) (*aggregat
 			return err
 		}
 
+		if err := s.recordDecision(ctx, tx, student.StudentID, aggregate.ApplicationDecision_Rejected); err != nil {
+			return err
+		}
+
 		result = student
 		return nil
 	})
@@ -393,34 +461,8 @@ func (s *StudentService) Update(ctx context.Context, studentID int32, input Upda
 			return txErr
 		}
 
-		if input.PhoneNumber != nil {
-			if err := student.UpdatePhoneNumber(*input.PhoneNumber); err != nil {
-				return err
-			}
-		}
-
-		if input.Availability != nil {
-			if err := student.UpdateAvailability(*input.Availability); err != nil {
-				return err
-			}
-		}
-
-		if input.MinWeeklyHours != nil {
-			student.MinWeeklyHours = *input.MinWeeklyHours
-		}
-
-		if input.MaxWeeklyHours != nil {
-			student.MaxWeeklyHours = input.MaxWeeklyHours
-		}
-
-		if input.Courses != nil {
-			identity := aggregate.TranscriptIdentity{}
-			if input.TranscriptIdentity != nil {
-				identity = *input.TranscriptIdentity
-			}
-			if err := student.UpdateTranscript(identity, input.Courses, input.OverallGPA, input.DegreeGPA, input.CurrentYear, input.CurrentProgramme, input.Major); err != nil {
-				return err
-			}
+		if err := applyStudentUpdate(ctx, tx, s.courseRepo, s.logger, student, input); err != nil {
+			return err
 		}
 
 		if err := s.repository.Update(ctx, tx, student); err != nil {
@@ -438,3 +480,90 @@ func (s *StudentService) Update(ctx context.Context, studentID int32, input Upda
 	s.logger.Info("student updated", zap.Int32("studentID", studentID))
 	return result, nil
 }
+
+// applyStudentUpdate applies the set fields of input to student without saving
+// it.
+func applyStudentUpdate(ctx context.Context, tx *sql.Tx, courseRepo scheduleRepository.CourseRepositoryInterface, logger *zap.Logger, student *aggregate.Student, input UpdateStudentInput) error {
+	if input.PhoneNumber != nil {
+		if err := student.UpdatePhoneNumber(*input.PhoneNumber); err != nil {
+			return err
+		}
+	}
+
+	if input.Availability != nil {
+		if err := student.UpdateAvailability(*input.Availability); err != nil {
+			return err
+		}
+	}
+
+	if input.MinWeeklyHours != nil {
+		student.MinWeeklyHours = *input.MinWeeklyHours
+	}
+
+	if input.MaxWeeklyHours != nil {
+		student.MaxWeeklyHours = input.MaxWeeklyHours
+	}
+
+	if input.Courses != nil {
+		identity := aggregate.TranscriptIdentity{}
+		if input.TranscriptIdentity != nil {
+			identity = *input.TranscriptIdentity
+		}
+		courses, err := normalizeTranscriptCourses(ctx, tx, courseRepo, logger, input.Courses)
+		if err != nil {
+			return err
+		}
+		if err := student.UpdateTranscript(identity, courses, input.OverallGPA, input.DegreeGPA, input.CurrentYear, input.CurrentProgramme, input.Major); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeCourses rewrites transcript course codes to their catalogue codes.
+// Codes missing from the catalogue are kept and show up in the unknown course
+// codes report.
+func (s *StudentService) normalizeCourses(ctx context.Context, tx *sql.Tx, courses []types.CourseResult) ([]types.CourseResult, error) {
+	return normalizeTranscriptCourses(ctx, tx, s.courseRepo, s.logger, courses)
+}
+
+func normalizeTranscriptCourses(ctx context.Context, tx *sql.Tx, courseRepo scheduleRepository.CourseRepositoryInterface, logger *zap.Logger, courses []types.CourseResult) ([]types.CourseResult, error) {
+	catalogue, err := courseRepo.ListAll(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	normalized, unknown := scheduleAggregate.NewCourseCatalogue(catalogue).NormalizeTranscript(courses)
+	if len(unknown) > 0 {
+		logger.Debug("transcript has courses missing from the catalogue", zap.Strings("codes", unknown))
+	}
+	return normalized, nil
+}
+
+// recordDecision adds the accept/reject outcome to the application's stage
+// history, noting the stage it was decided from.
+func (s *StudentService) recordDecision(ctx context.Context, tx *sql.Tx, studentID int32, decision aggregate.ApplicationDecision) error {
+	stages, err := s.reviewRepo.ListStages(ctx, tx)
+	if err != nil {
+		return err
+	}
+	history, err := s.reviewRepo.ListStageChanges(ctx, tx, []int32{studentID})
+	if err != nil {
+		return err
+	}
+
+	var from *uuid.UUID
+	if stage, ok := aggregate.NewReviewPipeline(stages).Current(history); ok {
+		id := stage.ID
+		from = &id
+	}
+
+	var changedBy *uuid.UUID
+	if authCtx, ok := database.GetAuthContextFromContext(ctx); ok {
+		if id, err := uuid.Parse(authCtx.UserID); err == nil {
+			changedBy = &id
+		}
+	}
+
+	_, err = s.reviewRepo.AddStageChange(ctx, tx, aggregate.NewDecisionRecord(studentID, from, decision, changedBy))
+	return err
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleRepository "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"go.uber.org/zap"
+)
+
+type TimetableServiceInterface interface {
+	// ImportMine replaces the caller's timetable with the classes in an .ics,
+	// .csv or .xlsx file.
+	ImportMine(ctx context.Context, filename string, data []byte) (*TimetableProposal, error)
+
+	// GetMine and GetByStudentID recompute the proposal from the stored
+	// timetable and the current shift times.
+	GetMine(ctx context.Context) (*TimetableProposal, error)
+	GetByStudentID(ctx context.Context, studentID int32) (*TimetableProposal, error)
+
+	// ApplyMine requests the caller's proposed availability as a profile
+	// change request for an admin to approve.
+	ApplyMine(ctx context.Context) (*aggregate.ProfileChangeRequest, error)
+}
+
+// TimetableProposal is the availability derived from a student's timetable.
+type TimetableProposal struct {
+	Timetable *aggregate.StudentTimetable
+	// ProposedAvailability is every hour an active shift runs that no class
+	// touches.
+	ProposedAvailability aggregate.Availability
+	// Overlaps are the hours of the student's current availability taken by
+	// a class.
+	Overlaps []aggregate.AvailabilityOverlap
+}
+
+type TimetableService struct {
+	logger            *zap.Logger
+	txManager         database.TxManagerInterface
+	timetableRepo     repository.StudentTimetableRepositoryInterface
+	studentRepo       repository.StudentRepositoryInterface
+	shiftTemplateRepo scheduleRepository.ShiftTemplateRepositoryInterface
+	profileChanges    ProfileChangeServiceInterface
+	loc               *time.Location
+}
+
+var _ TimetableServiceInterface = (*TimetableService)(nil)
+
+func NewTimetableService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	timetableRepo repository.StudentTimetableRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	shiftTemplateRepo scheduleRepository.ShiftTemplateRepositoryInterface,
+	profileChanges ProfileChangeServiceInterface,
+) *TimetableService {
+	return &TimetableService{
+		logger:            logger,
+		txManager:         txManager,
+		timetableRepo:     timetableRepo,
+		studentRepo:       studentRepo,
+		shiftTemplateRepo: shiftTemplateRepo,
+		profileChanges:    profileChanges,
+		// Calendar times are read in the timezone shift times use.
+		loc: (&scheduleAggregate.Location{Timezone: scheduleAggregate.DefaultLocationTimezone}).TimeLocation(),
+	}
+}
+
+func (s *TimetableService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *TimetableService) myStudentID(authCtx database.AuthContext) (int32, error) {
+	if authCtx.StudentID == nil {
+		return 0, studentErrors.ErrNotAuthorized
+	}
+	id, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		s.logger.Error("invalid student ID in auth context", zap.String("student_id", *authCtx.StudentID), zap.Error(err))
+		return 0, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAuthContext, err)
+	}
+	return int32(id), nil
+}
+
+// ImportMine uses InAuthTx so RLS applies.
+func (s *TimetableService) ImportMine(ctx context.Context, filename string, data []byte) (*TimetableProposal, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, classes, err := s.parseTimetable(filename, data)
+	if err != nil {
+		return nil, err
+	}
+	timetable, err := aggregate.NewStudentTimetable(studentID, source, classes)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *TimetableProposal
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		saved, err := s.timetableRepo.Upsert(ctx, tx, timetable)
+		if err != nil {
+			return err
+		}
+		result, err = s.propose(ctx, tx, saved)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to import timetable", zap.Int32("student_id", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("timetable imported",
+		zap.Int32("student_id", studentID),
+		zap.String("source", string(source)),
+		zap.Int("classes", len(result.Timetable.Classes)),
+		zap.Int("overlaps", len(result.Overlaps)))
+	return result, nil
+}
+
+// GetMine uses InAuthTx so RLS applies.
+func (s *TimetableService) GetMine(ctx context.Context) (*TimetableProposal, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, authCtx, studentID)
+}
+
+// GetByStudentID uses InAuthTx so RLS applies.
+func (s *TimetableService) GetByStudentID(ctx context.Context, studentID int32) (*TimetableProposal, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, authCtx, studentID)
+}
+
+func (s *TimetableService) ApplyMine(ctx context.Context) (*aggregate.ProfileChangeRequest, error) {
+	proposal, err := s.GetMine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposal.ProposedAvailability) == 0 {
+		return nil, fmt.Errorf("%w: no shift runs outside your classes", studentErrors.ErrInvalidAvailability)
+	}
+
+	raw, err := json.Marshal(proposal.ProposedAvailability)
+	if err != nil {
+		return nil, err
+	}
+	availability := json.RawMessage(raw)
+	return s.profileChanges.Request(ctx, ProfileChangeInput{Availability: &availability})
+}
+
+func (s *TimetableService) get(ctx context.Context, authCtx database.AuthContext, studentID int32) (*TimetableProposal, error) {
+	var result *TimetableProposal
+	err := s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		timetable, err := s.timetableRepo.GetByStudentID(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+		result, err = s.propose(ctx, tx, timetable)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// propose checks the timetable against the active shift templates and the
+// student's current availability.
+func (s *TimetableService) propose(ctx context.Context, tx *sql.Tx, timetable *aggregate.StudentTimetable) (*TimetableProposal, error) {
+	student, err := s.studentRepo.GetByID(ctx, tx, timetable.StudentID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.shiftTemplateRepo.List(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	openings := make([]aggregate.TimeBlock, 0, len(templates))
+	for _, t := range templates {
+		openings = append(openings, aggregate.TimeBlock{
+			DayOfWeek: int(t.DayOfWeek),
+			Start:     t.StartTime.Format("15:04"),
+			End:       t.EndTime.Format("15:04"),
+		})
+	}
+
+	return &TimetableProposal{
+		Timetable:            timetable,
+		ProposedAvailability: timetable.ProposeAvailability(openings),
+		Overlaps:             timetable.Overlaps(student.Availability),
+	}, nil
+}
+
+// parseTimetable picks the parser from the file extension.
+func (s *TimetableService) parseTimetable(filename string, data []byte) (aggregate.TimetableSource, []aggregate.ClassSession, error) {
+	if strings.EqualFold(filepath.Ext(filename), ".ics") {
+		classes, err := aggregate.ParseTimetableICS(data, s.loc)
+		return aggregate.TimetableSource_ICS, classes, err
+	}
+
+	format, err := spreadsheet.FormatFromFilename(filename)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: expected an .ics, .csv or .xlsx file", studentErrors.ErrInvalidTimetable)
+	}
+	rows, err := spreadsheet.Read(bytes.NewReader(data), format)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", studentErrors.ErrInvalidTimetable, err)
+	}
+	classes, err := aggregate.ParseTimetableCSV(rows)
+	return aggregate.TimetableSource_CSV, classes, err
+}
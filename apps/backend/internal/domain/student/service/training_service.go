@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxEmailBatchSize is the provider's limit on emails per batch request.
+const maxEmailBatchSize = 100
+
+type TrainingServiceInterface interface {
+	// ListRequirements returns every requirement, including inactive ones.
+	ListRequirements(ctx context.Context) ([]*aggregate.TrainingRequirement, error)
+	CreateRequirement(ctx context.Context, input TrainingRequirementInput) (*aggregate.TrainingRequirement, error)
+	UpdateRequirement(ctx context.Context, id uuid.UUID, input TrainingRequirementInput) (*aggregate.TrainingRequirement, error)
+
+	// RecordCompletion records or renews a student's completion, storing the
+	// evidence as a training_certificate document when one is given.
+	RecordCompletion(ctx context.Context, studentID int32, input TrainingCompletionInput) (*aggregate.TrainingRecord, error)
+	DeleteRecord(ctx context.Context, id uuid.UUID) error
+
+	GetMine(ctx context.Context) ([]aggregate.TrainingStatus, error)
+	GetByStudentID(ctx context.Context, studentID int32) ([]aggregate.TrainingStatus, error)
+
+	// MissingBlocking returns, for each of the given students missing any,
+	// the blocking requirements they have no completion valid on asOf for.
+	MissingBlocking(ctx context.Context, studentIDs []int32, asOf time.Time) (map[int32][]*aggregate.TrainingRequirement, error)
+
+	// SendExpiryReminders emails students whose completions expire within
+	// aggregate.TrainingReminderDays, once per completion, and returns how
+	// many reminders were sent.
+	SendExpiryReminders(ctx context.Context) (int, error)
+}
+
+// TrainingRequirementInput is the admin's definition of a requirement.
+// IsActive nil leaves a requirement active.
+type TrainingRequirementInput struct {
+	Name           string
+	Description    *string
+	IsBlocking     bool
+	ValidityMonths *int32
+	IsActive       *bool
+}
+
+// TrainingCompletionInput records a completion. ExpiresOn overrides the
+// expiry computed from the requirement's validity.
+type TrainingCompletionInput struct {
+	RequirementID uuid.UUID
+	CompletedOn   time.Time
+	ExpiresOn     *time.Time
+	Evidence      *TrainingEvidence
+}
+
+// TrainingEvidence is an uploaded certificate.
+type TrainingEvidence struct {
+	Filename string
+	Content  []byte
+}
+
+type TrainingService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	trainingRepo repository.TrainingRepositoryInterface
+	studentRepo  repository.StudentRepositoryInterface
+	documentSvc  StudentDocumentServiceInterface
+	emailSender  emailInterfaces.EmailSenderInterface
+	fromEmail    string
+	frontendURL  string
+	nowFn        func() time.Time
+}
+
+var _ TrainingServiceInterface = (*TrainingService)(nil)
+
+func NewTrainingService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	trainingRepo repository.TrainingRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	documentSvc StudentDocumentServiceInterface,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+	frontendURL string,
+) *TrainingService {
+	return &TrainingService{
+		logger:       logger,
+		txManager:    txManager,
+		trainingRepo: trainingRepo,
+		studentRepo:  studentRepo,
+		documentSvc:  documentSvc,
+		emailSender:  emailSender,
+		fromEmail:    fromEmail,
+		frontendURL:  frontendURL,
+		nowFn:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *TrainingService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *TrainingService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *TrainingService) myStudentID(authCtx database.AuthContext) (int32, error) {
+	if authCtx.StudentID == nil {
+		return 0, studentErrors.ErrNotAuthorized
+	}
+	id, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		s.logger.Error("invalid student ID in auth context", zap.String("student_id", *authCtx.StudentID), zap.Error(err))
+		return 0, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAuthContext, err)
+	}
+	return int32(id), nil
+}
+
+// ListRequirements uses InAuthTx so RLS applies.
+func (s *TrainingService) ListRequirements(ctx context.Context) ([]*aggregate.TrainingRequirement, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.TrainingRequirement
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.trainingRepo.ListRequirements(ctx, tx)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateRequirement uses InSystemTx because writes to
+// auth.training_requirements are only granted to the internal role.
+func (s *TrainingService) CreateRequirement(ctx context.Context, input TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	requirement, err := aggregate.NewTrainingRequirement(input.Name, input.Description, input.IsBlocking, input.ValidityMonths)
+	if err != nil {
+		return nil, err
+	}
+	if input.IsActive != nil {
+		requirement.IsActive = *input.IsActive
+	}
+
+	var result *aggregate.TrainingRequirement
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if txErr := s.checkNameFree(ctx, tx, requirement); txErr != nil {
+			return txErr
+		}
+		var txErr error
+		result, txErr = s.trainingRepo.CreateRequirement(ctx, tx, requirement)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to create training requirement", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("training requirement created",
+		zap.String("requirement_id", result.ID.String()),
+		zap.String("name", result.Name),
+		zap.Bool("is_blocking", result.IsBlocking))
+	return result, nil
+}
+
+// UpdateRequirement uses InSystemTx because writes to
+// auth.training_requirements are only granted to the internal role.
+func (s *TrainingService) UpdateRequirement(ctx context.Context, id uuid.UUID, input TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+	if _, err := s.authCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TrainingRequirement
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		requirement, txErr := s.trainingRepo.GetRequirementByID(ctx, tx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if err := requirement.SetName(input.Name); err != nil {
+			return err
+		}
+		if err := requirement.SetValidity(input.ValidityMonths); err != nil {
+			return err
+		}
+		requirement.SetDescription(input.Description)
+		requirement.IsBlocking = input.IsBlocking
+		if input.IsActive != nil {
+			requirement.IsActive = *input.IsActive
+		}
+
+		if txErr := s.checkNameFree(ctx, tx, requirement); txErr != nil {
+			return txErr
+		}
+		result, txErr = s.trainingRepo.UpdateRequirement(ctx, tx, requirement)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to update training requirement", zap.String("requirement_id", id.String()), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkNameFree rejects a name another requirement already has, ignoring case.
+func (s *TrainingService) checkNameFree(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) error {
+	existing, err := s.trainingRepo.ListRequirements(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.ID != requirement.ID && strings.EqualFold(r.Name, requirement.Name) {
+			return studentErrors.ErrDuplicateTrainingName
+		}
+	}
+	return nil
+}
+
+// RecordCompletion validates the record under InAuthTx before the evidence
+// is stored, so a rejected completion leaves no document behind. The upsert
+// uses InSystemTx because writes to auth.training_records are only granted
+// to the internal role.
+func (s *TrainingService) RecordCompletion(ctx context.Context, studentID int32, input TrainingCompletionInput) (*aggregate.TrainingRecord, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordedBy *uuid.UUID
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		recordedBy = &id
+	}
+
+	var record *aggregate.TrainingRecord
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+		requirement, txErr := s.trainingRepo.GetRequirementByID(ctx, tx, input.RequirementID)
+		if txErr != nil {
+			return txErr
+		}
+		record, txErr = aggregate.NewTrainingRecord(requirement, studentID, input.CompletedOn, input.ExpiresOn, recordedBy, s.nowFn())
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Evidence != nil {
+		doc, err := s.documentSvc.UploadDocumentByStudentID(ctx, studentID, string(aggregate.DocumentType_TrainingCertificate), input.Evidence.Filename, input.Evidence.Content)
+		if err != nil {
+			return nil, err
+		}
+		record.EvidenceDocumentID = &doc.ID
+	}
+
+	var result *aggregate.TrainingRecord
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.trainingRepo.UpsertRecord(ctx, tx, record)
+		return txErr
+	})
+	if err != nil {
+		s.logger.Error("failed to record training completion", zap.Int32("student_id", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("training completion recorded",
+		zap.Int32("student_id", studentID),
+		zap.String("requirement_id", result.RequirementID.String()),
+		zap.Bool("has_evidence", result.EvidenceDocumentID != nil))
+	return result, nil
+}
+
+// DeleteRecord uses InSystemTx because writes to auth.training_records are
+// only granted to the internal role. The evidence document is kept under its
+// own retention.
+func (s *TrainingService) DeleteRecord(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.authCtx(ctx); err != nil {
+		return err
+	}
+
+	return s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		return s.trainingRepo.DeleteRecord(ctx, tx, id)
+	})
+}
+
+func (s *TrainingService) GetMine(ctx context.Context) ([]aggregate.TrainingStatus, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+	return s.statuses(ctx, authCtx, studentID)
+}
+
+func (s *TrainingService) GetByStudentID(ctx context.Context, studentID int32) ([]aggregate.TrainingStatus, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.statuses(ctx, authCtx, studentID)
+}
+
+// statuses uses InAuthTx so RLS applies.
+func (s *TrainingService) statuses(ctx context.Context, authCtx database.AuthContext, studentID int32) ([]aggregate.TrainingStatus, error) {
+	var result []aggregate.TrainingStatus
+	err := s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		if _, txErr := s.studentRepo.GetByIDIncludingDeactivated(ctx, tx, studentID); txErr != nil {
+			return txErr
+		}
+		requirements, txErr := s.trainingRepo.ListRequirements(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		records, txErr := s.trainingRepo.ListRecords(ctx, tx, []int32{studentID})
+		if txErr != nil {
+			return txErr
+		}
+		result = aggregate.TrainingStatuses(requirements, records, s.nowFn())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MissingBlocking uses InAuthTx so RLS scopes the SELECT (admins see all via
+// policy). It is called while an admin generates a schedule.
+func (s *TrainingService) MissingBlocking(ctx context.Context, studentIDs []int32, asOf time.Time) (map[int32][]*aggregate.TrainingRequirement, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int32][]*aggregate.TrainingRequirement)
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		requirements, txErr := s.trainingRepo.ListRequirements(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		blocking := false
+		for _, r := range requirements {
+			if r.IsActive && r.IsBlocking {
+				blocking = true
+				break
+			}
+		}
+		if !blocking {
+			return nil
+		}
+
+		records, txErr := s.trainingRepo.ListRecords(ctx, tx, studentIDs)
+		if txErr != nil {
+			return txErr
+		}
+		byStudent := make(map[int32][]*aggregate.TrainingRecord, len(studentIDs))
+		for _, rec := range records {
+			byStudent[rec.StudentID] = append(byStudent[rec.StudentID], rec)
+		}
+
+		for _, id := range studentIDs {
+			if missing := aggregate.MissingBlockingTraining(requirements, byStudent[id], asOf); len(missing) > 0 {
+				result[id] = missing
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SendExpiryReminders uses InSystemTx; it runs from the periodic reminder job
+// with no caller. A record is marked reminded only after its email is sent, so
+// a failed batch is retried on the next run.
+func (s *TrainingService) SendExpiryReminders(ctx context.Context) (int, error) {
+	now := s.nowFn()
+
+	var (
+		items   dtos.SendEmailBulkRequest
+		pending []uuid.UUID
+	)
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		records, txErr := s.trainingRepo.ListUnremindedExpiring(ctx, tx, now.AddDate(0, 0, aggregate.TrainingReminderDays))
+		if txErr != nil {
+			return txErr
+		}
+		var due []*aggregate.TrainingRecord
+		for _, rec := range records {
+			if rec.NeedsReminder(now) {
+				due = append(due, rec)
+			}
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		requirements, txErr := s.trainingRepo.ListRequirements(ctx, tx)
+		if txErr != nil {
+			return txErr
+		}
+		byID := make(map[uuid.UUID]*aggregate.TrainingRequirement, len(requirements))
+		for _, r := range requirements {
+			byID[r.ID] = r
+		}
+
+		ids := make([]int32, 0, len(due))
+		seen := make(map[int32]bool, len(due))
+		for _, rec := range due {
+			if !seen[rec.StudentID] {
+				seen[rec.StudentID] = true
+				ids = append(ids, rec.StudentID)
+			}
+		}
+		students, txErr := s.studentRepo.ListByIDs(ctx, tx, ids)
+		if txErr != nil {
+			return txErr
+		}
+		byStudent := make(map[int32]*aggregate.Student, len(students))
+		for _, st := range students {
+			byStudent[st.StudentID] = st
+		}
+
+		for _, rec := range due {
+			requirement, student := byID[rec.RequirementID], byStudent[rec.StudentID]
+			// Inactive requirements and deactivated students are not reminded.
+			if requirement == nil || !requirement.IsActive || student == nil {
+				continue
+			}
+			items = append(items, s.reminderEmail(student, requirement, rec))
+			pending = append(pending, rec.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for start := 0; start < len(items); start += maxEmailBatchSize {
+		end := min(start+maxEmailBatchSize, len(items))
+		if _, err := s.emailSender.SendBatch(ctx, items[start:end]); err != nil {
+			s.logger.Error("failed to send training reminders", zap.Error(err), zap.Int("sent", sent))
+			return sent, fmt.Errorf("failed to send training reminders: %w", err)
+		}
+
+		err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+			return s.trainingRepo.MarkReminderSent(ctx, tx, pending[start:end], now)
+		})
+		if err != nil {
+			return sent, err
+		}
+		sent = end
+	}
+
+	return sent, nil
+}
+
+func (s *TrainingService) reminderEmail(student *aggregate.Student, requirement *aggregate.TrainingRequirement, record *aggregate.TrainingRecord) dtos.BatchEmailItem {
+	return dtos.BatchEmailItem{
+		From:    s.fromEmail,
+		To:      []string{student.EmailAddress},
+		Subject: fmt.Sprintf("%s expires soon - DCIT Help Desk", requirement.Name),
+		Template: &types.EmailTemplate{
+			ID: templates.TemplateID_TrainingExpiry,
+			Variables: map[string]any{
+				"STUDENT_NAME":  student.FirstName,
+				"TRAINING_NAME": requirement.Name,
+				"EXPIRES_ON":    record.ExpiresOn.Format("2 January 2006"),
+				"TRAINING_URL":  s.frontendURL + "/training",
+			},
+		},
+	}
+}
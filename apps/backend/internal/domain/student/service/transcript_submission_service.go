@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepository "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/interfaces"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TranscriptSubmissionServiceInterface interface {
+	// Submit re-extracts an uploaded transcript PDF and records its diff
+	// against the caller's transcript for an admin to review. It supersedes
+	// the caller's pending submission, if any.
+	Submit(ctx context.Context, filename string, pdf []byte) (*aggregate.TranscriptSubmission, error)
+	ListMine(ctx context.Context) ([]*aggregate.TranscriptSubmission, error)
+
+	List(ctx context.Context, status string) ([]*aggregate.TranscriptSubmission, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*aggregate.TranscriptSubmission, error)
+	// Approve applies the submission to the student and reports how their
+	// course eligibility and active schedule are affected.
+	Approve(ctx context.Context, id uuid.UUID, note string) (*TranscriptReviewResult, error)
+	Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.TranscriptSubmission, error)
+}
+
+// AffectedAssignment is a shift in the active schedule that demands a course
+// the student is no longer eligible to tutor.
+type AffectedAssignment struct {
+	aggregate.StudentAssignment
+	LostCourses []string
+}
+
+// TranscriptReviewResult is an approved submission with its effect on the
+// student's eligibility and schedule.
+type TranscriptReviewResult struct {
+	Submission          *aggregate.TranscriptSubmission
+	GainedCourses       []string
+	LostCourses         []string
+	AffectedAssignments []AffectedAssignment
+}
+
+type TranscriptSubmissionService struct {
+	logger            *zap.Logger
+	txManager         database.TxManagerInterface
+	submissionRepo    repository.TranscriptSubmissionRepositoryInterface
+	studentRepo       repository.StudentRepositoryInterface
+	eligibilityRepo   repository.CourseEligibilityRepositoryInterface
+	courseRepo        scheduleRepository.CourseRepositoryInterface
+	scheduleRepo      scheduleRepository.ScheduleRepositoryInterface
+	shiftTemplateRepo scheduleRepository.ShiftTemplateRepositoryInterface
+	transcripts       interfaces.TranscriptsServiceInterface
+	documentSvc       StudentDocumentServiceInterface
+	nowFn             func() time.Time
+}
+
+var _ TranscriptSubmissionServiceInterface = (*TranscriptSubmissionService)(nil)
+
+func NewTranscriptSubmissionService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	submissionRepo repository.TranscriptSubmissionRepositoryInterface,
+	studentRepo repository.StudentRepositoryInterface,
+	eligibilityRepo repository.CourseEligibilityRepositoryInterface,
+	courseRepo scheduleRepository.CourseRepositoryInterface,
+	scheduleRepo scheduleRepository.ScheduleRepositoryInterface,
+	shiftTemplateRepo scheduleRepository.ShiftTemplateRepositoryInterface,
+	transcripts interfaces.TranscriptsServiceInterface,
+	documentSvc StudentDocumentServiceInterface,
+) *TranscriptSubmissionService {
+	return &TranscriptSubmissionService{
+		logger:            logger,
+		txManager:         txManager,
+		submissionRepo:    submissionRepo,
+		studentRepo:       studentRepo,
+		eligibilityRepo:   eligibilityRepo,
+		courseRepo:        courseRepo,
+		scheduleRepo:      scheduleRepo,
+		shiftTemplateRepo: shiftTemplateRepo,
+		transcripts:       transcripts,
+		documentSvc:       documentSvc,
+		nowFn:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *TranscriptSubmissionService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *TranscriptSubmissionService) authCtx(ctx context.Context) (database.AuthContext, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		s.logger.Error("missing auth context in request")
+		return database.AuthContext{}, studentErrors.ErrMissingAuthContext
+	}
+	return authCtx, nil
+}
+
+func (s *TranscriptSubmissionService) myStudentID(authCtx database.AuthContext) (int32, error) {
+	if authCtx.StudentID == nil {
+		return 0, studentErrors.ErrNotAuthorized
+	}
+	id, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		s.logger.Error("invalid student ID in auth context", zap.String("student_id", *authCtx.StudentID), zap.Error(err))
+		return 0, fmt.Errorf("%w: %w", studentErrors.ErrInvalidAuthContext, err)
+	}
+	return int32(id), nil
+}
+
+// Submit checks the transcript under InAuthTx before storing the PDF, so a
+// mismatched or unchanged transcript leaves nothing behind. Recording the
+// submission uses InSystemTx because it also closes the previous pending
+// submission, which students cannot update; the student ID comes from the
+// auth context.
+func (s *TranscriptSubmissionService) Submit(ctx context.Context, filename string, pdf []byte) (*aggregate.TranscriptSubmission, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	extracted, err := s.transcripts.ExtractTranscript(filename, pdf)
+	if err != nil {
+		return nil, err
+	}
+	transcript := *extracted
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		student, err := s.studentRepo.GetByID(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+		transcript.Courses, err = normalizeTranscriptCourses(ctx, tx, s.courseRepo, s.logger, transcript.Courses)
+		if err != nil {
+			return err
+		}
+		_, err = aggregate.NewTranscriptSubmission(student, transcript, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.documentSvc.UploadMyDocument(ctx, string(aggregate.DocumentType_Transcript), filename, pdf)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TranscriptSubmission
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		// Re-read the student: the diff is against the transcript on record
+		// when the submission is saved.
+		student, err := s.studentRepo.GetByID(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+		submission, err := aggregate.NewTranscriptSubmission(student, transcript, &doc.ID)
+		if err != nil {
+			return err
+		}
+
+		pending, err := s.submissionRepo.GetPendingByStudentID(ctx, tx, studentID)
+		switch {
+		case err == nil:
+			if err := pending.Supersede(s.nowFn()); err != nil {
+				return err
+			}
+			if _, err := s.submissionRepo.Update(ctx, tx, pending); err != nil {
+				return err
+			}
+		case !errors.Is(err, studentErrors.ErrTranscriptSubmissionNotFound):
+			return err
+		}
+
+		result, err = s.submissionRepo.Create(ctx, tx, submission)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to submit transcript", zap.Int32("student_id", studentID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("transcript submitted", zap.Int32("student_id", studentID), zap.String("id", result.ID.String()))
+	return result, nil
+}
+
+func (s *TranscriptSubmissionService) ListMine(ctx context.Context) ([]*aggregate.TranscriptSubmission, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	studentID, err := s.myStudentID(authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.TranscriptSubmission
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.submissionRepo.List(ctx, tx, repository.TranscriptSubmissionFilter{StudentID: &studentID})
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// List uses InAuthTx so RLS applies.
+func (s *TranscriptSubmissionService) List(ctx context.Context, status string) ([]*aggregate.TranscriptSubmission, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.TranscriptSubmissionFilter{}
+	if status != "" {
+		st, err := aggregate.ParseTranscriptSubmissionStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &st
+	}
+
+	var result []*aggregate.TranscriptSubmission
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.submissionRepo.List(ctx, tx, filter)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetByID uses InAuthTx so RLS applies.
+func (s *TranscriptSubmissionService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.TranscriptSubmission, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TranscriptSubmission
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.submissionRepo.GetByID(ctx, tx, id)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Approve uses InSystemTx because UPDATE on auth.transcript_submissions is
+// only granted to the internal role. Eligibility is evaluated before and
+// after the transcript is applied, and shifts in the active schedule that
+// demand a course the student lost are reported so they can be reassigned.
+func (s *TranscriptSubmissionService) Approve(ctx context.Context, id uuid.UUID, note string) (*TranscriptReviewResult, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *TranscriptReviewResult
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		submission, err := s.submissionRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := submission.Approve(reviewerID(authCtx), note, s.nowFn()); err != nil {
+			return err
+		}
+
+		student, err := s.studentRepo.GetByID(ctx, tx, submission.StudentID)
+		if err != nil {
+			return err
+		}
+		rules, err := s.eligibilityRepo.ListRules(ctx, tx)
+		if err != nil {
+			return err
+		}
+		overrides, err := s.eligibilityRepo.ListOverrides(ctx, tx, []int32{student.StudentID})
+		if err != nil {
+			return err
+		}
+		policy := aggregate.NewEligibilityPolicy(rules)
+		before := policy.Evaluate(student, overrides).EligibleCourses()
+
+		if err := submission.ApplyTo(student); err != nil {
+			return err
+		}
+		if err := s.studentRepo.Update(ctx, tx, student); err != nil {
+			return err
+		}
+		after := policy.Evaluate(student, overrides).EligibleCourses()
+
+		saved, err := s.submissionRepo.Update(ctx, tx, submission)
+		if err != nil {
+			return err
+		}
+
+		gained, lost := courseSetChanges(before, after)
+		affected, err := s.affectedAssignments(ctx, tx, student.StudentID, lost)
+		if err != nil {
+			return err
+		}
+
+		result = &TranscriptReviewResult{
+			Submission:          saved,
+			GainedCourses:       gained,
+			LostCourses:         lost,
+			AffectedAssignments: affected,
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to approve transcript submission", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	if len(result.AffectedAssignments) > 0 {
+		s.logger.Warn("approved transcript affects active schedule",
+			zap.Int32("student_id", result.Submission.StudentID),
+			zap.Strings("lost_courses", result.LostCourses),
+			zap.Int("assignments", len(result.AffectedAssignments)))
+	}
+	s.logger.Info("transcript submission approved", zap.String("id", id.String()))
+	return result, nil
+}
+
+// Reject uses InSystemTx because UPDATE on auth.transcript_submissions is
+// only granted to the internal role.
+func (s *TranscriptSubmissionService) Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.TranscriptSubmission, error) {
+	authCtx, err := s.authCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TranscriptSubmission
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		submission, err := s.submissionRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := submission.Reject(reviewerID(authCtx), note, s.nowFn()); err != nil {
+			return err
+		}
+		result, err = s.submissionRepo.Update(ctx, tx, submission)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("failed to reject transcript submission", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("transcript submission rejected", zap.String("id", id.String()))
+	return result, nil
+}
+
+// affectedAssignments returns the student's shifts in the active schedule
+// whose course demands include a course in lost.
+func (s *TranscriptSubmissionService) affectedAssignments(ctx context.Context, tx *sql.Tx, studentID int32, lost []string) ([]AffectedAssignment, error) {
+	affected := []AffectedAssignment{}
+	if len(lost) == 0 {
+		return affected, nil
+	}
+
+	schedule, err := s.scheduleRepo.GetActive(ctx, tx)
+	if err != nil {
+		if errors.Is(err, scheduleErrors.ErrNotFound) {
+			return affected, nil
+		}
+		return nil, err
+	}
+	if schedule == nil {
+		return affected, nil
+	}
+
+	assignments, err := aggregate.StudentAssignments(schedule.Assignments, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schedule assignments: %w", err)
+	}
+	if len(assignments) == 0 {
+		return affected, nil
+	}
+
+	templates, err := s.shiftTemplateRepo.ListAll(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	demands := make(map[string][]scheduleAggregate.CourseDemand, len(templates))
+	for _, t := range templates {
+		demands[t.ID.String()] = t.CourseDemands
+	}
+
+	lostSet := make(map[string]bool, len(lost))
+	for _, c := range lost {
+		lostSet[aggregate.NormalizeCourseCode(c)] = true
+	}
+	for _, a := range assignments {
+		var courses []string
+		for _, d := range demands[a.ShiftID] {
+			if lostSet[aggregate.NormalizeCourseCode(d.CourseCode)] {
+				courses = append(courses, d.CourseCode)
+			}
+		}
+		if len(courses) > 0 {
+			affected = append(affected, AffectedAssignment{StudentAssignment: a, LostCourses: courses})
+		}
+	}
+	return affected, nil
+}
+
+// courseSetChanges returns the codes in after but not before, and in before
+// but not after, both sorted.
+func courseSetChanges(before, after []string) (gained, lost []string) {
+	had := make(map[string]bool, len(before))
+	for _, c := range before {
+		had[aggregate.NormalizeCourseCode(c)] = true
+	}
+	has := make(map[string]bool, len(after))
+	for _, c := range after {
+		has[aggregate.NormalizeCourseCode(c)] = true
+	}
+
+	gained, lost = []string{}, []string{}
+	for _, c := range after {
+		if !had[aggregate.NormalizeCourseCode(c)] {
+			gained = append(gained, c)
+		}
+	}
+	for _, c := range before {
+		if !has[aggregate.NormalizeCourseCode(c)] {
+			lost = append(lost, c)
+		}
+	}
+	sort.Strings(gained)
+	sort.Strings(lost)
+	return gained, lost
+}
+
+func reviewerID(authCtx database.AuthContext) *uuid.UUID {
+	if id, err := uuid.Parse(authCtx.UserID); err == nil {
+		return &id
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxFailedClockInAttempts is the number of invalid codes a student may
+	// submit within ClockInAttemptWindow before being locked out.
+	MaxFailedClockInAttempts = 5
+	// ClockInAttemptWindow is the sliding window failed attempts are counted in.
+	ClockInAttemptWindow = 15 * time.Minute
+	// CodeAbuseStudentThreshold is the number of distinct students submitting
+	// the same invalid code within ClockInAttemptWindow that triggers an alert.
+	CodeAbuseStudentThreshold = 3
+	// LockoutLevelResetAfter is how long a student must go without a lockout
+	// before the next one starts again at level 1.
+	LockoutLevelResetAfter = 24 * time.Hour
+
+	maxAttemptCodeLength = 32
+)
+
+// lockoutDurations are indexed by level-1; levels past the end use the last entry.
+var lockoutDurations = []time.Duration{
+	5 * time.Minute,
+	15 * time.Minute,
+	1 * time.Hour,
+	24 * time.Hour,
+}
+
+// LockoutDuration returns how long a lockout at the given level lasts.
+func LockoutDuration(level int32) time.Duration {
+	if level < 1 {
+		level = 1
+	}
+	if int(level) > len(lockoutDurations) {
+		return lockoutDurations[len(lockoutDurations)-1]
+	}
+	return lockoutDurations[level-1]
+}
+
+// ClockInAttempt records a single clock-in code submission by a student.
+type ClockInAttempt struct {
+	ID          uuid.UUID
+	StudentID   int32
+	Code        string
+	Succeeded   bool
+	AttemptedAt time.Time
+}
+
+func NewClockInAttempt(studentID int32, code string, succeeded bool, now time.Time) (*ClockInAttempt, error) {
+	if studentID <= 0 {
+		return nil, errors.ErrInvalidStudentID
+	}
+
+	return &ClockInAttempt{
+		ID:          uuid.New(),
+		StudentID:   studentID,
+		Code:        NormalizeAttemptCode(code),
+		Succeeded:   succeeded,
+		AttemptedAt: now,
+	}, nil
+}
+
+// NormalizeAttemptCode uppercases and trims a submitted code so that
+// submissions of the same code group together, and bounds its length.
+func NormalizeAttemptCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) > maxAttemptCodeLength {
+		code = code[:maxAttemptCodeLength]
+	}
+	return code
+}
+
+func ClockInAttemptFromModel(m model.ClockInAttempts) ClockInAttempt {
+	return ClockInAttempt{
+		ID:          m.ID,
+		StudentID:   m.StudentID,
+		Code:        m.Code,
+		Succeeded:   m.Succeeded,
+		AttemptedAt: m.AttemptedAt,
+	}
+}
+
+func (a *ClockInAttempt) ToModel() model.ClockInAttempts {
+	return model.ClockInAttempts{
+		ID:          a.ID,
+		StudentID:   a.StudentID,
+		Code:        a.Code,
+		Succeeded:   a.Succeeded,
+		AttemptedAt: a.AttemptedAt,
+	}
+}
+
+// ClockInLockout blocks a student from clocking in until LockedUntil, or
+// until an admin clears it.
+type ClockInLockout struct {
+	ID             uuid.UUID
+	StudentID      int32
+	Level          int32
+	FailedAttempts int32
+	LockedAt       time.Time
+	LockedUntil    time.Time
+	ClearedAt      *time.Time
+	ClearedBy      *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
+
+func NewClockInLockout(studentID, level, failedAttempts int32, now time.Time) (*ClockInLockout, error) {
+	if studentID <= 0 {
+		return nil, errors.ErrInvalidStudentID
+	}
+	if level < 1 {
+		level = 1
+	}
+
+	return &ClockInLockout{
+		ID:             uuid.New(),
+		StudentID:      studentID,
+		Level:          level,
+		FailedAttempts: failedAttempts,
+		LockedAt:       now,
+		LockedUntil:    now.Add(LockoutDuration(level)),
+	}, nil
+}
+
+// IsActive reports whether the lockout still blocks clock-ins at now.
+func (l *ClockInLockout) IsActive(now time.Time) bool {
+	return l.ClearedAt == nil && now.Before(l.LockedUntil)
+}
+
+// EndedAt returns when the lockout stopped applying: when it was cleared, or
+// when it expired.
+func (l *ClockInLockout) EndedAt() time.Time {
+	if l.ClearedAt != nil && l.ClearedAt.Before(l.LockedUntil) {
+		return *l.ClearedAt
+	}
+	return l.LockedUntil
+}
+
+// Clear lifts the lockout early.
+func (l *ClockInLockout) Clear(clearedBy uuid.UUID, now time.Time) error {
+	if l.ClearedAt != nil {
+		return errors.ErrLockoutAlreadyCleared
+	}
+	l.ClearedAt = &now
+	l.ClearedBy = &clearedBy
+	return nil
+}
+
+func ClockInLockoutFromModel(m model.ClockInLockouts) ClockInLockout {
+	return ClockInLockout{
+		ID:             m.ID,
+		StudentID:      m.StudentID,
+		Level:          m.Level,
+		FailedAttempts: m.FailedAttempts,
+		LockedAt:       m.LockedAt,
+		LockedUntil:    m.LockedUntil,
+		ClearedAt:      m.ClearedAt,
+		ClearedBy:      m.ClearedBy,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func (l *ClockInLockout) ToModel() model.ClockInLockouts {
+	return model.ClockInLockouts{
+		ID:             l.ID,
+		StudentID:      l.StudentID,
+		Level:          l.Level,
+		FailedAttempts: l.FailedAttempts,
+		LockedAt:       l.LockedAt,
+		LockedUntil:    l.LockedUntil,
+		ClearedAt:      l.ClearedAt,
+		ClearedBy:      l.ClearedBy,
+		CreatedAt:      l.CreatedAt,
+		UpdatedAt:      l.UpdatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package aggregate
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultKioskRotationSeconds = 30
+	MinKioskRotationSeconds     = 10
+	MaxKioskRotationSeconds     = 300
+
+	kioskCodeLength  = 8
+	kioskSecretBytes = 20
+	kioskTokenBytes  = 32
+)
+
+// Kiosk is a device-bound clock-in station. It displays a code that rotates
+// every RotationSeconds, derived TOTP-style from its Secret, so codes never
+// need to be persisted.
+type Kiosk struct {
+	ID              uuid.UUID
+	Name            string
+	Secret          string // hex-encoded HMAC key; encrypted at rest by the repository
+	TokenHash       string
+	RotationSeconds int32
+	IsActive        bool
+	LastSeenAt      *time.Time
+	CreatedAt       time.Time
+	CreatedBy       uuid.UUID
+	UpdatedAt       *time.Time
+}
+
+// NewKiosk creates a kiosk with a fresh secret and returns it together with
+// the plaintext device token. The token is only available at creation time.
+func NewKiosk(name string, rotationSeconds int, createdBy uuid.UUID) (*Kiosk, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", errors.ErrInvalidKioskName
+	}
+	if rotationSeconds == 0 {
+		rotationSeconds = DefaultKioskRotationSeconds
+	}
+	if rotationSeconds < MinKioskRotationSeconds || rotationSeconds > MaxKioskRotationSeconds {
+		return nil, "", errors.ErrInvalidKioskRotation
+	}
+
+	secret := make([]byte, kioskSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate kiosk secret: %w", err)
+	}
+	token := make([]byte, kioskTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return nil, "", fmt.Errorf("failed to generate kiosk token: %w", err)
+	}
+	plainToken := base64.RawURLEncoding.EncodeToString(token)
+
+	return &Kiosk{
+		ID:              uuid.New(),
+		Name:            name,
+		Secret:          hex.EncodeToString(secret),
+		TokenHash:       HashKioskToken(plainToken),
+		RotationSeconds: int32(rotationSeconds),
+		IsActive:        true,
+		CreatedBy:       createdBy,
+	}, plainToken, nil
+}
+
+// HashKioskToken returns the value stored in token_hash for a device token.
+func HashKioskToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CodeAt returns the code displayed at time t and when that code rotates.
+func (k *Kiosk) CodeAt(t time.Time) (string, time.Time, error) {
+	counter := t.Unix() / int64(k.RotationSeconds)
+	code, err := k.codeForCounter(counter)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Unix((counter+1)*int64(k.RotationSeconds), 0).UTC()
+	return code, expiresAt, nil
+}
+
+// Matches reports whether code is valid for the window containing now or the
+// window immediately before it, which absorbs clock skew and slow typists.
+func (k *Kiosk) Matches(code string, now time.Time) bool {
+	if !k.IsActive || len(code) != kioskCodeLength {
+		return false
+	}
+	code = strings.ToUpper(code)
+	counter := now.Unix() / int64(k.RotationSeconds)
+	for _, c := range []int64{counter, counter - 1} {
+		expected, err := k.codeForCounter(c)
+		if err != nil {
+			return false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Deactivate revokes the kiosk; its token and codes stop working immediately.
+func (k *Kiosk) Deactivate() error {
+	if !k.IsActive {
+		return errors.ErrKioskInactive
+	}
+	k.IsActive = false
+	return nil
+}
+
+// KioskFromModel builds a kiosk from its row and the already decrypted secret.
+func KioskFromModel(m *model.Kiosks, secret string) *Kiosk {
+	return &Kiosk{
+		ID:              m.ID,
+		Name:            m.Name,
+		Secret:          secret,
+		TokenHash:       m.TokenHash,
+		RotationSeconds: m.RotationSeconds,
+		IsActive:        m.IsActive,
+		LastSeenAt:      m.LastSeenAt,
+		CreatedAt:       m.CreatedAt,
+		CreatedBy:       m.CreatedBy,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+// ToModel converts the kiosk to its row, using the encrypted form of Secret.
+func (k *Kiosk) ToModel(encryptedSecret []byte) model.Kiosks {
+	return model.Kiosks{
+		ID:              k.ID,
+		Name:            k.Name,
+		Secret:          encryptedSecret,
+		TokenHash:       k.TokenHash,
+		RotationSeconds: k.RotationSeconds,
+		IsActive:        k.IsActive,
+		LastSeenAt:      k.LastSeenAt,
+		CreatedAt:       k.CreatedAt,
+		CreatedBy:       k.CreatedBy,
+		UpdatedAt:       k.UpdatedAt,
+	}
+}
+
+// codeForCounter derives an 8-character code using HMAC-SHA256 with RFC 4226
+// dynamic truncation, mapped onto the same charset as manual clock-in codes.
+func (k *Kiosk) codeForCounter(counter int64) (string, error) {
+	key, err := hex.DecodeString(k.Secret)
+	if err != nil {
+		return "", fmt.Errorf("invalid kiosk secret: %w", err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha256.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := int(sum[len(sum)-1] & 0x0f)
+	value := binary.BigEndian.Uint64(sum[offset : offset+8])
+
+	b := make([]byte, kioskCodeLength)
+	charsetLen := uint64(len(codeCharset))
+	for i := range b {
+		b[i] = codeCharset[value%charsetLen]
+		value /= charsetLen
+	}
+	return string(b), nil
+}
@@ -0,0 +1,261 @@
+package aggregate
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultEarlyClockInMinutes is how early a student may clock in before
+	// their shift when no pay rule applies.
+	DefaultEarlyClockInMinutes = 5
+
+	MaxRoundToMinutes      = 60
+	MaxEarlyClockInMinutes = 120
+	MaxLateGraceMinutes    = 60
+)
+
+// PayRule converts attendance into paid time. A rule with a nil LocationID
+// applies to every location; EffectiveFrom and EffectiveTo (inclusive) bound
+// the term it applies to and are open-ended when nil.
+type PayRule struct {
+	ID                    uuid.UUID
+	Name                  string
+	LocationID            *uuid.UUID
+	EffectiveFrom         *time.Time
+	EffectiveTo           *time.Time
+	RoundToMinutes        int32 // round paid time to the nearest N minutes; 0 disables
+	EarlyClockInMinutes   int32 // how early clock-in is allowed before the shift
+	PayFromScheduledStart bool  // early arrivals are paid from the scheduled start
+	LateGraceMinutes      int32 // late arrivals within the grace are paid from the scheduled start
+	CapAtScheduledEnd     bool  // time after the scheduled end is not paid
+	IsActive              bool
+	CreatedAt             time.Time
+	UpdatedAt             *time.Time
+}
+
+// PayRuleParams holds the configurable fields of a pay rule.
+type PayRuleParams struct {
+	Name                  string
+	LocationID            *uuid.UUID
+	EffectiveFrom         *time.Time
+	EffectiveTo           *time.Time
+	RoundToMinutes        int
+	EarlyClockInMinutes   int
+	PayFromScheduledStart bool
+	LateGraceMinutes      int
+	CapAtScheduledEnd     bool
+}
+
+func NewPayRule(params PayRuleParams) (*PayRule, error) {
+	r := &PayRule{
+		ID:       uuid.New(),
+		IsActive: true,
+	}
+	if err := r.Update(params); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DefaultPayRule is used when no configured rule applies: the standard early
+// clock-in window and raw time less breaks, unrounded.
+func DefaultPayRule() *PayRule {
+	return &PayRule{
+		EarlyClockInMinutes: DefaultEarlyClockInMinutes,
+		IsActive:            true,
+	}
+}
+
+// Update validates and replaces the rule's configuration.
+func (r *PayRule) Update(params PayRuleParams) error {
+	name := strings.TrimSpace(params.Name)
+	if name == "" || len(name) > 100 {
+		return errors.ErrInvalidPayRuleName
+	}
+	if params.RoundToMinutes < 0 || params.RoundToMinutes > MaxRoundToMinutes {
+		return errors.ErrInvalidRounding
+	}
+	if params.EarlyClockInMinutes < 0 || params.EarlyClockInMinutes > MaxEarlyClockInMinutes {
+		return errors.ErrInvalidEarlyWindow
+	}
+	if params.LateGraceMinutes < 0 || params.LateGraceMinutes > MaxLateGraceMinutes {
+		return errors.ErrInvalidLateGrace
+	}
+	from, to := truncateDate(params.EffectiveFrom), truncateDate(params.EffectiveTo)
+	if from != nil && to != nil && to.Before(*from) {
+		return errors.ErrInvalidEffectiveRange
+	}
+
+	r.Name = name
+	r.LocationID = params.LocationID
+	r.EffectiveFrom = from
+	r.EffectiveTo = to
+	r.RoundToMinutes = int32(params.RoundToMinutes)
+	r.EarlyClockInMinutes = int32(params.EarlyClockInMinutes)
+	r.PayFromScheduledStart = params.PayFromScheduledStart
+	r.LateGraceMinutes = int32(params.LateGraceMinutes)
+	r.CapAtScheduledEnd = params.CapAtScheduledEnd
+	return nil
+}
+
+func (r *PayRule) Deactivate() error {
+	if !r.IsActive {
+		return errors.ErrPayRuleInactive
+	}
+	r.IsActive = false
+	return nil
+}
+
+// AppliesTo reports whether the rule covers a shift at locationID on day.
+func (r *PayRule) AppliesTo(locationID *uuid.UUID, day time.Time) bool {
+	if !r.IsActive {
+		return false
+	}
+	if r.LocationID != nil && (locationID == nil || *r.LocationID != *locationID) {
+		return false
+	}
+	d := *truncateDate(&day)
+	if r.EffectiveFrom != nil && d.Before(*r.EffectiveFrom) {
+		return false
+	}
+	if r.EffectiveTo != nil && d.After(*r.EffectiveTo) {
+		return false
+	}
+	return true
+}
+
+// SelectPayRule returns the rule for a shift at locationID on day, or nil if
+// none applies. Location-specific rules win over global ones, and among those
+// the rule with the latest EffectiveFrom wins.
+func SelectPayRule(rules []*PayRule, locationID *uuid.UUID, day time.Time) *PayRule {
+	var best *PayRule
+	for _, r := range rules {
+		if !r.AppliesTo(locationID, day) {
+			continue
+		}
+		if best == nil || r.moreSpecificThan(best) {
+			best = r
+		}
+	}
+	return best
+}
+
+func (r *PayRule) moreSpecificThan(other *PayRule) bool {
+	if (r.LocationID != nil) != (other.LocationID != nil) {
+		return r.LocationID != nil
+	}
+	switch {
+	case r.EffectiveFrom == nil:
+		return false
+	case other.EffectiveFrom == nil:
+		return true
+	case !r.EffectiveFrom.Equal(*other.EffectiveFrom):
+		return r.EffectiveFrom.After(*other.EffectiveFrom)
+	}
+	return r.CreatedAt.After(other.CreatedAt)
+}
+
+// EarlyWindow is how long before the scheduled start clock-in is allowed.
+func (r *PayRule) EarlyWindow() time.Duration {
+	return time.Duration(r.EarlyClockInMinutes) * time.Minute
+}
+
+// PaidMinutes converts a log from entry to exit into paid minutes. The
+// scheduled window is optional; without it only breaks and rounding apply.
+// Breaks are unpaid wherever they overlap the paid window.
+func (r *PayRule) PaidMinutes(entry, exit time.Time, scheduledStart, scheduledEnd *time.Time, breaks []*TimeLogBreak) float64 {
+	start, end := entry, exit
+	if scheduledStart != nil {
+		grace := time.Duration(r.LateGraceMinutes) * time.Minute
+		switch {
+		case start.Before(*scheduledStart) && r.PayFromScheduledStart:
+			start = *scheduledStart
+		case start.After(*scheduledStart) && start.Sub(*scheduledStart) <= grace:
+			start = *scheduledStart
+		}
+	}
+	if scheduledEnd != nil && r.CapAtScheduledEnd && end.After(*scheduledEnd) {
+		end = *scheduledEnd
+	}
+	if !end.After(start) {
+		return 0
+	}
+
+	paid := end.Sub(start)
+	for _, b := range breaks {
+		if b.EndAt == nil {
+			continue
+		}
+		overlapStart, overlapEnd := b.StartAt, *b.EndAt
+		if overlapStart.Before(start) {
+			overlapStart = start
+		}
+		if overlapEnd.After(end) {
+			overlapEnd = end
+		}
+		if overlapEnd.After(overlapStart) {
+			paid -= overlapEnd.Sub(overlapStart)
+		}
+	}
+	if paid < 0 {
+		paid = 0
+	}
+
+	minutes := paid.Minutes()
+	if r.RoundToMinutes > 0 {
+		step := float64(r.RoundToMinutes)
+		minutes = math.Round(minutes/step) * step
+	}
+	return math.Round(minutes*100) / 100
+}
+
+func PayRuleFromModel(m model.PayRules) PayRule {
+	return PayRule{
+		ID:                    m.ID,
+		Name:                  m.Name,
+		LocationID:            m.LocationID,
+		EffectiveFrom:         m.EffectiveFrom,
+		EffectiveTo:           m.EffectiveTo,
+		RoundToMinutes:        m.RoundToMinutes,
+		EarlyClockInMinutes:   m.EarlyClockInMinutes,
+		PayFromScheduledStart: m.PayFromScheduledStart,
+		LateGraceMinutes:      m.LateGraceMinutes,
+		CapAtScheduledEnd:     m.CapAtScheduledEnd,
+		IsActive:              m.IsActive,
+		CreatedAt:             m.CreatedAt,
+		UpdatedAt:             m.UpdatedAt,
+	}
+}
+
+func (r *PayRule) ToModel() model.PayRules {
+	return model.PayRules{
+		ID:                    r.ID,
+		Name:                  r.Name,
+		LocationID:            r.LocationID,
+		EffectiveFrom:         r.EffectiveFrom,
+		EffectiveTo:           r.EffectiveTo,
+		RoundToMinutes:        r.RoundToMinutes,
+		EarlyClockInMinutes:   r.EarlyClockInMinutes,
+		PayFromScheduledStart: r.PayFromScheduledStart,
+		LateGraceMinutes:      r.LateGraceMinutes,
+		CapAtScheduledEnd:     r.CapAtScheduledEnd,
+		IsActive:              r.IsActive,
+		CreatedAt:             r.CreatedAt,
+		UpdatedAt:             r.UpdatedAt,
+	}
+}
+
+// truncateDate drops the time of day, keeping the calendar date in UTC.
+func truncateDate(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return &d
+}
@@ -1,6 +1,9 @@
 package aggregate
 
 import (
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
@@ -8,6 +11,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// FlagCode is the machine-readable reason a time log was flagged.
+type FlagCode string
+
+const (
+	FlagCode_Manual                FlagCode = "manual"
+	FlagCode_OutsideGeofence       FlagCode = "outside_geofence"
+	FlagCode_MissedBreak           FlagCode = "missed_break"
+	FlagCode_SharedDevice          FlagCode = "shared_device"
+	FlagCode_ImpossibleCoordinates FlagCode = "impossible_coordinates"
+	FlagCode_ZeroAccuracy          FlagCode = "zero_accuracy"
+	FlagCode_RepeatedCoordinates   FlagCode = "repeated_coordinates"
+)
+
+const (
+	maxDeviceFingerprintLength = 128
+	maxUserAgentLength         = 512
+)
+
 type TimeLog struct {
 	ID             uuid.UUID
 	StudentID      int32
@@ -18,7 +39,18 @@ type TimeLog struct {
 	DistanceMeters float64
 	IsFlagged      bool
 	FlagReason     *string
-	CreatedAt      time.Time
+	FlagCodes      []FlagCode // one per rule that flagged the log, in order
+	LocationID     *uuid.UUID
+	ScheduledStart *time.Time // start of the matched shift, set at clock-in
+	ScheduledEnd   *time.Time
+	PayRuleID      *uuid.UUID // nil when the default pay rule applied
+	PaidMinutes    *float64   // set at clock-out
+	// Reported by the client at clock-in; nil when not supplied.
+	DeviceFingerprint *string
+	IPAddress         *string
+	UserAgent         *string
+	GPSAccuracyMeters *float64
+	CreatedAt         time.Time
 }
 
 func NewTimeLog(studentID int32, longitude, latitude, distanceMeters float64) (*TimeLog, error) {
@@ -55,46 +87,150 @@ func (t *TimeLog) ClockOut(now time.Time) error {
 	return nil
 }
 
+// RawMinutes is the time between entry and exit, or nil while the log is open.
+func (t *TimeLog) RawMinutes() *float64 {
+	if t.ExitAt == nil {
+		return nil
+	}
+	minutes := math.Round(t.ExitAt.Sub(t.EntryAt).Minutes()*100) / 100
+	return &minutes
+}
+
+// ApplyPayRule records the paid minutes for a closed log under the given rule.
+// It does nothing while the log is open.
+func (t *TimeLog) ApplyPayRule(rule *PayRule, breaks []*TimeLogBreak) {
+	if t.ExitAt == nil {
+		return
+	}
+	paid := rule.PaidMinutes(t.EntryAt, *t.ExitAt, t.ScheduledStart, t.ScheduledEnd, breaks)
+	t.PaidMinutes = &paid
+}
+
+// RecordDevice stores what the client reported about itself at clock-in.
+// Empty values are stored as nil and overlong ones are truncated.
+func (t *TimeLog) RecordDevice(fingerprint, ipAddress, userAgent string, gpsAccuracyMeters *float64) {
+	t.DeviceFingerprint = optionalString(fingerprint, maxDeviceFingerprintLength)
+	t.IPAddress = optionalString(ipAddress, 0)
+	t.UserAgent = optionalString(userAgent, maxUserAgentLength)
+	t.GPSAccuracyMeters = gpsAccuracyMeters
+}
+
+// Flag is an admin's manual flag. It replaces any previous reason.
 func (t *TimeLog) Flag(reason string) error {
 	if reason == "" {
 		return errors.ErrInvalidFlagReason
 	}
 	t.IsFlagged = true
 	t.FlagReason = &reason
+	t.FlagCodes = []FlagCode{FlagCode_Manual}
+	return nil
+}
+
+// AddFlag flags the log for an automatic rule, keeping any earlier flags so
+// every rule that fired is recorded.
+func (t *TimeLog) AddFlag(code FlagCode, reason string) error {
+	if code == "" || reason == "" {
+		return errors.ErrInvalidFlagReason
+	}
+	if t.IsFlagged && t.FlagReason != nil {
+		reason = *t.FlagReason + "; " + reason
+	}
+	t.IsFlagged = true
+	t.FlagReason = &reason
+	if !slices.Contains(t.FlagCodes, code) {
+		t.FlagCodes = append(t.FlagCodes, code)
+	}
 	return nil
 }
 
 func (t *TimeLog) Unflag() {
 	t.IsFlagged = false
 	t.FlagReason = nil
+	t.FlagCodes = nil
 }
 
 func TimeLogFromModel(m model.TimeLogs) TimeLog {
 	return TimeLog{
-		ID:             m.ID,
-		StudentID:      m.StudentID,
-		EntryAt:        m.EntryAt,
-		ExitAt:         m.ExitAt,
-		Longitude:      m.Longitude,
-		Latitude:       m.Latitude,
-		DistanceMeters: m.DistanceMeters,
-		IsFlagged:      m.IsFlagged,
-		FlagReason:     m.FlagReason,
-		CreatedAt:      m.CreatedAt,
+		ID:                m.ID,
+		StudentID:         m.StudentID,
+		EntryAt:           m.EntryAt,
+		ExitAt:            m.ExitAt,
+		Longitude:         m.Longitude,
+		Latitude:          m.Latitude,
+		DistanceMeters:    m.DistanceMeters,
+		IsFlagged:         m.IsFlagged,
+		FlagReason:        m.FlagReason,
+		FlagCodes:         parseFlagCodes(m.FlagCodes),
+		LocationID:        m.LocationID,
+		ScheduledStart:    m.ScheduledStart,
+		ScheduledEnd:      m.ScheduledEnd,
+		PayRuleID:         m.PayRuleID,
+		PaidMinutes:       m.PaidMinutes,
+		DeviceFingerprint: m.DeviceFingerprint,
+		IPAddress:         m.IPAddress,
+		UserAgent:         m.UserAgent,
+		GPSAccuracyMeters: m.GpsAccuracyMeters,
+		CreatedAt:         m.CreatedAt,
 	}
 }
 
 func (t *TimeLog) ToModel() model.TimeLogs {
 	return model.TimeLogs{
-		ID:             t.ID,
-		StudentID:      t.StudentID,
-		EntryAt:        t.EntryAt,
-		ExitAt:         t.ExitAt,
-		Longitude:      t.Longitude,
-		Latitude:       t.Latitude,
-		DistanceMeters: t.DistanceMeters,
-		IsFlagged:      t.IsFlagged,
-		FlagReason:     t.FlagReason,
-		CreatedAt:      t.CreatedAt,
+		ID:                t.ID,
+		StudentID:         t.StudentID,
+		EntryAt:           t.EntryAt,
+		ExitAt:            t.ExitAt,
+		Longitude:         t.Longitude,
+		Latitude:          t.Latitude,
+		DistanceMeters:    t.DistanceMeters,
+		IsFlagged:         t.IsFlagged,
+		FlagReason:        t.FlagReason,
+		FlagCodes:         formatFlagCodes(t.FlagCodes),
+		LocationID:        t.LocationID,
+		ScheduledStart:    t.ScheduledStart,
+		ScheduledEnd:      t.ScheduledEnd,
+		PayRuleID:         t.PayRuleID,
+		PaidMinutes:       t.PaidMinutes,
+		DeviceFingerprint: t.DeviceFingerprint,
+		IPAddress:         t.IPAddress,
+		UserAgent:         t.UserAgent,
+		GpsAccuracyMeters: t.GPSAccuracyMeters,
+		CreatedAt:         t.CreatedAt,
+	}
+}
+
+// flag_codes is stored as a comma-separated list.
+func parseFlagCodes(s *string) []FlagCode {
+	if s == nil || *s == "" {
+		return nil
+	}
+	parts := strings.Split(*s, ",")
+	codes := make([]FlagCode, len(parts))
+	for i, p := range parts {
+		codes[i] = FlagCode(p)
+	}
+	return codes
+}
+
+func formatFlagCodes(codes []FlagCode) *string {
+	if len(codes) == 0 {
+		return nil
+	}
+	parts := make([]string, len(codes))
+	for i, c := range codes {
+		parts[i] = string(c)
+	}
+	s := strings.Join(parts, ",")
+	return &s
+}
+
+func optionalString(s string, maxLen int) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if r := []rune(s); maxLen > 0 && len(r) > maxLen {
+		s = string(r[:maxLen])
 	}
+	return &s
 }
@@ -0,0 +1,145 @@
+package aggregate
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+// DefaultMinBreak is the shortest break that satisfies a BreakPolicy when
+// none is configured.
+const DefaultMinBreak = 30 * time.Minute
+
+// TimeLogBreak is an unpaid break taken within a time log.
+type TimeLogBreak struct {
+	ID        uuid.UUID
+	TimeLogID uuid.UUID
+	StartAt   time.Time
+	EndAt     *time.Time
+	CreatedAt time.Time
+}
+
+func NewTimeLogBreak(timeLogID uuid.UUID, now time.Time) *TimeLogBreak {
+	return &TimeLogBreak{
+		ID:        uuid.New(),
+		TimeLogID: timeLogID,
+		StartAt:   now,
+	}
+}
+
+func (b *TimeLogBreak) IsOpen() bool {
+	return b.EndAt == nil
+}
+
+func (b *TimeLogBreak) End(now time.Time) error {
+	if b.EndAt != nil {
+		return errors.ErrBreakAlreadyEnded
+	}
+	if now.Before(b.StartAt) {
+		now = b.StartAt
+	}
+	b.EndAt = &now
+	return nil
+}
+
+// Duration returns how long the break lasted, or zero while it is open.
+func (b *TimeLogBreak) Duration() time.Duration {
+	if b.EndAt == nil {
+		return 0
+	}
+	return b.EndAt.Sub(b.StartAt)
+}
+
+func TimeLogBreakFromModel(m model.TimeLogBreaks) TimeLogBreak {
+	return TimeLogBreak{
+		ID:        m.ID,
+		TimeLogID: m.TimeLogID,
+		StartAt:   m.StartAt,
+		EndAt:     m.EndAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (b *TimeLogBreak) ToModel() model.TimeLogBreaks {
+	return model.TimeLogBreaks{
+		ID:        b.ID,
+		TimeLogID: b.TimeLogID,
+		StartAt:   b.StartAt,
+		EndAt:     b.EndAt,
+		CreatedAt: b.CreatedAt,
+	}
+}
+
+// BreakPolicy requires a break of at least MinBreak once a student has worked
+// RequiredAfter without one. A zero RequiredAfter disables the rule.
+type BreakPolicy struct {
+	RequiredAfter time.Duration
+	MinBreak      time.Duration
+}
+
+func NewBreakPolicy(requiredAfter, minBreak time.Duration) (BreakPolicy, error) {
+	if requiredAfter < 0 || minBreak < 0 {
+		return BreakPolicy{}, errors.ErrInvalidBreakPolicy
+	}
+	if minBreak == 0 {
+		minBreak = DefaultMinBreak
+	}
+	return BreakPolicy{RequiredAfter: requiredAfter, MinBreak: minBreak}, nil
+}
+
+func (p BreakPolicy) Enabled() bool {
+	return p.RequiredAfter > 0
+}
+
+// LongestContinuousWork returns the longest stretch between entry, exit and
+// the closed breaks of at least MinBreak. Shorter breaks are still unpaid but
+// do not interrupt the stretch.
+func (p BreakPolicy) LongestContinuousWork(entry, exit time.Time, breaks []*TimeLogBreak) time.Duration {
+	qualifying := make([]*TimeLogBreak, 0, len(breaks))
+	for _, b := range breaks {
+		if b.EndAt != nil && b.Duration() >= p.MinBreak {
+			qualifying = append(qualifying, b)
+		}
+	}
+	sort.Slice(qualifying, func(i, j int) bool {
+		return qualifying[i].StartAt.Before(qualifying[j].StartAt)
+	})
+
+	var longest time.Duration
+	segmentStart := entry
+	for _, b := range qualifying {
+		longest = max(longest, b.StartAt.Sub(segmentStart))
+		segmentStart = *b.EndAt
+	}
+	return max(longest, exit.Sub(segmentStart))
+}
+
+// Violation reports whether a log from entry to exit breaks the policy, with a
+// flag reason describing it.
+func (p BreakPolicy) Violation(entry, exit time.Time, breaks []*TimeLogBreak) (string, bool) {
+	if !p.Enabled() {
+		return "", false
+	}
+	if longest := p.LongestContinuousWork(entry, exit, breaks); longest > p.RequiredAfter {
+		return fmt.Sprintf("Worked %s without a %s break (required after %s)",
+			formatBreakDuration(longest), formatBreakDuration(p.MinBreak), formatBreakDuration(p.RequiredAfter)), true
+	}
+	return "", false
+}
+
+func formatBreakDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%02dm", h, m)
+	}
+}
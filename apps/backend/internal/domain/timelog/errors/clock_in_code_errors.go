@@ -6,3 +6,11 @@ var (
 	ErrInvalidClockInCode  = errors.New("clock-in code not found or expired")
 	ErrNoActiveClockInCode = errors.New("no active clock-in code")
 )
+
+var (
+	ErrKioskNotFound        = errors.New("kiosk not found")
+	ErrKioskInactive        = errors.New("kiosk is inactive")
+	ErrInvalidKioskToken    = errors.New("invalid kiosk token")
+	ErrInvalidKioskName     = errors.New("kiosk name must be between 1 and 100 characters")
+	ErrInvalidKioskRotation = errors.New("kiosk rotation must be between 10 and 300 seconds")
+)
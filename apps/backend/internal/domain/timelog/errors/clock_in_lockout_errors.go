@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrClockInLocked         = errors.New("too many failed clock-in attempts; clock-in is temporarily locked")
+	ErrLockoutNotFound       = errors.New("clock-in lockout not found")
+	ErrLockoutAlreadyCleared = errors.New("clock-in lockout has already been cleared")
+)
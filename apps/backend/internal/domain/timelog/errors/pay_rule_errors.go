@@ -0,0 +1,13 @@
+package errors
+
+import "errors"
+
+var (
+	ErrPayRuleNotFound       = errors.New("pay rule not found")
+	ErrPayRuleInactive       = errors.New("pay rule is already inactive")
+	ErrInvalidPayRuleName    = errors.New("pay rule name must be between 1 and 100 characters")
+	ErrInvalidRounding       = errors.New("round_to_minutes must be between 0 and 60")
+	ErrInvalidEarlyWindow    = errors.New("early_clock_in_minutes must be between 0 and 120")
+	ErrInvalidLateGrace      = errors.New("late_grace_minutes must be between 0 and 60")
+	ErrInvalidEffectiveRange = errors.New("effective_to must not be before effective_from")
+)
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrBreakNotFound      = errors.New("time log break not found")
+	ErrBreakInProgress    = errors.New("a break is already in progress")
+	ErrNotOnBreak         = errors.New("no break in progress")
+	ErrBreakAlreadyEnded  = errors.New("break has already ended")
+	ErrInvalidBreakPolicy = errors.New("break policy durations must not be negative")
+)
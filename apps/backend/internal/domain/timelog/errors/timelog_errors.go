@@ -8,6 +8,7 @@ var (
 	ErrAlreadyClockedIn   = errors.New("student already has an open time log")
 	ErrNotClockedIn       = errors.New("no open time log to clock out")
 	ErrNoActiveShift      = errors.New("student has no shift assignment right now")
+	ErrOnApprovedTimeOff  = errors.New("student is on approved time off for this shift")
 	ErrInvalidCoordinates = errors.New("longitude or latitude out of range")
 	ErrInvalidFlagReason  = errors.New("flag reason must not be empty")
 	ErrAlreadyClockedOut  = errors.New("time log already has an exit time")
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ClockInLockoutHandler struct {
+	logger  *zap.Logger
+	service service.ClockInLockoutServiceInterface
+}
+
+func NewClockInLockoutHandler(logger *zap.Logger, service service.ClockInLockoutServiceInterface) *ClockInLockoutHandler {
+	return &ClockInLockoutHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *ClockInLockoutHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/clock-in-lockouts", func(r chi.Router) {
+		r.Get("/", h.ListLockouts)
+		r.Patch("/{id}/clear", h.ClearLockout)
+		r.Get("/students/{studentID}/attempts", h.ListAttempts)
+	})
+}
+
+// ListLockouts supports ?active=true and ?student_id=.
+func (h *ClockInLockoutHandler) ListLockouts(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	activeOnly := false
+	if v := q.Get("active"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid active value")
+			return
+		}
+		activeOnly = parsed
+	}
+
+	var studentID *int32
+	if v := q.Get("student_id"); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid student_id")
+			return
+		}
+		sid := int32(parsed)
+		studentID = &sid
+	}
+
+	lockouts, err := h.service.ListLockouts(r.Context(), studentID, activeOnly)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ClockInLockoutsToResponse(lockouts, time.Now()))
+}
+
+func (h *ClockInLockoutHandler) ListAttempts(w http.ResponseWriter, r *http.Request) {
+	studentID, err := strconv.ParseInt(chi.URLParam(r, "studentID"), 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+		return
+	}
+
+	attempts, err := h.service.ListAttempts(r.Context(), int32(studentID))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ClockInAttemptsToResponse(attempts))
+}
+
+func (h *ClockInLockoutHandler) ClearLockout(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid lockout ID")
+		return
+	}
+
+	lockout, err := h.service.ClearLockout(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ClockInLockoutToResponse(lockout, time.Now()))
+}
+
+func (h *ClockInLockoutHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, timelogErrors.ErrLockoutNotFound):
+		writeError(w, http.StatusNotFound, "lockout not found")
+	case errors.Is(err, timelogErrors.ErrLockoutAlreadyCleared):
+		writeError(w, http.StatusConflict, "lockout has already been cleared")
+	case errors.Is(err, timelogErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, timelogErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
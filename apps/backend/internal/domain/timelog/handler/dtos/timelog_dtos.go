@@ -1,18 +1,22 @@
 package dtos
 
 import (
+	"errors"
 	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/google/uuid"
 )
 
 // --- Requests ---
 
 type ClockInRequest struct {
-	Code      string  `json:"code"`
-	Longitude float64 `json:"longitude"`
-	Latitude  float64 `json:"latitude"`
+	Code              string   `json:"code"`
+	Longitude         float64  `json:"longitude"`
+	Latitude          float64  `json:"latitude"`
+	DeviceFingerprint string   `json:"device_fingerprint"`
+	AccuracyMeters    *float64 `json:"accuracy_meters"`
 }
 
 type GenerateCodeRequest struct {
@@ -35,20 +39,40 @@ type TimeLogResponse struct {
 	DistanceMeters float64    `json:"distance_meters"`
 	IsFlagged      bool       `json:"is_flagged"`
 	FlagReason     *string    `json:"flag_reason"`
+	FlagCodes      []string   `json:"flag_codes"`
+	LocationID     *string    `json:"location_id"`
+	ScheduledStart *time.Time `json:"scheduled_start"`
+	ScheduledEnd   *time.Time `json:"scheduled_end"`
+	PayRuleID      *string    `json:"pay_rule_id"`
+	RawMinutes     *float64   `json:"raw_minutes"`
+	PaidMinutes    *float64   `json:"paid_minutes"`
 	CreatedAt      time.Time  `json:"created_at"`
 }
 
 type ClockInStatusResponse struct {
-	IsClockedIn  bool               `json:"is_clocked_in"`
-	CurrentLog   *TimeLogResponse   `json:"current_log"`
-	CurrentShift *ShiftInfoResponse `json:"current_shift"`
+	IsClockedIn  bool                  `json:"is_clocked_in"`
+	CurrentLog   *TimeLogResponse      `json:"current_log"`
+	CurrentShift *ShiftInfoResponse    `json:"current_shift"`
+	CurrentBreak *TimeLogBreakResponse `json:"current_break"`
+}
+
+type TimeLogBreakResponse struct {
+	ID              string     `json:"id"`
+	TimeLogID       string     `json:"time_log_id"`
+	StartAt         time.Time  `json:"start_at"`
+	EndAt           *time.Time `json:"end_at"`
+	DurationMinutes float64    `json:"duration_minutes"`
 }
 
 type ShiftInfoResponse struct {
-	ShiftID   string `json:"shift_id"`
-	Name      string `json:"name"`
-	StartTime string `json:"start_time"`
-	EndTime   string `json:"end_time"`
+	ShiftID        string    `json:"shift_id"`
+	Name           string    `json:"name"`
+	StartTime      string    `json:"start_time"`
+	EndTime        string    `json:"end_time"`
+	ScheduledStart time.Time `json:"scheduled_start"`
+	ScheduledEnd   time.Time `json:"scheduled_end"`
+	LocationID     *string   `json:"location_id"`
+	LocationName   string    `json:"location_name,omitempty"`
 }
 
 type ClockInCodeResponse struct {
@@ -71,7 +95,19 @@ type AdminTimeLogResponse struct {
 	DistanceMeters float64    `json:"distance_meters"`
 	IsFlagged      bool       `json:"is_flagged"`
 	FlagReason     *string    `json:"flag_reason"`
-	CreatedAt      time.Time  `json:"created_at"`
+	FlagCodes      []string   `json:"flag_codes"`
+	LocationID     *string    `json:"location_id"`
+	ScheduledStart *time.Time `json:"scheduled_start"`
+	ScheduledEnd   *time.Time `json:"scheduled_end"`
+	PayRuleID      *string    `json:"pay_rule_id"`
+	RawMinutes     *float64   `json:"raw_minutes"`
+	PaidMinutes    *float64   `json:"paid_minutes"`
+	// Clock-in device details, shown to admins reviewing flags.
+	DeviceFingerprint *string   `json:"device_fingerprint"`
+	IPAddress         *string   `json:"ip_address"`
+	UserAgent         *string   `json:"user_agent"`
+	GPSAccuracyMeters *float64  `json:"gps_accuracy_meters"`
+	CreatedAt         time.Time `json:"created_at"`
 }
 
 // --- Converters ---
@@ -87,10 +123,34 @@ func TimeLogToResponse(tl *aggregate.TimeLog) TimeLogResponse {
 		DistanceMeters: tl.DistanceMeters,
 		IsFlagged:      tl.IsFlagged,
 		FlagReason:     tl.FlagReason,
+		FlagCodes:      flagCodesToStrings(tl.FlagCodes),
+		LocationID:     uuidPtrToString(tl.LocationID),
+		ScheduledStart: tl.ScheduledStart,
+		ScheduledEnd:   tl.ScheduledEnd,
+		PayRuleID:      uuidPtrToString(tl.PayRuleID),
+		RawMinutes:     tl.RawMinutes(),
+		PaidMinutes:    tl.PaidMinutes,
 		CreatedAt:      tl.CreatedAt,
 	}
 }
 
+func uuidPtrToString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// flagCodesToStrings never returns nil so unflagged logs encode as [].
+func flagCodesToStrings(codes []aggregate.FlagCode) []string {
+	out := make([]string, len(codes))
+	for i, c := range codes {
+		out[i] = string(c)
+	}
+	return out
+}
+
 func TimeLogsToResponse(logs []*aggregate.TimeLog) []TimeLogResponse {
 	responses := make([]TimeLogResponse, len(logs))
 	for i, tl := range logs {
@@ -118,30 +178,67 @@ func ClockInStatusToResponse(status *service.ClockInStatus) ClockInStatusRespons
 	}
 	if status.CurrentShift != nil {
 		resp.CurrentShift = &ShiftInfoResponse{
-			ShiftID:   status.CurrentShift.ShiftID,
-			Name:      status.CurrentShift.Name,
-			StartTime: status.CurrentShift.StartTime,
-			EndTime:   status.CurrentShift.EndTime,
+			ShiftID:        status.CurrentShift.ShiftID,
+			Name:           status.CurrentShift.Name,
+			StartTime:      status.CurrentShift.StartTime,
+			EndTime:        status.CurrentShift.EndTime,
+			ScheduledStart: status.CurrentShift.ScheduledStart,
+			ScheduledEnd:   status.CurrentShift.ScheduledEnd,
+			LocationID:     uuidPtrToString(status.CurrentShift.LocationID),
+			LocationName:   status.CurrentShift.LocationName,
 		}
 	}
+	if status.CurrentBreak != nil {
+		br := TimeLogBreakToResponse(status.CurrentBreak)
+		resp.CurrentBreak = &br
+	}
 	return resp
 }
 
+func TimeLogBreakToResponse(b *aggregate.TimeLogBreak) TimeLogBreakResponse {
+	return TimeLogBreakResponse{
+		ID:              b.ID.String(),
+		TimeLogID:       b.TimeLogID.String(),
+		StartAt:         b.StartAt,
+		EndAt:           b.EndAt,
+		DurationMinutes: b.Duration().Minutes(),
+	}
+}
+
+func TimeLogBreaksToResponse(breaks []*aggregate.TimeLogBreak) []TimeLogBreakResponse {
+	responses := make([]TimeLogBreakResponse, len(breaks))
+	for i, b := range breaks {
+		responses[i] = TimeLogBreakToResponse(b)
+	}
+	return responses
+}
+
 func AdminTimeLogToResponse(atl *aggregate.AdminTimeLog) AdminTimeLogResponse {
 	return AdminTimeLogResponse{
-		ID:             atl.ID.String(),
-		StudentID:      atl.StudentID,
-		StudentName:    atl.StudentName,
-		StudentEmail:   atl.StudentEmail,
-		StudentPhone:   atl.StudentPhone,
-		EntryAt:        atl.EntryAt,
-		ExitAt:         atl.ExitAt,
-		Longitude:      atl.Longitude,
-		Latitude:       atl.Latitude,
-		DistanceMeters: atl.DistanceMeters,
-		IsFlagged:      atl.IsFlagged,
-		FlagReason:     atl.FlagReason,
-		CreatedAt:      atl.CreatedAt,
+		ID:                atl.ID.String(),
+		StudentID:         atl.StudentID,
+		StudentName:       atl.StudentName,
+		StudentEmail:      atl.StudentEmail,
+		StudentPhone:      atl.StudentPhone,
+		EntryAt:           atl.EntryAt,
+		ExitAt:            atl.ExitAt,
+		Longitude:         atl.Longitude,
+		Latitude:          atl.Latitude,
+		DistanceMeters:    atl.DistanceMeters,
+		IsFlagged:         atl.IsFlagged,
+		FlagReason:        atl.FlagReason,
+		FlagCodes:         flagCodesToStrings(atl.FlagCodes),
+		LocationID:        uuidPtrToString(atl.LocationID),
+		ScheduledStart:    atl.ScheduledStart,
+		ScheduledEnd:      atl.ScheduledEnd,
+		PayRuleID:         uuidPtrToString(atl.PayRuleID),
+		RawMinutes:        atl.RawMinutes(),
+		PaidMinutes:       atl.PaidMinutes,
+		DeviceFingerprint: atl.DeviceFingerprint,
+		IPAddress:         atl.IPAddress,
+		UserAgent:         atl.UserAgent,
+		GPSAccuracyMeters: atl.GPSAccuracyMeters,
+		CreatedAt:         atl.CreatedAt,
 	}
 }
 
@@ -152,3 +249,226 @@ func AdminTimeLogsToResponse(logs []*aggregate.AdminTimeLog) []AdminTimeLogRespo
 	}
 	return responses
 }
+
+// --- Kiosks ---
+
+type CreateKioskRequest struct {
+	Name            string `json:"name"`
+	RotationSeconds int    `json:"rotation_seconds"`
+}
+
+type KioskResponse struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	RotationSeconds int32      `json:"rotation_seconds"`
+	IsActive        bool       `json:"is_active"`
+	LastSeenAt      *time.Time `json:"last_seen_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// CreateKioskResponse includes the device token, which is only returned once.
+type CreateKioskResponse struct {
+	KioskResponse
+	Token string `json:"token"`
+}
+
+type KioskCodeResponse struct {
+	KioskID         string    `json:"kiosk_id"`
+	KioskName       string    `json:"kiosk_name"`
+	Code            string    `json:"code"`
+	ExpiresAt       time.Time `json:"expires_at"`
+	RotationSeconds int32     `json:"rotation_seconds"`
+}
+
+func KioskToResponse(k *aggregate.Kiosk) KioskResponse {
+	return KioskResponse{
+		ID:              k.ID.String(),
+		Name:            k.Name,
+		RotationSeconds: k.RotationSeconds,
+		IsActive:        k.IsActive,
+		LastSeenAt:      k.LastSeenAt,
+		CreatedAt:       k.CreatedAt,
+	}
+}
+
+func KiosksToResponse(kiosks []*aggregate.Kiosk) []KioskResponse {
+	responses := make([]KioskResponse, len(kiosks))
+	for i, k := range kiosks {
+		responses[i] = KioskToResponse(k)
+	}
+	return responses
+}
+
+func KioskCodeToResponse(c *service.KioskCode) KioskCodeResponse {
+	return KioskCodeResponse{
+		KioskID:         c.KioskID.String(),
+		KioskName:       c.KioskName,
+		Code:            c.Code,
+		ExpiresAt:       c.ExpiresAt,
+		RotationSeconds: c.RotationSeconds,
+	}
+}
+
+// --- Clock-in lockouts ---
+
+type ClockInLockoutResponse struct {
+	ID             string     `json:"id"`
+	StudentID      int32      `json:"student_id"`
+	Level          int32      `json:"level"`
+	FailedAttempts int32      `json:"failed_attempts"`
+	LockedAt       time.Time  `json:"locked_at"`
+	LockedUntil    time.Time  `json:"locked_until"`
+	IsActive       bool       `json:"is_active"`
+	ClearedAt      *time.Time `json:"cleared_at"`
+	ClearedBy      *string    `json:"cleared_by"`
+}
+
+type ClockInAttemptResponse struct {
+	ID          string    `json:"id"`
+	StudentID   int32     `json:"student_id"`
+	Code        string    `json:"code"`
+	Succeeded   bool      `json:"succeeded"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+func ClockInLockoutToResponse(l *aggregate.ClockInLockout, now time.Time) ClockInLockoutResponse {
+	return ClockInLockoutResponse{
+		ID:             l.ID.String(),
+		StudentID:      l.StudentID,
+		Level:          l.Level,
+		FailedAttempts: l.FailedAttempts,
+		LockedAt:       l.LockedAt,
+		LockedUntil:    l.LockedUntil,
+		IsActive:       l.IsActive(now),
+		ClearedAt:      l.ClearedAt,
+		ClearedBy:      uuidPtrToString(l.ClearedBy),
+	}
+}
+
+func ClockInLockoutsToResponse(lockouts []*aggregate.ClockInLockout, now time.Time) []ClockInLockoutResponse {
+	responses := make([]ClockInLockoutResponse, len(lockouts))
+	for i, l := range lockouts {
+		responses[i] = ClockInLockoutToResponse(l, now)
+	}
+	return responses
+}
+
+func ClockInAttemptsToResponse(attempts []*aggregate.ClockInAttempt) []ClockInAttemptResponse {
+	responses := make([]ClockInAttemptResponse, len(attempts))
+	for i, a := range attempts {
+		responses[i] = ClockInAttemptResponse{
+			ID:          a.ID.String(),
+			StudentID:   a.StudentID,
+			Code:        a.Code,
+			Succeeded:   a.Succeeded,
+			AttemptedAt: a.AttemptedAt,
+		}
+	}
+	return responses
+}
+
+// --- Pay rules ---
+
+type PayRuleRequest struct {
+	Name                  string  `json:"name"`
+	LocationID            *string `json:"location_id"`
+	EffectiveFrom         *string `json:"effective_from"` // YYYY-MM-DD
+	EffectiveTo           *string `json:"effective_to"`   // YYYY-MM-DD, inclusive
+	RoundToMinutes        int     `json:"round_to_minutes"`
+	EarlyClockInMinutes   *int    `json:"early_clock_in_minutes"`
+	PayFromScheduledStart bool    `json:"pay_from_scheduled_start"`
+	LateGraceMinutes      int     `json:"late_grace_minutes"`
+	CapAtScheduledEnd     bool    `json:"cap_at_scheduled_end"`
+}
+
+// ToParams parses the request. A missing early_clock_in_minutes keeps the
+// default window.
+func (r PayRuleRequest) ToParams() (aggregate.PayRuleParams, error) {
+	params := aggregate.PayRuleParams{
+		Name:                  r.Name,
+		RoundToMinutes:        r.RoundToMinutes,
+		EarlyClockInMinutes:   aggregate.DefaultEarlyClockInMinutes,
+		PayFromScheduledStart: r.PayFromScheduledStart,
+		LateGraceMinutes:      r.LateGraceMinutes,
+		CapAtScheduledEnd:     r.CapAtScheduledEnd,
+	}
+	if r.EarlyClockInMinutes != nil {
+		params.EarlyClockInMinutes = *r.EarlyClockInMinutes
+	}
+	if r.LocationID != nil && *r.LocationID != "" {
+		id, err := uuid.Parse(*r.LocationID)
+		if err != nil {
+			return params, errors.New("invalid location_id")
+		}
+		params.LocationID = &id
+	}
+	var err error
+	if params.EffectiveFrom, err = parseOptionalDate(r.EffectiveFrom); err != nil {
+		return params, errors.New("invalid effective_from format, expected YYYY-MM-DD")
+	}
+	if params.EffectiveTo, err = parseOptionalDate(r.EffectiveTo); err != nil {
+		return params, errors.New("invalid effective_to format, expected YYYY-MM-DD")
+	}
+	return params, nil
+}
+
+func parseOptionalDate(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+type PayRuleResponse struct {
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	LocationID            *string    `json:"location_id"`
+	EffectiveFrom         *string    `json:"effective_from"`
+	EffectiveTo           *string    `json:"effective_to"`
+	RoundToMinutes        int32      `json:"round_to_minutes"`
+	EarlyClockInMinutes   int32      `json:"early_clock_in_minutes"`
+	PayFromScheduledStart bool       `json:"pay_from_scheduled_start"`
+	LateGraceMinutes      int32      `json:"late_grace_minutes"`
+	CapAtScheduledEnd     bool       `json:"cap_at_scheduled_end"`
+	IsActive              bool       `json:"is_active"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             *time.Time `json:"updated_at"`
+}
+
+func PayRuleToResponse(r *aggregate.PayRule) PayRuleResponse {
+	return PayRuleResponse{
+		ID:                    r.ID.String(),
+		Name:                  r.Name,
+		LocationID:            uuidPtrToString(r.LocationID),
+		EffectiveFrom:         datePtrToString(r.EffectiveFrom),
+		EffectiveTo:           datePtrToString(r.EffectiveTo),
+		RoundToMinutes:        r.RoundToMinutes,
+		EarlyClockInMinutes:   r.EarlyClockInMinutes,
+		PayFromScheduledStart: r.PayFromScheduledStart,
+		LateGraceMinutes:      r.LateGraceMinutes,
+		CapAtScheduledEnd:     r.CapAtScheduledEnd,
+		IsActive:              r.IsActive,
+		CreatedAt:             r.CreatedAt,
+		UpdatedAt:             r.UpdatedAt,
+	}
+}
+
+func PayRulesToResponse(rules []*aggregate.PayRule) []PayRuleResponse {
+	responses := make([]PayRuleResponse, len(rules))
+	for i, r := range rules {
+		responses[i] = PayRuleToResponse(r)
+	}
+	return responses
+}
+
+func datePtrToString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.DateOnly)
+	return &s
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/skip2/go-qrcode"
+	"go.uber.org/zap"
+)
+
+// KioskTokenHeader carries the device token issued when a kiosk is created.
+const KioskTokenHeader = "X-Kiosk-Token"
+
+const (
+	defaultQRSize = 320
+	maxQRSize     = 1024
+)
+
+type KioskHandler struct {
+	logger      *zap.Logger
+	service     service.KioskServiceInterface
+	frontendURL string
+}
+
+func NewKioskHandler(logger *zap.Logger, service service.KioskServiceInterface, frontendURL string) *KioskHandler {
+	return &KioskHandler{
+		logger:      logger,
+		service:     service,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// RegisterKioskRoutes registers routes authenticated by the kiosk device token
+// rather than a user JWT.
+func (h *KioskHandler) RegisterKioskRoutes(r chi.Router) {
+	r.Get("/kiosk/code", h.GetCurrentCode)
+	r.Get("/kiosk/code/qr", h.GetCurrentCodeQR)
+}
+
+func (h *KioskHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/kiosks", func(r chi.Router) {
+		r.Post("/", h.CreateKiosk)
+		r.Get("/", h.ListKiosks)
+		r.Patch("/{id}/deactivate", h.DeactivateKiosk)
+	})
+}
+
+func (h *KioskHandler) CreateKiosk(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateKioskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	kiosk, token, err := h.service.CreateKiosk(r.Context(), req.Name, req.RotationSeconds)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.CreateKioskResponse{
+		KioskResponse: dtos.KioskToResponse(kiosk),
+		Token:         token,
+	})
+}
+
+func (h *KioskHandler) ListKiosks(w http.ResponseWriter, r *http.Request) {
+	kiosks, err := h.service.ListKiosks(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.KiosksToResponse(kiosks))
+}
+
+func (h *KioskHandler) DeactivateKiosk(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid kiosk ID")
+		return
+	}
+
+	kiosk, err := h.service.DeactivateKiosk(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.KioskToResponse(kiosk))
+}
+
+func (h *KioskHandler) GetCurrentCode(w http.ResponseWriter, r *http.Request) {
+	code, err := h.service.GetCurrentCode(r.Context(), r.Header.Get(KioskTokenHeader))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, dtos.KioskCodeToResponse(code))
+}
+
+// GetCurrentCodeQR renders the current code as a PNG QR code pointing at the
+// student clock page, so students can scan instead of typing.
+func (h *KioskHandler) GetCurrentCodeQR(w http.ResponseWriter, r *http.Request) {
+	size := defaultQRSize
+	if v := r.URL.Query().Get("size"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 64 || parsed > maxQRSize {
+			writeError(w, http.StatusBadRequest, "size must be between 64 and 1024")
+			return
+		}
+		size = parsed
+	}
+
+	code, err := h.service.GetCurrentCode(r.Context(), r.Header.Get(KioskTokenHeader))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	png, err := qrcode.Encode(h.frontendURL+"/clock?code="+url.QueryEscape(code.Code), qrcode.Medium, size)
+	if err != nil {
+		h.logger.Error("failed to render kiosk QR code", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Code-Expires-At", code.ExpiresAt.Format(time.RFC3339))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(png); err != nil {
+		h.logger.Warn("failed to write kiosk QR code", zap.Error(err))
+	}
+}
+
+func (h *KioskHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, timelogErrors.ErrInvalidKioskToken):
+		writeError(w, http.StatusUnauthorized, "invalid kiosk token")
+	case errors.Is(err, timelogErrors.ErrKioskNotFound):
+		writeError(w, http.StatusNotFound, "kiosk not found")
+	case errors.Is(err, timelogErrors.ErrKioskInactive):
+		writeError(w, http.StatusConflict, "kiosk is already inactive")
+	case errors.Is(err, timelogErrors.ErrInvalidKioskName):
+		writeError(w, http.StatusBadRequest, "name must be between 1 and 100 characters")
+	case errors.Is(err, timelogErrors.ErrInvalidKioskRotation):
+		writeError(w, http.StatusBadRequest, "rotation_seconds must be between 10 and 300")
+	case errors.Is(err, timelogErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, timelogErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
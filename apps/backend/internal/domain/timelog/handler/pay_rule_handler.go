@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type PayRuleHandler struct {
+	logger  *zap.Logger
+	service service.PayRuleServiceInterface
+}
+
+func NewPayRuleHandler(logger *zap.Logger, service service.PayRuleServiceInterface) *PayRuleHandler {
+	return &PayRuleHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *PayRuleHandler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/pay-rules", func(r chi.Router) {
+		r.Post("/", h.CreatePayRule)
+		r.Get("/", h.ListPayRules)
+		r.Put("/{id}", h.UpdatePayRule)
+		r.Patch("/{id}/deactivate", h.DeactivatePayRule)
+	})
+}
+
+func (h *PayRuleHandler) CreatePayRule(w http.ResponseWriter, r *http.Request) {
+	var req dtos.PayRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	params, err := req.ToParams()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rule, err := h.service.CreatePayRule(r.Context(), params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.PayRuleToResponse(rule))
+}
+
+func (h *PayRuleHandler) ListPayRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.service.ListPayRules(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayRulesToResponse(rules))
+}
+
+func (h *PayRuleHandler) UpdatePayRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid pay rule ID")
+		return
+	}
+
+	var req dtos.PayRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	params, err := req.ToParams()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rule, err := h.service.UpdatePayRule(r.Context(), id, params)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayRuleToResponse(rule))
+}
+
+func (h *PayRuleHandler) DeactivatePayRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid pay rule ID")
+		return
+	}
+
+	rule, err := h.service.DeactivatePayRule(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.PayRuleToResponse(rule))
+}
+
+func (h *PayRuleHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, timelogErrors.ErrPayRuleNotFound):
+		writeError(w, http.StatusNotFound, "pay rule not found")
+	case errors.Is(err, timelogErrors.ErrPayRuleInactive):
+		writeError(w, http.StatusConflict, "pay rule is already inactive")
+	case errors.Is(err, timelogErrors.ErrInvalidPayRuleName),
+		errors.Is(err, timelogErrors.ErrInvalidRounding),
+		errors.Is(err, timelogErrors.ErrInvalidEarlyWindow),
+		errors.Is(err, timelogErrors.ErrInvalidLateGrace),
+		errors.Is(err, timelogErrors.ErrInvalidEffectiveRange):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, scheduleErrors.ErrLocationNotFound):
+		writeError(w, http.StatusBadRequest, "location not found")
+	case errors.Is(err, timelogErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, timelogErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
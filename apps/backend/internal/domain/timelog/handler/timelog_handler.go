@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"log"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +19,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// maxDeviceFingerprintLength matches time_logs.device_fingerprint.
+const maxDeviceFingerprintLength = 128
+
 type TimeLogHandler struct {
 	logger  *zap.Logger
 	service service.TimeLogServiceInterface
@@ -33,6 +37,8 @@ func NewTimeLogHandler(logger *zap.Logger, service service.TimeLogServiceInterfa
 func (h *TimeLogHandler) RegisterRoutes(r chi.Router) {
 	r.Post("/time-logs/clock-in", h.ClockIn)
 	r.Post("/time-logs/clock-out", h.ClockOut)
+	r.Post("/time-logs/breaks/start", h.StartBreak)
+	r.Post("/time-logs/breaks/end", h.EndBreak)
 	r.Get("/time-logs/me/status", h.GetMyStatus)
 	r.Get("/time-logs/me", h.ListMyTimeLogs)
 }
@@ -46,6 +52,7 @@ func (h *TimeLogHandler) RegisterAdminRoutes(r chi.Router) {
 	// Individual routes (not r.Route) to avoid chi mount conflict in tests.
 	r.Get("/time-logs", h.ListTimeLogs)
 	r.Get("/time-logs/{id}", h.GetTimeLog)
+	r.Get("/time-logs/{id}/breaks", h.ListTimeLogBreaks)
 	r.Patch("/time-logs/{id}/flag", h.FlagTimeLog)
 	r.Patch("/time-logs/{id}/unflag", h.UnflagTimeLog)
 }
@@ -67,10 +74,19 @@ func (h *TimeLogHandler) ClockIn(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(req.DeviceFingerprint) > maxDeviceFingerprintLength {
+		writeError(w, http.StatusBadRequest, "device_fingerprint is too long")
+		return
+	}
+
 	tl, err := h.service.ClockIn(r.Context(), service.ClockInInput{
-		Code:      req.Code,
-		Longitude: req.Longitude,
-		Latitude:  req.Latitude,
+		Code:              req.Code,
+		Longitude:         req.Longitude,
+		Latitude:          req.Latitude,
+		DeviceFingerprint: req.DeviceFingerprint,
+		IPAddress:         clientIP(r),
+		UserAgent:         r.UserAgent(),
+		GPSAccuracyMeters: req.AccuracyMeters,
 	})
 	if err != nil {
 		h.handleServiceError(w, err)
@@ -90,6 +106,26 @@ func (h *TimeLogHandler) ClockOut(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, dtos.TimeLogToResponse(tl))
 }
 
+func (h *TimeLogHandler) StartBreak(w http.ResponseWriter, r *http.Request) {
+	b, err := h.service.StartBreak(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.TimeLogBreakToResponse(b))
+}
+
+func (h *TimeLogHandler) EndBreak(w http.ResponseWriter, r *http.Request) {
+	b, err := h.service.EndBreak(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeLogBreakToResponse(b))
+}
+
 func (h *TimeLogHandler) GetMyStatus(w http.ResponseWriter, r *http.Request) {
 	status, err := h.service.GetMyStatus(r.Context())
 	if err != nil {
@@ -232,6 +268,22 @@ func (h *TimeLogHandler) GetTimeLog(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, dtos.AdminTimeLogToResponse(tl))
 }
 
+func (h *TimeLogHandler) ListTimeLogBreaks(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid time log ID")
+		return
+	}
+
+	breaks, err := h.service.ListTimeLogBreaks(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeLogBreaksToResponse(breaks))
+}
+
 func (h *TimeLogHandler) FlagTimeLog(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
@@ -285,8 +337,12 @@ func (h *TimeLogHandler) handleServiceError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, timelogErrors.ErrInvalidClockInCode):
 		writeError(w, http.StatusBadRequest, "invalid or expired clock-in code")
+	case errors.Is(err, timelogErrors.ErrClockInLocked):
+		writeError(w, http.StatusTooManyRequests, "too many failed clock-in attempts; try again later")
 	case errors.Is(err, timelogErrors.ErrNoActiveShift):
 		writeError(w, http.StatusBadRequest, "no active shift assignment found")
+	case errors.Is(err, timelogErrors.ErrOnApprovedTimeOff):
+		writeError(w, http.StatusConflict, "on approved time off for this shift")
 	case errors.Is(err, timelogErrors.ErrAlreadyClockedIn):
 		writeError(w, http.StatusConflict, "already clocked in")
 	case errors.Is(err, timelogErrors.ErrNotClockedIn):
@@ -303,6 +359,10 @@ func (h *TimeLogHandler) handleServiceError(w http.ResponseWriter, err error) {
 		writeError(w, http.StatusNotFound, "time log not found")
 	case errors.Is(err, timelogErrors.ErrInvalidFlagReason):
 		writeError(w, http.StatusBadRequest, "flag reason must not be empty")
+	case errors.Is(err, timelogErrors.ErrBreakInProgress):
+		writeError(w, http.StatusConflict, "a break is already in progress")
+	case errors.Is(err, timelogErrors.ErrNotOnBreak):
+		writeError(w, http.StatusNotFound, "no break in progress")
 	default:
 		h.logger.Error("unhandled service error", zap.Error(err))
 		writeError(w, http.StatusInternalServerError, "internal server error")
@@ -320,3 +380,17 @@ func writeJSON(w http.ResponseWriter, status int, data any) {
 func writeError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]string{"error": message})
 }
+
+// clientIP returns the caller's address without the port, or "" when it is
+// not an IP. RemoteAddr is already rewritten by the RealIP middleware.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
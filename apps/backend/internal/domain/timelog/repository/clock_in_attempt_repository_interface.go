@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+)
+
+type ClockInAttemptRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, attempt *aggregate.ClockInAttempt) error
+	// CountFailuresSince counts a student's failed attempts after since.
+	CountFailuresSince(ctx context.Context, tx *sql.Tx, studentID int32, since time.Time) (int, error)
+	// LastSuccessAt returns the student's most recent successful attempt, or nil.
+	LastSuccessAt(ctx context.Context, tx *sql.Tx, studentID int32) (*time.Time, error)
+	// CountStudentsFailingCodeSince counts distinct students that submitted
+	// code unsuccessfully after since.
+	CountStudentsFailingCodeSince(ctx context.Context, tx *sql.Tx, code string, since time.Time) (int, error)
+	// ListByStudentID returns a student's most recent attempts, newest first.
+	ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.ClockInAttempt, error)
+}
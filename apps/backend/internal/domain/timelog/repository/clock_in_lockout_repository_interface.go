@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+)
+
+type ClockInLockoutFilter struct {
+	StudentID *int32
+	// ActiveAt restricts results to lockouts still in force at this time.
+	ActiveAt *time.Time
+}
+
+type ClockInLockoutRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ClockInLockout, error)
+	// GetLatestByStudentID returns the student's most recent lockout.
+	GetLatestByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ClockInLockout, error)
+	List(ctx context.Context, tx *sql.Tx, filter ClockInLockoutFilter) ([]*aggregate.ClockInLockout, error)
+	Update(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error)
+}
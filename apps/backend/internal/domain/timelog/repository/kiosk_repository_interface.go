@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+)
+
+type KioskRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Kiosk, error)
+	GetByTokenHash(ctx context.Context, tx *sql.Tx, tokenHash string) (*aggregate.Kiosk, error)
+	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error)
+	ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error)
+	Update(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error)
+	TouchLastSeen(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+)
+
+type PayRuleRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PayRule, error)
+	List(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error)
+	ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error)
+	Update(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error)
+}
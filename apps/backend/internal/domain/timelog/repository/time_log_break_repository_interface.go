@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+)
+
+type TimeLogBreakRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error)
+	// GetOpenByTimeLogID returns the break in progress for a time log.
+	GetOpenByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) (*aggregate.TimeLogBreak, error)
+	Update(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error)
+	ListByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) ([]*aggregate.TimeLogBreak, error)
+}
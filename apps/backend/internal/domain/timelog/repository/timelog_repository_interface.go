@@ -13,6 +13,9 @@ type TimeLogFilter struct {
 	StudentID *int32
 	From      *time.Time
 	To        *time.Time
+	// WeekStart selects logs that started in the Monday-to-Sunday week
+	// beginning on that date, in the local time of each log's location.
+	WeekStart *time.Time
 	Flagged   *bool
 	Search    string
 	Page      int
@@ -25,6 +28,14 @@ type TimeLogRepositoryInterface interface {
 	GetOpenByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TimeLog, error)
 	Update(ctx context.Context, tx *sql.Tx, timeLog *aggregate.TimeLog) (*aggregate.TimeLog, error)
 	List(ctx context.Context, tx *sql.Tx, filter TimeLogFilter) ([]*aggregate.TimeLog, int, error)
+	// ListAll returns every log matching the filter, oldest first, ignoring
+	// pagination. Intended for reports over a bounded date range.
+	ListAll(ctx context.Context, tx *sql.Tx, filter TimeLogFilter) ([]*aggregate.TimeLog, error)
+	// ListRecentByStudentID returns the student's latest clock-ins, newest first.
+	ListRecentByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.TimeLog, error)
+	// ListStudentIDsByDevice returns the students other than excludeStudentID
+	// who clocked in from the device since the given time.
+	ListStudentIDsByDevice(ctx context.Context, tx *sql.Tx, deviceFingerprint string, since time.Time, excludeStudentID int32) ([]int32, error)
 	ListWithStudentDetails(ctx context.Context, tx *sql.Tx, filter TimeLogFilter) ([]*aggregate.AdminTimeLog, int, error)
 	GetByIDWithStudentDetails(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.AdminTimeLog, error)
 }
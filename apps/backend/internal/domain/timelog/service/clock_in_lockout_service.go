@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	userRepo "github.com/HDR3604/HelpDeskApp/internal/domain/user/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxListedAttempts bounds the attempt history returned to admins.
+const maxListedAttempts = 100
+
+// ClockInLockoutServiceInterface tracks clock-in attempts per student and
+// enforces progressive lockouts after repeated invalid codes.
+type ClockInLockoutServiceInterface interface {
+	// GetActiveLockout returns the student's lockout in force now, or nil.
+	GetActiveLockout(ctx context.Context, studentID int32) (*aggregate.ClockInLockout, error)
+	// RecordAttempt stores an attempt and, for failures, locks the student out
+	// once the threshold is crossed. It returns the lockout it created, if any.
+	RecordAttempt(ctx context.Context, studentID int32, code string, succeeded bool) (*aggregate.ClockInLockout, error)
+	ListLockouts(ctx context.Context, studentID *int32, activeOnly bool) ([]*aggregate.ClockInLockout, error)
+	ListAttempts(ctx context.Context, studentID int32) ([]*aggregate.ClockInAttempt, error)
+	ClearLockout(ctx context.Context, id uuid.UUID) (*aggregate.ClockInLockout, error)
+}
+
+// ClockInLockoutService implements ClockInLockoutServiceInterface.
+type ClockInLockoutService struct {
+	logger      *zap.Logger
+	txManager   database.TxManagerInterface
+	attemptRepo repository.ClockInAttemptRepositoryInterface
+	lockoutRepo repository.ClockInLockoutRepositoryInterface
+	userRepo    userRepo.UserRepositoryInterface
+	emailSender emailInterfaces.EmailSenderInterface
+	fromEmail   string
+	nowFn       func() time.Time
+}
+
+var _ ClockInLockoutServiceInterface = (*ClockInLockoutService)(nil)
+
+func NewClockInLockoutService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	attemptRepo repository.ClockInAttemptRepositoryInterface,
+	lockoutRepo repository.ClockInLockoutRepositoryInterface,
+	userRepo userRepo.UserRepositoryInterface,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+) *ClockInLockoutService {
+	return &ClockInLockoutService{
+		logger:      logger,
+		txManager:   txManager,
+		attemptRepo: attemptRepo,
+		lockoutRepo: lockoutRepo,
+		userRepo:    userRepo,
+		emailSender: emailSender,
+		fromEmail:   fromEmail,
+		nowFn:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *ClockInLockoutService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+func (s *ClockInLockoutService) GetActiveLockout(ctx context.Context, studentID int32) (*aggregate.ClockInLockout, error) {
+	var result *aggregate.ClockInLockout
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		latest, err := s.lockoutRepo.GetLatestByStudentID(ctx, tx, studentID)
+		if err != nil {
+			if errors.Is(err, timelogErrors.ErrLockoutNotFound) {
+				return nil
+			}
+			return err
+		}
+		if latest.IsActive(s.nowFn()) {
+			result = latest
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RecordAttempt runs in its own system transaction so that attempts are kept
+// even when the caller's clock-in transaction rolls back.
+func (s *ClockInLockoutService) RecordAttempt(ctx context.Context, studentID int32, code string, succeeded bool) (*aggregate.ClockInLockout, error) {
+	now := s.nowFn()
+
+	attempt, err := aggregate.NewClockInAttempt(studentID, code, succeeded, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lockout        *aggregate.ClockInLockout
+		codeAbuseCount int
+	)
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if err := s.attemptRepo.Create(ctx, tx, attempt); err != nil {
+			return err
+		}
+		if succeeded {
+			return nil
+		}
+
+		// Only failures since the window opened, the last success and the end
+		// of the previous lockout count towards a new lockout.
+		since := now.Add(-aggregate.ClockInAttemptWindow)
+
+		lastSuccess, err := s.attemptRepo.LastSuccessAt(ctx, tx, studentID)
+		if err != nil {
+			return err
+		}
+		if lastSuccess != nil && lastSuccess.After(since) {
+			since = *lastSuccess
+		}
+
+		previous, err := s.lockoutRepo.GetLatestByStudentID(ctx, tx, studentID)
+		if err != nil && !errors.Is(err, timelogErrors.ErrLockoutNotFound) {
+			return err
+		}
+		if previous != nil && previous.EndedAt().After(since) {
+			since = previous.EndedAt()
+		}
+
+		failures, err := s.attemptRepo.CountFailuresSince(ctx, tx, studentID, since)
+		if err != nil {
+			return err
+		}
+
+		if failures >= aggregate.MaxFailedClockInAttempts {
+			level := int32(1)
+			// Lockouts escalate while the student keeps failing; an admin
+			// clearing the previous lockout resets the ladder.
+			if previous != nil && previous.ClearedBy == nil && now.Sub(previous.EndedAt()) < aggregate.LockoutLevelResetAfter {
+				level = previous.Level + 1
+			}
+
+			l, err := aggregate.NewClockInLockout(studentID, level, int32(failures), now)
+			if err != nil {
+				return err
+			}
+			lockout, err = s.lockoutRepo.Create(ctx, tx, l)
+			if err != nil {
+				return err
+			}
+		}
+
+		if attempt.Code != "" {
+			codeAbuseCount, err = s.attemptRepo.CountStudentsFailingCodeSince(ctx, tx, attempt.Code, now.Add(-aggregate.ClockInAttemptWindow))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Alerts are best-effort and sent only after the attempt is committed.
+	if lockout != nil {
+		s.notifyAdmins(ctx, "Clock-in lockout",
+			fmt.Sprintf("Student %d was locked out of clock-in until %s (level %d) after %d invalid codes.",
+				studentID, lockout.LockedUntil.Format(time.RFC1123), lockout.Level, lockout.FailedAttempts))
+	}
+	// Alert exactly once per burst, when the threshold is first reached.
+	if codeAbuseCount == aggregate.CodeAbuseStudentThreshold {
+		s.notifyAdmins(ctx, "Repeated invalid clock-in code",
+			fmt.Sprintf("%d different students submitted the invalid clock-in code %q within %s.",
+				codeAbuseCount, attempt.Code, aggregate.ClockInAttemptWindow))
+	}
+
+	return lockout, nil
+}
+
+func (s *ClockInLockoutService) ListLockouts(ctx context.Context, studentID *int32, activeOnly bool) ([]*aggregate.ClockInLockout, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	filter := repository.ClockInLockoutFilter{StudentID: studentID}
+	if activeOnly {
+		now := s.nowFn()
+		filter.ActiveAt = &now
+	}
+
+	var result []*aggregate.ClockInLockout
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.lockoutRepo.List(ctx, tx, filter)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ClockInLockoutService) ListAttempts(ctx context.Context, studentID int32) ([]*aggregate.ClockInAttempt, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.ClockInAttempt
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.attemptRepo.ListByStudentID(ctx, tx, studentID, maxListedAttempts)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ClockInLockoutService) ClearLockout(ctx context.Context, id uuid.UUID) (*aggregate.ClockInLockout, error) {
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.ClockInLockout
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		lockout, err := s.lockoutRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := lockout.Clear(adminID, s.nowFn()); err != nil {
+			return err
+		}
+
+		result, err = s.lockoutRepo.Update(ctx, tx, lockout)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// notifyAdmins emails every active admin. Failures are logged, not returned.
+func (s *ClockInLockoutService) notifyAdmins(ctx context.Context, title, message string) {
+	var recipients []string
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		admins, err := s.userRepo.ListByRole(ctx, tx, string(userAggregate.Role_Admin))
+		if err != nil {
+			return err
+		}
+		for _, a := range admins {
+			if a.IsActive {
+				recipients = append(recipients, a.Email)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn("failed to load admins for alert", zap.Error(err), zap.String("alert", title))
+		return
+	}
+	if len(recipients) == 0 {
+		s.logger.Warn("no active admins to alert", zap.String("alert", title))
+		return
+	}
+
+	_, err = s.emailSender.Send(ctx, dtos.SendEmailRequest{
+		From:    s.fromEmail,
+		To:      recipients,
+		Subject: title + " - DCIT Help Desk",
+		Template: &types.EmailTemplate{
+			ID: templates.TemplateID_AdminAlert,
+			Variables: map[string]any{
+				"ALERT_TITLE":   title,
+				"ALERT_MESSAGE": message,
+				"ALERT_TIME":    s.nowFn().Format(time.RFC1123),
+			},
+		},
+	})
+	if err != nil {
+		s.logger.Warn("failed to send admin alert", zap.Error(err), zap.String("alert", title))
+	}
+}
+
+func (s *ClockInLockoutService) requireAdmin(ctx context.Context) (uuid.UUID, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return uuid.Nil, timelogErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return uuid.Nil, timelogErrors.ErrNotAuthorized
+	}
+
+	userID, err := uuid.Parse(authCtx.UserID)
+	if err != nil {
+		return uuid.Nil, timelogErrors.ErrMissingAuthContext
+	}
+	return userID, nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+)
+
+// ClockInHistory is what the detector needs to know beyond the new log.
+// Loading it needs the internal role because students cannot see each other's
+// logs.
+type ClockInHistory struct {
+	// RecentLogs are the student's previous clock-ins, newest first.
+	RecentLogs []*aggregate.TimeLog
+	// SharedDeviceStudents are other students who clocked in from the same
+	// device fingerprint within the detector's window.
+	SharedDeviceStudents []int32
+}
+
+// FraudFinding is one heuristic that fired for a clock-in.
+type FraudFinding struct {
+	Code   aggregate.FlagCode
+	Reason string
+}
+
+// FraudDetector flags clock-ins that look like one phone clocking in several
+// students or a spoofed location.
+type FraudDetector struct {
+	// SharedDeviceWindow is how far back another student's use of the same
+	// device counts.
+	SharedDeviceWindow time.Duration
+	// HistorySize is how many of the student's previous clock-ins are checked.
+	HistorySize int
+	// RepeatedCoordinateThreshold is how many previous clock-ins at exactly
+	// the same coordinates trigger a flag. Real GPS fixes jitter between
+	// readings; spoofing tools replay the same point. Phones at a fixed desk
+	// can return a cached or Wi-Fi fix more than once, so a couple of repeats
+	// are expected.
+	RepeatedCoordinateThreshold int
+	// MaxTravelSpeedKmh is the fastest plausible travel between consecutive
+	// clock-ins, ignored for moves under MinTravelDistanceMeters.
+	MaxTravelSpeedKmh       float64
+	MinTravelDistanceMeters float64
+}
+
+func DefaultFraudDetector() FraudDetector {
+	return FraudDetector{
+		SharedDeviceWindow:          30 * 24 * time.Hour,
+		HistorySize:                 10,
+		RepeatedCoordinateThreshold: 5,
+		MaxTravelSpeedKmh:           200,
+		MinTravelDistanceMeters:     1000,
+	}
+}
+
+// Detect returns every heuristic the clock-in trips, in a stable order.
+func (d FraudDetector) Detect(tl *aggregate.TimeLog, history ClockInHistory) []FraudFinding {
+	var findings []FraudFinding
+
+	if len(history.SharedDeviceStudents) > 0 {
+		ids := make([]string, len(history.SharedDeviceStudents))
+		for i, id := range history.SharedDeviceStudents {
+			ids[i] = strconv.Itoa(int(id))
+		}
+		findings = append(findings, FraudFinding{
+			Code:   aggregate.FlagCode_SharedDevice,
+			Reason: fmt.Sprintf("Device also used to clock in student(s) %s", strings.Join(ids, ", ")),
+		})
+	}
+
+	if f, ok := invalidCoordinates(tl); ok {
+		findings = append(findings, f)
+	} else if f, ok := d.impossibleTravel(tl, history.RecentLogs); ok {
+		findings = append(findings, f)
+	}
+
+	if tl.GPSAccuracyMeters != nil && *tl.GPSAccuracyMeters <= 0 {
+		findings = append(findings, FraudFinding{
+			Code:   aggregate.FlagCode_ZeroAccuracy,
+			Reason: fmt.Sprintf("Reported GPS accuracy of %gm", *tl.GPSAccuracyMeters),
+		})
+	}
+
+	repeats := 0
+	for _, prev := range history.RecentLogs {
+		if prev.Latitude == tl.Latitude && prev.Longitude == tl.Longitude {
+			repeats++
+		}
+	}
+	if d.RepeatedCoordinateThreshold > 0 && repeats >= d.RepeatedCoordinateThreshold {
+		findings = append(findings, FraudFinding{
+			Code:   aggregate.FlagCode_RepeatedCoordinates,
+			Reason: fmt.Sprintf("Identical coordinates to %d previous clock-ins", repeats),
+		})
+	}
+
+	return findings
+}
+
+// invalidCoordinates flags coordinates off the globe and exactly 0,0 ("null
+// island"), which devices and spoofing tools report when they have no fix.
+func invalidCoordinates(tl *aggregate.TimeLog) (FraudFinding, bool) {
+	if validCoordinates(tl.Latitude, tl.Longitude) {
+		return FraudFinding{}, false
+	}
+	return FraudFinding{
+		Code:   aggregate.FlagCode_ImpossibleCoordinates,
+		Reason: fmt.Sprintf("Reported coordinates %g, %g are not a real location", tl.Latitude, tl.Longitude),
+	}, true
+}
+
+func validCoordinates(lat, lng float64) bool {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return false
+	}
+	return lat != 0 || lng != 0
+}
+
+// impossibleTravel compares the clock-in with the student's previous one. A
+// previous clock-in without real coordinates is no baseline, so it is skipped.
+func (d FraudDetector) impossibleTravel(tl *aggregate.TimeLog, recent []*aggregate.TimeLog) (FraudFinding, bool) {
+	if len(recent) == 0 || d.MaxTravelSpeedKmh <= 0 {
+		return FraudFinding{}, false
+	}
+	prev := recent[0]
+	if !validCoordinates(prev.Latitude, prev.Longitude) {
+		return FraudFinding{}, false
+	}
+	meters := haversineDistance(prev.Latitude, prev.Longitude, tl.Latitude, tl.Longitude)
+	if meters < d.MinTravelDistanceMeters {
+		return FraudFinding{}, false
+	}
+
+	elapsed := tl.EntryAt.Sub(prev.EntryAt)
+	speedKmh := meters / 1000 / elapsed.Hours()
+	if elapsed > 0 && speedKmh <= d.MaxTravelSpeedKmh {
+		return FraudFinding{}, false
+	}
+	return FraudFinding{
+		Code:   aggregate.FlagCode_ImpossibleCoordinates,
+		Reason: fmt.Sprintf("Moved %.1fkm in %s since the previous clock-in", meters/1000, elapsed.Round(time.Minute)),
+	}, true
+}
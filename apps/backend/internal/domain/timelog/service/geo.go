@@ -1,6 +1,10 @@
 package service
 
-import "math"
+import (
+	"math"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+)
 
 // haversineDistance returns the great-circle distance in meters between two
 // points specified as (latitude, longitude) in decimal degrees.
@@ -14,3 +18,18 @@ func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 	return R * c
 }
+
+// pointInPolygon reports whether (lat, lon) lies inside the polygon using the
+// ray casting algorithm. Vertices are [longitude, latitude] pairs; the polygon
+// is closed implicitly. Accurate enough for building-sized geofences.
+func pointInPolygon(lat, lon float64, polygon []scheduleAggregate.GeoPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		xi, yi := polygon[i].Longitude(), polygon[i].Latitude()
+		xj, yj := polygon[j].Longitude(), polygon[j].Latitude()
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"testing"
 
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -48,3 +49,17 @@ func (s *GeoTestSuite) TestKnownDistance_Equator() {
 	dist := haversineDistance(0, 0, 0, 1)
 	s.InDelta(111320, dist, 200, "1 degree on equator should be ~111 km")
 }
+
+func (s *GeoTestSuite) TestPointInPolygon() {
+	square := []scheduleAggregate.GeoPoint{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	s.True(pointInPolygon(0.5, 0.5, square), "centre should be inside")
+	s.False(pointInPolygon(1.5, 0.5, square), "point north of square should be outside")
+	s.False(pointInPolygon(0.5, -0.5, square), "point west of square should be outside")
+}
+
+func (s *GeoTestSuite) TestPointInPolygon_Concave() {
+	// L-shaped building: the notch at the top right is outside.
+	lShape := []scheduleAggregate.GeoPoint{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+	s.True(pointInPolygon(0.5, 1.5, lShape))
+	s.False(pointInPolygon(1.5, 1.5, lShape))
+}
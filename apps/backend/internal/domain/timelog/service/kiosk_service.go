@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// KioskCode is the code a kiosk should currently display.
+type KioskCode struct {
+	KioskID         uuid.UUID
+	KioskName       string
+	Code            string
+	ExpiresAt       time.Time
+	RotationSeconds int32
+}
+
+// KioskServiceInterface defines the kiosk management and display contract.
+type KioskServiceInterface interface {
+	CreateKiosk(ctx context.Context, name string, rotationSeconds int) (*aggregate.Kiosk, string, error)
+	ListKiosks(ctx context.Context) ([]*aggregate.Kiosk, error)
+	DeactivateKiosk(ctx context.Context, id uuid.UUID) (*aggregate.Kiosk, error)
+	GetCurrentCode(ctx context.Context, token string) (*KioskCode, error)
+}
+
+// KioskService implements KioskServiceInterface.
+type KioskService struct {
+	logger    *zap.Logger
+	txManager database.TxManagerInterface
+	kioskRepo repository.KioskRepositoryInterface
+	nowFn     func() time.Time
+}
+
+func NewKioskService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	kioskRepo repository.KioskRepositoryInterface,
+) *KioskService {
+	return &KioskService{
+		logger:    logger,
+		txManager: txManager,
+		kioskRepo: kioskRepo,
+		nowFn:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+var _ KioskServiceInterface = (*KioskService)(nil)
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *KioskService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+// CreateKiosk registers a kiosk and returns its device token. The token is not
+// stored and cannot be retrieved again.
+func (s *KioskService) CreateKiosk(ctx context.Context, name string, rotationSeconds int) (*aggregate.Kiosk, string, error) {
+	createdBy, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	kiosk, token, err := aggregate.NewKiosk(name, rotationSeconds, createdBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var result *aggregate.Kiosk
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.kioskRepo.Create(ctx, tx, kiosk)
+		return err
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+	return result, token, nil
+}
+
+func (s *KioskService) ListKiosks(ctx context.Context) ([]*aggregate.Kiosk, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Kiosk
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.kioskRepo.List(ctx, tx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *KioskService) DeactivateKiosk(ctx context.Context, id uuid.UUID) (*aggregate.Kiosk, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Kiosk
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		kiosk, err := s.kioskRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := kiosk.Deactivate(); err != nil {
+			return err
+		}
+
+		result, err = s.kioskRepo.Update(ctx, tx, kiosk)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCurrentCode authenticates a kiosk by its device token and returns the
+// code for the current rotation window.
+func (s *KioskService) GetCurrentCode(ctx context.Context, token string) (*KioskCode, error) {
+	if token == "" {
+		return nil, timelogErrors.ErrInvalidKioskToken
+	}
+
+	var result *KioskCode
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		kiosk, err := s.kioskRepo.GetByTokenHash(ctx, tx, aggregate.HashKioskToken(token))
+		if err != nil {
+			if errors.Is(err, timelogErrors.ErrKioskNotFound) {
+				return timelogErrors.ErrInvalidKioskToken
+			}
+			return err
+		}
+		if !kiosk.IsActive {
+			return timelogErrors.ErrInvalidKioskToken
+		}
+
+		code, expiresAt, err := kiosk.CodeAt(s.nowFn())
+		if err != nil {
+			return err
+		}
+
+		if err := s.kioskRepo.TouchLastSeen(ctx, tx, kiosk.ID); err != nil {
+			s.logger.Warn("failed to record kiosk heartbeat", zap.Error(err))
+		}
+
+		result = &KioskCode{
+			KioskID:         kiosk.ID,
+			KioskName:       kiosk.Name,
+			Code:            code,
+			ExpiresAt:       expiresAt,
+			RotationSeconds: kiosk.RotationSeconds,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *KioskService) requireAdmin(ctx context.Context) (uuid.UUID, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return uuid.Nil, timelogErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return uuid.Nil, timelogErrors.ErrNotAuthorized
+	}
+
+	userID, err := uuid.Parse(authCtx.UserID)
+	if err != nil {
+		return uuid.Nil, timelogErrors.ErrMissingAuthContext
+	}
+	return userID, nil
+}
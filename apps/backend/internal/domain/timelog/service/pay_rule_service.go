@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PayRuleServiceInterface defines the pay rule management contract.
+type PayRuleServiceInterface interface {
+	CreatePayRule(ctx context.Context, params aggregate.PayRuleParams) (*aggregate.PayRule, error)
+	ListPayRules(ctx context.Context) ([]*aggregate.PayRule, error)
+	UpdatePayRule(ctx context.Context, id uuid.UUID, params aggregate.PayRuleParams) (*aggregate.PayRule, error)
+	DeactivatePayRule(ctx context.Context, id uuid.UUID) (*aggregate.PayRule, error)
+}
+
+// PayRuleService implements PayRuleServiceInterface.
+type PayRuleService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	payRuleRepo  repository.PayRuleRepositoryInterface
+	locationRepo scheduleRepo.LocationRepositoryInterface
+}
+
+func NewPayRuleService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	payRuleRepo repository.PayRuleRepositoryInterface,
+	locationRepo scheduleRepo.LocationRepositoryInterface,
+) *PayRuleService {
+	return &PayRuleService{
+		logger:       logger,
+		txManager:    txManager,
+		payRuleRepo:  payRuleRepo,
+		locationRepo: locationRepo,
+	}
+}
+
+var _ PayRuleServiceInterface = (*PayRuleService)(nil)
+
+func (s *PayRuleService) CreatePayRule(ctx context.Context, params aggregate.PayRuleParams) (*aggregate.PayRule, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	rule, err := aggregate.NewPayRule(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.PayRule
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if err := s.ensureLocation(ctx, tx, rule.LocationID); err != nil {
+			return err
+		}
+
+		var err error
+		result, err = s.payRuleRepo.Create(ctx, tx, rule)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *PayRuleService) ListPayRules(ctx context.Context) ([]*aggregate.PayRule, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.PayRule
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.payRuleRepo.List(ctx, tx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdatePayRule replaces a rule's configuration. Logs already clocked out keep
+// the paid minutes calculated when they closed.
+func (s *PayRuleService) UpdatePayRule(ctx context.Context, id uuid.UUID, params aggregate.PayRuleParams) (*aggregate.PayRule, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.PayRule
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		rule, err := s.payRuleRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := rule.Update(params); err != nil {
+			return err
+		}
+		if err := s.ensureLocation(ctx, tx, rule.LocationID); err != nil {
+			return err
+		}
+
+		result, err = s.payRuleRepo.Update(ctx, tx, rule)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *PayRuleService) DeactivatePayRule(ctx context.Context, id uuid.UUID) (*aggregate.PayRule, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.PayRule
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		rule, err := s.payRuleRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := rule.Deactivate(); err != nil {
+			return err
+		}
+
+		result, err = s.payRuleRepo.Update(ctx, tx, rule)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ensureLocation checks that a referenced location exists. A nil ID is valid
+// and means the rule applies to every location.
+func (s *PayRuleService) ensureLocation(ctx context.Context, tx *sql.Tx, locationID *uuid.UUID) error {
+	if locationID == nil {
+		return nil
+	}
+	_, err := s.locationRepo.GetByID(ctx, tx, *locationID)
+	return err
+}
+
+func (s *PayRuleService) requireAdmin(ctx context.Context) error {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return timelogErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return timelogErrors.ErrNotAuthorized
+	}
+	return nil
+}
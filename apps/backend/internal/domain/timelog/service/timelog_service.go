@@ -3,17 +3,20 @@ package service
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
 	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
 	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
 	"github.com/google/uuid"
@@ -25,6 +28,11 @@ type ClockInInput struct {
 	Code      string
 	Longitude float64
 	Latitude  float64
+	// Reported by the client and recorded for fraud checks; all optional.
+	DeviceFingerprint string
+	IPAddress         string
+	UserAgent         string
+	GPSAccuracyMeters *float64
 }
 
 // ClockInStatus is the response for the "my status" endpoint.
@@ -32,20 +40,28 @@ type ClockInStatus struct {
 	IsClockedIn  bool
 	CurrentLog   *aggregate.TimeLog
 	CurrentShift *ShiftInfo
+	CurrentBreak *aggregate.TimeLogBreak
 }
 
 // ShiftInfo describes a matched shift assignment.
 type ShiftInfo struct {
-	ShiftID   string
-	Name      string
-	StartTime string
-	EndTime   string
+	ShiftID        string
+	Name           string
+	StartTime      string
+	EndTime        string
+	ScheduledStart time.Time // StartTime on the matched day, in UTC
+	ScheduledEnd   time.Time
+	LocationID     *uuid.UUID
+	LocationName   string
+	PayRuleID      *uuid.UUID // nil when the default pay rule applies
 }
 
 // TimeLogServiceInterface defines the service contract.
 type TimeLogServiceInterface interface {
 	ClockIn(ctx context.Context, input ClockInInput) (*aggregate.TimeLog, error)
 	ClockOut(ctx context.Context) (*aggregate.TimeLog, error)
+	StartBreak(ctx context.Context) (*aggregate.TimeLogBreak, error)
+	EndBreak(ctx context.Context) (*aggregate.TimeLogBreak, error)
 	GetMyStatus(ctx context.Context) (*ClockInStatus, error)
 	ListMyTimeLogs(ctx context.Context, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, int, error)
 	GenerateClockInCode(ctx context.Context, expiresInMinutes int) (*aggregate.ClockInCode, error)
@@ -54,6 +70,7 @@ type TimeLogServiceInterface interface {
 	GetTimeLog(ctx context.Context, id uuid.UUID) (*aggregate.AdminTimeLog, error)
 	FlagTimeLog(ctx context.Context, id uuid.UUID, reason string) (*aggregate.TimeLog, error)
 	UnflagTimeLog(ctx context.Context, id uuid.UUID) (*aggregate.TimeLog, error)
+	ListTimeLogBreaks(ctx context.Context, id uuid.UUID) ([]*aggregate.TimeLogBreak, error)
 }
 
 // TimeLogService implements TimeLogServiceInterface.
@@ -62,10 +79,16 @@ type TimeLogService struct {
 	txManager       database.TxManagerInterface
 	timeLogRepo     repository.TimeLogRepositoryInterface
 	clockInCodeRepo repository.ClockInCodeRepositoryInterface
+	kioskRepo       repository.KioskRepositoryInterface
 	scheduleRepo    scheduleRepo.ScheduleRepositoryInterface
-	helpDeskLon     float64
-	helpDeskLat     float64
-	localTZ         *time.Location
+	locationRepo    scheduleRepo.LocationRepositoryInterface
+	lockoutSvc      ClockInLockoutServiceInterface
+	breakRepo       repository.TimeLogBreakRepositoryInterface
+	payRuleRepo     repository.PayRuleRepositoryInterface
+	timeOffRepo     timeoffRepo.TimeOffRequestRepositoryInterface
+	breakPolicy     aggregate.BreakPolicy
+	fraudDetector   FraudDetector
+	defaultLocation *scheduleAggregate.Location
 	nowFn           func() time.Time
 }
 
@@ -74,14 +97,26 @@ func NewTimeLogService(
 	txManager database.TxManagerInterface,
 	timeLogRepo repository.TimeLogRepositoryInterface,
 	clockInCodeRepo repository.ClockInCodeRepositoryInterface,
+	kioskRepo repository.KioskRepositoryInterface,
 	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+	locationRepo scheduleRepo.LocationRepositoryInterface,
+	lockoutSvc ClockInLockoutServiceInterface,
+	breakRepo repository.TimeLogBreakRepositoryInterface,
+	payRuleRepo repository.PayRuleRepositoryInterface,
+	timeOffRepo timeoffRepo.TimeOffRequestRepositoryInterface,
+	breakPolicy aggregate.BreakPolicy,
+	fraudDetector FraudDetector,
 	helpDeskLon, helpDeskLat float64,
 ) TimeLogServiceInterface {
-	// Schedule times are stored in local time (Trinidad, AST = UTC-4)
-	tz, err := time.LoadLocation("America/Port_of_Spain")
-	if err != nil {
-		// Fallback to fixed UTC-4 offset if tzdata not available
-		tz = time.FixedZone("AST", -4*60*60)
+	// Shifts whose template has no location are checked against the
+	// configured help desk with the default radius and timezone.
+	defaultLocation := &scheduleAggregate.Location{
+		Name:         "help desk",
+		Latitude:     helpDeskLat,
+		Longitude:    helpDeskLon,
+		RadiusMeters: scheduleAggregate.DefaultGeofenceRadiusMeters,
+		Timezone:     scheduleAggregate.DefaultLocationTimezone,
+		IsActive:     true,
 	}
 
 	return &TimeLogService{
@@ -89,10 +124,16 @@ func NewTimeLogService(
 		txManager:       txManager,
 		timeLogRepo:     timeLogRepo,
 		clockInCodeRepo: clockInCodeRepo,
+		kioskRepo:       kioskRepo,
 		scheduleRepo:    scheduleRepo,
-		helpDeskLon:     helpDeskLon,
-		helpDeskLat:     helpDeskLat,
-		localTZ:         tz,
+		locationRepo:    locationRepo,
+		lockoutSvc:      lockoutSvc,
+		breakRepo:       breakRepo,
+		payRuleRepo:     payRuleRepo,
+		timeOffRepo:     timeOffRepo,
+		breakPolicy:     breakPolicy,
+		fraudDetector:   fraudDetector,
+		defaultLocation: defaultLocation,
 		nowFn:           func() time.Time { return time.Now().UTC() },
 	}
 }
@@ -113,18 +154,37 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 		return nil, timelogErrors.ErrMissingAuthContext
 	}
 
+	// Refuse outright while the student is locked out for repeated bad codes
+	lockout, err := s.lockoutSvc.GetActiveLockout(ctx, int32(studentID))
+	if err != nil {
+		return nil, err
+	}
+	if lockout != nil {
+		return nil, timelogErrors.ErrClockInLocked
+	}
+
+	history, err := s.clockInHistory(ctx, int32(studentID), input.DeviceFingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kiosk secrets are only readable by the internal role. They are checked
+	// before the auth transaction opens so a clock-in never holds two pool
+	// connections at once.
+	kioskMatched, err := s.matchesKioskCode(ctx, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
 	var result *aggregate.TimeLog
 
 	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
-		// a. Validate the clock-in code
+		// a. Validate the clock-in code (manual code first, then kiosk codes)
 		code, err := s.clockInCodeRepo.GetByCode(ctx, tx, input.Code)
-		if err != nil {
-			if errors.Is(err, timelogErrors.ErrInvalidClockInCode) {
-				return timelogErrors.ErrInvalidClockInCode
-			}
+		if err != nil && !errors.Is(err, timelogErrors.ErrInvalidClockInCode) {
 			return err
 		}
-		if code == nil || code.IsExpired() {
+		if (code == nil || code.IsExpired()) && !kioskMatched {
 			return timelogErrors.ErrInvalidClockInCode
 		}
 
@@ -138,7 +198,8 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 			return timelogErrors.ErrAlreadyClockedIn
 		}
 
-		// c. Check student has an active shift now (5 min early buffer)
+		// c. Check student has an active shift now, allowing the early
+		// clock-in window of the pay rule for that shift
 		activeSchedule, err := s.scheduleRepo.GetActive(ctx, tx)
 		if err != nil {
 			if errors.Is(err, scheduleErrors.ErrNotFound) {
@@ -150,7 +211,12 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 			return timelogErrors.ErrNoActiveShift
 		}
 
-		_, hasShift, shiftErr := s.hasActiveShift(activeSchedule.Assignments, int32(studentID), s.nowFn(), 5)
+		rules, err := s.payRuleRepo.ListActive(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		shiftInfo, location, hasShift, shiftErr := s.hasActiveShift(ctx, tx, activeSchedule, int32(studentID), s.nowFn(), rules)
 		if shiftErr != nil {
 			return shiftErr
 		}
@@ -158,8 +224,27 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 			return timelogErrors.ErrNoActiveShift
 		}
 
-		// d. Calculate distance
-		distanceMeters := haversineDistance(input.Latitude, input.Longitude, s.helpDeskLat, s.helpDeskLon)
+		// Time off approved after the schedule was generated still leaves
+		// the shift in it; the student is not expected to work it
+		student := int32(studentID)
+		shiftDate := shiftInfo.ScheduledStart.In(location.TimeLocation())
+		timeOff, err := s.timeOffRepo.List(ctx, tx, timeoffRepo.TimeOffFilter{
+			StudentID: &student,
+			Statuses:  []timeoffAggregate.Status{timeoffAggregate.Status_Approved},
+			From:      &shiftDate,
+			To:        &shiftDate,
+		})
+		if err != nil {
+			return err
+		}
+		for _, r := range timeOff {
+			if r.CoversDate(shiftDate) {
+				return timelogErrors.ErrOnApprovedTimeOff
+			}
+		}
+
+		// d. Calculate distance to the matched shift's location
+		distanceMeters := haversineDistance(input.Latitude, input.Longitude, location.Latitude, location.Longitude)
 
 		// e. Create time log (use service clock for consistent entry time)
 		tl, err := aggregate.NewTimeLog(int32(studentID), input.Longitude, input.Latitude, distanceMeters)
@@ -167,10 +252,19 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 			return err
 		}
 		tl.EntryAt = s.nowFn()
-
-		// f. Auto-flag if too far from help desk (>100m)
-		if distanceMeters > 100 {
-			_ = tl.Flag(fmt.Sprintf("Clocked in %.0fm from help desk", distanceMeters))
+		tl.LocationID = shiftInfo.LocationID
+		tl.ScheduledStart = &shiftInfo.ScheduledStart
+		tl.ScheduledEnd = &shiftInfo.ScheduledEnd
+		tl.PayRuleID = shiftInfo.PayRuleID
+		tl.RecordDevice(input.DeviceFingerprint, input.IPAddress, input.UserAgent, input.GPSAccuracyMeters)
+
+		// f. Auto-flag if outside the location's geofence or the clock-in
+		// looks like a shared device or spoofed location
+		if reason, outside := geofenceViolation(location, input.Latitude, input.Longitude, distanceMeters); outside {
+			_ = tl.AddFlag(aggregate.FlagCode_OutsideGeofence, reason)
+		}
+		for _, f := range s.fraudDetector.Detect(tl, history) {
+			_ = tl.AddFlag(f.Code, f.Reason)
 		}
 
 		created, err := s.timeLogRepo.Create(ctx, tx, tl)
@@ -181,9 +275,24 @@ func (s *TimeLogService) ClockIn(ctx context.Context, input ClockInInput) (*aggr
 		return nil
 	})
 
+	if errors.Is(err, timelogErrors.ErrInvalidClockInCode) {
+		// Recorded outside the rolled-back transaction so failures accumulate
+		lockout, recordErr := s.lockoutSvc.RecordAttempt(ctx, int32(studentID), input.Code, false)
+		if recordErr != nil {
+			s.logger.Error("failed to record clock-in attempt", zap.Error(recordErr), zap.Int64("student_id", studentID))
+		}
+		if lockout != nil {
+			return nil, timelogErrors.ErrClockInLocked
+		}
+		return nil, err
+	}
 	if err != nil {
 		return nil, err
 	}
+
+	if _, err := s.lockoutSvc.RecordAttempt(ctx, int32(studentID), input.Code, true); err != nil {
+		s.logger.Warn("failed to record clock-in attempt", zap.Error(err), zap.Int64("student_id", studentID))
+	}
 	return result, nil
 }
 
@@ -213,12 +322,46 @@ func (s *TimeLogService) ClockOut(ctx context.Context) (*aggregate.TimeLog, erro
 			return timelogErrors.ErrNotClockedIn
 		}
 
-		// b. Clock out
-		if err := openLog.ClockOut(s.nowFn()); err != nil {
+		// b. End any break still in progress, then clock out
+		now := s.nowFn()
+		openBreak, err := s.breakRepo.GetOpenByTimeLogID(ctx, tx, openLog.ID)
+		if err != nil && !errors.Is(err, timelogErrors.ErrBreakNotFound) {
 			return err
 		}
+		if openBreak != nil {
+			if err := openBreak.End(now); err != nil {
+				return err
+			}
+			if _, err := s.breakRepo.Update(ctx, tx, openBreak); err != nil {
+				return err
+			}
+		}
+
+		if err := openLog.ClockOut(now); err != nil {
+			return err
+		}
+
+		breaks, err := s.breakRepo.ListByTimeLogID(ctx, tx, openLog.ID)
+		if err != nil {
+			return err
+		}
+
+		// c. Convert the worked time into paid minutes under the pay rule
+		// chosen at clock-in
+		rule, err := s.payRuleFor(ctx, tx, openLog)
+		if err != nil {
+			return err
+		}
+		openLog.ApplyPayRule(rule, breaks)
+
+		// d. Flag the log if the required break was not taken
+		if s.breakPolicy.Enabled() {
+			if reason, violated := s.breakPolicy.Violation(openLog.EntryAt, *openLog.ExitAt, breaks); violated {
+				_ = openLog.AddFlag(aggregate.FlagCode_MissedBreak, reason)
+			}
+		}
 
-		// c. Update
+		// e. Update
 		updated, err := s.timeLogRepo.Update(ctx, tx, openLog)
 		if err != nil {
 			return err
@@ -233,6 +376,116 @@ func (s *TimeLogService) ClockOut(ctx context.Context) (*aggregate.TimeLog, erro
 	return result, nil
 }
 
+// StartBreak opens an unpaid break on the student's open time log.
+func (s *TimeLogService) StartBreak(ctx context.Context) (*aggregate.TimeLogBreak, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok || authCtx.StudentID == nil {
+		return nil, timelogErrors.ErrMissingAuthContext
+	}
+
+	studentID, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		return nil, timelogErrors.ErrMissingAuthContext
+	}
+
+	var result *aggregate.TimeLogBreak
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		openLog, err := s.getOpenLog(ctx, tx, int32(studentID))
+		if err != nil {
+			return err
+		}
+
+		_, err = s.breakRepo.GetOpenByTimeLogID(ctx, tx, openLog.ID)
+		if err == nil {
+			return timelogErrors.ErrBreakInProgress
+		}
+		if !errors.Is(err, timelogErrors.ErrBreakNotFound) {
+			return err
+		}
+
+		result, err = s.breakRepo.Create(ctx, tx, aggregate.NewTimeLogBreak(openLog.ID, s.nowFn()))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EndBreak closes the break in progress on the student's open time log.
+func (s *TimeLogService) EndBreak(ctx context.Context) (*aggregate.TimeLogBreak, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok || authCtx.StudentID == nil {
+		return nil, timelogErrors.ErrMissingAuthContext
+	}
+
+	studentID, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		return nil, timelogErrors.ErrMissingAuthContext
+	}
+
+	var result *aggregate.TimeLogBreak
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		openLog, err := s.getOpenLog(ctx, tx, int32(studentID))
+		if err != nil {
+			return err
+		}
+
+		openBreak, err := s.breakRepo.GetOpenByTimeLogID(ctx, tx, openLog.ID)
+		if err != nil {
+			if errors.Is(err, timelogErrors.ErrBreakNotFound) {
+				return timelogErrors.ErrNotOnBreak
+			}
+			return err
+		}
+
+		if err := openBreak.End(s.nowFn()); err != nil {
+			return err
+		}
+
+		result, err = s.breakRepo.Update(ctx, tx, openBreak)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// payRuleFor returns the pay rule a log was clocked in under, falling back to
+// the default rule when none was recorded or it has since been removed.
+func (s *TimeLogService) payRuleFor(ctx context.Context, tx *sql.Tx, tl *aggregate.TimeLog) (*aggregate.PayRule, error) {
+	if tl.PayRuleID == nil {
+		return aggregate.DefaultPayRule(), nil
+	}
+	rule, err := s.payRuleRepo.GetByID(ctx, tx, *tl.PayRuleID)
+	if err != nil {
+		if errors.Is(err, timelogErrors.ErrPayRuleNotFound) {
+			return aggregate.DefaultPayRule(), nil
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *TimeLogService) getOpenLog(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TimeLog, error) {
+	openLog, err := s.timeLogRepo.GetOpenByStudentID(ctx, tx, studentID)
+	if err != nil {
+		if errors.Is(err, timelogErrors.ErrTimeLogNotFound) {
+			return nil, timelogErrors.ErrNotClockedIn
+		}
+		return nil, err
+	}
+	if openLog == nil {
+		return nil, timelogErrors.ErrNotClockedIn
+	}
+	return openLog, nil
+}
+
 func (s *TimeLogService) GetMyStatus(ctx context.Context) (*ClockInStatus, error) {
 	authCtx, ok := database.GetAuthContextFromContext(ctx)
 	if !ok || authCtx.StudentID == nil {
@@ -258,6 +511,12 @@ func (s *TimeLogService) GetMyStatus(ctx context.Context) (*ClockInStatus, error
 			status.IsClockedIn = true
 			status.CurrentLog = openLog
 
+			openBreak, err := s.breakRepo.GetOpenByTimeLogID(ctx, tx, openLog.ID)
+			if err != nil && !errors.Is(err, timelogErrors.ErrBreakNotFound) {
+				return err
+			}
+			status.CurrentBreak = openBreak
+
 			// Look up the shift the student clocked into (use entry time, not now)
 			activeSchedule, err := s.scheduleRepo.GetActive(ctx, tx)
 			if err != nil {
@@ -265,7 +524,11 @@ func (s *TimeLogService) GetMyStatus(ctx context.Context) (*ClockInStatus, error
 					return err
 				}
 			} else if activeSchedule != nil {
-				shiftInfo, ok, shiftErr := s.hasActiveShift(activeSchedule.Assignments, int32(studentID), openLog.EntryAt, 5)
+				rules, err := s.payRuleRepo.ListActive(ctx, tx)
+				if err != nil {
+					return err
+				}
+				shiftInfo, _, ok, shiftErr := s.hasActiveShift(ctx, tx, activeSchedule, int32(studentID), openLog.EntryAt, rules)
 				if shiftErr != nil {
 					return shiftErr
 				}
@@ -488,72 +751,191 @@ func (s *TimeLogService) UnflagTimeLog(ctx context.Context, id uuid.UUID) (*aggr
 	return result, nil
 }
 
-// assignmentEntry represents a single entry in the schedule assignments JSON.
-type assignmentEntry struct {
-	AssistantID string `json:"assistant_id"`
-	ShiftID     string `json:"shift_id"`
-	DayOfWeek   int    `json:"day_of_week"`
-	Start       string `json:"start"`
-	End         string `json:"end"`
+func (s *TimeLogService) ListTimeLogBreaks(ctx context.Context, id uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, timelogErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return nil, timelogErrors.ErrNotAuthorized
+	}
+
+	var result []*aggregate.TimeLogBreak
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		if _, err := s.timeLogRepo.GetByID(ctx, tx, id); err != nil {
+			return err
+		}
+
+		var err error
+		result, err = s.breakRepo.ListByTimeLogID(ctx, tx, id)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// matchesKioskCode reports whether code is the current or previous rotating
+// code of any active kiosk. Kiosk secrets are not readable by students, so the
+// lookup runs in its own system transaction.
+func (s *TimeLogService) matchesKioskCode(ctx context.Context, code string) (bool, error) {
+	matched := false
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		kiosks, err := s.kioskRepo.ListActive(ctx, tx)
+		if err != nil {
+			return err
+		}
+		now := s.nowFn()
+		for _, k := range kiosks {
+			if k.Matches(code, now) {
+				matched = true
+				return nil
+			}
+		}
+		return nil
+	})
+	return matched, err
 }
 
 // hasActiveShift checks if the given student has a shift assignment right now
-// (within earlyMinutes before the shift start up to the shift end).
-// Times are compared as minutes since midnight after converting to local time.
+// (from the early clock-in window of the shift's pay rule up to the shift end)
+// and returns the location the shift is staffed at.
+// Times are compared as minutes since midnight in the timezone of each shift's
+// location, since schedule times are stored as local wall-clock times. The
+// schedule's overrides decide which assignments apply on the local date.
 // NOTE: Shifts that span midnight (end < start) are not supported.
-func (s *TimeLogService) hasActiveShift(assignments json.RawMessage, studentID int32, now time.Time, earlyMinutes int) (*ShiftInfo, bool, error) {
-	var entries []assignmentEntry
-	if err := json.Unmarshal(assignments, &entries); err != nil {
+func (s *TimeLogService) hasActiveShift(ctx context.Context, tx *sql.Tx, schedule *scheduleAggregate.Schedule, studentID int32, now time.Time, rules []*aggregate.PayRule) (*ShiftInfo, *scheduleAggregate.Location, bool, error) {
+	dated, err := schedule.DatedAssignments()
+	if err != nil {
 		s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err))
-		return nil, false, fmt.Errorf("malformed schedule assignments: %w", err)
+		return nil, nil, false, fmt.Errorf("malformed schedule assignments: %w", err)
 	}
 
-	// Convert to local time — schedule times are stored in local time
-	local := now.In(s.localTZ)
-
-	// Map Go weekday (Sunday=0) to schedule weekday (Monday=0)
-	scheduleDay := (int(local.Weekday()) + 6) % 7
-	currentMinutes := local.Hour()*60 + local.Minute()
+	// A location's local date is at most a day either side of the UTC date
+	mine := dated.Expand(time.Time{}, nil, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), func(id int32, _ time.Time) bool {
+		return id != studentID
+	})
+	if len(mine) == 0 {
+		return nil, nil, false, nil
+	}
 
-	studentIDStr := strconv.Itoa(int(studentID))
+	var shiftIDs []uuid.UUID
+	for _, entry := range mine {
+		if id, err := uuid.Parse(entry.ShiftID); err == nil {
+			shiftIDs = append(shiftIDs, id)
+		}
+	}
+	locations, err := s.locationRepo.ListByShiftTemplateIDs(ctx, tx, shiftIDs)
+	if err != nil {
+		return nil, nil, false, err
+	}
 
-	for _, entry := range entries {
-		if entry.AssistantID == studentIDStr && entry.DayOfWeek == scheduleDay {
-			startMin := parseTimeToMinutes(entry.Start)
-			endMin := parseTimeToMinutes(entry.End)
-			if startMin < 0 || endMin < 0 {
-				continue
+	for _, entry := range mine {
+		location := s.defaultLocation
+		var locationID *uuid.UUID
+		if id, err := uuid.Parse(entry.ShiftID); err == nil {
+			if loc, ok := locations[id]; ok {
+				location = loc
+				locationID = &loc.ID
 			}
+		}
 
-			earlyStartMin := startMin - earlyMinutes
-			if earlyStartMin < 0 {
-				earlyStartMin = 0
-			}
+		// Convert to the location's local time
+		local := now.In(location.TimeLocation())
+
+		scheduleDay := scheduleAggregate.Weekday(local)
+		currentMinutes := local.Hour()*60 + local.Minute()
+
+		if y, m, d := local.Date(); y != entry.Date.Year() || m != entry.Date.Month() || d != entry.Date.Day() {
+			continue
+		}
 
-			if currentMinutes >= earlyStartMin && currentMinutes < endMin {
-				return &ShiftInfo{
-					ShiftID:   entry.ShiftID,
-					Name:      formatShiftName(scheduleDay, entry.Start, entry.End),
-					StartTime: entry.Start,
-					EndTime:   entry.End,
-				}, true, nil
+		start, end, ok := entry.Clock()
+		if !ok {
+			continue
+		}
+		startMin, endMin := int(start.Minutes()), int(end.Minutes())
+
+		rule := aggregate.SelectPayRule(rules, locationID, local)
+		var ruleID *uuid.UUID
+		if rule != nil {
+			ruleID = &rule.ID
+		} else {
+			rule = aggregate.DefaultPayRule()
+		}
+
+		earlyStartMin := startMin - int(rule.EarlyClockInMinutes)
+		if earlyStartMin < 0 {
+			earlyStartMin = 0
+		}
+
+		if currentMinutes >= earlyStartMin && currentMinutes < endMin {
+			info := &ShiftInfo{
+				ShiftID:        entry.ShiftID,
+				Name:           formatShiftName(scheduleDay, entry.Start, entry.End),
+				StartTime:      entry.Start,
+				EndTime:        entry.End,
+				ScheduledStart: atMinutes(local, startMin),
+				ScheduledEnd:   atMinutes(local, endMin),
+				LocationID:     locationID,
+				PayRuleID:      ruleID,
+			}
+			if locationID != nil {
+				info.LocationName = location.Name
 			}
+			return info, location, true, nil
 		}
 	}
-	return nil, false, nil
+	return nil, nil, false, nil
 }
 
-// parseTimeToMinutes converts "HH:MM:SS" or "HH:MM" to minutes since midnight.
-// Returns -1 if the format is invalid.
-func parseTimeToMinutes(timeStr string) int {
-	t, err := time.Parse("15:04:05", timeStr)
-	if err != nil {
-		t, err = time.Parse("15:04", timeStr)
-		if err != nil {
-			return -1
+// clockInHistory loads the student's recent clock-ins and the other students
+// seen on the same device. It runs as the internal role because students
+// cannot see each other's time logs.
+func (s *TimeLogService) clockInHistory(ctx context.Context, studentID int32, deviceFingerprint string) (ClockInHistory, error) {
+	var history ClockInHistory
+	deviceFingerprint = strings.TrimSpace(deviceFingerprint)
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if s.fraudDetector.HistorySize > 0 {
+			history.RecentLogs, err = s.timeLogRepo.ListRecentByStudentID(ctx, tx, studentID, s.fraudDetector.HistorySize)
+			if err != nil {
+				return err
+			}
+		}
+		if deviceFingerprint != "" {
+			since := s.nowFn().Add(-s.fraudDetector.SharedDeviceWindow)
+			history.SharedDeviceStudents, err = s.timeLogRepo.ListStudentIDsByDevice(ctx, tx, deviceFingerprint, since, studentID)
+		}
+		return err
+	})
+	return history, err
+}
+
+// geofenceViolation reports whether a clock-in at (lat, lon) falls outside the
+// location's geofence, along with the flag reason to record. Polygon geofences
+// take precedence over the radius.
+func geofenceViolation(location *scheduleAggregate.Location, lat, lon, distanceMeters float64) (string, bool) {
+	if location.HasPolygon() {
+		if pointInPolygon(lat, lon, location.Geofence) {
+			return "", false
 		}
+		return fmt.Sprintf("Clocked in outside the %s geofence (%.0fm from desk)", location.Name, distanceMeters), true
 	}
-	return t.Hour()*60 + t.Minute()
+	if distanceMeters > location.RadiusMeters {
+		return fmt.Sprintf("Clocked in %.0fm from %s", distanceMeters, location.Name), true
+	}
+	return "", false
+}
+
+// atMinutes returns the instant that is the given minutes past midnight on
+// local's calendar day, in local's timezone, as UTC.
+func atMinutes(local time.Time, minutes int) time.Time {
+	return time.Date(local.Year(), local.Month(), local.Day(), minutes/60, minutes%60, 0, 0, local.Location()).UTC()
 }
 
 // subtractMinutes subtracts the given number of minutes from a "HH:MM:SS"
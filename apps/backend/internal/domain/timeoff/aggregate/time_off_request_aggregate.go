@@ -0,0 +1,153 @@
+package aggregate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+// Status represents the review state of a time-off request.
+type Status string
+
+const (
+	Status_Pending   Status = "pending"
+	Status_Approved  Status = "approved"
+	Status_Rejected  Status = "rejected"
+	Status_Cancelled Status = "cancelled"
+)
+
+const maxReasonLength = 500
+
+// TimeOffRequest is a student's request to be excused from shifts on every
+// day between StartDate and EndDate (both inclusive).
+type TimeOffRequest struct {
+	ID         uuid.UUID
+	StudentID  int32
+	StartDate  time.Time
+	EndDate    time.Time
+	Reason     string
+	Status     Status
+	ReviewNote *string
+	ReviewedBy *uuid.UUID
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+// NewTimeOffRequest creates a pending request. Dates are truncated to the day.
+func NewTimeOffRequest(studentID int32, startDate, endDate time.Time, reason string) (*TimeOffRequest, error) {
+	if studentID <= 0 {
+		return nil, errors.ErrInvalidStudentID
+	}
+	startDate = truncateToDate(startDate)
+	endDate = truncateToDate(endDate)
+	if endDate.Before(startDate) {
+		return nil, errors.ErrInvalidPeriod
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.ErrInvalidReason
+	}
+	if len(reason) > maxReasonLength {
+		return nil, errors.ErrReasonTooLong
+	}
+
+	return &TimeOffRequest{
+		ID:        uuid.New(),
+		StudentID: studentID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    reason,
+		Status:    Status_Pending,
+	}, nil
+}
+
+// Approve marks a pending request as approved by the given reviewer.
+func (t *TimeOffRequest) Approve(reviewerID uuid.UUID, note *string) error {
+	return t.review(Status_Approved, reviewerID, note)
+}
+
+// Reject marks a pending request as rejected by the given reviewer.
+func (t *TimeOffRequest) Reject(reviewerID uuid.UUID, note *string) error {
+	return t.review(Status_Rejected, reviewerID, note)
+}
+
+// Cancel withdraws a request. Only pending requests may be cancelled.
+func (t *TimeOffRequest) Cancel() error {
+	if t.Status != Status_Pending {
+		return errors.ErrNotPending
+	}
+	t.Status = Status_Cancelled
+	return nil
+}
+
+func (t *TimeOffRequest) review(status Status, reviewerID uuid.UUID, note *string) error {
+	if t.Status != Status_Pending {
+		return errors.ErrNotPending
+	}
+	now := time.Now().UTC()
+	t.Status = status
+	t.ReviewedBy = &reviewerID
+	t.ReviewedAt = &now
+	if note != nil && strings.TrimSpace(*note) != "" {
+		trimmed := strings.TrimSpace(*note)
+		t.ReviewNote = &trimmed
+	}
+	return nil
+}
+
+// CoversDate reports whether the given calendar day falls within the request.
+func (t *TimeOffRequest) CoversDate(day time.Time) bool {
+	d := truncateToDate(day)
+	return !d.Before(t.StartDate) && !d.After(t.EndDate)
+}
+
+// Overlaps reports whether the request shares at least one day with [from, to].
+func (t *TimeOffRequest) Overlaps(from, to time.Time) bool {
+	return !truncateToDate(to).Before(t.StartDate) && !truncateToDate(from).After(t.EndDate)
+}
+
+// IsActive reports whether the request still blocks the student's time,
+// i.e. it is pending or approved.
+func (t *TimeOffRequest) IsActive() bool {
+	return t.Status == Status_Pending || t.Status == Status_Approved
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func TimeOffRequestFromModel(m model.TimeOffRequests) TimeOffRequest {
+	return TimeOffRequest{
+		ID:         m.ID,
+		StudentID:  m.StudentID,
+		StartDate:  truncateToDate(m.StartDate),
+		EndDate:    truncateToDate(m.EndDate),
+		Reason:     m.Reason,
+		Status:     Status(m.Status),
+		ReviewNote: m.ReviewNote,
+		ReviewedBy: m.ReviewedBy,
+		ReviewedAt: m.ReviewedAt,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func (t *TimeOffRequest) ToModel() model.TimeOffRequests {
+	return model.TimeOffRequests{
+		ID:         t.ID,
+		StudentID:  t.StudentID,
+		StartDate:  t.StartDate,
+		EndDate:    t.EndDate,
+		Reason:     t.Reason,
+		Status:     string(t.Status),
+		ReviewNote: t.ReviewNote,
+		ReviewedBy: t.ReviewedBy,
+		ReviewedAt: t.ReviewedAt,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidStudentID   = errors.New("student ID must be positive")
+	ErrInvalidPeriod      = errors.New("end date must not be before start date")
+	ErrInvalidReason      = errors.New("reason must not be empty")
+	ErrReasonTooLong      = errors.New("reason must be at most 500 characters")
+	ErrTimeOffNotFound    = errors.New("time-off request not found")
+	ErrNotPending         = errors.New("time-off request is not pending")
+	ErrOverlappingRequest = errors.New("an overlapping time-off request already exists")
+	ErrMissingAuthContext = errors.New("missing authentication context")
+	ErrNotAuthorized      = errors.New("not authorized to perform this action")
+)
@@ -0,0 +1,90 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/service"
+)
+
+// --- Requests ---
+
+type CreateTimeOffRequest struct {
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`   // YYYY-MM-DD, inclusive
+	Reason    string `json:"reason"`
+}
+
+type ReviewTimeOffRequest struct {
+	Note *string `json:"note"`
+}
+
+// --- Responses ---
+
+type TimeOffResponse struct {
+	ID         string     `json:"id"`
+	StudentID  int32      `json:"student_id"`
+	StartDate  string     `json:"start_date"`
+	EndDate    string     `json:"end_date"`
+	Reason     string     `json:"reason"`
+	Status     string     `json:"status"`
+	ReviewNote *string    `json:"review_note"`
+	ReviewedBy *string    `json:"reviewed_by"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+}
+
+type TimeOffConflictResponse struct {
+	TimeOffID string `json:"time_off_id"`
+	StudentID int32  `json:"student_id"`
+	Date      string `json:"date"`
+	ShiftID   string `json:"shift_id"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+// --- Converters ---
+
+func TimeOffToResponse(t *aggregate.TimeOffRequest) TimeOffResponse {
+	resp := TimeOffResponse{
+		ID:         t.ID.String(),
+		StudentID:  t.StudentID,
+		StartDate:  t.StartDate.Format(time.DateOnly),
+		EndDate:    t.EndDate.Format(time.DateOnly),
+		Reason:     t.Reason,
+		Status:     string(t.Status),
+		ReviewNote: t.ReviewNote,
+		ReviewedAt: t.ReviewedAt,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+	if t.ReviewedBy != nil {
+		s := t.ReviewedBy.String()
+		resp.ReviewedBy = &s
+	}
+	return resp
+}
+
+func TimeOffListToResponse(requests []*aggregate.TimeOffRequest) []TimeOffResponse {
+	responses := make([]TimeOffResponse, len(requests))
+	for i, t := range requests {
+		responses[i] = TimeOffToResponse(t)
+	}
+	return responses
+}
+
+func ConflictsToResponse(conflicts []service.Conflict) []TimeOffConflictResponse {
+	responses := make([]TimeOffConflictResponse, len(conflicts))
+	for i, c := range conflicts {
+		responses[i] = TimeOffConflictResponse{
+			TimeOffID: c.Request.ID.String(),
+			StudentID: c.Request.StudentID,
+			Date:      c.Date.Format(time.DateOnly),
+			ShiftID:   c.ShiftID,
+			StartTime: c.StartTime,
+			EndTime:   c.EndTime,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TimeOffHandler struct {
+	logger  *zap.Logger
+	service service.TimeOffServiceInterface
+}
+
+func NewTimeOffHandler(logger *zap.Logger, service service.TimeOffServiceInterface) *TimeOffHandler {
+	return &TimeOffHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *TimeOffHandler) RegisterRoutes(r chi.Router) {
+	r.Post("/time-off", h.RequestTimeOff)
+	r.Get("/time-off/me", h.ListMyTimeOff)
+	r.Patch("/time-off/me/{id}/cancel", h.CancelTimeOff)
+}
+
+func (h *TimeOffHandler) RegisterAdminRoutes(r chi.Router) {
+	// Individual routes (not r.Route) to avoid chi mount conflict with the
+	// student routes above.
+	r.Get("/time-off", h.ListTimeOff)
+	r.Get("/time-off/conflicts", h.ListConflicts)
+	r.Patch("/time-off/{id}/approve", h.ApproveTimeOff)
+	r.Patch("/time-off/{id}/reject", h.RejectTimeOff)
+}
+
+func (h *TimeOffHandler) RequestTimeOff(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateTimeOffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	startDate, err := time.Parse(time.DateOnly, req.StartDate)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid start_date format, expected YYYY-MM-DD")
+		return
+	}
+	endDate, err := time.Parse(time.DateOnly, req.EndDate)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid end_date format, expected YYYY-MM-DD")
+		return
+	}
+
+	created, err := h.service.RequestTimeOff(r.Context(), service.RequestTimeOffInput{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dtos.TimeOffToResponse(created))
+}
+
+func (h *TimeOffHandler) ListMyTimeOff(w http.ResponseWriter, r *http.Request) {
+	requests, err := h.service.ListMyTimeOff(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeOffListToResponse(requests))
+}
+
+func (h *TimeOffHandler) CancelTimeOff(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid time-off request ID")
+		return
+	}
+
+	cancelled, err := h.service.CancelTimeOff(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeOffToResponse(cancelled))
+}
+
+func (h *TimeOffHandler) ListTimeOff(w http.ResponseWriter, r *http.Request) {
+	var filter repository.TimeOffFilter
+
+	if v := r.URL.Query().Get("status"); v != "" {
+		switch status := aggregate.Status(v); status {
+		case aggregate.Status_Pending, aggregate.Status_Approved, aggregate.Status_Rejected, aggregate.Status_Cancelled:
+			filter.Statuses = []aggregate.Status{status}
+		default:
+			writeError(w, http.StatusBadRequest, "invalid status filter")
+			return
+		}
+	}
+	if v := r.URL.Query().Get("student_id"); v != "" {
+		sid, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid student_id filter")
+			return
+		}
+		s := int32(sid)
+		filter.StudentID = &s
+	}
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid from date format, expected YYYY-MM-DD")
+			return
+		}
+		filter.From = &t
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid to date format, expected YYYY-MM-DD")
+			return
+		}
+		filter.To = &t
+	}
+
+	requests, err := h.service.ListTimeOff(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeOffListToResponse(requests))
+}
+
+func (h *TimeOffHandler) ListConflicts(w http.ResponseWriter, r *http.Request) {
+	from, err := time.Parse(time.DateOnly, r.URL.Query().Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "from is required in YYYY-MM-DD format")
+		return
+	}
+	to, err := time.Parse(time.DateOnly, r.URL.Query().Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "to is required in YYYY-MM-DD format")
+		return
+	}
+
+	conflicts, err := h.service.ListConflicts(r.Context(), from, to)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.ConflictsToResponse(conflicts))
+}
+
+func (h *TimeOffHandler) ApproveTimeOff(w http.ResponseWriter, r *http.Request) {
+	h.reviewTimeOff(w, r, h.service.ApproveTimeOff)
+}
+
+func (h *TimeOffHandler) RejectTimeOff(w http.ResponseWriter, r *http.Request) {
+	h.reviewTimeOff(w, r, h.service.RejectTimeOff)
+}
+
+func (h *TimeOffHandler) reviewTimeOff(
+	w http.ResponseWriter,
+	r *http.Request,
+	review func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error),
+) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid time-off request ID")
+		return
+	}
+
+	// The body is optional; a missing note is allowed.
+	var req dtos.ReviewTimeOffRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+	if req.Note != nil && len(*req.Note) > 500 {
+		writeError(w, http.StatusBadRequest, "note must be 500 characters or fewer")
+		return
+	}
+
+	reviewed, err := review(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimeOffToResponse(reviewed))
+}
+
+func (h *TimeOffHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, timeoffErrors.ErrInvalidPeriod):
+		writeError(w, http.StatusBadRequest, "end date must not be before start date")
+	case errors.Is(err, timeoffErrors.ErrInvalidReason):
+		writeError(w, http.StatusBadRequest, "reason is required")
+	case errors.Is(err, timeoffErrors.ErrReasonTooLong):
+		writeError(w, http.StatusBadRequest, "reason must be 500 characters or fewer")
+	case errors.Is(err, timeoffErrors.ErrInvalidStudentID):
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+	case errors.Is(err, timeoffErrors.ErrOverlappingRequest):
+		writeError(w, http.StatusConflict, "an overlapping time-off request already exists")
+	case errors.Is(err, timeoffErrors.ErrNotPending):
+		writeError(w, http.StatusConflict, "time-off request is not pending")
+	case errors.Is(err, timeoffErrors.ErrTimeOffNotFound):
+		writeError(w, http.StatusNotFound, "time-off request not found")
+	case errors.Is(err, timeoffErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, timeoffErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("failed to encode JSON response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	"github.com/google/uuid"
+)
+
+// TimeOffFilter narrows a listing of time-off requests. From and To select
+// requests that overlap the inclusive date range.
+type TimeOffFilter struct {
+	StudentID *int32
+	Statuses  []aggregate.Status
+	From      *time.Time
+	To        *time.Time
+}
+
+type TimeOffRequestRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TimeOffRequest, error)
+	Update(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error)
+	List(ctx context.Context, tx *sql.Tx, filter TimeOffFilter) ([]*aggregate.TimeOffRequest, error)
+}
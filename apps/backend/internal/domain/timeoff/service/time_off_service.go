@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RequestTimeOffInput contains the data sent by a student to request time off.
+type RequestTimeOffInput struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+// Conflict is a shift in the active schedule that falls on a day covered by
+// approved time off.
+type Conflict struct {
+	Request   *aggregate.TimeOffRequest
+	Date      time.Time
+	ShiftID   string
+	StartTime string
+	EndTime   string
+}
+
+// TimeOffServiceInterface defines the service contract.
+type TimeOffServiceInterface interface {
+	RequestTimeOff(ctx context.Context, input RequestTimeOffInput) (*aggregate.TimeOffRequest, error)
+	ListMyTimeOff(ctx context.Context) ([]*aggregate.TimeOffRequest, error)
+	CancelTimeOff(ctx context.Context, id uuid.UUID) (*aggregate.TimeOffRequest, error)
+	ListTimeOff(ctx context.Context, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error)
+	ApproveTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error)
+	RejectTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error)
+	ListConflicts(ctx context.Context, from, to time.Time) ([]Conflict, error)
+}
+
+// TimeOffService implements TimeOffServiceInterface.
+type TimeOffService struct {
+	logger       *zap.Logger
+	txManager    database.TxManagerInterface
+	timeOffRepo  repository.TimeOffRequestRepositoryInterface
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface
+}
+
+func NewTimeOffService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	timeOffRepo repository.TimeOffRequestRepositoryInterface,
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+) TimeOffServiceInterface {
+	return &TimeOffService{
+		logger:       logger,
+		txManager:    txManager,
+		timeOffRepo:  timeOffRepo,
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+func (s *TimeOffService) RequestTimeOff(ctx context.Context, input RequestTimeOffInput) (*aggregate.TimeOffRequest, error) {
+	authCtx, studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := aggregate.NewTimeOffRequest(studentID, input.StartDate, input.EndDate, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TimeOffRequest
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		// Reject requests that overlap one the student already has open.
+		existing, err := s.timeOffRepo.List(ctx, tx, repository.TimeOffFilter{
+			StudentID: &studentID,
+			Statuses:  []aggregate.Status{aggregate.Status_Pending, aggregate.Status_Approved},
+			From:      &req.StartDate,
+			To:        &req.EndDate,
+		})
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return timeoffErrors.ErrOverlappingRequest
+		}
+
+		result, err = s.timeOffRepo.Create(ctx, tx, req)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimeOffService) ListMyTimeOff(ctx context.Context) ([]*aggregate.TimeOffRequest, error) {
+	authCtx, studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.TimeOffRequest
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.timeOffRepo.List(ctx, tx, repository.TimeOffFilter{StudentID: &studentID})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimeOffService) CancelTimeOff(ctx context.Context, id uuid.UUID) (*aggregate.TimeOffRequest, error) {
+	_, studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TimeOffRequest
+
+	// Students only have SELECT/INSERT on time_off_requests, so the update runs
+	// as the internal role after checking ownership explicitly.
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		req, err := s.timeOffRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if req.StudentID != studentID {
+			return timeoffErrors.ErrTimeOffNotFound
+		}
+
+		if err := req.Cancel(); err != nil {
+			return err
+		}
+
+		result, err = s.timeOffRepo.Update(ctx, tx, req)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimeOffService) ListTimeOff(ctx context.Context, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.TimeOffRequest
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.timeOffRepo.List(ctx, tx, filter)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimeOffService) ApproveTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error) {
+	return s.review(ctx, id, func(req *aggregate.TimeOffRequest, reviewerID uuid.UUID) error {
+		return req.Approve(reviewerID, note)
+	})
+}
+
+func (s *TimeOffService) RejectTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error) {
+	return s.review(ctx, id, func(req *aggregate.TimeOffRequest, reviewerID uuid.UUID) error {
+		return req.Reject(reviewerID, note)
+	})
+}
+
+func (s *TimeOffService) review(ctx context.Context, id uuid.UUID, apply func(*aggregate.TimeOffRequest, uuid.UUID) error) (*aggregate.TimeOffRequest, error) {
+	reviewerID, err := adminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.TimeOffRequest
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		req, err := s.timeOffRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := apply(req, reviewerID); err != nil {
+			return err
+		}
+
+		result, err = s.timeOffRepo.Update(ctx, tx, req)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListConflicts returns every shift in the active schedule, between from and to
+// (inclusive), that is assigned to a student on approved time off.
+func (s *TimeOffService) ListConflicts(ctx context.Context, from, to time.Time) ([]Conflict, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, timeoffErrors.ErrInvalidPeriod
+	}
+
+	var conflicts []Conflict
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		activeSchedule, err := s.scheduleRepo.GetActive(ctx, tx)
+		if err != nil {
+			if errors.Is(err, scheduleErrors.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		if activeSchedule == nil {
+			return nil
+		}
+
+		// Clamp the window to the schedule's effective period.
+		if activeSchedule.EffectiveFrom.After(from) {
+			from = activeSchedule.EffectiveFrom
+		}
+		if activeSchedule.EffectiveTo != nil && activeSchedule.EffectiveTo.Before(to) {
+			to = *activeSchedule.EffectiveTo
+		}
+		if to.Before(from) {
+			return nil
+		}
+
+		assignments, err := activeSchedule.DatedAssignments()
+		if err != nil {
+			s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err))
+			return fmt.Errorf("malformed schedule assignments: %w", err)
+		}
+
+		approved, err := s.timeOffRepo.List(ctx, tx, repository.TimeOffFilter{
+			Statuses: []aggregate.Status{aggregate.Status_Approved},
+			From:     &from,
+			To:       &to,
+		})
+		if err != nil {
+			return err
+		}
+
+		conflicts = findConflicts(approved, assignments, from, to)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+// findConflicts dates the assignments over each request's days within
+// [from, to] and returns the student's shifts that fall on them.
+func findConflicts(requests []*aggregate.TimeOffRequest, assignments scheduleAggregate.DatedAssignments, from, to time.Time) []Conflict {
+	conflicts := []Conflict{}
+	for _, req := range requests {
+		studentOnly := func(studentID int32, _ time.Time) bool { return studentID != req.StudentID }
+		for _, shift := range assignments.Expand(from, &to, req.StartDate, req.EndDate, studentOnly) {
+			conflicts = append(conflicts, Conflict{
+				Request:   req,
+				Date:      shift.Date,
+				ShiftID:   shift.ShiftID,
+				StartTime: shift.Start,
+				EndTime:   shift.End,
+			})
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		if !conflicts[i].Date.Equal(conflicts[j].Date) {
+			return conflicts[i].Date.Before(conflicts[j].Date)
+		}
+		return conflicts[i].StartTime < conflicts[j].StartTime
+	})
+	return conflicts
+}
+
+func studentFromContext(ctx context.Context) (database.AuthContext, int32, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok || authCtx.StudentID == nil {
+		return database.AuthContext{}, 0, timeoffErrors.ErrMissingAuthContext
+	}
+
+	studentID, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		return database.AuthContext{}, 0, timeoffErrors.ErrMissingAuthContext
+	}
+	return authCtx, int32(studentID), nil
+}
+
+func adminFromContext(ctx context.Context) (uuid.UUID, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return uuid.Nil, timeoffErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return uuid.Nil, timeoffErrors.ErrNotAuthorized
+	}
+
+	userID, err := uuid.Parse(authCtx.UserID)
+	if err != nil {
+		return uuid.Nil, timeoffErrors.ErrMissingAuthContext
+	}
+	return userID, nil
+}
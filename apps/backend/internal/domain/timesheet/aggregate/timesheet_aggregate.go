@@ -0,0 +1,191 @@
+package aggregate
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/google/uuid"
+)
+
+// Status represents the review state of a timesheet.
+type Status string
+
+const (
+	Status_Submitted Status = "submitted"
+	Status_Approved  Status = "approved"
+	Status_Rejected  Status = "rejected"
+)
+
+// WeekSummary holds the totals a timesheet is signed off on.
+type WeekSummary struct {
+	ScheduledHours  float64
+	WorkedHours     float64
+	LogCount        int32
+	FlaggedLogCount int32
+}
+
+// Variance is worked minus scheduled hours; negative means under-worked.
+func (s WeekSummary) Variance() float64 {
+	return roundHours(s.WorkedHours - s.ScheduledHours)
+}
+
+// Timesheet is a student's record of one Monday-to-Sunday week, submitted for
+// admin sign-off. The totals are a snapshot taken at submission.
+type Timesheet struct {
+	ID          uuid.UUID
+	StudentID   int32
+	WeekStart   time.Time
+	Status      Status
+	Summary     WeekSummary
+	SubmittedAt time.Time
+	ReviewNote  *string
+	ReviewedBy  *uuid.UUID
+	ReviewedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// NewTimesheet creates a submitted timesheet. weekStart must be a Monday.
+func NewTimesheet(studentID int32, weekStart time.Time, summary WeekSummary, now time.Time) (*Timesheet, error) {
+	if studentID <= 0 {
+		return nil, errors.ErrInvalidStudentID
+	}
+	weekStart = truncateToDate(weekStart)
+	if weekStart.Weekday() != time.Monday {
+		return nil, errors.ErrInvalidWeekStart
+	}
+
+	return &Timesheet{
+		ID:          uuid.New(),
+		StudentID:   studentID,
+		WeekStart:   weekStart,
+		Status:      Status_Submitted,
+		Summary:     roundSummary(summary),
+		SubmittedAt: now,
+	}, nil
+}
+
+// WeekStartOf returns the Monday of the week containing the date t, as a UTC
+// date.
+func WeekStartOf(t time.Time) time.Time {
+	d := truncateToDate(t)
+	// Map Go weekday (Sunday=0) to days since Monday
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
+// WeekStartAt returns the Monday of the week the instant t falls in at a
+// location in tz. Weeks run Monday to Sunday in local time, so a Sunday
+// evening clock-in belongs to that week even though it is Monday in UTC.
+func WeekStartAt(t time.Time, tz *time.Location) time.Time {
+	return WeekStartOf(t.In(tz))
+}
+
+// WeekBounds returns the instants the week starting weekStart covers at a
+// location in tz: midnight Monday up to midnight the following Monday.
+func WeekBounds(weekStart time.Time, tz *time.Location) (from, to time.Time) {
+	from = time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day(), 0, 0, 0, 0, tz)
+	return from, from.AddDate(0, 0, 7)
+}
+
+// WeekEnd returns the Sunday that closes the timesheet's week.
+func (t *Timesheet) WeekEnd() time.Time {
+	return t.WeekStart.AddDate(0, 0, 6)
+}
+
+// Resubmit replaces the totals of a rejected timesheet and puts it back up
+// for review.
+func (t *Timesheet) Resubmit(summary WeekSummary, now time.Time) error {
+	if t.Status != Status_Rejected {
+		return errors.ErrAlreadySubmitted
+	}
+	t.Status = Status_Submitted
+	t.Summary = roundSummary(summary)
+	t.SubmittedAt = now
+	t.ReviewNote = nil
+	t.ReviewedBy = nil
+	t.ReviewedAt = nil
+	return nil
+}
+
+// Approve signs off a submitted timesheet, making its hours payable.
+func (t *Timesheet) Approve(reviewerID uuid.UUID, note *string, now time.Time) error {
+	return t.review(Status_Approved, reviewerID, note, now)
+}
+
+// Reject returns a submitted timesheet to the student for correction.
+func (t *Timesheet) Reject(reviewerID uuid.UUID, note *string, now time.Time) error {
+	return t.review(Status_Rejected, reviewerID, note, now)
+}
+
+func (t *Timesheet) review(status Status, reviewerID uuid.UUID, note *string, now time.Time) error {
+	if t.Status != Status_Submitted {
+		return errors.ErrNotSubmitted
+	}
+	t.Status = status
+	t.ReviewedBy = &reviewerID
+	t.ReviewedAt = &now
+	t.ReviewNote = nil
+	if note != nil && strings.TrimSpace(*note) != "" {
+		trimmed := strings.TrimSpace(*note)
+		t.ReviewNote = &trimmed
+	}
+	return nil
+}
+
+func roundSummary(s WeekSummary) WeekSummary {
+	s.ScheduledHours = roundHours(s.ScheduledHours)
+	s.WorkedHours = roundHours(s.WorkedHours)
+	return s
+}
+
+// roundHours rounds to 2 decimal places.
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func TimesheetFromModel(m model.Timesheets) Timesheet {
+	return Timesheet{
+		ID:        m.ID,
+		StudentID: m.StudentID,
+		WeekStart: truncateToDate(m.WeekStart),
+		Status:    Status(m.Status),
+		Summary: WeekSummary{
+			ScheduledHours:  m.ScheduledHours,
+			WorkedHours:     m.WorkedHours,
+			LogCount:        m.LogCount,
+			FlaggedLogCount: m.FlaggedLogCount,
+		},
+		SubmittedAt: m.SubmittedAt,
+		ReviewNote:  m.ReviewNote,
+		ReviewedBy:  m.ReviewedBy,
+		ReviewedAt:  m.ReviewedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (t *Timesheet) ToModel() model.Timesheets {
+	return model.Timesheets{
+		ID:              t.ID,
+		StudentID:       t.StudentID,
+		WeekStart:       t.WeekStart,
+		Status:          string(t.Status),
+		ScheduledHours:  t.Summary.ScheduledHours,
+		WorkedHours:     t.Summary.WorkedHours,
+		LogCount:        t.Summary.LogCount,
+		FlaggedLogCount: t.Summary.FlaggedLogCount,
+		SubmittedAt:     t.SubmittedAt,
+		ReviewNote:      t.ReviewNote,
+		ReviewedBy:      t.ReviewedBy,
+		ReviewedAt:      t.ReviewedAt,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
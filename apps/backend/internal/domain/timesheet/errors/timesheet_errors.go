@@ -0,0 +1,15 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidStudentID   = errors.New("student ID must be positive")
+	ErrInvalidWeekStart   = errors.New("week start must be a Monday")
+	ErrWeekNotOver        = errors.New("the week has not ended yet")
+	ErrOpenTimeLog        = errors.New("a time log in the week is still open")
+	ErrTimesheetNotFound  = errors.New("timesheet not found")
+	ErrAlreadySubmitted   = errors.New("timesheet has already been submitted")
+	ErrNotSubmitted       = errors.New("timesheet is not awaiting review")
+	ErrMissingAuthContext = errors.New("missing authentication context")
+	ErrNotAuthorized      = errors.New("not authorized to perform this action")
+)
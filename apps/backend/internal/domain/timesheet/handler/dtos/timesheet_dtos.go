@@ -0,0 +1,162 @@
+package dtos
+
+import (
+	"time"
+
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+)
+
+// --- Requests ---
+
+type SubmitTimesheetRequest struct {
+	WeekStart string `json:"week_start"` // YYYY-MM-DD, any day of the week
+}
+
+type ReviewTimesheetRequest struct {
+	Note *string `json:"note"`
+}
+
+type SendRemindersRequest struct {
+	WeekStart string `json:"week_start"` // YYYY-MM-DD, any day of the week
+}
+
+// --- Responses ---
+
+type TimesheetResponse struct {
+	ID              string     `json:"id"`
+	StudentID       int32      `json:"student_id"`
+	WeekStart       string     `json:"week_start"`
+	WeekEnd         string     `json:"week_end"`
+	Status          string     `json:"status"`
+	ScheduledHours  float64    `json:"scheduled_hours"`
+	WorkedHours     float64    `json:"worked_hours"`
+	VarianceHours   float64    `json:"variance_hours"`
+	LogCount        int32      `json:"log_count"`
+	FlaggedLogCount int32      `json:"flagged_log_count"`
+	SubmittedAt     time.Time  `json:"submitted_at"`
+	ReviewNote      *string    `json:"review_note"`
+	ReviewedBy      *string    `json:"reviewed_by"`
+	ReviewedAt      *time.Time `json:"reviewed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+type ScheduledShiftResponse struct {
+	Date      string  `json:"date"`
+	ShiftID   string  `json:"shift_id"`
+	StartTime string  `json:"start_time"`
+	EndTime   string  `json:"end_time"`
+	Hours     float64 `json:"hours"`
+}
+
+type WeekLogResponse struct {
+	ID          string     `json:"id"`
+	EntryAt     time.Time  `json:"entry_at"`
+	ExitAt      *time.Time `json:"exit_at"`
+	RawMinutes  *float64   `json:"raw_minutes"`
+	PaidMinutes *float64   `json:"paid_minutes"`
+	IsFlagged   bool       `json:"is_flagged"`
+	FlagReason  *string    `json:"flag_reason"`
+}
+
+type WeekViewResponse struct {
+	StudentID       int32                    `json:"student_id"`
+	WeekStart       string                   `json:"week_start"`
+	WeekEnd         string                   `json:"week_end"`
+	ScheduledHours  float64                  `json:"scheduled_hours"`
+	WorkedHours     float64                  `json:"worked_hours"`
+	VarianceHours   float64                  `json:"variance_hours"`
+	LogCount        int32                    `json:"log_count"`
+	FlaggedLogCount int32                    `json:"flagged_log_count"`
+	OpenLogCount    int32                    `json:"open_log_count"`
+	Timesheet       *TimesheetResponse       `json:"timesheet"`
+	Shifts          []ScheduledShiftResponse `json:"shifts"`
+	Logs            []WeekLogResponse        `json:"logs"`
+}
+
+type SendRemindersResponse struct {
+	WeekStart string `json:"week_start"`
+	Reminded  int    `json:"reminded"`
+}
+
+// --- Converters ---
+
+func TimesheetToResponse(t *aggregate.Timesheet) TimesheetResponse {
+	resp := TimesheetResponse{
+		ID:              t.ID.String(),
+		StudentID:       t.StudentID,
+		WeekStart:       t.WeekStart.Format(time.DateOnly),
+		WeekEnd:         t.WeekEnd().Format(time.DateOnly),
+		Status:          string(t.Status),
+		ScheduledHours:  t.Summary.ScheduledHours,
+		WorkedHours:     t.Summary.WorkedHours,
+		VarianceHours:   t.Summary.Variance(),
+		LogCount:        t.Summary.LogCount,
+		FlaggedLogCount: t.Summary.FlaggedLogCount,
+		SubmittedAt:     t.SubmittedAt,
+		ReviewNote:      t.ReviewNote,
+		ReviewedAt:      t.ReviewedAt,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+	if t.ReviewedBy != nil {
+		s := t.ReviewedBy.String()
+		resp.ReviewedBy = &s
+	}
+	return resp
+}
+
+func TimesheetListToResponse(timesheets []*aggregate.Timesheet) []TimesheetResponse {
+	responses := make([]TimesheetResponse, len(timesheets))
+	for i, t := range timesheets {
+		responses[i] = TimesheetToResponse(t)
+	}
+	return responses
+}
+
+func WeekViewToResponse(v *service.WeekView) WeekViewResponse {
+	resp := WeekViewResponse{
+		StudentID:       v.StudentID,
+		WeekStart:       v.WeekStart.Format(time.DateOnly),
+		WeekEnd:         v.WeekStart.AddDate(0, 0, 6).Format(time.DateOnly),
+		ScheduledHours:  v.Summary.ScheduledHours,
+		WorkedHours:     v.Summary.WorkedHours,
+		VarianceHours:   v.Summary.Variance(),
+		LogCount:        v.Summary.LogCount,
+		FlaggedLogCount: v.Summary.FlaggedLogCount,
+		OpenLogCount:    v.OpenLogCount,
+		Shifts:          make([]ScheduledShiftResponse, len(v.Shifts)),
+		Logs:            make([]WeekLogResponse, len(v.Logs)),
+	}
+	if v.Timesheet != nil {
+		ts := TimesheetToResponse(v.Timesheet)
+		resp.Timesheet = &ts
+	}
+	for i, sh := range v.Shifts {
+		resp.Shifts[i] = ScheduledShiftResponse{
+			Date:      sh.Date.Format(time.DateOnly),
+			ShiftID:   sh.ShiftID,
+			StartTime: sh.StartTime,
+			EndTime:   sh.EndTime,
+			Hours:     sh.Hours,
+		}
+	}
+	for i, l := range v.Logs {
+		resp.Logs[i] = weekLogToResponse(l)
+	}
+	return resp
+}
+
+func weekLogToResponse(l *timelogAggregate.TimeLog) WeekLogResponse {
+	return WeekLogResponse{
+		ID:          l.ID.String(),
+		EntryAt:     l.EntryAt,
+		ExitAt:      l.ExitAt,
+		RawMinutes:  l.RawMinutes(),
+		PaidMinutes: l.PaidMinutes,
+		IsFlagged:   l.IsFlagged,
+		FlagReason:  l.FlagReason,
+	}
+}
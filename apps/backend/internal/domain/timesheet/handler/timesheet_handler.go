@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TimesheetHandler struct {
+	logger  *zap.Logger
+	service service.TimesheetServiceInterface
+}
+
+func NewTimesheetHandler(logger *zap.Logger, service service.TimesheetServiceInterface) *TimesheetHandler {
+	return &TimesheetHandler{
+		logger:  logger,
+		service: service,
+	}
+}
+
+func (h *TimesheetHandler) RegisterRoutes(r chi.Router) {
+	r.Get("/timesheets/me", h.ListMyTimesheets)
+	r.Get("/timesheets/me/week", h.GetMyWeek)
+	r.Post("/timesheets/me/submit", h.SubmitTimesheet)
+}
+
+func (h *TimesheetHandler) RegisterAdminRoutes(r chi.Router) {
+	// Individual routes (not r.Route) to avoid chi mount conflict with the
+	// student routes above.
+	r.Get("/timesheets", h.ListTimesheets)
+	r.Post("/timesheets/reminders", h.SendReminders)
+	r.Get("/timesheets/{id}", h.GetTimesheet)
+	r.Patch("/timesheets/{id}/approve", h.ApproveTimesheet)
+	r.Patch("/timesheets/{id}/reject", h.RejectTimesheet)
+}
+
+func (h *TimesheetHandler) ListMyTimesheets(w http.ResponseWriter, r *http.Request) {
+	timesheets, err := h.service.ListMyTimesheets(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimesheetListToResponse(timesheets))
+}
+
+func (h *TimesheetHandler) GetMyWeek(w http.ResponseWriter, r *http.Request) {
+	// week_start is optional and defaults to the current week.
+	var weekStart time.Time
+	if v := r.URL.Query().Get("week_start"); v != "" {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid week_start format, expected YYYY-MM-DD")
+			return
+		}
+		weekStart = t
+	}
+
+	view, err := h.service.GetMyWeek(r.Context(), weekStart)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.WeekViewToResponse(view))
+}
+
+func (h *TimesheetHandler) SubmitTimesheet(w http.ResponseWriter, r *http.Request) {
+	var req dtos.SubmitTimesheetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	weekStart, err := time.Parse(time.DateOnly, req.WeekStart)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid week_start format, expected YYYY-MM-DD")
+		return
+	}
+
+	submitted, err := h.service.SubmitTimesheet(r.Context(), weekStart)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimesheetToResponse(submitted))
+}
+
+func (h *TimesheetHandler) ListTimesheets(w http.ResponseWriter, r *http.Request) {
+	var filter repository.TimesheetFilter
+
+	if v := r.URL.Query().Get("status"); v != "" {
+		switch status := aggregate.Status(v); status {
+		case aggregate.Status_Submitted, aggregate.Status_Approved, aggregate.Status_Rejected:
+			filter.Statuses = []aggregate.Status{status}
+		default:
+			writeError(w, http.StatusBadRequest, "invalid status filter")
+			return
+		}
+	}
+	if v := r.URL.Query().Get("student_id"); v != "" {
+		if sid, err := strconv.ParseInt(v, 10, 32); err == nil {
+			s := int32(sid)
+			filter.StudentID = &s
+		}
+	}
+	if v := r.URL.Query().Get("week_start"); v != "" {
+		if t, err := time.Parse(time.DateOnly, v); err == nil {
+			filter.WeekStart = &t
+		}
+	}
+
+	timesheets, err := h.service.ListTimesheets(r.Context(), filter)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimesheetListToResponse(timesheets))
+}
+
+func (h *TimesheetHandler) GetTimesheet(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid timesheet ID")
+		return
+	}
+
+	view, err := h.service.GetTimesheet(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.WeekViewToResponse(view))
+}
+
+func (h *TimesheetHandler) ApproveTimesheet(w http.ResponseWriter, r *http.Request) {
+	h.reviewTimesheet(w, r, h.service.ApproveTimesheet)
+}
+
+func (h *TimesheetHandler) RejectTimesheet(w http.ResponseWriter, r *http.Request) {
+	h.reviewTimesheet(w, r, h.service.RejectTimesheet)
+}
+
+func (h *TimesheetHandler) reviewTimesheet(
+	w http.ResponseWriter,
+	r *http.Request,
+	review func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error),
+) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid timesheet ID")
+		return
+	}
+
+	// The body is optional; a missing note is allowed.
+	var req dtos.ReviewTimesheetRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+	if req.Note != nil && len(*req.Note) > 500 {
+		writeError(w, http.StatusBadRequest, "note must be 500 characters or fewer")
+		return
+	}
+
+	reviewed, err := review(r.Context(), id, req.Note)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.TimesheetToResponse(reviewed))
+}
+
+func (h *TimesheetHandler) SendReminders(w http.ResponseWriter, r *http.Request) {
+	var req dtos.SendRemindersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	weekStart, err := time.Parse(time.DateOnly, req.WeekStart)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid week_start format, expected YYYY-MM-DD")
+		return
+	}
+
+	reminded, err := h.service.SendReminders(r.Context(), weekStart)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dtos.SendRemindersResponse{
+		WeekStart: aggregate.WeekStartOf(weekStart).Format(time.DateOnly),
+		Reminded:  reminded,
+	})
+}
+
+func (h *TimesheetHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, timesheetErrors.ErrInvalidWeekStart):
+		writeError(w, http.StatusBadRequest, "week start must be a Monday")
+	case errors.Is(err, timesheetErrors.ErrInvalidStudentID):
+		writeError(w, http.StatusBadRequest, "invalid student ID")
+	case errors.Is(err, timesheetErrors.ErrWeekNotOver):
+		writeError(w, http.StatusUnprocessableEntity, "the week has not ended yet")
+	case errors.Is(err, timesheetErrors.ErrOpenTimeLog):
+		writeError(w, http.StatusConflict, "clock out before submitting the timesheet")
+	case errors.Is(err, timesheetErrors.ErrAlreadySubmitted):
+		writeError(w, http.StatusConflict, "timesheet has already been submitted")
+	case errors.Is(err, timesheetErrors.ErrNotSubmitted):
+		writeError(w, http.StatusConflict, "timesheet is not awaiting review")
+	case errors.Is(err, timesheetErrors.ErrTimesheetNotFound):
+		writeError(w, http.StatusNotFound, "timesheet not found")
+	case errors.Is(err, timesheetErrors.ErrMissingAuthContext):
+		writeError(w, http.StatusUnauthorized, "missing auth context")
+	case errors.Is(err, timesheetErrors.ErrNotAuthorized):
+		writeError(w, http.StatusForbidden, "not authorized")
+	default:
+		h.logger.Error("unhandled service error", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		log.Printf("failed to encode JSON response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	"github.com/google/uuid"
+)
+
+// TimesheetFilter narrows a listing of timesheets.
+type TimesheetFilter struct {
+	StudentID *int32
+	WeekStart *time.Time
+	// WeekFrom and WeekTo bound the week start, inclusive.
+	WeekFrom *time.Time
+	WeekTo   *time.Time
+	Statuses []aggregate.Status
+}
+
+// WorkedSummary totals a student's time logs that started within a week.
+// WorkedHours only counts closed, unflagged logs, net of breaks.
+type WorkedSummary struct {
+	WorkedHours     float64
+	LogCount        int32
+	FlaggedLogCount int32
+	OpenLogCount    int32
+}
+
+type TimesheetRepositoryInterface interface {
+	Create(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error)
+	GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Timesheet, error)
+	GetByStudentAndWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*aggregate.Timesheet, error)
+	Update(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error)
+	List(ctx context.Context, tx *sql.Tx, filter TimesheetFilter) ([]*aggregate.Timesheet, error)
+	// SummarizeWeek totals the student's time logs that started in the week,
+	// in the local time of each log's location.
+	SummarizeWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*WorkedSummary, error)
+	// ListStudentIDsWithLogs returns every student with a time log that
+	// started in the week, in the local time of each log's location.
+	ListStudentIDsWithLogs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error)
+	// ListRemindedStudentIDs returns the students already reminded to submit
+	// the week's timesheet.
+	ListRemindedStudentIDs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error)
+	// RecordReminders notes that the students were reminded about the week.
+	RecordReminders(ctx context.Context, tx *sql.Tx, weekStart time.Time, studentIDs []int32, sentAt time.Time) error
+}
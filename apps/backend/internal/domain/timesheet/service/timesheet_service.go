@@ -0,0 +1,611 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	scheduleRepo "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailInterfaces "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxWeekLogs bounds the time logs listed in a week view.
+	maxWeekLogs = 100
+	// maxEmailBatchSize is the provider's limit on emails per batch request.
+	maxEmailBatchSize = 100
+)
+
+// ScheduledShift is a shift the active schedule assigns the student on a
+// specific day of the week.
+type ScheduledShift struct {
+	Date      time.Time
+	ShiftID   string
+	StartTime string
+	EndTime   string
+	Hours     float64
+}
+
+// WeekView is a student's week: what was scheduled, what was logged, and the
+// timesheet submitted for it, if any. Summary is always computed live.
+type WeekView struct {
+	StudentID    int32
+	WeekStart    time.Time
+	Summary      aggregate.WeekSummary
+	OpenLogCount int32
+	Timesheet    *aggregate.Timesheet
+	Shifts       []ScheduledShift
+	Logs         []*timelogAggregate.TimeLog
+}
+
+// TimesheetServiceInterface defines the service contract.
+type TimesheetServiceInterface interface {
+	GetMyWeek(ctx context.Context, weekStart time.Time) (*WeekView, error)
+	ListMyTimesheets(ctx context.Context) ([]*aggregate.Timesheet, error)
+	SubmitTimesheet(ctx context.Context, weekStart time.Time) (*aggregate.Timesheet, error)
+	ListTimesheets(ctx context.Context, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error)
+	GetTimesheet(ctx context.Context, id uuid.UUID) (*WeekView, error)
+	ApproveTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error)
+	RejectTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error)
+	// SendReminders emails every student who worked or was scheduled in the
+	// week but has no submitted or approved timesheet. It returns how many
+	// students were reminded.
+	SendReminders(ctx context.Context, weekStart time.Time) (int, error)
+	// SendDueReminders is SendReminders for the week that most recently
+	// ended, skipping students already reminded about it. It runs from the
+	// periodic reminder job and returns how many students were reminded.
+	SendDueReminders(ctx context.Context) (int, error)
+}
+
+// TimesheetService implements TimesheetServiceInterface.
+type TimesheetService struct {
+	logger        *zap.Logger
+	txManager     database.TxManagerInterface
+	timesheetRepo repository.TimesheetRepositoryInterface
+	timeLogRepo   timelogRepo.TimeLogRepositoryInterface
+	scheduleRepo  scheduleRepo.ScheduleRepositoryInterface
+	timeOffRepo   timeoffRepo.TimeOffRequestRepositoryInterface
+	studentRepo   studentRepo.StudentRepositoryInterface
+	emailSender   emailInterfaces.EmailSenderInterface
+	fromEmail     string
+	frontendURL   string
+	// defaultLocation decides which week is current and when a week is over.
+	// Time logs are placed in weeks by their own location's timezone.
+	defaultLocation *scheduleAggregate.Location
+	nowFn           func() time.Time
+}
+
+var _ TimesheetServiceInterface = (*TimesheetService)(nil)
+
+func NewTimesheetService(
+	logger *zap.Logger,
+	txManager database.TxManagerInterface,
+	timesheetRepo repository.TimesheetRepositoryInterface,
+	timeLogRepo timelogRepo.TimeLogRepositoryInterface,
+	scheduleRepo scheduleRepo.ScheduleRepositoryInterface,
+	timeOffRepo timeoffRepo.TimeOffRequestRepositoryInterface,
+	studentRepo studentRepo.StudentRepositoryInterface,
+	emailSender emailInterfaces.EmailSenderInterface,
+	fromEmail string,
+	frontendURL string,
+) *TimesheetService {
+	return &TimesheetService{
+		logger:          logger,
+		txManager:       txManager,
+		timesheetRepo:   timesheetRepo,
+		timeLogRepo:     timeLogRepo,
+		scheduleRepo:    scheduleRepo,
+		timeOffRepo:     timeOffRepo,
+		studentRepo:     studentRepo,
+		emailSender:     emailSender,
+		fromEmail:       fromEmail,
+		frontendURL:     frontendURL,
+		defaultLocation: &scheduleAggregate.Location{Timezone: scheduleAggregate.DefaultLocationTimezone},
+		nowFn:           func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithNowFn sets a custom time function, useful for testing.
+func (s *TimesheetService) WithNowFn(fn func() time.Time) {
+	s.nowFn = fn
+}
+
+// GetMyWeek runs as the internal role because it reads the schedule and time
+// off alongside the student's logs; every query is scoped to the caller. A zero
+// weekStart selects the current week.
+func (s *TimesheetService) GetMyWeek(ctx context.Context, weekStart time.Time) (*WeekView, error) {
+	studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if weekStart.IsZero() {
+		weekStart = aggregate.WeekStartAt(s.nowFn(), s.defaultLocation.TimeLocation())
+	}
+
+	var result *WeekView
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.buildWeekView(ctx, tx, studentID, aggregate.WeekStartOf(weekStart))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimesheetService) ListMyTimesheets(ctx context.Context) ([]*aggregate.Timesheet, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return nil, timesheetErrors.ErrMissingAuthContext
+	}
+	studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*aggregate.Timesheet
+
+	err = s.txManager.InAuthTx(ctx, authCtx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.timesheetRepo.List(ctx, tx, repository.TimesheetFilter{StudentID: &studentID})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SubmitTimesheet snapshots the student's totals for a finished week and puts
+// them up for review. A rejected timesheet may be resubmitted.
+func (s *TimesheetService) SubmitTimesheet(ctx context.Context, weekStart time.Time) (*aggregate.Timesheet, error) {
+	studentID, err := studentFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	weekStart = aggregate.WeekStartOf(weekStart)
+	now := s.nowFn()
+	if _, weekEnd := aggregate.WeekBounds(weekStart, s.defaultLocation.TimeLocation()); now.Before(weekEnd) {
+		return nil, timesheetErrors.ErrWeekNotOver
+	}
+
+	var result *aggregate.Timesheet
+
+	// Students only have SELECT on timesheets, so the write runs as the
+	// internal role for the caller's own student ID.
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		summary, openLogs, err := s.summarize(ctx, tx, studentID, weekStart)
+		if err != nil {
+			return err
+		}
+		if openLogs > 0 {
+			return timesheetErrors.ErrOpenTimeLog
+		}
+
+		existing, err := s.timesheetRepo.GetByStudentAndWeek(ctx, tx, studentID, weekStart)
+		if err != nil && !errors.Is(err, timesheetErrors.ErrTimesheetNotFound) {
+			return err
+		}
+
+		if existing != nil {
+			if err := existing.Resubmit(summary, now); err != nil {
+				return err
+			}
+			result, err = s.timesheetRepo.Update(ctx, tx, existing)
+			return err
+		}
+
+		ts, err := aggregate.NewTimesheet(studentID, weekStart, summary, now)
+		if err != nil {
+			return err
+		}
+		result, err = s.timesheetRepo.Create(ctx, tx, ts)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimesheetService) ListTimesheets(ctx context.Context, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if filter.WeekStart != nil {
+		ws := aggregate.WeekStartOf(*filter.WeekStart)
+		filter.WeekStart = &ws
+	}
+
+	var result []*aggregate.Timesheet
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		result, err = s.timesheetRepo.List(ctx, tx, filter)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetTimesheet returns the submitted timesheet together with the live view of
+// its week, so reviewers can compare the snapshot against the current logs.
+func (s *TimesheetService) GetTimesheet(ctx context.Context, id uuid.UUID) (*WeekView, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	var result *WeekView
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		ts, err := s.timesheetRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		result, err = s.buildWeekView(ctx, tx, ts.StudentID, ts.WeekStart)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimesheetService) ApproveTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error) {
+	return s.review(ctx, id, func(ts *aggregate.Timesheet, reviewerID uuid.UUID, now time.Time) error {
+		return ts.Approve(reviewerID, note, now)
+	})
+}
+
+func (s *TimesheetService) RejectTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error) {
+	return s.review(ctx, id, func(ts *aggregate.Timesheet, reviewerID uuid.UUID, now time.Time) error {
+		return ts.Reject(reviewerID, note, now)
+	})
+}
+
+func (s *TimesheetService) review(ctx context.Context, id uuid.UUID, apply func(*aggregate.Timesheet, uuid.UUID, time.Time) error) (*aggregate.Timesheet, error) {
+	reviewerID, err := adminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *aggregate.Timesheet
+
+	err = s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		ts, err := s.timesheetRepo.GetByID(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := apply(ts, reviewerID, s.nowFn()); err != nil {
+			return err
+		}
+
+		result, err = s.timesheetRepo.Update(ctx, tx, ts)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *TimesheetService) SendReminders(ctx context.Context, weekStart time.Time) (int, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return 0, err
+	}
+
+	weekStart = aggregate.WeekStartOf(weekStart)
+	if _, weekEnd := aggregate.WeekBounds(weekStart, s.defaultLocation.TimeLocation()); s.nowFn().Before(weekEnd) {
+		return 0, timesheetErrors.ErrWeekNotOver
+	}
+
+	return s.remind(ctx, weekStart, false)
+}
+
+// SendDueReminders needs no auth context; it runs as the internal role.
+func (s *TimesheetService) SendDueReminders(ctx context.Context) (int, error) {
+	weekStart := aggregate.WeekStartAt(s.nowFn(), s.defaultLocation.TimeLocation()).AddDate(0, 0, -7)
+	return s.remind(ctx, weekStart, true)
+}
+
+// remind emails the students with no submitted or approved timesheet for the
+// week and records each batch once it is sent. With skipReminded, students
+// already reminded about the week are left out.
+func (s *TimesheetService) remind(ctx context.Context, weekStart time.Time, skipReminded bool) (int, error) {
+	var items dtos.SendEmailBulkRequest
+	var recipients []int32
+
+	err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+		pending := make(map[int32]bool)
+
+		logged, err := s.timesheetRepo.ListStudentIDsWithLogs(ctx, tx, weekStart)
+		if err != nil {
+			return err
+		}
+		for _, id := range logged {
+			pending[id] = true
+		}
+
+		shifts, err := s.scheduledShiftsForWeek(ctx, tx, nil, weekStart)
+		if err != nil {
+			return err
+		}
+		for id, list := range shifts {
+			if len(list) > 0 {
+				pending[id] = true
+			}
+		}
+
+		done, err := s.timesheetRepo.List(ctx, tx, repository.TimesheetFilter{
+			WeekStart: &weekStart,
+			Statuses:  []aggregate.Status{aggregate.Status_Submitted, aggregate.Status_Approved},
+		})
+		if err != nil {
+			return err
+		}
+		for _, ts := range done {
+			delete(pending, ts.StudentID)
+		}
+
+		if skipReminded {
+			reminded, err := s.timesheetRepo.ListRemindedStudentIDs(ctx, tx, weekStart)
+			if err != nil {
+				return err
+			}
+			for _, id := range reminded {
+				delete(pending, id)
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		ids := make([]int32, 0, len(pending))
+		for id := range pending {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		students, err := s.studentRepo.ListByIDs(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		weekLabel := fmt.Sprintf("%s – %s", weekStart.Format("2 Jan"), weekStart.AddDate(0, 0, 6).Format("2 Jan 2006"))
+		for _, st := range students {
+			recipients = append(recipients, st.StudentID)
+			items = append(items, dtos.BatchEmailItem{
+				From:    s.fromEmail,
+				To:      []string{st.EmailAddress},
+				Subject: "Timesheet reminder - DCIT Help Desk",
+				Template: &types.EmailTemplate{
+					ID: templates.TemplateID_TimesheetReminder,
+					Variables: map[string]any{
+						"STUDENT_NAME":  st.FirstName,
+						"WEEK_LABEL":    weekLabel,
+						"TIMESHEET_URL": s.frontendURL + "/timesheets",
+					},
+				},
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	for start := 0; start < len(items); start += maxEmailBatchSize {
+		end := min(start+maxEmailBatchSize, len(items))
+		if _, err := s.emailSender.SendBatch(ctx, items[start:end]); err != nil {
+			s.logger.Error("failed to send timesheet reminders", zap.Error(err), zap.Int("sent", start))
+			return start, fmt.Errorf("failed to send timesheet reminders: %w", err)
+		}
+		err := s.txManager.InSystemTx(ctx, func(tx *sql.Tx) error {
+			return s.timesheetRepo.RecordReminders(ctx, tx, weekStart, recipients[start:end], s.nowFn())
+		})
+		if err != nil {
+			return end, err
+		}
+	}
+
+	return len(items), nil
+}
+
+func (s *TimesheetService) buildWeekView(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*WeekView, error) {
+	view := &WeekView{
+		StudentID: studentID,
+		WeekStart: weekStart,
+	}
+
+	existing, err := s.timesheetRepo.GetByStudentAndWeek(ctx, tx, studentID, weekStart)
+	if err != nil && !errors.Is(err, timesheetErrors.ErrTimesheetNotFound) {
+		return nil, err
+	}
+	view.Timesheet = existing
+
+	shifts, err := s.scheduledShiftsForWeek(ctx, tx, &studentID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	view.Shifts = shifts[studentID]
+	if view.Shifts == nil {
+		view.Shifts = []ScheduledShift{}
+	}
+
+	worked, err := s.timesheetRepo.SummarizeWeek(ctx, tx, studentID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	view.Summary = aggregate.WeekSummary{
+		ScheduledHours:  sumHours(view.Shifts),
+		WorkedHours:     worked.WorkedHours,
+		LogCount:        worked.LogCount,
+		FlaggedLogCount: worked.FlaggedLogCount,
+	}
+	view.OpenLogCount = worked.OpenLogCount
+
+	view.Logs, _, err = s.timeLogRepo.List(ctx, tx, timelogRepo.TimeLogFilter{
+		StudentID: &studentID,
+		WeekStart: &weekStart,
+		PerPage:   maxWeekLogs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (s *TimesheetService) summarize(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (aggregate.WeekSummary, int32, error) {
+	shifts, err := s.scheduledShiftsForWeek(ctx, tx, &studentID, weekStart)
+	if err != nil {
+		return aggregate.WeekSummary{}, 0, err
+	}
+	worked, err := s.timesheetRepo.SummarizeWeek(ctx, tx, studentID, weekStart)
+	if err != nil {
+		return aggregate.WeekSummary{}, 0, err
+	}
+	return aggregate.WeekSummary{
+		ScheduledHours:  sumHours(shifts[studentID]),
+		WorkedHours:     worked.WorkedHours,
+		LogCount:        worked.LogCount,
+		FlaggedLogCount: worked.FlaggedLogCount,
+	}, worked.OpenLogCount, nil
+}
+
+// scheduledShiftsForWeek expands the active schedule into dated shifts per
+// student for the week, skipping days outside the schedule's effective period
+// and days covered by approved time off. A nil studentID includes everyone.
+func (s *TimesheetService) scheduledShiftsForWeek(ctx context.Context, tx *sql.Tx, studentID *int32, weekStart time.Time) (map[int32][]ScheduledShift, error) {
+	result := make(map[int32][]ScheduledShift)
+
+	activeSchedule, err := s.scheduleRepo.GetActive(ctx, tx)
+	if err != nil {
+		if errors.Is(err, scheduleErrors.ErrNotFound) {
+			return result, nil
+		}
+		return nil, err
+	}
+	if activeSchedule == nil {
+		return result, nil
+	}
+
+	weekEnd := weekStart.AddDate(0, 0, 6)
+	timeOff, err := s.timeOffRepo.List(ctx, tx, timeoffRepo.TimeOffFilter{
+		StudentID: studentID,
+		Statuses:  []timeoffAggregate.Status{timeoffAggregate.Status_Approved},
+		From:      &weekStart,
+		To:        &weekEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dated, err := activeSchedule.Expand(weekStart, weekEnd, func(id int32, day time.Time) bool {
+		return (studentID != nil && *studentID != id) || onTimeOff(timeOff, id, day)
+	})
+	if err != nil {
+		s.logger.Error("failed to unmarshal schedule assignments", zap.Error(err))
+		return nil, fmt.Errorf("malformed schedule assignments: %w", err)
+	}
+
+	for _, d := range dated {
+		result[d.StudentID] = append(result[d.StudentID], ScheduledShift{
+			Date:      d.Date,
+			ShiftID:   d.ShiftID,
+			StartTime: d.Start,
+			EndTime:   d.End,
+			Hours:     d.Hours(),
+		})
+	}
+	for id := range result {
+		shifts := result[id]
+		sort.SliceStable(shifts, func(i, j int) bool {
+			if !shifts[i].Date.Equal(shifts[j].Date) {
+				return shifts[i].Date.Before(shifts[j].Date)
+			}
+			return shifts[i].StartTime < shifts[j].StartTime
+		})
+	}
+	return result, nil
+}
+
+func onTimeOff(requests []*timeoffAggregate.TimeOffRequest, studentID int32, day time.Time) bool {
+	for _, r := range requests {
+		if r.StudentID == studentID && r.CoversDate(day) {
+			return true
+		}
+	}
+	return false
+}
+
+func sumHours(shifts []ScheduledShift) float64 {
+	var total float64
+	for _, sh := range shifts {
+		total += sh.Hours
+	}
+	return total
+}
+
+func studentFromContext(ctx context.Context) (int32, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok || authCtx.StudentID == nil {
+		return 0, timesheetErrors.ErrMissingAuthContext
+	}
+
+	studentID, err := strconv.ParseInt(*authCtx.StudentID, 10, 32)
+	if err != nil {
+		return 0, timesheetErrors.ErrMissingAuthContext
+	}
+	return int32(studentID), nil
+}
+
+func adminFromContext(ctx context.Context) (uuid.UUID, error) {
+	authCtx, ok := database.GetAuthContextFromContext(ctx)
+	if !ok {
+		return uuid.Nil, timesheetErrors.ErrMissingAuthContext
+	}
+	if authCtx.Role != string(userAggregate.Role_Admin) {
+		return uuid.Nil, timesheetErrors.ErrNotAuthorized
+	}
+
+	userID, err := uuid.Parse(authCtx.UserID)
+	if err != nil {
+		return uuid.Nil, timesheetErrors.ErrMissingAuthContext
+	}
+	return userID, nil
+}
@@ -7,24 +7,35 @@ import (
 	"log"
 	"net/http"
 
+	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
 	transcriptErrors "github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/interfaces"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types/dtos"
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 )
 
 type TranscriptHandler struct {
-	logger  *zap.Logger
-	service interfaces.TranscriptsServiceInterface
+	logger      *zap.Logger
+	service     interfaces.TranscriptsServiceInterface
+	documentSvc studentService.StudentDocumentServiceInterface
 }
 
-func NewTranscriptHandler(logger *zap.Logger, service interfaces.TranscriptsServiceInterface) *TranscriptHandler {
+func NewTranscriptHandler(logger *zap.Logger, service interfaces.TranscriptsServiceInterface, documentSvc studentService.StudentDocumentServiceInterface) *TranscriptHandler {
 	return &TranscriptHandler{
-		logger:  logger,
-		service: service,
+		logger:      logger,
+		service:     service,
+		documentSvc: documentSvc,
 	}
 }
 
+// extractTranscriptResponse is the extracted metadata plus the ID of the
+// stored PDF, which the applicant sends back as transcript_document_id.
+type extractTranscriptResponse struct {
+	*dtos.ExtractTranscriptResponse
+	DocumentID *string `json:"document_id"`
+}
+
 const maxUploadSize = 10 << 20 // 10 MB
 
 func (h *TranscriptHandler) RegisterRoutes(r chi.Router) {
@@ -66,7 +77,19 @@ func (h *TranscriptHandler) ExtractTranscript(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	writeJSON(w, http.StatusOK, result)
+	// Keep the PDF so the transcript can be re-checked once the application
+	// links it. Extraction has already succeeded, so a storage failure is
+	// logged rather than failing the request.
+	resp := extractTranscriptResponse{ExtractTranscriptResponse: result}
+	doc, err := h.documentSvc.StorePending(r.Context(), header.Filename, pdfBytes)
+	if err != nil {
+		h.logger.Error("failed to store transcript", zap.Error(err))
+	} else {
+		id := doc.ID.String()
+		resp.DocumentID = &id
+	}
+
+	writeJSON(w, http.StatusOK, resp)
 }
 
 func (h *TranscriptHandler) handleServiceError(w http.ResponseWriter, err error) {
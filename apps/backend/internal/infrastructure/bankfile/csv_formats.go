@@ -0,0 +1,114 @@
+package bankfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+)
+
+// GenericCSV is the fallback layout for banks without their own format. It
+// carries every field the bursary needs to key the credits in manually and
+// ends with a trailer row holding the record count and control total.
+type GenericCSV struct{}
+
+func (GenericCSV) Name() string      { return "generic_csv" }
+func (GenericCSV) Extension() string { return "csv" }
+
+func (GenericCSV) Write(batch Batch) ([]byte, error) {
+	rows := [][]string{{
+		"Payment Reference",
+		"Student ID",
+		"Payee Name",
+		"Bank Name",
+		"Branch Name",
+		"Account Type",
+		"Account Number",
+		"Amount",
+	}}
+	for _, e := range batch.Entries {
+		rows = append(rows, []string{
+			e.Reference,
+			strconv.Itoa(int(e.StudentID)),
+			e.PayeeName,
+			batch.BankName,
+			e.BranchName,
+			e.AccountType,
+			e.AccountNumber,
+			formatCents(e.AmountCents),
+		})
+	}
+	rows = append(rows, []string{
+		"TRAILER",
+		"",
+		"",
+		"",
+		"",
+		"",
+		strconv.Itoa(batch.RecordCount()),
+		formatCents(batch.ControlTotalCents()),
+	})
+	return writeCSV(rows, false)
+}
+
+// RepublicCSV is Republic Bank's bulk credit upload layout: a header row with
+// the value date, one row per credit with a single-letter account type, and a
+// trailer row. The bank expects CRLF line endings.
+type RepublicCSV struct{}
+
+func (RepublicCSV) Name() string      { return "republic_csv" }
+func (RepublicCSV) Extension() string { return "csv" }
+
+func (RepublicCSV) Write(batch Batch) ([]byte, error) {
+	rows := [][]string{{
+		"H",
+		"DCIT HELP DESK",
+		batch.PeriodEnd.Format("02/01/2006"),
+		strconv.Itoa(batch.RecordCount()),
+	}}
+	for _, e := range batch.Entries {
+		rows = append(rows, []string{
+			"D",
+			e.AccountNumber,
+			accountTypeCode(e.AccountType),
+			e.BranchName,
+			e.PayeeName,
+			formatCents(e.AmountCents),
+			e.Reference,
+		})
+	}
+	rows = append(rows, []string{
+		"T",
+		strconv.Itoa(batch.RecordCount()),
+		formatCents(batch.ControlTotalCents()),
+	})
+	return writeCSV(rows, true)
+}
+
+func writeCSV(rows [][]string, crlf bool) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.UseCRLF = crlf
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write bank file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// formatCents renders an amount in cents as dollars with two decimals.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// accountTypeCode is the single-letter account type used by bank layouts.
+func accountTypeCode(accountType string) string {
+	if accountType == "savings" {
+		return "S"
+	}
+	return "C"
+}
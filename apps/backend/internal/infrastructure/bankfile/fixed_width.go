@@ -0,0 +1,103 @@
+package bankfile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecordLength is the length of every line in a FixedWidth file, excluding
+// the CRLF terminator.
+const RecordLength = 80
+
+// FixedWidth is the 80-column layout used by First Citizens and Scotiabank.
+//
+//	Header  H | originator (30) | bank name (30) | value date YYYYMMDD (8) | filler
+//	Detail  D | branch (20) | account number (20) | type C/S (1) | amount in cents (12) | payee (26)
+//	Trailer T | record count (6) | control total in cents (15) | filler
+//
+// Text fields are upper-cased, left-justified and space-padded; numbers are
+// zero-padded. Characters outside printable ASCII become '?'.
+type FixedWidth struct{}
+
+func (FixedWidth) Name() string      { return "fixed_width" }
+func (FixedWidth) Extension() string { return "txt" }
+
+func (FixedWidth) Write(batch Batch) ([]byte, error) {
+	var b strings.Builder
+
+	header := "H" +
+		alpha("DCIT HELP DESK", 30) +
+		alpha(batch.BankName, 30) +
+		batch.PeriodEnd.Format("20060102")
+	if err := writeRecord(&b, header); err != nil {
+		return nil, err
+	}
+
+	for _, e := range batch.Entries {
+		if e.AmountCents < 0 {
+			return nil, fmt.Errorf("negative amount for payment %s", e.Reference)
+		}
+		amount, err := numeric(e.AmountCents, 12)
+		if err != nil {
+			return nil, err
+		}
+		detail := "D" +
+			alpha(e.BranchName, 20) +
+			alpha(e.AccountNumber, 20) +
+			accountTypeCode(e.AccountType) +
+			amount +
+			alpha(e.PayeeName, 26)
+		if err := writeRecord(&b, detail); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := numeric(int64(batch.RecordCount()), 6)
+	if err != nil {
+		return nil, err
+	}
+	total, err := numeric(batch.ControlTotalCents(), 15)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeRecord(&b, "T"+count+total); err != nil {
+		return nil, err
+	}
+
+	return []byte(b.String()), nil
+}
+
+func writeRecord(b *strings.Builder, record string) error {
+	if len(record) > RecordLength {
+		return fmt.Errorf("bank file record exceeds %d characters", RecordLength)
+	}
+	b.WriteString(record)
+	b.WriteString(strings.Repeat(" ", RecordLength-len(record)))
+	b.WriteString("\r\n")
+	return nil
+}
+
+// alpha upper-cases s and pads or truncates it to exactly width characters.
+func alpha(s string, width int) string {
+	out := make([]byte, 0, width)
+	for _, r := range strings.ToUpper(strings.TrimSpace(s)) {
+		if len(out) == width {
+			break
+		}
+		if r < 0x20 || r > 0x7e {
+			r = '?'
+		}
+		out = append(out, byte(r))
+	}
+	return string(out) + strings.Repeat(" ", width-len(out))
+}
+
+// numeric zero-pads n to width digits. A value that does not fit is an error
+// rather than being truncated, since a wrong amount would still parse.
+func numeric(n int64, width int) (string, error) {
+	s := fmt.Sprintf("%0*d", width, n)
+	if len(s) > width {
+		return "", fmt.Errorf("value %d does not fit in %d digits", n, width)
+	}
+	return s, nil
+}
@@ -0,0 +1,147 @@
+// Package bankfile writes disbursement files in the layouts local banks
+// accept for bulk salary credits. Each layout is a Format; a Registry picks
+// the format for a bank from the bank name students enter with their banking
+// details, falling back to a generic CSV for banks without a known layout.
+package bankfile
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Entry is one credit to a student's account. Amounts are in cents so
+// control totals add up exactly.
+type Entry struct {
+	Reference     string // payment ID
+	StudentID     int32
+	PayeeName     string
+	BranchName    string
+	AccountType   string // "chequeing" or "savings"
+	AccountNumber string
+	AmountCents   int64
+}
+
+// Batch is every credit going to one bank for one pay period.
+type Batch struct {
+	BankName    string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Entries     []Entry
+}
+
+// RecordCount is the number of credits in the batch.
+func (b Batch) RecordCount() int {
+	return len(b.Entries)
+}
+
+// ControlTotalCents is the sum of every credit in the batch.
+func (b Batch) ControlTotalCents() int64 {
+	var total int64
+	for _, e := range b.Entries {
+		total += e.AmountCents
+	}
+	return total
+}
+
+// Format is one bank file layout. Write must include the batch's record
+// count and control total so the bank can check the file on upload.
+type Format interface {
+	// Name identifies the format in API responses and stored file records.
+	Name() string
+	// Extension is the file extension without a leading dot.
+	Extension() string
+	Write(batch Batch) ([]byte, error)
+}
+
+// Registry maps bank names to formats.
+type Registry struct {
+	formats  map[string]Format
+	banks    map[string]string // normalised bank name -> format name
+	fallback Format
+}
+
+// NewRegistry returns a registry that uses fallback for unknown banks.
+func NewRegistry(fallback Format) *Registry {
+	r := &Registry{
+		formats:  make(map[string]Format),
+		banks:    make(map[string]string),
+		fallback: fallback,
+	}
+	r.formats[fallback.Name()] = fallback
+	return r
+}
+
+// Register adds a format and routes the given bank names to it. Bank names
+// are matched case-insensitively and ignoring punctuation, so "Republic Bank
+// Ltd." matches "republic bank ltd".
+func (r *Registry) Register(format Format, bankNames ...string) {
+	r.formats[format.Name()] = format
+	for _, name := range bankNames {
+		r.banks[NormaliseBankName(name)] = format.Name()
+	}
+}
+
+// ForBank returns the format registered for bankName, or the fallback.
+func (r *Registry) ForBank(bankName string) Format {
+	if name, ok := r.banks[NormaliseBankName(bankName)]; ok {
+		return r.formats[name]
+	}
+	return r.fallback
+}
+
+// Formats returns every registered format name, sorted.
+func (r *Registry) Formats() []string {
+	names := make([]string, 0, len(r.formats))
+	for name := range r.formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Banks returns the normalised bank names with a registered format, mapped to
+// the format name.
+func (r *Registry) Banks() map[string]string {
+	banks := make(map[string]string, len(r.banks))
+	for bank, format := range r.banks {
+		banks[bank] = format
+	}
+	return banks
+}
+
+// FallbackFormat is the format name used for unknown banks.
+func (r *Registry) FallbackFormat() string {
+	return r.fallback.Name()
+}
+
+// DefaultRegistry returns the layouts the bursary currently sends files in.
+func DefaultRegistry() *Registry {
+	r := NewRegistry(GenericCSV{})
+	r.Register(RepublicCSV{},
+		"Republic Bank", "Republic Bank Limited", "Republic Bank Ltd", "RBL")
+	r.Register(FixedWidth{},
+		"First Citizens", "First Citizens Bank", "First Citizens Bank Limited", "FCB",
+		"Scotiabank", "Scotiabank Trinidad and Tobago", "Bank of Nova Scotia")
+	return r
+}
+
+// NormaliseBankName lowercases name and collapses every run of characters
+// other than letters and digits into a single space.
+func NormaliseBankName(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		space = true
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.BudgetRepositoryInterface = (*BudgetRepository)(nil)
+
+type BudgetRepository struct {
+	logger *zap.Logger
+}
+
+func NewBudgetRepository(logger *zap.Logger) repository.BudgetRepositoryInterface {
+	return &BudgetRepository{
+		logger: logger,
+	}
+}
+
+func (r *BudgetRepository) Create(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error) {
+	m := budget.ToModel()
+
+	stmt := table.Budgets.INSERT(
+		table.Budgets.ID,
+		table.Budgets.Name,
+		table.Budgets.PeriodType,
+		table.Budgets.PeriodStart,
+		table.Budgets.PeriodEnd,
+		table.Budgets.Amount,
+		table.Budgets.CreatedBy,
+	).MODEL(m).RETURNING(table.Budgets.AllColumns)
+
+	var result model.Budgets
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create budget", zap.Error(err))
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	created := aggregate.BudgetFromModel(result)
+	return &created, nil
+}
+
+func (r *BudgetRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Budget, error) {
+	stmt := table.Budgets.
+		SELECT(table.Budgets.AllColumns).
+		WHERE(table.Budgets.ID.EQ(postgres.UUID(id)))
+
+	var result model.Budgets
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, budgetErrors.ErrBudgetNotFound
+		}
+		r.logger.Error("failed to get budget", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get budget: %w", err)
+	}
+
+	budget := aggregate.BudgetFromModel(result)
+	return &budget, nil
+}
+
+func (r *BudgetRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Budget, error) {
+	stmt := table.Budgets.
+		SELECT(table.Budgets.AllColumns).
+		ORDER_BY(table.Budgets.PeriodStart.DESC(), table.Budgets.CreatedAt.DESC())
+
+	var results []model.Budgets
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Budget{}, nil
+		}
+		r.logger.Error("failed to list budgets", zap.Error(err))
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	budgets := make([]*aggregate.Budget, len(results))
+	for i := range results {
+		budget := aggregate.BudgetFromModel(results[i])
+		budgets[i] = &budget
+	}
+	return budgets, nil
+}
+
+func (r *BudgetRepository) Update(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error) {
+	m := budget.ToModel()
+
+	stmt := table.Budgets.UPDATE(
+		table.Budgets.Name,
+		table.Budgets.PeriodType,
+		table.Budgets.PeriodStart,
+		table.Budgets.PeriodEnd,
+		table.Budgets.Amount,
+	).MODEL(m).WHERE(
+		table.Budgets.ID.EQ(postgres.UUID(budget.ID)),
+	).RETURNING(table.Budgets.AllColumns)
+
+	var result model.Budgets
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, budgetErrors.ErrBudgetNotFound
+		}
+		r.logger.Error("failed to update budget", zap.Error(err), zap.String("id", budget.ID.String()))
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	updated := aggregate.BudgetFromModel(result)
+	return &updated, nil
+}
+
+func (r *BudgetRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := table.Budgets.
+		DELETE().
+		WHERE(table.Budgets.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete budget", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return budgetErrors.ErrBudgetNotFound
+	}
+	return nil
+}
@@ -11,6 +11,11 @@ import (
 // Encrypt encrypts plaintext using AES-256-GCM and returns raw ciphertext bytes
 // (nonce prepended). Store directly in a bytea column.
 func Encrypt(plaintext string, key []byte) ([]byte, error) {
+	return EncryptBytes([]byte(plaintext), key)
+}
+
+// EncryptBytes is Encrypt for binary data such as uploaded files.
+func EncryptBytes(plaintext []byte, key []byte) ([]byte, error) {
 	if len(key) != 32 {
 		return nil, fmt.Errorf("encryption key must be 32 bytes for AES-256, got %d", len(key))
 	}
@@ -30,35 +35,44 @@ func Encrypt(plaintext string, key []byte) ([]byte, error) {
 		return nil, fmt.Errorf("failed to generate nonce: %w", err)
 	}
 
-	return gcm.Seal(nonce, nonce, []byte(plaintext), nil), nil
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
 }
 
 // Decrypt decrypts raw ciphertext bytes (with prepended nonce) using AES-256-GCM.
 func Decrypt(ciphertext []byte, key []byte) (string, error) {
+	plaintext, err := DecryptBytes(ciphertext, key)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// DecryptBytes is Decrypt for binary data.
+func DecryptBytes(ciphertext []byte, key []byte) ([]byte, error) {
 	if len(key) != 32 {
-		return "", fmt.Errorf("encryption key must be 32 bytes for AES-256, got %d", len(key))
+		return nil, fmt.Errorf("encryption key must be 32 bytes for AES-256, got %d", len(key))
 	}
 
 	block, err := aes.NewCipher(key)
 	if err != nil {
-		return "", fmt.Errorf("failed to create cipher: %w", err)
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
 	}
 
 	gcm, err := cipher.NewGCM(block)
 	if err != nil {
-		return "", fmt.Errorf("failed to create GCM: %w", err)
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
 	}
 
 	nonceSize := gcm.NonceSize()
 	if len(ciphertext) < nonceSize {
-		return "", fmt.Errorf("ciphertext too short")
+		return nil, fmt.Errorf("ciphertext too short")
 	}
 
 	nonce, ciphertextOnly := ciphertext[:nonceSize], ciphertext[nonceSize:]
 	plaintext, err := gcm.Open(nil, nonce, ciphertextOnly, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to decrypt: %w", err)
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
 	}
 
-	return string(plaintext), nil
+	return plaintext, nil
 }
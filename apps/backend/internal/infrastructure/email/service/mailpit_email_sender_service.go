@@ -41,15 +41,24 @@ type mailpitAddress struct {
 }
 
 type mailpitSendRequest struct {
-	From    mailpitAddress    `json:"From"`
-	To      []mailpitAddress  `json:"To"`
-	Cc      []mailpitAddress  `json:"Cc,omitempty"`
-	Bcc     []mailpitAddress  `json:"Bcc,omitempty"`
-	ReplyTo []mailpitAddress  `json:"ReplyTo,omitempty"`
-	Subject string            `json:"Subject"`
-	Text    string            `json:"Text,omitempty"`
-	HTML    string            `json:"HTML,omitempty"`
-	Headers map[string]string `json:"Headers,omitempty"`
+	From        mailpitAddress      `json:"From"`
+	To          []mailpitAddress    `json:"To"`
+	Cc          []mailpitAddress    `json:"Cc,omitempty"`
+	Bcc         []mailpitAddress    `json:"Bcc,omitempty"`
+	ReplyTo     []mailpitAddress    `json:"ReplyTo,omitempty"`
+	Subject     string              `json:"Subject"`
+	Text        string              `json:"Text,omitempty"`
+	HTML        string              `json:"HTML,omitempty"`
+	Headers     map[string]string   `json:"Headers,omitempty"`
+	Attachments []mailpitAttachment `json:"Attachments,omitempty"`
+}
+
+// mailpitAttachment content is base64, matching types.EmailAttachment.
+type mailpitAttachment struct {
+	Content     string `json:"Content"`
+	Filename    string `json:"Filename"`
+	ContentType string `json:"ContentType,omitempty"`
+	ContentID   string `json:"ContentID,omitempty"`
 }
 
 type mailpitSendResponse struct {
@@ -91,6 +100,14 @@ func (s *MailpitEmailSenderService) Send(ctx context.Context, req dtos.SendEmail
 		HTML:    req.HTML,
 		Headers: req.Headers,
 	}
+	for _, a := range req.Attachments {
+		mailpitReq.Attachments = append(mailpitReq.Attachments, mailpitAttachment{
+			Content:     a.Content,
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			ContentID:   a.ContentID,
+		})
+	}
 
 	body, err := json.Marshal(mailpitReq)
 	if err != nil {
@@ -21,6 +21,12 @@ const (
 	TemplateID_PasswordReset       TemplateID = "password_reset"
 	TemplateID_VerificationCode    TemplateID = "verification_code"
 	TemplateID_ApplicationRejected TemplateID = "application_rejected"
+	TemplateID_AdminAlert          TemplateID = "admin_alert"
+	TemplateID_TimesheetReminder   TemplateID = "timesheet_reminder"
+	TemplateID_Payslip             TemplateID = "payslip"
+	TemplateID_PayPeriodSummary    TemplateID = "pay_period_summary"
+	TemplateID_InterviewInvitation TemplateID = "interview_invitation"
+	TemplateID_TrainingExpiry      TemplateID = "training_expiry"
 )
 
 var templateFiles = map[TemplateID]string{
@@ -31,6 +37,12 @@ var templateFiles = map[TemplateID]string{
 	TemplateID_PasswordReset:       "password_reset.html",
 	TemplateID_VerificationCode:    "verification_code.html",
 	TemplateID_ApplicationRejected: "application_rejected.html",
+	TemplateID_AdminAlert:          "admin_alert.html",
+	TemplateID_TimesheetReminder:   "timesheet_reminder.html",
+	TemplateID_Payslip:             "payslip.html",
+	TemplateID_PayPeriodSummary:    "pay_period_summary.html",
+	TemplateID_InterviewInvitation: "interview_invitation.html",
+	TemplateID_TrainingExpiry:      "training_expiry.html",
 }
 
 type ShiftEntry struct {
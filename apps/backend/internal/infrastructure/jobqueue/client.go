@@ -3,7 +3,9 @@ package jobqueue
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/jobqueue/jobs"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverdatabasesql"
 	"go.uber.org/zap"
@@ -21,9 +23,33 @@ func NewClient(db *sql.DB, logger *zap.Logger, workers *river.Workers) (*Client,
 		Queues: map[string]river.QueueConfig{
 			QueueScheduleGeneration: {MaxWorkers: 2},
 			QueueEmailNotification:  {MaxWorkers: 5},
+			QueuePayroll:            {MaxWorkers: 1},
+			QueueMaintenance:        {MaxWorkers: 1},
 		},
 		Workers: workers,
-		Logger:  newRiverLogger(logger),
+		PeriodicJobs: []*river.PeriodicJob{
+			river.NewPeriodicJob(
+				river.PeriodicInterval(PayCalendarInterval),
+				func() (river.JobArgs, *river.InsertOpts) { return jobs.PayCalendarArgs{}, nil },
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(DocumentRetentionInterval),
+				func() (river.JobArgs, *river.InsertOpts) { return jobs.DocumentRetentionArgs{}, nil },
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(TrainingReminderInterval),
+				func() (river.JobArgs, *river.InsertOpts) { return jobs.TrainingReminderArgs{}, nil },
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(TimesheetReminderInterval),
+				func() (river.JobArgs, *river.InsertOpts) { return jobs.TimesheetReminderArgs{}, nil },
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		},
+		Logger: newRiverLogger(logger),
 	})
 	if err != nil {
 		return nil, err
@@ -51,4 +77,23 @@ func (c *Client) River() *river.Client[*sql.Tx] {
 const (
 	QueueScheduleGeneration = "schedule_generation"
 	QueueEmailNotification  = "email_notification"
+	QueuePayroll            = "payroll"
+	QueueMaintenance        = "maintenance"
 )
+
+// PayCalendarInterval is how often the pay calendar job checks for closed
+// periods.
+const PayCalendarInterval = time.Hour
+
+// DocumentRetentionInterval is how often expired student documents are
+// purged.
+const DocumentRetentionInterval = time.Hour
+
+// TrainingReminderInterval is how often training completions nearing expiry
+// are checked. Each completion is reminded only once.
+const TrainingReminderInterval = time.Hour
+
+// TimesheetReminderInterval is how often students are checked for a missing
+// timesheet for the week that just ended. Each student is reminded once per
+// week.
+const TimesheetReminderInterval = time.Hour
@@ -4,15 +4,21 @@ import (
 	"context"
 	"database/sql"
 
+	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
 	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/jobqueue/jobs"
 	"github.com/google/uuid"
 	"github.com/riverqueue/river"
 )
 
-// Verify Enqueuer implements ScheduleJobEnqueuer at compile time.
-var _ service.ScheduleJobEnqueuer = (*Enqueuer)(nil)
+// Verify Enqueuer implements the domain enqueuer interfaces at compile time.
+var (
+	_ service.ScheduleJobEnqueuer                = (*Enqueuer)(nil)
+	_ payrollService.PayslipJobEnqueuer          = (*Enqueuer)(nil)
+	_ studentService.InterviewInvitationEnqueuer = (*Enqueuer)(nil)
+)
 
 // Enqueuer provides methods to enqueue jobs. It wraps the River client
 // and exposes domain-friendly methods.
@@ -32,10 +38,23 @@ func (e *Enqueuer) EnqueueScheduleGeneration(ctx context.Context, args service.S
 		EffectiveTo:    args.EffectiveTo,
 		CreatedBy:      args.CreatedBy,
 		RequestPayload: args.RequestPayload,
+		Overrides:      scheduleOverrideArgs(args.Overrides),
 	}, nil)
 	return err
 }
 
+func scheduleOverrideArgs(overrides []service.ScheduleOverrideRequest) []jobs.ScheduleOverrideArgs {
+	args := make([]jobs.ScheduleOverrideArgs, len(overrides))
+	for i, o := range overrides {
+		args[i] = jobs.ScheduleOverrideArgs{
+			From:           o.From,
+			To:             o.To,
+			RequestPayload: o.RequestPayload,
+		}
+	}
+	return args
+}
+
 // EnqueueEmailNotification splits emails into batches of 100 and enqueues
 // one job per batch. This ensures retries only resend the failed batch.
 func (e *Enqueuer) EnqueueEmailNotification(ctx context.Context, scheduleID uuid.UUID, emails emailDtos.SendEmailBulkRequest) error {
@@ -56,3 +75,13 @@ func (e *Enqueuer) EnqueueEmailNotification(ctx context.Context, scheduleID uuid
 	}
 	return nil
 }
+
+func (e *Enqueuer) EnqueuePayslipEmail(ctx context.Context, paymentID uuid.UUID) error {
+	_, err := e.client.Insert(ctx, jobs.PayslipEmailArgs{PaymentID: paymentID}, nil)
+	return err
+}
+
+func (e *Enqueuer) EnqueueInterviewInvitation(ctx context.Context, slotID uuid.UUID) error {
+	_, err := e.client.Insert(ctx, jobs.InterviewInvitationArgs{SlotID: slotID}, nil)
+	return err
+}
@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// DocumentRetentionArgs are the arguments for the periodic document retention
+// job. It carries no data; each run purges whatever has expired.
+type DocumentRetentionArgs struct{}
+
+func (DocumentRetentionArgs) Kind() string { return "document_retention" }
+
+func (DocumentRetentionArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "maintenance",
+		MaxAttempts: 3,
+	}
+}
+
+// DocumentRetentionWorker deletes stored student documents whose retention
+// period has ended, including transcripts never linked to an application.
+type DocumentRetentionWorker struct {
+	river.WorkerDefaults[DocumentRetentionArgs]
+	logger      *zap.Logger
+	documentSvc studentService.StudentDocumentServiceInterface
+}
+
+func NewDocumentRetentionWorker(
+	logger *zap.Logger,
+	documentSvc studentService.StudentDocumentServiceInterface,
+) *DocumentRetentionWorker {
+	return &DocumentRetentionWorker{
+		logger:      logger.Named("document_retention_worker"),
+		documentSvc: documentSvc,
+	}
+}
+
+func (w *DocumentRetentionWorker) Work(ctx context.Context, job *river.Job[DocumentRetentionArgs]) error {
+	purged, err := w.documentSvc.PurgeExpired(ctx)
+	if purged > 0 {
+		w.logger.Info("expired documents purged", zap.Int("count", purged))
+	}
+	if err != nil {
+		w.logger.Error("failed to purge expired documents", zap.Error(err))
+		return fmt.Errorf("failed to purge expired documents: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// InterviewInvitationArgs are the arguments for an interview invitation job.
+// It is queued once the booking has committed, so a rolled-back booking never
+// emails the applicant.
+type InterviewInvitationArgs struct {
+	SlotID uuid.UUID `json:"slot_id"`
+}
+
+func (InterviewInvitationArgs) Kind() string { return "interview_invitation" }
+
+func (InterviewInvitationArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "email_notification",
+		MaxAttempts: 5,
+	}
+}
+
+// InterviewInvitationWorker emails an applicant the details of their booked
+// interview slot.
+type InterviewInvitationWorker struct {
+	river.WorkerDefaults[InterviewInvitationArgs]
+	logger    *zap.Logger
+	reviewSvc studentService.ApplicationReviewServiceInterface
+}
+
+func NewInterviewInvitationWorker(
+	logger *zap.Logger,
+	reviewSvc studentService.ApplicationReviewServiceInterface,
+) *InterviewInvitationWorker {
+	return &InterviewInvitationWorker{
+		logger:    logger.Named("interview_invitation_worker"),
+		reviewSvc: reviewSvc,
+	}
+}
+
+func (w *InterviewInvitationWorker) Work(ctx context.Context, job *river.Job[InterviewInvitationArgs]) error {
+	log := w.logger.With(zap.String("slot_id", job.Args.SlotID.String()))
+
+	if err := w.reviewSvc.SendInterviewInvitation(ctx, job.Args.SlotID); err != nil {
+		log.Error("failed to send interview invitation", zap.Error(err))
+		return fmt.Errorf("failed to send interview invitation for slot %s: %w", job.Args.SlotID, err)
+	}
+
+	log.Info("interview invitation processed")
+	return nil
+}
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// PayCalendarArgs are the arguments for the periodic pay calendar job. It
+// carries no data; each run works out which periods have closed.
+type PayCalendarArgs struct{}
+
+func (PayCalendarArgs) Kind() string { return "pay_calendar" }
+
+func (PayCalendarArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "payroll",
+		MaxAttempts: 3,
+	}
+}
+
+// PayCalendarWorker generates draft payments for closed pay calendar periods
+// and emails admins when they are ready for review.
+type PayCalendarWorker struct {
+	river.WorkerDefaults[PayCalendarArgs]
+	logger         *zap.Logger
+	payCalendarSvc payrollService.PayCalendarServiceInterface
+}
+
+func NewPayCalendarWorker(
+	logger *zap.Logger,
+	payCalendarSvc payrollService.PayCalendarServiceInterface,
+) *PayCalendarWorker {
+	return &PayCalendarWorker{
+		logger:         logger.Named("pay_calendar_worker"),
+		payCalendarSvc: payCalendarSvc,
+	}
+}
+
+func (w *PayCalendarWorker) Work(ctx context.Context, job *river.Job[PayCalendarArgs]) error {
+	periods, err := w.payCalendarSvc.GenerateDuePeriods(ctx)
+	if err != nil {
+		w.logger.Error("failed to generate pay calendar periods", zap.Error(err))
+		return fmt.Errorf("failed to generate pay calendar periods: %w", err)
+	}
+
+	for _, p := range periods {
+		w.logger.Info("pay period recorded",
+			zap.String("period_start", p.PeriodStart.Format("2006-01-02")),
+			zap.String("period_end", p.PeriodEnd.Format("2006-01-02")),
+			zap.String("status", string(p.Status)),
+			zap.Int32("payments", p.PaymentCount),
+		)
+	}
+	return nil
+}
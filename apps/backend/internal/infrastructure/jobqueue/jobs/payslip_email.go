@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// PayslipEmailArgs are the arguments for a payslip email job. The payslip is
+// rendered when the job runs so it reflects the payment at send time.
+type PayslipEmailArgs struct {
+	PaymentID uuid.UUID `json:"payment_id"`
+}
+
+func (PayslipEmailArgs) Kind() string { return "payslip_email" }
+
+func (PayslipEmailArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "email_notification",
+		MaxAttempts: 5,
+	}
+}
+
+// PayslipEmailWorker emails a student the payslip for a processed payment.
+type PayslipEmailWorker struct {
+	river.WorkerDefaults[PayslipEmailArgs]
+	logger     *zap.Logger
+	payslipSvc payrollService.PayslipServiceInterface
+}
+
+func NewPayslipEmailWorker(
+	logger *zap.Logger,
+	payslipSvc payrollService.PayslipServiceInterface,
+) *PayslipEmailWorker {
+	return &PayslipEmailWorker{
+		logger:     logger.Named("payslip_email_worker"),
+		payslipSvc: payslipSvc,
+	}
+}
+
+func (w *PayslipEmailWorker) Work(ctx context.Context, job *river.Job[PayslipEmailArgs]) error {
+	log := w.logger.With(zap.String("payment_id", job.Args.PaymentID.String()))
+
+	if err := w.payslipSvc.SendPayslipEmail(ctx, job.Args.PaymentID); err != nil {
+		log.Error("failed to send payslip email", zap.Error(err))
+		return fmt.Errorf("failed to send payslip email for payment %s: %w", job.Args.PaymentID, err)
+	}
+
+	log.Info("payslip email sent")
+	return nil
+}
@@ -28,6 +28,15 @@ type ScheduleGenerationArgs struct {
 	EffectiveTo    *string                       `json:"effective_to,omitempty"`
 	CreatedBy      uuid.UUID                     `json:"created_by"`
 	RequestPayload types.GenerateScheduleRequest `json:"request_payload"`
+	Overrides      []ScheduleOverrideArgs        `json:"overrides,omitempty"`
+}
+
+// ScheduleOverrideArgs is a request solved for the dates From to To, whose
+// assignments replace the weekly ones on those dates.
+type ScheduleOverrideArgs struct {
+	From           string                        `json:"from"`
+	To             string                        `json:"to"`
+	RequestPayload types.GenerateScheduleRequest `json:"request_payload"`
 }
 
 func (ScheduleGenerationArgs) Kind() string { return "schedule_generation" }
@@ -80,42 +89,34 @@ func (w *ScheduleGenerationWorker) Work(ctx context.Context, job *river.Job[Sche
 	}
 
 	// Call the Python scheduler (the slow part)
-	response, err := w.schedulerSvc.GenerateSchedule(args.RequestPayload)
-	if err != nil {
-		log.Error("scheduler failed", zap.Error(err))
-
-		if errors.Is(err, schedulerErrors.ErrSchedulerUnavailable) {
-			// Transient error — let River retry, unless this is the last attempt
-			if job.Attempt >= job.MaxAttempts {
-				w.markFailed(ctx, args.GenerationID, fmt.Sprintf("scheduler unavailable after %d attempts: %v", job.Attempt, err))
-				return nil
-			}
-			return err
-		}
-
-		w.markFailed(ctx, args.GenerationID, err.Error())
-		return nil
+	response, responsePayload, err := w.solve(ctx, job, args.RequestPayload, "")
+	if response == nil {
+		return err
 	}
 
-	// Marshal response for audit trail
-	responsePayload, err := json.Marshal(response)
+	assignmentsBytes, err := json.Marshal(response.Assignments)
 	if err != nil {
-		log.Error("failed to marshal response payload", zap.Error(err))
-		w.markFailed(ctx, args.GenerationID, fmt.Sprintf("failed to marshal response: %v", err))
+		log.Error("failed to marshal assignments", zap.Error(err))
+		w.markFailed(ctx, args.GenerationID, fmt.Sprintf("failed to marshal assignments: %v", err))
 		return nil
 	}
 
-	// Check for infeasible result
-	if response.Status == types.ScheduleStatus_Infeasible {
-		w.markInfeasible(ctx, args.GenerationID, string(responsePayload),
-			fmt.Sprintf("solver returned status %s", response.Status))
-		return nil
+	// Solve the days on which approved time off changes who can work
+	overrides := make([]aggregate.AssignmentOverride, len(args.Overrides))
+	for i, o := range args.Overrides {
+		overrideResponse, _, err := w.solve(ctx, job, o.RequestPayload, fmt.Sprintf(" for %s to %s", o.From, o.To))
+		if overrideResponse == nil {
+			return err
+		}
+		overrides[i] = aggregate.AssignmentOverride{From: o.From, To: o.To, Assignments: make([]aggregate.Assignment, len(overrideResponse.Assignments))}
+		for j, a := range overrideResponse.Assignments {
+			overrides[i].Assignments[j] = aggregate.Assignment(a)
+		}
 	}
-
-	assignmentsBytes, err := json.Marshal(response.Assignments)
+	overridesBytes, err := json.Marshal(overrides)
 	if err != nil {
-		log.Error("failed to marshal assignments", zap.Error(err))
-		w.markFailed(ctx, args.GenerationID, fmt.Sprintf("failed to marshal assignments: %v", err))
+		log.Error("failed to marshal assignment overrides", zap.Error(err))
+		w.markFailed(ctx, args.GenerationID, fmt.Sprintf("failed to marshal assignment overrides: %v", err))
 		return nil
 	}
 
@@ -151,6 +152,7 @@ func (w *ScheduleGenerationWorker) Work(ctx context.Context, job *river.Job[Sche
 	}
 	schedule.CreatedBy = args.CreatedBy
 	schedule.Assignments = assignmentsBytes
+	schedule.AssignmentOverrides = overridesBytes
 	schedule.GenerationID = &args.GenerationID
 	schedule.SchedulerMetadata = &schedulerMetadata
 
@@ -168,7 +170,7 @@ func (w *ScheduleGenerationWorker) Work(ctx context.Context, job *river.Job[Sche
 		if txErr != nil {
 			return txErr
 		}
-		if txErr = generation.MarkCompleted(result.ScheduleID, string(responsePayload)); txErr != nil {
+		if txErr = generation.MarkCompleted(result.ScheduleID, responsePayload); txErr != nil {
 			return txErr
 		}
 		return w.generationRepo.Update(ctx, tx, generation)
@@ -186,6 +188,49 @@ func (w *ScheduleGenerationWorker) Work(ctx context.Context, job *river.Job[Sche
 	return nil
 }
 
+// solve runs one request through the scheduler and returns its response and
+// the response as JSON. Failures are recorded on the generation and return a
+// nil response; err is set only when River should retry the job. scope names
+// the dates being solved in failure messages.
+func (w *ScheduleGenerationWorker) solve(ctx context.Context, job *river.Job[ScheduleGenerationArgs], req types.GenerateScheduleRequest, scope string) (*types.GenerateScheduleResponse, string, error) {
+	generationID := job.Args.GenerationID
+	log := w.logger.With(zap.String("generation_id", generationID.String()))
+
+	response, err := w.schedulerSvc.GenerateSchedule(req)
+	if err != nil {
+		log.Error("scheduler failed", zap.Error(err))
+
+		if errors.Is(err, schedulerErrors.ErrSchedulerUnavailable) {
+			// Transient error — let River retry, unless this is the last attempt
+			if job.Attempt >= job.MaxAttempts {
+				w.markFailed(ctx, generationID, fmt.Sprintf("scheduler unavailable after %d attempts: %v", job.Attempt, err))
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		w.markFailed(ctx, generationID, err.Error())
+		return nil, "", nil
+	}
+
+	// Marshal response for audit trail
+	responsePayload, err := json.Marshal(response)
+	if err != nil {
+		log.Error("failed to marshal response payload", zap.Error(err))
+		w.markFailed(ctx, generationID, fmt.Sprintf("failed to marshal response: %v", err))
+		return nil, "", nil
+	}
+
+	// Check for infeasible result
+	if response.Status == types.ScheduleStatus_Infeasible {
+		w.markInfeasible(ctx, generationID, string(responsePayload),
+			fmt.Sprintf("solver returned status %s%s", response.Status, scope))
+		return nil, "", nil
+	}
+
+	return response, string(responsePayload), nil
+}
+
 func (w *ScheduleGenerationWorker) markFailed(ctx context.Context, generationID uuid.UUID, msg string) {
 	if err := w.generationSvc.MarkFailed(ctx, generationID, msg); err != nil {
 		w.logger.Error("failed to mark generation as failed",
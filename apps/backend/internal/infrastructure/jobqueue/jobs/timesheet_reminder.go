@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	timesheetService "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// TimesheetReminderArgs are the arguments for the periodic timesheet
+// reminder job. It carries no data; each run reminds whoever is due.
+type TimesheetReminderArgs struct{}
+
+func (TimesheetReminderArgs) Kind() string { return "timesheet_reminder" }
+
+func (TimesheetReminderArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "maintenance",
+		MaxAttempts: 3,
+	}
+}
+
+// TimesheetReminderWorker emails students who have not submitted a timesheet
+// for the week that just ended. The admin reminders endpoint remains as a
+// manual trigger.
+type TimesheetReminderWorker struct {
+	river.WorkerDefaults[TimesheetReminderArgs]
+	logger       *zap.Logger
+	timesheetSvc timesheetService.TimesheetServiceInterface
+}
+
+func NewTimesheetReminderWorker(
+	logger *zap.Logger,
+	timesheetSvc timesheetService.TimesheetServiceInterface,
+) *TimesheetReminderWorker {
+	return &TimesheetReminderWorker{
+		logger:       logger.Named("timesheet_reminder_worker"),
+		timesheetSvc: timesheetSvc,
+	}
+}
+
+func (w *TimesheetReminderWorker) Work(ctx context.Context, job *river.Job[TimesheetReminderArgs]) error {
+	sent, err := w.timesheetSvc.SendDueReminders(ctx)
+	if sent > 0 {
+		w.logger.Info("timesheet reminders sent", zap.Int("count", sent))
+	}
+	if err != nil {
+		w.logger.Error("failed to send timesheet reminders", zap.Error(err))
+		return fmt.Errorf("failed to send timesheet reminders: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	studentService "github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/riverqueue/river"
+	"go.uber.org/zap"
+)
+
+// TrainingReminderArgs are the arguments for the periodic training reminder
+// job. It carries no data; each run reminds whoever is due.
+type TrainingReminderArgs struct{}
+
+func (TrainingReminderArgs) Kind() string { return "training_reminder" }
+
+func (TrainingReminderArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       "maintenance",
+		MaxAttempts: 3,
+	}
+}
+
+// TrainingReminderWorker emails students whose training completions are about
+// to expire.
+type TrainingReminderWorker struct {
+	river.WorkerDefaults[TrainingReminderArgs]
+	logger      *zap.Logger
+	trainingSvc studentService.TrainingServiceInterface
+}
+
+func NewTrainingReminderWorker(
+	logger *zap.Logger,
+	trainingSvc studentService.TrainingServiceInterface,
+) *TrainingReminderWorker {
+	return &TrainingReminderWorker{
+		logger:      logger.Named("training_reminder_worker"),
+		trainingSvc: trainingSvc,
+	}
+}
+
+func (w *TrainingReminderWorker) Work(ctx context.Context, job *river.Job[TrainingReminderArgs]) error {
+	sent, err := w.trainingSvc.SendExpiryReminders(ctx)
+	if sent > 0 {
+		w.logger.Info("training expiry reminders sent", zap.Int("count", sent))
+	}
+	if err != nil {
+		w.logger.Error("failed to send training expiry reminders", zap.Error(err))
+		return fmt.Errorf("failed to send training expiry reminders: %w", err)
+	}
+	return nil
+}
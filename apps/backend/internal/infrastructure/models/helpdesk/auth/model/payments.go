@@ -14,13 +14,15 @@ import (
 )
 
 type Payments struct {
-	PaymentID   uuid.UUID `sql:"primary_key"`
-	StudentID   int32
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	HoursWorked float64
-	GrossAmount float64
-	ProcessedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   *time.Time
+	PaymentID        uuid.UUID `sql:"primary_key"`
+	StudentID        int32
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	HoursWorked      float64
+	GrossAmount      float64
+	ProcessedAt      *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        *time.Time
+	DeductionsAmount float64
+	NetAmount        float64
 }
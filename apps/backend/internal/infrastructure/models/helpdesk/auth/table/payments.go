@@ -17,15 +17,17 @@ type paymentsTable struct {
 	postgres.Table
 
 	// Columns
-	PaymentID   postgres.ColumnString
-	StudentID   postgres.ColumnInteger
-	PeriodStart postgres.ColumnDate
-	PeriodEnd   postgres.ColumnDate
-	HoursWorked postgres.ColumnFloat
-	GrossAmount postgres.ColumnFloat
-	ProcessedAt postgres.ColumnTimestampz
-	CreatedAt   postgres.ColumnTimestampz
-	UpdatedAt   postgres.ColumnTimestampz
+	PaymentID        postgres.ColumnString
+	StudentID        postgres.ColumnInteger
+	PeriodStart      postgres.ColumnDate
+	PeriodEnd        postgres.ColumnDate
+	HoursWorked      postgres.ColumnFloat
+	GrossAmount      postgres.ColumnFloat
+	ProcessedAt      postgres.ColumnTimestampz
+	CreatedAt        postgres.ColumnTimestampz
+	UpdatedAt        postgres.ColumnTimestampz
+	DeductionsAmount postgres.ColumnFloat
+	NetAmount        postgres.ColumnFloat
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -67,33 +69,37 @@ func newPaymentsTable(schemaName, tableName, alias string) *PaymentsTable {
 
 func newPaymentsTableImpl(schemaName, tableName, alias string) paymentsTable {
 	var (
-		PaymentIDColumn   = postgres.StringColumn("payment_id")
-		StudentIDColumn   = postgres.IntegerColumn("student_id")
-		PeriodStartColumn = postgres.DateColumn("period_start")
-		PeriodEndColumn   = postgres.DateColumn("period_end")
-		HoursWorkedColumn = postgres.FloatColumn("hours_worked")
-		GrossAmountColumn = postgres.FloatColumn("gross_amount")
-		ProcessedAtColumn = postgres.TimestampzColumn("processed_at")
-		CreatedAtColumn   = postgres.TimestampzColumn("created_at")
-		UpdatedAtColumn   = postgres.TimestampzColumn("updated_at")
-		allColumns        = postgres.ColumnList{PaymentIDColumn, StudentIDColumn, PeriodStartColumn, PeriodEndColumn, HoursWorkedColumn, GrossAmountColumn, ProcessedAtColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns    = postgres.ColumnList{StudentIDColumn, PeriodStartColumn, PeriodEndColumn, HoursWorkedColumn, GrossAmountColumn, ProcessedAtColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns    = postgres.ColumnList{PaymentIDColumn, CreatedAtColumn}
+		PaymentIDColumn        = postgres.StringColumn("payment_id")
+		StudentIDColumn        = postgres.IntegerColumn("student_id")
+		PeriodStartColumn      = postgres.DateColumn("period_start")
+		PeriodEndColumn        = postgres.DateColumn("period_end")
+		HoursWorkedColumn      = postgres.FloatColumn("hours_worked")
+		GrossAmountColumn      = postgres.FloatColumn("gross_amount")
+		ProcessedAtColumn      = postgres.TimestampzColumn("processed_at")
+		CreatedAtColumn        = postgres.TimestampzColumn("created_at")
+		UpdatedAtColumn        = postgres.TimestampzColumn("updated_at")
+		DeductionsAmountColumn = postgres.FloatColumn("deductions_amount")
+		NetAmountColumn        = postgres.FloatColumn("net_amount")
+		allColumns             = postgres.ColumnList{PaymentIDColumn, StudentIDColumn, PeriodStartColumn, PeriodEndColumn, HoursWorkedColumn, GrossAmountColumn, ProcessedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeductionsAmountColumn, NetAmountColumn}
+		mutableColumns         = postgres.ColumnList{StudentIDColumn, PeriodStartColumn, PeriodEndColumn, HoursWorkedColumn, GrossAmountColumn, ProcessedAtColumn, CreatedAtColumn, UpdatedAtColumn, DeductionsAmountColumn, NetAmountColumn}
+		defaultColumns         = postgres.ColumnList{PaymentIDColumn, CreatedAtColumn, DeductionsAmountColumn}
 	)
 
 	return paymentsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		PaymentID:   PaymentIDColumn,
-		StudentID:   StudentIDColumn,
-		PeriodStart: PeriodStartColumn,
-		PeriodEnd:   PeriodEndColumn,
-		HoursWorked: HoursWorkedColumn,
-		GrossAmount: GrossAmountColumn,
-		ProcessedAt: ProcessedAtColumn,
-		CreatedAt:   CreatedAtColumn,
-		UpdatedAt:   UpdatedAtColumn,
+		PaymentID:        PaymentIDColumn,
+		StudentID:        StudentIDColumn,
+		PeriodStart:      PeriodStartColumn,
+		PeriodEnd:        PeriodEndColumn,
+		HoursWorked:      HoursWorkedColumn,
+		GrossAmount:      GrossAmountColumn,
+		ProcessedAt:      ProcessedAtColumn,
+		CreatedAt:        CreatedAtColumn,
+		UpdatedAt:        UpdatedAtColumn,
+		DeductionsAmount: DeductionsAmountColumn,
+		NetAmount:        NetAmountColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -10,11 +10,31 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	ApplicationNotes = ApplicationNotes.FromSchema(schema)
+	ApplicationReviewers = ApplicationReviewers.FromSchema(schema)
+	ApplicationScores = ApplicationScores.FromSchema(schema)
+	ApplicationStageHistory = ApplicationStageHistory.FromSchema(schema)
 	AuthTokens = AuthTokens.FromSchema(schema)
 	BankingDetails = BankingDetails.FromSchema(schema)
+	CourseEligibilityOverrides = CourseEligibilityOverrides.FromSchema(schema)
+	CourseEligibilityRules = CourseEligibilityRules.FromSchema(schema)
+	DisbursementFilePayments = DisbursementFilePayments.FromSchema(schema)
+	DisbursementFiles = DisbursementFiles.FromSchema(schema)
+	InterviewSlots = InterviewSlots.FromSchema(schema)
+	PayCalendars = PayCalendars.FromSchema(schema)
+	PayPeriods = PayPeriods.FromSchema(schema)
+	PaymentAdjustments = PaymentAdjustments.FromSchema(schema)
 	Payments = Payments.FromSchema(schema)
+	ProfileChangeRequests = ProfileChangeRequests.FromSchema(schema)
 	RefreshTokens = RefreshTokens.FromSchema(schema)
+	ReviewCriteria = ReviewCriteria.FromSchema(schema)
+	ReviewStages = ReviewStages.FromSchema(schema)
 	StudentBankingConsent = StudentBankingConsent.FromSchema(schema)
+	StudentDocuments = StudentDocuments.FromSchema(schema)
+	StudentTimetables = StudentTimetables.FromSchema(schema)
 	Students = Students.FromSchema(schema)
+	TrainingRecords = TrainingRecords.FromSchema(schema)
+	TrainingRequirements = TrainingRequirements.FromSchema(schema)
+	TranscriptSubmissions = TranscriptSubmissions.FromSchema(schema)
 	Users = Users.FromSchema(schema)
 }
@@ -27,4 +27,5 @@ type Schedules struct {
 	EffectiveTo          *time.Time
 	GenerationID         *uuid.UUID
 	SchedulerMetadata    *string // Optimizer results: {objective_value, assistant_hours, shortfalls, solver_status}
+	AssignmentOverrides  string  // Dated replacements for the weekly assignments: [{from, to, assignments}]
 }
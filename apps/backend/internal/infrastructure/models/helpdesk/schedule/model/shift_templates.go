@@ -26,4 +26,5 @@ type ShiftTemplates struct {
 	IsActive      bool
 	CreatedAt     time.Time
 	UpdatedAt     *time.Time
+	LocationID    *uuid.UUID
 }
@@ -14,14 +14,24 @@ import (
 )
 
 type TimeLogs struct {
-	ID             uuid.UUID `sql:"primary_key"`
-	StudentID      int32
-	EntryAt        time.Time
-	ExitAt         *time.Time
-	CreatedAt      time.Time
-	Longitude      float64
-	Latitude       float64
-	DistanceMeters float64 // A pre-calculated distance based on the longitude and latitude to be later used to flag suspicious entries.
-	IsFlagged      bool
-	FlagReason     *string
+	ID                uuid.UUID `sql:"primary_key"`
+	StudentID         int32
+	EntryAt           time.Time
+	ExitAt            *time.Time
+	CreatedAt         time.Time
+	Longitude         float64
+	Latitude          float64
+	DistanceMeters    float64 // A pre-calculated distance based on the longitude and latitude to be later used to flag suspicious entries.
+	IsFlagged         bool
+	FlagReason        *string
+	LocationID        *uuid.UUID
+	ScheduledStart    *time.Time
+	ScheduledEnd      *time.Time
+	PayRuleID         *uuid.UUID
+	PaidMinutes       *float64
+	DeviceFingerprint *string
+	IPAddress         *string
+	UserAgent         *string
+	GpsAccuracyMeters *float64
+	FlagCodes         *string
 }
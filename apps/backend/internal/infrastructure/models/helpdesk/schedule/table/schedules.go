@@ -30,6 +30,7 @@ type schedulesTable struct {
 	EffectiveTo          postgres.ColumnDate
 	GenerationID         postgres.ColumnString
 	SchedulerMetadata    postgres.ColumnString // Optimizer results: {objective_value, assistant_hours, shortfalls, solver_status}
+	AssignmentOverrides  postgres.ColumnString // Dated replacements for the weekly assignments: [{from, to, assignments}]
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -84,9 +85,10 @@ func newSchedulesTableImpl(schemaName, tableName, alias string) schedulesTable {
 		EffectiveToColumn          = postgres.DateColumn("effective_to")
 		GenerationIDColumn         = postgres.StringColumn("generation_id")
 		SchedulerMetadataColumn    = postgres.StringColumn("scheduler_metadata")
-		allColumns                 = postgres.ColumnList{ScheduleIDColumn, TitleColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn, CreatedByColumn, UpdatedAtColumn, ArchivedAtColumn, EffectiveFromColumn, EffectiveToColumn, GenerationIDColumn, SchedulerMetadataColumn}
-		mutableColumns             = postgres.ColumnList{TitleColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn, CreatedByColumn, UpdatedAtColumn, ArchivedAtColumn, EffectiveFromColumn, EffectiveToColumn, GenerationIDColumn, SchedulerMetadataColumn}
-		defaultColumns             = postgres.ColumnList{ScheduleIDColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn}
+		AssignmentOverridesColumn  = postgres.StringColumn("assignment_overrides")
+		allColumns                 = postgres.ColumnList{ScheduleIDColumn, TitleColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn, CreatedByColumn, UpdatedAtColumn, ArchivedAtColumn, EffectiveFromColumn, EffectiveToColumn, GenerationIDColumn, SchedulerMetadataColumn, AssignmentOverridesColumn}
+		mutableColumns             = postgres.ColumnList{TitleColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn, CreatedByColumn, UpdatedAtColumn, ArchivedAtColumn, EffectiveFromColumn, EffectiveToColumn, GenerationIDColumn, SchedulerMetadataColumn, AssignmentOverridesColumn}
+		defaultColumns             = postgres.ColumnList{ScheduleIDColumn, IsActiveColumn, AssignmentsColumn, AvailabilityMetadataColumn, CreatedAtColumn, AssignmentOverridesColumn}
 	)
 
 	return schedulesTable{
@@ -106,6 +108,7 @@ func newSchedulesTableImpl(schemaName, tableName, alias string) schedulesTable {
 		EffectiveTo:          EffectiveToColumn,
 		GenerationID:         GenerationIDColumn,
 		SchedulerMetadata:    SchedulerMetadataColumn,
+		AssignmentOverrides:  AssignmentOverridesColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
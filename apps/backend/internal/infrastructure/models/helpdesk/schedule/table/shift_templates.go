@@ -29,6 +29,7 @@ type shiftTemplatesTable struct {
 	IsActive      postgres.ColumnBool
 	CreatedAt     postgres.ColumnTimestampz
 	UpdatedAt     postgres.ColumnTimestampz
+	LocationID    postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -81,8 +82,9 @@ func newShiftTemplatesTableImpl(schemaName, tableName, alias string) shiftTempla
 		IsActiveColumn      = postgres.BoolColumn("is_active")
 		CreatedAtColumn     = postgres.TimestampzColumn("created_at")
 		UpdatedAtColumn     = postgres.TimestampzColumn("updated_at")
-		allColumns          = postgres.ColumnList{IDColumn, NameColumn, DayOfWeekColumn, StartTimeColumn, EndTimeColumn, MinStaffColumn, MaxStaffColumn, CourseDemandsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns      = postgres.ColumnList{NameColumn, DayOfWeekColumn, StartTimeColumn, EndTimeColumn, MinStaffColumn, MaxStaffColumn, CourseDemandsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn}
+		LocationIDColumn    = postgres.StringColumn("location_id")
+		allColumns          = postgres.ColumnList{IDColumn, NameColumn, DayOfWeekColumn, StartTimeColumn, EndTimeColumn, MinStaffColumn, MaxStaffColumn, CourseDemandsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn, LocationIDColumn}
+		mutableColumns      = postgres.ColumnList{NameColumn, DayOfWeekColumn, StartTimeColumn, EndTimeColumn, MinStaffColumn, MaxStaffColumn, CourseDemandsColumn, IsActiveColumn, CreatedAtColumn, UpdatedAtColumn, LocationIDColumn}
 		defaultColumns      = postgres.ColumnList{IDColumn, MinStaffColumn, MaxStaffColumn, CourseDemandsColumn, IsActiveColumn, CreatedAtColumn}
 	)
 
@@ -101,6 +103,7 @@ func newShiftTemplatesTableImpl(schemaName, tableName, alias string) shiftTempla
 		IsActive:      IsActiveColumn,
 		CreatedAt:     CreatedAtColumn,
 		UpdatedAt:     UpdatedAtColumn,
+		LocationID:    LocationIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
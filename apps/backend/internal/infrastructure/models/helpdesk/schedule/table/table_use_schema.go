@@ -10,9 +10,21 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	Budgets = Budgets.FromSchema(schema)
+	ClockInAttempts = ClockInAttempts.FromSchema(schema)
+	ClockInCodes = ClockInCodes.FromSchema(schema)
+	ClockInLockouts = ClockInLockouts.FromSchema(schema)
+	Courses = Courses.FromSchema(schema)
+	Kiosks = Kiosks.FromSchema(schema)
+	Locations = Locations.FromSchema(schema)
+	PayRules = PayRules.FromSchema(schema)
 	ScheduleGenerations = ScheduleGenerations.FromSchema(schema)
 	SchedulerConfigs = SchedulerConfigs.FromSchema(schema)
 	Schedules = Schedules.FromSchema(schema)
 	ShiftTemplates = ShiftTemplates.FromSchema(schema)
+	TimeLogBreaks = TimeLogBreaks.FromSchema(schema)
 	TimeLogs = TimeLogs.FromSchema(schema)
+	TimeOffRequests = TimeOffRequests.FromSchema(schema)
+	TimesheetReminders = TimesheetReminders.FromSchema(schema)
+	Timesheets = Timesheets.FromSchema(schema)
 }
@@ -17,16 +17,26 @@ type timeLogsTable struct {
 	postgres.Table
 
 	// Columns
-	ID             postgres.ColumnString
-	StudentID      postgres.ColumnInteger
-	EntryAt        postgres.ColumnTimestampz
-	ExitAt         postgres.ColumnTimestampz
-	CreatedAt      postgres.ColumnTimestampz
-	Longitude      postgres.ColumnFloat
-	Latitude       postgres.ColumnFloat
-	DistanceMeters postgres.ColumnFloat // A pre-calculated distance based on the longitude and latitude to be later used to flag suspicious entries.
-	IsFlagged      postgres.ColumnBool
-	FlagReason     postgres.ColumnString
+	ID                postgres.ColumnString
+	StudentID         postgres.ColumnInteger
+	EntryAt           postgres.ColumnTimestampz
+	ExitAt            postgres.ColumnTimestampz
+	CreatedAt         postgres.ColumnTimestampz
+	Longitude         postgres.ColumnFloat
+	Latitude          postgres.ColumnFloat
+	DistanceMeters    postgres.ColumnFloat // A pre-calculated distance based on the longitude and latitude to be later used to flag suspicious entries.
+	IsFlagged         postgres.ColumnBool
+	FlagReason        postgres.ColumnString
+	LocationID        postgres.ColumnString
+	ScheduledStart    postgres.ColumnTimestampz
+	ScheduledEnd      postgres.ColumnTimestampz
+	PayRuleID         postgres.ColumnString
+	PaidMinutes       postgres.ColumnFloat
+	DeviceFingerprint postgres.ColumnString
+	IPAddress         postgres.ColumnString
+	UserAgent         postgres.ColumnString
+	GpsAccuracyMeters postgres.ColumnFloat
+	FlagCodes         postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -68,35 +78,55 @@ func newTimeLogsTable(schemaName, tableName, alias string) *TimeLogsTable {
 
 func newTimeLogsTableImpl(schemaName, tableName, alias string) timeLogsTable {
 	var (
-		IDColumn             = postgres.StringColumn("id")
-		StudentIDColumn      = postgres.IntegerColumn("student_id")
-		EntryAtColumn        = postgres.TimestampzColumn("entry_at")
-		ExitAtColumn         = postgres.TimestampzColumn("exit_at")
-		CreatedAtColumn      = postgres.TimestampzColumn("created_at")
-		LongitudeColumn      = postgres.FloatColumn("longitude")
-		LatitudeColumn       = postgres.FloatColumn("latitude")
-		DistanceMetersColumn = postgres.FloatColumn("distance_meters")
-		IsFlaggedColumn      = postgres.BoolColumn("is_flagged")
-		FlagReasonColumn     = postgres.StringColumn("flag_reason")
-		allColumns           = postgres.ColumnList{IDColumn, StudentIDColumn, EntryAtColumn, ExitAtColumn, CreatedAtColumn, LongitudeColumn, LatitudeColumn, DistanceMetersColumn, IsFlaggedColumn, FlagReasonColumn}
-		mutableColumns       = postgres.ColumnList{StudentIDColumn, EntryAtColumn, ExitAtColumn, CreatedAtColumn, LongitudeColumn, LatitudeColumn, DistanceMetersColumn, IsFlaggedColumn, FlagReasonColumn}
-		defaultColumns       = postgres.ColumnList{IDColumn, CreatedAtColumn, IsFlaggedColumn}
+		IDColumn                = postgres.StringColumn("id")
+		StudentIDColumn         = postgres.IntegerColumn("student_id")
+		EntryAtColumn           = postgres.TimestampzColumn("entry_at")
+		ExitAtColumn            = postgres.TimestampzColumn("exit_at")
+		CreatedAtColumn         = postgres.TimestampzColumn("created_at")
+		LongitudeColumn         = postgres.FloatColumn("longitude")
+		LatitudeColumn          = postgres.FloatColumn("latitude")
+		DistanceMetersColumn    = postgres.FloatColumn("distance_meters")
+		IsFlaggedColumn         = postgres.BoolColumn("is_flagged")
+		FlagReasonColumn        = postgres.StringColumn("flag_reason")
+		LocationIDColumn        = postgres.StringColumn("location_id")
+		ScheduledStartColumn    = postgres.TimestampzColumn("scheduled_start")
+		ScheduledEndColumn      = postgres.TimestampzColumn("scheduled_end")
+		PayRuleIDColumn         = postgres.StringColumn("pay_rule_id")
+		PaidMinutesColumn       = postgres.FloatColumn("paid_minutes")
+		DeviceFingerprintColumn = postgres.StringColumn("device_fingerprint")
+		IPAddressColumn         = postgres.StringColumn("ip_address")
+		UserAgentColumn         = postgres.StringColumn("user_agent")
+		GpsAccuracyMetersColumn = postgres.FloatColumn("gps_accuracy_meters")
+		FlagCodesColumn         = postgres.StringColumn("flag_codes")
+		allColumns              = postgres.ColumnList{IDColumn, StudentIDColumn, EntryAtColumn, ExitAtColumn, CreatedAtColumn, LongitudeColumn, LatitudeColumn, DistanceMetersColumn, IsFlaggedColumn, FlagReasonColumn, LocationIDColumn, ScheduledStartColumn, ScheduledEndColumn, PayRuleIDColumn, PaidMinutesColumn, DeviceFingerprintColumn, IPAddressColumn, UserAgentColumn, GpsAccuracyMetersColumn, FlagCodesColumn}
+		mutableColumns          = postgres.ColumnList{StudentIDColumn, EntryAtColumn, ExitAtColumn, CreatedAtColumn, LongitudeColumn, LatitudeColumn, DistanceMetersColumn, IsFlaggedColumn, FlagReasonColumn, LocationIDColumn, ScheduledStartColumn, ScheduledEndColumn, PayRuleIDColumn, PaidMinutesColumn, DeviceFingerprintColumn, IPAddressColumn, UserAgentColumn, GpsAccuracyMetersColumn, FlagCodesColumn}
+		defaultColumns          = postgres.ColumnList{IDColumn, CreatedAtColumn, IsFlaggedColumn}
 	)
 
 	return timeLogsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:             IDColumn,
-		StudentID:      StudentIDColumn,
-		EntryAt:        EntryAtColumn,
-		ExitAt:         ExitAtColumn,
-		CreatedAt:      CreatedAtColumn,
-		Longitude:      LongitudeColumn,
-		Latitude:       LatitudeColumn,
-		DistanceMeters: DistanceMetersColumn,
-		IsFlagged:      IsFlaggedColumn,
-		FlagReason:     FlagReasonColumn,
+		ID:                IDColumn,
+		StudentID:         StudentIDColumn,
+		EntryAt:           EntryAtColumn,
+		ExitAt:            ExitAtColumn,
+		CreatedAt:         CreatedAtColumn,
+		Longitude:         LongitudeColumn,
+		Latitude:          LatitudeColumn,
+		DistanceMeters:    DistanceMetersColumn,
+		IsFlagged:         IsFlaggedColumn,
+		FlagReason:        FlagReasonColumn,
+		LocationID:        LocationIDColumn,
+		ScheduledStart:    ScheduledStartColumn,
+		ScheduledEnd:      ScheduledEndColumn,
+		PayRuleID:         PayRuleIDColumn,
+		PaidMinutes:       PaidMinutesColumn,
+		DeviceFingerprint: DeviceFingerprintColumn,
+		IPAddress:         IPAddressColumn,
+		UserAgent:         UserAgentColumn,
+		GpsAccuracyMeters: GpsAccuracyMetersColumn,
+		FlagCodes:         FlagCodesColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
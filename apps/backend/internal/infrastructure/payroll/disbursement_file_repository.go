@@ -0,0 +1,181 @@
+package payroll
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/crypto"
+	authModel "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	authTable "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.DisbursementFileRepositoryInterface = (*DisbursementFileRepository)(nil)
+
+type DisbursementFileRepository struct {
+	logger        *zap.Logger
+	encryptionKey []byte
+}
+
+func NewDisbursementFileRepository(logger *zap.Logger, encryptionKey string) repository.DisbursementFileRepositoryInterface {
+	keyBytes, err := hex.DecodeString(encryptionKey)
+	if err != nil {
+		logger.Fatal("ENCRYPTION_KEY is not valid hex", zap.Error(err))
+	}
+	if len(keyBytes) != 32 {
+		logger.Fatal("ENCRYPTION_KEY must decode to exactly 32 bytes", zap.Int("got", len(keyBytes)))
+	}
+
+	return &DisbursementFileRepository{
+		logger:        logger,
+		encryptionKey: keyBytes,
+	}
+}
+
+func (r *DisbursementFileRepository) Create(ctx context.Context, tx *sql.Tx, file *aggregate.DisbursementFile) (*aggregate.DisbursementFile, error) {
+	encryptedContent, err := crypto.Encrypt(string(file.Content), r.encryptionKey)
+	if err != nil {
+		r.logger.Error("failed to encrypt disbursement file", zap.Error(err))
+		return nil, fmt.Errorf("failed to encrypt disbursement file: %w", err)
+	}
+	m := file.ToModel(encryptedContent)
+
+	stmt := authTable.DisbursementFiles.INSERT(
+		authTable.DisbursementFiles.ID,
+		authTable.DisbursementFiles.BankName,
+		authTable.DisbursementFiles.Format,
+		authTable.DisbursementFiles.Filename,
+		authTable.DisbursementFiles.PeriodStart,
+		authTable.DisbursementFiles.PeriodEnd,
+		authTable.DisbursementFiles.RecordCount,
+		authTable.DisbursementFiles.ControlTotal,
+		authTable.DisbursementFiles.Checksum,
+		authTable.DisbursementFiles.Content,
+		authTable.DisbursementFiles.GeneratedBy,
+	).MODEL(m).RETURNING(authTable.DisbursementFiles.AllColumns)
+
+	var result authModel.DisbursementFiles
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create disbursement file", zap.Error(err))
+		return nil, fmt.Errorf("failed to create disbursement file: %w", err)
+	}
+
+	if len(file.PaymentIDs) > 0 {
+		links := make([]authModel.DisbursementFilePayments, len(file.PaymentIDs))
+		for i, paymentID := range file.PaymentIDs {
+			links[i] = authModel.DisbursementFilePayments{DisbursementFileID: result.ID, PaymentID: paymentID}
+		}
+		insert := authTable.DisbursementFilePayments.INSERT(authTable.DisbursementFilePayments.AllColumns).MODELS(links)
+		if _, err := insert.ExecContext(ctx, tx); err != nil {
+			r.logger.Error("failed to record disbursed payments", zap.Error(err), zap.String("id", result.ID.String()))
+			return nil, fmt.Errorf("failed to record disbursed payments: %w", err)
+		}
+	}
+
+	// The caller already has the plaintext; avoid decrypting it again.
+	return aggregate.DisbursementFileFromModel(&result, file.Content, file.PaymentIDs), nil
+}
+
+func (r *DisbursementFileRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+	stmt := authTable.DisbursementFiles.
+		SELECT(authTable.DisbursementFiles.AllColumns).
+		WHERE(authTable.DisbursementFiles.ID.EQ(postgres.UUID(id)))
+
+	var result authModel.DisbursementFiles
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, payrollErrors.ErrDisbursementFileNotFound
+		}
+		r.logger.Error("failed to get disbursement file", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get disbursement file: %w", err)
+	}
+
+	content, err := crypto.Decrypt(result.Content, r.encryptionKey)
+	if err != nil {
+		r.logger.Error("failed to decrypt disbursement file", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to decrypt disbursement file: %w", err)
+	}
+
+	paymentIDs, err := r.paymentIDsByFile(ctx, tx, []uuid.UUID{result.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.DisbursementFileFromModel(&result, []byte(content), paymentIDs[result.ID]), nil
+}
+
+func (r *DisbursementFileRepository) ListByPeriod(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error) {
+	stmt := authTable.DisbursementFiles.
+		SELECT(authTable.DisbursementFiles.AllColumns.Except(authTable.DisbursementFiles.Content)).
+		WHERE(
+			authTable.DisbursementFiles.PeriodStart.EQ(postgres.DateT(periodStart)).
+				AND(authTable.DisbursementFiles.PeriodEnd.EQ(postgres.DateT(periodEnd))),
+		).
+		ORDER_BY(authTable.DisbursementFiles.CreatedAt.DESC(), authTable.DisbursementFiles.BankName.ASC())
+
+	var results []authModel.DisbursementFiles
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.DisbursementFile{}, nil
+		}
+		r.logger.Error("failed to list disbursement files", zap.Error(err))
+		return nil, fmt.Errorf("failed to list disbursement files: %w", err)
+	}
+
+	fileIDs := make([]uuid.UUID, len(results))
+	for i := range results {
+		fileIDs[i] = results[i].ID
+	}
+	paymentIDs, err := r.paymentIDsByFile(ctx, tx, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*aggregate.DisbursementFile, len(results))
+	for i := range results {
+		files[i] = aggregate.DisbursementFileFromModel(&results[i], nil, paymentIDs[results[i].ID])
+	}
+	return files, nil
+}
+
+// paymentIDsByFile returns the IDs of the payments each file pays out.
+func (r *DisbursementFileRepository) paymentIDsByFile(ctx context.Context, tx *sql.Tx, fileIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	out := make(map[uuid.UUID][]uuid.UUID, len(fileIDs))
+	if len(fileIDs) == 0 {
+		return out, nil
+	}
+
+	ids := make([]postgres.Expression, len(fileIDs))
+	for i, id := range fileIDs {
+		ids[i] = postgres.UUID(id)
+	}
+
+	stmt := authTable.DisbursementFilePayments.
+		SELECT(authTable.DisbursementFilePayments.AllColumns).
+		WHERE(authTable.DisbursementFilePayments.DisbursementFileID.IN(ids...)).
+		ORDER_BY(authTable.DisbursementFilePayments.PaymentID.ASC())
+
+	var results []authModel.DisbursementFilePayments
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return out, nil
+		}
+		r.logger.Error("failed to list disbursed payments", zap.Error(err))
+		return nil, fmt.Errorf("failed to list disbursed payments: %w", err)
+	}
+
+	for _, m := range results {
+		out[m.DisbursementFileID] = append(out[m.DisbursementFileID], m.PaymentID)
+	}
+	return out, nil
+}
@@ -0,0 +1,186 @@
+package payroll
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	authModel "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	authTable "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.PayCalendarRepositoryInterface = (*PayCalendarRepository)(nil)
+
+type PayCalendarRepository struct {
+	logger *zap.Logger
+}
+
+func NewPayCalendarRepository(logger *zap.Logger) repository.PayCalendarRepositoryInterface {
+	return &PayCalendarRepository{
+		logger: logger,
+	}
+}
+
+func (r *PayCalendarRepository) Create(ctx context.Context, tx *sql.Tx, calendar *aggregate.PayCalendar) (*aggregate.PayCalendar, error) {
+	m := calendar.ToModel()
+
+	stmt := authTable.PayCalendars.INSERT(
+		authTable.PayCalendars.ID,
+		authTable.PayCalendars.Frequency,
+		authTable.PayCalendars.AnchorDate,
+		authTable.PayCalendars.IsActive,
+		authTable.PayCalendars.CreatedBy,
+	).MODEL(m).RETURNING(authTable.PayCalendars.AllColumns)
+
+	var result authModel.PayCalendars
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create pay calendar", zap.Error(err))
+		return nil, fmt.Errorf("failed to create pay calendar: %w", err)
+	}
+
+	return aggregate.PayCalendarFromModel(&result), nil
+}
+
+func (r *PayCalendarRepository) GetActive(ctx context.Context, tx *sql.Tx) (*aggregate.PayCalendar, error) {
+	stmt := authTable.PayCalendars.
+		SELECT(authTable.PayCalendars.AllColumns).
+		WHERE(authTable.PayCalendars.IsActive.IS_TRUE())
+
+	var result authModel.PayCalendars
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, payrollErrors.ErrPayCalendarNotFound
+		}
+		r.logger.Error("failed to get active pay calendar", zap.Error(err))
+		return nil, fmt.Errorf("failed to get active pay calendar: %w", err)
+	}
+
+	return aggregate.PayCalendarFromModel(&result), nil
+}
+
+func (r *PayCalendarRepository) DeactivateAll(ctx context.Context, tx *sql.Tx) error {
+	stmt := authTable.PayCalendars.
+		UPDATE(authTable.PayCalendars.IsActive).
+		SET(postgres.Bool(false)).
+		WHERE(authTable.PayCalendars.IsActive.IS_TRUE())
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to deactivate pay calendars", zap.Error(err))
+		return fmt.Errorf("failed to deactivate pay calendars: %w", err)
+	}
+	return nil
+}
+
+var _ repository.PayPeriodRepositoryInterface = (*PayPeriodRepository)(nil)
+
+type PayPeriodRepository struct {
+	logger *zap.Logger
+}
+
+func NewPayPeriodRepository(logger *zap.Logger) repository.PayPeriodRepositoryInterface {
+	return &PayPeriodRepository{
+		logger: logger,
+	}
+}
+
+func (r *PayPeriodRepository) Create(ctx context.Context, tx *sql.Tx, period *aggregate.PayPeriod) (*aggregate.PayPeriod, error) {
+	m := period.ToModel()
+
+	stmt := authTable.PayPeriods.INSERT(
+		authTable.PayPeriods.ID,
+		authTable.PayPeriods.CalendarID,
+		authTable.PayPeriods.PeriodStart,
+		authTable.PayPeriods.PeriodEnd,
+		authTable.PayPeriods.Status,
+		authTable.PayPeriods.PaymentCount,
+		authTable.PayPeriods.GrossTotal,
+		authTable.PayPeriods.Note,
+	).MODEL(m).RETURNING(authTable.PayPeriods.AllColumns)
+
+	var result authModel.PayPeriods
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create pay period", zap.Error(err))
+		return nil, fmt.Errorf("failed to create pay period: %w", err)
+	}
+
+	return aggregate.PayPeriodFromModel(&result), nil
+}
+
+func (r *PayPeriodRepository) GetLatest(ctx context.Context, tx *sql.Tx) (*aggregate.PayPeriod, error) {
+	stmt := authTable.PayPeriods.
+		SELECT(authTable.PayPeriods.AllColumns).
+		ORDER_BY(authTable.PayPeriods.PeriodEnd.DESC(), authTable.PayPeriods.CreatedAt.DESC()).
+		LIMIT(1)
+
+	var result authModel.PayPeriods
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, payrollErrors.ErrPayPeriodNotFound
+		}
+		r.logger.Error("failed to get latest pay period", zap.Error(err))
+		return nil, fmt.Errorf("failed to get latest pay period: %w", err)
+	}
+
+	return aggregate.PayPeriodFromModel(&result), nil
+}
+
+func (r *PayPeriodRepository) List(ctx context.Context, tx *sql.Tx, limit int) ([]*aggregate.PayPeriod, error) {
+	stmt := authTable.PayPeriods.
+		SELECT(authTable.PayPeriods.AllColumns).
+		ORDER_BY(authTable.PayPeriods.PeriodEnd.DESC(), authTable.PayPeriods.CreatedAt.DESC()).
+		LIMIT(int64(limit))
+
+	return r.list(ctx, tx, stmt)
+}
+
+func (r *PayPeriodRepository) ListUnnotified(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayPeriod, error) {
+	stmt := authTable.PayPeriods.
+		SELECT(authTable.PayPeriods.AllColumns).
+		WHERE(authTable.PayPeriods.NotifiedAt.IS_NULL()).
+		ORDER_BY(authTable.PayPeriods.PeriodEnd.ASC(), authTable.PayPeriods.CreatedAt.ASC())
+
+	return r.list(ctx, tx, stmt)
+}
+
+func (r *PayPeriodRepository) MarkNotified(ctx context.Context, tx *sql.Tx, id uuid.UUID, at time.Time) error {
+	stmt := authTable.PayPeriods.
+		UPDATE(authTable.PayPeriods.NotifiedAt).
+		SET(postgres.TimestampzT(at)).
+		WHERE(authTable.PayPeriods.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to mark pay period notified", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to mark pay period notified: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return payrollErrors.ErrPayPeriodNotFound
+	}
+	return nil
+}
+
+func (r *PayPeriodRepository) list(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement) ([]*aggregate.PayPeriod, error) {
+	var results []authModel.PayPeriods
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.PayPeriod{}, nil
+		}
+		r.logger.Error("failed to list pay periods", zap.Error(err))
+		return nil, fmt.Errorf("failed to list pay periods: %w", err)
+	}
+
+	periods := make([]*aggregate.PayPeriod, len(results))
+	for i := range results {
+		periods[i] = aggregate.PayPeriodFromModel(&results[i])
+	}
+	return periods, nil
+}
@@ -0,0 +1,165 @@
+package payroll
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	authModel "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	authTable "github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.PaymentAdjustmentRepositoryInterface = (*PaymentAdjustmentRepository)(nil)
+
+type PaymentAdjustmentRepository struct {
+	logger *zap.Logger
+}
+
+func NewPaymentAdjustmentRepository(logger *zap.Logger) repository.PaymentAdjustmentRepositoryInterface {
+	return &PaymentAdjustmentRepository{
+		logger: logger,
+	}
+}
+
+func (r *PaymentAdjustmentRepository) Create(ctx context.Context, tx *sql.Tx, adjustment *aggregate.PaymentAdjustment) (*aggregate.PaymentAdjustment, error) {
+	m := adjustment.ToModel()
+
+	stmt := authTable.PaymentAdjustments.INSERT(
+		authTable.PaymentAdjustments.ID,
+		authTable.PaymentAdjustments.StudentID,
+		authTable.PaymentAdjustments.PaymentID,
+		authTable.PaymentAdjustments.OriginalPaymentID,
+		authTable.PaymentAdjustments.OriginalPeriodEnd,
+		authTable.PaymentAdjustments.Type,
+		authTable.PaymentAdjustments.Amount,
+		authTable.PaymentAdjustments.Reason,
+		authTable.PaymentAdjustments.CreatedBy,
+	).MODEL(m).RETURNING(authTable.PaymentAdjustments.AllColumns)
+
+	var result authModel.PaymentAdjustments
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create payment adjustment", zap.Error(err))
+		return nil, fmt.Errorf("failed to create payment adjustment: %w", err)
+	}
+
+	return aggregate.PaymentAdjustmentFromModel(&result), nil
+}
+
+func (r *PaymentAdjustmentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PaymentAdjustment, error) {
+	stmt := authTable.PaymentAdjustments.
+		SELECT(authTable.PaymentAdjustments.AllColumns).
+		WHERE(authTable.PaymentAdjustments.ID.EQ(postgres.UUID(id)))
+
+	var result authModel.PaymentAdjustments
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, payrollErrors.ErrAdjustmentNotFound
+		}
+		r.logger.Error("failed to get payment adjustment", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get payment adjustment: %w", err)
+	}
+
+	return aggregate.PaymentAdjustmentFromModel(&result), nil
+}
+
+func (r *PaymentAdjustmentRepository) ListByPaymentID(ctx context.Context, tx *sql.Tx, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	return r.list(ctx, tx,
+		authTable.PaymentAdjustments.PaymentID.EQ(postgres.UUID(paymentID)).
+			OR(authTable.PaymentAdjustments.OriginalPaymentID.EQ(postgres.UUID(paymentID))),
+	)
+}
+
+func (r *PaymentAdjustmentRepository) ListAppliedByPaymentIDs(ctx context.Context, tx *sql.Tx, paymentIDs []uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	if len(paymentIDs) == 0 {
+		return []*aggregate.PaymentAdjustment{}, nil
+	}
+
+	ids := make([]postgres.Expression, len(paymentIDs))
+	for i, id := range paymentIDs {
+		ids[i] = postgres.UUID(id)
+	}
+	return r.list(ctx, tx, authTable.PaymentAdjustments.PaymentID.IN(ids...))
+}
+
+func (r *PaymentAdjustmentRepository) ListPending(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.PaymentAdjustment, error) {
+	condition := authTable.PaymentAdjustments.PaymentID.IS_NULL()
+	if studentIDs != nil {
+		if len(studentIDs) == 0 {
+			return []*aggregate.PaymentAdjustment{}, nil
+		}
+		ids := make([]postgres.Expression, len(studentIDs))
+		for i, id := range studentIDs {
+			ids[i] = postgres.Int32(id)
+		}
+		condition = condition.AND(authTable.PaymentAdjustments.StudentID.IN(ids...))
+	}
+	return r.list(ctx, tx, condition)
+}
+
+func (r *PaymentAdjustmentRepository) SetPayment(ctx context.Context, tx *sql.Tx, id uuid.UUID, paymentID *uuid.UUID) error {
+	var value postgres.Expression = postgres.NULL
+	if paymentID != nil {
+		value = postgres.UUID(*paymentID)
+	}
+
+	stmt := authTable.PaymentAdjustments.
+		UPDATE(authTable.PaymentAdjustments.PaymentID).
+		SET(value).
+		WHERE(authTable.PaymentAdjustments.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to set payment adjustment payment", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to set payment adjustment payment: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return payrollErrors.ErrAdjustmentNotFound
+	}
+	return nil
+}
+
+func (r *PaymentAdjustmentRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := authTable.PaymentAdjustments.
+		DELETE().
+		WHERE(authTable.PaymentAdjustments.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete payment adjustment", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to delete payment adjustment: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return payrollErrors.ErrAdjustmentNotFound
+	}
+	return nil
+}
+
+func (r *PaymentAdjustmentRepository) list(ctx context.Context, tx *sql.Tx, condition postgres.BoolExpression) ([]*aggregate.PaymentAdjustment, error) {
+	stmt := authTable.PaymentAdjustments.
+		SELECT(authTable.PaymentAdjustments.AllColumns).
+		WHERE(condition).
+		ORDER_BY(authTable.PaymentAdjustments.CreatedAt.ASC())
+
+	var results []authModel.PaymentAdjustments
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.PaymentAdjustment{}, nil
+		}
+		r.logger.Error("failed to list payment adjustments", zap.Error(err))
+		return nil, fmt.Errorf("failed to list payment adjustments: %w", err)
+	}
+
+	adjustments := make([]*aggregate.PaymentAdjustment, len(results))
+	for i := range results {
+		adjustments[i] = aggregate.PaymentAdjustmentFromModel(&results[i])
+	}
+	return adjustments, nil
+}
@@ -31,6 +31,9 @@ func NewPaymentRepository(logger *zap.Logger) repository.PaymentRepositoryInterf
 	}
 }
 
+// Upsert inserts the payment or refreshes the hours and amounts of an existing
+// unprocessed payment for the same student and period. A processed payment is
+// returned unchanged; corrections to it are carried forward as adjustments.
 func (r *PaymentRepository) Upsert(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error) {
 	m := payment.ToModel()
 
@@ -41,17 +44,25 @@ func (r *PaymentRepository) Upsert(ctx context.Context, tx *sql.Tx, payment *agg
 		authTable.Payments.PeriodEnd,
 		authTable.Payments.HoursWorked,
 		authTable.Payments.GrossAmount,
+		authTable.Payments.DeductionsAmount,
+		authTable.Payments.NetAmount,
 	).MODEL(m).
 		ON_CONFLICT(authTable.Payments.StudentID, authTable.Payments.PeriodStart, authTable.Payments.PeriodEnd).
 		DO_UPDATE(
 			postgres.SET(
 				authTable.Payments.HoursWorked.SET(authTable.Payments.EXCLUDED.HoursWorked),
 				authTable.Payments.GrossAmount.SET(authTable.Payments.EXCLUDED.GrossAmount),
-			),
+				authTable.Payments.DeductionsAmount.SET(authTable.Payments.EXCLUDED.DeductionsAmount),
+				authTable.Payments.NetAmount.SET(authTable.Payments.EXCLUDED.NetAmount),
+			).WHERE(authTable.Payments.ProcessedAt.IS_NULL()),
 		).RETURNING(authTable.Payments.AllColumns)
 
 	var result authModel.Payments
 	err := stmt.QueryContext(ctx, tx, &result)
+	if errors.Is(err, qrm.ErrNoRows) {
+		// The conflicting payment is processed, so the update was skipped.
+		return r.getByStudentPeriod(ctx, tx, payment.StudentID, payment.PeriodStart, payment.PeriodEnd)
+	}
 	if err != nil {
 		r.logger.Error("failed to upsert payment", zap.Error(err))
 		return nil, fmt.Errorf("failed to upsert payment: %w", err)
@@ -61,6 +72,29 @@ func (r *PaymentRepository) Upsert(ctx context.Context, tx *sql.Tx, payment *agg
 	return &p, nil
 }
 
+func (r *PaymentRepository) getByStudentPeriod(ctx context.Context, tx *sql.Tx, studentID int32, periodStart, periodEnd time.Time) (*aggregate.Payment, error) {
+	stmt := authTable.Payments.
+		SELECT(authTable.Payments.AllColumns).
+		WHERE(
+			authTable.Payments.StudentID.EQ(postgres.Int32(studentID)).
+				AND(authTable.Payments.PeriodStart.EQ(postgres.DateT(periodStart))).
+				AND(authTable.Payments.PeriodEnd.EQ(postgres.DateT(periodEnd))),
+		)
+
+	var result authModel.Payments
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, payrollErrors.ErrPaymentNotFound
+		}
+		r.logger.Error("failed to get payment by student and period", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to get payment by student and period: %w", err)
+	}
+
+	p := aggregate.PaymentFromModel(result)
+	return &p, nil
+}
+
 func (r *PaymentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Payment, error) {
 	stmt := authTable.Payments.
 		SELECT(authTable.Payments.AllColumns).
@@ -80,6 +114,25 @@ func (r *PaymentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUI
 	return &p, nil
 }
 
+func (r *PaymentRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.Payment, error) {
+	stmt := authTable.Payments.
+		SELECT(authTable.Payments.AllColumns).
+		WHERE(authTable.Payments.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(authTable.Payments.PeriodStart.DESC(), authTable.Payments.PeriodEnd.DESC())
+
+	var results []authModel.Payments
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Payment{}, nil
+		}
+		r.logger.Error("failed to list payments by student ID", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to list payments by student ID: %w", err)
+	}
+
+	return toPaymentAggregates(results), nil
+}
+
 func (r *PaymentRepository) ListByPeriod(ctx context.Context, tx *sql.Tx, filter repository.PaymentFilter) ([]*aggregate.Payment, error) {
 	condition := postgres.Bool(true)
 
@@ -95,6 +148,12 @@ func (r *PaymentRepository) ListByPeriod(ctx context.Context, tx *sql.Tx, filter
 	if filter.ProcessedOnly {
 		condition = condition.AND(authTable.Payments.ProcessedAt.IS_NOT_NULL())
 	}
+	if filter.UnprocessedOnly {
+		condition = condition.AND(authTable.Payments.ProcessedAt.IS_NULL())
+	}
+	if filter.UndisbursedOnly {
+		condition = condition.AND(postgres.RawBool(undisbursedSQL))
+	}
 
 	stmt := authTable.Payments.
 		SELECT(authTable.Payments.AllColumns).
@@ -119,8 +178,14 @@ func (r *PaymentRepository) Update(ctx context.Context, tx *sql.Tx, payment *agg
 
 	stmt := authTable.Payments.UPDATE(
 		authTable.Payments.ProcessedAt,
+		authTable.Payments.GrossAmount,
+		authTable.Payments.DeductionsAmount,
+		authTable.Payments.NetAmount,
 	).SET(
 		m.ProcessedAt,
+		m.GrossAmount,
+		m.DeductionsAmount,
+		m.NetAmount,
 	).WHERE(
 		authTable.Payments.PaymentID.EQ(postgres.UUID(m.PaymentID)),
 	).RETURNING(authTable.Payments.AllColumns)
@@ -139,14 +204,39 @@ func (r *PaymentRepository) Update(ctx context.Context, tx *sql.Tx, payment *agg
 	return &p, nil
 }
 
+// netHoursSQL is a time log's paid time in hours. Logs closed before pay rules
+// existed have no paid_minutes and fall back to their duration minus closed
+// breaks.
+const netHoursSQL = `COALESCE(schedule.time_logs.paid_minutes / 60.0, (EXTRACT(EPOCH FROM (schedule.time_logs.exit_at - schedule.time_logs.entry_at)) - COALESCE((
+	SELECT SUM(EXTRACT(EPOCH FROM (b.end_at - b.start_at)))
+	FROM schedule.time_log_breaks b
+	WHERE b.time_log_id = schedule.time_logs.id AND b.end_at IS NOT NULL
+), 0)) / 3600.0)`
+
+// undisbursedSQL limits payments to those not yet in a disbursement file.
+const undisbursedSQL = `NOT EXISTS (
+	SELECT 1 FROM auth.disbursement_file_payments dfp
+	WHERE dfp.payment_id = auth.payments.payment_id
+)`
+
+// approvedWeekSQL limits time logs to weeks covered by an approved timesheet.
+// Timesheet weeks run Monday to Sunday in the local time of each log's
+// location.
+const approvedWeekSQL = `EXISTS (
+	SELECT 1 FROM schedule.timesheets ts
+	WHERE ts.student_id = schedule.time_logs.student_id
+		AND ts.status = 'approved'
+		AND schedule.time_log_local_date(schedule.time_logs.entry_at, schedule.time_logs.location_id) BETWEEN ts.week_start AND ts.week_start + 6
+)`
+
 func (r *PaymentRepository) CalculateHoursForPeriod(ctx context.Context, tx *sql.Tx, studentID int32, periodStart, periodEnd time.Time) (float64, error) {
-	// Calculate total hours from completed time logs within the period.
-	// Uses EXTRACT(EPOCH FROM (exit_at - entry_at)) / 3600 to get hours.
+	// Calculate total hours from completed time logs within the period,
+	// net of unpaid breaks, counting only weeks with an approved timesheet.
 	stmt := scheduleTable.TimeLogs.
 		SELECT(
 			postgres.COALESCE(
 				postgres.SUM(
-					postgres.RawFloat("EXTRACT(EPOCH FROM (schedule.time_logs.exit_at - schedule.time_logs.entry_at)) / 3600.0"),
+					postgres.RawFloat(netHoursSQL),
 				),
 				postgres.Float(0),
 			).AS("total_hours"),
@@ -156,7 +246,8 @@ func (r *PaymentRepository) CalculateHoursForPeriod(ctx context.Context, tx *sql
 				AND(scheduleTable.TimeLogs.EntryAt.GT_EQ(postgres.TimestampzT(periodStart))).
 				AND(scheduleTable.TimeLogs.EntryAt.LT(postgres.TimestampzT(periodEnd.AddDate(0, 0, 1)))).
 				AND(scheduleTable.TimeLogs.ExitAt.IS_NOT_NULL()).
-				AND(scheduleTable.TimeLogs.IsFlagged.EQ(postgres.Bool(false))),
+				AND(scheduleTable.TimeLogs.IsFlagged.EQ(postgres.Bool(false))).
+				AND(postgres.RawBool(approvedWeekSQL)),
 		)
 
 	var result struct {
@@ -175,6 +266,65 @@ func (r *PaymentRepository) CalculateHoursForPeriod(ctx context.Context, tx *sql
 	return float64(int(result.TotalHours*100)) / 100, nil
 }
 
+func (r *PaymentRepository) TotalGrossAmount(ctx context.Context, tx *sql.Tx, from, to time.Time) (float64, error) {
+	stmt := authTable.Payments.
+		SELECT(
+			postgres.COALESCE(
+				postgres.SUM(authTable.Payments.GrossAmount),
+				postgres.Float(0),
+			).AS("total_gross"),
+		).
+		WHERE(
+			authTable.Payments.PeriodEnd.GT_EQ(postgres.DateT(from)).
+				AND(authTable.Payments.PeriodEnd.LT_EQ(postgres.DateT(to))),
+		)
+
+	var result struct {
+		TotalGross float64 `alias:"total_gross"`
+	}
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		r.logger.Error("failed to total gross amount", zap.Error(err))
+		return 0, fmt.Errorf("failed to total gross amount: %w", err)
+	}
+
+	return result.TotalGross, nil
+}
+
+func (r *PaymentRepository) ListOverlappingPeriods(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]aggregate.PeriodRange, error) {
+	start, end := postgres.DateT(periodStart), postgres.DateT(periodEnd)
+
+	stmt := authTable.Payments.
+		SELECT(authTable.Payments.PeriodStart, authTable.Payments.PeriodEnd).
+		DISTINCT().
+		WHERE(
+			authTable.Payments.PeriodStart.LT_EQ(end).
+				AND(authTable.Payments.PeriodEnd.GT_EQ(start)).
+				AND(postgres.NOT(authTable.Payments.PeriodStart.EQ(start).AND(authTable.Payments.PeriodEnd.EQ(end)))),
+		).
+		ORDER_BY(authTable.Payments.PeriodStart.ASC(), authTable.Payments.PeriodEnd.ASC())
+
+	var rows []struct {
+		PeriodStart time.Time `sql:"primary_key" alias:"payments.period_start"`
+		PeriodEnd   time.Time `sql:"primary_key" alias:"payments.period_end"`
+	}
+	if err := stmt.QueryContext(ctx, tx, &rows); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []aggregate.PeriodRange{}, nil
+		}
+		r.logger.Error("failed to list overlapping payment periods", zap.Error(err))
+		return nil, fmt.Errorf("failed to list overlapping payment periods: %w", err)
+	}
+
+	periods := make([]aggregate.PeriodRange, len(rows))
+	for i, row := range rows {
+		periods[i] = aggregate.PeriodRange{Start: row.PeriodStart, End: row.PeriodEnd}
+	}
+	return periods, nil
+}
+
 func (r *PaymentRepository) CalculateHoursBatch(ctx context.Context, tx *sql.Tx, studentIDs []int32, periodStart, periodEnd time.Time) (map[int32]float64, error) {
 	result := make(map[int32]float64, len(studentIDs))
 	if len(studentIDs) == 0 {
@@ -191,7 +341,7 @@ func (r *PaymentRepository) CalculateHoursBatch(ctx context.Context, tx *sql.Tx,
 			scheduleTable.TimeLogs.StudentID,
 			postgres.COALESCE(
 				postgres.SUM(
-					postgres.RawFloat("EXTRACT(EPOCH FROM (schedule.time_logs.exit_at - schedule.time_logs.entry_at)) / 3600.0"),
+					postgres.RawFloat(netHoursSQL),
 				),
 				postgres.Float(0),
 			).AS("total_hours"),
@@ -201,7 +351,8 @@ func (r *PaymentRepository) CalculateHoursBatch(ctx context.Context, tx *sql.Tx,
 				AND(scheduleTable.TimeLogs.EntryAt.GT_EQ(postgres.TimestampzT(periodStart))).
 				AND(scheduleTable.TimeLogs.EntryAt.LT(postgres.TimestampzT(periodEnd.AddDate(0, 0, 1)))).
 				AND(scheduleTable.TimeLogs.ExitAt.IS_NOT_NULL()).
-				AND(scheduleTable.TimeLogs.IsFlagged.EQ(postgres.Bool(false))),
+				AND(scheduleTable.TimeLogs.IsFlagged.EQ(postgres.Bool(false))).
+				AND(postgres.RawBool(approvedWeekSQL)),
 		).
 		GROUP_BY(scheduleTable.TimeLogs.StudentID)
 
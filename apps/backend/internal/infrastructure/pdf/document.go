@@ -0,0 +1,111 @@
+// Package pdf writes simple single-page PDF documents: text in the standard
+// Helvetica fonts and straight lines. It covers generated paperwork such as
+// payslips without pulling in a layout engine.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.0
+	PageHeight = 842.0
+)
+
+type Font int
+
+const (
+	Font_Regular Font = iota
+	Font_Bold
+)
+
+// Document is one A4 page. Coordinates are in points from the bottom-left
+// corner, as in PDF itself.
+type Document struct {
+	content bytes.Buffer
+}
+
+func NewDocument() *Document {
+	return &Document{}
+}
+
+// Text draws s with its baseline starting at (x, y). Characters outside
+// Latin-1 are replaced with '?' because the standard fonts cannot show them.
+func (d *Document) Text(x, y float64, font Font, size float64, s string) {
+	fmt.Fprintf(&d.content, "BT /F%d %s Tf %s %s Td (%s) Tj ET\n",
+		int(font)+1, num(size), num(x), num(y), escape(s))
+}
+
+// TextRight draws s so that it ends at x. Widths are estimated from the
+// average Helvetica glyph width, which is close enough for digits.
+func (d *Document) TextRight(x, y float64, font Font, size float64, s string) {
+	d.Text(x-EstimateWidth(s, size), y, font, size, s)
+}
+
+// Line draws a 0.5pt line from (x1, y1) to (x2, y2).
+func (d *Document) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&d.content, "0.5 w %s %s m %s %s l S\n", num(x1), num(y1), num(x2), num(y2))
+}
+
+// EstimateWidth approximates the rendered width of s in points.
+func EstimateWidth(s string, size float64) float64 {
+	return float64(len([]rune(s))) * size * 0.556
+}
+
+// Bytes serialises the document.
+func (d *Document) Bytes() []byte {
+	content := d.content.Bytes()
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>",
+			num(PageWidth), num(PageHeight)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// escape encodes s as a PDF literal string body in WinAnsi (Latin-1).
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n' || r == '\r' || r == '\t':
+			b.WriteByte(' ')
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		case r < 0x80:
+			b.WriteRune(r)
+		default:
+			fmt.Fprintf(&b, "\\%03o", r)
+		}
+	}
+	return b.String()
+}
+
+func num(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
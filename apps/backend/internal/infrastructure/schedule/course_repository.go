@@ -0,0 +1,147 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.CourseRepositoryInterface = (*CourseRepository)(nil)
+
+type CourseRepository struct {
+	logger *zap.Logger
+}
+
+func NewCourseRepository(logger *zap.Logger) repository.CourseRepositoryInterface {
+	return &CourseRepository{
+		logger: logger,
+	}
+}
+
+func (r *CourseRepository) Create(ctx context.Context, tx *sql.Tx, c *aggregate.Course) (*aggregate.Course, error) {
+	m := c.ToModel()
+
+	stmt := table.Courses.INSERT(
+		table.Courses.ID,
+		table.Courses.Code,
+		table.Courses.Title,
+		table.Courses.Department,
+		table.Courses.Level,
+		table.Courses.IsActive,
+	).MODEL(m).RETURNING(table.Courses.AllColumns)
+
+	var result model.Courses
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		r.logger.Error("failed to create course", zap.Error(err), zap.String("code", c.Code))
+		return nil, fmt.Errorf("failed to create course: %w", err)
+	}
+
+	course := aggregate.CourseFromModel(result)
+	return &course, nil
+}
+
+func (r *CourseRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Course, error) {
+	stmt := table.Courses.
+		SELECT(table.Courses.AllColumns).
+		WHERE(table.Courses.ID.EQ(postgres.UUID(id)))
+
+	return r.get(ctx, tx, stmt)
+}
+
+func (r *CourseRepository) GetByCode(ctx context.Context, tx *sql.Tx, code string) (*aggregate.Course, error) {
+	stmt := table.Courses.
+		SELECT(table.Courses.AllColumns).
+		WHERE(table.Courses.Code.EQ(postgres.String(aggregate.NormalizeCourseCode(code))))
+
+	return r.get(ctx, tx, stmt)
+}
+
+func (r *CourseRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error) {
+	stmt := table.Courses.
+		SELECT(table.Courses.AllColumns).
+		WHERE(table.Courses.IsActive.EQ(postgres.Bool(true))).
+		ORDER_BY(table.Courses.Code.ASC())
+
+	return r.list(ctx, tx, stmt)
+}
+
+func (r *CourseRepository) ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error) {
+	stmt := table.Courses.
+		SELECT(table.Courses.AllColumns).
+		ORDER_BY(table.Courses.Code.ASC())
+
+	return r.list(ctx, tx, stmt)
+}
+
+func (r *CourseRepository) Update(ctx context.Context, tx *sql.Tx, c *aggregate.Course) error {
+	m := c.ToModel()
+
+	stmt := table.Courses.UPDATE(
+		table.Courses.Title,
+		table.Courses.Department,
+		table.Courses.Level,
+		table.Courses.IsActive,
+	).MODEL(m).WHERE(table.Courses.ID.EQ(postgres.UUID(m.ID)))
+
+	result, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to update course", zap.Error(err), zap.String("id", c.ID.String()))
+		return fmt.Errorf("failed to update course: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return scheduleErrors.ErrCourseNotFound
+	}
+
+	return nil
+}
+
+func (r *CourseRepository) get(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement) (*aggregate.Course, error) {
+	var result model.Courses
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, scheduleErrors.ErrCourseNotFound
+		}
+		r.logger.Error("failed to get course", zap.Error(err))
+		return nil, fmt.Errorf("failed to get course: %w", err)
+	}
+
+	course := aggregate.CourseFromModel(result)
+	return &course, nil
+}
+
+func (r *CourseRepository) list(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement) ([]*aggregate.Course, error) {
+	var results []model.Courses
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Course{}, nil
+		}
+		r.logger.Error("failed to list courses", zap.Error(err))
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+
+	courses := make([]*aggregate.Course, len(results))
+	for i, m := range results {
+		c := aggregate.CourseFromModel(m)
+		courses[i] = &c
+	}
+	return courses, nil
+}
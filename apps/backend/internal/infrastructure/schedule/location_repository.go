@@ -0,0 +1,191 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.LocationRepositoryInterface = (*LocationRepository)(nil)
+
+type LocationRepository struct {
+	logger *zap.Logger
+}
+
+func NewLocationRepository(logger *zap.Logger) repository.LocationRepositoryInterface {
+	return &LocationRepository{
+		logger: logger,
+	}
+}
+
+func (r *LocationRepository) Create(ctx context.Context, tx *sql.Tx, l *aggregate.Location) (*aggregate.Location, error) {
+	m := l.ToModel()
+
+	stmt := table.Locations.INSERT(
+		table.Locations.ID,
+		table.Locations.Name,
+		table.Locations.Latitude,
+		table.Locations.Longitude,
+		table.Locations.RadiusMeters,
+		table.Locations.Geofence,
+		table.Locations.Timezone,
+		table.Locations.IsActive,
+	).MODEL(m).RETURNING(table.Locations.AllColumns)
+
+	var result model.Locations
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		r.logger.Error("failed to create location", zap.Error(err))
+		return nil, fmt.Errorf("failed to create location: %w", err)
+	}
+
+	loc := aggregate.LocationFromModel(result)
+	return &loc, nil
+}
+
+func (r *LocationRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Location, error) {
+	stmt := table.Locations.
+		SELECT(table.Locations.AllColumns).
+		WHERE(table.Locations.ID.EQ(postgres.UUID(id)))
+
+	var result model.Locations
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, scheduleErrors.ErrLocationNotFound
+		}
+		r.logger.Error("failed to get location by ID", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get location by ID: %w", err)
+	}
+
+	loc := aggregate.LocationFromModel(result)
+	return &loc, nil
+}
+
+func (r *LocationRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error) {
+	stmt := table.Locations.
+		SELECT(table.Locations.AllColumns).
+		WHERE(table.Locations.IsActive.EQ(postgres.Bool(true))).
+		ORDER_BY(table.Locations.Name.ASC())
+
+	var results []model.Locations
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Location{}, nil
+		}
+		r.logger.Error("failed to list locations", zap.Error(err))
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+
+	return toLocationAggregates(results), nil
+}
+
+func (r *LocationRepository) ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error) {
+	stmt := table.Locations.
+		SELECT(table.Locations.AllColumns).
+		ORDER_BY(table.Locations.Name.ASC())
+
+	var results []model.Locations
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Location{}, nil
+		}
+		r.logger.Error("failed to list all locations", zap.Error(err))
+		return nil, fmt.Errorf("failed to list all locations: %w", err)
+	}
+
+	return toLocationAggregates(results), nil
+}
+
+func (r *LocationRepository) Update(ctx context.Context, tx *sql.Tx, l *aggregate.Location) error {
+	m := l.ToModel()
+
+	stmt := table.Locations.UPDATE(
+		table.Locations.Name,
+		table.Locations.Latitude,
+		table.Locations.Longitude,
+		table.Locations.RadiusMeters,
+		table.Locations.Geofence,
+		table.Locations.Timezone,
+		table.Locations.IsActive,
+	).MODEL(m).WHERE(table.Locations.ID.EQ(postgres.UUID(m.ID)))
+
+	result, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to update location", zap.Error(err), zap.String("id", l.ID.String()))
+		return fmt.Errorf("failed to update location: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return scheduleErrors.ErrLocationNotFound
+	}
+
+	return nil
+}
+
+func (r *LocationRepository) ListByShiftTemplateIDs(ctx context.Context, tx *sql.Tx, shiftTemplateIDs []uuid.UUID) (map[uuid.UUID]*aggregate.Location, error) {
+	locations := make(map[uuid.UUID]*aggregate.Location)
+	if len(shiftTemplateIDs) == 0 {
+		return locations, nil
+	}
+
+	ids := make([]postgres.Expression, len(shiftTemplateIDs))
+	for i, id := range shiftTemplateIDs {
+		ids[i] = postgres.UUID(id)
+	}
+
+	stmt := postgres.
+		SELECT(
+			table.ShiftTemplates.AllColumns,
+			table.Locations.AllColumns,
+		).
+		FROM(table.ShiftTemplates.
+			INNER_JOIN(table.Locations, table.Locations.ID.EQ(table.ShiftTemplates.LocationID)),
+		).
+		WHERE(table.ShiftTemplates.ID.IN(ids...))
+
+	var results []struct {
+		model.ShiftTemplates
+		Location model.Locations
+	}
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return locations, nil
+		}
+		r.logger.Error("failed to list locations by shift template IDs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list locations by shift template IDs: %w", err)
+	}
+
+	for _, row := range results {
+		loc := aggregate.LocationFromModel(row.Location)
+		locations[row.ID] = &loc
+	}
+	return locations, nil
+}
+
+func toLocationAggregates(models []model.Locations) []*aggregate.Location {
+	locations := make([]*aggregate.Location, len(models))
+	for i, m := range models {
+		l := aggregate.LocationFromModel(m)
+		locations[i] = &l
+	}
+	return locations
+}
@@ -42,6 +42,7 @@ func (r *ScheduleRepository) Create(ctx context.Context, tx *sql.Tx, schedule *a
 		table.Schedules.EffectiveTo,
 		table.Schedules.GenerationID,
 		table.Schedules.SchedulerMetadata,
+		table.Schedules.AssignmentOverrides,
 	).MODEL(m).RETURNING(table.Schedules.AllColumns)
 
 	var result model.Schedules
@@ -164,6 +165,7 @@ func (r *ScheduleRepository) Update(ctx context.Context, tx *sql.Tx, schedule *a
 		table.Schedules.EffectiveTo,
 		table.Schedules.GenerationID,
 		table.Schedules.SchedulerMetadata,
+		table.Schedules.AssignmentOverrides,
 	).SET(
 		m.Title,
 		m.IsActive,
@@ -174,6 +176,7 @@ func (r *ScheduleRepository) Update(ctx context.Context, tx *sql.Tx, schedule *a
 		effectiveTo,
 		generationID,
 		schedulerMetadata,
+		m.AssignmentOverrides,
 	).WHERE(table.Schedules.ScheduleID.EQ(postgres.UUID(m.ScheduleID)))
 
 	result, err := stmt.ExecContext(ctx, tx)
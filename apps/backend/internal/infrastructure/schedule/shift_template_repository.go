@@ -42,6 +42,7 @@ func (r *ShiftTemplateRepository) Create(ctx context.Context, tx *sql.Tx, t *agg
 		table.ShiftTemplates.MaxStaff,
 		table.ShiftTemplates.CourseDemands,
 		table.ShiftTemplates.IsActive,
+		table.ShiftTemplates.LocationID,
 	).MODEL(m).RETURNING(table.ShiftTemplates.AllColumns)
 
 	var result model.ShiftTemplates
@@ -71,6 +72,7 @@ func (r *ShiftTemplateRepository) BulkCreate(ctx context.Context, tx *sql.Tx, te
 		table.ShiftTemplates.MaxStaff,
 		table.ShiftTemplates.CourseDemands,
 		table.ShiftTemplates.IsActive,
+		table.ShiftTemplates.LocationID,
 	).MODELS(models).RETURNING(table.ShiftTemplates.AllColumns)
 
 	var results []model.ShiftTemplates
@@ -151,6 +153,7 @@ func (r *ShiftTemplateRepository) Update(ctx context.Context, tx *sql.Tx, t *agg
 		table.ShiftTemplates.MaxStaff,
 		table.ShiftTemplates.CourseDemands,
 		table.ShiftTemplates.IsActive,
+		table.ShiftTemplates.LocationID,
 	).MODEL(m).WHERE(table.ShiftTemplates.ID.EQ(postgres.UUID(m.ID)))
 
 	result, err := stmt.ExecContext(ctx, tx)
@@ -45,6 +45,9 @@ type SchedulerConfig struct {
 	SolverTimeLimit        *int32   `json:"solver_time_limit,omitempty"`
 	SolverGap              *float32 `json:"solver_gap,omitempty"`
 	LogSolverOutput        bool     `json:"log_solver_output"`
+	// WeeklyCostCeiling caps the summed cost_per_hour x shift hours of the
+	// assignments. Nil leaves cost unconstrained.
+	WeeklyCostCeiling *float32 `json:"weekly_cost_ceiling,omitempty"`
 }
 
 type Assignment struct {
@@ -0,0 +1,94 @@
+// Package spreadsheet reads and writes the tabular files admins exchange with
+// the app: CSV and single-sheet XLSX workbooks. Rows are plain string slices;
+// callers own header handling and validation.
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	Format_CSV  Format = "csv"
+	Format_XLSX Format = "xlsx"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format (expected csv or xlsx)")
+	ErrInvalidFile       = errors.New("invalid spreadsheet file")
+)
+
+// ParseFormat validates a format name such as "csv" or "XLSX".
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case Format_CSV:
+		return Format_CSV, nil
+	case Format_XLSX:
+		return Format_XLSX, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// FormatFromFilename picks the format from a file's extension.
+func FormatFromFilename(name string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(name), "."))
+}
+
+func (f Format) ContentType() string {
+	if f == Format_XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Read returns every row of the file. XLSX files are read from their first
+// worksheet. Ragged rows are returned as-is.
+func Read(r io.Reader, format Format) ([][]string, error) {
+	switch format {
+	case Format_CSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+		if len(rows) > 0 && len(rows[0]) > 0 {
+			rows[0][0] = strings.TrimPrefix(rows[0][0], "\ufeff")
+		}
+		return rows, nil
+	case Format_XLSX:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return readXLSX(bytes.NewReader(data), int64(len(data)))
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// Write encodes rows in the given format. The first row is usually a header.
+func Write(w io.Writer, format Format, rows [][]string) error {
+	switch format {
+	case Format_CSV:
+		writer := csv.NewWriter(w)
+		if err := writer.WriteAll(rows); err != nil {
+			return fmt.Errorf("failed to write csv: %w", err)
+		}
+		return nil
+	case Format_XLSX:
+		return writeXLSX(w, rows)
+	}
+	return ErrUnsupportedFormat
+}
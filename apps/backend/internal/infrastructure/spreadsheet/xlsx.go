@@ -0,0 +1,290 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// The XLSX support covers what exports from Excel, Google Sheets and
+// LibreOffice need: shared and inline strings, numbers and booleans on the
+// first worksheet. Formulas are read as their cached values; styles, merged
+// cells and dates (which are stored as serial numbers) are not interpreted.
+
+const (
+	relOfficeDocument = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	relWorksheet      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"
+
+	// maxXLSXPartSize caps how much of any one workbook part is decompressed.
+	// A small upload can inflate to gigabytes, so larger parts are rejected.
+	maxXLSXPartSize = 64 << 20 // 64 MB
+)
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.Text)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func readXLSX(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: not an xlsx workbook", ErrInvalidFile)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeZipXML(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("%w: worksheet %s missing", ErrInvalidFile, sheetPath)
+	}
+	var sheet xlsxWorksheet
+	if err := decodeZipXML(f, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		var values []string
+		for i, c := range row.Cells {
+			col := i
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(values) <= col {
+				values = append(values, "")
+			}
+
+			switch c.Type {
+			case "s":
+				var idx int
+				if _, err := fmt.Sscanf(c.Value, "%d", &idx); err != nil || idx < 0 || idx >= len(shared.Items) {
+					return nil, fmt.Errorf("%w: bad shared string reference in %s", ErrInvalidFile, c.Ref)
+				}
+				values[col] = shared.Items[idx].String()
+			case "inlineStr":
+				values[col] = c.Inline.String()
+			case "b":
+				if c.Value == "1" {
+					values[col] = "TRUE"
+				} else {
+					values[col] = "FALSE"
+				}
+			default:
+				values[col] = c.Value
+			}
+		}
+		rows = append(rows, values)
+	}
+	return rows, nil
+}
+
+// firstSheetPath follows the workbook relationships to the first sheet,
+// falling back to the conventional path for minimal workbooks.
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	const fallback = "xl/worksheets/sheet1.xml"
+
+	workbookPath := "xl/workbook.xml"
+	if f, ok := files["_rels/.rels"]; ok {
+		var rels xlsxRelationships
+		if err := decodeZipXML(f, &rels); err != nil {
+			return "", err
+		}
+		for _, rel := range rels.Relationships {
+			if rel.Type == relOfficeDocument {
+				workbookPath = strings.TrimPrefix(rel.Target, "/")
+			}
+		}
+	}
+
+	wf, ok := files[workbookPath]
+	if !ok {
+		if _, ok := files[fallback]; ok {
+			return fallback, nil
+		}
+		return "", fmt.Errorf("%w: workbook missing", ErrInvalidFile)
+	}
+	var workbook xlsxWorkbook
+	if err := decodeZipXML(wf, &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("%w: workbook has no sheets", ErrInvalidFile)
+	}
+
+	dir := path.Dir(workbookPath)
+	relsPath := path.Join(dir, "_rels", path.Base(workbookPath)+".rels")
+	rf, ok := files[relsPath]
+	if !ok {
+		return fallback, nil
+	}
+	var rels xlsxRelationships
+	if err := decodeZipXML(rf, &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID == workbook.Sheets[0].RelID && rel.Type == relWorksheet {
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/"), nil
+			}
+			return path.Join(dir, rel.Target), nil
+		}
+	}
+	return fallback, nil
+}
+
+func decodeZipXML(f *zip.File, v any) error {
+	if f.UncompressedSize64 > maxXLSXPartSize {
+		return fmt.Errorf("%w: %s is too large", ErrInvalidFile, f.Name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	defer rc.Close()
+	// The declared size can lie, so the read is capped as well.
+	if err := xml.NewDecoder(io.LimitReader(rc, maxXLSXPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidFile, f.Name, err)
+	}
+	return nil
+}
+
+// columnIndex converts the letters of a cell reference such as "AB12" to a
+// zero-based column index.
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+		n++
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%w: bad cell reference %q", ErrInvalidFile, ref)
+	}
+	return col - 1, nil
+}
+
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+var xlsxStaticParts = []struct{ name, body string }{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="` + relOfficeDocument + `" Target="xl/workbook.xml"/></Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="` + relWorksheet + `" Target="worksheets/sheet1.xml"/></Relationships>`},
+}
+
+// writeXLSX writes a single-sheet workbook. Every cell is an inline string so
+// values such as student IDs and phone numbers keep their exact text.
+func writeXLSX(w io.Writer, rows [][]string) error {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxStaticParts {
+		fw, err := zw.Create(part.name)
+		if err != nil {
+			return fmt.Errorf("failed to write xlsx: %w", err)
+		}
+		if _, err := io.WriteString(fw, part.body); err != nil {
+			return fmt.Errorf("failed to write xlsx: %w", err)
+		}
+	}
+
+	fw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&b, `<row r="%d">`, i+1)
+		for j, value := range row {
+			fmt.Fprintf(&b, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, columnName(j), i+1)
+			if err := xml.EscapeText(&b, []byte(value)); err != nil {
+				return fmt.Errorf("failed to write xlsx: %w", err)
+			}
+			b.WriteString(`</t></is></c>`)
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	if _, err := io.WriteString(fw, b.String()); err != nil {
+		return fmt.Errorf("failed to write xlsx: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/crypto"
+)
+
+var _ ObjectStore = (*EncryptedStore)(nil)
+
+// EncryptedStore encrypts objects with AES-256-GCM before handing them to the
+// wrapped store, so the backend only ever holds ciphertext.
+type EncryptedStore struct {
+	backend ObjectStore
+	key     []byte
+}
+
+func NewEncryptedStore(backend ObjectStore, key []byte) (*EncryptedStore, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(key))
+	}
+	return &EncryptedStore{backend: backend, key: key}, nil
+}
+
+func (s *EncryptedStore) Put(ctx context.Context, key string, data []byte) error {
+	ciphertext, err := crypto.EncryptBytes(data, s.key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt object: %w", err)
+	}
+	return s.backend.Put(ctx, key, ciphertext)
+}
+
+func (s *EncryptedStore) Get(ctx context.Context, key string) ([]byte, error) {
+	ciphertext, err := s.backend.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := crypto.DecryptBytes(ciphertext, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt object: %w", err)
+	}
+	return plaintext, nil
+}
+
+func (s *EncryptedStore) Delete(ctx context.Context, key string) error {
+	return s.backend.Delete(ctx, key)
+}
@@ -0,0 +1,20 @@
+// Package storage keeps uploaded files outside the database. Callers address
+// objects by key; the backend decides where the bytes live.
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrObjectNotFound = errors.New("storage object not found")
+
+// ObjectStore stores opaque blobs by key. Keys are slash-separated relative
+// paths such as "documents/<uuid>".
+type ObjectStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns ErrObjectNotFound when nothing is stored under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete is idempotent: deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,413 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.ApplicationReviewRepositoryInterface = (*ApplicationReviewRepository)(nil)
+
+type ApplicationReviewRepository struct {
+	logger *zap.Logger
+}
+
+func NewApplicationReviewRepository(logger *zap.Logger) repository.ApplicationReviewRepositoryInterface {
+	return &ApplicationReviewRepository{logger: logger}
+}
+
+// --- Stages ---
+
+func (r *ApplicationReviewRepository) CreateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error) {
+	m := stage.ToModel()
+
+	stmt := table.ReviewStages.INSERT(
+		table.ReviewStages.ID,
+		table.ReviewStages.Name,
+		table.ReviewStages.Position,
+		table.ReviewStages.IsActive,
+	).MODEL(m).RETURNING(table.ReviewStages.AllColumns)
+
+	var result model.ReviewStages
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create review stage", zap.Error(err), zap.String("name", stage.Name))
+		return nil, fmt.Errorf("failed to create review stage: %w", err)
+	}
+
+	return aggregate.ReviewStageFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) GetStageByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewStage, error) {
+	stmt := table.ReviewStages.
+		SELECT(table.ReviewStages.AllColumns).
+		WHERE(table.ReviewStages.ID.EQ(postgres.UUID(id)))
+
+	var result model.ReviewStages
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrReviewStageNotFound
+		}
+		r.logger.Error("failed to get review stage", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get review stage: %w", err)
+	}
+
+	return aggregate.ReviewStageFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) UpdateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error) {
+	m := stage.ToModel()
+
+	stmt := table.ReviewStages.UPDATE(
+		table.ReviewStages.Name,
+		table.ReviewStages.Position,
+		table.ReviewStages.IsActive,
+	).MODEL(m).
+		WHERE(table.ReviewStages.ID.EQ(postgres.UUID(stage.ID))).
+		RETURNING(table.ReviewStages.AllColumns)
+
+	var result model.ReviewStages
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrReviewStageNotFound
+		}
+		r.logger.Error("failed to update review stage", zap.Error(err), zap.String("id", stage.ID.String()))
+		return nil, fmt.Errorf("failed to update review stage: %w", err)
+	}
+
+	return aggregate.ReviewStageFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) ListStages(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewStage, error) {
+	stmt := table.ReviewStages.
+		SELECT(table.ReviewStages.AllColumns).
+		ORDER_BY(table.ReviewStages.Position.ASC(), table.ReviewStages.Name.ASC())
+
+	var results []model.ReviewStages
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ReviewStage{}, nil
+		}
+		r.logger.Error("failed to list review stages", zap.Error(err))
+		return nil, fmt.Errorf("failed to list review stages: %w", err)
+	}
+
+	stages := make([]*aggregate.ReviewStage, len(results))
+	for i := range results {
+		stages[i] = aggregate.ReviewStageFromModel(&results[i])
+	}
+	return stages, nil
+}
+
+// --- Rubric ---
+
+func (r *ApplicationReviewRepository) CreateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error) {
+	m := criterion.ToModel()
+
+	stmt := table.ReviewCriteria.INSERT(
+		table.ReviewCriteria.ID,
+		table.ReviewCriteria.Name,
+		table.ReviewCriteria.Description,
+		table.ReviewCriteria.MaxScore,
+		table.ReviewCriteria.Position,
+		table.ReviewCriteria.IsActive,
+	).MODEL(m).RETURNING(table.ReviewCriteria.AllColumns)
+
+	var result model.ReviewCriteria
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create review criterion", zap.Error(err), zap.String("name", criterion.Name))
+		return nil, fmt.Errorf("failed to create review criterion: %w", err)
+	}
+
+	return aggregate.ReviewCriterionFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) GetCriterionByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewCriterion, error) {
+	stmt := table.ReviewCriteria.
+		SELECT(table.ReviewCriteria.AllColumns).
+		WHERE(table.ReviewCriteria.ID.EQ(postgres.UUID(id)))
+
+	var result model.ReviewCriteria
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrReviewCriterionNotFound
+		}
+		r.logger.Error("failed to get review criterion", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get review criterion: %w", err)
+	}
+
+	return aggregate.ReviewCriterionFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) UpdateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error) {
+	m := criterion.ToModel()
+
+	stmt := table.ReviewCriteria.UPDATE(
+		table.ReviewCriteria.Name,
+		table.ReviewCriteria.Description,
+		table.ReviewCriteria.MaxScore,
+		table.ReviewCriteria.Position,
+		table.ReviewCriteria.IsActive,
+	).MODEL(m).
+		WHERE(table.ReviewCriteria.ID.EQ(postgres.UUID(criterion.ID))).
+		RETURNING(table.ReviewCriteria.AllColumns)
+
+	var result model.ReviewCriteria
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrReviewCriterionNotFound
+		}
+		r.logger.Error("failed to update review criterion", zap.Error(err), zap.String("id", criterion.ID.String()))
+		return nil, fmt.Errorf("failed to update review criterion: %w", err)
+	}
+
+	return aggregate.ReviewCriterionFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) ListCriteria(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewCriterion, error) {
+	stmt := table.ReviewCriteria.
+		SELECT(table.ReviewCriteria.AllColumns).
+		ORDER_BY(table.ReviewCriteria.Position.ASC(), table.ReviewCriteria.Name.ASC())
+
+	var results []model.ReviewCriteria
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ReviewCriterion{}, nil
+		}
+		r.logger.Error("failed to list review criteria", zap.Error(err))
+		return nil, fmt.Errorf("failed to list review criteria: %w", err)
+	}
+
+	criteria := make([]*aggregate.ReviewCriterion, len(results))
+	for i := range results {
+		criteria[i] = aggregate.ReviewCriterionFromModel(&results[i])
+	}
+	return criteria, nil
+}
+
+// --- Stage history ---
+
+func (r *ApplicationReviewRepository) AddStageChange(ctx context.Context, tx *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error) {
+	m := change.ToModel()
+
+	stmt := table.ApplicationStageHistory.INSERT(
+		table.ApplicationStageHistory.ID,
+		table.ApplicationStageHistory.StudentID,
+		table.ApplicationStageHistory.FromStageID,
+		table.ApplicationStageHistory.ToStageID,
+		table.ApplicationStageHistory.Decision,
+		table.ApplicationStageHistory.Note,
+		table.ApplicationStageHistory.ChangedBy,
+	).MODEL(m).RETURNING(table.ApplicationStageHistory.AllColumns)
+
+	var result model.ApplicationStageHistory
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to add stage change", zap.Error(err), zap.Int32("student_id", change.StudentID))
+		return nil, fmt.Errorf("failed to add stage change: %w", err)
+	}
+
+	return aggregate.StageChangeFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) ListStageChanges(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.StageChange, error) {
+	stmt := table.ApplicationStageHistory.
+		SELECT(table.ApplicationStageHistory.AllColumns).
+		ORDER_BY(table.ApplicationStageHistory.CreatedAt.ASC(), table.ApplicationStageHistory.ID.ASC())
+
+	if len(studentIDs) > 0 {
+		stmt = stmt.WHERE(table.ApplicationStageHistory.StudentID.IN(int32Expressions(studentIDs)...))
+	}
+
+	var results []model.ApplicationStageHistory
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.StageChange{}, nil
+		}
+		r.logger.Error("failed to list stage changes", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stage changes: %w", err)
+	}
+
+	changes := make([]*aggregate.StageChange, len(results))
+	for i := range results {
+		changes[i] = aggregate.StageChangeFromModel(&results[i])
+	}
+	return changes, nil
+}
+
+// --- Reviewers ---
+
+func (r *ApplicationReviewRepository) AddReviewer(ctx context.Context, tx *sql.Tx, reviewer *aggregate.ApplicationReviewer) error {
+	m := reviewer.ToModel()
+
+	stmt := table.ApplicationReviewers.INSERT(
+		table.ApplicationReviewers.StudentID,
+		table.ApplicationReviewers.ReviewerID,
+		table.ApplicationReviewers.AssignedBy,
+	).MODEL(m).
+		ON_CONFLICT(table.ApplicationReviewers.StudentID, table.ApplicationReviewers.ReviewerID).
+		DO_NOTHING()
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to add application reviewer", zap.Error(err), zap.Int32("student_id", reviewer.StudentID))
+		return fmt.Errorf("failed to add application reviewer: %w", err)
+	}
+	return nil
+}
+
+func (r *ApplicationReviewRepository) RemoveReviewer(ctx context.Context, tx *sql.Tx, studentID int32, reviewerID uuid.UUID) error {
+	stmt := table.ApplicationReviewers.
+		DELETE().
+		WHERE(
+			table.ApplicationReviewers.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.ApplicationReviewers.ReviewerID.EQ(postgres.UUID(reviewerID))),
+		)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to remove application reviewer", zap.Error(err), zap.Int32("student_id", studentID))
+		return fmt.Errorf("failed to remove application reviewer: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return studentErrors.ErrReviewerNotFound
+	}
+	return nil
+}
+
+func (r *ApplicationReviewRepository) ListReviewers(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.ApplicationReviewer, error) {
+	stmt := table.ApplicationReviewers.
+		SELECT(table.ApplicationReviewers.AllColumns).
+		ORDER_BY(table.ApplicationReviewers.StudentID.ASC(), table.ApplicationReviewers.CreatedAt.ASC())
+
+	if len(studentIDs) > 0 {
+		stmt = stmt.WHERE(table.ApplicationReviewers.StudentID.IN(int32Expressions(studentIDs)...))
+	}
+
+	var results []model.ApplicationReviewers
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ApplicationReviewer{}, nil
+		}
+		r.logger.Error("failed to list application reviewers", zap.Error(err))
+		return nil, fmt.Errorf("failed to list application reviewers: %w", err)
+	}
+
+	reviewers := make([]*aggregate.ApplicationReviewer, len(results))
+	for i := range results {
+		reviewers[i] = aggregate.ApplicationReviewerFromModel(&results[i])
+	}
+	return reviewers, nil
+}
+
+// --- Notes ---
+
+func (r *ApplicationReviewRepository) CreateNote(ctx context.Context, tx *sql.Tx, note *aggregate.ApplicationNote) (*aggregate.ApplicationNote, error) {
+	m := note.ToModel()
+
+	stmt := table.ApplicationNotes.INSERT(
+		table.ApplicationNotes.ID,
+		table.ApplicationNotes.StudentID,
+		table.ApplicationNotes.StageID,
+		table.ApplicationNotes.AuthorID,
+		table.ApplicationNotes.Body,
+	).MODEL(m).RETURNING(table.ApplicationNotes.AllColumns)
+
+	var result model.ApplicationNotes
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create application note", zap.Error(err), zap.Int32("student_id", note.StudentID))
+		return nil, fmt.Errorf("failed to create application note: %w", err)
+	}
+
+	return aggregate.ApplicationNoteFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) ListNotes(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationNote, error) {
+	stmt := table.ApplicationNotes.
+		SELECT(table.ApplicationNotes.AllColumns).
+		WHERE(table.ApplicationNotes.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.ApplicationNotes.CreatedAt.ASC())
+
+	var results []model.ApplicationNotes
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ApplicationNote{}, nil
+		}
+		r.logger.Error("failed to list application notes", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to list application notes: %w", err)
+	}
+
+	notes := make([]*aggregate.ApplicationNote, len(results))
+	for i := range results {
+		notes[i] = aggregate.ApplicationNoteFromModel(&results[i])
+	}
+	return notes, nil
+}
+
+// --- Scores ---
+
+func (r *ApplicationReviewRepository) UpsertScore(ctx context.Context, tx *sql.Tx, score *aggregate.ApplicationScore) (*aggregate.ApplicationScore, error) {
+	m := score.ToModel()
+
+	stmt := table.ApplicationScores.INSERT(
+		table.ApplicationScores.StudentID,
+		table.ApplicationScores.CriterionID,
+		table.ApplicationScores.ReviewerID,
+		table.ApplicationScores.Score,
+		table.ApplicationScores.Comment,
+	).MODEL(m).
+		ON_CONFLICT(table.ApplicationScores.StudentID, table.ApplicationScores.CriterionID, table.ApplicationScores.ReviewerID).
+		DO_UPDATE(
+			postgres.SET(
+				table.ApplicationScores.Score.SET(table.ApplicationScores.EXCLUDED.Score),
+				table.ApplicationScores.Comment.SET(table.ApplicationScores.EXCLUDED.Comment),
+			),
+		).
+		RETURNING(table.ApplicationScores.AllColumns)
+
+	var result model.ApplicationScores
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to upsert application score", zap.Error(err), zap.Int32("student_id", score.StudentID))
+		return nil, fmt.Errorf("failed to upsert application score: %w", err)
+	}
+
+	return aggregate.ApplicationScoreFromModel(&result), nil
+}
+
+func (r *ApplicationReviewRepository) ListScores(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationScore, error) {
+	stmt := table.ApplicationScores.
+		SELECT(table.ApplicationScores.AllColumns).
+		WHERE(table.ApplicationScores.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.ApplicationScores.CreatedAt.ASC())
+
+	var results []model.ApplicationScores
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ApplicationScore{}, nil
+		}
+		r.logger.Error("failed to list application scores", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to list application scores: %w", err)
+	}
+
+	scores := make([]*aggregate.ApplicationScore, len(results))
+	for i := range results {
+		scores[i] = aggregate.ApplicationScoreFromModel(&results[i])
+	}
+	return scores, nil
+}
+
+func int32Expressions(values []int32) []postgres.Expression {
+	exprs := make([]postgres.Expression, len(values))
+	for i, v := range values {
+		exprs[i] = postgres.Int32(v)
+	}
+	return exprs
+}
@@ -0,0 +1,200 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.CourseEligibilityRepositoryInterface = (*CourseEligibilityRepository)(nil)
+
+type CourseEligibilityRepository struct {
+	logger *zap.Logger
+}
+
+func NewCourseEligibilityRepository(logger *zap.Logger) repository.CourseEligibilityRepositoryInterface {
+	return &CourseEligibilityRepository{logger: logger}
+}
+
+func (r *CourseEligibilityRepository) CreateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error) {
+	m := rule.ToModel()
+
+	stmt := table.CourseEligibilityRules.INSERT(
+		table.CourseEligibilityRules.ID,
+		table.CourseEligibilityRules.CourseCode,
+		table.CourseEligibilityRules.CourseLevel,
+		table.CourseEligibilityRules.MinGrade,
+	).MODEL(m).RETURNING(table.CourseEligibilityRules.AllColumns)
+
+	var result model.CourseEligibilityRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create eligibility rule", zap.Error(err))
+		return nil, fmt.Errorf("failed to create eligibility rule: %w", err)
+	}
+
+	return aggregate.EligibilityRuleFromModel(&result), nil
+}
+
+func (r *CourseEligibilityRepository) GetRuleByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.EligibilityRule, error) {
+	stmt := table.CourseEligibilityRules.
+		SELECT(table.CourseEligibilityRules.AllColumns).
+		WHERE(table.CourseEligibilityRules.ID.EQ(postgres.UUID(id)))
+
+	var result model.CourseEligibilityRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrEligibilityRuleNotFound
+		}
+		r.logger.Error("failed to get eligibility rule", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get eligibility rule: %w", err)
+	}
+
+	return aggregate.EligibilityRuleFromModel(&result), nil
+}
+
+func (r *CourseEligibilityRepository) UpdateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error) {
+	stmt := table.CourseEligibilityRules.
+		UPDATE(table.CourseEligibilityRules.MinGrade).
+		SET(postgres.String(rule.MinGrade)).
+		WHERE(table.CourseEligibilityRules.ID.EQ(postgres.UUID(rule.ID))).
+		RETURNING(table.CourseEligibilityRules.AllColumns)
+
+	var result model.CourseEligibilityRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrEligibilityRuleNotFound
+		}
+		r.logger.Error("failed to update eligibility rule", zap.Error(err), zap.String("id", rule.ID.String()))
+		return nil, fmt.Errorf("failed to update eligibility rule: %w", err)
+	}
+
+	return aggregate.EligibilityRuleFromModel(&result), nil
+}
+
+func (r *CourseEligibilityRepository) DeleteRule(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := table.CourseEligibilityRules.
+		DELETE().
+		WHERE(table.CourseEligibilityRules.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete eligibility rule", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to delete eligibility rule: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return studentErrors.ErrEligibilityRuleNotFound
+	}
+	return nil
+}
+
+func (r *CourseEligibilityRepository) ListRules(ctx context.Context, tx *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+	stmt := table.CourseEligibilityRules.
+		SELECT(table.CourseEligibilityRules.AllColumns).
+		ORDER_BY(
+			table.CourseEligibilityRules.CourseLevel.ASC().NULLS_LAST(),
+			table.CourseEligibilityRules.CourseCode.ASC(),
+		)
+
+	var results []model.CourseEligibilityRules
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.EligibilityRule{}, nil
+		}
+		r.logger.Error("failed to list eligibility rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list eligibility rules: %w", err)
+	}
+
+	rules := make([]*aggregate.EligibilityRule, len(results))
+	for i := range results {
+		rules[i] = aggregate.EligibilityRuleFromModel(&results[i])
+	}
+	return rules, nil
+}
+
+func (r *CourseEligibilityRepository) UpsertOverride(ctx context.Context, tx *sql.Tx, override *aggregate.EligibilityOverride) (*aggregate.EligibilityOverride, error) {
+	m := override.ToModel()
+
+	stmt := table.CourseEligibilityOverrides.INSERT(
+		table.CourseEligibilityOverrides.StudentID,
+		table.CourseEligibilityOverrides.CourseCode,
+		table.CourseEligibilityOverrides.Eligible,
+		table.CourseEligibilityOverrides.Reason,
+		table.CourseEligibilityOverrides.CreatedBy,
+	).MODEL(m).
+		ON_CONFLICT(table.CourseEligibilityOverrides.StudentID, table.CourseEligibilityOverrides.CourseCode).
+		DO_UPDATE(
+			postgres.SET(
+				table.CourseEligibilityOverrides.Eligible.SET(table.CourseEligibilityOverrides.EXCLUDED.Eligible),
+				table.CourseEligibilityOverrides.Reason.SET(table.CourseEligibilityOverrides.EXCLUDED.Reason),
+				table.CourseEligibilityOverrides.CreatedBy.SET(table.CourseEligibilityOverrides.EXCLUDED.CreatedBy),
+			),
+		).
+		RETURNING(table.CourseEligibilityOverrides.AllColumns)
+
+	var result model.CourseEligibilityOverrides
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to upsert eligibility override", zap.Error(err), zap.Int32("student_id", override.StudentID))
+		return nil, fmt.Errorf("failed to upsert eligibility override: %w", err)
+	}
+
+	return aggregate.EligibilityOverrideFromModel(&result), nil
+}
+
+func (r *CourseEligibilityRepository) DeleteOverride(ctx context.Context, tx *sql.Tx, studentID int32, courseCode string) error {
+	stmt := table.CourseEligibilityOverrides.
+		DELETE().
+		WHERE(
+			table.CourseEligibilityOverrides.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.CourseEligibilityOverrides.CourseCode.EQ(postgres.String(courseCode))),
+		)
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete eligibility override", zap.Error(err), zap.Int32("student_id", studentID))
+		return fmt.Errorf("failed to delete eligibility override: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return studentErrors.ErrEligibilityOverrideNotFound
+	}
+	return nil
+}
+
+func (r *CourseEligibilityRepository) ListOverrides(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.EligibilityOverride, error) {
+	stmt := table.CourseEligibilityOverrides.
+		SELECT(table.CourseEligibilityOverrides.AllColumns).
+		ORDER_BY(table.CourseEligibilityOverrides.StudentID.ASC(), table.CourseEligibilityOverrides.CourseCode.ASC())
+
+	if len(studentIDs) > 0 {
+		ids := make([]postgres.Expression, len(studentIDs))
+		for i, id := range studentIDs {
+			ids[i] = postgres.Int32(id)
+		}
+		stmt = stmt.WHERE(table.CourseEligibilityOverrides.StudentID.IN(ids...))
+	}
+
+	var results []model.CourseEligibilityOverrides
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.EligibilityOverride{}, nil
+		}
+		r.logger.Error("failed to list eligibility overrides", zap.Error(err))
+		return nil, fmt.Errorf("failed to list eligibility overrides: %w", err)
+	}
+
+	overrides := make([]*aggregate.EligibilityOverride, len(results))
+	for i := range results {
+		overrides[i] = aggregate.EligibilityOverrideFromModel(&results[i])
+	}
+	return overrides, nil
+}
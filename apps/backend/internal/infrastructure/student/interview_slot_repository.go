@@ -0,0 +1,188 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.InterviewSlotRepositoryInterface = (*InterviewSlotRepository)(nil)
+
+type InterviewSlotRepository struct {
+	logger *zap.Logger
+}
+
+func NewInterviewSlotRepository(logger *zap.Logger) repository.InterviewSlotRepositoryInterface {
+	return &InterviewSlotRepository{logger: logger}
+}
+
+func (r *InterviewSlotRepository) Create(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	m := slot.ToModel()
+
+	stmt := table.InterviewSlots.INSERT(
+		table.InterviewSlots.ID,
+		table.InterviewSlots.StartsAt,
+		table.InterviewSlots.EndsAt,
+		table.InterviewSlots.Location,
+		table.InterviewSlots.LocationID,
+		table.InterviewSlots.InterviewerID,
+		table.InterviewSlots.CreatedBy,
+	).MODEL(m).RETURNING(table.InterviewSlots.AllColumns)
+
+	var result model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create interview slot", zap.Error(err))
+		return nil, fmt.Errorf("failed to create interview slot: %w", err)
+	}
+
+	return aggregate.InterviewSlotFromModel(&result), nil
+}
+
+func (r *InterviewSlotRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.InterviewSlot, error) {
+	stmt := table.InterviewSlots.
+		SELECT(table.InterviewSlots.AllColumns).
+		WHERE(table.InterviewSlots.ID.EQ(postgres.UUID(id)))
+
+	return r.get(ctx, tx, stmt)
+}
+
+func (r *InterviewSlotRepository) GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.InterviewSlot, error) {
+	stmt := table.InterviewSlots.
+		SELECT(table.InterviewSlots.AllColumns).
+		WHERE(table.InterviewSlots.StudentID.EQ(postgres.Int32(studentID)))
+
+	return r.get(ctx, tx, stmt)
+}
+
+func (r *InterviewSlotRepository) ListFrom(ctx context.Context, tx *sql.Tx, from time.Time) ([]*aggregate.InterviewSlot, error) {
+	stmt := table.InterviewSlots.
+		SELECT(table.InterviewSlots.AllColumns).
+		WHERE(table.InterviewSlots.StartsAt.GT_EQ(postgres.TimestampzT(from))).
+		ORDER_BY(table.InterviewSlots.StartsAt.ASC())
+
+	var results []model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.InterviewSlot{}, nil
+		}
+		r.logger.Error("failed to list interview slots", zap.Error(err))
+		return nil, fmt.Errorf("failed to list interview slots: %w", err)
+	}
+
+	slots := make([]*aggregate.InterviewSlot, len(results))
+	for i := range results {
+		slots[i] = aggregate.InterviewSlotFromModel(&results[i])
+	}
+	return slots, nil
+}
+
+func (r *InterviewSlotRepository) Update(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	m := slot.ToModel()
+
+	stmt := table.InterviewSlots.UPDATE(
+		table.InterviewSlots.StudentID,
+		table.InterviewSlots.BookedAt,
+		table.InterviewSlots.InvitedAt,
+	).MODEL(m).
+		WHERE(table.InterviewSlots.ID.EQ(postgres.UUID(slot.ID))).
+		RETURNING(table.InterviewSlots.AllColumns)
+
+	var result model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrInterviewSlotNotFound
+		}
+		r.logger.Error("failed to update interview slot", zap.Error(err), zap.String("id", slot.ID.String()))
+		return nil, fmt.Errorf("failed to update interview slot: %w", err)
+	}
+
+	return aggregate.InterviewSlotFromModel(&result), nil
+}
+
+func (r *InterviewSlotRepository) Book(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	m := slot.ToModel()
+
+	stmt := table.InterviewSlots.UPDATE(
+		table.InterviewSlots.StudentID,
+		table.InterviewSlots.BookedAt,
+		table.InterviewSlots.InvitedAt,
+	).MODEL(m).
+		WHERE(
+			table.InterviewSlots.ID.EQ(postgres.UUID(slot.ID)).
+				AND(table.InterviewSlots.StudentID.IS_NULL()),
+		).
+		RETURNING(table.InterviewSlots.AllColumns)
+
+	var result model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrInterviewSlotBooked
+		}
+		r.logger.Error("failed to book interview slot", zap.Error(err), zap.String("id", slot.ID.String()))
+		return nil, fmt.Errorf("failed to book interview slot: %w", err)
+	}
+
+	return aggregate.InterviewSlotFromModel(&result), nil
+}
+
+func (r *InterviewSlotRepository) MarkInvited(ctx context.Context, tx *sql.Tx, id uuid.UUID, studentID int32, at time.Time) (*aggregate.InterviewSlot, error) {
+	stmt := table.InterviewSlots.UPDATE(table.InterviewSlots.InvitedAt).
+		SET(postgres.TimestampzT(at)).
+		WHERE(
+			table.InterviewSlots.ID.EQ(postgres.UUID(id)).
+				AND(table.InterviewSlots.StudentID.EQ(postgres.Int32(studentID))),
+		).
+		RETURNING(table.InterviewSlots.AllColumns)
+
+	var result model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrInterviewSlotNotFound
+		}
+		r.logger.Error("failed to mark interview invitation sent", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to mark interview invitation sent: %w", err)
+	}
+
+	return aggregate.InterviewSlotFromModel(&result), nil
+}
+
+func (r *InterviewSlotRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := table.InterviewSlots.
+		DELETE().
+		WHERE(table.InterviewSlots.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete interview slot", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to delete interview slot: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return studentErrors.ErrInterviewSlotNotFound
+	}
+	return nil
+}
+
+func (r *InterviewSlotRepository) get(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement) (*aggregate.InterviewSlot, error) {
+	var result model.InterviewSlots
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrInterviewSlotNotFound
+		}
+		r.logger.Error("failed to get interview slot", zap.Error(err))
+		return nil, fmt.Errorf("failed to get interview slot: %w", err)
+	}
+
+	return aggregate.InterviewSlotFromModel(&result), nil
+}
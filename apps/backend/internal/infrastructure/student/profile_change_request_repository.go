@@ -0,0 +1,150 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.ProfileChangeRequestRepositoryInterface = (*ProfileChangeRequestRepository)(nil)
+
+type ProfileChangeRequestRepository struct {
+	logger *zap.Logger
+}
+
+func NewProfileChangeRequestRepository(logger *zap.Logger) repository.ProfileChangeRequestRepositoryInterface {
+	return &ProfileChangeRequestRepository{logger: logger}
+}
+
+func (r *ProfileChangeRequestRepository) Create(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+	m := request.ToModel()
+
+	stmt := table.ProfileChangeRequests.INSERT(
+		table.ProfileChangeRequests.ID,
+		table.ProfileChangeRequests.StudentID,
+		table.ProfileChangeRequests.Status,
+		table.ProfileChangeRequests.Availability,
+		table.ProfileChangeRequests.MinWeeklyHours,
+		table.ProfileChangeRequests.MaxWeeklyHours,
+		table.ProfileChangeRequests.Conflicts,
+	).MODEL(m).RETURNING(table.ProfileChangeRequests.AllColumns)
+
+	var result model.ProfileChangeRequests
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create profile change request", zap.Error(err), zap.Int32("student_id", request.StudentID))
+		return nil, fmt.Errorf("failed to create profile change request: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *ProfileChangeRequestRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+	stmt := table.ProfileChangeRequests.
+		SELECT(table.ProfileChangeRequests.AllColumns).
+		WHERE(table.ProfileChangeRequests.ID.EQ(postgres.UUID(id)))
+
+	return r.get(ctx, tx, stmt, zap.String("id", id.String()))
+}
+
+func (r *ProfileChangeRequestRepository) GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ProfileChangeRequest, error) {
+	stmt := table.ProfileChangeRequests.
+		SELECT(table.ProfileChangeRequests.AllColumns).
+		WHERE(
+			table.ProfileChangeRequests.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.ProfileChangeRequests.Status.EQ(postgres.String(string(aggregate.ProfileChangeStatus_Pending)))),
+		)
+
+	return r.get(ctx, tx, stmt, zap.Int32("student_id", studentID))
+}
+
+func (r *ProfileChangeRequestRepository) List(ctx context.Context, tx *sql.Tx, filter repository.ProfileChangeFilter) ([]*aggregate.ProfileChangeRequest, error) {
+	condition := postgres.Bool(true)
+	if filter.StudentID != nil {
+		condition = condition.AND(table.ProfileChangeRequests.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+	if filter.Status != nil {
+		condition = condition.AND(table.ProfileChangeRequests.Status.EQ(postgres.String(string(*filter.Status))))
+	}
+
+	stmt := table.ProfileChangeRequests.
+		SELECT(table.ProfileChangeRequests.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.ProfileChangeRequests.CreatedAt.DESC())
+
+	var results []model.ProfileChangeRequests
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ProfileChangeRequest{}, nil
+		}
+		r.logger.Error("failed to list profile change requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to list profile change requests: %w", err)
+	}
+
+	requests := make([]*aggregate.ProfileChangeRequest, 0, len(results))
+	for i := range results {
+		request, err := r.fromModel(&results[i])
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func (r *ProfileChangeRequestRepository) Update(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+	m := request.ToModel()
+
+	stmt := table.ProfileChangeRequests.UPDATE(
+		table.ProfileChangeRequests.Status,
+		table.ProfileChangeRequests.ReviewedBy,
+		table.ProfileChangeRequests.ReviewedAt,
+		table.ProfileChangeRequests.ReviewNote,
+		table.ProfileChangeRequests.Conflicts,
+	).MODEL(m).
+		WHERE(table.ProfileChangeRequests.ID.EQ(postgres.UUID(request.ID))).
+		RETURNING(table.ProfileChangeRequests.AllColumns)
+
+	var result model.ProfileChangeRequests
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrProfileChangeNotFound
+		}
+		r.logger.Error("failed to update profile change request", zap.Error(err), zap.String("id", request.ID.String()))
+		return nil, fmt.Errorf("failed to update profile change request: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *ProfileChangeRequestRepository) get(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement, fields ...zap.Field) (*aggregate.ProfileChangeRequest, error) {
+	var result model.ProfileChangeRequests
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrProfileChangeNotFound
+		}
+		r.logger.Error("failed to get profile change request", append(fields, zap.Error(err))...)
+		return nil, fmt.Errorf("failed to get profile change request: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *ProfileChangeRequestRepository) fromModel(m *model.ProfileChangeRequests) (*aggregate.ProfileChangeRequest, error) {
+	request, err := aggregate.ProfileChangeRequestFromModel(m)
+	if err != nil {
+		r.logger.Error("failed to decode profile change request", zap.Error(err), zap.String("id", m.ID.String()))
+		return nil, fmt.Errorf("failed to decode profile change request: %w", err)
+	}
+	return request, nil
+}
@@ -0,0 +1,132 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.StudentDocumentRepositoryInterface = (*StudentDocumentRepository)(nil)
+
+type StudentDocumentRepository struct {
+	logger *zap.Logger
+}
+
+func NewStudentDocumentRepository(logger *zap.Logger) repository.StudentDocumentRepositoryInterface {
+	return &StudentDocumentRepository{logger: logger}
+}
+
+func (r *StudentDocumentRepository) Create(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+	m := document.ToModel()
+
+	stmt := table.StudentDocuments.INSERT(
+		table.StudentDocuments.ID,
+		table.StudentDocuments.StudentID,
+		table.StudentDocuments.DocumentType,
+		table.StudentDocuments.Filename,
+		table.StudentDocuments.ContentType,
+		table.StudentDocuments.SizeBytes,
+		table.StudentDocuments.Checksum,
+		table.StudentDocuments.StorageKey,
+		table.StudentDocuments.UploadedBy,
+		table.StudentDocuments.RetainUntil,
+	).MODEL(m).RETURNING(table.StudentDocuments.AllColumns)
+
+	var result model.StudentDocuments
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create student document", zap.Error(err), zap.String("id", document.ID.String()))
+		return nil, fmt.Errorf("failed to create student document: %w", err)
+	}
+
+	return aggregate.StudentDocumentFromModel(&result), nil
+}
+
+func (r *StudentDocumentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.StudentDocument, error) {
+	stmt := table.StudentDocuments.
+		SELECT(table.StudentDocuments.AllColumns).
+		WHERE(table.StudentDocuments.ID.EQ(postgres.UUID(id)))
+
+	var result model.StudentDocuments
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrDocumentNotFound
+		}
+		r.logger.Error("failed to get student document", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get student document: %w", err)
+	}
+
+	return aggregate.StudentDocumentFromModel(&result), nil
+}
+
+func (r *StudentDocumentRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.StudentDocument, error) {
+	stmt := table.StudentDocuments.
+		SELECT(table.StudentDocuments.AllColumns).
+		WHERE(table.StudentDocuments.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.StudentDocuments.CreatedAt.DESC())
+
+	return r.list(ctx, tx, stmt, zap.Int32("student_id", studentID))
+}
+
+func (r *StudentDocumentRepository) Update(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+	m := document.ToModel()
+
+	stmt := table.StudentDocuments.UPDATE(
+		table.StudentDocuments.StudentID,
+		table.StudentDocuments.RetainUntil,
+		table.StudentDocuments.PurgedAt,
+	).MODEL(m).
+		WHERE(table.StudentDocuments.ID.EQ(postgres.UUID(document.ID))).
+		RETURNING(table.StudentDocuments.AllColumns)
+
+	var result model.StudentDocuments
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrDocumentNotFound
+		}
+		r.logger.Error("failed to update student document", zap.Error(err), zap.String("id", document.ID.String()))
+		return nil, fmt.Errorf("failed to update student document: %w", err)
+	}
+
+	return aggregate.StudentDocumentFromModel(&result), nil
+}
+
+func (r *StudentDocumentRepository) ListExpired(ctx context.Context, tx *sql.Tx, now time.Time) ([]*aggregate.StudentDocument, error) {
+	stmt := table.StudentDocuments.
+		SELECT(table.StudentDocuments.AllColumns).
+		WHERE(
+			table.StudentDocuments.PurgedAt.IS_NULL().
+				AND(table.StudentDocuments.RetainUntil.LT(postgres.TimestampzT(now))),
+		).
+		ORDER_BY(table.StudentDocuments.RetainUntil.ASC())
+
+	return r.list(ctx, tx, stmt)
+}
+
+func (r *StudentDocumentRepository) list(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement, fields ...zap.Field) ([]*aggregate.StudentDocument, error) {
+	var results []model.StudentDocuments
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.StudentDocument{}, nil
+		}
+		r.logger.Error("failed to list student documents", append(fields, zap.Error(err))...)
+		return nil, fmt.Errorf("failed to list student documents: %w", err)
+	}
+
+	documents := make([]*aggregate.StudentDocument, len(results))
+	for i := range results {
+		documents[i] = aggregate.StudentDocumentFromModel(&results[i])
+	}
+	return documents, nil
+}
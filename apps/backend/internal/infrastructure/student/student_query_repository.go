@@ -0,0 +1,179 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"go.uber.org/zap"
+)
+
+// The expressions below must match the indexes on auth.students, or the
+// planner falls back to a sequential scan.
+
+// studentSearchSQL is the text StudentQuery.Search matches, indexed by
+// students_idx_search (trigram).
+const studentSearchSQL = `(auth.students.first_name || ' ' || auth.students.last_name || ' ' || auth.students.email_address || ' ' || auth.students.student_id::text)`
+
+const studentGPASQL = `(auth.students.transcript_metadata->>'overall_gpa')::numeric`
+
+// studentSortSQL is the ORDER BY key of each sort; ties are broken by student
+// ID. Students without a GPA sort below every GPA.
+var studentSortSQL = map[repository.StudentSort]string{
+	repository.StudentSort_AppliedAt: `auth.students.created_at`,
+	repository.StudentSort_Name:      `lower(auth.students.last_name || ', ' || auth.students.first_name)`,
+	repository.StudentSort_GPA:       `COALESCE(` + studentGPASQL + `, -1)`,
+}
+
+// eligibleCourseSQL mirrors aggregate.EligibilityPolicy for one course: an
+// override decides, otherwise any attempt with a qualifying grade does.
+const eligibleCourseSQL = `(EXISTS (
+	SELECT 1 FROM auth.course_eligibility_overrides o
+	WHERE o.student_id = auth.students.student_id AND o.course_code = #course AND o.eligible
+) OR (NOT EXISTS (
+	SELECT 1 FROM auth.course_eligibility_overrides o
+	WHERE o.student_id = auth.students.student_id AND o.course_code = #course AND NOT o.eligible
+) AND EXISTS (
+	SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(auth.students.transcript_metadata->'courses') = 'array'
+		THEN auth.students.transcript_metadata->'courses' ELSE '[]'::jsonb END) c
+	WHERE upper(regexp_replace(c->>'code', '\s', '', 'g')) = #course
+		AND upper(trim(c->>'grade')) IN (%s)
+)))`
+
+func (r *StudentRepository) Query(ctx context.Context, tx *sql.Tx, query repository.StudentQuery) ([]*aggregate.Student, int, error) {
+	condition := studentQueryCondition(query)
+
+	countStmt := table.Students.
+		SELECT(postgres.COUNT(table.Students.StudentID).AS("count")).
+		WHERE(condition)
+
+	var countResult struct{ Count int }
+	err := countStmt.QueryContext(ctx, tx, &countResult)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		r.logger.Error("failed to count students", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count students: %w", err)
+	}
+
+	if query.After != nil {
+		condition = condition.AND(studentCursorCondition(query))
+	}
+
+	var orderBy []postgres.OrderByClause
+	if sortSQL, ok := studentSortSQL[query.Sort]; ok {
+		if query.Descending {
+			orderBy = append(orderBy, postgres.Raw(sortSQL).DESC())
+		} else {
+			orderBy = append(orderBy, postgres.Raw(sortSQL).ASC())
+		}
+	}
+	if query.Descending {
+		orderBy = append(orderBy, table.Students.StudentID.DESC())
+	} else {
+		orderBy = append(orderBy, table.Students.StudentID.ASC())
+	}
+
+	stmt := table.Students.SELECT(table.Students.AllColumns).
+		WHERE(condition).
+		ORDER_BY(orderBy...).
+		LIMIT(int64(query.Limit))
+
+	var models []model.Students
+	if err := stmt.QueryContext(ctx, tx, &models); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Student{}, countResult.Count, nil
+		}
+		r.logger.Error("failed to query students", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to query students: %w", err)
+	}
+
+	students, err := r.toAggregates(models)
+	if err != nil {
+		return nil, 0, err
+	}
+	return students, countResult.Count, nil
+}
+
+func studentQueryCondition(query repository.StudentQuery) postgres.BoolExpression {
+	condition := postgres.Bool(true)
+
+	if statusCondition, ok := studentStatusCondition(query.Status); ok {
+		condition = condition.AND(statusCondition)
+	}
+	for _, term := range strings.Fields(query.Search) {
+		condition = condition.AND(postgres.RawBool(studentSearchSQL+" ILIKE #term", postgres.RawArgs{"#term": likePattern(term)}))
+	}
+	if query.Programme != "" {
+		condition = condition.AND(postgres.RawBool("auth.students.transcript_metadata->>'current_programme' ILIKE #programme",
+			postgres.RawArgs{"#programme": likePattern(query.Programme)}))
+	}
+	if query.Year != nil {
+		condition = condition.AND(postgres.RawBool("auth.students.transcript_metadata->>'current_year' = #year",
+			postgres.RawArgs{"#year": strconv.Itoa(int(*query.Year))}))
+	}
+	if query.MinGPA != nil {
+		condition = condition.AND(postgres.RawBool(studentGPASQL+" >= #min_gpa", postgres.RawArgs{"#min_gpa": *query.MinGPA}))
+	}
+	if query.MaxGPA != nil {
+		condition = condition.AND(postgres.RawBool(studentGPASQL+" <= #max_gpa", postgres.RawArgs{"#max_gpa": *query.MaxGPA}))
+	}
+	if query.EligibleCourse != "" {
+		condition = condition.AND(eligibleCourseCondition(query.EligibleCourse, query.EligibleGrades))
+	}
+	if query.AvailableDay != nil {
+		day := strconv.Itoa(*query.AvailableDay)
+		if query.AvailableHour != nil {
+			slot := fmt.Sprintf(`{%q: [%d]}`, day, *query.AvailableHour)
+			condition = condition.AND(postgres.RawBool("auth.students.availability @> #slot::jsonb", postgres.RawArgs{"#slot": slot}))
+		} else {
+			condition = condition.AND(postgres.RawBool("auth.students.availability->#day <> '[]'::jsonb", postgres.RawArgs{"#day": day}))
+		}
+	}
+
+	return condition
+}
+
+// eligibleCourseCondition binds each grade to its own argument; the names are
+// fixed width so none is a prefix of another.
+func eligibleCourseCondition(courseCode string, grades []string) postgres.BoolExpression {
+	args := postgres.RawArgs{"#course": courseCode}
+	names := make([]string, len(grades))
+	for i, g := range grades {
+		names[i] = fmt.Sprintf("#grade%02d", i)
+		args[names[i]] = g
+	}
+	gradeList := "NULL"
+	if len(names) > 0 {
+		gradeList = strings.Join(names, ", ")
+	}
+	return postgres.RawBool(fmt.Sprintf(eligibleCourseSQL, gradeList), args)
+}
+
+// studentCursorCondition keeps the students after the cursor in the query's
+// order.
+func studentCursorCondition(query repository.StudentQuery) postgres.BoolExpression {
+	op := ">"
+	if query.Descending {
+		op = "<"
+	}
+	sortSQL, ok := studentSortSQL[query.Sort]
+	if !ok {
+		return postgres.RawBool("auth.students.student_id "+op+" #after_id", postgres.RawArgs{"#after_id": query.After.StudentID})
+	}
+	return postgres.RawBool(fmt.Sprintf("(%s, auth.students.student_id) %s (#after_value, #after_id)", sortSQL, op),
+		postgres.RawArgs{"#after_value": query.After.Value, "#after_id": query.After.StudentID})
+}
+
+// likePattern matches text containing s, escaping LIKE wildcards in s.
+func likePattern(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+	return "%" + s + "%"
+}
@@ -166,22 +166,8 @@ func (r *StudentRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.
 }
 
 func (r *StudentRepository) ListByStatus(ctx context.Context, tx *sql.Tx, status string) ([]*aggregate.Student, error) {
-	var condition postgres.BoolExpression
-
-	switch status {
-	case "pending":
-		condition = table.Students.AcceptedAt.IS_NULL().
-			AND(table.Students.RejectedAt.IS_NULL()).
-			AND(table.Students.DeletedAt.IS_NULL())
-	case "accepted":
-		condition = table.Students.AcceptedAt.IS_NOT_NULL().
-			AND(table.Students.DeletedAt.IS_NULL())
-	case "rejected":
-		condition = table.Students.RejectedAt.IS_NOT_NULL().
-			AND(table.Students.DeletedAt.IS_NULL())
-	case "deactivated":
-		condition = table.Students.DeletedAt.IS_NOT_NULL()
-	default:
+	condition, ok := studentStatusCondition(status)
+	if !ok {
 		return r.List(ctx, tx)
 	}
 
@@ -201,6 +187,27 @@ func (r *StudentRepository) ListByStatus(ctx context.Context, tx *sql.Tx, status
 	return r.toAggregates(models)
 }
 
+// studentStatusCondition matches the students with a status; ok is false for
+// an unknown status.
+func studentStatusCondition(status string) (postgres.BoolExpression, bool) {
+	switch status {
+	case "pending":
+		return table.Students.AcceptedAt.IS_NULL().
+			AND(table.Students.RejectedAt.IS_NULL()).
+			AND(table.Students.DeletedAt.IS_NULL()), true
+	case "accepted":
+		return table.Students.AcceptedAt.IS_NOT_NULL().
+			AND(table.Students.DeletedAt.IS_NULL()), true
+	case "rejected":
+		return table.Students.RejectedAt.IS_NOT_NULL().
+			AND(table.Students.DeletedAt.IS_NULL()), true
+	case "deactivated":
+		return table.Students.DeletedAt.IS_NOT_NULL(), true
+	default:
+		return nil, false
+	}
+}
+
 func (r *StudentRepository) ListByIDs(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.Student, error) {
 	if len(studentIDs) == 0 {
 		return []*aggregate.Student{}, nil
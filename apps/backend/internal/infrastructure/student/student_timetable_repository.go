@@ -0,0 +1,81 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"go.uber.org/zap"
+)
+
+var _ repository.StudentTimetableRepositoryInterface = (*StudentTimetableRepository)(nil)
+
+type StudentTimetableRepository struct {
+	logger *zap.Logger
+}
+
+func NewStudentTimetableRepository(logger *zap.Logger) repository.StudentTimetableRepositoryInterface {
+	return &StudentTimetableRepository{logger: logger}
+}
+
+func (r *StudentTimetableRepository) GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.StudentTimetable, error) {
+	stmt := table.StudentTimetables.
+		SELECT(table.StudentTimetables.AllColumns).
+		WHERE(table.StudentTimetables.StudentID.EQ(postgres.Int32(studentID)))
+
+	var result model.StudentTimetables
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrTimetableNotFound
+		}
+		r.logger.Error("failed to get student timetable", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to get student timetable: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *StudentTimetableRepository) Upsert(ctx context.Context, tx *sql.Tx, timetable *aggregate.StudentTimetable) (*aggregate.StudentTimetable, error) {
+	m := timetable.ToModel()
+
+	stmt := table.StudentTimetables.
+		INSERT(
+			table.StudentTimetables.StudentID,
+			table.StudentTimetables.Source,
+			table.StudentTimetables.Classes,
+		).
+		MODEL(m).
+		ON_CONFLICT(table.StudentTimetables.StudentID).
+		DO_UPDATE(
+			postgres.SET(
+				table.StudentTimetables.Source.SET(table.StudentTimetables.EXCLUDED.Source),
+				table.StudentTimetables.Classes.SET(table.StudentTimetables.EXCLUDED.Classes),
+			),
+		).
+		RETURNING(table.StudentTimetables.AllColumns)
+
+	var result model.StudentTimetables
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to upsert student timetable", zap.Error(err), zap.Int32("student_id", timetable.StudentID))
+		return nil, fmt.Errorf("failed to upsert student timetable: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *StudentTimetableRepository) fromModel(m *model.StudentTimetables) (*aggregate.StudentTimetable, error) {
+	timetable, err := aggregate.StudentTimetableFromModel(m)
+	if err != nil {
+		r.logger.Error("failed to decode student timetable", zap.Error(err), zap.Int32("student_id", m.StudentID))
+		return nil, fmt.Errorf("failed to decode student timetable: %w", err)
+	}
+	return timetable, nil
+}
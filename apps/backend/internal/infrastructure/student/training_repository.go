@@ -0,0 +1,231 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.TrainingRepositoryInterface = (*TrainingRepository)(nil)
+
+type TrainingRepository struct {
+	logger *zap.Logger
+}
+
+func NewTrainingRepository(logger *zap.Logger) repository.TrainingRepositoryInterface {
+	return &TrainingRepository{logger: logger}
+}
+
+func (r *TrainingRepository) CreateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+	m := requirement.ToModel()
+
+	stmt := table.TrainingRequirements.INSERT(
+		table.TrainingRequirements.ID,
+		table.TrainingRequirements.Name,
+		table.TrainingRequirements.Description,
+		table.TrainingRequirements.IsBlocking,
+		table.TrainingRequirements.ValidityMonths,
+		table.TrainingRequirements.IsActive,
+	).MODEL(m).RETURNING(table.TrainingRequirements.AllColumns)
+
+	var result model.TrainingRequirements
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create training requirement", zap.Error(err))
+		return nil, fmt.Errorf("failed to create training requirement: %w", err)
+	}
+
+	return aggregate.TrainingRequirementFromModel(&result), nil
+}
+
+func (r *TrainingRepository) GetRequirementByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TrainingRequirement, error) {
+	stmt := table.TrainingRequirements.
+		SELECT(table.TrainingRequirements.AllColumns).
+		WHERE(table.TrainingRequirements.ID.EQ(postgres.UUID(id)))
+
+	var result model.TrainingRequirements
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrTrainingRequirementNotFound
+		}
+		r.logger.Error("failed to get training requirement", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get training requirement: %w", err)
+	}
+
+	return aggregate.TrainingRequirementFromModel(&result), nil
+}
+
+func (r *TrainingRepository) UpdateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+	m := requirement.ToModel()
+
+	stmt := table.TrainingRequirements.
+		UPDATE(
+			table.TrainingRequirements.Name,
+			table.TrainingRequirements.Description,
+			table.TrainingRequirements.IsBlocking,
+			table.TrainingRequirements.ValidityMonths,
+			table.TrainingRequirements.IsActive,
+		).
+		MODEL(m).
+		WHERE(table.TrainingRequirements.ID.EQ(postgres.UUID(requirement.ID))).
+		RETURNING(table.TrainingRequirements.AllColumns)
+
+	var result model.TrainingRequirements
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrTrainingRequirementNotFound
+		}
+		r.logger.Error("failed to update training requirement", zap.Error(err), zap.String("id", requirement.ID.String()))
+		return nil, fmt.Errorf("failed to update training requirement: %w", err)
+	}
+
+	return aggregate.TrainingRequirementFromModel(&result), nil
+}
+
+func (r *TrainingRepository) ListRequirements(ctx context.Context, tx *sql.Tx) ([]*aggregate.TrainingRequirement, error) {
+	stmt := table.TrainingRequirements.
+		SELECT(table.TrainingRequirements.AllColumns).
+		ORDER_BY(table.TrainingRequirements.Name.ASC())
+
+	var results []model.TrainingRequirements
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TrainingRequirement{}, nil
+		}
+		r.logger.Error("failed to list training requirements", zap.Error(err))
+		return nil, fmt.Errorf("failed to list training requirements: %w", err)
+	}
+
+	requirements := make([]*aggregate.TrainingRequirement, len(results))
+	for i := range results {
+		requirements[i] = aggregate.TrainingRequirementFromModel(&results[i])
+	}
+	return requirements, nil
+}
+
+func (r *TrainingRepository) UpsertRecord(ctx context.Context, tx *sql.Tx, record *aggregate.TrainingRecord) (*aggregate.TrainingRecord, error) {
+	m := record.ToModel()
+
+	stmt := table.TrainingRecords.INSERT(
+		table.TrainingRecords.ID,
+		table.TrainingRecords.StudentID,
+		table.TrainingRecords.RequirementID,
+		table.TrainingRecords.CompletedOn,
+		table.TrainingRecords.ExpiresOn,
+		table.TrainingRecords.EvidenceDocumentID,
+		table.TrainingRecords.RecordedBy,
+	).MODEL(m).
+		ON_CONFLICT(table.TrainingRecords.StudentID, table.TrainingRecords.RequirementID).
+		DO_UPDATE(
+			postgres.SET(
+				table.TrainingRecords.CompletedOn.SET(table.TrainingRecords.EXCLUDED.CompletedOn),
+				table.TrainingRecords.ExpiresOn.SET(table.TrainingRecords.EXCLUDED.ExpiresOn),
+				table.TrainingRecords.EvidenceDocumentID.SET(table.TrainingRecords.EXCLUDED.EvidenceDocumentID),
+				table.TrainingRecords.RecordedBy.SET(table.TrainingRecords.EXCLUDED.RecordedBy),
+				table.TrainingRecords.ReminderSentAt.SET(postgres.TimestampzExp(postgres.NULL)),
+			),
+		).
+		RETURNING(table.TrainingRecords.AllColumns)
+
+	var result model.TrainingRecords
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to upsert training record", zap.Error(err), zap.Int32("student_id", record.StudentID))
+		return nil, fmt.Errorf("failed to upsert training record: %w", err)
+	}
+
+	return aggregate.TrainingRecordFromModel(&result), nil
+}
+
+func (r *TrainingRepository) DeleteRecord(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := table.TrainingRecords.
+		DELETE().
+		WHERE(table.TrainingRecords.ID.EQ(postgres.UUID(id)))
+
+	res, err := stmt.ExecContext(ctx, tx)
+	if err != nil {
+		r.logger.Error("failed to delete training record", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to delete training record: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return studentErrors.ErrTrainingRecordNotFound
+	}
+	return nil
+}
+
+func (r *TrainingRepository) ListRecords(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.TrainingRecord, error) {
+	if len(studentIDs) == 0 {
+		return []*aggregate.TrainingRecord{}, nil
+	}
+
+	ids := make([]postgres.Expression, len(studentIDs))
+	for i, id := range studentIDs {
+		ids[i] = postgres.Int32(id)
+	}
+	stmt := table.TrainingRecords.
+		SELECT(table.TrainingRecords.AllColumns).
+		WHERE(table.TrainingRecords.StudentID.IN(ids...)).
+		ORDER_BY(table.TrainingRecords.StudentID.ASC(), table.TrainingRecords.CompletedOn.DESC())
+
+	return r.listRecords(ctx, tx, stmt)
+}
+
+func (r *TrainingRepository) ListUnremindedExpiring(ctx context.Context, tx *sql.Tx, before time.Time) ([]*aggregate.TrainingRecord, error) {
+	stmt := table.TrainingRecords.
+		SELECT(table.TrainingRecords.AllColumns).
+		WHERE(
+			table.TrainingRecords.ReminderSentAt.IS_NULL().
+				AND(table.TrainingRecords.ExpiresOn.LT_EQ(postgres.DateT(before))),
+		).
+		ORDER_BY(table.TrainingRecords.ExpiresOn.ASC())
+
+	return r.listRecords(ctx, tx, stmt)
+}
+
+func (r *TrainingRepository) listRecords(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement) ([]*aggregate.TrainingRecord, error) {
+	var results []model.TrainingRecords
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TrainingRecord{}, nil
+		}
+		r.logger.Error("failed to list training records", zap.Error(err))
+		return nil, fmt.Errorf("failed to list training records: %w", err)
+	}
+
+	records := make([]*aggregate.TrainingRecord, len(results))
+	for i := range results {
+		records[i] = aggregate.TrainingRecordFromModel(&results[i])
+	}
+	return records, nil
+}
+
+func (r *TrainingRepository) MarkReminderSent(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	exprs := make([]postgres.Expression, len(ids))
+	for i, id := range ids {
+		exprs[i] = postgres.UUID(id)
+	}
+	stmt := table.TrainingRecords.
+		UPDATE(table.TrainingRecords.ReminderSentAt).
+		SET(postgres.TimestampzT(at)).
+		WHERE(table.TrainingRecords.ID.IN(exprs...))
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to mark training reminders sent", zap.Error(err), zap.Int("count", len(ids)))
+		return fmt.Errorf("failed to mark training reminders sent: %w", err)
+	}
+	return nil
+}
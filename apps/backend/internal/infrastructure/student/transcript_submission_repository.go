@@ -0,0 +1,148 @@
+package student
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/auth/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.TranscriptSubmissionRepositoryInterface = (*TranscriptSubmissionRepository)(nil)
+
+type TranscriptSubmissionRepository struct {
+	logger *zap.Logger
+}
+
+func NewTranscriptSubmissionRepository(logger *zap.Logger) repository.TranscriptSubmissionRepositoryInterface {
+	return &TranscriptSubmissionRepository{logger: logger}
+}
+
+func (r *TranscriptSubmissionRepository) Create(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+	m := submission.ToModel()
+
+	stmt := table.TranscriptSubmissions.INSERT(
+		table.TranscriptSubmissions.ID,
+		table.TranscriptSubmissions.StudentID,
+		table.TranscriptSubmissions.DocumentID,
+		table.TranscriptSubmissions.Status,
+		table.TranscriptSubmissions.TranscriptMetadata,
+		table.TranscriptSubmissions.Diff,
+	).MODEL(m).RETURNING(table.TranscriptSubmissions.AllColumns)
+
+	var result model.TranscriptSubmissions
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create transcript submission", zap.Error(err), zap.Int32("student_id", submission.StudentID))
+		return nil, fmt.Errorf("failed to create transcript submission: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *TranscriptSubmissionRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TranscriptSubmission, error) {
+	stmt := table.TranscriptSubmissions.
+		SELECT(table.TranscriptSubmissions.AllColumns).
+		WHERE(table.TranscriptSubmissions.ID.EQ(postgres.UUID(id)))
+
+	return r.get(ctx, tx, stmt, zap.String("id", id.String()))
+}
+
+func (r *TranscriptSubmissionRepository) GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TranscriptSubmission, error) {
+	stmt := table.TranscriptSubmissions.
+		SELECT(table.TranscriptSubmissions.AllColumns).
+		WHERE(
+			table.TranscriptSubmissions.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.TranscriptSubmissions.Status.EQ(postgres.String(string(aggregate.TranscriptSubmissionStatus_Pending)))),
+		)
+
+	return r.get(ctx, tx, stmt, zap.Int32("student_id", studentID))
+}
+
+func (r *TranscriptSubmissionRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TranscriptSubmissionFilter) ([]*aggregate.TranscriptSubmission, error) {
+	condition := postgres.Bool(true)
+	if filter.StudentID != nil {
+		condition = condition.AND(table.TranscriptSubmissions.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+	if filter.Status != nil {
+		condition = condition.AND(table.TranscriptSubmissions.Status.EQ(postgres.String(string(*filter.Status))))
+	}
+
+	stmt := table.TranscriptSubmissions.
+		SELECT(table.TranscriptSubmissions.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.TranscriptSubmissions.CreatedAt.DESC())
+
+	var results []model.TranscriptSubmissions
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TranscriptSubmission{}, nil
+		}
+		r.logger.Error("failed to list transcript submissions", zap.Error(err))
+		return nil, fmt.Errorf("failed to list transcript submissions: %w", err)
+	}
+
+	submissions := make([]*aggregate.TranscriptSubmission, 0, len(results))
+	for i := range results {
+		submission, err := r.fromModel(&results[i])
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+	return submissions, nil
+}
+
+func (r *TranscriptSubmissionRepository) Update(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+	m := submission.ToModel()
+
+	stmt := table.TranscriptSubmissions.UPDATE(
+		table.TranscriptSubmissions.Status,
+		table.TranscriptSubmissions.ReviewedBy,
+		table.TranscriptSubmissions.ReviewedAt,
+		table.TranscriptSubmissions.ReviewNote,
+	).MODEL(m).
+		WHERE(table.TranscriptSubmissions.ID.EQ(postgres.UUID(submission.ID))).
+		RETURNING(table.TranscriptSubmissions.AllColumns)
+
+	var result model.TranscriptSubmissions
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrTranscriptSubmissionNotFound
+		}
+		r.logger.Error("failed to update transcript submission", zap.Error(err), zap.String("id", submission.ID.String()))
+		return nil, fmt.Errorf("failed to update transcript submission: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *TranscriptSubmissionRepository) get(ctx context.Context, tx *sql.Tx, stmt postgres.SelectStatement, fields ...zap.Field) (*aggregate.TranscriptSubmission, error) {
+	var result model.TranscriptSubmissions
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, studentErrors.ErrTranscriptSubmissionNotFound
+		}
+		r.logger.Error("failed to get transcript submission", append(fields, zap.Error(err))...)
+		return nil, fmt.Errorf("failed to get transcript submission: %w", err)
+	}
+
+	return r.fromModel(&result)
+}
+
+func (r *TranscriptSubmissionRepository) fromModel(m *model.TranscriptSubmissions) (*aggregate.TranscriptSubmission, error) {
+	submission, err := aggregate.TranscriptSubmissionFromModel(m)
+	if err != nil {
+		r.logger.Error("failed to decode transcript submission", zap.Error(err), zap.String("id", m.ID.String()))
+		return nil, fmt.Errorf("failed to decode transcript submission: %w", err)
+	}
+	return submission, nil
+}
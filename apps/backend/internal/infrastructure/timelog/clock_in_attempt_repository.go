@@ -0,0 +1,126 @@
+package timelog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"go.uber.org/zap"
+)
+
+var _ repository.ClockInAttemptRepositoryInterface = (*ClockInAttemptRepository)(nil)
+
+type ClockInAttemptRepository struct {
+	logger *zap.Logger
+}
+
+func NewClockInAttemptRepository(logger *zap.Logger) repository.ClockInAttemptRepositoryInterface {
+	return &ClockInAttemptRepository{
+		logger: logger,
+	}
+}
+
+func (r *ClockInAttemptRepository) Create(ctx context.Context, tx *sql.Tx, attempt *aggregate.ClockInAttempt) error {
+	m := attempt.ToModel()
+
+	stmt := table.ClockInAttempts.INSERT(
+		table.ClockInAttempts.ID,
+		table.ClockInAttempts.StudentID,
+		table.ClockInAttempts.Code,
+		table.ClockInAttempts.Succeeded,
+		table.ClockInAttempts.AttemptedAt,
+	).MODEL(m)
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to create clock-in attempt", zap.Error(err))
+		return fmt.Errorf("failed to create clock-in attempt: %w", err)
+	}
+	return nil
+}
+
+func (r *ClockInAttemptRepository) CountFailuresSince(ctx context.Context, tx *sql.Tx, studentID int32, since time.Time) (int, error) {
+	stmt := table.ClockInAttempts.
+		SELECT(postgres.COUNT(table.ClockInAttempts.ID).AS("count")).
+		WHERE(
+			table.ClockInAttempts.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.ClockInAttempts.Succeeded.EQ(postgres.Bool(false))).
+				AND(table.ClockInAttempts.AttemptedAt.GT(postgres.TimestampzT(since))),
+		)
+
+	var result struct{ Count int }
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		r.logger.Error("failed to count failed clock-in attempts", zap.Error(err), zap.Int32("student_id", studentID))
+		return 0, fmt.Errorf("failed to count failed clock-in attempts: %w", err)
+	}
+	return result.Count, nil
+}
+
+func (r *ClockInAttemptRepository) LastSuccessAt(ctx context.Context, tx *sql.Tx, studentID int32) (*time.Time, error) {
+	stmt := table.ClockInAttempts.
+		SELECT(table.ClockInAttempts.AllColumns).
+		WHERE(
+			table.ClockInAttempts.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.ClockInAttempts.Succeeded.EQ(postgres.Bool(true))),
+		).
+		ORDER_BY(table.ClockInAttempts.AttemptedAt.DESC()).
+		LIMIT(1)
+
+	var result model.ClockInAttempts
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error("failed to get last successful clock-in attempt", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to get last successful clock-in attempt: %w", err)
+	}
+	return &result.AttemptedAt, nil
+}
+
+func (r *ClockInAttemptRepository) CountStudentsFailingCodeSince(ctx context.Context, tx *sql.Tx, code string, since time.Time) (int, error) {
+	stmt := table.ClockInAttempts.
+		SELECT(postgres.COUNT(postgres.DISTINCT(table.ClockInAttempts.StudentID)).AS("count")).
+		WHERE(
+			table.ClockInAttempts.Code.EQ(postgres.String(code)).
+				AND(table.ClockInAttempts.Succeeded.EQ(postgres.Bool(false))).
+				AND(table.ClockInAttempts.AttemptedAt.GT(postgres.TimestampzT(since))),
+		)
+
+	var result struct{ Count int }
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		r.logger.Error("failed to count students failing clock-in code", zap.Error(err))
+		return 0, fmt.Errorf("failed to count students failing clock-in code: %w", err)
+	}
+	return result.Count, nil
+}
+
+func (r *ClockInAttemptRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.ClockInAttempt, error) {
+	stmt := table.ClockInAttempts.
+		SELECT(table.ClockInAttempts.AllColumns).
+		WHERE(table.ClockInAttempts.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.ClockInAttempts.AttemptedAt.DESC()).
+		LIMIT(int64(limit))
+
+	var results []model.ClockInAttempts
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ClockInAttempt{}, nil
+		}
+		r.logger.Error("failed to list clock-in attempts", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to list clock-in attempts: %w", err)
+	}
+
+	attempts := make([]*aggregate.ClockInAttempt, len(results))
+	for i, m := range results {
+		a := aggregate.ClockInAttemptFromModel(m)
+		attempts[i] = &a
+	}
+	return attempts, nil
+}
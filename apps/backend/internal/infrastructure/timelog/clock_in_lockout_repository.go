@@ -0,0 +1,148 @@
+package timelog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.ClockInLockoutRepositoryInterface = (*ClockInLockoutRepository)(nil)
+
+type ClockInLockoutRepository struct {
+	logger *zap.Logger
+}
+
+func NewClockInLockoutRepository(logger *zap.Logger) repository.ClockInLockoutRepositoryInterface {
+	return &ClockInLockoutRepository{
+		logger: logger,
+	}
+}
+
+func (r *ClockInLockoutRepository) Create(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+	m := lockout.ToModel()
+
+	stmt := table.ClockInLockouts.INSERT(
+		table.ClockInLockouts.ID,
+		table.ClockInLockouts.StudentID,
+		table.ClockInLockouts.Level,
+		table.ClockInLockouts.FailedAttempts,
+		table.ClockInLockouts.LockedAt,
+		table.ClockInLockouts.LockedUntil,
+	).MODEL(m).RETURNING(table.ClockInLockouts.AllColumns)
+
+	var result model.ClockInLockouts
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create clock-in lockout", zap.Error(err))
+		return nil, fmt.Errorf("failed to create clock-in lockout: %w", err)
+	}
+
+	l := aggregate.ClockInLockoutFromModel(result)
+	return &l, nil
+}
+
+func (r *ClockInLockoutRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ClockInLockout, error) {
+	stmt := table.ClockInLockouts.
+		SELECT(table.ClockInLockouts.AllColumns).
+		WHERE(table.ClockInLockouts.ID.EQ(postgres.UUID(id)))
+
+	var result model.ClockInLockouts
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrLockoutNotFound
+		}
+		r.logger.Error("failed to get clock-in lockout", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get clock-in lockout: %w", err)
+	}
+
+	l := aggregate.ClockInLockoutFromModel(result)
+	return &l, nil
+}
+
+func (r *ClockInLockoutRepository) GetLatestByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ClockInLockout, error) {
+	stmt := table.ClockInLockouts.
+		SELECT(table.ClockInLockouts.AllColumns).
+		WHERE(table.ClockInLockouts.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.ClockInLockouts.LockedAt.DESC()).
+		LIMIT(1)
+
+	var result model.ClockInLockouts
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrLockoutNotFound
+		}
+		r.logger.Error("failed to get latest clock-in lockout", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to get latest clock-in lockout: %w", err)
+	}
+
+	l := aggregate.ClockInLockoutFromModel(result)
+	return &l, nil
+}
+
+func (r *ClockInLockoutRepository) List(ctx context.Context, tx *sql.Tx, filter repository.ClockInLockoutFilter) ([]*aggregate.ClockInLockout, error) {
+	condition := postgres.Bool(true)
+	if filter.StudentID != nil {
+		condition = condition.AND(table.ClockInLockouts.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+	if filter.ActiveAt != nil {
+		condition = condition.AND(
+			table.ClockInLockouts.ClearedAt.IS_NULL().
+				AND(table.ClockInLockouts.LockedUntil.GT(postgres.TimestampzT(*filter.ActiveAt))),
+		)
+	}
+
+	stmt := table.ClockInLockouts.
+		SELECT(table.ClockInLockouts.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.ClockInLockouts.LockedAt.DESC())
+
+	var results []model.ClockInLockouts
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.ClockInLockout{}, nil
+		}
+		r.logger.Error("failed to list clock-in lockouts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list clock-in lockouts: %w", err)
+	}
+
+	lockouts := make([]*aggregate.ClockInLockout, len(results))
+	for i, m := range results {
+		l := aggregate.ClockInLockoutFromModel(m)
+		lockouts[i] = &l
+	}
+	return lockouts, nil
+}
+
+func (r *ClockInLockoutRepository) Update(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+	stmt := table.ClockInLockouts.UPDATE(
+		table.ClockInLockouts.ClearedAt,
+		table.ClockInLockouts.ClearedBy,
+	).SET(
+		lockout.ClearedAt,
+		lockout.ClearedBy,
+	).WHERE(
+		table.ClockInLockouts.ID.EQ(postgres.UUID(lockout.ID)),
+	).RETURNING(table.ClockInLockouts.AllColumns)
+
+	var result model.ClockInLockouts
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrLockoutNotFound
+		}
+		r.logger.Error("failed to update clock-in lockout", zap.Error(err), zap.String("id", lockout.ID.String()))
+		return nil, fmt.Errorf("failed to update clock-in lockout: %w", err)
+	}
+
+	l := aggregate.ClockInLockoutFromModel(result)
+	return &l, nil
+}
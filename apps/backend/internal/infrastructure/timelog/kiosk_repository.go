@@ -0,0 +1,175 @@
+package timelog
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/crypto"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.KioskRepositoryInterface = (*KioskRepository)(nil)
+
+type KioskRepository struct {
+	logger        *zap.Logger
+	encryptionKey []byte
+}
+
+func NewKioskRepository(logger *zap.Logger, encryptionKey string) repository.KioskRepositoryInterface {
+	keyBytes, err := hex.DecodeString(encryptionKey)
+	if err != nil {
+		logger.Fatal("ENCRYPTION_KEY is not valid hex", zap.Error(err))
+	}
+	if len(keyBytes) != 32 {
+		logger.Fatal("ENCRYPTION_KEY must decode to exactly 32 bytes", zap.Int("got", len(keyBytes)))
+	}
+
+	return &KioskRepository{
+		logger:        logger,
+		encryptionKey: keyBytes,
+	}
+}
+
+func (r *KioskRepository) Create(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error) {
+	encryptedSecret, err := crypto.Encrypt(kiosk.Secret, r.encryptionKey)
+	if err != nil {
+		r.logger.Error("failed to encrypt kiosk secret", zap.Error(err))
+		return nil, fmt.Errorf("failed to encrypt kiosk secret: %w", err)
+	}
+	m := kiosk.ToModel(encryptedSecret)
+
+	stmt := table.Kiosks.INSERT(
+		table.Kiosks.ID,
+		table.Kiosks.Name,
+		table.Kiosks.Secret,
+		table.Kiosks.TokenHash,
+		table.Kiosks.RotationSeconds,
+		table.Kiosks.IsActive,
+		table.Kiosks.CreatedBy,
+	).MODEL(m).RETURNING(table.Kiosks.AllColumns)
+
+	var result model.Kiosks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create kiosk", zap.Error(err))
+		return nil, fmt.Errorf("failed to create kiosk: %w", err)
+	}
+
+	return r.toAggregate(&result)
+}
+
+func (r *KioskRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Kiosk, error) {
+	return r.getOne(ctx, tx, table.Kiosks.ID.EQ(postgres.UUID(id)))
+}
+
+func (r *KioskRepository) GetByTokenHash(ctx context.Context, tx *sql.Tx, tokenHash string) (*aggregate.Kiosk, error) {
+	return r.getOne(ctx, tx, table.Kiosks.TokenHash.EQ(postgres.String(tokenHash)))
+}
+
+func (r *KioskRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error) {
+	return r.list(ctx, tx, postgres.Bool(true))
+}
+
+func (r *KioskRepository) ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error) {
+	return r.list(ctx, tx, table.Kiosks.IsActive.EQ(postgres.Bool(true)))
+}
+
+func (r *KioskRepository) Update(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error) {
+	stmt := table.Kiosks.UPDATE(
+		table.Kiosks.Name,
+		table.Kiosks.RotationSeconds,
+		table.Kiosks.IsActive,
+	).SET(
+		kiosk.Name,
+		kiosk.RotationSeconds,
+		kiosk.IsActive,
+	).WHERE(
+		table.Kiosks.ID.EQ(postgres.UUID(kiosk.ID)),
+	).RETURNING(table.Kiosks.AllColumns)
+
+	var result model.Kiosks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrKioskNotFound
+		}
+		r.logger.Error("failed to update kiosk", zap.Error(err), zap.String("id", kiosk.ID.String()))
+		return nil, fmt.Errorf("failed to update kiosk: %w", err)
+	}
+
+	return r.toAggregate(&result)
+}
+
+func (r *KioskRepository) TouchLastSeen(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	stmt := table.Kiosks.UPDATE(table.Kiosks.LastSeenAt).
+		SET(postgres.NOW()).
+		WHERE(table.Kiosks.ID.EQ(postgres.UUID(id)))
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to update kiosk last seen", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to update kiosk last seen: %w", err)
+	}
+	return nil
+}
+
+func (r *KioskRepository) getOne(ctx context.Context, tx *sql.Tx, condition postgres.BoolExpression) (*aggregate.Kiosk, error) {
+	stmt := table.Kiosks.
+		SELECT(table.Kiosks.AllColumns).
+		WHERE(condition)
+
+	var result model.Kiosks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrKioskNotFound
+		}
+		r.logger.Error("failed to get kiosk", zap.Error(err))
+		return nil, fmt.Errorf("failed to get kiosk: %w", err)
+	}
+
+	return r.toAggregate(&result)
+}
+
+func (r *KioskRepository) list(ctx context.Context, tx *sql.Tx, condition postgres.BoolExpression) ([]*aggregate.Kiosk, error) {
+	stmt := table.Kiosks.
+		SELECT(table.Kiosks.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.Kiosks.CreatedAt.ASC())
+
+	var results []model.Kiosks
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Kiosk{}, nil
+		}
+		r.logger.Error("failed to list kiosks", zap.Error(err))
+		return nil, fmt.Errorf("failed to list kiosks: %w", err)
+	}
+
+	kiosks := make([]*aggregate.Kiosk, 0, len(results))
+	for i := range results {
+		k, err := r.toAggregate(&results[i])
+		if err != nil {
+			// Skip unreadable rows rather than taking every kiosk offline.
+			continue
+		}
+		kiosks = append(kiosks, k)
+	}
+	return kiosks, nil
+}
+
+func (r *KioskRepository) toAggregate(m *model.Kiosks) (*aggregate.Kiosk, error) {
+	secret, err := crypto.Decrypt(m.Secret, r.encryptionKey)
+	if err != nil {
+		r.logger.Error("failed to decrypt kiosk secret", zap.Error(err), zap.String("id", m.ID.String()))
+		return nil, fmt.Errorf("failed to decrypt kiosk secret: %w", err)
+	}
+	return aggregate.KioskFromModel(m, secret), nil
+}
@@ -0,0 +1,137 @@
+package timelog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.PayRuleRepositoryInterface = (*PayRuleRepository)(nil)
+
+type PayRuleRepository struct {
+	logger *zap.Logger
+}
+
+func NewPayRuleRepository(logger *zap.Logger) repository.PayRuleRepositoryInterface {
+	return &PayRuleRepository{
+		logger: logger,
+	}
+}
+
+func (r *PayRuleRepository) Create(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error) {
+	m := rule.ToModel()
+
+	stmt := table.PayRules.INSERT(
+		table.PayRules.ID,
+		table.PayRules.Name,
+		table.PayRules.LocationID,
+		table.PayRules.EffectiveFrom,
+		table.PayRules.EffectiveTo,
+		table.PayRules.RoundToMinutes,
+		table.PayRules.EarlyClockInMinutes,
+		table.PayRules.PayFromScheduledStart,
+		table.PayRules.LateGraceMinutes,
+		table.PayRules.CapAtScheduledEnd,
+		table.PayRules.IsActive,
+	).MODEL(m).RETURNING(table.PayRules.AllColumns)
+
+	var result model.PayRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create pay rule", zap.Error(err))
+		return nil, fmt.Errorf("failed to create pay rule: %w", err)
+	}
+
+	created := aggregate.PayRuleFromModel(result)
+	return &created, nil
+}
+
+func (r *PayRuleRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PayRule, error) {
+	stmt := table.PayRules.
+		SELECT(table.PayRules.AllColumns).
+		WHERE(table.PayRules.ID.EQ(postgres.UUID(id)))
+
+	var result model.PayRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrPayRuleNotFound
+		}
+		r.logger.Error("failed to get pay rule", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get pay rule: %w", err)
+	}
+
+	rule := aggregate.PayRuleFromModel(result)
+	return &rule, nil
+}
+
+func (r *PayRuleRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error) {
+	return r.list(ctx, tx, postgres.Bool(true))
+}
+
+func (r *PayRuleRepository) ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error) {
+	return r.list(ctx, tx, table.PayRules.IsActive.EQ(postgres.Bool(true)))
+}
+
+func (r *PayRuleRepository) Update(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error) {
+	m := rule.ToModel()
+
+	stmt := table.PayRules.UPDATE(
+		table.PayRules.Name,
+		table.PayRules.LocationID,
+		table.PayRules.EffectiveFrom,
+		table.PayRules.EffectiveTo,
+		table.PayRules.RoundToMinutes,
+		table.PayRules.EarlyClockInMinutes,
+		table.PayRules.PayFromScheduledStart,
+		table.PayRules.LateGraceMinutes,
+		table.PayRules.CapAtScheduledEnd,
+		table.PayRules.IsActive,
+	).MODEL(m).WHERE(
+		table.PayRules.ID.EQ(postgres.UUID(rule.ID)),
+	).RETURNING(table.PayRules.AllColumns)
+
+	var result model.PayRules
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrPayRuleNotFound
+		}
+		r.logger.Error("failed to update pay rule", zap.Error(err), zap.String("id", rule.ID.String()))
+		return nil, fmt.Errorf("failed to update pay rule: %w", err)
+	}
+
+	updated := aggregate.PayRuleFromModel(result)
+	return &updated, nil
+}
+
+func (r *PayRuleRepository) list(ctx context.Context, tx *sql.Tx, condition postgres.BoolExpression) ([]*aggregate.PayRule, error) {
+	stmt := table.PayRules.
+		SELECT(table.PayRules.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.PayRules.CreatedAt.ASC())
+
+	var results []model.PayRules
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.PayRule{}, nil
+		}
+		r.logger.Error("failed to list pay rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list pay rules: %w", err)
+	}
+
+	rules := make([]*aggregate.PayRule, len(results))
+	for i := range results {
+		rule := aggregate.PayRuleFromModel(results[i])
+		rules[i] = &rule
+	}
+	return rules, nil
+}
@@ -0,0 +1,115 @@
+package timelog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.TimeLogBreakRepositoryInterface = (*TimeLogBreakRepository)(nil)
+
+type TimeLogBreakRepository struct {
+	logger *zap.Logger
+}
+
+func NewTimeLogBreakRepository(logger *zap.Logger) repository.TimeLogBreakRepositoryInterface {
+	return &TimeLogBreakRepository{
+		logger: logger,
+	}
+}
+
+func (r *TimeLogBreakRepository) Create(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+	m := b.ToModel()
+
+	stmt := table.TimeLogBreaks.INSERT(
+		table.TimeLogBreaks.ID,
+		table.TimeLogBreaks.TimeLogID,
+		table.TimeLogBreaks.StartAt,
+	).MODEL(m).RETURNING(table.TimeLogBreaks.AllColumns)
+
+	var result model.TimeLogBreaks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		r.logger.Error("failed to create time log break", zap.Error(err))
+		return nil, fmt.Errorf("failed to create time log break: %w", err)
+	}
+
+	created := aggregate.TimeLogBreakFromModel(result)
+	return &created, nil
+}
+
+func (r *TimeLogBreakRepository) GetOpenByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) (*aggregate.TimeLogBreak, error) {
+	stmt := table.TimeLogBreaks.
+		SELECT(table.TimeLogBreaks.AllColumns).
+		WHERE(
+			table.TimeLogBreaks.TimeLogID.EQ(postgres.UUID(timeLogID)).
+				AND(table.TimeLogBreaks.EndAt.IS_NULL()),
+		)
+
+	var result model.TimeLogBreaks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrBreakNotFound
+		}
+		r.logger.Error("failed to get open time log break", zap.Error(err), zap.String("time_log_id", timeLogID.String()))
+		return nil, fmt.Errorf("failed to get open time log break: %w", err)
+	}
+
+	b := aggregate.TimeLogBreakFromModel(result)
+	return &b, nil
+}
+
+func (r *TimeLogBreakRepository) Update(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+	stmt := table.TimeLogBreaks.UPDATE(
+		table.TimeLogBreaks.EndAt,
+	).SET(
+		b.EndAt,
+	).WHERE(
+		table.TimeLogBreaks.ID.EQ(postgres.UUID(b.ID)),
+	).RETURNING(table.TimeLogBreaks.AllColumns)
+
+	var result model.TimeLogBreaks
+	if err := stmt.QueryContext(ctx, tx, &result); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timelogErrors.ErrBreakNotFound
+		}
+		r.logger.Error("failed to update time log break", zap.Error(err), zap.String("id", b.ID.String()))
+		return nil, fmt.Errorf("failed to update time log break: %w", err)
+	}
+
+	updated := aggregate.TimeLogBreakFromModel(result)
+	return &updated, nil
+}
+
+func (r *TimeLogBreakRepository) ListByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+	stmt := table.TimeLogBreaks.
+		SELECT(table.TimeLogBreaks.AllColumns).
+		WHERE(table.TimeLogBreaks.TimeLogID.EQ(postgres.UUID(timeLogID))).
+		ORDER_BY(table.TimeLogBreaks.StartAt.ASC())
+
+	var results []model.TimeLogBreaks
+	if err := stmt.QueryContext(ctx, tx, &results); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TimeLogBreak{}, nil
+		}
+		r.logger.Error("failed to list time log breaks", zap.Error(err), zap.String("time_log_id", timeLogID.String()))
+		return nil, fmt.Errorf("failed to list time log breaks: %w", err)
+	}
+
+	breaks := make([]*aggregate.TimeLogBreak, len(results))
+	for i, m := range results {
+		b := aggregate.TimeLogBreakFromModel(m)
+		breaks[i] = &b
+	}
+	return breaks, nil
+}
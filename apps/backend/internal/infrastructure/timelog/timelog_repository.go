@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
@@ -21,6 +22,10 @@ import (
 
 var _ repository.TimeLogRepositoryInterface = (*TimeLogRepository)(nil)
 
+// logLocalDateSQL is the calendar date a time log started on at its own
+// location; see the schedule.time_log_local_date migration.
+const logLocalDateSQL = `schedule.time_log_local_date(schedule.time_logs.entry_at, schedule.time_logs.location_id)`
+
 type TimeLogRepository struct {
 	logger *zap.Logger
 }
@@ -43,6 +48,15 @@ func (r *TimeLogRepository) Create(ctx context.Context, tx *sql.Tx, timeLog *agg
 		table.TimeLogs.DistanceMeters,
 		table.TimeLogs.IsFlagged,
 		table.TimeLogs.FlagReason,
+		table.TimeLogs.LocationID,
+		table.TimeLogs.ScheduledStart,
+		table.TimeLogs.ScheduledEnd,
+		table.TimeLogs.PayRuleID,
+		table.TimeLogs.DeviceFingerprint,
+		table.TimeLogs.IPAddress,
+		table.TimeLogs.UserAgent,
+		table.TimeLogs.GpsAccuracyMeters,
+		table.TimeLogs.FlagCodes,
 	).MODEL(m).RETURNING(table.TimeLogs.AllColumns)
 
 	var result model.TimeLogs
@@ -104,10 +118,14 @@ func (r *TimeLogRepository) Update(ctx context.Context, tx *sql.Tx, timeLog *agg
 		table.TimeLogs.ExitAt,
 		table.TimeLogs.IsFlagged,
 		table.TimeLogs.FlagReason,
+		table.TimeLogs.FlagCodes,
+		table.TimeLogs.PaidMinutes,
 	).SET(
 		m.ExitAt,
 		m.IsFlagged,
 		m.FlagReason,
+		m.FlagCodes,
+		m.PaidMinutes,
 	).WHERE(
 		table.TimeLogs.ID.EQ(postgres.UUID(m.ID)),
 	).RETURNING(table.TimeLogs.AllColumns)
@@ -169,6 +187,78 @@ func (r *TimeLogRepository) List(ctx context.Context, tx *sql.Tx, filter reposit
 	return toTimeLogAggregates(results), countResult.Count, nil
 }
 
+func (r *TimeLogRepository) ListAll(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, error) {
+	condition := buildFilterCondition(filter)
+
+	if filter.StudentID != nil {
+		condition = condition.AND(table.TimeLogs.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+
+	stmt := table.TimeLogs.
+		SELECT(table.TimeLogs.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.TimeLogs.EntryAt.ASC())
+
+	var results []model.TimeLogs
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TimeLog{}, nil
+		}
+		r.logger.Error("failed to list time logs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list time logs: %w", err)
+	}
+
+	return toTimeLogAggregates(results), nil
+}
+
+func (r *TimeLogRepository) ListRecentByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.TimeLog, error) {
+	stmt := table.TimeLogs.
+		SELECT(table.TimeLogs.AllColumns).
+		WHERE(table.TimeLogs.StudentID.EQ(postgres.Int32(studentID))).
+		ORDER_BY(table.TimeLogs.EntryAt.DESC()).
+		LIMIT(int64(limit))
+
+	var results []model.TimeLogs
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TimeLog{}, nil
+		}
+		r.logger.Error("failed to list recent time logs", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to list recent time logs: %w", err)
+	}
+
+	return toTimeLogAggregates(results), nil
+}
+
+func (r *TimeLogRepository) ListStudentIDsByDevice(ctx context.Context, tx *sql.Tx, deviceFingerprint string, since time.Time, excludeStudentID int32) ([]int32, error) {
+	stmt := table.TimeLogs.
+		SELECT(table.TimeLogs.StudentID).
+		DISTINCT().
+		WHERE(
+			table.TimeLogs.DeviceFingerprint.EQ(postgres.String(deviceFingerprint)).
+				AND(table.TimeLogs.EntryAt.GT_EQ(postgres.TimestampzT(since))).
+				AND(table.TimeLogs.StudentID.NOT_EQ(postgres.Int32(excludeStudentID))),
+		).
+		ORDER_BY(table.TimeLogs.StudentID.ASC())
+
+	var results []struct {
+		StudentID int32 `sql:"primary_key" alias:"time_logs.student_id"`
+	}
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		r.logger.Error("failed to list students by device", zap.Error(err))
+		return nil, fmt.Errorf("failed to list students by device: %w", err)
+	}
+
+	ids := make([]int32, len(results))
+	for i, row := range results {
+		ids[i] = row.StudentID
+	}
+	return ids, nil
+}
+
 func buildFilterCondition(filter repository.TimeLogFilter) postgres.BoolExpression {
 	condition := postgres.Bool(true)
 
@@ -178,6 +268,10 @@ func buildFilterCondition(filter repository.TimeLogFilter) postgres.BoolExpressi
 	if filter.To != nil {
 		condition = condition.AND(table.TimeLogs.EntryAt.LT(postgres.TimestampzT(*filter.To)))
 	}
+	if filter.WeekStart != nil {
+		condition = condition.AND(postgres.RawDate(logLocalDateSQL).
+			BETWEEN(postgres.DateT(*filter.WeekStart), postgres.DateT(filter.WeekStart.AddDate(0, 0, 6))))
+	}
 	if filter.Flagged != nil {
 		condition = condition.AND(table.TimeLogs.IsFlagged.EQ(postgres.Bool(*filter.Flagged)))
 	}
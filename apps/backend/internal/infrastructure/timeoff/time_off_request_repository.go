@@ -0,0 +1,146 @@
+package timeoff
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.TimeOffRequestRepositoryInterface = (*TimeOffRequestRepository)(nil)
+
+type TimeOffRequestRepository struct {
+	logger *zap.Logger
+}
+
+func NewTimeOffRequestRepository(logger *zap.Logger) repository.TimeOffRequestRepositoryInterface {
+	return &TimeOffRequestRepository{
+		logger: logger,
+	}
+}
+
+func (r *TimeOffRequestRepository) Create(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+	m := request.ToModel()
+
+	stmt := table.TimeOffRequests.INSERT(
+		table.TimeOffRequests.ID,
+		table.TimeOffRequests.StudentID,
+		table.TimeOffRequests.StartDate,
+		table.TimeOffRequests.EndDate,
+		table.TimeOffRequests.Reason,
+		table.TimeOffRequests.Status,
+	).MODEL(m).RETURNING(table.TimeOffRequests.AllColumns)
+
+	var result model.TimeOffRequests
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		r.logger.Error("failed to create time-off request", zap.Error(err))
+		return nil, fmt.Errorf("failed to create time-off request: %w", err)
+	}
+
+	req := aggregate.TimeOffRequestFromModel(result)
+	return &req, nil
+}
+
+func (r *TimeOffRequestRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TimeOffRequest, error) {
+	stmt := table.TimeOffRequests.
+		SELECT(table.TimeOffRequests.AllColumns).
+		WHERE(table.TimeOffRequests.ID.EQ(postgres.UUID(id)))
+
+	var result model.TimeOffRequests
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timeoffErrors.ErrTimeOffNotFound
+		}
+		r.logger.Error("failed to get time-off request by ID", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get time-off request by ID: %w", err)
+	}
+
+	req := aggregate.TimeOffRequestFromModel(result)
+	return &req, nil
+}
+
+func (r *TimeOffRequestRepository) Update(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+	m := request.ToModel()
+
+	stmt := table.TimeOffRequests.UPDATE(
+		table.TimeOffRequests.Status,
+		table.TimeOffRequests.ReviewNote,
+		table.TimeOffRequests.ReviewedBy,
+		table.TimeOffRequests.ReviewedAt,
+	).SET(
+		m.Status,
+		m.ReviewNote,
+		m.ReviewedBy,
+		m.ReviewedAt,
+	).WHERE(
+		table.TimeOffRequests.ID.EQ(postgres.UUID(m.ID)),
+	).RETURNING(table.TimeOffRequests.AllColumns)
+
+	var result model.TimeOffRequests
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timeoffErrors.ErrTimeOffNotFound
+		}
+		r.logger.Error("failed to update time-off request", zap.Error(err), zap.String("id", request.ID.String()))
+		return nil, fmt.Errorf("failed to update time-off request: %w", err)
+	}
+
+	req := aggregate.TimeOffRequestFromModel(result)
+	return &req, nil
+}
+
+func (r *TimeOffRequestRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+	condition := postgres.Bool(true)
+
+	if filter.StudentID != nil {
+		condition = condition.AND(table.TimeOffRequests.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]postgres.Expression, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = postgres.String(string(s))
+		}
+		condition = condition.AND(table.TimeOffRequests.Status.IN(statuses...))
+	}
+	if filter.From != nil {
+		condition = condition.AND(table.TimeOffRequests.EndDate.GT_EQ(postgres.DateT(*filter.From)))
+	}
+	if filter.To != nil {
+		condition = condition.AND(table.TimeOffRequests.StartDate.LT_EQ(postgres.DateT(*filter.To)))
+	}
+
+	stmt := table.TimeOffRequests.
+		SELECT(table.TimeOffRequests.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.TimeOffRequests.StartDate.ASC(), table.TimeOffRequests.CreatedAt.ASC())
+
+	var results []model.TimeOffRequests
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.TimeOffRequest{}, nil
+		}
+		r.logger.Error("failed to list time-off requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to list time-off requests: %w", err)
+	}
+
+	requests := make([]*aggregate.TimeOffRequest, len(results))
+	for i, m := range results {
+		req := aggregate.TimeOffRequestFromModel(m)
+		requests[i] = &req
+	}
+	return requests, nil
+}
@@ -0,0 +1,314 @@
+package timesheet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/model"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/models/helpdesk/schedule/table"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ repository.TimesheetRepositoryInterface = (*TimesheetRepository)(nil)
+
+// workedHoursSQL sums the paid time of closed, unflagged time logs in hours,
+// the same way payroll does.
+const workedHoursSQL = `COALESCE(SUM(CASE WHEN schedule.time_logs.exit_at IS NOT NULL AND NOT schedule.time_logs.is_flagged THEN
+	COALESCE(schedule.time_logs.paid_minutes / 60.0, (EXTRACT(EPOCH FROM (schedule.time_logs.exit_at - schedule.time_logs.entry_at)) - COALESCE((
+		SELECT SUM(EXTRACT(EPOCH FROM (b.end_at - b.start_at)))
+		FROM schedule.time_log_breaks b
+		WHERE b.time_log_id = schedule.time_logs.id AND b.end_at IS NOT NULL
+	), 0)) / 3600.0)
+END), 0)`
+
+// logLocalDateSQL is the calendar date a time log started on at its own
+// location; see the schedule.time_log_local_date migration.
+const logLocalDateSQL = `schedule.time_log_local_date(schedule.time_logs.entry_at, schedule.time_logs.location_id)`
+
+type TimesheetRepository struct {
+	logger *zap.Logger
+}
+
+func NewTimesheetRepository(logger *zap.Logger) repository.TimesheetRepositoryInterface {
+	return &TimesheetRepository{
+		logger: logger,
+	}
+}
+
+func (r *TimesheetRepository) Create(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+	m := timesheet.ToModel()
+
+	stmt := table.Timesheets.INSERT(
+		table.Timesheets.ID,
+		table.Timesheets.StudentID,
+		table.Timesheets.WeekStart,
+		table.Timesheets.Status,
+		table.Timesheets.ScheduledHours,
+		table.Timesheets.WorkedHours,
+		table.Timesheets.LogCount,
+		table.Timesheets.FlaggedLogCount,
+		table.Timesheets.SubmittedAt,
+	).MODEL(m).RETURNING(table.Timesheets.AllColumns)
+
+	var result model.Timesheets
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		r.logger.Error("failed to create timesheet", zap.Error(err))
+		return nil, fmt.Errorf("failed to create timesheet: %w", err)
+	}
+
+	ts := aggregate.TimesheetFromModel(result)
+	return &ts, nil
+}
+
+func (r *TimesheetRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Timesheet, error) {
+	stmt := table.Timesheets.
+		SELECT(table.Timesheets.AllColumns).
+		WHERE(table.Timesheets.ID.EQ(postgres.UUID(id)))
+
+	var result model.Timesheets
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timesheetErrors.ErrTimesheetNotFound
+		}
+		r.logger.Error("failed to get timesheet by ID", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get timesheet by ID: %w", err)
+	}
+
+	ts := aggregate.TimesheetFromModel(result)
+	return &ts, nil
+}
+
+func (r *TimesheetRepository) GetByStudentAndWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*aggregate.Timesheet, error) {
+	stmt := table.Timesheets.
+		SELECT(table.Timesheets.AllColumns).
+		WHERE(
+			table.Timesheets.StudentID.EQ(postgres.Int32(studentID)).
+				AND(table.Timesheets.WeekStart.EQ(postgres.DateT(weekStart))),
+		)
+
+	var result model.Timesheets
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timesheetErrors.ErrTimesheetNotFound
+		}
+		r.logger.Error("failed to get timesheet by student and week", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to get timesheet by student and week: %w", err)
+	}
+
+	ts := aggregate.TimesheetFromModel(result)
+	return &ts, nil
+}
+
+func (r *TimesheetRepository) Update(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+	m := timesheet.ToModel()
+
+	stmt := table.Timesheets.UPDATE(
+		table.Timesheets.Status,
+		table.Timesheets.ScheduledHours,
+		table.Timesheets.WorkedHours,
+		table.Timesheets.LogCount,
+		table.Timesheets.FlaggedLogCount,
+		table.Timesheets.SubmittedAt,
+		table.Timesheets.ReviewNote,
+		table.Timesheets.ReviewedBy,
+		table.Timesheets.ReviewedAt,
+	).SET(
+		m.Status,
+		m.ScheduledHours,
+		m.WorkedHours,
+		m.LogCount,
+		m.FlaggedLogCount,
+		m.SubmittedAt,
+		m.ReviewNote,
+		m.ReviewedBy,
+		m.ReviewedAt,
+	).WHERE(
+		table.Timesheets.ID.EQ(postgres.UUID(m.ID)),
+	).RETURNING(table.Timesheets.AllColumns)
+
+	var result model.Timesheets
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, timesheetErrors.ErrTimesheetNotFound
+		}
+		r.logger.Error("failed to update timesheet", zap.Error(err), zap.String("id", timesheet.ID.String()))
+		return nil, fmt.Errorf("failed to update timesheet: %w", err)
+	}
+
+	ts := aggregate.TimesheetFromModel(result)
+	return &ts, nil
+}
+
+func (r *TimesheetRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+	condition := postgres.Bool(true)
+
+	if filter.StudentID != nil {
+		condition = condition.AND(table.Timesheets.StudentID.EQ(postgres.Int32(*filter.StudentID)))
+	}
+	if filter.WeekStart != nil {
+		condition = condition.AND(table.Timesheets.WeekStart.EQ(postgres.DateT(*filter.WeekStart)))
+	}
+	if filter.WeekFrom != nil {
+		condition = condition.AND(table.Timesheets.WeekStart.GT_EQ(postgres.DateT(*filter.WeekFrom)))
+	}
+	if filter.WeekTo != nil {
+		condition = condition.AND(table.Timesheets.WeekStart.LT_EQ(postgres.DateT(*filter.WeekTo)))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]postgres.Expression, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = postgres.String(string(s))
+		}
+		condition = condition.AND(table.Timesheets.Status.IN(statuses...))
+	}
+
+	stmt := table.Timesheets.
+		SELECT(table.Timesheets.AllColumns).
+		WHERE(condition).
+		ORDER_BY(table.Timesheets.WeekStart.DESC(), table.Timesheets.StudentID.ASC())
+
+	var results []model.Timesheets
+	err := stmt.QueryContext(ctx, tx, &results)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []*aggregate.Timesheet{}, nil
+		}
+		r.logger.Error("failed to list timesheets", zap.Error(err))
+		return nil, fmt.Errorf("failed to list timesheets: %w", err)
+	}
+
+	timesheets := make([]*aggregate.Timesheet, len(results))
+	for i, m := range results {
+		ts := aggregate.TimesheetFromModel(m)
+		timesheets[i] = &ts
+	}
+	return timesheets, nil
+}
+
+func (r *TimesheetRepository) SummarizeWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*repository.WorkedSummary, error) {
+	stmt := table.TimeLogs.
+		SELECT(
+			postgres.RawFloat(workedHoursSQL).AS("worked_hours"),
+			postgres.COUNT(table.TimeLogs.ID).AS("log_count"),
+			postgres.RawInt("COUNT(*) FILTER (WHERE schedule.time_logs.is_flagged)").AS("flagged_log_count"),
+			postgres.RawInt("COUNT(*) FILTER (WHERE schedule.time_logs.exit_at IS NULL)").AS("open_log_count"),
+		).
+		WHERE(
+			table.TimeLogs.StudentID.EQ(postgres.Int32(studentID)).
+				AND(weekCondition(weekStart)),
+		)
+
+	var result struct {
+		WorkedHours     float64 `alias:"worked_hours"`
+		LogCount        int32   `alias:"log_count"`
+		FlaggedLogCount int32   `alias:"flagged_log_count"`
+		OpenLogCount    int32   `alias:"open_log_count"`
+	}
+	err := stmt.QueryContext(ctx, tx, &result)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		r.logger.Error("failed to summarize week", zap.Error(err), zap.Int32("student_id", studentID))
+		return nil, fmt.Errorf("failed to summarize week: %w", err)
+	}
+
+	return &repository.WorkedSummary{
+		WorkedHours:     result.WorkedHours,
+		LogCount:        result.LogCount,
+		FlaggedLogCount: result.FlaggedLogCount,
+		OpenLogCount:    result.OpenLogCount,
+	}, nil
+}
+
+func (r *TimesheetRepository) ListStudentIDsWithLogs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error) {
+	stmt := table.TimeLogs.
+		SELECT(table.TimeLogs.StudentID).
+		DISTINCT().
+		WHERE(weekCondition(weekStart)).
+		ORDER_BY(table.TimeLogs.StudentID.ASC())
+
+	var rows []struct {
+		StudentID int32 `sql:"primary_key" alias:"time_logs.student_id"`
+	}
+	err := stmt.QueryContext(ctx, tx, &rows)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []int32{}, nil
+		}
+		r.logger.Error("failed to list students with time logs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list students with time logs: %w", err)
+	}
+
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		ids[i] = row.StudentID
+	}
+	return ids, nil
+}
+
+func (r *TimesheetRepository) ListRemindedStudentIDs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error) {
+	stmt := table.TimesheetReminders.
+		SELECT(table.TimesheetReminders.StudentID).
+		WHERE(table.TimesheetReminders.WeekStart.EQ(postgres.DateT(weekStart))).
+		ORDER_BY(table.TimesheetReminders.StudentID.ASC())
+
+	var rows []model.TimesheetReminders
+	err := stmt.QueryContext(ctx, tx, &rows)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return []int32{}, nil
+		}
+		r.logger.Error("failed to list reminded students", zap.Error(err))
+		return nil, fmt.Errorf("failed to list reminded students: %w", err)
+	}
+
+	ids := make([]int32, len(rows))
+	for i, row := range rows {
+		ids[i] = row.StudentID
+	}
+	return ids, nil
+}
+
+func (r *TimesheetRepository) RecordReminders(ctx context.Context, tx *sql.Tx, weekStart time.Time, studentIDs []int32, sentAt time.Time) error {
+	if len(studentIDs) == 0 {
+		return nil
+	}
+
+	reminders := make([]model.TimesheetReminders, len(studentIDs))
+	for i, id := range studentIDs {
+		reminders[i] = model.TimesheetReminders{StudentID: id, WeekStart: weekStart, SentAt: sentAt}
+	}
+	stmt := table.TimesheetReminders.
+		INSERT(table.TimesheetReminders.AllColumns).
+		MODELS(reminders).
+		ON_CONFLICT(table.TimesheetReminders.StudentID, table.TimesheetReminders.WeekStart).
+		DO_UPDATE(
+			postgres.SET(
+				table.TimesheetReminders.SentAt.SET(table.TimesheetReminders.EXCLUDED.SentAt),
+			),
+		)
+
+	if _, err := stmt.ExecContext(ctx, tx); err != nil {
+		r.logger.Error("failed to record timesheet reminders", zap.Error(err))
+		return fmt.Errorf("failed to record timesheet reminders: %w", err)
+	}
+	return nil
+}
+
+// weekCondition selects time logs that started within the week beginning
+// weekStart, in the local time of each log's location.
+func weekCondition(weekStart time.Time) postgres.BoolExpression {
+	return postgres.RawDate(logLocalDateSQL).
+		BETWEEN(postgres.DateT(weekStart), postgres.DateT(weekStart.AddDate(0, 0, 6)))
+}
@@ -16,6 +16,7 @@ import (
 	authHandler "github.com/HDR3604/HelpDeskApp/internal/domain/auth/handler"
 	authService "github.com/HDR3604/HelpDeskApp/internal/domain/auth/service"
 	authDtos "github.com/HDR3604/HelpDeskApp/internal/domain/auth/types/dtos"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	timelogHandler "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/handler"
 	timelogService "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
@@ -24,6 +25,7 @@ import (
 	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
 	scheduleInfra "github.com/HDR3604/HelpDeskApp/internal/infrastructure/schedule"
 	timelogInfra "github.com/HDR3604/HelpDeskApp/internal/infrastructure/timelog"
+	timeoffInfra "github.com/HDR3604/HelpDeskApp/internal/infrastructure/timeoff"
 	userRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/user"
 	"github.com/HDR3604/HelpDeskApp/internal/middleware"
 	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
@@ -70,7 +72,14 @@ func (s *TimeLogE2ETestSuite) SetupSuite() {
 	authTokenRepo := authRepo.NewAuthTokenRepository(logger)
 	timeLogRepo := timelogInfra.NewTimeLogRepository(logger)
 	clockInCodeRepo := timelogInfra.NewClockInCodeRepository(logger)
+	kioskRepo := timelogInfra.NewKioskRepository(logger, "0000000000000000000000000000000000000000000000000000000000000000")
 	scheduleRepo := scheduleInfra.NewScheduleRepository(logger)
+	locationRepo := scheduleInfra.NewLocationRepository(logger)
+	clockInAttemptRepo := timelogInfra.NewClockInAttemptRepository(logger)
+	clockInLockoutRepo := timelogInfra.NewClockInLockoutRepository(logger)
+	payRuleRepo := timelogInfra.NewPayRuleRepository(logger)
+	breakRepo := timelogInfra.NewTimeLogBreakRepository(logger)
+	timeOffRepo := timeoffInfra.NewTimeOffRequestRepository(logger)
 
 	// 3. Mock email sender
 	emailSender := &mocks.MockEmailSender{
@@ -100,8 +109,12 @@ func (s *TimeLogE2ETestSuite) SetupSuite() {
 		"http://localhost:3000", "noreply@test.com",
 	)
 
+	lockoutSvc := timelogService.NewClockInLockoutService(
+		logger, s.txManager, clockInAttemptRepo, clockInLockoutRepo, uRepo, emailSender, "noreply@test.com",
+	)
 	tlSvc := timelogService.NewTimeLogService(
-		logger, s.txManager, timeLogRepo, clockInCodeRepo, scheduleRepo,
+		logger, s.txManager, timeLogRepo, clockInCodeRepo, kioskRepo, scheduleRepo, locationRepo, lockoutSvc,
+		breakRepo, payRuleRepo, timeOffRepo, timelogAggregate.BreakPolicy{}, timelogService.DefaultFraudDetector(),
 		-61.277001, 10.642707, // UWI St Augustine
 	)
 	s.timeLogSvc = tlSvc.(*timelogService.TimeLogService)
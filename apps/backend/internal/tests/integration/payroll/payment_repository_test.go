@@ -0,0 +1,97 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	payrollRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/payroll"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentRepositoryTestSuite struct {
+	suite.Suite
+	testDB    *utils.TestDB
+	txManager database.TxManagerInterface
+	repo      repository.PaymentRepositoryInterface
+	ctx       context.Context
+	studentID int32
+}
+
+func TestPaymentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentRepositoryTestSuite))
+}
+
+func (s *PaymentRepositoryTestSuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.txManager = database.NewTxManager(s.testDB.DB, s.testDB.Logger)
+	s.repo = payrollRepo.NewPaymentRepository(s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Seed a student for FK constraints
+	s.studentID = 30001
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(s.ctx,
+			`INSERT INTO auth.students (student_id, email_address, first_name, last_name, phone_number, transcript_metadata, availability)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			s.studentID, "payment-test@test.com", "Test", "Student", "+18681234567", `{}`, `{}`,
+		)
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PaymentRepositoryTestSuite) TearDownTest() {
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(s.ctx, "TRUNCATE TABLE schedule.time_logs, schedule.timesheets CASCADE")
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PaymentRepositoryTestSuite) seedClosedLog(entryAt time.Time) {
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(s.ctx,
+			`INSERT INTO schedule.time_logs (student_id, entry_at, exit_at, longitude, latitude, distance_meters)
+			 VALUES ($1, $2, $3, $4, $5, $6)`,
+			s.studentID, entryAt, entryAt.Add(time.Hour), -61.277001, 10.642707, 0,
+		)
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PaymentRepositoryTestSuite) approveWeek(weekStart string) {
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(s.ctx,
+			`INSERT INTO schedule.timesheets (student_id, week_start, status, submitted_at)
+			 VALUES ($1, $2, 'approved', now())`,
+			s.studentID, weekStart,
+		)
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PaymentRepositoryTestSuite) TestCalculateHoursForPeriod_WeeksRunInLocalTime() {
+	// Sunday 15 March, 23:00 in Port of Spain is already Monday in UTC.
+	s.seedClosedLog(time.Date(2026, 3, 16, 3, 0, 0, 0, time.UTC))
+	// Monday 16 March, 01:00 in Port of Spain starts the next week.
+	s.seedClosedLog(time.Date(2026, 3, 16, 5, 0, 0, 0, time.UTC))
+	s.approveWeek("2026-03-09")
+
+	var hours float64
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		var txErr error
+		hours, txErr = s.repo.CalculateHoursForPeriod(s.ctx, tx, s.studentID,
+			time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+		return txErr
+	})
+
+	s.Require().NoError(err)
+	s.Equal(1.0, hours, "only the Sunday evening log is in the approved week")
+}
@@ -0,0 +1,146 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	studentRepo "github.com/HDR3604/HelpDeskApp/internal/infrastructure/student"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type InterviewSlotRepositoryTestSuite struct {
+	suite.Suite
+	testDB    *utils.TestDB
+	txManager database.TxManagerInterface
+	repo      repository.InterviewSlotRepositoryInterface
+	ctx       context.Context
+}
+
+func TestInterviewSlotRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InterviewSlotRepositoryTestSuite))
+}
+
+func (s *InterviewSlotRepositoryTestSuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.txManager = database.NewTxManager(s.testDB.DB, s.testDB.Logger)
+	s.repo = studentRepo.NewInterviewSlotRepository(s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Seed two applicants to compete for the same slot
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		for _, id := range []int32{20001, 20002} {
+			_, err := tx.ExecContext(s.ctx,
+				`INSERT INTO auth.students (student_id, email_address, first_name, last_name, phone_number, transcript_metadata, availability)
+				 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				id, fmt.Sprintf("applicant-%d@test.com", id), "Test", "Applicant", "+18681234567", `{}`, `{}`,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *InterviewSlotRepositoryTestSuite) TearDownTest() {
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(s.ctx, "TRUNCATE TABLE auth.interview_slots CASCADE")
+		return err
+	})
+	s.Require().NoError(err)
+}
+
+func (s *InterviewSlotRepositoryTestSuite) createSlot() *aggregate.InterviewSlot {
+	start := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	slot, err := aggregate.NewInterviewSlot(start, start.Add(30*time.Minute), "Room 101", nil, nil, nil)
+	s.Require().NoError(err)
+
+	var result *aggregate.InterviewSlot
+	err = s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		var txErr error
+		result, txErr = s.repo.Create(s.ctx, tx, slot)
+		return txErr
+	})
+	s.Require().NoError(err)
+	return result
+}
+
+func (s *InterviewSlotRepositoryTestSuite) TestBook_ConcurrentBookingsOnlyOneWins() {
+	slot := s.createSlot()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, studentID := range []int32{20001, 20002} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each booking works from its own unbooked copy, as two admins
+			// who loaded the slot at the same time would.
+			mine := *slot
+			if err := mine.Book(studentID, time.Now().UTC()); err != nil {
+				errs[i] = err
+				return
+			}
+			errs[i] = s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+				_, err := s.repo.Book(s.ctx, tx, &mine)
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.ErrorIs(err, studentErrors.ErrInterviewSlotBooked)
+	}
+	s.Equal(1, succeeded)
+
+	var stored *aggregate.InterviewSlot
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		var txErr error
+		stored, txErr = s.repo.GetByID(s.ctx, tx, slot.ID)
+		return txErr
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(stored.StudentID)
+}
+
+func (s *InterviewSlotRepositoryTestSuite) TestMarkInvited_RequiresCurrentBooking() {
+	slot := s.createSlot()
+	s.Require().NoError(slot.Book(20001, time.Now().UTC()))
+	err := s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := s.repo.Book(s.ctx, tx, slot)
+		return err
+	})
+	s.Require().NoError(err)
+
+	err = s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		_, err := s.repo.MarkInvited(s.ctx, tx, slot.ID, 20002, time.Now().UTC())
+		return err
+	})
+	s.ErrorIs(err, studentErrors.ErrInterviewSlotNotFound)
+
+	var invited *aggregate.InterviewSlot
+	err = s.txManager.InSystemTx(s.ctx, func(tx *sql.Tx) error {
+		var txErr error
+		invited, txErr = s.repo.MarkInvited(s.ctx, tx, slot.ID, 20001, time.Now().UTC())
+		return txErr
+	})
+	s.Require().NoError(err)
+	s.NotNil(invited.InvitedAt)
+}
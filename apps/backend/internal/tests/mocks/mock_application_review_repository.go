@@ -0,0 +1,101 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.ApplicationReviewRepositoryInterface = (*MockApplicationReviewRepository)(nil)
+
+// MockApplicationReviewRepository provides function-based mocking for the application review repository.
+type MockApplicationReviewRepository struct {
+	CreateStageFn      func(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error)
+	GetStageByIDFn     func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewStage, error)
+	UpdateStageFn      func(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error)
+	ListStagesFn       func(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewStage, error)
+	CreateCriterionFn  func(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error)
+	GetCriterionByIDFn func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewCriterion, error)
+	UpdateCriterionFn  func(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error)
+	ListCriteriaFn     func(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewCriterion, error)
+	AddStageChangeFn   func(ctx context.Context, tx *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error)
+	ListStageChangesFn func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.StageChange, error)
+	AddReviewerFn      func(ctx context.Context, tx *sql.Tx, reviewer *aggregate.ApplicationReviewer) error
+	RemoveReviewerFn   func(ctx context.Context, tx *sql.Tx, studentID int32, reviewerID uuid.UUID) error
+	ListReviewersFn    func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.ApplicationReviewer, error)
+	CreateNoteFn       func(ctx context.Context, tx *sql.Tx, note *aggregate.ApplicationNote) (*aggregate.ApplicationNote, error)
+	ListNotesFn        func(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationNote, error)
+	UpsertScoreFn      func(ctx context.Context, tx *sql.Tx, score *aggregate.ApplicationScore) (*aggregate.ApplicationScore, error)
+	ListScoresFn       func(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationScore, error)
+}
+
+func (m *MockApplicationReviewRepository) CreateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error) {
+	return m.CreateStageFn(ctx, tx, stage)
+}
+
+func (m *MockApplicationReviewRepository) GetStageByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewStage, error) {
+	return m.GetStageByIDFn(ctx, tx, id)
+}
+
+func (m *MockApplicationReviewRepository) UpdateStage(ctx context.Context, tx *sql.Tx, stage *aggregate.ReviewStage) (*aggregate.ReviewStage, error) {
+	return m.UpdateStageFn(ctx, tx, stage)
+}
+
+func (m *MockApplicationReviewRepository) ListStages(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewStage, error) {
+	return m.ListStagesFn(ctx, tx)
+}
+
+func (m *MockApplicationReviewRepository) CreateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error) {
+	return m.CreateCriterionFn(ctx, tx, criterion)
+}
+
+func (m *MockApplicationReviewRepository) GetCriterionByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ReviewCriterion, error) {
+	return m.GetCriterionByIDFn(ctx, tx, id)
+}
+
+func (m *MockApplicationReviewRepository) UpdateCriterion(ctx context.Context, tx *sql.Tx, criterion *aggregate.ReviewCriterion) (*aggregate.ReviewCriterion, error) {
+	return m.UpdateCriterionFn(ctx, tx, criterion)
+}
+
+func (m *MockApplicationReviewRepository) ListCriteria(ctx context.Context, tx *sql.Tx) ([]*aggregate.ReviewCriterion, error) {
+	return m.ListCriteriaFn(ctx, tx)
+}
+
+func (m *MockApplicationReviewRepository) AddStageChange(ctx context.Context, tx *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error) {
+	return m.AddStageChangeFn(ctx, tx, change)
+}
+
+func (m *MockApplicationReviewRepository) ListStageChanges(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.StageChange, error) {
+	return m.ListStageChangesFn(ctx, tx, studentIDs)
+}
+
+func (m *MockApplicationReviewRepository) AddReviewer(ctx context.Context, tx *sql.Tx, reviewer *aggregate.ApplicationReviewer) error {
+	return m.AddReviewerFn(ctx, tx, reviewer)
+}
+
+func (m *MockApplicationReviewRepository) RemoveReviewer(ctx context.Context, tx *sql.Tx, studentID int32, reviewerID uuid.UUID) error {
+	return m.RemoveReviewerFn(ctx, tx, studentID, reviewerID)
+}
+
+func (m *MockApplicationReviewRepository) ListReviewers(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.ApplicationReviewer, error) {
+	return m.ListReviewersFn(ctx, tx, studentIDs)
+}
+
+func (m *MockApplicationReviewRepository) CreateNote(ctx context.Context, tx *sql.Tx, note *aggregate.ApplicationNote) (*aggregate.ApplicationNote, error) {
+	return m.CreateNoteFn(ctx, tx, note)
+}
+
+func (m *MockApplicationReviewRepository) ListNotes(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationNote, error) {
+	return m.ListNotesFn(ctx, tx, studentID)
+}
+
+func (m *MockApplicationReviewRepository) UpsertScore(ctx context.Context, tx *sql.Tx, score *aggregate.ApplicationScore) (*aggregate.ApplicationScore, error) {
+	return m.UpsertScoreFn(ctx, tx, score)
+}
+
+func (m *MockApplicationReviewRepository) ListScores(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.ApplicationScore, error) {
+	return m.ListScoresFn(ctx, tx, studentID)
+}
@@ -0,0 +1,111 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.ApplicationReviewServiceInterface = (*MockApplicationReviewService)(nil)
+
+// MockApplicationReviewService provides function-based mocking for the application review service.
+type MockApplicationReviewService struct {
+	ListStagesFn              func(ctx context.Context) ([]*aggregate.ReviewStage, error)
+	CreateStageFn             func(ctx context.Context, name string, position int32) (*aggregate.ReviewStage, error)
+	UpdateStageFn             func(ctx context.Context, id uuid.UUID, params service.UpdateReviewStageParams) (*aggregate.ReviewStage, error)
+	ListCriteriaFn            func(ctx context.Context) ([]*aggregate.ReviewCriterion, error)
+	CreateCriterionFn         func(ctx context.Context, name, description string, maxScore, position int32) (*aggregate.ReviewCriterion, error)
+	UpdateCriterionFn         func(ctx context.Context, id uuid.UUID, params service.UpdateReviewCriterionParams) (*aggregate.ReviewCriterion, error)
+	ListQueueFn               func(ctx context.Context, filter service.ReviewQueueFilter) ([]*aggregate.ApplicationReviewSummary, error)
+	GetReviewFn               func(ctx context.Context, studentID int32) (*aggregate.ApplicationReview, error)
+	MoveStageFn               func(ctx context.Context, studentID int32, stageID uuid.UUID, note string) (*aggregate.StageChange, error)
+	AssignReviewerFn          func(ctx context.Context, studentID int32, reviewerID uuid.UUID) error
+	UnassignReviewerFn        func(ctx context.Context, studentID int32, reviewerID uuid.UUID) error
+	AddNoteFn                 func(ctx context.Context, studentID int32, body string) (*aggregate.ApplicationNote, error)
+	ScoreFn                   func(ctx context.Context, studentID int32, criterionID uuid.UUID, score int32, comment string) (*aggregate.ApplicationScore, error)
+	ListInterviewSlotsFn      func(ctx context.Context, from time.Time) ([]*aggregate.InterviewSlot, error)
+	CreateInterviewSlotFn     func(ctx context.Context, startsAt, endsAt time.Time, location string, locationID, interviewerID *uuid.UUID) (*aggregate.InterviewSlot, error)
+	DeleteInterviewSlotFn     func(ctx context.Context, id uuid.UUID) error
+	BookInterviewFn           func(ctx context.Context, slotID uuid.UUID, studentID int32) (*aggregate.InterviewSlot, error)
+	CancelInterviewFn         func(ctx context.Context, slotID uuid.UUID) (*aggregate.InterviewSlot, error)
+	SendInterviewInvitationFn func(ctx context.Context, slotID uuid.UUID) error
+}
+
+func (m *MockApplicationReviewService) ListStages(ctx context.Context) ([]*aggregate.ReviewStage, error) {
+	return m.ListStagesFn(ctx)
+}
+
+func (m *MockApplicationReviewService) CreateStage(ctx context.Context, name string, position int32) (*aggregate.ReviewStage, error) {
+	return m.CreateStageFn(ctx, name, position)
+}
+
+func (m *MockApplicationReviewService) UpdateStage(ctx context.Context, id uuid.UUID, params service.UpdateReviewStageParams) (*aggregate.ReviewStage, error) {
+	return m.UpdateStageFn(ctx, id, params)
+}
+
+func (m *MockApplicationReviewService) ListCriteria(ctx context.Context) ([]*aggregate.ReviewCriterion, error) {
+	return m.ListCriteriaFn(ctx)
+}
+
+func (m *MockApplicationReviewService) CreateCriterion(ctx context.Context, name, description string, maxScore, position int32) (*aggregate.ReviewCriterion, error) {
+	return m.CreateCriterionFn(ctx, name, description, maxScore, position)
+}
+
+func (m *MockApplicationReviewService) UpdateCriterion(ctx context.Context, id uuid.UUID, params service.UpdateReviewCriterionParams) (*aggregate.ReviewCriterion, error) {
+	return m.UpdateCriterionFn(ctx, id, params)
+}
+
+func (m *MockApplicationReviewService) ListQueue(ctx context.Context, filter service.ReviewQueueFilter) ([]*aggregate.ApplicationReviewSummary, error) {
+	return m.ListQueueFn(ctx, filter)
+}
+
+func (m *MockApplicationReviewService) GetReview(ctx context.Context, studentID int32) (*aggregate.ApplicationReview, error) {
+	return m.GetReviewFn(ctx, studentID)
+}
+
+func (m *MockApplicationReviewService) MoveStage(ctx context.Context, studentID int32, stageID uuid.UUID, note string) (*aggregate.StageChange, error) {
+	return m.MoveStageFn(ctx, studentID, stageID, note)
+}
+
+func (m *MockApplicationReviewService) AssignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error {
+	return m.AssignReviewerFn(ctx, studentID, reviewerID)
+}
+
+func (m *MockApplicationReviewService) UnassignReviewer(ctx context.Context, studentID int32, reviewerID uuid.UUID) error {
+	return m.UnassignReviewerFn(ctx, studentID, reviewerID)
+}
+
+func (m *MockApplicationReviewService) AddNote(ctx context.Context, studentID int32, body string) (*aggregate.ApplicationNote, error) {
+	return m.AddNoteFn(ctx, studentID, body)
+}
+
+func (m *MockApplicationReviewService) Score(ctx context.Context, studentID int32, criterionID uuid.UUID, score int32, comment string) (*aggregate.ApplicationScore, error) {
+	return m.ScoreFn(ctx, studentID, criterionID, score, comment)
+}
+
+func (m *MockApplicationReviewService) ListInterviewSlots(ctx context.Context, from time.Time) ([]*aggregate.InterviewSlot, error) {
+	return m.ListInterviewSlotsFn(ctx, from)
+}
+
+func (m *MockApplicationReviewService) CreateInterviewSlot(ctx context.Context, startsAt, endsAt time.Time, location string, locationID, interviewerID *uuid.UUID) (*aggregate.InterviewSlot, error) {
+	return m.CreateInterviewSlotFn(ctx, startsAt, endsAt, location, locationID, interviewerID)
+}
+
+func (m *MockApplicationReviewService) DeleteInterviewSlot(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteInterviewSlotFn(ctx, id)
+}
+
+func (m *MockApplicationReviewService) BookInterview(ctx context.Context, slotID uuid.UUID, studentID int32) (*aggregate.InterviewSlot, error) {
+	return m.BookInterviewFn(ctx, slotID, studentID)
+}
+
+func (m *MockApplicationReviewService) CancelInterview(ctx context.Context, slotID uuid.UUID) (*aggregate.InterviewSlot, error) {
+	return m.CancelInterviewFn(ctx, slotID)
+}
+
+func (m *MockApplicationReviewService) SendInterviewInvitation(ctx context.Context, slotID uuid.UUID) error {
+	return m.SendInterviewInvitationFn(ctx, slotID)
+}
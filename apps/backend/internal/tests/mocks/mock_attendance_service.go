@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/service"
+)
+
+var _ service.AttendanceServiceInterface = (*MockAttendanceService)(nil)
+
+// MockAttendanceService provides function-based mocking for the attendance service.
+// Set the Fn fields to control return values per test case.
+type MockAttendanceService struct {
+	GetReportFn func(ctx context.Context, query aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error)
+}
+
+func (m *MockAttendanceService) GetReport(ctx context.Context, query aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error) {
+	return m.GetReportFn(ctx, query)
+}
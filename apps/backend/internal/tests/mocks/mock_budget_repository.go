@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.BudgetRepositoryInterface = (*MockBudgetRepository)(nil)
+
+// MockBudgetRepository provides function-based mocking for the budget repository.
+type MockBudgetRepository struct {
+	CreateFn  func(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error)
+	GetByIDFn func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Budget, error)
+	ListFn    func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Budget, error)
+	UpdateFn  func(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error)
+	DeleteFn  func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
+
+func (m *MockBudgetRepository) Create(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error) {
+	return m.CreateFn(ctx, tx, budget)
+}
+
+func (m *MockBudgetRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Budget, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockBudgetRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Budget, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockBudgetRepository) Update(ctx context.Context, tx *sql.Tx, budget *aggregate.Budget) (*aggregate.Budget, error) {
+	return m.UpdateFn(ctx, tx, budget)
+}
+
+func (m *MockBudgetRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.DeleteFn(ctx, tx, id)
+}
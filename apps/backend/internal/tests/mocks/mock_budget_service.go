@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/service"
+	"github.com/google/uuid"
+)
+
+var _ service.BudgetServiceInterface = (*MockBudgetService)(nil)
+
+// MockBudgetService provides function-based mocking for the budget service.
+// It also satisfies the schedule service's CostCeilingProvider.
+type MockBudgetService struct {
+	CreateBudgetFn        func(ctx context.Context, params aggregate.BudgetParams) (*aggregate.Budget, error)
+	ListBudgetsFn         func(ctx context.Context) ([]*aggregate.Budget, error)
+	GetBudgetFn           func(ctx context.Context, id uuid.UUID) (*aggregate.Budget, error)
+	UpdateBudgetFn        func(ctx context.Context, id uuid.UUID, params aggregate.BudgetParams) (*aggregate.Budget, error)
+	DeleteBudgetFn        func(ctx context.Context, id uuid.UUID) error
+	ProjectScheduleCostFn func(ctx context.Context, scheduleID uuid.UUID, budgetID *uuid.UUID) (*aggregate.CostProjection, error)
+	CostCeilingFn         func(ctx context.Context, budgetID uuid.UUID, effectiveFrom time.Time, effectiveTo *time.Time) (float64, float64, error)
+}
+
+func (m *MockBudgetService) CreateBudget(ctx context.Context, params aggregate.BudgetParams) (*aggregate.Budget, error) {
+	return m.CreateBudgetFn(ctx, params)
+}
+
+func (m *MockBudgetService) ListBudgets(ctx context.Context) ([]*aggregate.Budget, error) {
+	return m.ListBudgetsFn(ctx)
+}
+
+func (m *MockBudgetService) GetBudget(ctx context.Context, id uuid.UUID) (*aggregate.Budget, error) {
+	return m.GetBudgetFn(ctx, id)
+}
+
+func (m *MockBudgetService) UpdateBudget(ctx context.Context, id uuid.UUID, params aggregate.BudgetParams) (*aggregate.Budget, error) {
+	return m.UpdateBudgetFn(ctx, id, params)
+}
+
+func (m *MockBudgetService) DeleteBudget(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteBudgetFn(ctx, id)
+}
+
+func (m *MockBudgetService) ProjectScheduleCost(ctx context.Context, scheduleID uuid.UUID, budgetID *uuid.UUID) (*aggregate.CostProjection, error) {
+	return m.ProjectScheduleCostFn(ctx, scheduleID, budgetID)
+}
+
+func (m *MockBudgetService) CostCeiling(ctx context.Context, budgetID uuid.UUID, effectiveFrom time.Time, effectiveTo *time.Time) (float64, float64, error) {
+	return m.CostCeilingFn(ctx, budgetID, effectiveFrom, effectiveTo)
+}
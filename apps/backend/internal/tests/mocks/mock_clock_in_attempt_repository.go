@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+)
+
+var _ repository.ClockInAttemptRepositoryInterface = (*MockClockInAttemptRepository)(nil)
+
+// MockClockInAttemptRepository provides function-based mocking for the clock-in attempt repository.
+// Set the Fn fields to control return values per test case.
+type MockClockInAttemptRepository struct {
+	CreateFn                        func(ctx context.Context, tx *sql.Tx, attempt *aggregate.ClockInAttempt) error
+	CountFailuresSinceFn            func(ctx context.Context, tx *sql.Tx, studentID int32, since time.Time) (int, error)
+	LastSuccessAtFn                 func(ctx context.Context, tx *sql.Tx, studentID int32) (*time.Time, error)
+	CountStudentsFailingCodeSinceFn func(ctx context.Context, tx *sql.Tx, code string, since time.Time) (int, error)
+	ListByStudentIDFn               func(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.ClockInAttempt, error)
+}
+
+func (m *MockClockInAttemptRepository) Create(ctx context.Context, tx *sql.Tx, attempt *aggregate.ClockInAttempt) error {
+	return m.CreateFn(ctx, tx, attempt)
+}
+
+func (m *MockClockInAttemptRepository) CountFailuresSince(ctx context.Context, tx *sql.Tx, studentID int32, since time.Time) (int, error) {
+	return m.CountFailuresSinceFn(ctx, tx, studentID, since)
+}
+
+func (m *MockClockInAttemptRepository) LastSuccessAt(ctx context.Context, tx *sql.Tx, studentID int32) (*time.Time, error) {
+	return m.LastSuccessAtFn(ctx, tx, studentID)
+}
+
+func (m *MockClockInAttemptRepository) CountStudentsFailingCodeSince(ctx context.Context, tx *sql.Tx, code string, since time.Time) (int, error) {
+	return m.CountStudentsFailingCodeSinceFn(ctx, tx, code, since)
+}
+
+func (m *MockClockInAttemptRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.ClockInAttempt, error) {
+	return m.ListByStudentIDFn(ctx, tx, studentID, limit)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.ClockInLockoutRepositoryInterface = (*MockClockInLockoutRepository)(nil)
+
+// MockClockInLockoutRepository provides function-based mocking for the clock-in lockout repository.
+// Set the Fn fields to control return values per test case.
+type MockClockInLockoutRepository struct {
+	CreateFn               func(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error)
+	GetByIDFn              func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ClockInLockout, error)
+	GetLatestByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ClockInLockout, error)
+	ListFn                 func(ctx context.Context, tx *sql.Tx, filter repository.ClockInLockoutFilter) ([]*aggregate.ClockInLockout, error)
+	UpdateFn               func(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error)
+}
+
+func (m *MockClockInLockoutRepository) Create(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+	return m.CreateFn(ctx, tx, lockout)
+}
+
+func (m *MockClockInLockoutRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ClockInLockout, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockClockInLockoutRepository) GetLatestByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ClockInLockout, error) {
+	return m.GetLatestByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockClockInLockoutRepository) List(ctx context.Context, tx *sql.Tx, filter repository.ClockInLockoutFilter) ([]*aggregate.ClockInLockout, error) {
+	return m.ListFn(ctx, tx, filter)
+}
+
+func (m *MockClockInLockoutRepository) Update(ctx context.Context, tx *sql.Tx, lockout *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+	return m.UpdateFn(ctx, tx, lockout)
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/google/uuid"
+)
+
+var _ service.ClockInLockoutServiceInterface = (*MockClockInLockoutService)(nil)
+
+// MockClockInLockoutService provides function-based mocking for the clock-in lockout service.
+// Set the Fn fields to control return values per test case.
+type MockClockInLockoutService struct {
+	GetActiveLockoutFn func(ctx context.Context, studentID int32) (*aggregate.ClockInLockout, error)
+	RecordAttemptFn    func(ctx context.Context, studentID int32, code string, succeeded bool) (*aggregate.ClockInLockout, error)
+	ListLockoutsFn     func(ctx context.Context, studentID *int32, activeOnly bool) ([]*aggregate.ClockInLockout, error)
+	ListAttemptsFn     func(ctx context.Context, studentID int32) ([]*aggregate.ClockInAttempt, error)
+	ClearLockoutFn     func(ctx context.Context, id uuid.UUID) (*aggregate.ClockInLockout, error)
+}
+
+func (m *MockClockInLockoutService) GetActiveLockout(ctx context.Context, studentID int32) (*aggregate.ClockInLockout, error) {
+	return m.GetActiveLockoutFn(ctx, studentID)
+}
+
+func (m *MockClockInLockoutService) RecordAttempt(ctx context.Context, studentID int32, code string, succeeded bool) (*aggregate.ClockInLockout, error) {
+	return m.RecordAttemptFn(ctx, studentID, code, succeeded)
+}
+
+func (m *MockClockInLockoutService) ListLockouts(ctx context.Context, studentID *int32, activeOnly bool) ([]*aggregate.ClockInLockout, error) {
+	return m.ListLockoutsFn(ctx, studentID, activeOnly)
+}
+
+func (m *MockClockInLockoutService) ListAttempts(ctx context.Context, studentID int32) ([]*aggregate.ClockInAttempt, error) {
+	return m.ListAttemptsFn(ctx, studentID)
+}
+
+func (m *MockClockInLockoutService) ClearLockout(ctx context.Context, id uuid.UUID) (*aggregate.ClockInLockout, error) {
+	return m.ClearLockoutFn(ctx, id)
+}
@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.CourseEligibilityRepositoryInterface = (*MockCourseEligibilityRepository)(nil)
+
+// MockCourseEligibilityRepository provides function-based mocking for the course eligibility repository.
+type MockCourseEligibilityRepository struct {
+	CreateRuleFn     func(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error)
+	GetRuleByIDFn    func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.EligibilityRule, error)
+	UpdateRuleFn     func(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error)
+	DeleteRuleFn     func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+	ListRulesFn      func(ctx context.Context, tx *sql.Tx) ([]*aggregate.EligibilityRule, error)
+	UpsertOverrideFn func(ctx context.Context, tx *sql.Tx, override *aggregate.EligibilityOverride) (*aggregate.EligibilityOverride, error)
+	DeleteOverrideFn func(ctx context.Context, tx *sql.Tx, studentID int32, courseCode string) error
+	ListOverridesFn  func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.EligibilityOverride, error)
+}
+
+func (m *MockCourseEligibilityRepository) CreateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error) {
+	return m.CreateRuleFn(ctx, tx, rule)
+}
+
+func (m *MockCourseEligibilityRepository) GetRuleByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.EligibilityRule, error) {
+	return m.GetRuleByIDFn(ctx, tx, id)
+}
+
+func (m *MockCourseEligibilityRepository) UpdateRule(ctx context.Context, tx *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error) {
+	return m.UpdateRuleFn(ctx, tx, rule)
+}
+
+func (m *MockCourseEligibilityRepository) DeleteRule(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.DeleteRuleFn(ctx, tx, id)
+}
+
+func (m *MockCourseEligibilityRepository) ListRules(ctx context.Context, tx *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+	return m.ListRulesFn(ctx, tx)
+}
+
+func (m *MockCourseEligibilityRepository) UpsertOverride(ctx context.Context, tx *sql.Tx, override *aggregate.EligibilityOverride) (*aggregate.EligibilityOverride, error) {
+	return m.UpsertOverrideFn(ctx, tx, override)
+}
+
+func (m *MockCourseEligibilityRepository) DeleteOverride(ctx context.Context, tx *sql.Tx, studentID int32, courseCode string) error {
+	return m.DeleteOverrideFn(ctx, tx, studentID, courseCode)
+}
+
+func (m *MockCourseEligibilityRepository) ListOverrides(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.EligibilityOverride, error) {
+	return m.ListOverridesFn(ctx, tx, studentIDs)
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.CourseEligibilityServiceInterface = (*MockCourseEligibilityService)(nil)
+
+// MockCourseEligibilityService provides function-based mocking for the course eligibility service.
+type MockCourseEligibilityService struct {
+	ListRulesFn      func(ctx context.Context) ([]*aggregate.EligibilityRule, error)
+	CreateRuleFn     func(ctx context.Context, courseCode *string, courseLevel *int32, minGrade string) (*aggregate.EligibilityRule, error)
+	UpdateRuleFn     func(ctx context.Context, id uuid.UUID, minGrade string) (*aggregate.EligibilityRule, error)
+	DeleteRuleFn     func(ctx context.Context, id uuid.UUID) error
+	SetOverrideFn    func(ctx context.Context, studentID int32, courseCode string, eligible bool, reason string) (*aggregate.EligibilityOverride, error)
+	DeleteOverrideFn func(ctx context.Context, studentID int32, courseCode string) error
+	GetMatrixFn      func(ctx context.Context, studentID *int32) ([]*aggregate.StudentEligibility, error)
+	EvaluateFn       func(ctx context.Context, students []*aggregate.Student) ([]*aggregate.StudentEligibility, error)
+}
+
+func (m *MockCourseEligibilityService) ListRules(ctx context.Context) ([]*aggregate.EligibilityRule, error) {
+	return m.ListRulesFn(ctx)
+}
+
+func (m *MockCourseEligibilityService) CreateRule(ctx context.Context, courseCode *string, courseLevel *int32, minGrade string) (*aggregate.EligibilityRule, error) {
+	return m.CreateRuleFn(ctx, courseCode, courseLevel, minGrade)
+}
+
+func (m *MockCourseEligibilityService) UpdateRule(ctx context.Context, id uuid.UUID, minGrade string) (*aggregate.EligibilityRule, error) {
+	return m.UpdateRuleFn(ctx, id, minGrade)
+}
+
+func (m *MockCourseEligibilityService) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteRuleFn(ctx, id)
+}
+
+func (m *MockCourseEligibilityService) SetOverride(ctx context.Context, studentID int32, courseCode string, eligible bool, reason string) (*aggregate.EligibilityOverride, error) {
+	return m.SetOverrideFn(ctx, studentID, courseCode, eligible, reason)
+}
+
+func (m *MockCourseEligibilityService) DeleteOverride(ctx context.Context, studentID int32, courseCode string) error {
+	return m.DeleteOverrideFn(ctx, studentID, courseCode)
+}
+
+func (m *MockCourseEligibilityService) GetMatrix(ctx context.Context, studentID *int32) ([]*aggregate.StudentEligibility, error) {
+	return m.GetMatrixFn(ctx, studentID)
+}
+
+func (m *MockCourseEligibilityService) Evaluate(ctx context.Context, students []*aggregate.Student) ([]*aggregate.StudentEligibility, error) {
+	return m.EvaluateFn(ctx, students)
+}
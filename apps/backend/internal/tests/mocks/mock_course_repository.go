@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.CourseRepositoryInterface = (*MockCourseRepository)(nil)
+
+type MockCourseRepository struct {
+	CreateFn    func(ctx context.Context, tx *sql.Tx, c *aggregate.Course) (*aggregate.Course, error)
+	GetByIDFn   func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Course, error)
+	GetByCodeFn func(ctx context.Context, tx *sql.Tx, code string) (*aggregate.Course, error)
+	ListFn      func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error)
+	ListAllFn   func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error)
+	UpdateFn    func(ctx context.Context, tx *sql.Tx, c *aggregate.Course) error
+}
+
+func (m *MockCourseRepository) Create(ctx context.Context, tx *sql.Tx, c *aggregate.Course) (*aggregate.Course, error) {
+	return m.CreateFn(ctx, tx, c)
+}
+
+func (m *MockCourseRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Course, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockCourseRepository) GetByCode(ctx context.Context, tx *sql.Tx, code string) (*aggregate.Course, error) {
+	return m.GetByCodeFn(ctx, tx, code)
+}
+
+func (m *MockCourseRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockCourseRepository) ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Course, error) {
+	return m.ListAllFn(ctx, tx)
+}
+
+func (m *MockCourseRepository) Update(ctx context.Context, tx *sql.Tx, c *aggregate.Course) error {
+	return m.UpdateFn(ctx, tx, c)
+}
@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	"github.com/google/uuid"
+)
+
+var _ service.CourseServiceInterface = (*MockCourseService)(nil)
+
+type MockCourseService struct {
+	CreateFn               func(ctx context.Context, c *aggregate.Course) (*aggregate.Course, error)
+	GetByIDFn              func(ctx context.Context, id uuid.UUID) (*aggregate.Course, error)
+	ListFn                 func(ctx context.Context) ([]*aggregate.Course, error)
+	ListAllFn              func(ctx context.Context) ([]*aggregate.Course, error)
+	UpdateFn               func(ctx context.Context, id uuid.UUID, params service.UpdateCourseParams) (*aggregate.Course, error)
+	ActivateFn             func(ctx context.Context, id uuid.UUID) error
+	DeactivateFn           func(ctx context.Context, id uuid.UUID) error
+	ImportFn               func(ctx context.Context, r io.Reader) (*service.CourseImportResult, error)
+	UnknownCodesFn         func(ctx context.Context) ([]service.UnknownCourseCode, error)
+	NormalizeTranscriptsFn func(ctx context.Context) (int, error)
+}
+
+func (m *MockCourseService) Create(ctx context.Context, c *aggregate.Course) (*aggregate.Course, error) {
+	return m.CreateFn(ctx, c)
+}
+
+func (m *MockCourseService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.Course, error) {
+	return m.GetByIDFn(ctx, id)
+}
+
+func (m *MockCourseService) List(ctx context.Context) ([]*aggregate.Course, error) {
+	return m.ListFn(ctx)
+}
+
+func (m *MockCourseService) ListAll(ctx context.Context) ([]*aggregate.Course, error) {
+	return m.ListAllFn(ctx)
+}
+
+func (m *MockCourseService) Update(ctx context.Context, id uuid.UUID, params service.UpdateCourseParams) (*aggregate.Course, error) {
+	return m.UpdateFn(ctx, id, params)
+}
+
+func (m *MockCourseService) Activate(ctx context.Context, id uuid.UUID) error {
+	return m.ActivateFn(ctx, id)
+}
+
+func (m *MockCourseService) Deactivate(ctx context.Context, id uuid.UUID) error {
+	return m.DeactivateFn(ctx, id)
+}
+
+func (m *MockCourseService) Import(ctx context.Context, r io.Reader) (*service.CourseImportResult, error) {
+	return m.ImportFn(ctx, r)
+}
+
+func (m *MockCourseService) UnknownCodes(ctx context.Context) ([]service.UnknownCourseCode, error) {
+	return m.UnknownCodesFn(ctx)
+}
+
+func (m *MockCourseService) NormalizeTranscripts(ctx context.Context) (int, error) {
+	return m.NormalizeTranscriptsFn(ctx)
+}
@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.DisbursementFileRepositoryInterface = (*MockDisbursementFileRepository)(nil)
+
+// MockDisbursementFileRepository provides function-based mocking for the disbursement file repository.
+type MockDisbursementFileRepository struct {
+	CreateFn       func(ctx context.Context, tx *sql.Tx, file *aggregate.DisbursementFile) (*aggregate.DisbursementFile, error)
+	GetByIDFn      func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.DisbursementFile, error)
+	ListByPeriodFn func(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error)
+}
+
+func (m *MockDisbursementFileRepository) Create(ctx context.Context, tx *sql.Tx, file *aggregate.DisbursementFile) (*aggregate.DisbursementFile, error) {
+	return m.CreateFn(ctx, tx, file)
+}
+
+func (m *MockDisbursementFileRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockDisbursementFileRepository) ListByPeriod(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error) {
+	return m.ListByPeriodFn(ctx, tx, periodStart, periodEnd)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+)
+
+var _ service.DisbursementServiceInterface = (*MockDisbursementService)(nil)
+
+// MockDisbursementService provides function-based mocking for the disbursement service.
+type MockDisbursementService struct {
+	GenerateDisbursementFilesFn func(ctx context.Context, periodStart, periodEnd time.Time) (*service.DisbursementRun, error)
+	ListDisbursementFilesFn     func(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error)
+	GetDisbursementFileFn       func(ctx context.Context, id uuid.UUID) (*aggregate.DisbursementFile, error)
+	ListBankFormatsFn           func() service.BankFormats
+}
+
+func (m *MockDisbursementService) GenerateDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) (*service.DisbursementRun, error) {
+	return m.GenerateDisbursementFilesFn(ctx, periodStart, periodEnd)
+}
+
+func (m *MockDisbursementService) ListDisbursementFiles(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.DisbursementFile, error) {
+	return m.ListDisbursementFilesFn(ctx, periodStart, periodEnd)
+}
+
+func (m *MockDisbursementService) GetDisbursementFile(ctx context.Context, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+	return m.GetDisbursementFileFn(ctx, id)
+}
+
+func (m *MockDisbursementService) ListBankFormats() service.BankFormats {
+	return m.ListBankFormatsFn()
+}
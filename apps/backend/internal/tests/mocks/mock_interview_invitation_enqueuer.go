@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.InterviewInvitationEnqueuer = (*MockInterviewInvitationEnqueuer)(nil)
+
+type MockInterviewInvitationEnqueuer struct {
+	EnqueueInterviewInvitationFn func(ctx context.Context, slotID uuid.UUID) error
+}
+
+func (m *MockInterviewInvitationEnqueuer) EnqueueInterviewInvitation(ctx context.Context, slotID uuid.UUID) error {
+	return m.EnqueueInterviewInvitationFn(ctx, slotID)
+}
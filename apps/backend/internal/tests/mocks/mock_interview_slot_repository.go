@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.InterviewSlotRepositoryInterface = (*MockInterviewSlotRepository)(nil)
+
+// MockInterviewSlotRepository provides function-based mocking for the interview slot repository.
+type MockInterviewSlotRepository struct {
+	CreateFn         func(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	GetByIDFn        func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.InterviewSlot, error)
+	GetByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.InterviewSlot, error)
+	ListFromFn       func(ctx context.Context, tx *sql.Tx, from time.Time) ([]*aggregate.InterviewSlot, error)
+	UpdateFn         func(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	BookFn           func(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error)
+	MarkInvitedFn    func(ctx context.Context, tx *sql.Tx, id uuid.UUID, studentID int32, at time.Time) (*aggregate.InterviewSlot, error)
+	DeleteFn         func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
+
+func (m *MockInterviewSlotRepository) Create(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	return m.CreateFn(ctx, tx, slot)
+}
+
+func (m *MockInterviewSlotRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.InterviewSlot, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockInterviewSlotRepository) GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.InterviewSlot, error) {
+	return m.GetByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockInterviewSlotRepository) ListFrom(ctx context.Context, tx *sql.Tx, from time.Time) ([]*aggregate.InterviewSlot, error) {
+	return m.ListFromFn(ctx, tx, from)
+}
+
+func (m *MockInterviewSlotRepository) Update(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	return m.UpdateFn(ctx, tx, slot)
+}
+
+func (m *MockInterviewSlotRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.DeleteFn(ctx, tx, id)
+}
+
+func (m *MockInterviewSlotRepository) Book(ctx context.Context, tx *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+	return m.BookFn(ctx, tx, slot)
+}
+
+func (m *MockInterviewSlotRepository) MarkInvited(ctx context.Context, tx *sql.Tx, id uuid.UUID, studentID int32, at time.Time) (*aggregate.InterviewSlot, error) {
+	return m.MarkInvitedFn(ctx, tx, id, studentID, at)
+}
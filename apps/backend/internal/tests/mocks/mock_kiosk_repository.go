@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.KioskRepositoryInterface = (*MockKioskRepository)(nil)
+
+// MockKioskRepository provides function-based mocking for the kiosk repository.
+// Set the Fn fields to control return values per test case.
+type MockKioskRepository struct {
+	CreateFn         func(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error)
+	GetByIDFn        func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Kiosk, error)
+	GetByTokenHashFn func(ctx context.Context, tx *sql.Tx, tokenHash string) (*aggregate.Kiosk, error)
+	ListFn           func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error)
+	ListActiveFn     func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error)
+	UpdateFn         func(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error)
+	TouchLastSeenFn  func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
+
+func (m *MockKioskRepository) Create(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error) {
+	return m.CreateFn(ctx, tx, kiosk)
+}
+
+func (m *MockKioskRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Kiosk, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockKioskRepository) GetByTokenHash(ctx context.Context, tx *sql.Tx, tokenHash string) (*aggregate.Kiosk, error) {
+	return m.GetByTokenHashFn(ctx, tx, tokenHash)
+}
+
+func (m *MockKioskRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockKioskRepository) ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.Kiosk, error) {
+	return m.ListActiveFn(ctx, tx)
+}
+
+func (m *MockKioskRepository) Update(ctx context.Context, tx *sql.Tx, kiosk *aggregate.Kiosk) (*aggregate.Kiosk, error) {
+	return m.UpdateFn(ctx, tx, kiosk)
+}
+
+func (m *MockKioskRepository) TouchLastSeen(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.TouchLastSeenFn(ctx, tx, id)
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.LocationRepositoryInterface = (*MockLocationRepository)(nil)
+
+type MockLocationRepository struct {
+	CreateFn                 func(ctx context.Context, tx *sql.Tx, l *aggregate.Location) (*aggregate.Location, error)
+	GetByIDFn                func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Location, error)
+	ListFn                   func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error)
+	ListAllFn                func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error)
+	UpdateFn                 func(ctx context.Context, tx *sql.Tx, l *aggregate.Location) error
+	ListByShiftTemplateIDsFn func(ctx context.Context, tx *sql.Tx, shiftTemplateIDs []uuid.UUID) (map[uuid.UUID]*aggregate.Location, error)
+}
+
+func (m *MockLocationRepository) Create(ctx context.Context, tx *sql.Tx, l *aggregate.Location) (*aggregate.Location, error) {
+	return m.CreateFn(ctx, tx, l)
+}
+
+func (m *MockLocationRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Location, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockLocationRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockLocationRepository) ListAll(ctx context.Context, tx *sql.Tx) ([]*aggregate.Location, error) {
+	return m.ListAllFn(ctx, tx)
+}
+
+func (m *MockLocationRepository) Update(ctx context.Context, tx *sql.Tx, l *aggregate.Location) error {
+	return m.UpdateFn(ctx, tx, l)
+}
+
+func (m *MockLocationRepository) ListByShiftTemplateIDs(ctx context.Context, tx *sql.Tx, shiftTemplateIDs []uuid.UUID) (map[uuid.UUID]*aggregate.Location, error) {
+	return m.ListByShiftTemplateIDsFn(ctx, tx, shiftTemplateIDs)
+}
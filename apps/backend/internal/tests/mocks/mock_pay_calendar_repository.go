@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.PayCalendarRepositoryInterface = (*MockPayCalendarRepository)(nil)
+
+// MockPayCalendarRepository provides function-based mocking for the pay calendar repository.
+type MockPayCalendarRepository struct {
+	CreateFn        func(ctx context.Context, tx *sql.Tx, calendar *aggregate.PayCalendar) (*aggregate.PayCalendar, error)
+	GetActiveFn     func(ctx context.Context, tx *sql.Tx) (*aggregate.PayCalendar, error)
+	DeactivateAllFn func(ctx context.Context, tx *sql.Tx) error
+}
+
+func (m *MockPayCalendarRepository) Create(ctx context.Context, tx *sql.Tx, calendar *aggregate.PayCalendar) (*aggregate.PayCalendar, error) {
+	return m.CreateFn(ctx, tx, calendar)
+}
+
+func (m *MockPayCalendarRepository) GetActive(ctx context.Context, tx *sql.Tx) (*aggregate.PayCalendar, error) {
+	return m.GetActiveFn(ctx, tx)
+}
+
+func (m *MockPayCalendarRepository) DeactivateAll(ctx context.Context, tx *sql.Tx) error {
+	return m.DeactivateAllFn(ctx, tx)
+}
+
+var _ repository.PayPeriodRepositoryInterface = (*MockPayPeriodRepository)(nil)
+
+// MockPayPeriodRepository provides function-based mocking for the pay period repository.
+type MockPayPeriodRepository struct {
+	CreateFn         func(ctx context.Context, tx *sql.Tx, period *aggregate.PayPeriod) (*aggregate.PayPeriod, error)
+	GetLatestFn      func(ctx context.Context, tx *sql.Tx) (*aggregate.PayPeriod, error)
+	ListFn           func(ctx context.Context, tx *sql.Tx, limit int) ([]*aggregate.PayPeriod, error)
+	ListUnnotifiedFn func(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayPeriod, error)
+	MarkNotifiedFn   func(ctx context.Context, tx *sql.Tx, id uuid.UUID, at time.Time) error
+}
+
+func (m *MockPayPeriodRepository) Create(ctx context.Context, tx *sql.Tx, period *aggregate.PayPeriod) (*aggregate.PayPeriod, error) {
+	return m.CreateFn(ctx, tx, period)
+}
+
+func (m *MockPayPeriodRepository) GetLatest(ctx context.Context, tx *sql.Tx) (*aggregate.PayPeriod, error) {
+	return m.GetLatestFn(ctx, tx)
+}
+
+func (m *MockPayPeriodRepository) List(ctx context.Context, tx *sql.Tx, limit int) ([]*aggregate.PayPeriod, error) {
+	return m.ListFn(ctx, tx, limit)
+}
+
+func (m *MockPayPeriodRepository) ListUnnotified(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayPeriod, error) {
+	return m.ListUnnotifiedFn(ctx, tx)
+}
+
+func (m *MockPayPeriodRepository) MarkNotified(ctx context.Context, tx *sql.Tx, id uuid.UUID, at time.Time) error {
+	return m.MarkNotifiedFn(ctx, tx, id, at)
+}
@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+)
+
+var _ service.PayCalendarServiceInterface = (*MockPayCalendarService)(nil)
+
+// MockPayCalendarService provides function-based mocking for the pay calendar service.
+type MockPayCalendarService struct {
+	GetCalendarFn        func(ctx context.Context) (*service.PayCalendarOverview, error)
+	SetCalendarFn        func(ctx context.Context, frequency aggregate.PayFrequency, anchorDate time.Time) (*service.PayCalendarOverview, error)
+	DisableCalendarFn    func(ctx context.Context) error
+	ListPayPeriodsFn     func(ctx context.Context) ([]*aggregate.PayPeriod, error)
+	GenerateDuePeriodsFn func(ctx context.Context) ([]*aggregate.PayPeriod, error)
+}
+
+func (m *MockPayCalendarService) GetCalendar(ctx context.Context) (*service.PayCalendarOverview, error) {
+	return m.GetCalendarFn(ctx)
+}
+
+func (m *MockPayCalendarService) SetCalendar(ctx context.Context, frequency aggregate.PayFrequency, anchorDate time.Time) (*service.PayCalendarOverview, error) {
+	return m.SetCalendarFn(ctx, frequency, anchorDate)
+}
+
+func (m *MockPayCalendarService) DisableCalendar(ctx context.Context) error {
+	return m.DisableCalendarFn(ctx)
+}
+
+func (m *MockPayCalendarService) ListPayPeriods(ctx context.Context) ([]*aggregate.PayPeriod, error) {
+	return m.ListPayPeriodsFn(ctx)
+}
+
+func (m *MockPayCalendarService) GenerateDuePeriods(ctx context.Context) ([]*aggregate.PayPeriod, error) {
+	return m.GenerateDuePeriodsFn(ctx)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.PayRuleRepositoryInterface = (*MockPayRuleRepository)(nil)
+
+// MockPayRuleRepository provides function-based mocking for the pay rule repository.
+// Set the Fn fields to control return values per test case.
+type MockPayRuleRepository struct {
+	CreateFn     func(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error)
+	GetByIDFn    func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PayRule, error)
+	ListFn       func(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error)
+	ListActiveFn func(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error)
+	UpdateFn     func(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error)
+}
+
+func (m *MockPayRuleRepository) Create(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error) {
+	return m.CreateFn(ctx, tx, rule)
+}
+
+func (m *MockPayRuleRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PayRule, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockPayRuleRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockPayRuleRepository) ListActive(ctx context.Context, tx *sql.Tx) ([]*aggregate.PayRule, error) {
+	return m.ListActiveFn(ctx, tx)
+}
+
+func (m *MockPayRuleRepository) Update(ctx context.Context, tx *sql.Tx, rule *aggregate.PayRule) (*aggregate.PayRule, error) {
+	return m.UpdateFn(ctx, tx, rule)
+}
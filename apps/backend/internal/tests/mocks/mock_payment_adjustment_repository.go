@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.PaymentAdjustmentRepositoryInterface = (*MockPaymentAdjustmentRepository)(nil)
+
+// MockPaymentAdjustmentRepository provides function-based mocking for the payment adjustment repository.
+type MockPaymentAdjustmentRepository struct {
+	CreateFn                  func(ctx context.Context, tx *sql.Tx, adjustment *aggregate.PaymentAdjustment) (*aggregate.PaymentAdjustment, error)
+	GetByIDFn                 func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PaymentAdjustment, error)
+	ListByPaymentIDFn         func(ctx context.Context, tx *sql.Tx, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	ListAppliedByPaymentIDsFn func(ctx context.Context, tx *sql.Tx, paymentIDs []uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	ListPendingFn             func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.PaymentAdjustment, error)
+	SetPaymentFn              func(ctx context.Context, tx *sql.Tx, id uuid.UUID, paymentID *uuid.UUID) error
+	DeleteFn                  func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+}
+
+func (m *MockPaymentAdjustmentRepository) Create(ctx context.Context, tx *sql.Tx, adjustment *aggregate.PaymentAdjustment) (*aggregate.PaymentAdjustment, error) {
+	return m.CreateFn(ctx, tx, adjustment)
+}
+
+func (m *MockPaymentAdjustmentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.PaymentAdjustment, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockPaymentAdjustmentRepository) ListByPaymentID(ctx context.Context, tx *sql.Tx, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	return m.ListByPaymentIDFn(ctx, tx, paymentID)
+}
+
+func (m *MockPaymentAdjustmentRepository) ListAppliedByPaymentIDs(ctx context.Context, tx *sql.Tx, paymentIDs []uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	return m.ListAppliedByPaymentIDsFn(ctx, tx, paymentIDs)
+}
+
+func (m *MockPaymentAdjustmentRepository) ListPending(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.PaymentAdjustment, error) {
+	return m.ListPendingFn(ctx, tx, studentIDs)
+}
+
+func (m *MockPaymentAdjustmentRepository) SetPayment(ctx context.Context, tx *sql.Tx, id uuid.UUID, paymentID *uuid.UUID) error {
+	return m.SetPaymentFn(ctx, tx, id, paymentID)
+}
+
+func (m *MockPaymentAdjustmentRepository) Delete(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.DeleteFn(ctx, tx, id)
+}
@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.PaymentRepositoryInterface = (*MockPaymentRepository)(nil)
+
+// MockPaymentRepository provides function-based mocking for the payment repository.
+type MockPaymentRepository struct {
+	UpsertFn                  func(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error)
+	GetByIDFn                 func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Payment, error)
+	ListByStudentIDFn         func(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.Payment, error)
+	ListByPeriodFn            func(ctx context.Context, tx *sql.Tx, filter repository.PaymentFilter) ([]*aggregate.Payment, error)
+	UpdateFn                  func(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error)
+	CalculateHoursForPeriodFn func(ctx context.Context, tx *sql.Tx, studentID int32, periodStart, periodEnd time.Time) (float64, error)
+	CalculateHoursBatchFn     func(ctx context.Context, tx *sql.Tx, studentIDs []int32, periodStart, periodEnd time.Time) (map[int32]float64, error)
+	TotalGrossAmountFn        func(ctx context.Context, tx *sql.Tx, from, to time.Time) (float64, error)
+	ListOverlappingPeriodsFn  func(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]aggregate.PeriodRange, error)
+}
+
+func (m *MockPaymentRepository) Upsert(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error) {
+	return m.UpsertFn(ctx, tx, payment)
+}
+
+func (m *MockPaymentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Payment, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockPaymentRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.Payment, error) {
+	return m.ListByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockPaymentRepository) ListByPeriod(ctx context.Context, tx *sql.Tx, filter repository.PaymentFilter) ([]*aggregate.Payment, error) {
+	return m.ListByPeriodFn(ctx, tx, filter)
+}
+
+func (m *MockPaymentRepository) Update(ctx context.Context, tx *sql.Tx, payment *aggregate.Payment) (*aggregate.Payment, error) {
+	return m.UpdateFn(ctx, tx, payment)
+}
+
+func (m *MockPaymentRepository) CalculateHoursForPeriod(ctx context.Context, tx *sql.Tx, studentID int32, periodStart, periodEnd time.Time) (float64, error) {
+	return m.CalculateHoursForPeriodFn(ctx, tx, studentID, periodStart, periodEnd)
+}
+
+func (m *MockPaymentRepository) CalculateHoursBatch(ctx context.Context, tx *sql.Tx, studentIDs []int32, periodStart, periodEnd time.Time) (map[int32]float64, error) {
+	return m.CalculateHoursBatchFn(ctx, tx, studentIDs, periodStart, periodEnd)
+}
+
+func (m *MockPaymentRepository) TotalGrossAmount(ctx context.Context, tx *sql.Tx, from, to time.Time) (float64, error) {
+	return m.TotalGrossAmountFn(ctx, tx, from, to)
+}
+
+func (m *MockPaymentRepository) ListOverlappingPeriods(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]aggregate.PeriodRange, error) {
+	return m.ListOverlappingPeriodsFn(ctx, tx, periodStart, periodEnd)
+}
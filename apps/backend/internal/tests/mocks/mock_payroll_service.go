@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+)
+
+var _ service.PayrollServiceInterface = (*MockPayrollService)(nil)
+
+// MockPayrollService provides function-based mocking for the payroll service.
+type MockPayrollService struct {
+	ListMyPaymentsFn         func(ctx context.Context) ([]*aggregate.Payment, error)
+	ListPaymentsFn           func(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
+	GeneratePaymentsFn       func(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
+	ProcessPaymentFn         func(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error)
+	RevertPaymentFn          func(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error)
+	BulkProcessPaymentsFn    func(ctx context.Context, paymentIDs []uuid.UUID) ([]*aggregate.Payment, error)
+	ExportPaymentsFn         func(ctx context.Context, periodStart, periodEnd time.Time) ([]*service.ExportRow, error)
+	ListAdjustmentsFn        func(ctx context.Context, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error)
+	AddAdjustmentFn          func(ctx context.Context, paymentID uuid.UUID, input service.AdjustmentInput) (*service.AdjustmentResult, error)
+	RemoveAdjustmentFn       func(ctx context.Context, paymentID, adjustmentID uuid.UUID) (*aggregate.Payment, error)
+	ListPendingAdjustmentsFn func(ctx context.Context) ([]*aggregate.PaymentAdjustment, error)
+}
+
+func (m *MockPayrollService) ListMyPayments(ctx context.Context) ([]*aggregate.Payment, error) {
+	return m.ListMyPaymentsFn(ctx)
+}
+
+func (m *MockPayrollService) ListPayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error) {
+	return m.ListPaymentsFn(ctx, periodStart, periodEnd)
+}
+
+func (m *MockPayrollService) GeneratePayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error) {
+	return m.GeneratePaymentsFn(ctx, periodStart, periodEnd)
+}
+
+func (m *MockPayrollService) ProcessPayment(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error) {
+	return m.ProcessPaymentFn(ctx, paymentID)
+}
+
+func (m *MockPayrollService) RevertPayment(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payment, error) {
+	return m.RevertPaymentFn(ctx, paymentID)
+}
+
+func (m *MockPayrollService) BulkProcessPayments(ctx context.Context, paymentIDs []uuid.UUID) ([]*aggregate.Payment, error) {
+	return m.BulkProcessPaymentsFn(ctx, paymentIDs)
+}
+
+func (m *MockPayrollService) ExportPayments(ctx context.Context, periodStart, periodEnd time.Time) ([]*service.ExportRow, error) {
+	return m.ExportPaymentsFn(ctx, periodStart, periodEnd)
+}
+
+func (m *MockPayrollService) ListAdjustments(ctx context.Context, paymentID uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+	return m.ListAdjustmentsFn(ctx, paymentID)
+}
+
+func (m *MockPayrollService) AddAdjustment(ctx context.Context, paymentID uuid.UUID, input service.AdjustmentInput) (*service.AdjustmentResult, error) {
+	return m.AddAdjustmentFn(ctx, paymentID, input)
+}
+
+func (m *MockPayrollService) RemoveAdjustment(ctx context.Context, paymentID, adjustmentID uuid.UUID) (*aggregate.Payment, error) {
+	return m.RemoveAdjustmentFn(ctx, paymentID, adjustmentID)
+}
+
+func (m *MockPayrollService) ListPendingAdjustments(ctx context.Context) ([]*aggregate.PaymentAdjustment, error) {
+	return m.ListPendingAdjustmentsFn(ctx)
+}
@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+)
+
+var _ service.PayslipJobEnqueuer = (*MockPayslipJobEnqueuer)(nil)
+
+type MockPayslipJobEnqueuer struct {
+	EnqueuePayslipEmailFn func(ctx context.Context, paymentID uuid.UUID) error
+}
+
+func (m *MockPayslipJobEnqueuer) EnqueuePayslipEmail(ctx context.Context, paymentID uuid.UUID) error {
+	return m.EnqueuePayslipEmailFn(ctx, paymentID)
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+)
+
+var _ service.PayslipServiceInterface = (*MockPayslipService)(nil)
+
+// MockPayslipService provides function-based mocking for the payslip service.
+type MockPayslipService struct {
+	GetMyPayslipFn     func(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payslip, error)
+	SendPayslipEmailFn func(ctx context.Context, paymentID uuid.UUID) error
+}
+
+func (m *MockPayslipService) GetMyPayslip(ctx context.Context, paymentID uuid.UUID) (*aggregate.Payslip, error) {
+	return m.GetMyPayslipFn(ctx, paymentID)
+}
+
+func (m *MockPayslipService) SendPayslipEmail(ctx context.Context, paymentID uuid.UUID) error {
+	return m.SendPayslipEmailFn(ctx, paymentID)
+}
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+)
+
+var _ service.PeriodPaymentGenerator = (*MockPeriodPaymentGenerator)(nil)
+
+// MockPeriodPaymentGenerator provides function-based mocking for period payment generation.
+type MockPeriodPaymentGenerator struct {
+	GeneratePaymentsInTxFn func(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error)
+}
+
+func (m *MockPeriodPaymentGenerator) GeneratePaymentsInTx(ctx context.Context, tx *sql.Tx, periodStart, periodEnd time.Time) ([]*aggregate.Payment, error) {
+	return m.GeneratePaymentsInTxFn(ctx, tx, periodStart, periodEnd)
+}
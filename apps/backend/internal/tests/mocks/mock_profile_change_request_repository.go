@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.ProfileChangeRequestRepositoryInterface = (*MockProfileChangeRequestRepository)(nil)
+
+// MockProfileChangeRequestRepository provides function-based mocking for the profile change request repository.
+type MockProfileChangeRequestRepository struct {
+	CreateFn                func(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error)
+	GetByIDFn               func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ProfileChangeRequest, error)
+	GetPendingByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ProfileChangeRequest, error)
+	ListFn                  func(ctx context.Context, tx *sql.Tx, filter repository.ProfileChangeFilter) ([]*aggregate.ProfileChangeRequest, error)
+	UpdateFn                func(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error)
+}
+
+func (m *MockProfileChangeRequestRepository) Create(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+	return m.CreateFn(ctx, tx, request)
+}
+
+func (m *MockProfileChangeRequestRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockProfileChangeRequestRepository) GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.ProfileChangeRequest, error) {
+	return m.GetPendingByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockProfileChangeRequestRepository) List(ctx context.Context, tx *sql.Tx, filter repository.ProfileChangeFilter) ([]*aggregate.ProfileChangeRequest, error) {
+	return m.ListFn(ctx, tx, filter)
+}
+
+func (m *MockProfileChangeRequestRepository) Update(ctx context.Context, tx *sql.Tx, request *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+	return m.UpdateFn(ctx, tx, request)
+}
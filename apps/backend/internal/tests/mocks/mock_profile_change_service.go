@@ -0,0 +1,50 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.ProfileChangeServiceInterface = (*MockProfileChangeService)(nil)
+
+// MockProfileChangeService provides function-based mocking for the profile change service.
+type MockProfileChangeService struct {
+	RequestFn    func(ctx context.Context, input service.ProfileChangeInput) (*aggregate.ProfileChangeRequest, error)
+	UpdateMineFn func(ctx context.Context, input service.UpdateStudentInput, change service.ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error)
+	ListMineFn   func(ctx context.Context) ([]*aggregate.ProfileChangeRequest, error)
+	ListFn       func(ctx context.Context, status string) ([]*aggregate.ProfileChangeRequest, error)
+	GetByIDFn    func(ctx context.Context, id uuid.UUID) (*aggregate.ProfileChangeRequest, error)
+	ApproveFn    func(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error)
+	RejectFn     func(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error)
+}
+
+func (m *MockProfileChangeService) Request(ctx context.Context, input service.ProfileChangeInput) (*aggregate.ProfileChangeRequest, error) {
+	return m.RequestFn(ctx, input)
+}
+
+func (m *MockProfileChangeService) UpdateMine(ctx context.Context, input service.UpdateStudentInput, change service.ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error) {
+	return m.UpdateMineFn(ctx, input, change)
+}
+
+func (m *MockProfileChangeService) ListMine(ctx context.Context) ([]*aggregate.ProfileChangeRequest, error) {
+	return m.ListMineFn(ctx)
+}
+
+func (m *MockProfileChangeService) List(ctx context.Context, status string) ([]*aggregate.ProfileChangeRequest, error) {
+	return m.ListFn(ctx, status)
+}
+
+func (m *MockProfileChangeService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+	return m.GetByIDFn(ctx, id)
+}
+
+func (m *MockProfileChangeService) Approve(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+	return m.ApproveFn(ctx, id, note)
+}
+
+func (m *MockProfileChangeService) Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+	return m.RejectFn(ctx, id, note)
+}
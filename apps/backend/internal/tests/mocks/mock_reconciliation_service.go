@@ -0,0 +1,19 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+)
+
+var _ service.ReconciliationServiceInterface = (*MockReconciliationService)(nil)
+
+// MockReconciliationService provides function-based mocking for the reconciliation service.
+type MockReconciliationService struct {
+	GetReconciliationFn func(ctx context.Context, query aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error)
+}
+
+func (m *MockReconciliationService) GetReconciliation(ctx context.Context, query aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error) {
+	return m.GetReconciliationFn(ctx, query)
+}
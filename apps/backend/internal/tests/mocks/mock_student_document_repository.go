@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.StudentDocumentRepositoryInterface = (*MockStudentDocumentRepository)(nil)
+
+// MockStudentDocumentRepository provides function-based mocking for the student document repository.
+type MockStudentDocumentRepository struct {
+	CreateFn          func(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error)
+	GetByIDFn         func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.StudentDocument, error)
+	ListByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.StudentDocument, error)
+	UpdateFn          func(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error)
+	ListExpiredFn     func(ctx context.Context, tx *sql.Tx, now time.Time) ([]*aggregate.StudentDocument, error)
+}
+
+func (m *MockStudentDocumentRepository) Create(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+	return m.CreateFn(ctx, tx, document)
+}
+
+func (m *MockStudentDocumentRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.StudentDocument, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockStudentDocumentRepository) ListByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) ([]*aggregate.StudentDocument, error) {
+	return m.ListByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockStudentDocumentRepository) Update(ctx context.Context, tx *sql.Tx, document *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+	return m.UpdateFn(ctx, tx, document)
+}
+
+func (m *MockStudentDocumentRepository) ListExpired(ctx context.Context, tx *sql.Tx, now time.Time) ([]*aggregate.StudentDocument, error) {
+	return m.ListExpiredFn(ctx, tx, now)
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.StudentDocumentServiceInterface = (*MockStudentDocumentService)(nil)
+
+// MockStudentDocumentService provides function-based mocking for the student document service.
+type MockStudentDocumentService struct {
+	StorePendingFn              func(ctx context.Context, filename string, content []byte) (*aggregate.StudentDocument, error)
+	UploadMyDocumentFn          func(ctx context.Context, documentType, filename string, content []byte) (*aggregate.StudentDocument, error)
+	ListMyDocumentsFn           func(ctx context.Context) ([]*aggregate.StudentDocument, error)
+	UploadDocumentByStudentIDFn func(ctx context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error)
+	ListDocumentsByStudentIDFn  func(ctx context.Context, studentID int32) ([]*aggregate.StudentDocument, error)
+	DownloadFn                  func(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, []byte, error)
+	SetRetentionFn              func(ctx context.Context, id uuid.UUID, retainUntil time.Time) (*aggregate.StudentDocument, error)
+	DeleteFn                    func(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, error)
+	PurgeExpiredFn              func(ctx context.Context) (int, error)
+}
+
+func (m *MockStudentDocumentService) StorePending(ctx context.Context, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	return m.StorePendingFn(ctx, filename, content)
+}
+
+func (m *MockStudentDocumentService) UploadMyDocument(ctx context.Context, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	return m.UploadMyDocumentFn(ctx, documentType, filename, content)
+}
+
+func (m *MockStudentDocumentService) ListMyDocuments(ctx context.Context) ([]*aggregate.StudentDocument, error) {
+	return m.ListMyDocumentsFn(ctx)
+}
+
+func (m *MockStudentDocumentService) UploadDocumentByStudentID(ctx context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+	return m.UploadDocumentByStudentIDFn(ctx, studentID, documentType, filename, content)
+}
+
+func (m *MockStudentDocumentService) ListDocumentsByStudentID(ctx context.Context, studentID int32) ([]*aggregate.StudentDocument, error) {
+	return m.ListDocumentsByStudentIDFn(ctx, studentID)
+}
+
+func (m *MockStudentDocumentService) Download(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, []byte, error) {
+	return m.DownloadFn(ctx, id)
+}
+
+func (m *MockStudentDocumentService) SetRetention(ctx context.Context, id uuid.UUID, retainUntil time.Time) (*aggregate.StudentDocument, error) {
+	return m.SetRetentionFn(ctx, id, retainUntil)
+}
+
+func (m *MockStudentDocumentService) Delete(ctx context.Context, id uuid.UUID) (*aggregate.StudentDocument, error) {
+	return m.DeleteFn(ctx, id)
+}
+
+func (m *MockStudentDocumentService) PurgeExpired(ctx context.Context) (int, error) {
+	return m.PurgeExpiredFn(ctx)
+}
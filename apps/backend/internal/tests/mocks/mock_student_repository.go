@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+)
+
+var _ repository.StudentRepositoryInterface = (*MockStudentRepository)(nil)
+
+// MockStudentRepository provides function-based mocking for the student repository.
+// Set the Fn fields to control return values per test case.
+type MockStudentRepository struct {
+	CreateFn                      func(ctx context.Context, tx *sql.Tx, student *aggregate.Student) (*aggregate.Student, error)
+	GetByIDFn                     func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.Student, error)
+	GetByIDIncludingDeactivatedFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.Student, error)
+	GetByEmailFn                  func(ctx context.Context, tx *sql.Tx, email string) (*aggregate.Student, error)
+	UpdateFn                      func(ctx context.Context, tx *sql.Tx, student *aggregate.Student) error
+	ListFn                        func(ctx context.Context, tx *sql.Tx) ([]*aggregate.Student, error)
+	ListByStatusFn                func(ctx context.Context, tx *sql.Tx, status string) ([]*aggregate.Student, error)
+	ListByIDsFn                   func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.Student, error)
+	QueryFn                       func(ctx context.Context, tx *sql.Tx, query repository.StudentQuery) ([]*aggregate.Student, int, error)
+}
+
+func (m *MockStudentRepository) Create(ctx context.Context, tx *sql.Tx, student *aggregate.Student) (*aggregate.Student, error) {
+	return m.CreateFn(ctx, tx, student)
+}
+
+func (m *MockStudentRepository) GetByID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.Student, error) {
+	return m.GetByIDFn(ctx, tx, studentID)
+}
+
+func (m *MockStudentRepository) GetByIDIncludingDeactivated(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.Student, error) {
+	return m.GetByIDIncludingDeactivatedFn(ctx, tx, studentID)
+}
+
+func (m *MockStudentRepository) GetByEmail(ctx context.Context, tx *sql.Tx, email string) (*aggregate.Student, error) {
+	return m.GetByEmailFn(ctx, tx, email)
+}
+
+func (m *MockStudentRepository) Update(ctx context.Context, tx *sql.Tx, student *aggregate.Student) error {
+	return m.UpdateFn(ctx, tx, student)
+}
+
+func (m *MockStudentRepository) List(ctx context.Context, tx *sql.Tx) ([]*aggregate.Student, error) {
+	return m.ListFn(ctx, tx)
+}
+
+func (m *MockStudentRepository) ListByStatus(ctx context.Context, tx *sql.Tx, status string) ([]*aggregate.Student, error) {
+	return m.ListByStatusFn(ctx, tx, status)
+}
+
+func (m *MockStudentRepository) ListByIDs(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.Student, error) {
+	return m.ListByIDsFn(ctx, tx, studentIDs)
+}
+
+func (m *MockStudentRepository) Query(ctx context.Context, tx *sql.Tx, query repository.StudentQuery) ([]*aggregate.Student, int, error) {
+	return m.QueryFn(ctx, tx, query)
+}
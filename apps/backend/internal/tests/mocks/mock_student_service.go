@@ -2,9 +2,11 @@ package mocks
 
 import (
 	"context"
+	"io"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
 )
 
 var _ service.StudentServiceInterface = (*MockStudentService)(nil)
@@ -22,6 +24,11 @@ type MockStudentService struct {
 	BulkDeactivateFn func(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 	BulkActivateFn   func(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 	UpdateFn         func(ctx context.Context, studentID int32, input service.UpdateStudentInput) (*aggregate.Student, error)
+	PreviewImportFn  func(ctx context.Context, r io.Reader, format spreadsheet.Format) (*service.StudentImportResult, error)
+	ImportFn         func(ctx context.Context, r io.Reader, format spreadsheet.Format, autoAccept bool) (*service.StudentImportResult, error)
+	ExportFn         func(ctx context.Context, w io.Writer, params service.StudentExportParams) error
+	QueryFn          func(ctx context.Context, params service.StudentQueryParams) (*service.StudentPage, error)
+	ListByIDsFn      func(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error)
 }
 
 func (m *MockStudentService) Apply(ctx context.Context, input service.ApplyInput) (*aggregate.Student, error) {
@@ -74,3 +81,23 @@ func (m *MockStudentService) BulkActivate(ctx context.Context, studentIDs []int3
 func (m *MockStudentService) Update(ctx context.Context, studentID int32, input service.UpdateStudentInput) (*aggregate.Student, error) {
 	return m.UpdateFn(ctx, studentID, input)
 }
+
+func (m *MockStudentService) PreviewImport(ctx context.Context, r io.Reader, format spreadsheet.Format) (*service.StudentImportResult, error) {
+	return m.PreviewImportFn(ctx, r, format)
+}
+
+func (m *MockStudentService) Import(ctx context.Context, r io.Reader, format spreadsheet.Format, autoAccept bool) (*service.StudentImportResult, error) {
+	return m.ImportFn(ctx, r, format, autoAccept)
+}
+
+func (m *MockStudentService) Export(ctx context.Context, w io.Writer, params service.StudentExportParams) error {
+	return m.ExportFn(ctx, w, params)
+}
+
+func (m *MockStudentService) Query(ctx context.Context, params service.StudentQueryParams) (*service.StudentPage, error) {
+	return m.QueryFn(ctx, params)
+}
+
+func (m *MockStudentService) ListByIDs(ctx context.Context, studentIDs []int32) ([]*aggregate.Student, error) {
+	return m.ListByIDsFn(ctx, studentIDs)
+}
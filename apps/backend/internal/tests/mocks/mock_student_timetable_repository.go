@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+)
+
+var _ repository.StudentTimetableRepositoryInterface = (*MockStudentTimetableRepository)(nil)
+
+// MockStudentTimetableRepository provides function-based mocking for the student timetable repository.
+type MockStudentTimetableRepository struct {
+	GetByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.StudentTimetable, error)
+	UpsertFn         func(ctx context.Context, tx *sql.Tx, timetable *aggregate.StudentTimetable) (*aggregate.StudentTimetable, error)
+}
+
+func (m *MockStudentTimetableRepository) GetByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.StudentTimetable, error) {
+	return m.GetByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockStudentTimetableRepository) Upsert(ctx context.Context, tx *sql.Tx, timetable *aggregate.StudentTimetable) (*aggregate.StudentTimetable, error) {
+	return m.UpsertFn(ctx, tx, timetable)
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.TimeLogBreakRepositoryInterface = (*MockTimeLogBreakRepository)(nil)
+
+// MockTimeLogBreakRepository provides function-based mocking for the time log break repository.
+// Set the Fn fields to control return values per test case.
+type MockTimeLogBreakRepository struct {
+	CreateFn             func(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error)
+	GetOpenByTimeLogIDFn func(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) (*aggregate.TimeLogBreak, error)
+	UpdateFn             func(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error)
+	ListByTimeLogIDFn    func(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) ([]*aggregate.TimeLogBreak, error)
+}
+
+func (m *MockTimeLogBreakRepository) Create(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+	return m.CreateFn(ctx, tx, b)
+}
+
+func (m *MockTimeLogBreakRepository) GetOpenByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) (*aggregate.TimeLogBreak, error) {
+	return m.GetOpenByTimeLogIDFn(ctx, tx, timeLogID)
+}
+
+func (m *MockTimeLogBreakRepository) Update(ctx context.Context, tx *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+	return m.UpdateFn(ctx, tx, b)
+}
+
+func (m *MockTimeLogBreakRepository) ListByTimeLogID(ctx context.Context, tx *sql.Tx, timeLogID uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+	return m.ListByTimeLogIDFn(ctx, tx, timeLogID)
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.TimeOffRequestRepositoryInterface = (*MockTimeOffRequestRepository)(nil)
+
+// MockTimeOffRequestRepository provides function-based mocking for the time-off request repository.
+// Set the Fn fields to control return values per test case.
+type MockTimeOffRequestRepository struct {
+	CreateFn  func(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error)
+	GetByIDFn func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TimeOffRequest, error)
+	UpdateFn  func(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error)
+	ListFn    func(ctx context.Context, tx *sql.Tx, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error)
+}
+
+func (m *MockTimeOffRequestRepository) Create(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+	return m.CreateFn(ctx, tx, request)
+}
+
+func (m *MockTimeOffRequestRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TimeOffRequest, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockTimeOffRequestRepository) Update(ctx context.Context, tx *sql.Tx, request *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+	return m.UpdateFn(ctx, tx, request)
+}
+
+func (m *MockTimeOffRequestRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+	return m.ListFn(ctx, tx, filter)
+}
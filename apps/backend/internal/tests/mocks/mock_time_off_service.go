@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/service"
+	"github.com/google/uuid"
+)
+
+var _ service.TimeOffServiceInterface = (*MockTimeOffService)(nil)
+
+// MockTimeOffService provides function-based mocking for the time-off service.
+type MockTimeOffService struct {
+	RequestTimeOffFn func(ctx context.Context, input service.RequestTimeOffInput) (*aggregate.TimeOffRequest, error)
+	ListMyTimeOffFn  func(ctx context.Context) ([]*aggregate.TimeOffRequest, error)
+	CancelTimeOffFn  func(ctx context.Context, id uuid.UUID) (*aggregate.TimeOffRequest, error)
+	ListTimeOffFn    func(ctx context.Context, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error)
+	ApproveTimeOffFn func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error)
+	RejectTimeOffFn  func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error)
+	ListConflictsFn  func(ctx context.Context, from, to time.Time) ([]service.Conflict, error)
+}
+
+func (m *MockTimeOffService) RequestTimeOff(ctx context.Context, input service.RequestTimeOffInput) (*aggregate.TimeOffRequest, error) {
+	return m.RequestTimeOffFn(ctx, input)
+}
+
+func (m *MockTimeOffService) ListMyTimeOff(ctx context.Context) ([]*aggregate.TimeOffRequest, error) {
+	return m.ListMyTimeOffFn(ctx)
+}
+
+func (m *MockTimeOffService) CancelTimeOff(ctx context.Context, id uuid.UUID) (*aggregate.TimeOffRequest, error) {
+	return m.CancelTimeOffFn(ctx, id)
+}
+
+func (m *MockTimeOffService) ListTimeOff(ctx context.Context, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+	return m.ListTimeOffFn(ctx, filter)
+}
+
+func (m *MockTimeOffService) ApproveTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error) {
+	return m.ApproveTimeOffFn(ctx, id, note)
+}
+
+func (m *MockTimeOffService) RejectTimeOff(ctx context.Context, id uuid.UUID, note *string) (*aggregate.TimeOffRequest, error) {
+	return m.RejectTimeOffFn(ctx, id, note)
+}
+
+func (m *MockTimeOffService) ListConflicts(ctx context.Context, from, to time.Time) ([]service.Conflict, error) {
+	return m.ListConflictsFn(ctx, from, to)
+}
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
@@ -19,6 +20,9 @@ type MockTimeLogRepository struct {
 	GetOpenByStudentIDFn        func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TimeLog, error)
 	UpdateFn                    func(ctx context.Context, tx *sql.Tx, timeLog *aggregate.TimeLog) (*aggregate.TimeLog, error)
 	ListFn                      func(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, int, error)
+	ListAllFn                   func(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, error)
+	ListRecentByStudentIDFn     func(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.TimeLog, error)
+	ListStudentIDsByDeviceFn    func(ctx context.Context, tx *sql.Tx, deviceFingerprint string, since time.Time, excludeStudentID int32) ([]int32, error)
 	ListWithStudentDetailsFn    func(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.AdminTimeLog, int, error)
 	GetByIDWithStudentDetailsFn func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.AdminTimeLog, error)
 }
@@ -43,6 +47,18 @@ func (m *MockTimeLogRepository) List(ctx context.Context, tx *sql.Tx, filter rep
 	return m.ListFn(ctx, tx, filter)
 }
 
+func (m *MockTimeLogRepository) ListAll(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, error) {
+	return m.ListAllFn(ctx, tx, filter)
+}
+
+func (m *MockTimeLogRepository) ListRecentByStudentID(ctx context.Context, tx *sql.Tx, studentID int32, limit int) ([]*aggregate.TimeLog, error) {
+	return m.ListRecentByStudentIDFn(ctx, tx, studentID, limit)
+}
+
+func (m *MockTimeLogRepository) ListStudentIDsByDevice(ctx context.Context, tx *sql.Tx, deviceFingerprint string, since time.Time, excludeStudentID int32) ([]int32, error) {
+	return m.ListStudentIDsByDeviceFn(ctx, tx, deviceFingerprint, since, excludeStudentID)
+}
+
 func (m *MockTimeLogRepository) ListWithStudentDetails(ctx context.Context, tx *sql.Tx, filter repository.TimeLogFilter) ([]*aggregate.AdminTimeLog, int, error) {
 	return m.ListWithStudentDetailsFn(ctx, tx, filter)
 }
@@ -16,6 +16,8 @@ var _ service.TimeLogServiceInterface = (*MockTimeLogService)(nil)
 type MockTimeLogService struct {
 	ClockInFn              func(ctx context.Context, input service.ClockInInput) (*aggregate.TimeLog, error)
 	ClockOutFn             func(ctx context.Context) (*aggregate.TimeLog, error)
+	StartBreakFn           func(ctx context.Context) (*aggregate.TimeLogBreak, error)
+	EndBreakFn             func(ctx context.Context) (*aggregate.TimeLogBreak, error)
 	GetMyStatusFn          func(ctx context.Context) (*service.ClockInStatus, error)
 	ListMyTimeLogsFn       func(ctx context.Context, filter repository.TimeLogFilter) ([]*aggregate.TimeLog, int, error)
 	GenerateClockInCodeFn  func(ctx context.Context, expiresInMinutes int) (*aggregate.ClockInCode, error)
@@ -24,6 +26,7 @@ type MockTimeLogService struct {
 	GetTimeLogFn           func(ctx context.Context, id uuid.UUID) (*aggregate.AdminTimeLog, error)
 	FlagTimeLogFn          func(ctx context.Context, id uuid.UUID, reason string) (*aggregate.TimeLog, error)
 	UnflagTimeLogFn        func(ctx context.Context, id uuid.UUID) (*aggregate.TimeLog, error)
+	ListTimeLogBreaksFn    func(ctx context.Context, id uuid.UUID) ([]*aggregate.TimeLogBreak, error)
 }
 
 func (m *MockTimeLogService) ClockIn(ctx context.Context, input service.ClockInInput) (*aggregate.TimeLog, error) {
@@ -34,6 +37,14 @@ func (m *MockTimeLogService) ClockOut(ctx context.Context) (*aggregate.TimeLog,
 	return m.ClockOutFn(ctx)
 }
 
+func (m *MockTimeLogService) StartBreak(ctx context.Context) (*aggregate.TimeLogBreak, error) {
+	return m.StartBreakFn(ctx)
+}
+
+func (m *MockTimeLogService) EndBreak(ctx context.Context) (*aggregate.TimeLogBreak, error) {
+	return m.EndBreakFn(ctx)
+}
+
 func (m *MockTimeLogService) GetMyStatus(ctx context.Context) (*service.ClockInStatus, error) {
 	return m.GetMyStatusFn(ctx)
 }
@@ -65,3 +76,7 @@ func (m *MockTimeLogService) FlagTimeLog(ctx context.Context, id uuid.UUID, reas
 func (m *MockTimeLogService) UnflagTimeLog(ctx context.Context, id uuid.UUID) (*aggregate.TimeLog, error) {
 	return m.UnflagTimeLogFn(ctx, id)
 }
+
+func (m *MockTimeLogService) ListTimeLogBreaks(ctx context.Context, id uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+	return m.ListTimeLogBreaksFn(ctx, id)
+}
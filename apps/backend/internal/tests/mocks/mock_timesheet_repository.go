@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.TimesheetRepositoryInterface = (*MockTimesheetRepository)(nil)
+
+// MockTimesheetRepository provides function-based mocking for the timesheet repository.
+// Set the Fn fields to control return values per test case.
+type MockTimesheetRepository struct {
+	CreateFn                 func(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error)
+	GetByIDFn                func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Timesheet, error)
+	GetByStudentAndWeekFn    func(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*aggregate.Timesheet, error)
+	UpdateFn                 func(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error)
+	ListFn                   func(ctx context.Context, tx *sql.Tx, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error)
+	SummarizeWeekFn          func(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*repository.WorkedSummary, error)
+	ListStudentIDsWithLogsFn func(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error)
+	ListRemindedStudentIDsFn func(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error)
+	RecordRemindersFn        func(ctx context.Context, tx *sql.Tx, weekStart time.Time, studentIDs []int32, sentAt time.Time) error
+}
+
+func (m *MockTimesheetRepository) Create(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+	return m.CreateFn(ctx, tx, timesheet)
+}
+
+func (m *MockTimesheetRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.Timesheet, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockTimesheetRepository) GetByStudentAndWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*aggregate.Timesheet, error) {
+	return m.GetByStudentAndWeekFn(ctx, tx, studentID, weekStart)
+}
+
+func (m *MockTimesheetRepository) Update(ctx context.Context, tx *sql.Tx, timesheet *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+	return m.UpdateFn(ctx, tx, timesheet)
+}
+
+func (m *MockTimesheetRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+	return m.ListFn(ctx, tx, filter)
+}
+
+func (m *MockTimesheetRepository) SummarizeWeek(ctx context.Context, tx *sql.Tx, studentID int32, weekStart time.Time) (*repository.WorkedSummary, error) {
+	return m.SummarizeWeekFn(ctx, tx, studentID, weekStart)
+}
+
+func (m *MockTimesheetRepository) ListStudentIDsWithLogs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error) {
+	return m.ListStudentIDsWithLogsFn(ctx, tx, weekStart)
+}
+
+func (m *MockTimesheetRepository) ListRemindedStudentIDs(ctx context.Context, tx *sql.Tx, weekStart time.Time) ([]int32, error) {
+	return m.ListRemindedStudentIDsFn(ctx, tx, weekStart)
+}
+
+func (m *MockTimesheetRepository) RecordReminders(ctx context.Context, tx *sql.Tx, weekStart time.Time, studentIDs []int32, sentAt time.Time) error {
+	return m.RecordRemindersFn(ctx, tx, weekStart, studentIDs, sentAt)
+}
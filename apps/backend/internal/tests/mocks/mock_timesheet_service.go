@@ -0,0 +1,63 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+	"github.com/google/uuid"
+)
+
+var _ service.TimesheetServiceInterface = (*MockTimesheetService)(nil)
+
+// MockTimesheetService provides function-based mocking for the timesheet service.
+// Set the Fn fields to control return values per test case.
+type MockTimesheetService struct {
+	GetMyWeekFn        func(ctx context.Context, weekStart time.Time) (*service.WeekView, error)
+	ListMyTimesheetsFn func(ctx context.Context) ([]*aggregate.Timesheet, error)
+	SubmitTimesheetFn  func(ctx context.Context, weekStart time.Time) (*aggregate.Timesheet, error)
+	ListTimesheetsFn   func(ctx context.Context, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error)
+	GetTimesheetFn     func(ctx context.Context, id uuid.UUID) (*service.WeekView, error)
+	ApproveTimesheetFn func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error)
+	RejectTimesheetFn  func(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error)
+	SendRemindersFn    func(ctx context.Context, weekStart time.Time) (int, error)
+	SendDueRemindersFn func(ctx context.Context) (int, error)
+}
+
+func (m *MockTimesheetService) GetMyWeek(ctx context.Context, weekStart time.Time) (*service.WeekView, error) {
+	return m.GetMyWeekFn(ctx, weekStart)
+}
+
+func (m *MockTimesheetService) ListMyTimesheets(ctx context.Context) ([]*aggregate.Timesheet, error) {
+	return m.ListMyTimesheetsFn(ctx)
+}
+
+func (m *MockTimesheetService) SubmitTimesheet(ctx context.Context, weekStart time.Time) (*aggregate.Timesheet, error) {
+	return m.SubmitTimesheetFn(ctx, weekStart)
+}
+
+func (m *MockTimesheetService) ListTimesheets(ctx context.Context, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+	return m.ListTimesheetsFn(ctx, filter)
+}
+
+func (m *MockTimesheetService) GetTimesheet(ctx context.Context, id uuid.UUID) (*service.WeekView, error) {
+	return m.GetTimesheetFn(ctx, id)
+}
+
+func (m *MockTimesheetService) ApproveTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error) {
+	return m.ApproveTimesheetFn(ctx, id, note)
+}
+
+func (m *MockTimesheetService) RejectTimesheet(ctx context.Context, id uuid.UUID, note *string) (*aggregate.Timesheet, error) {
+	return m.RejectTimesheetFn(ctx, id, note)
+}
+
+func (m *MockTimesheetService) SendReminders(ctx context.Context, weekStart time.Time) (int, error) {
+	return m.SendRemindersFn(ctx, weekStart)
+}
+
+func (m *MockTimesheetService) SendDueReminders(ctx context.Context) (int, error) {
+	return m.SendDueRemindersFn(ctx)
+}
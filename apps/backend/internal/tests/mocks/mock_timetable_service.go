@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+)
+
+var _ service.TimetableServiceInterface = (*MockTimetableService)(nil)
+
+// MockTimetableService provides function-based mocking for the timetable service.
+type MockTimetableService struct {
+	ImportMineFn     func(ctx context.Context, filename string, data []byte) (*service.TimetableProposal, error)
+	GetMineFn        func(ctx context.Context) (*service.TimetableProposal, error)
+	GetByStudentIDFn func(ctx context.Context, studentID int32) (*service.TimetableProposal, error)
+	ApplyMineFn      func(ctx context.Context) (*aggregate.ProfileChangeRequest, error)
+}
+
+func (m *MockTimetableService) ImportMine(ctx context.Context, filename string, data []byte) (*service.TimetableProposal, error) {
+	return m.ImportMineFn(ctx, filename, data)
+}
+
+func (m *MockTimetableService) GetMine(ctx context.Context) (*service.TimetableProposal, error) {
+	return m.GetMineFn(ctx)
+}
+
+func (m *MockTimetableService) GetByStudentID(ctx context.Context, studentID int32) (*service.TimetableProposal, error) {
+	return m.GetByStudentIDFn(ctx, studentID)
+}
+
+func (m *MockTimetableService) ApplyMine(ctx context.Context) (*aggregate.ProfileChangeRequest, error) {
+	return m.ApplyMineFn(ctx)
+}
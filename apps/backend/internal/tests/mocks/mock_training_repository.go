@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.TrainingRepositoryInterface = (*MockTrainingRepository)(nil)
+
+// MockTrainingRepository provides function-based mocking for the training repository.
+type MockTrainingRepository struct {
+	CreateRequirementFn      func(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error)
+	GetRequirementByIDFn     func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TrainingRequirement, error)
+	UpdateRequirementFn      func(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error)
+	ListRequirementsFn       func(ctx context.Context, tx *sql.Tx) ([]*aggregate.TrainingRequirement, error)
+	UpsertRecordFn           func(ctx context.Context, tx *sql.Tx, record *aggregate.TrainingRecord) (*aggregate.TrainingRecord, error)
+	DeleteRecordFn           func(ctx context.Context, tx *sql.Tx, id uuid.UUID) error
+	ListRecordsFn            func(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.TrainingRecord, error)
+	ListUnremindedExpiringFn func(ctx context.Context, tx *sql.Tx, before time.Time) ([]*aggregate.TrainingRecord, error)
+	MarkReminderSentFn       func(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, at time.Time) error
+}
+
+func (m *MockTrainingRepository) CreateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+	return m.CreateRequirementFn(ctx, tx, requirement)
+}
+
+func (m *MockTrainingRepository) GetRequirementByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TrainingRequirement, error) {
+	return m.GetRequirementByIDFn(ctx, tx, id)
+}
+
+func (m *MockTrainingRepository) UpdateRequirement(ctx context.Context, tx *sql.Tx, requirement *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+	return m.UpdateRequirementFn(ctx, tx, requirement)
+}
+
+func (m *MockTrainingRepository) ListRequirements(ctx context.Context, tx *sql.Tx) ([]*aggregate.TrainingRequirement, error) {
+	return m.ListRequirementsFn(ctx, tx)
+}
+
+func (m *MockTrainingRepository) UpsertRecord(ctx context.Context, tx *sql.Tx, record *aggregate.TrainingRecord) (*aggregate.TrainingRecord, error) {
+	return m.UpsertRecordFn(ctx, tx, record)
+}
+
+func (m *MockTrainingRepository) DeleteRecord(ctx context.Context, tx *sql.Tx, id uuid.UUID) error {
+	return m.DeleteRecordFn(ctx, tx, id)
+}
+
+func (m *MockTrainingRepository) ListRecords(ctx context.Context, tx *sql.Tx, studentIDs []int32) ([]*aggregate.TrainingRecord, error) {
+	return m.ListRecordsFn(ctx, tx, studentIDs)
+}
+
+func (m *MockTrainingRepository) ListUnremindedExpiring(ctx context.Context, tx *sql.Tx, before time.Time) ([]*aggregate.TrainingRecord, error) {
+	return m.ListUnremindedExpiringFn(ctx, tx, before)
+}
+
+func (m *MockTrainingRepository) MarkReminderSent(ctx context.Context, tx *sql.Tx, ids []uuid.UUID, at time.Time) error {
+	return m.MarkReminderSentFn(ctx, tx, ids, at)
+}
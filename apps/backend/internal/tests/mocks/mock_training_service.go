@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.TrainingServiceInterface = (*MockTrainingService)(nil)
+
+// MockTrainingService provides function-based mocking for the training service.
+type MockTrainingService struct {
+	ListRequirementsFn    func(ctx context.Context) ([]*aggregate.TrainingRequirement, error)
+	CreateRequirementFn   func(ctx context.Context, input service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error)
+	UpdateRequirementFn   func(ctx context.Context, id uuid.UUID, input service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error)
+	RecordCompletionFn    func(ctx context.Context, studentID int32, input service.TrainingCompletionInput) (*aggregate.TrainingRecord, error)
+	DeleteRecordFn        func(ctx context.Context, id uuid.UUID) error
+	GetMineFn             func(ctx context.Context) ([]aggregate.TrainingStatus, error)
+	GetByStudentIDFn      func(ctx context.Context, studentID int32) ([]aggregate.TrainingStatus, error)
+	MissingBlockingFn     func(ctx context.Context, studentIDs []int32, asOf time.Time) (map[int32][]*aggregate.TrainingRequirement, error)
+	SendExpiryRemindersFn func(ctx context.Context) (int, error)
+}
+
+func (m *MockTrainingService) ListRequirements(ctx context.Context) ([]*aggregate.TrainingRequirement, error) {
+	return m.ListRequirementsFn(ctx)
+}
+
+func (m *MockTrainingService) CreateRequirement(ctx context.Context, input service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+	return m.CreateRequirementFn(ctx, input)
+}
+
+func (m *MockTrainingService) UpdateRequirement(ctx context.Context, id uuid.UUID, input service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+	return m.UpdateRequirementFn(ctx, id, input)
+}
+
+func (m *MockTrainingService) RecordCompletion(ctx context.Context, studentID int32, input service.TrainingCompletionInput) (*aggregate.TrainingRecord, error) {
+	return m.RecordCompletionFn(ctx, studentID, input)
+}
+
+func (m *MockTrainingService) DeleteRecord(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteRecordFn(ctx, id)
+}
+
+func (m *MockTrainingService) GetMine(ctx context.Context) ([]aggregate.TrainingStatus, error) {
+	return m.GetMineFn(ctx)
+}
+
+func (m *MockTrainingService) GetByStudentID(ctx context.Context, studentID int32) ([]aggregate.TrainingStatus, error) {
+	return m.GetByStudentIDFn(ctx, studentID)
+}
+
+func (m *MockTrainingService) MissingBlocking(ctx context.Context, studentIDs []int32, asOf time.Time) (map[int32][]*aggregate.TrainingRequirement, error) {
+	return m.MissingBlockingFn(ctx, studentIDs, asOf)
+}
+
+func (m *MockTrainingService) SendExpiryReminders(ctx context.Context) (int, error) {
+	return m.SendExpiryRemindersFn(ctx)
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.TranscriptSubmissionRepositoryInterface = (*MockTranscriptSubmissionRepository)(nil)
+
+// MockTranscriptSubmissionRepository provides function-based mocking for the transcript submission repository.
+type MockTranscriptSubmissionRepository struct {
+	CreateFn                func(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error)
+	GetByIDFn               func(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TranscriptSubmission, error)
+	GetPendingByStudentIDFn func(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TranscriptSubmission, error)
+	ListFn                  func(ctx context.Context, tx *sql.Tx, filter repository.TranscriptSubmissionFilter) ([]*aggregate.TranscriptSubmission, error)
+	UpdateFn                func(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error)
+}
+
+func (m *MockTranscriptSubmissionRepository) Create(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+	return m.CreateFn(ctx, tx, submission)
+}
+
+func (m *MockTranscriptSubmissionRepository) GetByID(ctx context.Context, tx *sql.Tx, id uuid.UUID) (*aggregate.TranscriptSubmission, error) {
+	return m.GetByIDFn(ctx, tx, id)
+}
+
+func (m *MockTranscriptSubmissionRepository) GetPendingByStudentID(ctx context.Context, tx *sql.Tx, studentID int32) (*aggregate.TranscriptSubmission, error) {
+	return m.GetPendingByStudentIDFn(ctx, tx, studentID)
+}
+
+func (m *MockTranscriptSubmissionRepository) List(ctx context.Context, tx *sql.Tx, filter repository.TranscriptSubmissionFilter) ([]*aggregate.TranscriptSubmission, error) {
+	return m.ListFn(ctx, tx, filter)
+}
+
+func (m *MockTranscriptSubmissionRepository) Update(ctx context.Context, tx *sql.Tx, submission *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+	return m.UpdateFn(ctx, tx, submission)
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/google/uuid"
+)
+
+var _ service.TranscriptSubmissionServiceInterface = (*MockTranscriptSubmissionService)(nil)
+
+// MockTranscriptSubmissionService provides function-based mocking for the transcript submission service.
+type MockTranscriptSubmissionService struct {
+	SubmitFn   func(ctx context.Context, filename string, pdf []byte) (*aggregate.TranscriptSubmission, error)
+	ListMineFn func(ctx context.Context) ([]*aggregate.TranscriptSubmission, error)
+	ListFn     func(ctx context.Context, status string) ([]*aggregate.TranscriptSubmission, error)
+	GetByIDFn  func(ctx context.Context, id uuid.UUID) (*aggregate.TranscriptSubmission, error)
+	ApproveFn  func(ctx context.Context, id uuid.UUID, note string) (*service.TranscriptReviewResult, error)
+	RejectFn   func(ctx context.Context, id uuid.UUID, note string) (*aggregate.TranscriptSubmission, error)
+}
+
+func (m *MockTranscriptSubmissionService) Submit(ctx context.Context, filename string, pdf []byte) (*aggregate.TranscriptSubmission, error) {
+	return m.SubmitFn(ctx, filename, pdf)
+}
+
+func (m *MockTranscriptSubmissionService) ListMine(ctx context.Context) ([]*aggregate.TranscriptSubmission, error) {
+	return m.ListMineFn(ctx)
+}
+
+func (m *MockTranscriptSubmissionService) List(ctx context.Context, status string) ([]*aggregate.TranscriptSubmission, error) {
+	return m.ListFn(ctx, status)
+}
+
+func (m *MockTranscriptSubmissionService) GetByID(ctx context.Context, id uuid.UUID) (*aggregate.TranscriptSubmission, error) {
+	return m.GetByIDFn(ctx, id)
+}
+
+func (m *MockTranscriptSubmissionService) Approve(ctx context.Context, id uuid.UUID, note string) (*service.TranscriptReviewResult, error) {
+	return m.ApproveFn(ctx, id, note)
+}
+
+func (m *MockTranscriptSubmissionService) Reject(ctx context.Context, id uuid.UUID, note string) (*aggregate.TranscriptSubmission, error) {
+	return m.RejectFn(ctx, id, note)
+}
@@ -0,0 +1,212 @@
+package attendance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	attendanceErrors "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttendanceAggregateTestSuite struct {
+	suite.Suite
+	query aggregate.ReportQuery
+}
+
+func TestAttendanceAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceAggregateTestSuite))
+}
+
+func (s *AttendanceAggregateTestSuite) SetupTest() {
+	s.query = aggregate.ReportQuery{From: date(2026, 3, 2), To: date(2026, 3, 15)}
+	s.Require().NoError(s.query.Validate())
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func at(day time.Time, h, m int) time.Time {
+	return day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+}
+
+func shift(studentID int32, day time.Time, startH, endH int, grace int32) aggregate.Shift {
+	return aggregate.Shift{
+		StudentID:        studentID,
+		ShiftID:          "s",
+		Date:             day,
+		Start:            at(day, startH, 0),
+		End:              at(day, endH, 0),
+		LateGraceMinutes: grace,
+	}
+}
+
+func logOf(studentID int32, entry time.Time, exit *time.Time) *timelogAggregate.TimeLog {
+	return &timelogAggregate.TimeLog{ID: uuid.New(), StudentID: studentID, EntryAt: entry, ExitAt: exit}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// --- Validate ---
+
+func (s *AttendanceAggregateTestSuite) TestValidate() {
+	tests := []struct {
+		name  string
+		query aggregate.ReportQuery
+		err   error
+	}{
+		{"defaults", aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 1)}, nil},
+		{"to before from", aggregate.ReportQuery{From: date(2026, 3, 2), To: date(2026, 3, 1)}, attendanceErrors.ErrInvalidDateRange},
+		{"range too long", aggregate.ReportQuery{From: date(2025, 1, 1), To: date(2026, 1, 2)}, attendanceErrors.ErrDateRangeTooLong},
+		{"bad interval", aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 2), Interval: "day"}, attendanceErrors.ErrInvalidInterval},
+		{"bad sort", aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 2), SortBy: "name"}, attendanceErrors.ErrInvalidSortField},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			err := tt.query.Validate()
+			if tt.err == nil {
+				s.NoError(err)
+				s.Equal(aggregate.SortField_StudentID, tt.query.SortBy)
+			} else {
+				s.ErrorIs(err, tt.err)
+			}
+		})
+	}
+}
+
+// --- BuildReport ---
+
+func (s *AttendanceAggregateTestSuite) TestBuildReport_ClassifiesShifts() {
+	mon, tue, wed, thu := date(2026, 3, 2), date(2026, 3, 3), date(2026, 3, 4), date(2026, 3, 5)
+	shifts := []aggregate.Shift{
+		shift(1, mon, 8, 10, 5), // on time
+		shift(1, tue, 8, 10, 5), // 3 minutes late, within grace
+		shift(1, wed, 8, 10, 5), // 20 minutes late, leaves early
+		shift(1, thu, 8, 10, 5), // missed
+	}
+	logs := []*timelogAggregate.TimeLog{
+		logOf(1, at(mon, 7, 58), ptr(at(mon, 10, 0))),
+		logOf(1, at(tue, 8, 3), ptr(at(tue, 10, 0))),
+		logOf(1, at(wed, 8, 20), ptr(at(wed, 9, 30))),
+	}
+
+	rows := aggregate.BuildReport(shifts, logs, s.query, time.UTC)
+
+	s.Require().Len(rows, 1)
+	r := rows[0]
+	s.Equal(int32(1), r.StudentID)
+	s.Equal(s.query.From, r.PeriodStart)
+	s.Equal(s.query.To, r.PeriodEnd)
+	s.Equal(4, r.ScheduledShifts)
+	s.Equal(2, r.OnTimeShifts)
+	s.Equal(1, r.LateShifts)
+	s.Equal(1, r.MissedShifts)
+	s.Equal(1, r.EarlyDepartures)
+	s.Equal(8.0, r.HoursScheduled)
+	s.Equal(5.15, r.HoursWorked) // 2h02 + 1h57 + 1h10 of raw time
+
+	rate, ok := r.OnTimeRate()
+	s.True(ok)
+	s.Equal(0.5, rate)
+	s.InDelta(23.0/3, r.AverageMinutesLate(), 0.01)
+}
+
+func (s *AttendanceAggregateTestSuite) TestBuildReport_SplitLogsAndOpenLogs() {
+	mon, tue := date(2026, 3, 2), date(2026, 3, 3)
+	shifts := []aggregate.Shift{shift(1, mon, 8, 12, 0), shift(1, tue, 8, 10, 0)}
+	logs := []*timelogAggregate.TimeLog{
+		// Two logs cover Monday; the latest exit decides the departure.
+		logOf(1, at(mon, 8, 0), ptr(at(mon, 9, 0))),
+		logOf(1, at(mon, 9, 30), ptr(at(mon, 12, 0))),
+		// A log still open is not an early departure.
+		logOf(1, at(tue, 8, 0), nil),
+	}
+
+	rows := aggregate.BuildReport(shifts, logs, s.query, time.UTC)
+
+	s.Require().Len(rows, 1)
+	s.Equal(2, rows[0].OnTimeShifts)
+	s.Equal(0, rows[0].EarlyDepartures)
+	s.Equal(3.5, rows[0].HoursWorked)
+}
+
+func (s *AttendanceAggregateTestSuite) TestBuildReport_FlaggedAndPaidMinutes() {
+	mon := date(2026, 3, 2)
+	flagged := logOf(1, at(mon, 13, 0), ptr(at(mon, 15, 0)))
+	flagged.IsFlagged = true
+	paid := logOf(1, at(mon, 8, 0), ptr(at(mon, 10, 7)))
+	paid.PaidMinutes = ptr(120.0)
+
+	rows := aggregate.BuildReport(nil, []*timelogAggregate.TimeLog{paid, flagged}, s.query, time.UTC)
+
+	s.Require().Len(rows, 1)
+	s.Equal(1, rows[0].FlaggedLogs)
+	s.Equal(2.0, rows[0].HoursWorked)
+	_, ok := rows[0].OnTimeRate()
+	s.False(ok)
+}
+
+func (s *AttendanceAggregateTestSuite) TestBuildReport_WeeklyInterval() {
+	s.query.Interval = aggregate.Interval_Week
+	shifts := []aggregate.Shift{
+		shift(1, date(2026, 3, 3), 8, 10, 0),
+		shift(1, date(2026, 3, 10), 8, 10, 0),
+	}
+
+	rows := aggregate.BuildReport(shifts, nil, s.query, time.UTC)
+
+	s.Require().Len(rows, 2)
+	s.Equal(date(2026, 3, 2), rows[0].PeriodStart)
+	s.Equal(date(2026, 3, 8), rows[0].PeriodEnd)
+	s.Equal(date(2026, 3, 9), rows[1].PeriodStart)
+	s.Equal(1, rows[1].MissedShifts)
+}
+
+func (s *AttendanceAggregateTestSuite) TestBuildReport_MonthlyIntervalClipsToRange() {
+	s.query = aggregate.ReportQuery{From: date(2026, 2, 20), To: date(2026, 3, 10), Interval: aggregate.Interval_Month}
+	s.Require().NoError(s.query.Validate())
+	shifts := []aggregate.Shift{
+		shift(1, date(2026, 2, 23), 8, 10, 0),
+		shift(1, date(2026, 3, 2), 8, 10, 0),
+	}
+
+	rows := aggregate.BuildReport(shifts, nil, s.query, time.UTC)
+
+	s.Require().Len(rows, 2)
+	s.Equal(date(2026, 2, 20), rows[0].PeriodStart)
+	s.Equal(date(2026, 2, 28), rows[0].PeriodEnd)
+	s.Equal(date(2026, 3, 1), rows[1].PeriodStart)
+	s.Equal(date(2026, 3, 10), rows[1].PeriodEnd)
+}
+
+// --- SortReport ---
+
+func (s *AttendanceAggregateTestSuite) TestSortReport() {
+	rows := []*aggregate.StudentAttendance{
+		{StudentID: 1, ScheduledShifts: 4, OnTimeShifts: 2, MissedShifts: 2},
+		{StudentID: 2, ScheduledShifts: 0},
+		{StudentID: 3, ScheduledShifts: 4, OnTimeShifts: 4},
+		{StudentID: 4, ScheduledShifts: 4, OnTimeShifts: 1, MissedShifts: 2},
+	}
+
+	aggregate.SortReport(rows, aggregate.SortField_OnTimeRate, false)
+	s.Equal([]int32{4, 1, 3, 2}, ids(rows))
+
+	aggregate.SortReport(rows, aggregate.SortField_OnTimeRate, true)
+	s.Equal([]int32{3, 1, 4, 2}, ids(rows))
+
+	aggregate.SortReport(rows, aggregate.SortField_MissedShifts, true)
+	s.Equal([]int32{1, 4, 2, 3}, ids(rows))
+}
+
+func ids(rows []*aggregate.StudentAttendance) []int32 {
+	out := make([]int32, len(rows))
+	for i, r := range rows {
+		out[i] = r.StudentID
+	}
+	return out
+}
@@ -0,0 +1,156 @@
+package attendance_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	attendanceErrors "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type AttendanceHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockAttendanceService
+	router  *chi.Mux
+}
+
+func TestAttendanceHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceHandlerTestSuite))
+}
+
+func (s *AttendanceHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockAttendanceService{}
+	hdl := handler.NewAttendanceHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *AttendanceHandlerTestSuite) doRequest(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func sampleRows() []*aggregate.StudentAttendance {
+	return []*aggregate.StudentAttendance{
+		{
+			StudentID:        12345,
+			StudentName:      "Jane Doe",
+			PeriodStart:      date(2026, 3, 2),
+			PeriodEnd:        date(2026, 3, 8),
+			ScheduledShifts:  4,
+			OnTimeShifts:     3,
+			LateShifts:       1,
+			TotalMinutesLate: 12,
+			FlaggedLogs:      1,
+			HoursScheduled:   8,
+			HoursWorked:      7.5,
+		},
+		{StudentID: 22222, PeriodStart: date(2026, 3, 2), PeriodEnd: date(2026, 3, 8), HoursWorked: 1},
+	}
+}
+
+func (s *AttendanceHandlerTestSuite) TestGetReport_Success() {
+	s.mockSvc.GetReportFn = func(_ context.Context, query aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error) {
+		s.Equal(date(2026, 3, 2), query.From)
+		s.Equal(date(2026, 3, 8), query.To)
+		s.Equal(aggregate.Interval_Week, query.Interval)
+		s.Equal(aggregate.SortField_OnTimeRate, query.SortBy)
+		s.True(query.Descending)
+		s.Require().NotNil(query.StudentID)
+		s.Equal(int32(12345), *query.StudentID)
+		return sampleRows(), nil
+	}
+
+	rr := s.doRequest("/api/v1/attendance/report?from=2026-03-02&to=2026-03-08&interval=week&sort=on_time_rate&order=desc&student_id=12345")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []dtos.StudentAttendanceResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 2)
+	s.Equal("2026-03-02", resp[0].PeriodStart)
+	s.Require().NotNil(resp[0].OnTimeRate)
+	s.Equal(0.75, *resp[0].OnTimeRate)
+	s.Equal(3.0, resp[0].AvgMinutesLate)
+	s.Nil(resp[1].OnTimeRate)
+}
+
+func (s *AttendanceHandlerTestSuite) TestGetReport_BadParams() {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing dates", "/api/v1/attendance/report?from=2026-03-02"},
+		{"bad from", "/api/v1/attendance/report?from=02/03/2026&to=2026-03-08"},
+		{"bad student", "/api/v1/attendance/report?from=2026-03-02&to=2026-03-08&student_id=abc"},
+		{"bad order", "/api/v1/attendance/report?from=2026-03-02&to=2026-03-08&order=up"},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rr := s.doRequest(tt.path)
+			s.Equal(http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func (s *AttendanceHandlerTestSuite) TestGetReport_ErrorMapping() {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"invalid range", attendanceErrors.ErrInvalidDateRange, http.StatusBadRequest},
+		{"range too long", attendanceErrors.ErrDateRangeTooLong, http.StatusBadRequest},
+		{"invalid interval", attendanceErrors.ErrInvalidInterval, http.StatusBadRequest},
+		{"invalid sort", attendanceErrors.ErrInvalidSortField, http.StatusBadRequest},
+		{"missing auth", attendanceErrors.ErrMissingAuthContext, http.StatusUnauthorized},
+		{"not admin", attendanceErrors.ErrNotAuthorized, http.StatusForbidden},
+		{"unexpected", context.DeadlineExceeded, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockSvc.GetReportFn = func(_ context.Context, _ aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error) {
+				return nil, tt.err
+			}
+
+			rr := s.doRequest("/api/v1/attendance/report?from=2026-03-02&to=2026-03-08")
+
+			s.Equal(tt.status, rr.Code)
+		})
+	}
+}
+
+func (s *AttendanceHandlerTestSuite) TestExportReport_CSV() {
+	s.mockSvc.GetReportFn = func(_ context.Context, _ aggregate.ReportQuery) ([]*aggregate.StudentAttendance, error) {
+		return sampleRows(), nil
+	}
+
+	rr := s.doRequest("/api/v1/attendance/report/export?from=2026-03-02&to=2026-03-08")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+	s.Contains(rr.Header().Get("Content-Disposition"), `filename="attendance_2026-03-02_2026-03-08.csv"`)
+
+	records, err := csv.NewReader(strings.NewReader(rr.Body.String())).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(records, 3)
+	s.Equal("Student ID", records[0][0])
+	s.Equal([]string{"12345", "Jane Doe", "2026-03-02", "2026-03-08", "4", "3", "1", "0", "0", "0.75", "3.00", "1", "8.00", "7.50"}, records[1])
+	s.Equal("", records[2][9])
+}
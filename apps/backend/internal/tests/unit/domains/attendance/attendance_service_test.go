@@ -0,0 +1,218 @@
+package attendance_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/aggregate"
+	attendanceErrors "github.com/HDR3604/HelpDeskApp/internal/domain/attendance/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/attendance/service"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type AttendanceServiceTestSuite struct {
+	suite.Suite
+	scheduleRepo *mocks.MockScheduleRepository
+	locationRepo *mocks.MockLocationRepository
+	timeLogRepo  *mocks.MockTimeLogRepository
+	payRuleRepo  *mocks.MockPayRuleRepository
+	timeOffRepo  *mocks.MockTimeOffRequestRepository
+	studentRepo  *mocks.MockStudentRepository
+	service      *service.AttendanceService
+	adminCtx     context.Context
+	logs         []*timelogAggregate.TimeLog
+	timeOff      []*timeoffAggregate.TimeOffRequest
+}
+
+func TestAttendanceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceServiceTestSuite))
+}
+
+// Shift times are local to the default timezone, UTC-4: an 08:00 shift starts
+// at 12:00 UTC.
+func (s *AttendanceServiceTestSuite) SetupTest() {
+	s.scheduleRepo = &mocks.MockScheduleRepository{}
+	s.locationRepo = &mocks.MockLocationRepository{}
+	s.timeLogRepo = &mocks.MockTimeLogRepository{}
+	s.payRuleRepo = &mocks.MockPayRuleRepository{}
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{}
+	s.studentRepo = &mocks.MockStudentRepository{}
+	s.logs = nil
+	s.timeOff = nil
+
+	s.service = service.NewAttendanceService(
+		zap.NewNop(), &mocks.StubTxManager{},
+		s.scheduleRepo, s.locationRepo, s.timeLogRepo, s.payRuleRepo, s.timeOffRepo, s.studentRepo,
+	)
+	// Wednesday 2026-03-11, 13:00 local.
+	s.service.WithNowFn(func() time.Time { return time.Date(2026, 3, 11, 17, 0, 0, 0, time.UTC) })
+
+	s.adminCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+
+	// The archived schedule had 12345 on Mondays until it was archived on
+	// Sunday 2026-03-08; the active one moves them to Tuesdays and Wednesdays.
+	archivedAt := time.Date(2026, 3, 8, 15, 0, 0, 0, time.UTC)
+	s.scheduleRepo.ListArchivedFn = func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.Schedule, error) {
+		return []*scheduleAggregate.Schedule{{
+			ScheduleID:    uuid.New(),
+			Assignments:   json.RawMessage(`[{"assistant_id":"12345","shift_id":"s-mon","day_of_week":0,"start":"08:00:00","end":"10:00:00"}]`),
+			EffectiveFrom: date(2026, 1, 1),
+			ArchivedAt:    &archivedAt,
+		}}, nil
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return &scheduleAggregate.Schedule{
+			ScheduleID: uuid.New(),
+			IsActive:   true,
+			Assignments: json.RawMessage(`[
+				{"assistant_id":"12345","shift_id":"s-tue","day_of_week":1,"start":"08:00:00","end":"10:00:00"},
+				{"assistant_id":"12345","shift_id":"s-wed","day_of_week":2,"start":"08:00:00","end":"10:00:00"},
+				{"assistant_id":"12345","shift_id":"s-wed-pm","day_of_week":2,"start":"14:00:00","end":"16:00:00"}
+			]`),
+			EffectiveFrom: date(2026, 3, 9),
+		}, nil
+	}
+	s.locationRepo.ListByShiftTemplateIDsFn = func(_ context.Context, _ *sql.Tx, _ []uuid.UUID) (map[uuid.UUID]*scheduleAggregate.Location, error) {
+		return map[uuid.UUID]*scheduleAggregate.Location{}, nil
+	}
+	s.payRuleRepo.ListActiveFn = func(_ context.Context, _ *sql.Tx) ([]*timelogAggregate.PayRule, error) {
+		return []*timelogAggregate.PayRule{{ID: uuid.New(), Name: "Grace", LateGraceMinutes: 5, IsActive: true}}, nil
+	}
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		s.Equal([]timeoffAggregate.Status{timeoffAggregate.Status_Approved}, filter.Statuses)
+		return s.timeOff, nil
+	}
+	s.timeLogRepo.ListAllFn = func(_ context.Context, _ *sql.Tx, _ timelogRepo.TimeLogFilter) ([]*timelogAggregate.TimeLog, error) {
+		return s.logs, nil
+	}
+	s.studentRepo.ListByIDsFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+		return []*studentAggregate.Student{{StudentID: 12345, FirstName: "Jane", LastName: "Doe"}}, nil
+	}
+}
+
+func utc(y int, m time.Month, d, h, min int) time.Time {
+	return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_ArchivedAndActiveSchedules() {
+	s.logs = []*timelogAggregate.TimeLog{
+		logOf(12345, utc(2026, 3, 2, 12, 10), ptr(utc(2026, 3, 2, 14, 0))),  // Mon, 10 minutes late
+		logOf(12345, utc(2026, 3, 10, 12, 0), ptr(utc(2026, 3, 10, 14, 0))), // Tue, on time
+		// Wednesday morning missed; the afternoon shift has not ended yet.
+	}
+
+	rows, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 11)})
+
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	r := rows[0]
+	s.Equal("Jane Doe", r.StudentName)
+	s.Equal(3, r.ScheduledShifts) // Mon 2nd (archived), Tue 10th, Wed 11th morning
+	s.Equal(1, r.OnTimeShifts)
+	s.Equal(1, r.LateShifts)
+	s.Equal(1, r.MissedShifts)
+	s.Equal(6.0, r.HoursScheduled)
+	s.InDelta(3.83, r.HoursWorked, 0.01)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_LateWithinGraceIsOnTime() {
+	s.logs = []*timelogAggregate.TimeLog{
+		logOf(12345, utc(2026, 3, 10, 12, 4), ptr(utc(2026, 3, 10, 14, 0))),
+	}
+
+	rows, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{From: date(2026, 3, 10), To: date(2026, 3, 10)})
+
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	s.Equal(1, rows[0].OnTimeShifts)
+	s.Equal(4.0, rows[0].AverageMinutesLate())
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_TimeOffIsNotMissed() {
+	s.timeOff = []*timeoffAggregate.TimeOffRequest{{
+		ID:        uuid.New(),
+		StudentID: 12345,
+		StartDate: date(2026, 3, 10),
+		EndDate:   date(2026, 3, 11),
+		Status:    timeoffAggregate.Status_Approved,
+	}}
+
+	rows, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{From: date(2026, 3, 9), To: date(2026, 3, 11)})
+
+	s.Require().NoError(err)
+	s.Empty(rows)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_NoActiveSchedule() {
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return nil, scheduleErrors.ErrNotFound
+	}
+
+	rows, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{From: date(2026, 3, 9), To: date(2026, 3, 11)})
+
+	s.Require().NoError(err)
+	s.Empty(rows)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_Sorted() {
+	s.studentRepo.ListByIDsFn = func(_ context.Context, _ *sql.Tx, _ []int32) ([]*studentAggregate.Student, error) {
+		return nil, nil
+	}
+	// 22222 only has a flagged log; 12345 has three flagged logs.
+	s.logs = []*timelogAggregate.TimeLog{
+		{ID: uuid.New(), StudentID: 22222, EntryAt: utc(2026, 3, 10, 12, 0), IsFlagged: true},
+	}
+	for range 3 {
+		s.logs = append(s.logs, &timelogAggregate.TimeLog{ID: uuid.New(), StudentID: 12345, EntryAt: utc(2026, 3, 10, 12, 0), IsFlagged: true})
+	}
+
+	rows, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{
+		From:       date(2026, 3, 10),
+		To:         date(2026, 3, 10),
+		SortBy:     aggregate.SortField_FlaggedLogs,
+		Descending: true,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(rows, 2)
+	s.Equal(int32(12345), rows[0].StudentID)
+	s.Equal(3, rows[0].FlaggedLogs)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_InvalidQuery() {
+	_, err := s.service.GetReport(s.adminCtx, aggregate.ReportQuery{From: date(2026, 3, 10), To: date(2026, 3, 1)})
+
+	s.ErrorIs(err, attendanceErrors.ErrInvalidDateRange)
+}
+
+func (s *AttendanceServiceTestSuite) TestGetReport_RequiresAdmin() {
+	studentID := "12345"
+	ctx := database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+
+	_, err := s.service.GetReport(ctx, aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 2)})
+	s.ErrorIs(err, attendanceErrors.ErrNotAuthorized)
+
+	_, err = s.service.GetReport(context.Background(), aggregate.ReportQuery{From: date(2026, 3, 1), To: date(2026, 3, 2)})
+	s.ErrorIs(err, attendanceErrors.ErrMissingAuthContext)
+}
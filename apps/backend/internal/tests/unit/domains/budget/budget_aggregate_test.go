@@ -0,0 +1,170 @@
+package budget_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/stretchr/testify/suite"
+)
+
+type BudgetAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestBudgetAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetAggregateTestSuite))
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func termBudget(amount float64) *aggregate.Budget {
+	end := date(2026, 4, 26)
+	b, err := aggregate.NewBudget(aggregate.BudgetParams{
+		Name:        "Semester II",
+		PeriodType:  aggregate.PeriodType_Term,
+		PeriodStart: date(2026, 1, 19),
+		PeriodEnd:   &end,
+		Amount:      amount,
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// --- NewBudget ---
+
+func (s *BudgetAggregateTestSuite) TestNewBudget_MonthCoversCalendarMonth() {
+	ignored := date(2026, 12, 31)
+	b, err := aggregate.NewBudget(aggregate.BudgetParams{
+		Name:        "  February  ",
+		PeriodType:  aggregate.PeriodType_Month,
+		PeriodStart: time.Date(2028, 2, 17, 13, 30, 0, 0, time.UTC),
+		PeriodEnd:   &ignored,
+		Amount:      1234.567,
+	}, nil)
+	s.Require().NoError(err)
+
+	s.Equal("February", b.Name)
+	s.Equal(date(2028, 2, 1), b.PeriodStart)
+	s.Equal(date(2028, 2, 29), b.PeriodEnd)
+	s.Equal(1234.57, b.Amount)
+}
+
+func (s *BudgetAggregateTestSuite) TestNewBudget_Validation() {
+	end := date(2026, 4, 26)
+	before := date(2026, 1, 1)
+	tooLong := date(2027, 3, 1)
+	valid := aggregate.BudgetParams{Name: "Term", PeriodType: aggregate.PeriodType_Term, PeriodStart: date(2026, 1, 19), PeriodEnd: &end, Amount: 1000}
+
+	cases := map[string]struct {
+		mutate func(p *aggregate.BudgetParams)
+		want   error
+	}{
+		"blank name":       {func(p *aggregate.BudgetParams) { p.Name = "  " }, budgetErrors.ErrInvalidBudgetName},
+		"zero amount":      {func(p *aggregate.BudgetParams) { p.Amount = 0 }, budgetErrors.ErrInvalidBudgetAmount},
+		"sub-cent amount":  {func(p *aggregate.BudgetParams) { p.Amount = 0.001 }, budgetErrors.ErrInvalidBudgetAmount},
+		"unknown type":     {func(p *aggregate.BudgetParams) { p.PeriodType = "year" }, budgetErrors.ErrInvalidPeriodType},
+		"term without end": {func(p *aggregate.BudgetParams) { p.PeriodEnd = nil }, budgetErrors.ErrInvalidBudgetPeriod},
+		"end before start": {func(p *aggregate.BudgetParams) { p.PeriodEnd = &before }, budgetErrors.ErrInvalidBudgetPeriod},
+		"term too long":    {func(p *aggregate.BudgetParams) { p.PeriodEnd = &tooLong }, budgetErrors.ErrInvalidBudgetPeriod},
+	}
+	for name, tc := range cases {
+		params := valid
+		tc.mutate(&params)
+		_, err := aggregate.NewBudget(params, nil)
+		s.ErrorIs(err, tc.want, name)
+	}
+}
+
+// --- Projection ---
+
+func (s *BudgetAggregateTestSuite) TestWeeklyHours_SumsPerStudentAndSkipsBadEntries() {
+	hours := aggregate.WeeklyHours([]scheduleAggregate.Assignment{
+		{AssistantID: "816000001", DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000001", DayOfWeek: 3, Start: "13:00", End: "14:30"},
+		{AssistantID: "816000002", DayOfWeek: 2, Start: "09:00:00", End: "11:00:00"},
+		{AssistantID: "a1", DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000003", DayOfWeek: 1, Start: "12:00:00", End: "08:00:00"},
+		{AssistantID: "816000003", DayOfWeek: 1, Start: "noon", End: "13:00:00"},
+	})
+
+	s.Equal(map[int32]float64{816000001: 5.5, 816000002: 2}, hours)
+}
+
+func (s *BudgetAggregateTestSuite) TestProjectionWindow() {
+	from := date(2026, 1, 5)
+	to := date(2026, 5, 31)
+	budget := termBudget(10000)
+
+	// Starts today once the schedule is under way.
+	w := aggregate.ProjectionWindow(from, &to, nil, date(2026, 2, 2))
+	s.Require().NotNil(w)
+	s.Equal(date(2026, 2, 2), w.From)
+	s.Equal(to, w.To)
+
+	// Clipped to the budget period.
+	w = aggregate.ProjectionWindow(from, &to, budget, date(2026, 1, 1))
+	s.Require().NotNil(w)
+	s.Equal(budget.PeriodStart, w.From)
+	s.Equal(budget.PeriodEnd, w.To)
+
+	// Open-ended schedules run to the end of the budget, or have no window.
+	w = aggregate.ProjectionWindow(from, nil, budget, date(2026, 1, 1))
+	s.Require().NotNil(w)
+	s.Equal(budget.PeriodEnd, w.To)
+	s.Nil(aggregate.ProjectionWindow(from, nil, nil, date(2026, 1, 1)))
+}
+
+func (s *BudgetAggregateTestSuite) TestProjectCost_ComparesToBudget() {
+	window := &aggregate.DateRange{From: date(2026, 3, 2), To: date(2026, 3, 29)}
+	p := aggregate.ProjectCost(map[int32]float64{816000002: 2, 816000001: 5.5}, 20, window)
+
+	s.Equal(aggregate.RateBasis_Flat, p.RateBasis)
+	s.Equal(4.0, p.Weeks)
+	s.Equal(7.5, p.WeeklyHours)
+	s.Equal(150.0, p.WeeklyCost)
+	s.Equal(600.0, p.ProjectedCost)
+	s.Require().Len(p.Students, 2)
+	s.Equal(int32(816000001), p.Students[0].StudentID)
+	s.Equal(440.0, p.Students[0].ProjectedCost)
+
+	p.CompareToBudget(termBudget(1000), 500)
+	s.Require().NotNil(p.Budget)
+	s.Equal(1100.0, p.Budget.ProjectedTotal)
+	s.Equal(-100.0, p.Budget.Remaining)
+	s.True(p.Budget.OverBudget)
+	s.Equal(110.0, p.Budget.UtilisationPercent)
+}
+
+func (s *BudgetAggregateTestSuite) TestProjectCost_WithoutWindow() {
+	p := aggregate.ProjectCost(map[int32]float64{816000001: 4}, 20, nil)
+
+	s.Equal(80.0, p.WeeklyCost)
+	s.Zero(p.Weeks)
+	s.Zero(p.ProjectedCost)
+}
+
+// --- WeeklyCeiling ---
+
+func (s *BudgetAggregateTestSuite) TestWeeklyCeiling_SpreadsRemainingBudget() {
+	b := termBudget(1000)
+	ceiling, err := b.WeeklyCeiling(300, aggregate.DateRange{From: date(2026, 3, 2), To: date(2026, 3, 22)})
+	s.Require().NoError(err)
+	s.Equal(233.33, ceiling)
+}
+
+func (s *BudgetAggregateTestSuite) TestWeeklyCeiling_Errors() {
+	b := termBudget(1000)
+
+	_, err := b.WeeklyCeiling(1000, aggregate.DateRange{From: date(2026, 3, 2), To: date(2026, 3, 8)})
+	s.ErrorIs(err, budgetErrors.ErrBudgetExhausted)
+
+	_, err = b.WeeklyCeiling(0, aggregate.DateRange{From: date(2026, 5, 1), To: date(2026, 4, 26)})
+	s.ErrorIs(err, budgetErrors.ErrScheduleOutsideBudget)
+}
@@ -0,0 +1,120 @@
+package budget_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type BudgetHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockBudgetService
+	router  *chi.Mux
+}
+
+func TestBudgetHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetHandlerTestSuite))
+}
+
+func (s *BudgetHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockBudgetService{}
+	hdl := handler.NewBudgetHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *BudgetHandlerTestSuite) doRequest(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *BudgetHandlerTestSuite) TestCreateBudget_Success() {
+	s.mockSvc.CreateBudgetFn = func(_ context.Context, params aggregate.BudgetParams) (*aggregate.Budget, error) {
+		s.Equal(aggregate.PeriodType_Term, params.PeriodType)
+		s.Equal(date(2026, 1, 19), params.PeriodStart)
+		s.Require().NotNil(params.PeriodEnd)
+		return aggregate.NewBudget(params, nil)
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/budgets",
+		`{"name":"Semester II","period_type":"term","period_start":"2026-01-19","period_end":"2026-04-26","amount":5000}`)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var resp dtos.BudgetResponse
+	s.Require().NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal("2026-04-26", resp.PeriodEnd)
+	s.Equal(5000.0, resp.Amount)
+}
+
+func (s *BudgetHandlerTestSuite) TestCreateBudget_InvalidDate() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/budgets", `{"name":"x","period_type":"month","period_start":"03/2026","amount":1}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *BudgetHandlerTestSuite) TestCreateBudget_ValidationError() {
+	s.mockSvc.CreateBudgetFn = func(_ context.Context, _ aggregate.BudgetParams) (*aggregate.Budget, error) {
+		return nil, budgetErrors.ErrInvalidPeriodType
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/budgets", `{"name":"x","period_type":"year","period_start":"2026-03-01","amount":1}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *BudgetHandlerTestSuite) TestDeleteBudget_NotFound() {
+	s.mockSvc.DeleteBudgetFn = func(_ context.Context, _ uuid.UUID) error {
+		return budgetErrors.ErrBudgetNotFound
+	}
+
+	rr := s.doRequest(http.MethodDelete, "/api/v1/budgets/"+uuid.NewString(), "")
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *BudgetHandlerTestSuite) TestProjectScheduleCost_Success() {
+	scheduleID := uuid.New()
+	budget := termBudget(1000)
+	s.mockSvc.ProjectScheduleCostFn = func(_ context.Context, id uuid.UUID, budgetID *uuid.UUID) (*aggregate.CostProjection, error) {
+		s.Equal(scheduleID, id)
+		s.Require().NotNil(budgetID)
+		s.Equal(budget.ID, *budgetID)
+		p := aggregate.ProjectCost(map[int32]float64{816000001: 4}, 20, &aggregate.DateRange{From: date(2026, 3, 2), To: date(2026, 3, 29)})
+		p.ScheduleID = id
+		p.CompareToBudget(budget, 0)
+		return p, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/schedules/"+scheduleID.String()+"/cost-projection?budget_id="+budget.ID.String(), "")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.CostProjectionResponse
+	s.Require().NoError(json.NewDecoder(rr.Body).Decode(&resp))
+	s.Equal(320.0, resp.ProjectedCost)
+	s.Equal("flat", resp.RateBasis)
+	s.Require().NotNil(resp.ProjectionFrom)
+	s.Equal("2026-03-02", *resp.ProjectionFrom)
+	s.Require().NotNil(resp.Budget)
+	s.Equal(680.0, resp.Budget.Remaining)
+	s.Len(resp.Students, 1)
+}
+
+func (s *BudgetHandlerTestSuite) TestProjectScheduleCost_InvalidBudgetID() {
+	rr := s.doRequest(http.MethodGet, "/api/v1/schedules/"+uuid.NewString()+"/cost-projection?budget_id=nope", "")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,180 @@
+package budget_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/aggregate"
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/budget/service"
+	payrollService "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type BudgetServiceTestSuite struct {
+	suite.Suite
+	budgetRepo   *mocks.MockBudgetRepository
+	scheduleRepo *mocks.MockScheduleRepository
+	paymentRepo  *mocks.MockPaymentRepository
+	service      *service.BudgetService
+	budget       *aggregate.Budget
+	schedule     *scheduleAggregate.Schedule
+	actual       float64
+}
+
+func TestBudgetServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetServiceTestSuite))
+}
+
+func adminContext() context.Context {
+	return database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+func studentContext() context.Context {
+	studentID := "816000001"
+	return database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+}
+
+func (s *BudgetServiceTestSuite) SetupTest() {
+	s.budget = termBudget(5000)
+	s.actual = 1000
+
+	assignments, err := json.Marshal([]scheduleAggregate.Assignment{
+		{AssistantID: "816000001", ShiftID: "s1", DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000002", ShiftID: "s2", DayOfWeek: 2, Start: "10:00:00", End: "16:00:00"},
+	})
+	s.Require().NoError(err)
+	effectiveTo := date(2026, 5, 31)
+	s.schedule = &scheduleAggregate.Schedule{
+		ScheduleID:    uuid.New(),
+		Title:         "Semester II",
+		EffectiveFrom: date(2026, 1, 19),
+		EffectiveTo:   &effectiveTo,
+		Assignments:   assignments,
+	}
+
+	s.budgetRepo = &mocks.MockBudgetRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.Budget, error) {
+			if id != s.budget.ID {
+				return nil, budgetErrors.ErrBudgetNotFound
+			}
+			return s.budget, nil
+		},
+	}
+	s.scheduleRepo = &mocks.MockScheduleRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*scheduleAggregate.Schedule, error) {
+			return s.schedule, nil
+		},
+	}
+	s.paymentRepo = &mocks.MockPaymentRepository{
+		TotalGrossAmountFn: func(_ context.Context, _ *sql.Tx, from, to time.Time) (float64, error) {
+			s.Equal(s.budget.PeriodStart, from)
+			s.Equal(s.budget.PeriodEnd, to)
+			return s.actual, nil
+		},
+	}
+
+	s.service = service.NewBudgetService(zap.NewNop(), &mocks.StubTxManager{}, s.budgetRepo, s.scheduleRepo, s.paymentRepo)
+	// Four weeks before the end of the budget period.
+	s.service.WithNowFn(func() time.Time { return time.Date(2026, 3, 30, 15, 0, 0, 0, time.UTC) })
+}
+
+func (s *BudgetServiceTestSuite) TestCreateBudget_SetsCreator() {
+	s.budgetRepo.CreateFn = func(_ context.Context, _ *sql.Tx, b *aggregate.Budget) (*aggregate.Budget, error) {
+		return b, nil
+	}
+
+	budget, err := s.service.CreateBudget(adminContext(), aggregate.BudgetParams{
+		Name:        "March",
+		PeriodType:  aggregate.PeriodType_Month,
+		PeriodStart: date(2026, 3, 10),
+		Amount:      2000,
+	})
+	s.Require().NoError(err)
+	s.NotNil(budget.CreatedBy)
+	s.Equal(date(2026, 3, 31), budget.PeriodEnd)
+}
+
+func (s *BudgetServiceTestSuite) TestCreateBudget_RequiresAdmin() {
+	_, err := s.service.CreateBudget(studentContext(), aggregate.BudgetParams{})
+	s.ErrorIs(err, budgetErrors.ErrNotAuthorized)
+
+	_, err = s.service.CreateBudget(context.Background(), aggregate.BudgetParams{})
+	s.ErrorIs(err, budgetErrors.ErrMissingAuthContext)
+}
+
+func (s *BudgetServiceTestSuite) TestUpdateBudget_Validates() {
+	_, err := s.service.UpdateBudget(adminContext(), s.budget.ID, aggregate.BudgetParams{Name: "x", PeriodType: aggregate.PeriodType_Month, Amount: -1})
+	s.ErrorIs(err, budgetErrors.ErrInvalidBudgetAmount)
+}
+
+func (s *BudgetServiceTestSuite) TestProjectScheduleCost_WithBudget() {
+	projection, err := s.service.ProjectScheduleCost(adminContext(), s.schedule.ScheduleID, &s.budget.ID)
+	s.Require().NoError(err)
+
+	s.Equal(s.schedule.Title, projection.ScheduleTitle)
+	s.Equal(payrollService.HourlyRate, projection.HourlyRate)
+	s.Equal(10.0, projection.WeeklyHours)
+	s.Equal(200.0, projection.WeeklyCost)
+	s.Require().NotNil(projection.Window)
+	s.Equal(date(2026, 3, 30), projection.Window.From)
+	s.Equal(date(2026, 4, 26), projection.Window.To)
+	s.Equal(800.0, projection.ProjectedCost)
+
+	s.Require().NotNil(projection.Budget)
+	s.Equal(1800.0, projection.Budget.ProjectedTotal)
+	s.Equal(3200.0, projection.Budget.Remaining)
+	s.False(projection.Budget.OverBudget)
+	s.Equal(36.0, projection.Budget.UtilisationPercent)
+}
+
+func (s *BudgetServiceTestSuite) TestProjectScheduleCost_WithoutBudget() {
+	s.paymentRepo.TotalGrossAmountFn = nil
+
+	projection, err := s.service.ProjectScheduleCost(adminContext(), s.schedule.ScheduleID, nil)
+	s.Require().NoError(err)
+
+	s.Equal(date(2026, 5, 31), projection.Window.To)
+	s.Nil(projection.Budget)
+}
+
+func (s *BudgetServiceTestSuite) TestProjectScheduleCost_BudgetNotFound() {
+	missing := uuid.New()
+	_, err := s.service.ProjectScheduleCost(adminContext(), s.schedule.ScheduleID, &missing)
+	s.ErrorIs(err, budgetErrors.ErrBudgetNotFound)
+}
+
+func (s *BudgetServiceTestSuite) TestCostCeiling_SpreadsRemainingOverWindow() {
+	rate, ceiling, err := s.service.CostCeiling(adminContext(), s.budget.ID, date(2026, 4, 6), nil)
+	s.Require().NoError(err)
+
+	s.Equal(payrollService.HourlyRate, rate)
+	// 4000 left over the three weeks from 6 April to 26 April.
+	s.Equal(1333.33, ceiling)
+}
+
+func (s *BudgetServiceTestSuite) TestCostCeiling_ScheduleAfterBudget() {
+	_, _, err := s.service.CostCeiling(adminContext(), s.budget.ID, date(2026, 6, 1), nil)
+	s.ErrorIs(err, budgetErrors.ErrScheduleOutsideBudget)
+}
+
+func (s *BudgetServiceTestSuite) TestCostCeiling_BudgetExhausted() {
+	s.actual = 5000
+	_, _, err := s.service.CostCeiling(adminContext(), s.budget.ID, date(2026, 4, 6), nil)
+	s.ErrorIs(err, budgetErrors.ErrBudgetExhausted)
+}
@@ -0,0 +1,251 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/bankfile"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type DisbursementServiceTestSuite struct {
+	suite.Suite
+	paymentRepo *mocks.MockPaymentRepository
+	fileRepo    *mocks.MockDisbursementFileRepository
+	studentRepo *mocks.MockStudentRepository
+	bankingRepo *mocks.MockBankingDetailsRepository
+	service     *service.DisbursementService
+	payments    []*aggregate.Payment
+	banking     map[int32]*studentAggregate.BankingDetails
+	created     []*aggregate.DisbursementFile
+	disbursed   map[uuid.UUID]bool
+	filter      repository.PaymentFilter
+	periodStart time.Time
+	periodEnd   time.Time
+}
+
+func TestDisbursementServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DisbursementServiceTestSuite))
+}
+
+func (s *DisbursementServiceTestSuite) payment(studentID int32, amount float64) *aggregate.Payment {
+	return &aggregate.Payment{
+		PaymentID:   uuid.New(),
+		StudentID:   studentID,
+		PeriodStart: s.periodStart,
+		PeriodEnd:   s.periodEnd,
+		HoursWorked: amount / service.HourlyRate,
+		GrossAmount: amount,
+		NetAmount:   amount,
+	}
+}
+
+func (s *DisbursementServiceTestSuite) SetupTest() {
+	s.periodStart = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.periodEnd = time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	s.created = nil
+	s.disbursed = map[uuid.UUID]bool{}
+	s.payments = []*aggregate.Payment{
+		s.payment(816000001, 250),
+		s.payment(816000002, 125.5),
+		s.payment(816000003, 100),
+		s.payment(816000004, 0),
+		s.payment(816000005, 80),
+	}
+	s.banking = map[int32]*studentAggregate.BankingDetails{
+		816000001: {StudentID: 816000001, BankName: "Republic Bank", BranchName: "St. Augustine", AccountType: studentAggregate.BankAccountType_Savings, AccountNumber: "111111111"},
+		816000002: {StudentID: 816000002, BankName: "republic bank", BranchName: "Port of Spain", AccountType: studentAggregate.BankAccountType_Chequeing, AccountNumber: "222222222"},
+		816000003: {StudentID: 816000003, BankName: "First Citizens", BranchName: "Chaguanas", AccountType: studentAggregate.BankAccountType_Savings, AccountNumber: "333333333"},
+	}
+
+	s.paymentRepo = &mocks.MockPaymentRepository{
+		ListByPeriodFn: func(_ context.Context, _ *sql.Tx, filter repository.PaymentFilter) ([]*aggregate.Payment, error) {
+			s.filter = filter
+			var out []*aggregate.Payment
+			for _, p := range s.payments {
+				if filter.UndisbursedOnly && s.disbursed[p.PaymentID] {
+					continue
+				}
+				out = append(out, p)
+			}
+			return out, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{
+		ListByIDsFn: func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+			students := make([]*studentAggregate.Student, 0, len(ids))
+			for _, id := range ids {
+				students = append(students, &studentAggregate.Student{StudentID: id, FirstName: "Student", LastName: "Worker"})
+			}
+			return students, nil
+		},
+	}
+	s.bankingRepo = &mocks.MockBankingDetailsRepository{
+		ListByStudentIDsFn: func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.BankingDetails, error) {
+			var out []*studentAggregate.BankingDetails
+			for _, id := range ids {
+				if bd, ok := s.banking[id]; ok {
+					out = append(out, bd)
+				}
+			}
+			return out, nil
+		},
+	}
+	s.fileRepo = &mocks.MockDisbursementFileRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, file *aggregate.DisbursementFile) (*aggregate.DisbursementFile, error) {
+			s.created = append(s.created, file)
+			for _, id := range file.PaymentIDs {
+				s.disbursed[id] = true
+			}
+			return file, nil
+		},
+	}
+
+	s.service = service.NewDisbursementService(
+		zap.NewNop(), &mocks.StubTxManager{},
+		s.paymentRepo, s.fileRepo, s.studentRepo, s.bankingRepo,
+		bankfile.DefaultRegistry(),
+	)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_GroupsByBankWithControlTotals() {
+	run, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	s.True(s.filter.UnprocessedOnly)
+	s.True(s.filter.UndisbursedOnly)
+	s.False(s.filter.ProcessedOnly)
+
+	s.Require().Len(run.Files, 2)
+	fcb, republic := run.Files[0], run.Files[1]
+
+	s.Equal("First Citizens", fcb.BankName)
+	s.Equal("fixed_width", fcb.Format)
+	s.Equal(int32(1), fcb.RecordCount)
+	s.Equal(100.0, fcb.ControlTotal)
+	s.Equal("disbursement_first_citizens_2026-03-01_2026-03-15.txt", fcb.Filename)
+
+	// Differently cased spellings of the same bank share one file.
+	s.Equal("Republic Bank", republic.BankName)
+	s.Equal("republic_csv", republic.Format)
+	s.Equal(int32(2), republic.RecordCount)
+	s.Equal(375.5, republic.ControlTotal)
+	s.Contains(string(republic.Content), "T,2,375.50")
+	s.Equal([]uuid.UUID{s.payments[2].PaymentID}, fcb.PaymentIDs)
+	s.ElementsMatch([]uuid.UUID{s.payments[0].PaymentID, s.payments[1].PaymentID}, republic.PaymentIDs)
+
+	for _, f := range run.Files {
+		s.Len(f.Checksum, 64)
+		s.True(f.VerifyChecksum())
+		s.NotNil(f.GeneratedBy)
+	}
+	s.Len(s.created, 2)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_ReportsSkippedPayments() {
+	run, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	reasons := map[int32]string{}
+	for _, sp := range run.Skipped {
+		reasons[sp.Payment.StudentID] = sp.Reason
+	}
+	s.Equal(map[int32]string{
+		816000004: service.SkipReason_ZeroAmount,
+		816000005: service.SkipReason_NoBankingDetails,
+	}, reasons)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_SecondRunLeavesOutDisbursedPayments() {
+	_, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+	s.Require().Len(s.created, 2)
+
+	run, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	s.Empty(run.Files)
+	s.Len(s.created, 2, "no new files on the second run")
+
+	// Payments skipped the first time are still reported, since they were
+	// never paid out.
+	reasons := map[int32]string{}
+	for _, sp := range run.Skipped {
+		reasons[sp.Payment.StudentID] = sp.Reason
+	}
+	s.Equal(map[int32]string{
+		816000004: service.SkipReason_ZeroAmount,
+		816000005: service.SkipReason_NoBankingDetails,
+	}, reasons)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_SecondRunPaysNewlyPayablePayments() {
+	_, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	s.banking[816000005] = &studentAggregate.BankingDetails{StudentID: 816000005, BankName: "Scotiabank", BranchName: "Arima", AccountType: studentAggregate.BankAccountType_Savings, AccountNumber: "555555555"}
+
+	run, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	s.Require().Len(run.Files, 1)
+	s.Equal("Scotiabank", run.Files[0].BankName)
+	s.Equal([]uuid.UUID{s.payments[4].PaymentID}, run.Files[0].PaymentIDs)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_NothingToPay() {
+	s.payments = []*aggregate.Payment{s.payment(816000004, 0)}
+
+	run, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodStart, s.periodEnd)
+	s.Require().NoError(err)
+
+	s.Empty(run.Files)
+	s.Len(run.Skipped, 1)
+	s.Empty(s.created)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_InvalidPeriod() {
+	_, err := s.service.GenerateDisbursementFiles(adminContext(), s.periodEnd, s.periodStart)
+	s.ErrorIs(err, payrollErrors.ErrInvalidPeriod)
+}
+
+func (s *DisbursementServiceTestSuite) TestGenerate_MissingAuthContext() {
+	_, err := s.service.GenerateDisbursementFiles(context.Background(), s.periodStart, s.periodEnd)
+	s.ErrorIs(err, payrollErrors.ErrMissingAuthContext)
+}
+
+func (s *DisbursementServiceTestSuite) TestGetDisbursementFile_VerifiesChecksum() {
+	content := []byte("H,DCIT HELP DESK\r\n")
+	file := aggregate.NewDisbursementFile("Republic Bank", "republic_csv", "csv", s.periodStart, s.periodEnd, 1, 1000, content, nil, nil)
+	s.fileRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+		s.Equal(file.ID, id)
+		return file, nil
+	}
+
+	got, err := s.service.GetDisbursementFile(adminContext(), file.ID)
+	s.Require().NoError(err)
+	s.Equal(content, got.Content)
+
+	file.Content = []byte(strings.Replace(string(content), "DCIT", "XXXX", 1))
+	_, err = s.service.GetDisbursementFile(adminContext(), file.ID)
+	s.ErrorIs(err, payrollErrors.ErrChecksumMismatch)
+}
+
+func (s *DisbursementServiceTestSuite) TestListBankFormats() {
+	formats := s.service.ListBankFormats()
+
+	s.Equal("generic_csv", formats.Fallback)
+	s.Contains(formats.Formats, "fixed_width")
+	s.Equal("republic_csv", formats.Banks["republic bank"])
+}
@@ -0,0 +1,238 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type PayCalendarServiceTestSuite struct {
+	suite.Suite
+	calendarRepo *mocks.MockPayCalendarRepository
+	periodRepo   *mocks.MockPayPeriodRepository
+	paymentRepo  *mocks.MockPaymentRepository
+	generator    *mocks.MockPeriodPaymentGenerator
+	emailSender  *mocks.MockEmailSender
+	service      *service.PayCalendarService
+	calendar     *aggregate.PayCalendar
+	periods      []*aggregate.PayPeriod
+	generated    []aggregate.PeriodRange
+	notified     []uuid.UUID
+	sent         []emailDtos.SendEmailRequest
+}
+
+func TestPayCalendarServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PayCalendarServiceTestSuite))
+}
+
+func (s *PayCalendarServiceTestSuite) SetupTest() {
+	s.calendar = payCalendar(aggregate.PayFrequency_Weekly, date(2026, 1, 5))
+	s.periods = nil
+	s.generated = nil
+	s.notified = nil
+	s.sent = nil
+
+	s.calendarRepo = &mocks.MockPayCalendarRepository{
+		GetActiveFn: func(_ context.Context, _ *sql.Tx) (*aggregate.PayCalendar, error) {
+			if s.calendar == nil {
+				return nil, payrollErrors.ErrPayCalendarNotFound
+			}
+			return s.calendar, nil
+		},
+	}
+	s.periodRepo = &mocks.MockPayPeriodRepository{
+		GetLatestFn: func(_ context.Context, _ *sql.Tx) (*aggregate.PayPeriod, error) {
+			if len(s.periods) == 0 {
+				return nil, payrollErrors.ErrPayPeriodNotFound
+			}
+			return s.periods[len(s.periods)-1], nil
+		},
+		CreateFn: func(_ context.Context, _ *sql.Tx, period *aggregate.PayPeriod) (*aggregate.PayPeriod, error) {
+			s.periods = append(s.periods, period)
+			return period, nil
+		},
+		ListUnnotifiedFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.PayPeriod, error) {
+			var unnotified []*aggregate.PayPeriod
+			for _, p := range s.periods {
+				if p.NotifiedAt == nil {
+					unnotified = append(unnotified, p)
+				}
+			}
+			return unnotified, nil
+		},
+		MarkNotifiedFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID, at time.Time) error {
+			for _, p := range s.periods {
+				if p.ID == id {
+					p.NotifiedAt = &at
+				}
+			}
+			s.notified = append(s.notified, id)
+			return nil
+		},
+	}
+	s.paymentRepo = &mocks.MockPaymentRepository{
+		ListOverlappingPeriodsFn: func(_ context.Context, _ *sql.Tx, _, _ time.Time) ([]aggregate.PeriodRange, error) {
+			return []aggregate.PeriodRange{}, nil
+		},
+	}
+	s.generator = &mocks.MockPeriodPaymentGenerator{
+		GeneratePaymentsInTxFn: func(_ context.Context, _ *sql.Tx, start, end time.Time) ([]*aggregate.Payment, error) {
+			s.generated = append(s.generated, aggregate.PeriodRange{Start: start, End: end})
+			return []*aggregate.Payment{samplePayment()}, nil
+		},
+	}
+	userRepo := &mocks.MockUserRepository{
+		ListByRoleFn: func(_ context.Context, _ *sql.Tx, _ string) ([]*userAggregate.User, error) {
+			return []*userAggregate.User{
+				{Email: "admin@uwi.edu", IsActive: true},
+				{Email: "former@uwi.edu", IsActive: false},
+			}, nil
+		},
+	}
+	s.emailSender = &mocks.MockEmailSender{
+		SendFn: func(_ context.Context, req emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+			s.sent = append(s.sent, req)
+			return &emailDtos.SendEmailResponse{ID: "msg-1"}, nil
+		},
+	}
+
+	s.service = service.NewPayCalendarService(zap.NewNop(), &mocks.StubTxManager{}, s.calendarRepo, s.periodRepo, s.paymentRepo, userRepo, s.generator, s.emailSender, "noreply@helpdesk.test", "https://helpdesk.test")
+	s.service.WithNowFn(func() time.Time { return time.Date(2026, 3, 18, 6, 0, 0, 0, time.UTC) })
+}
+
+func (s *PayCalendarServiceTestSuite) TestGenerateDuePeriods_NoCalendar() {
+	s.calendar = nil
+
+	periods, err := s.service.GenerateDuePeriods(context.Background())
+
+	s.Require().NoError(err)
+	s.Empty(periods)
+	s.Empty(s.sent)
+}
+
+func (s *PayCalendarServiceTestSuite) TestGenerateDuePeriods_FirstRun() {
+	periods, err := s.service.GenerateDuePeriods(context.Background())
+
+	s.Require().NoError(err)
+	s.Require().Len(periods, 1)
+	s.Equal([]aggregate.PeriodRange{{Start: date(2026, 3, 9), End: date(2026, 3, 15)}}, s.generated)
+	s.Equal(aggregate.PayPeriodStatus_Generated, periods[0].Status)
+	s.Equal(int32(1), periods[0].PaymentCount)
+	s.Equal(250.0, periods[0].GrossTotal)
+
+	s.Require().Len(s.sent, 1)
+	s.Equal([]string{"admin@uwi.edu"}, s.sent[0].To)
+	s.Equal(templates.TemplateID_PayPeriodSummary, s.sent[0].Template.ID)
+	s.Equal("https://helpdesk.test/payroll?period_start=2026-03-09&period_end=2026-03-15", s.sent[0].Template.Variables["REVIEW_URL"])
+	s.Equal([]uuid.UUID{periods[0].ID}, s.notified)
+}
+
+func (s *PayCalendarServiceTestSuite) TestGenerateDuePeriods_CatchesUpAndIsIdempotent() {
+	last := aggregate.NewGeneratedPayPeriod(s.calendar.ID, aggregate.PeriodRange{Start: date(2026, 2, 23), End: date(2026, 3, 1)}, nil)
+	notifiedAt := date(2026, 3, 2)
+	last.NotifiedAt = &notifiedAt
+	s.periods = []*aggregate.PayPeriod{last}
+
+	periods, err := s.service.GenerateDuePeriods(context.Background())
+
+	s.Require().NoError(err)
+	s.Len(periods, 2)
+	s.Equal([]aggregate.PeriodRange{
+		{Start: date(2026, 3, 2), End: date(2026, 3, 8)},
+		{Start: date(2026, 3, 9), End: date(2026, 3, 15)},
+	}, s.generated)
+	s.Len(s.sent, 2)
+
+	periods, err = s.service.GenerateDuePeriods(context.Background())
+
+	s.Require().NoError(err)
+	s.Empty(periods)
+	s.Len(s.generated, 2)
+	s.Len(s.sent, 2)
+}
+
+func (s *PayCalendarServiceTestSuite) TestGenerateDuePeriods_BlocksOverlappingPeriod() {
+	manual := aggregate.PeriodRange{Start: date(2026, 3, 1), End: date(2026, 3, 10)}
+	s.paymentRepo.ListOverlappingPeriodsFn = func(_ context.Context, _ *sql.Tx, _, _ time.Time) ([]aggregate.PeriodRange, error) {
+		return []aggregate.PeriodRange{manual}, nil
+	}
+
+	periods, err := s.service.GenerateDuePeriods(context.Background())
+
+	s.Require().NoError(err)
+	s.Require().Len(periods, 1)
+	s.Equal(aggregate.PayPeriodStatus_Blocked, periods[0].Status)
+	s.Empty(s.generated)
+	s.Require().Len(s.sent, 1)
+	s.Equal("Pay period blocked - DCIT Help Desk", s.sent[0].Subject)
+	s.Contains(s.sent[0].Template.Variables["MESSAGE"], "2026-03-01 to 2026-03-10")
+}
+
+func (s *PayCalendarServiceTestSuite) TestGenerateDuePeriods_SendFailureLeavesPeriodUnnotified() {
+	s.emailSender.SendFn = func(_ context.Context, _ emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+		return nil, errors.New("smtp down")
+	}
+
+	periods, err := s.service.GenerateDuePeriods(context.Background())
+
+	s.Error(err)
+	s.Len(periods, 1)
+	s.Empty(s.notified)
+	s.Nil(s.periods[0].NotifiedAt)
+}
+
+func (s *PayCalendarServiceTestSuite) TestSetCalendar() {
+	var deactivated bool
+	s.calendarRepo.DeactivateAllFn = func(_ context.Context, _ *sql.Tx) error {
+		deactivated = true
+		return nil
+	}
+	s.calendarRepo.CreateFn = func(_ context.Context, _ *sql.Tx, c *aggregate.PayCalendar) (*aggregate.PayCalendar, error) {
+		return c, nil
+	}
+
+	overview, err := s.service.SetCalendar(adminContext(), aggregate.PayFrequency_SemiMonthly, date(2026, 1, 1))
+
+	s.Require().NoError(err)
+	s.True(deactivated)
+	s.NotNil(overview.Calendar.CreatedBy)
+	s.Equal([]aggregate.PeriodRange{
+		{Start: date(2026, 3, 16), End: date(2026, 3, 31)},
+		{Start: date(2026, 4, 1), End: date(2026, 4, 15)},
+		{Start: date(2026, 4, 16), End: date(2026, 4, 30)},
+	}, overview.Upcoming)
+}
+
+func (s *PayCalendarServiceTestSuite) TestSetCalendar_InvalidAnchor() {
+	_, err := s.service.SetCalendar(adminContext(), aggregate.PayFrequency_SemiMonthly, date(2026, 1, 5))
+	s.ErrorIs(err, payrollErrors.ErrInvalidPayAnchorDate)
+}
+
+func (s *PayCalendarServiceTestSuite) TestDisableCalendar_NotFound() {
+	s.calendar = nil
+
+	err := s.service.DisableCalendar(adminContext())
+
+	s.ErrorIs(err, payrollErrors.ErrPayCalendarNotFound)
+}
+
+func (s *PayCalendarServiceTestSuite) TestMissingAuthContext() {
+	_, err := s.service.GetCalendar(context.Background())
+	s.ErrorIs(err, payrollErrors.ErrMissingAuthContext)
+
+	_, err = s.service.ListPayPeriods(context.Background())
+	s.ErrorIs(err, payrollErrors.ErrMissingAuthContext)
+}
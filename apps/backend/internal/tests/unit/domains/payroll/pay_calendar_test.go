@@ -0,0 +1,131 @@
+package payroll_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PayCalendarTestSuite struct {
+	suite.Suite
+}
+
+func TestPayCalendarTestSuite(t *testing.T) {
+	suite.Run(t, new(PayCalendarTestSuite))
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func payCalendar(frequency aggregate.PayFrequency, anchor time.Time) *aggregate.PayCalendar {
+	c, err := aggregate.NewPayCalendar(frequency, anchor, nil)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (s *PayCalendarTestSuite) TestNewPayCalendar_Validation() {
+	_, err := aggregate.NewPayCalendar("fortnightly", date(2026, 3, 1), nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidPayFrequency)
+
+	_, err = aggregate.NewPayCalendar(aggregate.PayFrequency_SemiMonthly, date(2026, 3, 2), nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidPayAnchorDate)
+
+	_, err = aggregate.NewPayCalendar(aggregate.PayFrequency_Monthly, date(2026, 3, 29), nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidPayAnchorDate)
+
+	c, err := aggregate.NewPayCalendar(aggregate.PayFrequency_Weekly, time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC), nil)
+	s.Require().NoError(err)
+	s.Equal(date(2026, 3, 2), c.AnchorDate)
+	s.True(c.IsActive)
+}
+
+func (s *PayCalendarTestSuite) TestPeriodContaining() {
+	tests := []struct {
+		name      string
+		frequency aggregate.PayFrequency
+		anchor    time.Time
+		day       time.Time
+		want      aggregate.PeriodRange
+	}{
+		{"weekly", aggregate.PayFrequency_Weekly, date(2026, 3, 2), date(2026, 3, 18), aggregate.PeriodRange{Start: date(2026, 3, 16), End: date(2026, 3, 22)}},
+		{"biweekly", aggregate.PayFrequency_Biweekly, date(2026, 3, 2), date(2026, 3, 18), aggregate.PeriodRange{Start: date(2026, 3, 16), End: date(2026, 3, 29)}},
+		{"biweekly anchor day", aggregate.PayFrequency_Biweekly, date(2026, 3, 2), date(2026, 3, 2), aggregate.PeriodRange{Start: date(2026, 3, 2), End: date(2026, 3, 15)}},
+		{"semimonthly first half", aggregate.PayFrequency_SemiMonthly, date(2026, 1, 1), date(2026, 2, 15), aggregate.PeriodRange{Start: date(2026, 2, 1), End: date(2026, 2, 15)}},
+		{"semimonthly second half", aggregate.PayFrequency_SemiMonthly, date(2026, 1, 1), date(2026, 2, 16), aggregate.PeriodRange{Start: date(2026, 2, 16), End: date(2026, 2, 28)}},
+		{"monthly from the 1st", aggregate.PayFrequency_Monthly, date(2026, 1, 1), date(2026, 2, 28), aggregate.PeriodRange{Start: date(2026, 2, 1), End: date(2026, 2, 28)}},
+		{"monthly mid-month anchor", aggregate.PayFrequency_Monthly, date(2026, 1, 25), date(2026, 3, 10), aggregate.PeriodRange{Start: date(2026, 2, 25), End: date(2026, 3, 24)}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			got, ok := payCalendar(tt.frequency, tt.anchor).PeriodContaining(tt.day)
+			s.Require().True(ok)
+			s.Equal(tt.want, got)
+		})
+	}
+
+	_, ok := payCalendar(aggregate.PayFrequency_Weekly, date(2026, 3, 2)).PeriodContaining(date(2026, 3, 1))
+	s.False(ok)
+}
+
+func (s *PayCalendarTestSuite) TestNext_SemiMonthlyWrapsMonth() {
+	c := payCalendar(aggregate.PayFrequency_SemiMonthly, date(2026, 1, 1))
+	next := c.Next(aggregate.PeriodRange{Start: date(2026, 1, 16), End: date(2026, 1, 31)})
+	s.Equal(aggregate.PeriodRange{Start: date(2026, 2, 1), End: date(2026, 2, 15)}, next)
+}
+
+func (s *PayCalendarTestSuite) TestClosedPeriods_FirstRunReturnsMostRecent() {
+	c := payCalendar(aggregate.PayFrequency_Weekly, date(2026, 1, 5))
+
+	// Monday: the previous week has just closed.
+	got := c.ClosedPeriods(nil, date(2026, 3, 16), 12)
+	s.Equal([]aggregate.PeriodRange{{Start: date(2026, 3, 9), End: date(2026, 3, 15)}}, got)
+
+	// Midweek: the current week is still open.
+	got = c.ClosedPeriods(nil, date(2026, 3, 18), 12)
+	s.Equal([]aggregate.PeriodRange{{Start: date(2026, 3, 9), End: date(2026, 3, 15)}}, got)
+
+	s.Empty(c.ClosedPeriods(nil, date(2026, 1, 7), 12))
+}
+
+func (s *PayCalendarTestSuite) TestClosedPeriods_ContinuesFromLastPeriod() {
+	c := payCalendar(aggregate.PayFrequency_Weekly, date(2026, 1, 5))
+	lastEnd := date(2026, 2, 22)
+
+	got := c.ClosedPeriods(&lastEnd, date(2026, 3, 18), 12)
+	s.Equal([]aggregate.PeriodRange{
+		{Start: date(2026, 2, 23), End: date(2026, 3, 1)},
+		{Start: date(2026, 3, 2), End: date(2026, 3, 8)},
+		{Start: date(2026, 3, 9), End: date(2026, 3, 15)},
+	}, got)
+
+	s.Len(c.ClosedPeriods(&lastEnd, date(2026, 3, 18), 2), 2)
+
+	lastEnd = date(2026, 3, 15)
+	s.Empty(c.ClosedPeriods(&lastEnd, date(2026, 3, 18), 12))
+}
+
+func (s *PayCalendarTestSuite) TestNewPayPeriods() {
+	calendarID := uuid.New()
+	period := aggregate.PeriodRange{Start: date(2026, 3, 1), End: date(2026, 3, 15)}
+
+	a, b := samplePayment(), samplePayment()
+	a.GrossAmount, b.GrossAmount = 100.1, 200.2
+	generated := aggregate.NewGeneratedPayPeriod(calendarID, period, []*aggregate.Payment{a, b})
+	s.Equal(aggregate.PayPeriodStatus_Generated, generated.Status)
+	s.Equal(int32(2), generated.PaymentCount)
+	s.Equal(300.3, generated.GrossTotal)
+	s.Equal(period, generated.Range())
+
+	blocked := aggregate.NewBlockedPayPeriod(calendarID, period, []aggregate.PeriodRange{{Start: date(2026, 3, 10), End: date(2026, 3, 20)}})
+	s.Equal(aggregate.PayPeriodStatus_Blocked, blocked.Status)
+	s.Require().NotNil(blocked.Note)
+	s.Equal("overlaps payments already generated for 2026-03-10 to 2026-03-20", *blocked.Note)
+}
@@ -0,0 +1,117 @@
+package payroll_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentAdjustmentTestSuite struct {
+	suite.Suite
+}
+
+func TestPaymentAdjustmentTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentAdjustmentTestSuite))
+}
+
+func adjustment(t aggregate.AdjustmentType, amount float64) *aggregate.PaymentAdjustment {
+	a, err := aggregate.NewPaymentAdjustment(816000001, t, amount, "test", nil)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func (s *PaymentAdjustmentTestSuite) TestNewPaymentAdjustment_Validation() {
+	_, err := aggregate.NewPaymentAdjustment(816000001, "gift", 10, "reason", nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidAdjustmentType)
+
+	_, err = aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_Bonus, 0, "reason", nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidAdjustmentAmount)
+
+	_, err = aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_Bonus, -5, "reason", nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidAdjustmentAmount)
+
+	_, err = aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_Bonus, 0.001, "reason", nil)
+	s.ErrorIs(err, payrollErrors.ErrInvalidAdjustmentAmount)
+
+	_, err = aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_Bonus, 10, "   ", nil)
+	s.ErrorIs(err, payrollErrors.ErrAdjustmentReasonRequired)
+
+	a, err := aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_Deduction, 10.005, " Uniform ", nil)
+	s.Require().NoError(err)
+	s.Equal(10.01, a.Amount)
+	s.Equal("Uniform", a.Reason)
+	s.Equal(-10.01, a.SignedAmount())
+	s.True(a.IsPending())
+}
+
+func (s *PaymentAdjustmentTestSuite) TestApplyAdjustments() {
+	p := samplePayment()
+	p.ProcessedAt = nil
+
+	err := p.ApplyAdjustments(250, []*aggregate.PaymentAdjustment{
+		adjustment(aggregate.AdjustmentType_Bonus, 50),
+		adjustment(aggregate.AdjustmentType_BackPay, 0.1),
+		adjustment(aggregate.AdjustmentType_Deduction, 20.2),
+		adjustment(aggregate.AdjustmentType_OverpaymentRecovery, 10),
+	})
+
+	s.Require().NoError(err)
+	s.Equal(300.1, p.GrossAmount)
+	s.Equal(30.2, p.DeductionsAmount)
+	s.Equal(269.9, p.NetAmount)
+}
+
+func (s *PaymentAdjustmentTestSuite) TestApplyAdjustments_RejectsNegativeNet() {
+	p := samplePayment()
+	p.ProcessedAt = nil
+
+	err := p.ApplyAdjustments(250, []*aggregate.PaymentAdjustment{adjustment(aggregate.AdjustmentType_Deduction, 250.01)})
+
+	s.ErrorIs(err, payrollErrors.ErrNegativeNetAmount)
+	s.Equal(250.0, p.NetAmount)
+}
+
+func (s *PaymentAdjustmentTestSuite) TestApplyAdjustments_Processed() {
+	err := samplePayment().ApplyAdjustments(250, nil)
+
+	s.ErrorIs(err, payrollErrors.ErrAlreadyProcessed)
+}
+
+func (s *PaymentAdjustmentTestSuite) TestCanApplyTo_CarriedForward() {
+	processed := samplePayment()
+	a := adjustment(aggregate.AdjustmentType_BackPay, 20)
+	a.CarryForwardFrom(processed)
+
+	s.True(a.IsPending())
+	s.Equal(processed.PaymentID, *a.OriginalPaymentID)
+
+	next := &aggregate.Payment{
+		PaymentID:   uuid.New(),
+		StudentID:   processed.StudentID,
+		PeriodStart: processed.PeriodEnd,
+		PeriodEnd:   processed.PeriodEnd.AddDate(0, 0, 14),
+	}
+	earlier := &aggregate.Payment{
+		PaymentID:   uuid.New(),
+		StudentID:   processed.StudentID,
+		PeriodStart: processed.PeriodStart.AddDate(0, 0, -14),
+		PeriodEnd:   processed.PeriodStart,
+	}
+	otherStudent := *next
+	otherStudent.StudentID = 816000002
+	processedNext := *next
+	processedAt := time.Now()
+	processedNext.ProcessedAt = &processedAt
+
+	s.True(a.CanApplyTo(next))
+	s.False(a.CanApplyTo(earlier))
+	s.False(a.CanApplyTo(&otherStudent))
+	s.False(a.CanApplyTo(&processedNext))
+	s.False(a.CanApplyTo(processed))
+}
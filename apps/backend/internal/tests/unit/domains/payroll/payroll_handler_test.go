@@ -0,0 +1,458 @@
+package payroll_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type PayrollHandlerTestSuite struct {
+	suite.Suite
+	payrollSvc      *mocks.MockPayrollService
+	payslipSvc      *mocks.MockPayslipService
+	disbursementSvc *mocks.MockDisbursementService
+	reconcileSvc    *mocks.MockReconciliationService
+	calendarSvc     *mocks.MockPayCalendarService
+	router          *chi.Mux
+}
+
+func TestPayrollHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PayrollHandlerTestSuite))
+}
+
+func (s *PayrollHandlerTestSuite) SetupTest() {
+	s.payrollSvc = &mocks.MockPayrollService{}
+	s.payslipSvc = &mocks.MockPayslipService{}
+	s.disbursementSvc = &mocks.MockDisbursementService{}
+	s.reconcileSvc = &mocks.MockReconciliationService{}
+	s.calendarSvc = &mocks.MockPayCalendarService{}
+	hdl := handler.NewPayrollHandler(zap.NewNop(), s.payrollSvc, s.payslipSvc, s.disbursementSvc, s.reconcileSvc, s.calendarSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *PayrollHandlerTestSuite) doRequest(method, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *PayrollHandlerTestSuite) TestListMyPayments() {
+	s.payrollSvc.ListMyPaymentsFn = func(_ context.Context) ([]*aggregate.Payment, error) {
+		return []*aggregate.Payment{samplePayment()}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/me")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []dtos.PaymentResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal("2026-03-01", resp[0].PeriodStart)
+	s.Equal(250.0, resp[0].GrossAmount)
+}
+
+func (s *PayrollHandlerTestSuite) TestListMyPayments_NotAStudent() {
+	s.payrollSvc.ListMyPaymentsFn = func(_ context.Context) ([]*aggregate.Payment, error) {
+		return nil, payrollErrors.ErrNotAuthorized
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/me")
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestDownloadMyPayslip() {
+	payment := samplePayment()
+	s.payslipSvc.GetMyPayslipFn = func(_ context.Context, id uuid.UUID) (*aggregate.Payslip, error) {
+		s.Equal(payment.PaymentID, id)
+		return &aggregate.Payslip{Payment: payment, StudentName: "Jane Doe", HourlyRate: 20}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/me/"+payment.PaymentID.String()+"/payslip")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("application/pdf", rr.Header().Get("Content-Type"))
+	s.Contains(rr.Header().Get("Content-Disposition"), `filename="payslip_816000001_2026-03-15.pdf"`)
+	s.True(len(rr.Body.Bytes()) > 0)
+	s.Equal("%PDF", rr.Body.String()[:4])
+}
+
+func (s *PayrollHandlerTestSuite) TestDownloadMyPayslip_Errors() {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", payrollErrors.ErrPaymentNotFound, http.StatusNotFound},
+		{"not processed", payrollErrors.ErrNotProcessed, http.StatusConflict},
+		{"missing auth", payrollErrors.ErrMissingAuthContext, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.payslipSvc.GetMyPayslipFn = func(_ context.Context, _ uuid.UUID) (*aggregate.Payslip, error) {
+				return nil, tt.err
+			}
+
+			rr := s.doRequest(http.MethodGet, "/api/v1/payments/me/"+uuid.NewString()+"/payslip")
+
+			s.Equal(tt.status, rr.Code)
+		})
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/me/not-a-uuid/payslip")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestGenerateDisbursementFiles() {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	s.disbursementSvc.GenerateDisbursementFilesFn = func(_ context.Context, periodStart, periodEnd time.Time) (*service.DisbursementRun, error) {
+		s.Equal(start, periodStart)
+		s.Equal(end, periodEnd)
+		return &service.DisbursementRun{
+			Files: []*aggregate.DisbursementFile{
+				aggregate.NewDisbursementFile("First Citizens", "fixed_width", "txt", start, end, 1, 10000, []byte("a"), nil, nil),
+				aggregate.NewDisbursementFile("Republic Bank", "republic_csv", "csv", start, end, 2, 37550, []byte("b"), nil, nil),
+			},
+			Skipped: []service.SkippedPayment{{Payment: samplePayment(), Reason: service.SkipReason_NoBankingDetails}},
+		}, nil
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments/disbursements",
+		strings.NewReader(`{"period_start":"2026-03-01","period_end":"2026-03-15"}`))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var resp dtos.DisbursementRunResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Len(resp.Files, 2)
+	s.Equal(int32(3), resp.RecordCount)
+	s.Equal(475.5, resp.ControlTotal)
+	s.Require().Len(resp.Skipped, 1)
+	s.Equal("no_banking_details", resp.Skipped[0].Reason)
+}
+
+func (s *PayrollHandlerTestSuite) TestGenerateDisbursementFiles_InvalidDate() {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments/disbursements",
+		strings.NewReader(`{"period_start":"03/01/2026","period_end":"2026-03-15"}`))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestDownloadDisbursementFile() {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	file := aggregate.NewDisbursementFile("Republic Bank", "republic_csv", "csv", start, end, 1, 25000, []byte("T,1,250.00\r\n"), nil, nil)
+	s.disbursementSvc.GetDisbursementFileFn = func(_ context.Context, id uuid.UUID) (*aggregate.DisbursementFile, error) {
+		s.Equal(file.ID, id)
+		return file, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/disbursements/"+file.ID.String()+"/download")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+	s.Contains(rr.Header().Get("Content-Disposition"), `filename="disbursement_republic_bank_2026-03-01_2026-03-15.csv"`)
+	s.Equal(file.Checksum, rr.Header().Get("X-Checksum-SHA256"))
+	s.Equal("T,1,250.00\r\n", rr.Body.String())
+}
+
+func (s *PayrollHandlerTestSuite) TestDownloadDisbursementFile_Errors() {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not found", payrollErrors.ErrDisbursementFileNotFound, http.StatusNotFound},
+		{"checksum mismatch", payrollErrors.ErrChecksumMismatch, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.disbursementSvc.GetDisbursementFileFn = func(_ context.Context, _ uuid.UUID) (*aggregate.DisbursementFile, error) {
+				return nil, tt.err
+			}
+
+			rr := s.doRequest(http.MethodGet, "/api/v1/payments/disbursements/"+uuid.NewString()+"/download")
+
+			s.Equal(tt.status, rr.Code)
+		})
+	}
+}
+
+func (s *PayrollHandlerTestSuite) TestListBankFormats() {
+	s.disbursementSvc.ListBankFormatsFn = func() service.BankFormats {
+		return service.BankFormats{
+			Formats:  []string{"generic_csv", "republic_csv"},
+			Banks:    map[string]string{"republic bank": "republic_csv"},
+			Fallback: "generic_csv",
+		}
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/disbursements/formats")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.BankFormatsResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("republic_csv", resp.Banks["republic bank"])
+	s.Equal("generic_csv", resp.Fallback)
+}
+
+func (s *PayrollHandlerTestSuite) TestAddAdjustment() {
+	payment := samplePayment()
+	payment.ProcessedAt = nil
+	s.payrollSvc.AddAdjustmentFn = func(_ context.Context, paymentID uuid.UUID, input service.AdjustmentInput) (*service.AdjustmentResult, error) {
+		s.Equal(payment.PaymentID, paymentID)
+		s.Equal(aggregate.AdjustmentType_Deduction, input.Type)
+		a, err := aggregate.NewPaymentAdjustment(payment.StudentID, input.Type, input.Amount, input.Reason, nil)
+		s.Require().NoError(err)
+		a.ApplyTo(payment)
+		payment.DeductionsAmount = 15
+		payment.NetAmount = 235
+		return &service.AdjustmentResult{Adjustment: a, Payment: payment}, nil
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments/"+payment.PaymentID.String()+"/adjustments",
+		strings.NewReader(`{"type":"deduction","amount":15,"reason":"Lost access card"}`))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var resp dtos.AddAdjustmentResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal(-15.0, resp.Adjustment.SignedAmount)
+	s.False(resp.Adjustment.Pending)
+	s.Require().NotNil(resp.Payment)
+	s.Equal(235.0, resp.Payment.NetAmount)
+}
+
+func (s *PayrollHandlerTestSuite) TestAddAdjustment_Errors() {
+	path := "/api/v1/payments/" + uuid.NewString() + "/adjustments"
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{payrollErrors.ErrInvalidAdjustmentType, http.StatusBadRequest},
+		{payrollErrors.ErrAdjustmentReasonRequired, http.StatusBadRequest},
+		{payrollErrors.ErrNegativeNetAmount, http.StatusConflict},
+		{payrollErrors.ErrPaymentNotFound, http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		s.payrollSvc.AddAdjustmentFn = func(_ context.Context, _ uuid.UUID, _ service.AdjustmentInput) (*service.AdjustmentResult, error) {
+			return nil, tc.err
+		}
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"type":"bonus","amount":5,"reason":"x"}`))
+		rr := httptest.NewRecorder()
+		s.router.ServeHTTP(rr, req)
+		s.Equal(tc.code, rr.Code, tc.err.Error())
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/payments/not-a-uuid/adjustments")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestRemoveAdjustment() {
+	paymentID, adjustmentID := uuid.New(), uuid.New()
+	s.payrollSvc.RemoveAdjustmentFn = func(_ context.Context, pID, aID uuid.UUID) (*aggregate.Payment, error) {
+		s.Equal(paymentID, pID)
+		s.Equal(adjustmentID, aID)
+		return nil, nil
+	}
+
+	rr := s.doRequest(http.MethodDelete, "/api/v1/payments/"+paymentID.String()+"/adjustments/"+adjustmentID.String())
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestListPendingAdjustments() {
+	a, err := aggregate.NewPaymentAdjustment(816000001, aggregate.AdjustmentType_BackPay, 20, "Missed shift", nil)
+	s.Require().NoError(err)
+	a.CarryForwardFrom(samplePayment())
+	s.payrollSvc.ListPendingAdjustmentsFn = func(_ context.Context) ([]*aggregate.PaymentAdjustment, error) {
+		return []*aggregate.PaymentAdjustment{a}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/adjustments/pending")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []dtos.AdjustmentResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.True(resp[0].Pending)
+	s.Nil(resp[0].PaymentID)
+	s.Equal(samplePayment().PaymentID.String(), *resp[0].OriginalPaymentID)
+}
+
+func (s *PayrollHandlerTestSuite) TestGetReconciliation() {
+	logID := uuid.New()
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	s.reconcileSvc.GetReconciliationFn = func(_ context.Context, query aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error) {
+		s.Equal(day, query.PeriodStart)
+		s.Require().NotNil(query.StudentID)
+		s.Equal(int32(816000001), *query.StudentID)
+		s.Equal(15, query.ToleranceMinutes)
+		s.True(query.DiscrepanciesOnly)
+		return []*aggregate.StudentReconciliation{{
+			StudentID:      816000001,
+			ScheduledHours: 4,
+			FlaggedHours:   4,
+			Variance:       -4,
+			Payment:        samplePayment(),
+			Shifts:         []aggregate.ScheduledShift{{StudentID: 816000001, Date: day, ShiftID: "s1", Start: "08:00:00", End: "12:00:00", Hours: 4}},
+			Logs:           []aggregate.ReconciledLog{{TimeLogID: logID, Date: day, EntryAt: day.Add(8 * time.Hour), Hours: 4, Status: aggregate.LogStatus_Flagged}},
+			Discrepancies: []aggregate.Discrepancy{
+				{Code: aggregate.DiscrepancyCode_Variance, Date: &day, Hours: -4, TimeLogIDs: []uuid.UUID{}},
+				{Code: aggregate.DiscrepancyCode_Flagged, Date: &day, Hours: 4, TimeLogIDs: []uuid.UUID{logID}},
+			},
+		}}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/reconciliation?period_start=2026-03-02&period_end=2026-03-15&student_id=816000001&tolerance_minutes=15&discrepancies_only=true")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []dtos.ReconciliationResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal(-4.0, resp[0].Variance)
+	s.Equal("flagged", resp[0].Logs[0].Status)
+	s.Require().Len(resp[0].Discrepancies, 2)
+	s.Equal("2026-03-02", *resp[0].Discrepancies[1].Date)
+	s.Equal([]string{logID.String()}, resp[0].Discrepancies[1].TimeLogIDs)
+	s.Require().NotNil(resp[0].PaymentHours)
+}
+
+func (s *PayrollHandlerTestSuite) TestGetReconciliation_BadRequests() {
+	for _, path := range []string{
+		"/api/v1/payments/reconciliation?period_start=2026-03-02",
+		"/api/v1/payments/reconciliation?period_start=2026-03-02&period_end=15-03-2026",
+		"/api/v1/payments/reconciliation?period_start=2026-03-02&period_end=2026-03-15&tolerance_minutes=abc",
+	} {
+		rr := s.doRequest(http.MethodGet, path)
+		s.Equal(http.StatusBadRequest, rr.Code, path)
+	}
+
+	s.reconcileSvc.GetReconciliationFn = func(_ context.Context, _ aggregate.ReconciliationQuery) ([]*aggregate.StudentReconciliation, error) {
+		return nil, payrollErrors.ErrPeriodTooLong
+	}
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/reconciliation?period_start=2025-01-01&period_end=2026-03-15")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestSetPayCalendar() {
+	anchor := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	s.calendarSvc.SetCalendarFn = func(_ context.Context, frequency aggregate.PayFrequency, anchorDate time.Time) (*service.PayCalendarOverview, error) {
+		s.Equal(aggregate.PayFrequency_Biweekly, frequency)
+		s.Equal(anchor, anchorDate)
+		calendar, err := aggregate.NewPayCalendar(frequency, anchorDate, nil)
+		s.Require().NoError(err)
+		return &service.PayCalendarOverview{
+			Calendar: calendar,
+			Upcoming: []aggregate.PeriodRange{{Start: anchor, End: anchor.AddDate(0, 0, 13)}},
+		}, nil
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/payments/calendar",
+		strings.NewReader(`{"frequency":"biweekly","anchor_date":"2026-01-05"}`))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.PayCalendarResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("biweekly", resp.Frequency)
+	s.Equal("2026-01-05", resp.AnchorDate)
+	s.Require().Len(resp.Upcoming, 1)
+	s.Equal("2026-01-18", resp.Upcoming[0].PeriodEnd)
+}
+
+func (s *PayrollHandlerTestSuite) TestSetPayCalendar_BadRequests() {
+	s.calendarSvc.SetCalendarFn = func(_ context.Context, _ aggregate.PayFrequency, _ time.Time) (*service.PayCalendarOverview, error) {
+		return nil, payrollErrors.ErrInvalidPayAnchorDate
+	}
+
+	for _, body := range []string{
+		`{"frequency":"weekly","anchor_date":"05/01/2026"}`,
+		`{"frequency":"semimonthly","anchor_date":"2026-01-05"}`,
+	} {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/payments/calendar", strings.NewReader(body))
+		rr := httptest.NewRecorder()
+		s.router.ServeHTTP(rr, req)
+		s.Equal(http.StatusBadRequest, rr.Code, body)
+	}
+}
+
+func (s *PayrollHandlerTestSuite) TestGetPayCalendar_NotFound() {
+	s.calendarSvc.GetCalendarFn = func(_ context.Context) (*service.PayCalendarOverview, error) {
+		return nil, payrollErrors.ErrPayCalendarNotFound
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/calendar")
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestDisablePayCalendar() {
+	s.calendarSvc.DisableCalendarFn = func(_ context.Context) error { return nil }
+
+	rr := s.doRequest(http.MethodDelete, "/api/v1/payments/calendar")
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *PayrollHandlerTestSuite) TestListPayPeriods() {
+	period := aggregate.NewBlockedPayPeriod(uuid.New(),
+		aggregate.PeriodRange{Start: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		[]aggregate.PeriodRange{{Start: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)}})
+	s.calendarSvc.ListPayPeriodsFn = func(_ context.Context) ([]*aggregate.PayPeriod, error) {
+		return []*aggregate.PayPeriod{period}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/payments/periods")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []dtos.PayPeriodResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal("blocked", resp[0].Status)
+	s.Equal("2026-03-02", resp[0].PeriodStart)
+	s.NotNil(resp[0].Note)
+}
+
+func (s *PayrollHandlerTestSuite) TestGeneratePayments_OverlapConflict() {
+	s.payrollSvc.GeneratePaymentsFn = func(_ context.Context, _, _ time.Time) ([]*aggregate.Payment, error) {
+		return nil, fmt.Errorf("%w: 2026-03-01 to 2026-03-15", payrollErrors.ErrPeriodOverlap)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments/generate",
+		strings.NewReader(`{"period_start":"2026-03-10","period_end":"2026-03-20"}`))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+	s.Contains(rr.Body.String(), "2026-03-01 to 2026-03-15")
+}
@@ -0,0 +1,376 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type PayrollServiceTestSuite struct {
+	suite.Suite
+	paymentRepo *mocks.MockPaymentRepository
+	adjustRepo  *mocks.MockPaymentAdjustmentRepository
+	studentRepo *mocks.MockStudentRepository
+	enqueuer    *mocks.MockPayslipJobEnqueuer
+	service     service.PayrollServiceInterface
+	payments    map[uuid.UUID]*aggregate.Payment
+	enqueued    []uuid.UUID
+	adjustments map[uuid.UUID]*aggregate.PaymentAdjustment
+}
+
+func TestPayrollServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PayrollServiceTestSuite))
+}
+
+func (s *PayrollServiceTestSuite) SetupTest() {
+	s.payments = map[uuid.UUID]*aggregate.Payment{}
+	s.enqueued = nil
+	s.adjustments = map[uuid.UUID]*aggregate.PaymentAdjustment{}
+	s.paymentRepo = &mocks.MockPaymentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.Payment, error) {
+			p, ok := s.payments[id]
+			if !ok {
+				return nil, payrollErrors.ErrPaymentNotFound
+			}
+			return p, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, p *aggregate.Payment) (*aggregate.Payment, error) {
+			return p, nil
+		},
+		ListOverlappingPeriodsFn: func(_ context.Context, _ *sql.Tx, _, _ time.Time) ([]aggregate.PeriodRange, error) {
+			return []aggregate.PeriodRange{}, nil
+		},
+	}
+	s.enqueuer = &mocks.MockPayslipJobEnqueuer{
+		EnqueuePayslipEmailFn: func(_ context.Context, id uuid.UUID) error {
+			s.enqueued = append(s.enqueued, id)
+			return nil
+		},
+	}
+	s.adjustRepo = &mocks.MockPaymentAdjustmentRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, a *aggregate.PaymentAdjustment) (*aggregate.PaymentAdjustment, error) {
+			s.adjustments[a.ID] = a
+			return a, nil
+		},
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.PaymentAdjustment, error) {
+			a, ok := s.adjustments[id]
+			if !ok {
+				return nil, payrollErrors.ErrAdjustmentNotFound
+			}
+			return a, nil
+		},
+		ListAppliedByPaymentIDsFn: func(_ context.Context, _ *sql.Tx, ids []uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+			var out []*aggregate.PaymentAdjustment
+			for _, a := range s.adjustments {
+				for _, id := range ids {
+					if a.PaymentID != nil && *a.PaymentID == id {
+						out = append(out, a)
+					}
+				}
+			}
+			return out, nil
+		},
+		ListPendingFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.PaymentAdjustment, error) {
+			var out []*aggregate.PaymentAdjustment
+			for _, a := range s.adjustments {
+				if a.IsPending() {
+					out = append(out, a)
+				}
+			}
+			return out, nil
+		},
+		SetPaymentFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID, paymentID *uuid.UUID) error {
+			s.adjustments[id].PaymentID = paymentID
+			return nil
+		},
+		DeleteFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) error {
+			delete(s.adjustments, id)
+			return nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{}
+	s.service = service.NewPayrollService(zap.NewNop(), &mocks.StubTxManager{}, s.paymentRepo, s.adjustRepo, s.studentRepo, &mocks.MockBankingDetailsRepository{}, s.enqueuer)
+}
+
+func (s *PayrollServiceTestSuite) pendingPayment() *aggregate.Payment {
+	p := samplePayment()
+	p.PaymentID = uuid.New()
+	p.ProcessedAt = nil
+	s.payments[p.PaymentID] = p
+	return p
+}
+
+// nextPayment adds an unprocessed payment for the period after p.
+func (s *PayrollServiceTestSuite) nextPayment(p *aggregate.Payment) *aggregate.Payment {
+	next := &aggregate.Payment{
+		PaymentID:   uuid.New(),
+		StudentID:   p.StudentID,
+		PeriodStart: p.PeriodEnd,
+		PeriodEnd:   p.PeriodEnd.AddDate(0, 0, 14),
+		HoursWorked: 10,
+		GrossAmount: 200,
+		NetAmount:   200,
+	}
+	s.payments[next.PaymentID] = next
+	return next
+}
+
+func (s *PayrollServiceTestSuite) listByStudent() {
+	s.paymentRepo.ListByStudentIDFn = func(_ context.Context, _ *sql.Tx, studentID int32) ([]*aggregate.Payment, error) {
+		var out []*aggregate.Payment
+		for _, p := range s.payments {
+			if p.StudentID == studentID {
+				out = append(out, p)
+			}
+		}
+		return out, nil
+	}
+}
+
+func (s *PayrollServiceTestSuite) TestListMyPayments() {
+	var listedFor int32
+	s.paymentRepo.ListByStudentIDFn = func(_ context.Context, _ *sql.Tx, studentID int32) ([]*aggregate.Payment, error) {
+		listedFor = studentID
+		return []*aggregate.Payment{samplePayment()}, nil
+	}
+
+	payments, err := s.service.ListMyPayments(studentContext("816000001"))
+
+	s.Require().NoError(err)
+	s.Len(payments, 1)
+	s.Equal(int32(816000001), listedFor)
+}
+
+func (s *PayrollServiceTestSuite) TestListMyPayments_RequiresStudent() {
+	_, err := s.service.ListMyPayments(adminContext())
+
+	s.ErrorIs(err, payrollErrors.ErrNotAuthorized)
+}
+
+func (s *PayrollServiceTestSuite) TestProcessPayment_EnqueuesPayslip() {
+	p := s.pendingPayment()
+
+	result, err := s.service.ProcessPayment(adminContext(), p.PaymentID)
+
+	s.Require().NoError(err)
+	s.NotNil(result.ProcessedAt)
+	s.Equal([]uuid.UUID{p.PaymentID}, s.enqueued)
+}
+
+func (s *PayrollServiceTestSuite) TestProcessPayment_EnqueueFailureIsNotFatal() {
+	p := s.pendingPayment()
+	s.enqueuer.EnqueuePayslipEmailFn = func(_ context.Context, _ uuid.UUID) error {
+		return errors.New("queue unavailable")
+	}
+
+	result, err := s.service.ProcessPayment(adminContext(), p.PaymentID)
+
+	s.Require().NoError(err)
+	s.NotNil(result.ProcessedAt)
+}
+
+func (s *PayrollServiceTestSuite) TestProcessPayment_AlreadyProcessed() {
+	p := samplePayment()
+	s.payments[p.PaymentID] = p
+
+	_, err := s.service.ProcessPayment(adminContext(), p.PaymentID)
+
+	s.ErrorIs(err, payrollErrors.ErrAlreadyProcessed)
+	s.Empty(s.enqueued)
+}
+
+func (s *PayrollServiceTestSuite) TestBulkProcessPayments_EnqueuesNewlyProcessedOnly() {
+	pending := s.pendingPayment()
+	done := samplePayment()
+	s.payments[done.PaymentID] = done
+
+	results, err := s.service.BulkProcessPayments(adminContext(), []uuid.UUID{pending.PaymentID, done.PaymentID})
+
+	s.Require().NoError(err)
+	s.Len(results, 2)
+	s.Equal([]uuid.UUID{pending.PaymentID}, s.enqueued)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_UnprocessedPayment() {
+	p := s.pendingPayment()
+
+	result, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Bonus, Amount: 40, Reason: "Exam week cover",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(p.PaymentID, *result.Adjustment.PaymentID)
+	s.Nil(result.Adjustment.OriginalPaymentID)
+	s.NotNil(result.Adjustment.CreatedBy)
+	s.Require().NotNil(result.Payment)
+	s.Equal(290.0, result.Payment.GrossAmount)
+	s.Equal(290.0, result.Payment.NetAmount)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_DeductionExceedingGross() {
+	p := s.pendingPayment()
+
+	_, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Deduction, Amount: 300, Reason: "Laptop damage",
+	})
+
+	s.ErrorIs(err, payrollErrors.ErrNegativeNetAmount)
+	s.Empty(s.adjustments)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_InvalidInput() {
+	p := s.pendingPayment()
+
+	_, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Bonus, Amount: 10,
+	})
+
+	s.ErrorIs(err, payrollErrors.ErrAdjustmentReasonRequired)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_CarriesForwardToNextPayment() {
+	processed := samplePayment()
+	s.payments[processed.PaymentID] = processed
+	next := s.nextPayment(processed)
+	s.listByStudent()
+
+	result, err := s.service.AddAdjustment(adminContext(), processed.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_OverpaymentRecovery, Amount: 25, Reason: "Hours double counted",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(processed.PaymentID, *result.Adjustment.OriginalPaymentID)
+	s.Equal(next.PaymentID, *result.Adjustment.PaymentID)
+	s.Equal(next.PaymentID, result.Payment.PaymentID)
+	s.Equal(200.0, result.Payment.GrossAmount)
+	s.Equal(25.0, result.Payment.DeductionsAmount)
+	s.Equal(175.0, result.Payment.NetAmount)
+	s.Equal(250.0, processed.NetAmount)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_PendingWithoutNextPayment() {
+	processed := samplePayment()
+	s.payments[processed.PaymentID] = processed
+	s.listByStudent()
+
+	result, err := s.service.AddAdjustment(adminContext(), processed.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_BackPay, Amount: 15, Reason: "Missed shift on 12 March",
+	})
+
+	s.Require().NoError(err)
+	s.True(result.Adjustment.IsPending())
+	s.Nil(result.Payment)
+}
+
+func (s *PayrollServiceTestSuite) TestAddAdjustment_TooLargeForNextPaymentStaysPending() {
+	processed := samplePayment()
+	s.payments[processed.PaymentID] = processed
+	s.nextPayment(processed)
+	s.listByStudent()
+
+	result, err := s.service.AddAdjustment(adminContext(), processed.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_OverpaymentRecovery, Amount: 500, Reason: "Duplicate payment",
+	})
+
+	s.Require().NoError(err)
+	s.True(result.Adjustment.IsPending())
+	s.Nil(result.Payment)
+}
+
+func (s *PayrollServiceTestSuite) TestRemoveAdjustment_RecalculatesPayment() {
+	p := s.pendingPayment()
+	result, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Stipend, Amount: 30, Reason: "Training stipend",
+	})
+	s.Require().NoError(err)
+
+	updated, err := s.service.RemoveAdjustment(adminContext(), p.PaymentID, result.Adjustment.ID)
+
+	s.Require().NoError(err)
+	s.Equal(250.0, updated.GrossAmount)
+	s.Equal(250.0, updated.NetAmount)
+	s.Empty(s.adjustments)
+}
+
+func (s *PayrollServiceTestSuite) TestRemoveAdjustment_ProcessedPayment() {
+	p := s.pendingPayment()
+	result, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Bonus, Amount: 30, Reason: "Bonus",
+	})
+	s.Require().NoError(err)
+	now := time.Now()
+	p.ProcessedAt = &now
+
+	_, err = s.service.RemoveAdjustment(adminContext(), p.PaymentID, result.Adjustment.ID)
+
+	s.ErrorIs(err, payrollErrors.ErrAlreadyProcessed)
+	s.Len(s.adjustments, 1)
+}
+
+func (s *PayrollServiceTestSuite) TestRemoveAdjustment_WrongPayment() {
+	p := s.pendingPayment()
+	result, err := s.service.AddAdjustment(adminContext(), p.PaymentID, service.AdjustmentInput{
+		Type: aggregate.AdjustmentType_Bonus, Amount: 30, Reason: "Bonus",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.RemoveAdjustment(adminContext(), uuid.New(), result.Adjustment.ID)
+
+	s.ErrorIs(err, payrollErrors.ErrAdjustmentNotFound)
+}
+
+func (s *PayrollServiceTestSuite) TestGeneratePayments_AppliesPendingAdjustments() {
+	processed := samplePayment()
+	pending, err := aggregate.NewPaymentAdjustment(processed.StudentID, aggregate.AdjustmentType_BackPay, 12.5, "Late timesheet", nil)
+	s.Require().NoError(err)
+	pending.CarryForwardFrom(processed)
+	s.adjustments[pending.ID] = pending
+
+	tooLarge, err := aggregate.NewPaymentAdjustment(processed.StudentID, aggregate.AdjustmentType_OverpaymentRecovery, 1000, "Duplicate payment", nil)
+	s.Require().NoError(err)
+	tooLarge.CarryForwardFrom(processed)
+	s.adjustments[tooLarge.ID] = tooLarge
+
+	s.studentRepo.ListByStatusFn = func(_ context.Context, _ *sql.Tx, _ string) ([]*studentAggregate.Student, error) {
+		return []*studentAggregate.Student{{StudentID: processed.StudentID}}, nil
+	}
+	s.paymentRepo.CalculateHoursBatchFn = func(_ context.Context, _ *sql.Tx, _ []int32, _, _ time.Time) (map[int32]float64, error) {
+		return map[int32]float64{processed.StudentID: 10}, nil
+	}
+	s.paymentRepo.UpsertFn = func(_ context.Context, _ *sql.Tx, p *aggregate.Payment) (*aggregate.Payment, error) {
+		return p, nil
+	}
+
+	start := processed.PeriodEnd
+	payments, err := s.service.GeneratePayments(adminContext(), start, start.AddDate(0, 0, 14))
+
+	s.Require().NoError(err)
+	s.Require().Len(payments, 1)
+	s.Equal(10*service.HourlyRate+12.5, payments[0].GrossAmount)
+	s.Equal(payments[0].GrossAmount, payments[0].NetAmount)
+	s.Equal(payments[0].PaymentID, *pending.PaymentID)
+	s.True(tooLarge.IsPending())
+}
+
+func (s *PayrollServiceTestSuite) TestGeneratePayments_RejectsOverlappingPeriod() {
+	existing := samplePayment()
+	s.paymentRepo.ListOverlappingPeriodsFn = func(_ context.Context, _ *sql.Tx, _, _ time.Time) ([]aggregate.PeriodRange, error) {
+		return []aggregate.PeriodRange{{Start: existing.PeriodStart, End: existing.PeriodEnd}}, nil
+	}
+
+	_, err := s.service.GeneratePayments(adminContext(), existing.PeriodStart.AddDate(0, 0, 7), existing.PeriodEnd.AddDate(0, 0, 7))
+
+	s.ErrorIs(err, payrollErrors.ErrPeriodOverlap)
+	s.Contains(err.Error(), "2026-03-01 to 2026-03-15")
+}
@@ -0,0 +1,179 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type PayslipServiceTestSuite struct {
+	suite.Suite
+	paymentRepo *mocks.MockPaymentRepository
+	adjustments []*aggregate.PaymentAdjustment
+	studentRepo *mocks.MockStudentRepository
+	bankingRepo *mocks.MockBankingDetailsRepository
+	emailSender *mocks.MockEmailSender
+	service     *service.PayslipService
+	studentCtx  context.Context
+	payment     *aggregate.Payment
+	sent        []emailDtos.SendEmailRequest
+}
+
+func TestPayslipServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PayslipServiceTestSuite))
+}
+
+func studentContext(studentID string) context.Context {
+	return database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+}
+
+func adminContext() context.Context {
+	return database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+func (s *PayslipServiceTestSuite) SetupTest() {
+	s.payment = samplePayment()
+	s.adjustments = nil
+	s.sent = nil
+	s.paymentRepo = &mocks.MockPaymentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.Payment, error) {
+			if id != s.payment.PaymentID {
+				return nil, payrollErrors.ErrPaymentNotFound
+			}
+			return s.payment, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id int32) (*studentAggregate.Student, error) {
+			return &studentAggregate.Student{StudentID: id, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane.doe@my.uwi.edu"}, nil
+		},
+	}
+	s.bankingRepo = &mocks.MockBankingDetailsRepository{
+		GetByStudentIDFn: func(_ context.Context, _ *sql.Tx, id int32) (*studentAggregate.BankingDetails, error) {
+			return &studentAggregate.BankingDetails{
+				StudentID:     id,
+				BankName:      "Republic Bank",
+				BranchName:    "St. Augustine",
+				AccountType:   studentAggregate.BankAccountType_Savings,
+				AccountNumber: "123456789",
+			}, nil
+		},
+	}
+	s.emailSender = &mocks.MockEmailSender{
+		SendFn: func(_ context.Context, req emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+			s.sent = append(s.sent, req)
+			return &emailDtos.SendEmailResponse{ID: "msg-1"}, nil
+		},
+	}
+	adjustmentRepo := &mocks.MockPaymentAdjustmentRepository{
+		ListAppliedByPaymentIDsFn: func(_ context.Context, _ *sql.Tx, _ []uuid.UUID) ([]*aggregate.PaymentAdjustment, error) {
+			return s.adjustments, nil
+		},
+	}
+	s.service = service.NewPayslipService(zap.NewNop(), &mocks.StubTxManager{}, s.paymentRepo, adjustmentRepo, s.studentRepo, s.bankingRepo, s.emailSender, "noreply@helpdesk.test")
+	s.studentCtx = studentContext("816000001")
+}
+
+func (s *PayslipServiceTestSuite) TestGetMyPayslip_Success() {
+	payslip, err := s.service.GetMyPayslip(s.studentCtx, s.payment.PaymentID)
+
+	s.Require().NoError(err)
+	s.Equal("Jane Doe", payslip.StudentName)
+	s.Equal(service.HourlyRate, payslip.HourlyRate)
+	s.Equal("Republic Bank", payslip.BankName)
+	s.Equal("savings", payslip.AccountType)
+	s.Equal("****6789", payslip.MaskedAccountNumber)
+}
+
+func (s *PayslipServiceTestSuite) TestGetMyPayslip_NoBankingDetails() {
+	s.bankingRepo.GetByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*studentAggregate.BankingDetails, error) {
+		return nil, studentErrors.ErrBankingDetailsNotFound
+	}
+
+	payslip, err := s.service.GetMyPayslip(s.studentCtx, s.payment.PaymentID)
+
+	s.Require().NoError(err)
+	s.Empty(payslip.MaskedAccountNumber)
+}
+
+func (s *PayslipServiceTestSuite) TestGetMyPayslip_OtherStudentsPayment() {
+	_, err := s.service.GetMyPayslip(studentContext("816000002"), s.payment.PaymentID)
+
+	s.ErrorIs(err, payrollErrors.ErrPaymentNotFound)
+}
+
+func (s *PayslipServiceTestSuite) TestGetMyPayslip_NotProcessed() {
+	s.payment.ProcessedAt = nil
+
+	_, err := s.service.GetMyPayslip(s.studentCtx, s.payment.PaymentID)
+
+	s.ErrorIs(err, payrollErrors.ErrNotProcessed)
+}
+
+func (s *PayslipServiceTestSuite) TestGetMyPayslip_RequiresStudent() {
+	_, err := s.service.GetMyPayslip(adminContext(), s.payment.PaymentID)
+	s.ErrorIs(err, payrollErrors.ErrNotAuthorized)
+
+	_, err = s.service.GetMyPayslip(context.Background(), s.payment.PaymentID)
+	s.ErrorIs(err, payrollErrors.ErrMissingAuthContext)
+}
+
+func (s *PayslipServiceTestSuite) TestSendPayslipEmail_AttachesPDF() {
+	err := s.service.SendPayslipEmail(context.Background(), s.payment.PaymentID)
+
+	s.Require().NoError(err)
+	s.Require().Len(s.sent, 1)
+	req := s.sent[0]
+	s.Equal([]string{"jane.doe@my.uwi.edu"}, req.To)
+	s.Equal(templates.TemplateID_Payslip, req.Template.ID)
+	s.Equal("$250.00", req.Template.Variables["GROSS_AMOUNT"])
+	s.Equal("$250.00", req.Template.Variables["NET_AMOUNT"])
+	s.Require().Len(req.Attachments, 1)
+	s.Equal("payslip_816000001_2026-03-15.pdf", req.Attachments[0].Filename)
+	s.Equal("application/pdf", req.Attachments[0].ContentType)
+
+	pdf, err := base64.StdEncoding.DecodeString(req.Attachments[0].Content)
+	s.Require().NoError(err)
+	s.Contains(string(pdf), "****6789")
+}
+
+func (s *PayslipServiceTestSuite) TestSendPayslipEmail_SkipsRevertedPayment() {
+	s.payment.ProcessedAt = nil
+
+	err := s.service.SendPayslipEmail(context.Background(), s.payment.PaymentID)
+
+	s.NoError(err)
+	s.Empty(s.sent)
+}
+
+func (s *PayslipServiceTestSuite) TestSendPayslipEmail_SendFails() {
+	s.emailSender.SendFn = func(_ context.Context, _ emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+		return nil, errors.New("provider down")
+	}
+
+	err := s.service.SendPayslipEmail(context.Background(), s.payment.PaymentID)
+
+	s.ErrorContains(err, "provider down")
+}
@@ -0,0 +1,97 @@
+package payroll_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PayslipTestSuite struct {
+	suite.Suite
+}
+
+func TestPayslipTestSuite(t *testing.T) {
+	suite.Run(t, new(PayslipTestSuite))
+}
+
+func samplePayment() *aggregate.Payment {
+	processedAt := time.Date(2026, 3, 20, 14, 0, 0, 0, time.UTC)
+	return &aggregate.Payment{
+		PaymentID:   uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		StudentID:   816000001,
+		PeriodStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		HoursWorked: 12.5,
+		GrossAmount: 250,
+		NetAmount:   250,
+		ProcessedAt: &processedAt,
+	}
+}
+
+func (s *PayslipTestSuite) TestMaskAccountNumber() {
+	s.Equal("****6789", aggregate.MaskAccountNumber("123456789"))
+	s.Equal("****", aggregate.MaskAccountNumber("1234"))
+	s.Equal("****", aggregate.MaskAccountNumber(""))
+}
+
+func (s *PayslipTestSuite) TestFilename() {
+	p := &aggregate.Payslip{Payment: samplePayment()}
+
+	s.Equal("payslip_816000001_2026-03-15.pdf", p.Filename())
+}
+
+func (s *PayslipTestSuite) TestRenderPayslipPDF() {
+	p := &aggregate.Payslip{
+		Payment:             samplePayment(),
+		StudentName:         "Jane (JD) Doe",
+		HourlyRate:          20,
+		BankName:            "Republic Bank",
+		BranchName:          "St. Augustine",
+		AccountType:         "savings",
+		MaskedAccountNumber: aggregate.MaskAccountNumber("123456789"),
+	}
+
+	out := service.RenderPayslipPDF(p)
+
+	s.True(bytes.HasPrefix(out, []byte("%PDF-1.4")))
+	s.True(bytes.HasSuffix(out, []byte("%%EOF\n")))
+	s.Contains(string(out), `Jane \(JD\) Doe`)
+	s.Contains(string(out), "$250.00")
+	s.Contains(string(out), "12.50")
+	s.Contains(string(out), "****6789")
+	s.NotContains(string(out), "123456789")
+}
+
+func (s *PayslipTestSuite) TestRenderPayslipPDF_NoBankingDetails() {
+	out := service.RenderPayslipPDF(&aggregate.Payslip{Payment: samplePayment(), StudentName: "Jane Doe", HourlyRate: 20})
+
+	s.Contains(string(out), "No banking details on file.")
+}
+
+func (s *PayslipTestSuite) TestRenderPayslipPDF_Adjustments() {
+	payment := samplePayment()
+	payment.GrossAmount = 300
+	payment.DeductionsAmount = 20
+	payment.NetAmount = 280
+	bonus, _ := aggregate.NewPaymentAdjustment(payment.StudentID, aggregate.AdjustmentType_Bonus, 50, "Exam week cover", nil)
+	deduction, _ := aggregate.NewPaymentAdjustment(payment.StudentID, aggregate.AdjustmentType_Deduction, 20, "Lost access card", nil)
+
+	out := string(service.RenderPayslipPDF(&aggregate.Payslip{
+		Payment:     payment,
+		StudentName: "Jane Doe",
+		HourlyRate:  20,
+		Adjustments: []*aggregate.PaymentAdjustment{bonus, deduction},
+	}))
+
+	s.Contains(out, "Bonus: Exam week cover")
+	s.Contains(out, "+50.00")
+	s.Contains(out, "Deduction: Lost access card")
+	s.Contains(out, "-20.00")
+	s.Contains(out, "$300.00")
+	s.Contains(out, "$280.00")
+}
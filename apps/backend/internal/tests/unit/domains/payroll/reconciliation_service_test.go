@@ -0,0 +1,162 @@
+package payroll_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/service"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	timesheetAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ReconciliationServiceTestSuite struct {
+	suite.Suite
+	scheduleRepo  *mocks.MockScheduleRepository
+	timeLogRepo   *mocks.MockTimeLogRepository
+	timesheetRepo *mocks.MockTimesheetRepository
+	timeOffRepo   *mocks.MockTimeOffRequestRepository
+	service       *service.ReconciliationService
+	monday        time.Time
+	logs          []*timelogAggregate.TimeLog
+	logFilter     timelogRepo.TimeLogFilter
+	sheetFilter   timesheetRepo.TimesheetFilter
+}
+
+func TestReconciliationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationServiceTestSuite))
+}
+
+func (s *ReconciliationServiceTestSuite) SetupTest() {
+	s.monday = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	s.logs = nil
+
+	assignments, _ := json.Marshal([]scheduleAggregate.Assignment{
+		{AssistantID: "816000001", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000002", ShiftID: "tue-am", DayOfWeek: 1, Start: "08:00:00", End: "10:00:00"},
+	})
+	s.scheduleRepo = &mocks.MockScheduleRepository{
+		GetActiveFn: func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+			return &scheduleAggregate.Schedule{ScheduleID: uuid.New(), Assignments: assignments, EffectiveFrom: s.monday.AddDate(0, -1, 0)}, nil
+		},
+	}
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx, _ timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+			next := s.monday.AddDate(0, 0, 7)
+			return []*timeoffAggregate.TimeOffRequest{{StudentID: 816000001, StartDate: next, EndDate: next, Status: timeoffAggregate.Status_Approved}}, nil
+		},
+	}
+	s.timeLogRepo = &mocks.MockTimeLogRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx, filter timelogRepo.TimeLogFilter) ([]*timelogAggregate.TimeLog, error) {
+			s.logFilter = filter
+			return s.logs, nil
+		},
+	}
+	s.timesheetRepo = &mocks.MockTimesheetRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx, filter timesheetRepo.TimesheetFilter) ([]*timesheetAggregate.Timesheet, error) {
+			s.sheetFilter = filter
+			return []*timesheetAggregate.Timesheet{{StudentID: 816000001, WeekStart: s.monday, Status: timesheetAggregate.Status_Approved}}, nil
+		},
+	}
+	paymentRepo := &mocks.MockPaymentRepository{
+		ListByPeriodFn: func(_ context.Context, _ *sql.Tx, _ repository.PaymentFilter) ([]*aggregate.Payment, error) {
+			return []*aggregate.Payment{}, nil
+		},
+	}
+	studentRepo := &mocks.MockStudentRepository{
+		ListByIDsFn: func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+			students := make([]*studentAggregate.Student, 0, len(ids))
+			for _, id := range ids {
+				students = append(students, &studentAggregate.Student{StudentID: id, FirstName: "Student", LastName: "Worker"})
+			}
+			return students, nil
+		},
+	}
+
+	locationRepo := &mocks.MockLocationRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.Location, error) {
+			return []*scheduleAggregate.Location{}, nil
+		},
+	}
+
+	s.service = service.NewReconciliationService(
+		zap.NewNop(), &mocks.StubTxManager{},
+		paymentRepo, studentRepo, s.scheduleRepo, locationRepo, s.timeLogRepo, s.timesheetRepo, s.timeOffRepo,
+	)
+}
+
+func (s *ReconciliationServiceTestSuite) query() aggregate.ReconciliationQuery {
+	return aggregate.ReconciliationQuery{PeriodStart: s.monday, PeriodEnd: s.monday.AddDate(0, 0, 13)}
+}
+
+func (s *ReconciliationServiceTestSuite) TestGetReconciliation() {
+	paid := 240.0
+	exit := s.monday.Add(12 * time.Hour)
+	s.logs = []*timelogAggregate.TimeLog{{ID: uuid.New(), StudentID: 816000001, EntryAt: s.monday.Add(8 * time.Hour), ExitAt: &exit, PaidMinutes: &paid}}
+
+	rows, err := s.service.GetReconciliation(adminContext(), s.query())
+	s.Require().NoError(err)
+
+	s.Equal(s.monday.AddDate(0, 0, 14), *s.logFilter.To)
+	s.Equal(s.monday, *s.sheetFilter.WeekFrom)
+
+	s.Require().Len(rows, 2)
+	s.Equal("Student Worker", rows[0].StudentName)
+	// The second Monday is on approved time off, so only the first is scheduled.
+	s.Equal(4.0, rows[0].ScheduledHours)
+	s.Equal(4.0, rows[0].PayableHours)
+	s.False(rows[0].HasDiscrepancies())
+
+	s.Equal(int32(816000002), rows[1].StudentID)
+	s.Equal(4.0, rows[1].ScheduledHours)
+	s.Len(rows[1].Discrepancies, 2)
+}
+
+func (s *ReconciliationServiceTestSuite) TestGetReconciliation_DiscrepanciesOnly() {
+	q := s.query()
+	q.DiscrepanciesOnly = true
+	paid := 240.0
+	exit := s.monday.Add(12 * time.Hour)
+	s.logs = []*timelogAggregate.TimeLog{{ID: uuid.New(), StudentID: 816000001, EntryAt: s.monday.Add(8 * time.Hour), ExitAt: &exit, PaidMinutes: &paid}}
+
+	rows, err := s.service.GetReconciliation(adminContext(), q)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	s.Equal(int32(816000002), rows[0].StudentID)
+}
+
+func (s *ReconciliationServiceTestSuite) TestGetReconciliation_NoActiveSchedule() {
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return nil, scheduleErrors.ErrNotFound
+	}
+
+	rows, err := s.service.GetReconciliation(adminContext(), s.query())
+	s.Require().NoError(err)
+	s.Empty(rows)
+}
+
+func (s *ReconciliationServiceTestSuite) TestGetReconciliation_Errors() {
+	_, err := s.service.GetReconciliation(context.Background(), s.query())
+	s.ErrorIs(err, payrollErrors.ErrMissingAuthContext)
+
+	q := s.query()
+	q.PeriodEnd = q.PeriodStart
+	_, err = s.service.GetReconciliation(adminContext(), q)
+	s.ErrorIs(err, payrollErrors.ErrInvalidPeriod)
+}
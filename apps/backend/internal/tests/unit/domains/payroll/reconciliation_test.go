@@ -0,0 +1,178 @@
+package payroll_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
+	payrollErrors "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/errors"
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconciliationTestSuite struct {
+	suite.Suite
+	monday time.Time
+}
+
+func TestReconciliationTestSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationTestSuite))
+}
+
+func (s *ReconciliationTestSuite) SetupTest() {
+	s.monday = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *ReconciliationTestSuite) closedLog(studentID int32, entry time.Time, paidMinutes float64) *timelogAggregate.TimeLog {
+	exit := entry.Add(time.Duration(paidMinutes) * time.Minute)
+	return &timelogAggregate.TimeLog{
+		ID:          uuid.New(),
+		StudentID:   studentID,
+		EntryAt:     entry,
+		ExitAt:      &exit,
+		PaidMinutes: &paidMinutes,
+	}
+}
+
+func (s *ReconciliationTestSuite) TestQueryValidate() {
+	q := aggregate.ReconciliationQuery{PeriodStart: s.monday.Add(10 * time.Hour), PeriodEnd: s.monday.AddDate(0, 0, 13)}
+	s.Require().NoError(q.Validate())
+	s.Equal(s.monday, q.PeriodStart)
+
+	q = aggregate.ReconciliationQuery{PeriodStart: s.monday, PeriodEnd: s.monday}
+	s.ErrorIs(q.Validate(), payrollErrors.ErrInvalidPeriod)
+
+	q = aggregate.ReconciliationQuery{PeriodStart: s.monday, PeriodEnd: s.monday.AddDate(0, 0, aggregate.MaxReconciliationDays)}
+	s.ErrorIs(q.Validate(), payrollErrors.ErrPeriodTooLong)
+
+	q = aggregate.ReconciliationQuery{PeriodStart: s.monday, PeriodEnd: s.monday.AddDate(0, 0, 6), ToleranceMinutes: -1}
+	s.ErrorIs(q.Validate(), payrollErrors.ErrInvalidTolerance)
+}
+
+func (s *ReconciliationTestSuite) TestScheduledShiftsFrom() {
+	dated := []scheduleAggregate.DatedShift{{
+		Assignment: scheduleAggregate.Assignment{AssistantID: "816000001", ShiftID: "wed-pm", DayOfWeek: 2, Start: "13:00", End: "14:30"},
+		StudentID:  816000001,
+		Date:       s.monday.AddDate(0, 0, 2),
+	}}
+
+	shifts := aggregate.ScheduledShiftsFrom(dated)
+
+	s.Equal([]aggregate.ScheduledShift{
+		{StudentID: 816000001, Date: s.monday.AddDate(0, 0, 2), ShiftID: "wed-pm", Start: "13:00", End: "14:30", Hours: 1.5},
+	}, shifts)
+}
+
+func (s *ReconciliationTestSuite) TestReconcile_ClassifiesLogs() {
+	counted := s.closedLog(816000001, s.monday.Add(8*time.Hour), 240)
+	flagged := s.closedLog(816000001, s.monday.AddDate(0, 0, 1).Add(8*time.Hour), 120)
+	flagged.IsFlagged = true
+	unapproved := s.closedLog(816000001, s.monday.AddDate(0, 0, 7).Add(8*time.Hour), 60)
+	open := &timelogAggregate.TimeLog{ID: uuid.New(), StudentID: 816000001, EntryAt: s.monday.AddDate(0, 0, 8).Add(9 * time.Hour)}
+
+	approved := aggregate.ApprovedWeeks{}
+	approved.Add(816000001, s.monday)
+
+	rows := aggregate.Reconcile(nil, []*timelogAggregate.TimeLog{counted, flagged, unapproved, open}, approved, nil, nil, 0)
+
+	s.Require().Len(rows, 1)
+	r := rows[0]
+	s.Equal(4.0, r.PayableHours)
+	s.Equal(2.0, r.FlaggedHours)
+	s.Equal(1.0, r.UnapprovedHours)
+	s.Equal(7.0, r.LoggedHours)
+	s.Equal(int32(1), r.OpenLogs)
+
+	statuses := make(map[uuid.UUID]aggregate.LogStatus)
+	for _, l := range r.Logs {
+		statuses[l.TimeLogID] = l.Status
+	}
+	s.Equal(map[uuid.UUID]aggregate.LogStatus{
+		counted.ID:    aggregate.LogStatus_Counted,
+		flagged.ID:    aggregate.LogStatus_Flagged,
+		unapproved.ID: aggregate.LogStatus_UnapprovedWeek,
+		open.ID:       aggregate.LogStatus_Open,
+	}, statuses)
+}
+
+func (s *ReconciliationTestSuite) TestReconcile_UsesLogLocationWeek() {
+	// 03:30 UTC on the second Monday is still Sunday of the approved week in
+	// Port of Spain, but already the unapproved next week in London.
+	entry := s.monday.AddDate(0, 0, 7).Add(3*time.Hour + 30*time.Minute)
+	london := &scheduleAggregate.Location{ID: uuid.New(), Timezone: "Europe/London"}
+	local := s.closedLog(816000001, entry, 60)
+	abroad := s.closedLog(816000001, entry, 60)
+	abroad.LocationID = &london.ID
+
+	approved := aggregate.ApprovedWeeks{}
+	approved.Add(816000001, s.monday)
+	zones := aggregate.TimezonesFrom([]*scheduleAggregate.Location{london})
+
+	rows := aggregate.Reconcile(nil, []*timelogAggregate.TimeLog{local, abroad}, approved, zones, nil, 0)
+
+	s.Require().Len(rows, 1)
+	statuses := make(map[uuid.UUID]aggregate.LogStatus)
+	for _, l := range rows[0].Logs {
+		statuses[l.TimeLogID] = l.Status
+	}
+	s.Equal(aggregate.LogStatus_Counted, statuses[local.ID])
+	s.Equal(aggregate.LogStatus_UnapprovedWeek, statuses[abroad.ID])
+}
+
+func (s *ReconciliationTestSuite) TestReconcile_DailyVariancesLinkLogs() {
+	shifts := []aggregate.ScheduledShift{
+		{StudentID: 816000001, Date: s.monday, ShiftID: "mon-am", Hours: 4},
+		{StudentID: 816000001, Date: s.monday.AddDate(0, 0, 1), ShiftID: "tue-am", Hours: 4},
+	}
+	short := s.closedLog(816000001, s.monday.Add(8*time.Hour), 230) // 10 minutes short
+	flagged := s.closedLog(816000001, s.monday.AddDate(0, 0, 1).Add(8*time.Hour), 240)
+	flagged.IsFlagged = true
+
+	approved := aggregate.ApprovedWeeks{}
+	approved.Add(816000001, s.monday)
+	logs := []*timelogAggregate.TimeLog{short, flagged}
+
+	rows := aggregate.Reconcile(shifts, logs, approved, nil, nil, 0)
+	s.Require().Len(rows, 1)
+	s.Equal(8.0, rows[0].ScheduledHours)
+	s.Equal(-4.17, rows[0].Variance)
+
+	d := rows[0].Discrepancies
+	s.Require().Len(d, 3)
+	s.Equal(aggregate.DiscrepancyCode_Variance, d[0].Code)
+	s.Equal(s.monday, *d[0].Date)
+	s.Equal(-0.17, d[0].Hours)
+	s.Equal([]uuid.UUID{short.ID}, d[0].TimeLogIDs)
+
+	s.Equal(aggregate.DiscrepancyCode_Variance, d[1].Code)
+	s.Equal(-4.0, d[1].Hours)
+	s.Empty(d[1].TimeLogIDs)
+	s.Equal(aggregate.DiscrepancyCode_Flagged, d[2].Code)
+	s.Equal([]uuid.UUID{flagged.ID}, d[2].TimeLogIDs)
+
+	// A 15 minute tolerance absorbs Monday's short log.
+	rows = aggregate.Reconcile(shifts, logs, approved, nil, nil, 15*time.Minute)
+	s.Len(rows[0].Discrepancies, 2)
+	s.Equal(s.monday.AddDate(0, 0, 1), *rows[0].Discrepancies[0].Date)
+}
+
+func (s *ReconciliationTestSuite) TestReconcile_PaymentMismatch() {
+	log := s.closedLog(816000002, s.monday.Add(8*time.Hour), 180)
+	approved := aggregate.ApprovedWeeks{}
+	approved.Add(816000002, s.monday)
+	payment := &aggregate.Payment{PaymentID: uuid.New(), StudentID: 816000002, HoursWorked: 3}
+
+	rows := aggregate.Reconcile(nil, []*timelogAggregate.TimeLog{log}, approved, nil, []*aggregate.Payment{payment}, 24*time.Hour)
+	s.Require().Len(rows, 1)
+	s.False(rows[0].HasDiscrepancies())
+	s.Same(payment, rows[0].Payment)
+
+	payment.HoursWorked = 5
+	rows = aggregate.Reconcile(nil, []*timelogAggregate.TimeLog{log}, approved, nil, []*aggregate.Payment{payment}, 24*time.Hour)
+	s.Require().Len(rows[0].Discrepancies, 1)
+	s.Equal(aggregate.DiscrepancyCode_PaymentMismatch, rows[0].Discrepancies[0].Code)
+	s.Nil(rows[0].Discrepancies[0].Date)
+	s.Equal(2.0, rows[0].Discrepancies[0].Hours)
+}
@@ -0,0 +1,157 @@
+package schedule_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type CourseHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockCourseService
+	router  *chi.Mux
+}
+
+func TestCourseHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseHandlerTestSuite))
+}
+
+func (s *CourseHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockCourseService{}
+	hdl := handler.NewCourseHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+	})
+}
+
+func (s *CourseHandlerTestSuite) doRequest(method, path, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *CourseHandlerTestSuite) doImport(csv string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", "courses.csv")
+	s.Require().NoError(err)
+	_, err = part.Write([]byte(csv))
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/courses/import", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *CourseHandlerTestSuite) TestCreate_Success() {
+	s.mockSvc.CreateFn = func(_ context.Context, c *aggregate.Course) (*aggregate.Course, error) {
+		return c, nil
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/courses", `{"code":"comp 1601","title":"Computer Programming I","department":"DCIT"}`)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("COMP1601", resp["code"])
+	s.Equal(float64(1), resp["level"])
+}
+
+func (s *CourseHandlerTestSuite) TestCreate_InvalidCode() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/courses", `{"code":"1601","title":"Computer Programming I"}`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseHandlerTestSuite) TestCreate_Duplicate() {
+	s.mockSvc.CreateFn = func(_ context.Context, _ *aggregate.Course) (*aggregate.Course, error) {
+		return nil, scheduleErrors.ErrCourseExists
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/courses", `{"code":"COMP1601","title":"Computer Programming I"}`)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *CourseHandlerTestSuite) TestImport_Success() {
+	s.mockSvc.ImportFn = func(_ context.Context, r io.Reader) (*service.CourseImportResult, error) {
+		data, err := io.ReadAll(r)
+		s.Require().NoError(err)
+		s.Contains(string(data), "COMP1601")
+		return &service.CourseImportResult{Created: 1}, nil
+	}
+
+	rr := s.doImport("code,title\nCOMP1601,Computer Programming I\n")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal(float64(1), resp["created"])
+	s.Equal([]any{}, resp["errors"])
+}
+
+func (s *CourseHandlerTestSuite) TestImport_RowErrors() {
+	s.mockSvc.ImportFn = func(_ context.Context, _ io.Reader) (*service.CourseImportResult, error) {
+		return &service.CourseImportResult{Errors: []service.CourseImportError{{Line: 2, Code: "1601", Error: "bad code"}}}, nil
+	}
+
+	rr := s.doImport("code,title\n1601,Computer Programming I\n")
+
+	s.Equal(http.StatusUnprocessableEntity, rr.Code)
+}
+
+func (s *CourseHandlerTestSuite) TestImport_InvalidFile() {
+	s.mockSvc.ImportFn = func(_ context.Context, _ io.Reader) (*service.CourseImportResult, error) {
+		return nil, scheduleErrors.ErrInvalidCourseImport
+	}
+
+	rr := s.doImport("nonsense")
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseHandlerTestSuite) TestImport_MissingFile() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/courses/import", `{}`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseHandlerTestSuite) TestUnknownCodes() {
+	s.mockSvc.UnknownCodesFn = func(_ context.Context) ([]service.UnknownCourseCode, error) {
+		return []service.UnknownCourseCode{{Code: "MATH1115", StudentIDs: []int32{1}, ShiftTemplates: []string{}}}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/courses/unknown-codes", "")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal("MATH1115", resp[0]["code"])
+}
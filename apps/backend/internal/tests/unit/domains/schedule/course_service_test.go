@@ -0,0 +1,218 @@
+package schedule_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	transcriptTypes "github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type CourseServiceTestSuite struct {
+	suite.Suite
+	repo         *mocks.MockCourseRepository
+	templateRepo *mocks.MockShiftTemplateRepository
+	studentRepo  *mocks.MockStudentRepository
+	service      service.CourseServiceInterface
+	authCtx      context.Context
+	existing     []*aggregate.Course
+}
+
+func TestCourseServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseServiceTestSuite))
+}
+
+func (s *CourseServiceTestSuite) SetupTest() {
+	comp1601, _ := aggregate.NewCourse("COMP1601", "Computer Programming I", "DCIT", 0)
+	comp1602, _ := aggregate.NewCourse("COMP1602", "Computer Programming II", "DCIT", 0)
+	s.existing = []*aggregate.Course{comp1601, comp1602}
+
+	s.repo = &mocks.MockCourseRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.Course, error) {
+			return s.existing, nil
+		},
+	}
+	s.templateRepo = &mocks.MockShiftTemplateRepository{}
+	s.studentRepo = &mocks.MockStudentRepository{}
+	s.service = service.NewCourseService(zap.NewNop(), s.repo, s.templateRepo, s.studentRepo, &mocks.StubTxManager{})
+	s.authCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+// --- Create ---
+
+func (s *CourseServiceTestSuite) TestCreate_Duplicate() {
+	s.repo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, code string) (*aggregate.Course, error) {
+		s.Equal("COMP1601", code)
+		return s.existing[0], nil
+	}
+	c, _ := aggregate.NewCourse("comp1601", "Programming", "", 0)
+
+	result, err := s.service.Create(s.authCtx, c)
+
+	s.ErrorIs(err, scheduleErrors.ErrCourseExists)
+	s.Nil(result)
+}
+
+func (s *CourseServiceTestSuite) TestCreate_Success() {
+	s.repo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.Course, error) {
+		return nil, scheduleErrors.ErrCourseNotFound
+	}
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, c *aggregate.Course) (*aggregate.Course, error) {
+		return c, nil
+	}
+	c, _ := aggregate.NewCourse("COMP2611", "Data Structures", "DCIT", 0)
+
+	result, err := s.service.Create(s.authCtx, c)
+
+	s.Require().NoError(err)
+	s.Equal("COMP2611", result.Code)
+}
+
+// --- Import ---
+
+func (s *CourseServiceTestSuite) TestImport_UpsertsByCode() {
+	var created []*aggregate.Course
+	var updated []*aggregate.Course
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, c *aggregate.Course) (*aggregate.Course, error) {
+		created = append(created, c)
+		return c, nil
+	}
+	s.repo.UpdateFn = func(_ context.Context, _ *sql.Tx, c *aggregate.Course) error {
+		updated = append(updated, c)
+		return nil
+	}
+
+	csv := "Code,Title,Department,Level,is_active\n" +
+		"comp 1601,Computer Programming I,DCIT,,\n" +
+		"COMP1602,Programming II,DCIT,1,false\n" +
+		"INFO3604,Project,DCIT,,true\n" +
+		"\n"
+
+	result, err := s.service.Import(s.authCtx, strings.NewReader(csv))
+
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.Equal(1, result.Created)
+	s.Equal(1, result.Updated)
+	s.Equal(1, result.Unchanged)
+
+	s.Require().Len(created, 1)
+	s.Equal("INFO3604", created[0].Code)
+	s.Equal(int32(3), created[0].Level)
+
+	s.Require().Len(updated, 1)
+	s.Equal("COMP1602", updated[0].Code)
+	s.Equal("Programming II", updated[0].Title)
+	s.False(updated[0].IsActive)
+}
+
+func (s *CourseServiceTestSuite) TestImport_RowErrorsWriteNothing() {
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, _ *aggregate.Course) (*aggregate.Course, error) {
+		s.Fail("no course should be created when a row is invalid")
+		return nil, nil
+	}
+
+	csv := "code,title,level\n" +
+		"INFO3604,Project,\n" +
+		"1234,Bad code,\n" +
+		"INFO 3604,Duplicate,\n" +
+		"COMP3613,Software Engineering II,x\n"
+
+	result, err := s.service.Import(s.authCtx, strings.NewReader(csv))
+
+	s.Require().NoError(err)
+	s.Require().Len(result.Errors, 3)
+	s.Equal(3, result.Errors[0].Line)
+	s.Equal(4, result.Errors[1].Line)
+	s.Contains(result.Errors[1].Error, "duplicate of line 2")
+	s.Equal(5, result.Errors[2].Line)
+	s.Zero(result.Created)
+}
+
+func (s *CourseServiceTestSuite) TestImport_MissingColumn() {
+	_, err := s.service.Import(s.authCtx, strings.NewReader("code,department\nCOMP1601,DCIT\n"))
+
+	s.ErrorIs(err, scheduleErrors.ErrInvalidCourseImport)
+	s.Contains(err.Error(), "title")
+}
+
+func (s *CourseServiceTestSuite) TestImport_MissingAuthContext() {
+	_, err := s.service.Import(context.Background(), strings.NewReader("code,title\n"))
+
+	s.ErrorIs(err, scheduleErrors.ErrMissingAuthContext)
+}
+
+// --- Unknown codes and transcript normalisation ---
+
+func courseStudent(id int32, codes ...string) *studentAggregate.Student {
+	courses := make([]transcriptTypes.CourseResult, len(codes))
+	for i, c := range codes {
+		courses[i] = transcriptTypes.CourseResult{Code: c, Title: "Transcript title"}
+	}
+	return &studentAggregate.Student{
+		StudentID:          id,
+		TranscriptMetadata: transcriptTypes.TranscriptMetadata{Courses: courses},
+	}
+}
+
+func (s *CourseServiceTestSuite) TestUnknownCodes() {
+	s.existing[1].Deactivate()
+	s.studentRepo.ListFn = func(_ context.Context, _ *sql.Tx) ([]*studentAggregate.Student, error) {
+		return []*studentAggregate.Student{
+			courseStudent(1, "COMP1601", "MATH1115"),
+			courseStudent(2, "math 1115", "COMP1602"),
+		}, nil
+	}
+	s.templateRepo.ListAllFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.ShiftTemplate, error) {
+		return []*aggregate.ShiftTemplate{
+			{Name: "Mon 09:00", CourseDemands: []aggregate.CourseDemand{{CourseCode: "COMP1601"}, {CourseCode: "COMP1602"}}},
+			{Name: "Tue 09:00", CourseDemands: []aggregate.CourseDemand{{CourseCode: "comp 1603"}}},
+		}, nil
+	}
+
+	result, err := s.service.UnknownCodes(s.authCtx)
+
+	s.Require().NoError(err)
+	s.Require().Len(result, 3)
+	s.Equal("COMP1602", result[0].Code)
+	s.Empty(result[0].StudentIDs, "inactive courses are known for transcripts")
+	s.Equal([]string{"Mon 09:00"}, result[0].ShiftTemplates)
+	s.Equal("COMP1603", result[1].Code)
+	s.Equal([]string{"Tue 09:00"}, result[1].ShiftTemplates)
+	s.Equal("MATH1115", result[2].Code)
+	s.Equal([]int32{1, 2}, result[2].StudentIDs)
+}
+
+func (s *CourseServiceTestSuite) TestNormalizeTranscripts_UpdatesChangedOnly() {
+	s.studentRepo.ListFn = func(_ context.Context, _ *sql.Tx) ([]*studentAggregate.Student, error) {
+		return []*studentAggregate.Student{
+			courseStudent(1, "COMP1601"),
+			courseStudent(2, "comp 1602"),
+		}, nil
+	}
+	var updated []int32
+	s.studentRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, st *studentAggregate.Student) error {
+		updated = append(updated, st.StudentID)
+		s.Equal("COMP1602", st.TranscriptMetadata.Courses[0].Code)
+		return nil
+	}
+
+	count, err := s.service.NormalizeTranscripts(s.authCtx)
+
+	s.Require().NoError(err)
+	s.Equal(1, count)
+	s.Equal([]int32{2}, updated)
+}
@@ -0,0 +1,90 @@
+package schedule_test
+
+import (
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type CourseAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestCourseAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseAggregateTestSuite))
+}
+
+func (s *CourseAggregateTestSuite) TestNewCourse_NormalisesCode() {
+	c, err := aggregate.NewCourse(" comp 1601 ", "  Computer Programming I ", " DCIT ", 0)
+
+	s.Require().NoError(err)
+	s.Equal("COMP1601", c.Code)
+	s.Equal("Computer Programming I", c.Title)
+	s.Equal("DCIT", c.Department)
+	s.Equal(int32(1), c.Level, "level is taken from the course number")
+	s.True(c.IsActive)
+}
+
+func (s *CourseAggregateTestSuite) TestNewCourse_ExplicitLevel() {
+	c, err := aggregate.NewCourse("INFO3604", "Project", "DCIT", 3)
+
+	s.Require().NoError(err)
+	s.Equal(int32(3), c.Level)
+}
+
+func (s *CourseAggregateTestSuite) TestNewCourse_Validation() {
+	_, err := aggregate.NewCourse("1601", "Programming", "", 0)
+	s.ErrorIs(err, errors.ErrInvalidCourseCode)
+
+	_, err = aggregate.NewCourse("COMP-1601", "Programming", "", 0)
+	s.ErrorIs(err, errors.ErrInvalidCourseCode)
+
+	_, err = aggregate.NewCourse("COMP1601", "  ", "", 0)
+	s.ErrorIs(err, errors.ErrInvalidCourseTitle)
+
+	_, err = aggregate.NewCourse("COMP0601", "Programming", "", 0)
+	s.ErrorIs(err, errors.ErrInvalidCourseLevel)
+
+	_, err = aggregate.NewCourse("COMP1601", "Programming", "", 10)
+	s.ErrorIs(err, errors.ErrInvalidCourseLevel)
+}
+
+func (s *CourseAggregateTestSuite) catalogue() *aggregate.CourseCatalogue {
+	active, _ := aggregate.NewCourse("COMP1601", "Computer Programming I", "DCIT", 0)
+	inactive, _ := aggregate.NewCourse("COMP1000", "Retired Course", "DCIT", 0)
+	inactive.Deactivate()
+	return aggregate.NewCourseCatalogue([]*aggregate.Course{active, inactive})
+}
+
+func (s *CourseAggregateTestSuite) TestResolveDemands() {
+	demands, unknown := s.catalogue().ResolveDemands([]aggregate.CourseDemand{
+		{CourseCode: "comp 1601", TutorsRequired: 2, Weight: 1},
+		{CourseCode: "COMP1000", TutorsRequired: 1, Weight: 1},
+		{CourseCode: "COMP1602", TutorsRequired: 1, Weight: 1},
+	})
+
+	s.Equal("COMP1601", demands[0].CourseCode)
+	s.Equal(2, demands[0].TutorsRequired)
+	s.Equal([]string{"COMP1000", "COMP1602"}, unknown)
+}
+
+func (s *CourseAggregateTestSuite) TestNormalizeTranscript() {
+	grade := "A"
+	courses, unknown := s.catalogue().NormalizeTranscript([]types.CourseResult{
+		{Code: "Comp 1601", Title: "", Grade: &grade},
+		{Code: "COMP1000", Title: "Old title"},
+		{Code: "math 1115", Title: "Fundamental Mathematics"},
+	})
+
+	s.Require().Len(courses, 3)
+	s.Equal("COMP1601", courses[0].Code)
+	s.Equal("Computer Programming I", courses[0].Title, "missing titles come from the catalogue")
+	s.Equal(&grade, courses[0].Grade)
+	s.Equal("COMP1000", courses[1].Code)
+	s.Equal("Old title", courses[1].Title, "transcript titles are kept")
+	s.Equal("MATH1115", courses[2].Code)
+	s.Equal([]string{"MATH1115"}, unknown, "inactive courses are still known")
+}
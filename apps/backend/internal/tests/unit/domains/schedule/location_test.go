@@ -0,0 +1,82 @@
+package schedule_test
+
+import (
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestLocationAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(LocationAggregateTestSuite))
+}
+
+func (s *LocationAggregateTestSuite) TestNewLocation_Defaults() {
+	loc, err := aggregate.NewLocation("  Main Library  ", 10.642707, -61.277001, 0, nil, "")
+
+	s.Require().NoError(err)
+	s.NotEqual(uuid.Nil, loc.ID)
+	s.Equal("Main Library", loc.Name)
+	s.Equal(float64(aggregate.DefaultGeofenceRadiusMeters), loc.RadiusMeters)
+	s.Equal(aggregate.DefaultLocationTimezone, loc.Timezone)
+	s.True(loc.IsActive)
+	s.False(loc.HasPolygon())
+}
+
+func (s *LocationAggregateTestSuite) TestNewLocation_WithPolygon() {
+	polygon := []aggregate.GeoPoint{{-61.2775, 10.6415}, {-61.2765, 10.6415}, {-61.2765, 10.6425}}
+
+	loc, err := aggregate.NewLocation("Engineering", 10.642, -61.277, 50, polygon, "America/Port_of_Spain")
+
+	s.Require().NoError(err)
+	s.True(loc.HasPolygon())
+	s.Equal(10.6415, loc.Geofence[0].Latitude())
+	s.Equal(-61.2775, loc.Geofence[0].Longitude())
+}
+
+func (s *LocationAggregateTestSuite) TestNewLocation_Validation() {
+	cases := []struct {
+		name     string
+		locName  string
+		lat, lon float64
+		radius   float64
+		polygon  []aggregate.GeoPoint
+		tz       string
+		expected error
+	}{
+		{"empty name", " ", 10, -61, 100, nil, "", errors.ErrInvalidLocationName},
+		{"latitude out of range", "Desk", 91, -61, 100, nil, "", errors.ErrInvalidCoordinates},
+		{"longitude out of range", "Desk", 10, -181, 100, nil, "", errors.ErrInvalidCoordinates},
+		{"negative radius", "Desk", 10, -61, -5, nil, "", errors.ErrInvalidGeofenceRadius},
+		{"too few polygon points", "Desk", 10, -61, 100, []aggregate.GeoPoint{{-61, 10}, {-61.1, 10}}, "", errors.ErrInvalidGeofencePolygon},
+		{"polygon point out of range", "Desk", 10, -61, 100, []aggregate.GeoPoint{{-61, 10}, {-61.1, 10}, {200, 10}}, "", errors.ErrInvalidGeofencePolygon},
+		{"unknown timezone", "Desk", 10, -61, 100, nil, "Mars/Olympus_Mons", errors.ErrInvalidLocationTimezone},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			loc, err := aggregate.NewLocation(tc.locName, tc.lat, tc.lon, tc.radius, tc.polygon, tc.tz)
+			s.ErrorIs(err, tc.expected)
+			s.Nil(loc)
+		})
+	}
+}
+
+func (s *LocationAggregateTestSuite) TestModelRoundTrip() {
+	polygon := []aggregate.GeoPoint{{-61.2775, 10.6415}, {-61.2765, 10.6415}, {-61.2765, 10.6425}}
+	loc, err := aggregate.NewLocation("Engineering", 10.642, -61.277, 50, polygon, "UTC")
+	s.Require().NoError(err)
+
+	m := loc.ToModel()
+	s.Require().NotNil(m.Geofence)
+
+	restored := aggregate.LocationFromModel(m)
+	s.Equal(loc.Geofence, restored.Geofence)
+	s.Equal("UTC", restored.TimeLocation().String())
+}
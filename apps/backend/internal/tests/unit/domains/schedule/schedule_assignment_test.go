@@ -0,0 +1,148 @@
+package schedule_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleAssignmentTestSuite struct {
+	suite.Suite
+	monday time.Time
+}
+
+func TestScheduleAssignmentTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleAssignmentTestSuite))
+}
+
+func (s *ScheduleAssignmentTestSuite) SetupTest() {
+	s.monday = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestParseAssignments() {
+	assignments, err := aggregate.ParseAssignments(json.RawMessage(`[{"assistant_id":"816000001","shift_id":"mon-am","day_of_week":0,"start":"08:00:00","end":"12:00:00"}]`))
+	s.Require().NoError(err)
+	s.Equal([]aggregate.Assignment{{AssistantID: "816000001", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"}}, assignments)
+
+	assignments, err = aggregate.ParseAssignments(nil)
+	s.Require().NoError(err)
+	s.Empty(assignments)
+
+	_, err = aggregate.ParseAssignments(json.RawMessage(`{`))
+	s.Error(err)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestParseClock() {
+	d, ok := aggregate.ParseClock("13:30:15")
+	s.True(ok)
+	s.Equal(13*time.Hour+30*time.Minute+15*time.Second, d)
+
+	d, ok = aggregate.ParseClock("08:05")
+	s.True(ok)
+	s.Equal(8*time.Hour+5*time.Minute, d)
+
+	_, ok = aggregate.ParseClock("8am")
+	s.False(ok)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestAssignment_HoursAndStudentID() {
+	a := aggregate.Assignment{AssistantID: "816000001", Start: "13:00", End: "14:30:00"}
+	s.Equal(1.5, a.Hours())
+	id, ok := a.StudentID()
+	s.True(ok)
+	s.Equal(int32(816000001), id)
+
+	// Shifts spanning midnight and unreadable times count for nothing.
+	s.Zero(aggregate.Assignment{Start: "22:00", End: "02:00"}.Hours())
+	s.Zero(aggregate.Assignment{Start: "noon", End: "14:00"}.Hours())
+
+	_, ok = aggregate.Assignment{AssistantID: "not-a-student"}.StudentID()
+	s.False(ok)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestAssignment_At() {
+	tz := time.FixedZone("AST", -4*60*60)
+	a := aggregate.Assignment{Start: "08:00:00", End: "12:00:00"}
+
+	start, end, ok := a.At(s.monday, tz)
+
+	s.True(ok)
+	s.Equal(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), start)
+	s.Equal(time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC), end)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestDatedAssignments_Expand() {
+	assignments := []aggregate.Assignment{
+		{AssistantID: "816000001", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000002", ShiftID: "wed-pm", DayOfWeek: 2, Start: "13:00", End: "14:30"},
+		{AssistantID: "not-a-student", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+	}
+	effectiveTo := s.monday.AddDate(0, 0, 9) // Wednesday of the second week
+	skip := func(studentID int32, day time.Time) bool {
+		return studentID == 816000001 && day.Equal(s.monday.AddDate(0, 0, 7))
+	}
+
+	shifts := aggregate.DatedAssignments{Weekly: assignments}.Expand(s.monday.AddDate(0, 0, -30), &effectiveTo, s.monday, s.monday.AddDate(0, 0, 13), skip)
+
+	s.Require().Len(shifts, 3)
+	s.Equal(aggregate.DatedShift{Assignment: assignments[0], StudentID: 816000001, Date: s.monday}, shifts[0])
+	s.Equal(s.monday.AddDate(0, 0, 2), shifts[1].Date)
+	s.Equal(1.5, shifts[1].Hours())
+	s.Equal(s.monday.AddDate(0, 0, 9), shifts[2].Date)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestDatedAssignments_Expand_ClipsToEffectiveFrom() {
+	assignments := []aggregate.Assignment{
+		{AssistantID: "816000001", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+	}
+
+	shifts := aggregate.DatedAssignments{Weekly: assignments}.Expand(s.monday.AddDate(0, 0, 1), nil, s.monday, s.monday.AddDate(0, 0, 13), nil)
+
+	s.Require().Len(shifts, 1)
+	s.Equal(s.monday.AddDate(0, 0, 7), shifts[0].Date)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestDatedAssignments_Expand_OverrideReplacesWeeklyAssignments() {
+	weekly := []aggregate.Assignment{
+		{AssistantID: "816000001", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+		{AssistantID: "816000001", ShiftID: "tue-am", DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+	}
+	cover := aggregate.Assignment{AssistantID: "816000002", ShiftID: "mon-am", DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"}
+	dated := aggregate.DatedAssignments{
+		Weekly: weekly,
+		// 816000001 is away on the second Monday only.
+		Overrides: []aggregate.AssignmentOverride{{From: "2026-03-09", To: "2026-03-09", Assignments: []aggregate.Assignment{cover}}},
+	}
+
+	shifts := dated.Expand(s.monday, nil, s.monday, s.monday.AddDate(0, 0, 13), nil)
+
+	s.Require().Len(shifts, 4)
+	s.Equal(int32(816000001), shifts[0].StudentID)
+	s.Equal(s.monday, shifts[0].Date)
+	s.Equal(int32(816000001), shifts[1].StudentID)
+	s.Equal(aggregate.DatedShift{Assignment: cover, StudentID: 816000002, Date: s.monday.AddDate(0, 0, 7)}, shifts[2])
+	s.Equal("tue-am", shifts[3].ShiftID)
+	s.Equal(s.monday.AddDate(0, 0, 8), shifts[3].Date)
+}
+
+func (s *ScheduleAssignmentTestSuite) TestSchedule_AssignmentsOn() {
+	schedule, err := aggregate.NewSchedule("Term", s.monday, nil)
+	s.Require().NoError(err)
+	schedule.Assignments = json.RawMessage(`[{"assistant_id":"816000001","shift_id":"mon-am","day_of_week":0,"start":"08:00:00","end":"12:00:00"}]`)
+	schedule.AssignmentOverrides = json.RawMessage(`[{"from":"2026-03-09","to":"2026-03-13","assignments":[]}]`)
+
+	assignments, err := schedule.AssignmentsOn(s.monday)
+	s.Require().NoError(err)
+	s.Len(assignments, 1)
+
+	assignments, err = schedule.AssignmentsOn(time.Date(2026, 3, 9, 15, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Empty(assignments)
+
+	schedule.AssignmentOverrides = json.RawMessage(`{`)
+	_, err = schedule.AssignmentsOn(s.monday)
+	s.Error(err)
+}
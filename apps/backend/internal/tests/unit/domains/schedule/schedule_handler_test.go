@@ -28,9 +28,11 @@ import (
 
 type ScheduleHandlerTestSuite struct {
 	suite.Suite
-	mockSvc        *mocks.MockScheduleService
-	mockStudentSvc *mocks.MockStudentService
-	router         *chi.Mux
+	mockSvc         *mocks.MockScheduleService
+	mockStudentSvc  *mocks.MockStudentService
+	mockEligibility *mocks.MockCourseEligibilityService
+	mockTraining    *mocks.MockTrainingService
+	router          *chi.Mux
 }
 
 func TestScheduleHandlerTestSuite(t *testing.T) {
@@ -40,7 +42,22 @@ func TestScheduleHandlerTestSuite(t *testing.T) {
 func (s *ScheduleHandlerTestSuite) SetupTest() {
 	s.mockSvc = &mocks.MockScheduleService{}
 	s.mockStudentSvc = &mocks.MockStudentService{}
-	hdl := handler.NewScheduleHandler(zap.NewNop(), s.mockSvc, s.mockStudentSvc, nil, nil, "")
+	s.mockEligibility = &mocks.MockCourseEligibilityService{
+		EvaluateFn: func(_ context.Context, students []*studentAggregate.Student) ([]*studentAggregate.StudentEligibility, error) {
+			policy := studentAggregate.NewEligibilityPolicy(nil)
+			rows := make([]*studentAggregate.StudentEligibility, len(students))
+			for i, st := range students {
+				rows[i] = policy.Evaluate(st, nil)
+			}
+			return rows, nil
+		},
+	}
+	s.mockTraining = &mocks.MockTrainingService{
+		MissingBlockingFn: func(_ context.Context, _ []int32, _ time.Time) (map[int32][]*studentAggregate.TrainingRequirement, error) {
+			return map[int32][]*studentAggregate.TrainingRequirement{}, nil
+		},
+	}
+	hdl := handler.NewScheduleHandler(zap.NewNop(), s.mockSvc, s.mockStudentSvc, s.mockEligibility, s.mockTraining, nil, nil, "")
 	s.router = chi.NewRouter()
 	s.router.Route("/api/v1", func(r chi.Router) {
 		// Admin-only routes (behind permission middleware)
@@ -378,6 +395,134 @@ func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_Success() {
 	s.Equal("pending", resp["status"])
 }
 
+func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_SendsOnlyEligibleCourses() {
+	pass, fail := "B", "D"
+	s.mockStudentSvc.GetByIDFn = func(_ context.Context, _ int32) (*studentAggregate.Student, error) {
+		st := testStudent()
+		st.TranscriptMetadata.Courses = []transcriptTypes.CourseResult{
+			{Code: "COMP1601", Grade: &pass},
+			{Code: "COMP1602", Grade: &fail},
+		}
+		return st, nil
+	}
+	s.mockSvc.GenerateScheduleFn = func(_ context.Context, params service.GenerateScheduleParams) (*aggregate.ScheduleGeneration, error) {
+		s.Require().Len(params.Assistants, 1)
+		s.Equal([]string{"COMP1601"}, params.Assistants[0].Courses)
+		return &aggregate.ScheduleGeneration{ID: uuid.New(), Status: aggregate.GenerationStatus_Pending}, nil
+	}
+
+	rr := s.doRequest("POST", "/api/v1/schedules/generate", `{
+		"config_id": "44444444-4444-4444-4444-444444444444",
+		"title": "Generated Schedule",
+		"effective_from": "2025-09-01",
+		"student_ids": ["100"]
+	}`)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_EligibilityError() {
+	s.setupStudentMock()
+	s.mockEligibility.EvaluateFn = func(_ context.Context, _ []*studentAggregate.Student) ([]*studentAggregate.StudentEligibility, error) {
+		return nil, context.DeadlineExceeded
+	}
+
+	rr := s.doRequest("POST", "/api/v1/schedules/generate", `{
+		"config_id": "44444444-4444-4444-4444-444444444444",
+		"title": "Generated Schedule",
+		"effective_from": "2025-09-01",
+		"student_ids": ["100"]
+	}`)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+// setupTwoStudents returns students 100 and 101; 101 is missing the privacy
+// module.
+func (s *ScheduleHandlerTestSuite) setupTwoStudents() {
+	s.mockStudentSvc.GetByIDFn = func(_ context.Context, studentID int32) (*studentAggregate.Student, error) {
+		st := testStudent()
+		st.StudentID = studentID
+		return st, nil
+	}
+	s.mockTraining.MissingBlockingFn = func(_ context.Context, ids []int32, asOf time.Time) (map[int32][]*studentAggregate.TrainingRequirement, error) {
+		s.Equal([]int32{100, 101}, ids)
+		s.Equal("2025-09-01", asOf.Format("2006-01-02"))
+		return map[int32][]*studentAggregate.TrainingRequirement{
+			101: {{Name: "Privacy Module", IsBlocking: true, IsActive: true}},
+		}, nil
+	}
+}
+
+func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_ExcludesUntrained() {
+	s.setupTwoStudents()
+	s.mockSvc.GenerateScheduleFn = func(_ context.Context, params service.GenerateScheduleParams) (*aggregate.ScheduleGeneration, error) {
+		s.Require().Len(params.Assistants, 1)
+		s.Equal("100", params.Assistants[0].ID)
+		return &aggregate.ScheduleGeneration{ID: uuid.New(), Status: aggregate.GenerationStatus_Pending}, nil
+	}
+
+	rr := s.doRequest("POST", "/api/v1/schedules/generate", `{
+		"config_id": "44444444-4444-4444-4444-444444444444",
+		"title": "Generated Schedule",
+		"effective_from": "2025-09-01",
+		"student_ids": ["100", "101"]
+	}`)
+
+	s.Require().Equal(http.StatusAccepted, rr.Code, rr.Body.String())
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("pending", resp["status"])
+	warnings := resp["training_warnings"].([]any)
+	s.Require().Len(warnings, 1)
+	warning := warnings[0].(map[string]any)
+	s.Equal("101", warning["student_id"])
+	s.Equal([]any{"Privacy Module"}, warning["missing_training"])
+	s.Equal(true, warning["excluded"])
+}
+
+func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_IncludeUntrainedWarns() {
+	s.setupTwoStudents()
+	s.mockSvc.GenerateScheduleFn = func(_ context.Context, params service.GenerateScheduleParams) (*aggregate.ScheduleGeneration, error) {
+		s.Len(params.Assistants, 2)
+		return &aggregate.ScheduleGeneration{ID: uuid.New(), Status: aggregate.GenerationStatus_Pending}, nil
+	}
+
+	rr := s.doRequest("POST", "/api/v1/schedules/generate", `{
+		"config_id": "44444444-4444-4444-4444-444444444444",
+		"title": "Generated Schedule",
+		"effective_from": "2025-09-01",
+		"student_ids": ["100", "101"],
+		"include_untrained": true
+	}`)
+
+	s.Require().Equal(http.StatusAccepted, rr.Code, rr.Body.String())
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	warnings := resp["training_warnings"].([]any)
+	s.Require().Len(warnings, 1)
+	s.Equal(false, warnings[0].(map[string]any)["excluded"])
+}
+
+func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_AllUntrained() {
+	s.setupStudentMock()
+	s.mockTraining.MissingBlockingFn = func(_ context.Context, _ []int32, _ time.Time) (map[int32][]*studentAggregate.TrainingRequirement, error) {
+		return map[int32][]*studentAggregate.TrainingRequirement{
+			100: {{Name: "Orientation", IsBlocking: true, IsActive: true}},
+		}, nil
+	}
+
+	rr := s.doRequest("POST", "/api/v1/schedules/generate", `{
+		"config_id": "44444444-4444-4444-4444-444444444444",
+		"title": "Generated Schedule",
+		"effective_from": "2025-09-01",
+		"student_ids": ["100"]
+	}`)
+
+	s.Equal(http.StatusUnprocessableEntity, rr.Code)
+	s.Contains(rr.Body.String(), "Orientation")
+}
+
 func (s *ScheduleHandlerTestSuite) TestGenerateSchedule_InvalidBody() {
 	rr := s.doRequest("POST", "/api/v1/schedules/generate", `not json`)
 
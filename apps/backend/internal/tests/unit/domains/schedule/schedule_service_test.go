@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	budgetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/budget/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/service"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/scheduler/types"
 	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
@@ -25,6 +28,8 @@ type ScheduleServiceTestSuite struct {
 	jobEnqueuer        *mocks.MockJobEnqueuer
 	shiftTemplateSvc   *mocks.MockShiftTemplateService
 	schedulerConfigSvc *mocks.MockSchedulerConfigService
+	timeOffRepo        *mocks.MockTimeOffRequestRepository
+	budgetSvc          *mocks.MockBudgetService
 	service            service.ScheduleServiceInterface
 	authCtx            context.Context
 	userID             uuid.UUID
@@ -42,8 +47,14 @@ func (s *ScheduleServiceTestSuite) SetupTest() {
 	s.jobEnqueuer = &mocks.MockJobEnqueuer{}
 	s.shiftTemplateSvc = &mocks.MockShiftTemplateService{}
 	s.schedulerConfigSvc = &mocks.MockSchedulerConfigService{}
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx, _ timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+			return nil, nil
+		},
+	}
+	s.budgetSvc = &mocks.MockBudgetService{}
 	s.userID = uuid.New()
-	svc := service.NewScheduleService(zap.NewNop(), s.repo, &mocks.StubTxManager{}, s.generationSvc, s.jobEnqueuer, s.shiftTemplateSvc, s.schedulerConfigSvc)
+	svc := service.NewScheduleService(zap.NewNop(), s.repo, &mocks.StubTxManager{}, s.generationSvc, s.jobEnqueuer, s.shiftTemplateSvc, s.schedulerConfigSvc, s.timeOffRepo, s.budgetSvc)
 	s.service = svc
 	s.authCtx = database.WithAuthContext(context.Background(), database.AuthContext{
 		UserID: s.userID.String(),
@@ -343,6 +354,50 @@ func (s *ScheduleServiceTestSuite) TestGenerateSchedule_Success() {
 	s.Equal(float32(100.0), enqueuedArgs.RequestPayload.SchedulerConfig.UnderstaffedPenalty)
 }
 
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_WithBudgetSetsCostsAndCeiling() {
+	params := s.newGenerateParams()
+	budgetID := uuid.New()
+	params.BudgetID = &budgetID
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.setupGenerationMocks(uuid.New())
+	s.budgetSvc.CostCeilingFn = func(_ context.Context, id uuid.UUID, from time.Time, to *time.Time) (float64, float64, error) {
+		s.Equal(budgetID, id)
+		s.Equal(params.EffectiveFrom, from)
+		s.Nil(to)
+		return 20, 1500, nil
+	}
+
+	var enqueuedArgs service.ScheduleGenerationJobArgs
+	s.jobEnqueuer.EnqueueScheduleGenerationFn = func(_ context.Context, args service.ScheduleGenerationJobArgs) error {
+		enqueuedArgs = args
+		return nil
+	}
+
+	_, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Require().NoError(err)
+
+	s.Equal(float32(20), enqueuedArgs.RequestPayload.Assistants[0].CostPerHour)
+	s.Require().NotNil(enqueuedArgs.RequestPayload.SchedulerConfig.WeeklyCostCeiling)
+	s.Equal(float32(1500), *enqueuedArgs.RequestPayload.SchedulerConfig.WeeklyCostCeiling)
+}
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_BudgetExhausted() {
+	params := s.newGenerateParams()
+	budgetID := uuid.New()
+	params.BudgetID = &budgetID
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.setupGenerationMocks(uuid.New())
+	s.budgetSvc.CostCeilingFn = func(_ context.Context, _ uuid.UUID, _ time.Time, _ *time.Time) (float64, float64, error) {
+		return 0, 0, budgetErrors.ErrBudgetExhausted
+	}
+
+	result, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Nil(result)
+	s.ErrorIs(err, budgetErrors.ErrBudgetExhausted)
+}
+
 func (s *ScheduleServiceTestSuite) TestGenerateSchedule_RejectsWhenGenerationInProgress() {
 	s.generationSvc.HasActiveFn = func(_ context.Context) (bool, error) {
 		return true, nil
@@ -456,3 +511,207 @@ func (s *ScheduleServiceTestSuite) TestGenerateSchedule_ConfigNotFound() {
 	s.ErrorIs(err, scheduleErrors.ErrSchedulerConfigNotFound)
 	s.Nil(result)
 }
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_SplitsPeriodAroundApprovedTimeOff() {
+	generationID := uuid.New()
+	params := s.newGenerateParams()
+	// 2025-09-01 is a Monday; the term runs for two weeks.
+	effectiveTo := time.Date(2025, 9, 14, 0, 0, 0, 0, time.UTC)
+	params.EffectiveTo = &effectiveTo
+	params.Assistants = []types.Assistant{
+		{
+			ID:       "1001",
+			MinHours: 4,
+			MaxHours: 10,
+			Availability: []types.AvailabilityWindow{
+				{DayOfWeek: 0, Start: "08:00:00", End: "12:00:00"},
+				{DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+			},
+		},
+		{
+			ID:       "1002",
+			MinHours: 4,
+			MaxHours: 10,
+			Availability: []types.AvailabilityWindow{
+				{DayOfWeek: 1, Start: "08:00:00", End: "12:00:00"},
+			},
+		},
+	}
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.setupGenerationMocks(generationID)
+
+	var listFilter timeoffRepo.TimeOffFilter
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		listFilter = filter
+		// 1001 is away both Mondays; 1002 the first Tuesday and Wednesday.
+		// 1003 is not being scheduled.
+		return []*timeoffAggregate.TimeOffRequest{
+			{StudentID: 1001, StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), Status: timeoffAggregate.Status_Approved},
+			{StudentID: 1002, StartDate: time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC), Status: timeoffAggregate.Status_Approved},
+			{StudentID: 1003, StartDate: time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC), Status: timeoffAggregate.Status_Approved},
+			{StudentID: 1001, StartDate: time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), Status: timeoffAggregate.Status_Approved},
+		}, nil
+	}
+
+	var enqueuedArgs service.ScheduleGenerationJobArgs
+	s.jobEnqueuer.EnqueueScheduleGenerationFn = func(_ context.Context, args service.ScheduleGenerationJobArgs) error {
+		enqueuedArgs = args
+		return nil
+	}
+
+	_, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Require().NoError(err)
+
+	s.Equal([]timeoffAggregate.Status{timeoffAggregate.Status_Approved}, listFilter.Statuses)
+	s.Equal(params.EffectiveFrom, *listFilter.From)
+	s.Equal(effectiveTo, *listFilter.To)
+
+	// The weekly request keeps everyone's availability.
+	s.Equal(params.Assistants, enqueuedArgs.RequestPayload.Assistants)
+
+	overrides := enqueuedArgs.Overrides
+	s.Require().Len(overrides, 3)
+
+	s.Equal("2025-09-01", overrides[0].From)
+	s.Equal("2025-09-01", overrides[0].To)
+	s.Require().Len(overrides[0].RequestPayload.Assistants, 1)
+	s.Equal("1002", overrides[0].RequestPayload.Assistants[0].ID)
+	s.Equal(float32(0), overrides[0].RequestPayload.Assistants[0].MinHours)
+	s.Equal(float32(10), overrides[0].RequestPayload.Assistants[0].MaxHours)
+	s.Empty(overrides[0].RequestPayload.Shifts) // the only shift is on Tuesdays
+
+	s.Equal("2025-09-02", overrides[1].From)
+	s.Equal("2025-09-03", overrides[1].To)
+	s.Require().Len(overrides[1].RequestPayload.Assistants, 1)
+	s.Equal("1001", overrides[1].RequestPayload.Assistants[0].ID)
+	s.Len(overrides[1].RequestPayload.Shifts, 1)
+	s.Equal(enqueuedArgs.RequestPayload.SchedulerConfig, overrides[1].RequestPayload.SchedulerConfig)
+
+	s.Equal("2025-09-08", overrides[2].From)
+	s.Equal("2025-09-08", overrides[2].To)
+
+	// The caller's slice must not be mutated.
+	s.Equal(float32(4), params.Assistants[1].MinHours)
+}
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_NoTimeOffNoOverrides() {
+	params := s.newGenerateParams()
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.setupGenerationMocks(uuid.New())
+
+	var enqueuedArgs service.ScheduleGenerationJobArgs
+	s.jobEnqueuer.EnqueueScheduleGenerationFn = func(_ context.Context, args service.ScheduleGenerationJobArgs) error {
+		enqueuedArgs = args
+		return nil
+	}
+
+	_, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Require().NoError(err)
+
+	s.Empty(enqueuedArgs.Overrides)
+}
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_NormalizesCourseCodes() {
+	params := s.newGenerateParams()
+	params.Assistants = []types.Assistant{
+		{ID: "1001", Courses: []string{"COMP 1601"}, MinHours: 4, MaxHours: 10},
+		{ID: "1002", Courses: []string{"COMP1602"}, MinHours: 4, MaxHours: 10},
+	}
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.shiftTemplateSvc.ListFn = func(_ context.Context) ([]*aggregate.ShiftTemplate, error) {
+		return []*aggregate.ShiftTemplate{{
+			ID:        uuid.New(),
+			Name:      "Morning Shift",
+			DayOfWeek: 1,
+			StartTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(0, 1, 1, 12, 0, 0, 0, time.UTC),
+			MinStaff:  1,
+			CourseDemands: []aggregate.CourseDemand{
+				{CourseCode: "COMP1601", TutorsRequired: 1, Weight: 1.0},
+				{CourseCode: "comp 1602", TutorsRequired: 1, Weight: 1.0},
+			},
+			IsActive: true,
+		}}, nil
+	}
+	s.setupGenerationMocks(uuid.New())
+
+	var enqueuedArgs service.ScheduleGenerationJobArgs
+	s.jobEnqueuer.EnqueueScheduleGenerationFn = func(_ context.Context, args service.ScheduleGenerationJobArgs) error {
+		enqueuedArgs = args
+		return nil
+	}
+
+	_, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Require().NoError(err)
+
+	payload := enqueuedArgs.RequestPayload
+	s.Equal([]string{"COMP1601"}, payload.Assistants[0].Courses)
+	s.Equal([]string{"COMP1602"}, payload.Assistants[1].Courses)
+	s.Require().Len(payload.Shifts, 1)
+	s.Equal("COMP1601", payload.Shifts[0].CourseDemands[0].CourseCode)
+	s.Equal("COMP1602", payload.Shifts[0].CourseDemands[1].CourseCode)
+
+	// The caller's slice must not be mutated.
+	s.Equal([]string{"COMP 1601"}, params.Assistants[0].Courses)
+}
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_OpenEndedScheduleSplitsUpToLastTimeOff() {
+	generationID := uuid.New()
+	params := s.newGenerateParams()
+	s.Require().Nil(params.EffectiveTo)
+	params.Assistants = []types.Assistant{
+		{ID: "1001", MinHours: 4, MaxHours: 10},
+		{ID: "1002", MinHours: 4, MaxHours: 10},
+	}
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.setupGenerationMocks(generationID)
+
+	var listFilter timeoffRepo.TimeOffFilter
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		listFilter = filter
+		// Leave that started before the schedule counts from its first day.
+		return []*timeoffAggregate.TimeOffRequest{
+			{StudentID: 1001, StartDate: time.Date(2025, 8, 25, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 9, 12, 0, 0, 0, 0, time.UTC), Status: timeoffAggregate.Status_Approved},
+		}, nil
+	}
+
+	var enqueuedArgs service.ScheduleGenerationJobArgs
+	s.jobEnqueuer.EnqueueScheduleGenerationFn = func(_ context.Context, args service.ScheduleGenerationJobArgs) error {
+		enqueuedArgs = args
+		return nil
+	}
+
+	_, err := s.service.GenerateSchedule(s.authCtx, params)
+	s.Require().NoError(err)
+
+	s.Nil(listFilter.To)
+	s.Require().Len(enqueuedArgs.Overrides, 1)
+	override := enqueuedArgs.Overrides[0]
+	s.Equal("2025-09-01", override.From)
+	s.Equal("2025-09-12", override.To)
+	// A run of a week or more offers every shift and keeps weekly minimums.
+	s.Require().Len(override.RequestPayload.Assistants, 1)
+	s.Equal("1002", override.RequestPayload.Assistants[0].ID)
+	s.Equal(float32(4), override.RequestPayload.Assistants[0].MinHours)
+	s.Len(override.RequestPayload.Shifts, 1)
+}
+
+func (s *ScheduleServiceTestSuite) TestGenerateSchedule_TimeOffLookupFails() {
+	params := s.newGenerateParams()
+	effectiveTo := time.Date(2025, 12, 19, 0, 0, 0, 0, time.UTC)
+	params.EffectiveTo = &effectiveTo
+
+	s.setupShiftTemplateAndConfigMocks()
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		return nil, fmt.Errorf("db error")
+	}
+
+	result, err := s.service.GenerateSchedule(s.authCtx, params)
+
+	s.Error(err)
+	s.Nil(result)
+}
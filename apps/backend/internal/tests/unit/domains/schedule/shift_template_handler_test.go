@@ -3,6 +3,7 @@ package schedule_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -131,6 +132,24 @@ func (s *ShiftTemplateHandlerTestSuite) TestCreate_ValidationError() {
 	s.Equal(http.StatusBadRequest, rr.Code)
 }
 
+func (s *ShiftTemplateHandlerTestSuite) TestCreate_UnknownCourseCode() {
+	s.mockSvc.CreateFn = func(_ context.Context, _ *aggregate.ShiftTemplate) (*aggregate.ShiftTemplate, error) {
+		return nil, fmt.Errorf("%w: CS999", scheduleErrors.ErrUnknownCourseCode)
+	}
+
+	rr := s.doRequest("POST", "/api/v1/shift-templates", `{
+		"name": "Monday 9-10am",
+		"day_of_week": 0,
+		"start_time": "09:00",
+		"end_time": "10:00",
+		"min_staff": 2,
+		"course_demands": [{"course_code": "CS999", "tutors_required": 1, "weight": 1.0}]
+	}`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Contains(rr.Body.String(), "CS999")
+}
+
 func (s *ShiftTemplateHandlerTestSuite) TestCreate_Unauthorized() {
 	s.mockSvc.CreateFn = func(_ context.Context, _ *aggregate.ShiftTemplate) (*aggregate.ShiftTemplate, error) {
 		return nil, scheduleErrors.ErrMissingAuthContext
@@ -19,10 +19,12 @@ import (
 
 type ShiftTemplateServiceTestSuite struct {
 	suite.Suite
-	repo    *mocks.MockShiftTemplateRepository
-	service service.ShiftTemplateServiceInterface
-	authCtx context.Context
-	userID  uuid.UUID
+	repo         *mocks.MockShiftTemplateRepository
+	locationRepo *mocks.MockLocationRepository
+	courseRepo   *mocks.MockCourseRepository
+	service      service.ShiftTemplateServiceInterface
+	authCtx      context.Context
+	userID       uuid.UUID
 }
 
 func TestShiftTemplateServiceTestSuite(t *testing.T) {
@@ -31,8 +33,17 @@ func TestShiftTemplateServiceTestSuite(t *testing.T) {
 
 func (s *ShiftTemplateServiceTestSuite) SetupTest() {
 	s.repo = &mocks.MockShiftTemplateRepository{}
+	s.locationRepo = &mocks.MockLocationRepository{}
+	s.courseRepo = &mocks.MockCourseRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.Course, error) {
+			return []*aggregate.Course{
+				{ID: uuid.New(), Code: "CS101", Title: "Intro to Computing", Level: 1, IsActive: true},
+				{ID: uuid.New(), Code: "CS202", Title: "Data Structures", Level: 2, IsActive: true},
+			}, nil
+		},
+	}
 	s.userID = uuid.New()
-	svc := service.NewShiftTemplateService(zap.NewNop(), s.repo, &mocks.StubTxManager{})
+	svc := service.NewShiftTemplateService(zap.NewNop(), s.repo, s.locationRepo, s.courseRepo, &mocks.StubTxManager{})
 	s.service = svc
 	s.authCtx = database.WithAuthContext(context.Background(), database.AuthContext{
 		UserID: s.userID.String(),
@@ -94,6 +105,79 @@ func (s *ShiftTemplateServiceTestSuite) TestCreate_RepositoryError() {
 	s.Contains(err.Error(), "db error")
 }
 
+func (s *ShiftTemplateServiceTestSuite) TestCreate_WithLocation() {
+	locationID := uuid.New()
+	input := s.newShiftTemplate()
+	input.SetLocation(&locationID)
+
+	s.locationRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.Location, error) {
+		s.Equal(locationID, id)
+		return &aggregate.Location{ID: id, Name: "Engineering Desk"}, nil
+	}
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, t *aggregate.ShiftTemplate) (*aggregate.ShiftTemplate, error) {
+		return t, nil
+	}
+
+	result, err := s.service.Create(s.authCtx, input)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(result.LocationID)
+	s.Equal(locationID, *result.LocationID)
+}
+
+func (s *ShiftTemplateServiceTestSuite) TestCreate_UnknownLocation() {
+	locationID := uuid.New()
+	input := s.newShiftTemplate()
+	input.SetLocation(&locationID)
+
+	s.locationRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.Location, error) {
+		return nil, scheduleErrors.ErrLocationNotFound
+	}
+
+	result, err := s.service.Create(s.authCtx, input)
+
+	s.ErrorIs(err, scheduleErrors.ErrLocationNotFound)
+	s.Nil(result)
+}
+
+func (s *ShiftTemplateServiceTestSuite) TestCreate_NormalisesCourseCodes() {
+	input := s.newShiftTemplate()
+	input.CourseDemands = []aggregate.CourseDemand{{CourseCode: "cs 101", TutorsRequired: 1, Weight: 1.0}}
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, t *aggregate.ShiftTemplate) (*aggregate.ShiftTemplate, error) {
+		return t, nil
+	}
+
+	result, err := s.service.Create(s.authCtx, input)
+
+	s.Require().NoError(err)
+	s.Equal("CS101", result.CourseDemands[0].CourseCode)
+}
+
+func (s *ShiftTemplateServiceTestSuite) TestCreate_UnknownCourseCode() {
+	input := s.newShiftTemplate()
+	input.CourseDemands = append(input.CourseDemands, aggregate.CourseDemand{CourseCode: "CS110", TutorsRequired: 1, Weight: 1.0})
+	s.repo.CreateFn = func(_ context.Context, _ *sql.Tx, _ *aggregate.ShiftTemplate) (*aggregate.ShiftTemplate, error) {
+		s.Fail("template with an unknown course should not be saved")
+		return nil, nil
+	}
+
+	result, err := s.service.Create(s.authCtx, input)
+
+	s.ErrorIs(err, scheduleErrors.ErrUnknownCourseCode)
+	s.Contains(err.Error(), "CS110")
+	s.Nil(result)
+}
+
+func (s *ShiftTemplateServiceTestSuite) TestBulkCreate_UnknownCourseCode() {
+	valid := s.newShiftTemplate()
+	invalid := s.newShiftTemplate()
+	invalid.CourseDemands = []aggregate.CourseDemand{{CourseCode: "MATH1115", TutorsRequired: 1, Weight: 1.0}}
+
+	_, err := s.service.BulkCreate(s.authCtx, []*aggregate.ShiftTemplate{valid, invalid})
+
+	s.ErrorIs(err, scheduleErrors.ErrUnknownCourseCode)
+}
+
 // --- GetByID ---
 
 func (s *ShiftTemplateServiceTestSuite) TestGetByID_Success() {
@@ -0,0 +1,166 @@
+package student_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ApplicationReviewHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockApplicationReviewService
+	router  *chi.Mux
+	adminID uuid.UUID
+}
+
+func TestApplicationReviewHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationReviewHandlerTestSuite))
+}
+
+func (s *ApplicationReviewHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockApplicationReviewService{}
+	s.adminID = uuid.New()
+	hdl := handler.NewApplicationReviewHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *ApplicationReviewHandlerTestSuite) doRequest(method, path, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+	req = req.WithContext(database.WithAuthContext(req.Context(), database.AuthContext{UserID: s.adminID.String(), Role: "admin"}))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestListQueue_ReviewerMe() {
+	s.mockSvc.ListQueueFn = func(_ context.Context, filter service.ReviewQueueFilter) ([]*aggregate.ApplicationReviewSummary, error) {
+		s.Require().NotNil(filter.ReviewerID)
+		s.Equal(s.adminID, *filter.ReviewerID)
+		s.Nil(filter.StageID)
+		return []*aggregate.ApplicationReviewSummary{{
+			Student:     &aggregate.Student{StudentID: 816000001, FirstName: "Jane", LastName: "Doe"},
+			Stage:       reviewStage("Screening", 1),
+			ReviewerIDs: []uuid.UUID{s.adminID},
+		}}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/applications?reviewer_id=me", "")
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp []dtos.ApplicationReviewSummaryResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal("Jane Doe", resp[0].StudentName)
+	s.Equal("Screening", resp[0].Stage.Name)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestListQueue_InvalidStageID() {
+	rr := s.doRequest(http.MethodGet, "/api/v1/applications?stage_id=nope", "")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestMoveStage_Success() {
+	stageID := uuid.New()
+	s.mockSvc.MoveStageFn = func(_ context.Context, studentID int32, to uuid.UUID, note string) (*aggregate.StageChange, error) {
+		s.Equal(int32(816000001), studentID)
+		s.Equal(stageID, to)
+		s.Equal("ready for interview", note)
+		return aggregate.NewStageChange(studentID, nil, to, note, nil)
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/applications/816000001/stage", `{"stage_id":"`+stageID.String()+`","note":"ready for interview"}`)
+	s.Equal(http.StatusCreated, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestMoveStage_Decided() {
+	s.mockSvc.MoveStageFn = func(_ context.Context, _ int32, _ uuid.UUID, _ string) (*aggregate.StageChange, error) {
+		return nil, studentErrors.ErrApplicationDecided
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/applications/816000001/stage", `{"stage_id":"`+uuid.NewString()+`"}`)
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestScore_MissingScore() {
+	rr := s.doRequest(http.MethodPut, "/api/v1/applications/816000001/scores/"+uuid.NewString(), `{"comment":"ok"}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestScore_InvalidScore() {
+	s.mockSvc.ScoreFn = func(_ context.Context, _ int32, _ uuid.UUID, _ int32, _ string) (*aggregate.ApplicationScore, error) {
+		return nil, studentErrors.ErrInvalidScore
+	}
+
+	rr := s.doRequest(http.MethodPut, "/api/v1/applications/816000001/scores/"+uuid.NewString(), `{"score":9}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestGetReview_NotFound() {
+	s.mockSvc.GetReviewFn = func(_ context.Context, _ int32) (*aggregate.ApplicationReview, error) {
+		return nil, studentErrors.ErrNotFound
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/applications/816000001", "")
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestBookInterview_Success() {
+	slotID := uuid.New()
+	s.mockSvc.BookInterviewFn = func(_ context.Context, id uuid.UUID, studentID int32) (*aggregate.InterviewSlot, error) {
+		s.Equal(slotID, id)
+		now := time.Now()
+		return &aggregate.InterviewSlot{ID: id, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), Location: "Room 101", StudentID: &studentID, BookedAt: &now, InvitedAt: &now}, nil
+	}
+
+	rr := s.doRequest(http.MethodPut, "/api/v1/interview-slots/"+slotID.String()+"/booking", `{"student_id":816000001}`)
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp dtos.InterviewSlotResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal(int32(816000001), *resp.StudentID)
+	s.NotNil(resp.InvitedAt)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestBookInterview_SlotTaken() {
+	s.mockSvc.BookInterviewFn = func(_ context.Context, _ uuid.UUID, _ int32) (*aggregate.InterviewSlot, error) {
+		return nil, studentErrors.ErrInterviewSlotBooked
+	}
+
+	rr := s.doRequest(http.MethodPut, "/api/v1/interview-slots/"+uuid.NewString()+"/booking", `{"student_id":816000001}`)
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestCreateInterviewSlot_InvalidInterviewer() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/interview-slots", `{"starts_at":"2026-04-02T10:00:00Z","ends_at":"2026-04-02T10:30:00Z","location":"Room 101","interviewer_id":"bad"}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *ApplicationReviewHandlerTestSuite) TestCreateInterviewSlot_InvalidLocationID() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/interview-slots", `{"starts_at":"2026-04-02T10:00:00Z","ends_at":"2026-04-02T10:30:00Z","location":"Room 101","location_id":"bad"}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,414 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	userErrors "github.com/HDR3604/HelpDeskApp/internal/domain/user/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ApplicationReviewServiceTestSuite struct {
+	suite.Suite
+	reviewRepo   *mocks.MockApplicationReviewRepository
+	slotRepo     *mocks.MockInterviewSlotRepository
+	studentRepo  *mocks.MockStudentRepository
+	userRepo     *mocks.MockUserRepository
+	locationRepo *mocks.MockLocationRepository
+	emailSender  *mocks.MockEmailSender
+	enqueuer     *mocks.MockInterviewInvitationEnqueuer
+	svc          *service.ApplicationReviewService
+	ctx          context.Context
+	adminID      uuid.UUID
+	now          time.Time
+	screening    *aggregate.ReviewStage
+	interview    *aggregate.ReviewStage
+}
+
+func TestApplicationReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationReviewServiceTestSuite))
+}
+
+func (s *ApplicationReviewServiceTestSuite) SetupTest() {
+	s.now = time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	s.adminID = uuid.New()
+	s.screening = reviewStage("Screening", 1)
+	s.interview = reviewStage("Interview", 2)
+
+	s.reviewRepo = &mocks.MockApplicationReviewRepository{
+		ListStagesFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.ReviewStage, error) {
+			return []*aggregate.ReviewStage{s.screening, s.interview}, nil
+		},
+		ListStageChangesFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.StageChange, error) {
+			return []*aggregate.StageChange{}, nil
+		},
+		ListReviewersFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.ApplicationReviewer, error) {
+			return []*aggregate.ApplicationReviewer{}, nil
+		},
+	}
+	s.slotRepo = &mocks.MockInterviewSlotRepository{}
+	s.studentRepo = &mocks.MockStudentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, studentID int32) (*aggregate.Student, error) {
+			return &aggregate.Student{StudentID: studentID, FirstName: "Jane", LastName: "Doe", EmailAddress: "jane.doe@my.uwi.edu"}, nil
+		},
+	}
+	s.studentRepo.GetByIDIncludingDeactivatedFn = s.studentRepo.GetByIDFn
+	s.userRepo = &mocks.MockUserRepository{}
+	s.locationRepo = &mocks.MockLocationRepository{}
+	s.emailSender = &mocks.MockEmailSender{}
+	s.svc = service.NewApplicationReviewService(zap.NewNop(), &mocks.StubTxManager{}, s.reviewRepo, s.slotRepo, s.studentRepo, s.userRepo, s.locationRepo, s.emailSender, "helpdesk@example.com")
+	s.svc.WithNowFn(func() time.Time { return s.now })
+	s.enqueuer = &mocks.MockInterviewInvitationEnqueuer{
+		EnqueueInterviewInvitationFn: func(_ context.Context, _ uuid.UUID) error { return nil },
+	}
+	s.svc.SetInvitationEnqueuer(s.enqueuer)
+	s.ctx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: s.adminID.String(),
+		Role:   "admin",
+	})
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestMoveStage_Success() {
+	s.reviewRepo.AddStageChangeFn = func(_ context.Context, _ *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error) {
+		return change, nil
+	}
+
+	change, err := s.svc.MoveStage(s.ctx, 816000001, s.interview.ID, "strong transcript")
+	s.Require().NoError(err)
+	s.Equal(s.screening.ID, *change.FromStageID)
+	s.Equal(s.interview.ID, *change.ToStageID)
+	s.Require().NotNil(change.ChangedBy)
+	s.Equal(s.adminID, *change.ChangedBy)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestMoveStage_AlreadyInStage() {
+	_, err := s.svc.MoveStage(s.ctx, 816000001, s.screening.ID, "")
+	s.ErrorIs(err, studentErrors.ErrAlreadyInReviewStage)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestMoveStage_MissingAuthContext() {
+	_, err := s.svc.MoveStage(context.Background(), 816000001, s.interview.ID, "")
+	s.ErrorIs(err, studentErrors.ErrMissingAuthContext)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestAssignReviewer_NotAdmin() {
+	s.userRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, userID string) (*userAggregate.User, error) {
+		return &userAggregate.User{ID: uuid.MustParse(userID), Role: userAggregate.Role_Student, IsActive: true}, nil
+	}
+
+	err := s.svc.AssignReviewer(s.ctx, 816000001, uuid.New())
+	s.ErrorIs(err, studentErrors.ErrInvalidReviewer)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestAssignReviewer_UnknownUser() {
+	s.userRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ string) (*userAggregate.User, error) {
+		return nil, userErrors.ErrUserNotFound
+	}
+
+	err := s.svc.AssignReviewer(s.ctx, 816000001, uuid.New())
+	s.ErrorIs(err, studentErrors.ErrInvalidReviewer)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestScore_Success() {
+	criterion := reviewCriterion("Communication", 5, 1)
+	s.reviewRepo.GetCriterionByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.ReviewCriterion, error) {
+		return criterion, nil
+	}
+	s.reviewRepo.UpsertScoreFn = func(_ context.Context, _ *sql.Tx, score *aggregate.ApplicationScore) (*aggregate.ApplicationScore, error) {
+		return score, nil
+	}
+
+	score, err := s.svc.Score(s.ctx, 816000001, criterion.ID, 4, "clear answers")
+	s.Require().NoError(err)
+	s.Equal(int32(4), score.Score)
+	s.Equal(s.adminID, score.ReviewerID)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestScore_OutOfRange() {
+	criterion := reviewCriterion("Communication", 5, 1)
+	s.reviewRepo.GetCriterionByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.ReviewCriterion, error) {
+		return criterion, nil
+	}
+
+	_, err := s.svc.Score(s.ctx, 816000001, criterion.ID, 9, "")
+	s.ErrorIs(err, studentErrors.ErrInvalidScore)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestListQueue_Filters() {
+	reviewerID := uuid.New()
+	s.studentRepo.ListByStatusFn = func(_ context.Context, _ *sql.Tx, status string) ([]*aggregate.Student, error) {
+		s.Equal("pending", status)
+		return []*aggregate.Student{{StudentID: 816000001}, {StudentID: 816000002}}, nil
+	}
+	s.reviewRepo.ListStageChangesFn = func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.StageChange, error) {
+		return []*aggregate.StageChange{moveTo(816000002, s.interview.ID)}, nil
+	}
+	s.reviewRepo.ListReviewersFn = func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.ApplicationReviewer, error) {
+		return []*aggregate.ApplicationReviewer{{StudentID: 816000001, ReviewerID: reviewerID}}, nil
+	}
+
+	all, err := s.svc.ListQueue(s.ctx, service.ReviewQueueFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(all, 2)
+	s.Equal(s.screening.ID, all[0].Stage.ID)
+	s.Nil(all[0].EnteredAt)
+	s.Equal(s.interview.ID, all[1].Stage.ID)
+	s.NotNil(all[1].EnteredAt)
+
+	byStage, err := s.svc.ListQueue(s.ctx, service.ReviewQueueFilter{StageID: &s.interview.ID})
+	s.Require().NoError(err)
+	s.Require().Len(byStage, 1)
+	s.Equal(int32(816000002), byStage[0].Student.StudentID)
+
+	byReviewer, err := s.svc.ListQueue(s.ctx, service.ReviewQueueFilter{ReviewerID: &reviewerID})
+	s.Require().NoError(err)
+	s.Require().Len(byReviewer, 1)
+	s.Equal(int32(816000001), byReviewer[0].Student.StudentID)
+}
+
+func (s *ApplicationReviewServiceTestSuite) bookableSlot() *aggregate.InterviewSlot {
+	slot, err := aggregate.NewInterviewSlot(s.now.Add(24*time.Hour), s.now.Add(25*time.Hour), "Room 101", nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.slotRepo.GetByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.InterviewSlot, error) {
+		return nil, studentErrors.ErrInterviewSlotNotFound
+	}
+	s.slotRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.InterviewSlot, error) {
+		return slot, nil
+	}
+	s.slotRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+		return slot, nil
+	}
+	s.slotRepo.BookFn = func(_ context.Context, _ *sql.Tx, slot *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+		return slot, nil
+	}
+	return slot
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestBookInterview_QueuesInvitation() {
+	slot := s.bookableSlot()
+
+	var queued uuid.UUID
+	s.enqueuer.EnqueueInterviewInvitationFn = func(_ context.Context, slotID uuid.UUID) error {
+		queued = slotID
+		return nil
+	}
+	s.emailSender.SendFn = func(_ context.Context, _ dtos.SendEmailRequest) (*dtos.SendEmailResponse, error) {
+		s.Fail("the invitation is sent by the job, not while booking")
+		return nil, nil
+	}
+
+	booked, err := s.svc.BookInterview(s.ctx, slot.ID, 816000001)
+	s.Require().NoError(err)
+	s.Equal(int32(816000001), *booked.StudentID)
+	s.Nil(booked.InvitedAt)
+	s.Equal(slot.ID, queued)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestBookInterview_EnqueueFailureKeepsBooking() {
+	slot := s.bookableSlot()
+	s.enqueuer.EnqueueInterviewInvitationFn = func(_ context.Context, _ uuid.UUID) error {
+		return errors.New("queue down")
+	}
+
+	booked, err := s.svc.BookInterview(s.ctx, slot.ID, 816000001)
+	s.Require().NoError(err)
+	s.Equal(int32(816000001), *booked.StudentID)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestBookInterview_ConcurrentBookingsOnlyOneWins() {
+	slot := s.bookableSlot()
+
+	// Both bookings read the slot as free before either writes, so only the
+	// conditional update can tell them apart.
+	var (
+		mu      sync.Mutex
+		holder  *int32
+		readers sync.WaitGroup
+		queued  int
+	)
+	readers.Add(2)
+	s.slotRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.InterviewSlot, error) {
+		readers.Done()
+		readers.Wait()
+		copied := *slot
+		return &copied, nil
+	}
+	s.slotRepo.BookFn = func(_ context.Context, _ *sql.Tx, booking *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if holder != nil {
+			return nil, studentErrors.ErrInterviewSlotBooked
+		}
+		holder = booking.StudentID
+		return booking, nil
+	}
+	s.enqueuer.EnqueueInterviewInvitationFn = func(_ context.Context, _ uuid.UUID) error {
+		mu.Lock()
+		defer mu.Unlock()
+		queued++
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, studentID := range []int32{816000001, 816000002} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.svc.BookInterview(s.ctx, slot.ID, studentID)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.ErrorIs(err, studentErrors.ErrInterviewSlotBooked)
+	}
+	s.Equal(1, succeeded)
+	s.Equal(1, queued, "only the winning booking queues an invitation")
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestSendInterviewInvitation() {
+	slot := s.bookableSlot()
+	s.Require().NoError(slot.Book(816000001, s.now))
+
+	var sent dtos.SendEmailRequest
+	s.emailSender.SendFn = func(_ context.Context, req dtos.SendEmailRequest) (*dtos.SendEmailResponse, error) {
+		sent = req
+		return &dtos.SendEmailResponse{}, nil
+	}
+	var invitedAt time.Time
+	s.slotRepo.MarkInvitedFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID, studentID int32, at time.Time) (*aggregate.InterviewSlot, error) {
+		s.Equal(slot.ID, id)
+		s.Equal(int32(816000001), studentID)
+		invitedAt = at
+		return slot, nil
+	}
+
+	s.Require().NoError(s.svc.SendInterviewInvitation(context.Background(), slot.ID))
+	s.Equal([]string{"jane.doe@my.uwi.edu"}, sent.To)
+	s.Require().NotNil(sent.Template)
+	s.Equal(templates.TemplateID_InterviewInvitation, sent.Template.ID)
+	s.Equal("Room 101", sent.Template.Variables["LOCATION"])
+	// A slot without a location is shown in the default timezone (UTC-4).
+	s.Equal("8:00 AM – 9:00 AM", sent.Template.Variables["INTERVIEW_TIME"])
+	s.Equal(s.now, invitedAt)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestSendInterviewInvitation_UsesSlotLocationTimezone() {
+	slot := s.bookableSlot()
+	s.Require().NoError(slot.Book(816000001, s.now))
+	locationID := uuid.New()
+	slot.LocationID = &locationID
+	s.locationRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*scheduleAggregate.Location, error) {
+		s.Equal(locationID, id)
+		return &scheduleAggregate.Location{ID: id, Timezone: "Europe/London"}, nil
+	}
+	var sent dtos.SendEmailRequest
+	s.emailSender.SendFn = func(_ context.Context, req dtos.SendEmailRequest) (*dtos.SendEmailResponse, error) {
+		sent = req
+		return &dtos.SendEmailResponse{}, nil
+	}
+	s.slotRepo.MarkInvitedFn = func(context.Context, *sql.Tx, uuid.UUID, int32, time.Time) (*aggregate.InterviewSlot, error) {
+		return slot, nil
+	}
+
+	s.Require().NoError(s.svc.SendInterviewInvitation(context.Background(), slot.ID))
+	s.Require().NotNil(sent.Template)
+	// 12:00 UTC is 13:00 in London during British Summer Time.
+	s.Equal("1:00 PM – 2:00 PM", sent.Template.Variables["INTERVIEW_TIME"])
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestCreateInterviewSlot_UnknownLocation() {
+	s.locationRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*scheduleAggregate.Location, error) {
+		return nil, scheduleErrors.ErrLocationNotFound
+	}
+	s.slotRepo.CreateFn = func(_ context.Context, _ *sql.Tx, _ *aggregate.InterviewSlot) (*aggregate.InterviewSlot, error) {
+		s.Fail("a slot with an unknown location must not be created")
+		return nil, nil
+	}
+	locationID := uuid.New()
+
+	_, err := s.svc.CreateInterviewSlot(s.ctx, s.now.Add(time.Hour), s.now.Add(2*time.Hour), "Room 101", &locationID, nil)
+
+	s.ErrorIs(err, studentErrors.ErrUnknownInterviewLocation)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestSendInterviewInvitation_SkipsCancelledOrInvited() {
+	slot := s.bookableSlot()
+	s.emailSender.SendFn = func(_ context.Context, _ dtos.SendEmailRequest) (*dtos.SendEmailResponse, error) {
+		s.Fail("no invitation should be sent")
+		return nil, nil
+	}
+
+	// Cancelled before the job ran.
+	s.NoError(s.svc.SendInterviewInvitation(context.Background(), slot.ID))
+
+	// Already invited by an earlier attempt.
+	s.Require().NoError(slot.Book(816000001, s.now))
+	slot.InvitedAt = &s.now
+	s.NoError(s.svc.SendInterviewInvitation(context.Background(), slot.ID))
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestSendInterviewInvitation_EmailFailureIsRetried() {
+	slot := s.bookableSlot()
+	s.Require().NoError(slot.Book(816000001, s.now))
+	s.emailSender.SendFn = func(_ context.Context, _ dtos.SendEmailRequest) (*dtos.SendEmailResponse, error) {
+		return nil, errors.New("smtp down")
+	}
+	s.slotRepo.MarkInvitedFn = func(context.Context, *sql.Tx, uuid.UUID, int32, time.Time) (*aggregate.InterviewSlot, error) {
+		s.Fail("a failed send must not be marked as invited")
+		return nil, nil
+	}
+
+	s.Error(s.svc.SendInterviewInvitation(context.Background(), slot.ID))
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestBookInterview_AlreadyBooked() {
+	slot := s.bookableSlot()
+	s.slotRepo.GetByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.InterviewSlot, error) {
+		return slot, nil
+	}
+
+	_, err := s.svc.BookInterview(s.ctx, uuid.New(), 816000001)
+	s.ErrorIs(err, studentErrors.ErrInterviewAlreadyBooked)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestBookInterview_DecidedApplication() {
+	slot := s.bookableSlot()
+	s.studentRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, studentID int32) (*aggregate.Student, error) {
+		return &aggregate.Student{StudentID: studentID, AcceptedAt: &s.now}, nil
+	}
+
+	_, err := s.svc.BookInterview(s.ctx, slot.ID, 816000001)
+	s.ErrorIs(err, studentErrors.ErrApplicationDecided)
+}
+
+func (s *ApplicationReviewServiceTestSuite) TestDeleteInterviewSlot_Booked() {
+	slot := s.bookableSlot()
+	s.Require().NoError(slot.Book(816000001, s.now))
+
+	err := s.svc.DeleteInterviewSlot(s.ctx, slot.ID)
+	s.ErrorIs(err, studentErrors.ErrInterviewSlotBooked)
+}
@@ -0,0 +1,178 @@
+package student_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ApplicationReviewTestSuite struct {
+	suite.Suite
+}
+
+func TestApplicationReviewTestSuite(t *testing.T) {
+	suite.Run(t, new(ApplicationReviewTestSuite))
+}
+
+func reviewStage(name string, position int32) *aggregate.ReviewStage {
+	stage, err := aggregate.NewReviewStage(name, position)
+	if err != nil {
+		panic(err)
+	}
+	return stage
+}
+
+func reviewCriterion(name string, maxScore, position int32) *aggregate.ReviewCriterion {
+	criterion, err := aggregate.NewReviewCriterion(name, "", maxScore, position)
+	if err != nil {
+		panic(err)
+	}
+	return criterion
+}
+
+func moveTo(studentID int32, to uuid.UUID) *aggregate.StageChange {
+	change, err := aggregate.NewStageChange(studentID, nil, to, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return change
+}
+
+func (s *ApplicationReviewTestSuite) TestNewReviewStage_Validation() {
+	_, err := aggregate.NewReviewStage("  ", 1)
+	s.ErrorIs(err, studentErrors.ErrInvalidReviewStageName)
+
+	_, err = aggregate.NewReviewStage("Screening", 0)
+	s.ErrorIs(err, studentErrors.ErrInvalidReviewPosition)
+}
+
+func (s *ApplicationReviewTestSuite) TestNewReviewCriterion_InvalidMaxScore() {
+	_, err := aggregate.NewReviewCriterion("Communication", "", 11, 1)
+	s.ErrorIs(err, studentErrors.ErrInvalidMaxScore)
+}
+
+func (s *ApplicationReviewTestSuite) TestPipeline_CurrentDefaultsToFirstActiveStage() {
+	screening := reviewStage("Screening", 1)
+	screening.IsActive = false
+	interview := reviewStage("Interview", 2)
+	decision := reviewStage("Decision", 3)
+
+	current, ok := aggregate.NewReviewPipeline([]*aggregate.ReviewStage{decision, interview, screening}).Current(nil)
+	s.Require().True(ok)
+	s.Equal(interview.ID, current.ID)
+}
+
+func (s *ApplicationReviewTestSuite) TestPipeline_CurrentFollowsHistory() {
+	screening := reviewStage("Screening", 1)
+	interview := reviewStage("Interview", 2)
+	pipeline := aggregate.NewReviewPipeline([]*aggregate.ReviewStage{screening, interview})
+
+	decision := aggregate.NewDecisionRecord(816000001, &interview.ID, aggregate.ApplicationDecision_Accepted, nil)
+	current, ok := pipeline.Current([]*aggregate.StageChange{moveTo(816000001, interview.ID), decision})
+	s.Require().True(ok)
+	s.Equal(interview.ID, current.ID)
+}
+
+func (s *ApplicationReviewTestSuite) TestPipeline_Move() {
+	screening := reviewStage("Screening", 1)
+	interview := reviewStage("Interview", 2)
+	pipeline := aggregate.NewReviewPipeline([]*aggregate.ReviewStage{screening, interview})
+	student := &aggregate.Student{StudentID: 816000001}
+
+	change, err := pipeline.Move(student, nil, interview.ID, " looks promising ", nil)
+	s.Require().NoError(err)
+	s.Require().NotNil(change.FromStageID)
+	s.Equal(screening.ID, *change.FromStageID)
+	s.Equal(interview.ID, *change.ToStageID)
+	s.Equal("looks promising", change.Note)
+}
+
+func (s *ApplicationReviewTestSuite) TestPipeline_MoveErrors() {
+	screening := reviewStage("Screening", 1)
+	inactive := reviewStage("Archived", 2)
+	inactive.IsActive = false
+	pipeline := aggregate.NewReviewPipeline([]*aggregate.ReviewStage{screening, inactive})
+	student := &aggregate.Student{StudentID: 816000001}
+
+	_, err := pipeline.Move(student, nil, screening.ID, "", nil)
+	s.ErrorIs(err, studentErrors.ErrAlreadyInReviewStage)
+
+	_, err = pipeline.Move(student, nil, inactive.ID, "", nil)
+	s.ErrorIs(err, studentErrors.ErrReviewStageInactive)
+
+	_, err = pipeline.Move(student, nil, uuid.New(), "", nil)
+	s.ErrorIs(err, studentErrors.ErrReviewStageNotFound)
+
+	now := time.Now()
+	student.RejectedAt = &now
+	_, err = pipeline.Move(student, nil, screening.ID, "", nil)
+	s.ErrorIs(err, studentErrors.ErrApplicationDecided)
+}
+
+func (s *ApplicationReviewTestSuite) TestNewApplicationScore_Validation() {
+	criterion := reviewCriterion("Communication", 5, 1)
+
+	_, err := aggregate.NewApplicationScore(816000001, criterion, uuid.New(), 6, "")
+	s.ErrorIs(err, studentErrors.ErrInvalidScore)
+
+	criterion.IsActive = false
+	_, err = aggregate.NewApplicationScore(816000001, criterion, uuid.New(), 3, "")
+	s.ErrorIs(err, studentErrors.ErrReviewCriterionInactive)
+}
+
+func (s *ApplicationReviewTestSuite) TestSummarizeScores() {
+	technical := reviewCriterion("Technical knowledge", 5, 1)
+	communication := reviewCriterion("Communication", 10, 2)
+	retired := reviewCriterion("Retired", 5, 3)
+	retired.IsActive = false
+
+	scores := []*aggregate.ApplicationScore{
+		{CriterionID: technical.ID, ReviewerID: uuid.New(), Score: 4},
+		{CriterionID: technical.ID, ReviewerID: uuid.New(), Score: 5},
+		{CriterionID: communication.ID, ReviewerID: uuid.New(), Score: 6},
+	}
+
+	summary := aggregate.SummarizeScores([]*aggregate.ReviewCriterion{retired, communication, technical}, scores)
+	s.Require().Len(summary.Criteria, 2)
+	s.Equal(technical.ID, summary.Criteria[0].CriterionID)
+	s.InDelta(4.5, summary.Criteria[0].Average, 0.001)
+	s.Equal(2, summary.Criteria[0].Count)
+	s.Equal(communication.ID, summary.Criteria[1].CriterionID)
+	s.Require().NotNil(summary.Overall)
+	s.InDelta(75.0, *summary.Overall, 0.001)
+}
+
+func (s *ApplicationReviewTestSuite) TestSummarizeScores_Unscored() {
+	summary := aggregate.SummarizeScores([]*aggregate.ReviewCriterion{reviewCriterion("Communication", 5, 1)}, nil)
+	s.Len(summary.Criteria, 1)
+	s.Nil(summary.Overall)
+}
+
+func (s *ApplicationReviewTestSuite) TestInterviewSlot_BookAndCancel() {
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	slot, err := aggregate.NewInterviewSlot(now.Add(time.Hour), now.Add(90*time.Minute), "Room 101", nil, nil, nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(slot.Book(816000001, now))
+	s.Equal(int32(816000001), *slot.StudentID)
+	s.ErrorIs(slot.Book(816000002, now), studentErrors.ErrInterviewSlotBooked)
+
+	s.Require().NoError(slot.Cancel())
+	s.Nil(slot.StudentID)
+	s.ErrorIs(slot.Cancel(), studentErrors.ErrInterviewSlotNotBooked)
+
+	s.ErrorIs(slot.Book(816000001, now.Add(2*time.Hour)), studentErrors.ErrInterviewSlotInPast)
+}
+
+func (s *ApplicationReviewTestSuite) TestNewInterviewSlot_Validation() {
+	now := time.Now()
+	_, err := aggregate.NewInterviewSlot(now, now, "Room 101", nil, nil, nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidInterviewSlot)
+
+	_, err = aggregate.NewInterviewSlot(now, now.Add(time.Hour), " ", nil, nil, nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidInterviewLocation)
+}
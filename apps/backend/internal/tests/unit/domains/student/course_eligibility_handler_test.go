@@ -0,0 +1,147 @@
+package student_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type CourseEligibilityHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockCourseEligibilityService
+	router  *chi.Mux
+}
+
+func TestCourseEligibilityHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseEligibilityHandlerTestSuite))
+}
+
+func (s *CourseEligibilityHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockCourseEligibilityService{}
+	hdl := handler.NewCourseEligibilityHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *CourseEligibilityHandlerTestSuite) doRequest(method, path, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestGetMatrix_Success() {
+	s.mockSvc.GetMatrixFn = func(_ context.Context, studentID *int32) ([]*aggregate.StudentEligibility, error) {
+		s.Require().NotNil(studentID)
+		s.Equal(int32(816000001), *studentID)
+		return []*aggregate.StudentEligibility{
+			aggregate.NewEligibilityPolicy(nil).Evaluate(eligibilityStudent(course("COMP1601", "B"), course("COMP1602", "D")), nil),
+		}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/course-eligibility?student_id=816000001", "")
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp []dtos.StudentEligibilityResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal([]string{"COMP1601"}, resp[0].EligibleCourses)
+	s.Len(resp[0].Courses, 2)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestGetMatrix_InvalidStudentID() {
+	rr := s.doRequest(http.MethodGet, "/api/v1/course-eligibility?student_id=abc", "")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestCreateRule_Success() {
+	s.mockSvc.CreateRuleFn = func(_ context.Context, code *string, level *int32, minGrade string) (*aggregate.EligibilityRule, error) {
+		return aggregate.NewEligibilityRule(code, level, minGrade)
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/course-eligibility/rules", `{"course_level":3,"min_grade":"B+"}`)
+	s.Equal(http.StatusCreated, rr.Code)
+
+	var resp dtos.EligibilityRuleResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("B+", resp.MinGrade)
+	s.Require().NotNil(resp.CourseLevel)
+	s.Equal(int32(3), *resp.CourseLevel)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestCreateRule_Conflict() {
+	s.mockSvc.CreateRuleFn = func(_ context.Context, _ *string, _ *int32, _ string) (*aggregate.EligibilityRule, error) {
+		return nil, studentErrors.ErrEligibilityRuleExists
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/course-eligibility/rules", `{"course_level":3,"min_grade":"B+"}`)
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestCreateRule_ValidationError() {
+	s.mockSvc.CreateRuleFn = func(_ context.Context, _ *string, _ *int32, _ string) (*aggregate.EligibilityRule, error) {
+		return nil, studentErrors.ErrInvalidGrade
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/course-eligibility/rules", `{"course_level":3,"min_grade":"Z"}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestDeleteRule_NotFound() {
+	s.mockSvc.DeleteRuleFn = func(_ context.Context, _ uuid.UUID) error {
+		return studentErrors.ErrEligibilityRuleNotFound
+	}
+
+	rr := s.doRequest(http.MethodDelete, "/api/v1/course-eligibility/rules/"+uuid.New().String(), "")
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestSetOverride_Success() {
+	s.mockSvc.SetOverrideFn = func(_ context.Context, studentID int32, code string, eligible bool, reason string) (*aggregate.EligibilityOverride, error) {
+		s.Equal("COMP1601", code)
+		return aggregate.NewEligibilityOverride(studentID, code, eligible, reason, nil)
+	}
+
+	rr := s.doRequest(http.MethodPut, "/api/v1/course-eligibility/overrides/816000001/COMP1601", `{"eligible":false,"reason":"misconduct"}`)
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp dtos.EligibilityOverrideResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.False(resp.Eligible)
+	s.Equal("misconduct", resp.Reason)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestSetOverride_EligibleRequired() {
+	rr := s.doRequest(http.MethodPut, "/api/v1/course-eligibility/overrides/816000001/COMP1601", `{"reason":"misconduct"}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *CourseEligibilityHandlerTestSuite) TestDeleteOverride_Success() {
+	s.mockSvc.DeleteOverrideFn = func(_ context.Context, _ int32, _ string) error { return nil }
+
+	rr := s.doRequest(http.MethodDelete, "/api/v1/course-eligibility/overrides/816000001/COMP1601", "")
+	s.Equal(http.StatusNoContent, rr.Code)
+}
@@ -0,0 +1,146 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type CourseEligibilityServiceTestSuite struct {
+	suite.Suite
+	eligibilityRepo *mocks.MockCourseEligibilityRepository
+	studentRepo     *mocks.MockStudentRepository
+	svc             *service.CourseEligibilityService
+	ctx             context.Context
+}
+
+func TestCourseEligibilityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseEligibilityServiceTestSuite))
+}
+
+func (s *CourseEligibilityServiceTestSuite) SetupTest() {
+	s.eligibilityRepo = &mocks.MockCourseEligibilityRepository{
+		ListRulesFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+			return []*aggregate.EligibilityRule{}, nil
+		},
+		ListOverridesFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.EligibilityOverride, error) {
+			return []*aggregate.EligibilityOverride{}, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{}
+	s.svc = service.NewCourseEligibilityService(zap.NewNop(), &mocks.StubTxManager{}, s.eligibilityRepo, s.studentRepo)
+	s.ctx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestCreateRule_Success() {
+	s.eligibilityRepo.CreateRuleFn = func(_ context.Context, _ *sql.Tx, rule *aggregate.EligibilityRule) (*aggregate.EligibilityRule, error) {
+		return rule, nil
+	}
+
+	rule, err := s.svc.CreateRule(s.ctx, strPtr("comp3613"), nil, "B")
+	s.Require().NoError(err)
+	s.Equal("COMP3613", *rule.CourseCode)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestCreateRule_DuplicateTarget() {
+	existing, _ := aggregate.NewEligibilityRule(nil, levelPtr(2), "B")
+	s.eligibilityRepo.ListRulesFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+		return []*aggregate.EligibilityRule{existing}, nil
+	}
+
+	_, err := s.svc.CreateRule(s.ctx, nil, levelPtr(2), "A")
+	s.ErrorIs(err, studentErrors.ErrEligibilityRuleExists)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestCreateRule_MissingAuthContext() {
+	_, err := s.svc.CreateRule(context.Background(), nil, levelPtr(2), "A")
+	s.ErrorIs(err, studentErrors.ErrMissingAuthContext)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestUpdateRule_InvalidGrade() {
+	existing, _ := aggregate.NewEligibilityRule(nil, levelPtr(2), "B")
+	s.eligibilityRepo.GetRuleByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.EligibilityRule, error) {
+		return existing, nil
+	}
+
+	_, err := s.svc.UpdateRule(s.ctx, existing.ID, "Q")
+	s.ErrorIs(err, studentErrors.ErrInvalidGrade)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestSetOverride_Success() {
+	s.studentRepo.GetByIDIncludingDeactivatedFn = func(_ context.Context, _ *sql.Tx, id int32) (*aggregate.Student, error) {
+		return eligibilityStudent(), nil
+	}
+	s.eligibilityRepo.UpsertOverrideFn = func(_ context.Context, _ *sql.Tx, o *aggregate.EligibilityOverride) (*aggregate.EligibilityOverride, error) {
+		return o, nil
+	}
+
+	override, err := s.svc.SetOverride(s.ctx, 816000001, "comp 1601", true, "demonstrated in interview")
+	s.Require().NoError(err)
+	s.Equal("COMP1601", override.CourseCode)
+	s.Require().NotNil(override.CreatedBy)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestSetOverride_StudentNotFound() {
+	s.studentRepo.GetByIDIncludingDeactivatedFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.Student, error) {
+		return nil, studentErrors.ErrNotFound
+	}
+
+	_, err := s.svc.SetOverride(s.ctx, 1, "COMP1601", true, "reason")
+	s.ErrorIs(err, studentErrors.ErrNotFound)
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestGetMatrix_AllAccepted() {
+	s.studentRepo.ListByStatusFn = func(_ context.Context, _ *sql.Tx, status string) ([]*aggregate.Student, error) {
+		s.Equal("accepted", status)
+		return []*aggregate.Student{eligibilityStudent(course("COMP1601", "A"))}, nil
+	}
+	s.eligibilityRepo.ListOverridesFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*aggregate.EligibilityOverride, error) {
+		s.Nil(ids)
+		return []*aggregate.EligibilityOverride{}, nil
+	}
+
+	rows, err := s.svc.GetMatrix(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	s.Equal([]string{"COMP1601"}, rows[0].EligibleCourses())
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestEvaluate_AppliesRulesAndOverrides() {
+	rule, _ := aggregate.NewEligibilityRule(nil, levelPtr(1), "A")
+	s.eligibilityRepo.ListRulesFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+		return []*aggregate.EligibilityRule{rule}, nil
+	}
+	s.eligibilityRepo.ListOverridesFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*aggregate.EligibilityOverride, error) {
+		s.Equal([]int32{816000001}, ids)
+		o, _ := aggregate.NewEligibilityOverride(816000001, "COMP1602", true, "reason", nil)
+		return []*aggregate.EligibilityOverride{o}, nil
+	}
+
+	rows, err := s.svc.Evaluate(s.ctx, []*aggregate.Student{
+		eligibilityStudent(course("COMP1601", "B+"), course("COMP1602", "B+")),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rows, 1)
+	s.Equal([]string{"COMP1602"}, rows[0].EligibleCourses())
+}
+
+func (s *CourseEligibilityServiceTestSuite) TestEvaluate_NoStudents() {
+	s.eligibilityRepo.ListRulesFn = nil
+
+	rows, err := s.svc.Evaluate(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Empty(rows)
+}
@@ -0,0 +1,219 @@
+package student_test
+
+import (
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type CourseEligibilityTestSuite struct {
+	suite.Suite
+}
+
+func TestCourseEligibilityTestSuite(t *testing.T) {
+	suite.Run(t, new(CourseEligibilityTestSuite))
+}
+
+func eligibilityStudent(courses ...types.CourseResult) *aggregate.Student {
+	return &aggregate.Student{
+		StudentID:          816000001,
+		FirstName:          "Jane",
+		LastName:           "Doe",
+		TranscriptMetadata: types.TranscriptMetadata{Courses: courses},
+	}
+}
+
+func course(code, grade string) types.CourseResult {
+	c := types.CourseResult{Code: code, Title: code + " title"}
+	if grade != "" {
+		c.Grade = &grade
+	}
+	return c
+}
+
+func levelPtr(l int32) *int32 { return &l }
+
+func findCourse(e *aggregate.StudentEligibility, code string) *aggregate.CourseEligibility {
+	for i := range e.Courses {
+		if e.Courses[i].CourseCode == code {
+			return &e.Courses[i]
+		}
+	}
+	return nil
+}
+
+// --- Grades ---
+
+func (s *CourseEligibilityTestSuite) TestGradeAtLeast() {
+	s.True(aggregate.GradeAtLeast("A", "C"))
+	s.True(aggregate.GradeAtLeast("C", "C"))
+	s.True(aggregate.GradeAtLeast(" b+ ", "B"))
+	s.False(aggregate.GradeAtLeast("C+", "B-"))
+	s.False(aggregate.GradeAtLeast("F1", "D"))
+	s.False(aggregate.GradeAtLeast("P", "D"))
+	s.False(aggregate.GradeAtLeast("A", "Z"))
+}
+
+func (s *CourseEligibilityTestSuite) TestCourseLevel() {
+	level, ok := aggregate.CourseLevel("COMP1601")
+	s.True(ok)
+	s.Equal(int32(1), level)
+
+	level, ok = aggregate.CourseLevel("info 3604")
+	s.True(ok)
+	s.Equal(int32(3), level)
+
+	_, ok = aggregate.CourseLevel("COMP0601")
+	s.False(ok)
+
+	_, ok = aggregate.CourseLevel("COMP")
+	s.False(ok)
+}
+
+// --- Rules ---
+
+func (s *CourseEligibilityTestSuite) TestNewEligibilityRule_Course() {
+	rule, err := aggregate.NewEligibilityRule(strPtr(" comp 1601 "), nil, "b+")
+	s.Require().NoError(err)
+	s.Equal("COMP1601", *rule.CourseCode)
+	s.Nil(rule.CourseLevel)
+	s.Equal("B+", rule.MinGrade)
+}
+
+func (s *CourseEligibilityTestSuite) TestNewEligibilityRule_Level() {
+	rule, err := aggregate.NewEligibilityRule(nil, levelPtr(2), "B")
+	s.Require().NoError(err)
+	s.Nil(rule.CourseCode)
+	s.Equal(int32(2), *rule.CourseLevel)
+}
+
+func (s *CourseEligibilityTestSuite) TestNewEligibilityRule_Validation() {
+	_, err := aggregate.NewEligibilityRule(nil, nil, "B")
+	s.ErrorIs(err, studentErrors.ErrInvalidEligibilityRuleTarget)
+
+	_, err = aggregate.NewEligibilityRule(strPtr("COMP1601"), levelPtr(1), "B")
+	s.ErrorIs(err, studentErrors.ErrInvalidEligibilityRuleTarget)
+
+	_, err = aggregate.NewEligibilityRule(strPtr("COMP"), nil, "B")
+	s.ErrorIs(err, studentErrors.ErrInvalidCourseCode)
+
+	_, err = aggregate.NewEligibilityRule(nil, levelPtr(0), "B")
+	s.ErrorIs(err, studentErrors.ErrInvalidCourseLevel)
+
+	_, err = aggregate.NewEligibilityRule(nil, levelPtr(1), "E")
+	s.ErrorIs(err, studentErrors.ErrInvalidGrade)
+}
+
+func (s *CourseEligibilityTestSuite) TestNewEligibilityOverride_RequiresReason() {
+	_, err := aggregate.NewEligibilityOverride(1, "COMP1601", true, "  ", nil)
+	s.ErrorIs(err, studentErrors.ErrOverrideReasonRequired)
+}
+
+// --- Evaluate ---
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_LegacyAndCompactCodes() {
+	code := "COMP1602"
+	policy := aggregate.NewEligibilityPolicy([]*aggregate.EligibilityRule{{CourseCode: &code, MinGrade: "A"}})
+	result := policy.Evaluate(eligibilityStudent(
+		course("COMP 1601", "B"),
+		course("comp 1602", "B"),
+		course("COMP1602", "A"),
+	), nil)
+
+	// The legacy and compact forms of COMP1602 are one course, judged on the
+	// best grade, and the rule written in compact form applies to both.
+	s.Len(result.Courses, 2)
+	s.Equal([]string{"COMP1601", "COMP1602"}, result.EligibleCourses())
+}
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_DefaultMinGrade() {
+	policy := aggregate.NewEligibilityPolicy(nil)
+	result := policy.Evaluate(eligibilityStudent(
+		course("COMP1601", "C"),
+		course("COMP1602", "D+"),
+		course("COMP1603", ""),
+	), nil)
+
+	s.Equal("Jane Doe", result.StudentName)
+	s.Equal([]string{"COMP1601"}, result.EligibleCourses())
+	c := findCourse(result, "COMP1602")
+	s.Require().NotNil(c)
+	s.Equal(aggregate.DefaultMinGrade, c.MinGrade)
+	s.Equal(aggregate.EligibilitySource_Default, c.Source)
+}
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_CourseRuleBeatsLevelRule() {
+	courseRule, _ := aggregate.NewEligibilityRule(strPtr("COMP2603"), nil, "A-")
+	levelRule, _ := aggregate.NewEligibilityRule(nil, levelPtr(2), "B")
+	policy := aggregate.NewEligibilityPolicy([]*aggregate.EligibilityRule{courseRule, levelRule})
+
+	result := policy.Evaluate(eligibilityStudent(
+		course("COMP2603", "B+"),
+		course("COMP2601", "B"),
+		course("COMP1601", "C"),
+	), nil)
+
+	c := findCourse(result, "COMP2603")
+	s.Require().NotNil(c)
+	s.False(c.Eligible)
+	s.Equal("A-", c.MinGrade)
+	s.Equal(aggregate.EligibilitySource_CourseRule, c.Source)
+
+	c = findCourse(result, "COMP2601")
+	s.Require().NotNil(c)
+	s.True(c.Eligible)
+	s.Equal(aggregate.EligibilitySource_LevelRule, c.Source)
+
+	s.Equal([]string{"COMP2601", "COMP1601"}, result.EligibleCourses())
+}
+
+func (s *CourseEligibilityTestSuite) TestQualifyingGrades() {
+	levelRule, _ := aggregate.NewEligibilityRule(nil, levelPtr(2), "B+")
+	policy := aggregate.NewEligibilityPolicy([]*aggregate.EligibilityRule{levelRule})
+
+	s.Equal([]string{"A+", "A", "A-", "B+"}, policy.QualifyingGrades("comp 2603"))
+	s.Equal([]string{"A+", "A", "A-", "B+", "B", "B-", "C+", "C"}, policy.QualifyingGrades("COMP1601"))
+}
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_RetakeUsesBestGrade() {
+	policy := aggregate.NewEligibilityPolicy(nil)
+	result := policy.Evaluate(eligibilityStudent(
+		course("COMP1601", "F1"),
+		course("comp1601", "B"),
+		course("COMP1601", "EI"),
+	), nil)
+
+	s.Require().Len(result.Courses, 1)
+	s.Equal("B", *result.Courses[0].Grade)
+	s.True(result.Courses[0].Eligible)
+}
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_OverridesWin() {
+	policy := aggregate.NewEligibilityPolicy(nil)
+	student := eligibilityStudent(
+		course("COMP1601", "A"),
+		course("COMP1602", "D"),
+	)
+	deny, _ := aggregate.NewEligibilityOverride(student.StudentID, "COMP1601", false, "academic misconduct", nil)
+	allow, _ := aggregate.NewEligibilityOverride(student.StudentID, "COMP1602", true, "lab demonstrator", nil)
+	extra, _ := aggregate.NewEligibilityOverride(student.StudentID, "info 3604", true, "took equivalent course", nil)
+	other, _ := aggregate.NewEligibilityOverride(1, "COMP1603", true, "different student", nil)
+
+	result := policy.Evaluate(student, []*aggregate.EligibilityOverride{deny, allow, extra, other})
+
+	s.Equal([]string{"COMP1602", "INFO3604"}, result.EligibleCourses())
+	c := findCourse(result, "COMP1601")
+	s.Require().NotNil(c)
+	s.Equal(aggregate.EligibilitySource_Override, c.Source)
+	s.Equal("academic misconduct", *c.OverrideReason)
+	s.Nil(findCourse(result, "COMP1603"))
+}
+
+func (s *CourseEligibilityTestSuite) TestEvaluate_NoCourses() {
+	result := aggregate.NewEligibilityPolicy(nil).Evaluate(eligibilityStudent(), nil)
+	s.NotNil(result.EligibleCourses())
+	s.Empty(result.EligibleCourses())
+}
@@ -0,0 +1,155 @@
+package student_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ProfileChangeHandlerTestSuite struct {
+	suite.Suite
+	mockSvc    *mocks.MockProfileChangeService
+	studentSvc *mocks.MockStudentService
+	router     *chi.Mux
+}
+
+func TestProfileChangeHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileChangeHandlerTestSuite))
+}
+
+func (s *ProfileChangeHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockProfileChangeService{}
+	s.studentSvc = &mocks.MockStudentService{}
+	studentHdl := handler.NewStudentHandler(zap.NewNop(), nil, s.studentSvc, s.mockSvc, nil, nil, "", "")
+	hdl := handler.NewProfileChangeHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		studentHdl.RegisterRoutes(r)
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *ProfileChangeHandlerTestSuite) do(ctx context.Context, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body)).WithContext(ctx)
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func sampleChangeRequest() *aggregate.ProfileChangeRequest {
+	return &aggregate.ProfileChangeRequest{
+		ID:           uuid.New(),
+		StudentID:    816000001,
+		Status:       aggregate.ProfileChangeStatus_Pending,
+		Availability: aggregate.Availability{"0": {9, 10}},
+		Conflicts:    []aggregate.StudentAssignment{{ShiftID: "wed", DayOfWeek: 2, Start: "13:00", End: "14:00"}},
+	}
+}
+
+func (s *ProfileChangeHandlerTestSuite) TestUpdateMe_CreatesChangeRequest() {
+	s.mockSvc.UpdateMineFn = func(_ context.Context, input service.UpdateStudentInput, change service.ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error) {
+		s.Equal("868-555-0100", *input.PhoneNumber)
+		s.JSONEq(`{"0": [9, 10]}`, string(*change.Availability))
+		s.Nil(change.MinWeeklyHours)
+		student := profileStudent()
+		student.PhoneNumber = *input.PhoneNumber
+		return student, sampleChangeRequest(), nil
+	}
+
+	rr := s.do(studentCtx("816000001"), http.MethodPut, "/api/v1/students/me",
+		`{"phone_number": "868-555-0100", "availability": {"0": [9, 10]}}`)
+	s.Require().Equal(http.StatusAccepted, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("868-555-0100", resp["phone_number"])
+	pending := resp["pending_change_request"].(map[string]any)
+	s.Equal("pending", pending["status"])
+	conflicts := pending["conflicts"].([]any)
+	s.Require().Len(conflicts, 1)
+	s.Equal("wed", conflicts[0].(map[string]any)["shift_id"])
+}
+
+func (s *ProfileChangeHandlerTestSuite) TestUpdateMe_UnchangedFieldsApplyDirectly() {
+	s.mockSvc.UpdateMineFn = func(_ context.Context, input service.UpdateStudentInput, change service.ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error) {
+		s.Nil(input.PhoneNumber)
+		s.Equal(4.0, *change.MinWeeklyHours)
+		return profileStudent(), nil, nil
+	}
+
+	rr := s.do(studentCtx("816000001"), http.MethodPut, "/api/v1/students/me", `{"min_weekly_hours": 4}`)
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.NotContains(resp, "pending_change_request")
+}
+
+func (s *ProfileChangeHandlerTestSuite) TestUpdateMe_InvalidChange() {
+	s.mockSvc.UpdateMineFn = func(context.Context, service.UpdateStudentInput, service.ProfileChangeInput) (*aggregate.Student, *aggregate.ProfileChangeRequest, error) {
+		return nil, nil, studentErrors.ErrInvalidWeeklyHours
+	}
+
+	rr := s.do(studentCtx("816000001"), http.MethodPut, "/api/v1/students/me",
+		`{"phone_number": "868-555-0100", "min_weekly_hours": 40}`)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *ProfileChangeHandlerTestSuite) TestApprove() {
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	req := sampleChangeRequest()
+	s.mockSvc.ApproveFn = func(_ context.Context, id uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+		s.Equal(req.ID, id)
+		s.Equal("ok", note)
+		req.Status = aggregate.ProfileChangeStatus_Approved
+		return req, nil
+	}
+
+	rr := s.do(adminCtx, http.MethodPost, "/api/v1/profile-change-requests/"+req.ID.String()+"/approve", `{"note":"ok"}`)
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("approved", resp["status"])
+}
+
+func (s *ProfileChangeHandlerTestSuite) TestReview_Errors() {
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	s.mockSvc.RejectFn = func(_ context.Context, _ uuid.UUID, note string) (*aggregate.ProfileChangeRequest, error) {
+		s.Empty(note)
+		return nil, studentErrors.ErrProfileChangeNotPending
+	}
+	rr := s.do(adminCtx, http.MethodPost, "/api/v1/profile-change-requests/"+uuid.NewString()+"/reject", "")
+	s.Equal(http.StatusConflict, rr.Code)
+
+	s.mockSvc.GetByIDFn = func(context.Context, uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+		return nil, studentErrors.ErrProfileChangeNotFound
+	}
+	rr = s.do(adminCtx, http.MethodGet, "/api/v1/profile-change-requests/"+uuid.NewString(), "")
+	s.Equal(http.StatusNotFound, rr.Code)
+
+	rr = s.do(adminCtx, http.MethodGet, "/api/v1/profile-change-requests/not-a-uuid", "")
+	s.Equal(http.StatusBadRequest, rr.Code)
+
+	s.mockSvc.ListFn = func(_ context.Context, status string) ([]*aggregate.ProfileChangeRequest, error) {
+		s.Equal("done", status)
+		return nil, studentErrors.ErrInvalidChangeRequestStatus
+	}
+	rr = s.do(adminCtx, http.MethodGet, "/api/v1/profile-change-requests?status=done", "")
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,137 @@
+package student_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProfileChangeRequestTestSuite struct {
+	suite.Suite
+}
+
+func TestProfileChangeRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileChangeRequestTestSuite))
+}
+
+func rawJSON(s string) *json.RawMessage {
+	raw := json.RawMessage(s)
+	return &raw
+}
+
+func profileStudent() *aggregate.Student {
+	return &aggregate.Student{
+		StudentID:      816000001,
+		Availability:   aggregate.Availability{"0": {9, 10, 11}, "2": {13, 14}},
+		MinWeeklyHours: 4,
+		MaxWeeklyHours: floatPtr(10),
+	}
+}
+
+func (s *ProfileChangeRequestTestSuite) TestNewProfileChangeRequest_KeepsChangedFields() {
+	req, err := aggregate.NewProfileChangeRequest(profileStudent(),
+		rawJSON(`{"0": [9, 10]}`), floatPtr(4), floatPtr(12))
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.ProfileChangeStatus_Pending, req.Status)
+	s.Equal(aggregate.Availability{"0": {9, 10}}, req.Availability)
+	s.Nil(req.MinWeeklyHours)
+	s.Equal(12.0, *req.MaxWeeklyHours)
+}
+
+func (s *ProfileChangeRequestTestSuite) TestNewProfileChangeRequest_Unchanged() {
+	// Order, duplicates and empty days are not changes.
+	_, err := aggregate.NewProfileChangeRequest(profileStudent(),
+		rawJSON(`{"2": [14, 13], "0": [11, 9, 10, 9], "4": []}`), floatPtr(4), floatPtr(10))
+	s.ErrorIs(err, studentErrors.ErrNoProfileChanges)
+}
+
+func (s *ProfileChangeRequestTestSuite) TestNewProfileChangeRequest_Invalid() {
+	_, err := aggregate.NewProfileChangeRequest(profileStudent(), rawJSON(`{"5": [9]}`), nil, nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidAvailability)
+
+	// The bound is checked against the max already on the profile.
+	_, err = aggregate.NewProfileChangeRequest(profileStudent(), nil, floatPtr(12), nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidWeeklyHours)
+
+	_, err = aggregate.NewProfileChangeRequest(profileStudent(), nil, floatPtr(-1), nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidWeeklyHours)
+}
+
+func (s *ProfileChangeRequestTestSuite) TestApplyTo() {
+	student := profileStudent()
+	req, err := aggregate.NewProfileChangeRequest(student, rawJSON(`{"1": [8]}`), floatPtr(6), nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(req.ApplyTo(student))
+	s.Equal(aggregate.Availability{"1": {8}}, student.Availability)
+	s.Equal(6.0, student.MinWeeklyHours)
+	s.Equal(10.0, *student.MaxWeeklyHours)
+
+	// An admin lowering the max after the request makes the min invalid.
+	student.MaxWeeklyHours = floatPtr(5)
+	s.ErrorIs(req.ApplyTo(student), studentErrors.ErrInvalidWeeklyHours)
+}
+
+func (s *ProfileChangeRequestTestSuite) TestReview() {
+	now := time.Date(2026, 4, 7, 9, 0, 0, 0, time.UTC)
+	reviewer := uuid.New()
+	req, err := aggregate.NewProfileChangeRequest(profileStudent(), nil, nil, floatPtr(8))
+	s.Require().NoError(err)
+
+	s.Require().NoError(req.Reject(&reviewer, " mid-semester ", now))
+	s.Equal(aggregate.ProfileChangeStatus_Rejected, req.Status)
+	s.Equal("mid-semester", *req.ReviewNote)
+	s.Equal(&reviewer, req.ReviewedBy)
+
+	s.ErrorIs(req.Approve(&reviewer, "", now), studentErrors.ErrProfileChangeNotPending)
+	s.ErrorIs(req.Supersede(now), studentErrors.ErrProfileChangeNotPending)
+}
+
+func (s *ProfileChangeRequestTestSuite) TestFindConflicts() {
+	assignments := []aggregate.StudentAssignment{
+		{ShiftID: "a", DayOfWeek: 0, Start: "09:00", End: "11:00"},
+		{ShiftID: "b", DayOfWeek: 0, Start: "10:30", End: "11:30"},
+		{ShiftID: "c", DayOfWeek: 2, Start: "13:00", End: "14:00"},
+	}
+
+	req, err := aggregate.NewProfileChangeRequest(profileStudent(), rawJSON(`{"0": [9, 10]}`), nil, nil)
+	s.Require().NoError(err)
+	conflicts := req.FindConflicts(assignments)
+	s.Require().Len(conflicts, 2)
+	s.Equal("b", conflicts[0].ShiftID)
+	s.Equal("c", conflicts[1].ShiftID)
+
+	hoursOnly, err := aggregate.NewProfileChangeRequest(profileStudent(), nil, nil, floatPtr(8))
+	s.Require().NoError(err)
+	s.Empty(hoursOnly.FindConflicts(assignments))
+}
+
+func (s *ProfileChangeRequestTestSuite) TestWithinAvailability() {
+	avail := aggregate.Availability{"1": {9, 10}}
+
+	s.True(aggregate.StudentAssignment{DayOfWeek: 1, Start: "09:00", End: "11:00"}.WithinAvailability(avail))
+	s.True(aggregate.StudentAssignment{DayOfWeek: 1, Start: "09:30:00", End: "10:30:00"}.WithinAvailability(avail))
+	s.False(aggregate.StudentAssignment{DayOfWeek: 1, Start: "10:00", End: "11:15"}.WithinAvailability(avail))
+	s.False(aggregate.StudentAssignment{DayOfWeek: 0, Start: "09:00", End: "10:00"}.WithinAvailability(avail))
+	s.False(aggregate.StudentAssignment{DayOfWeek: 1, Start: "bad", End: "10:00"}.WithinAvailability(avail))
+}
+
+func (s *ProfileChangeRequestTestSuite) TestModelRoundTrip() {
+	req, err := aggregate.NewProfileChangeRequest(profileStudent(), rawJSON(`{"3": [12]}`), nil, nil)
+	s.Require().NoError(err)
+	req.Conflicts = []aggregate.StudentAssignment{{ShiftID: "a", DayOfWeek: 0, Start: "09:00", End: "10:00"}}
+
+	m := req.ToModel()
+	s.Nil(m.MinWeeklyHours)
+	back, err := aggregate.ProfileChangeRequestFromModel(&m)
+	s.Require().NoError(err)
+	s.Equal(req.Availability, back.Availability)
+	s.Equal(req.Conflicts, back.Conflicts)
+	s.Nil(back.MaxWeeklyHours)
+}
@@ -0,0 +1,263 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ProfileChangeServiceTestSuite struct {
+	suite.Suite
+	requestRepo  *mocks.MockProfileChangeRequestRepository
+	studentRepo  *mocks.MockStudentRepository
+	scheduleRepo *mocks.MockScheduleRepository
+	student      *aggregate.Student
+	saved        map[uuid.UUID]*aggregate.ProfileChangeRequest
+	studentSaves int
+	assignments  string
+	svc          *service.ProfileChangeService
+	now          time.Time
+}
+
+func TestProfileChangeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfileChangeServiceTestSuite))
+}
+
+func (s *ProfileChangeServiceTestSuite) SetupTest() {
+	s.now = time.Date(2026, 4, 7, 9, 0, 0, 0, time.UTC)
+	s.saved = map[uuid.UUID]*aggregate.ProfileChangeRequest{}
+	s.studentSaves = 0
+	s.student = profileStudent()
+	s.assignments = `[
+		{"assistant_id": "816000001", "shift_id": "mon", "day_of_week": 0, "start": "09:00", "end": "11:00"},
+		{"assistant_id": "816000001", "shift_id": "wed", "day_of_week": 2, "start": "13:00", "end": "14:00"},
+		{"assistant_id": "816000002", "shift_id": "other", "day_of_week": 2, "start": "13:00", "end": "14:00"}
+	]`
+
+	s.requestRepo = &mocks.MockProfileChangeRequestRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, req *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+			s.saved[req.ID] = req
+			return req, nil
+		},
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.ProfileChangeRequest, error) {
+			req, ok := s.saved[id]
+			if !ok {
+				return nil, studentErrors.ErrProfileChangeNotFound
+			}
+			return req, nil
+		},
+		GetPendingByStudentIDFn: func(_ context.Context, _ *sql.Tx, studentID int32) (*aggregate.ProfileChangeRequest, error) {
+			for _, req := range s.saved {
+				if req.StudentID == studentID && req.IsPending() {
+					return req, nil
+				}
+			}
+			return nil, studentErrors.ErrProfileChangeNotFound
+		},
+		ListFn: func(_ context.Context, _ *sql.Tx, filter repository.ProfileChangeFilter) ([]*aggregate.ProfileChangeRequest, error) {
+			result := []*aggregate.ProfileChangeRequest{}
+			for _, req := range s.saved {
+				if filter.StudentID != nil && req.StudentID != *filter.StudentID {
+					continue
+				}
+				if filter.Status != nil && req.Status != *filter.Status {
+					continue
+				}
+				result = append(result, req)
+			}
+			return result, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, req *aggregate.ProfileChangeRequest) (*aggregate.ProfileChangeRequest, error) {
+			s.saved[req.ID] = req
+			return req, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.Student, error) {
+			return s.student, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, student *aggregate.Student) error {
+			s.student = student
+			s.studentSaves++
+			return nil
+		},
+	}
+	s.scheduleRepo = &mocks.MockScheduleRepository{
+		GetActiveFn: func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+			return &scheduleAggregate.Schedule{Assignments: json.RawMessage(s.assignments)}, nil
+		},
+	}
+
+	s.svc = service.NewProfileChangeService(zap.NewNop(), &mocks.StubTxManager{}, s.requestRepo, s.studentRepo, s.scheduleRepo, &mocks.MockCourseRepository{})
+	s.svc.WithNowFn(func() time.Time { return s.now })
+}
+
+func (s *ProfileChangeServiceTestSuite) adminCtx() context.Context {
+	return database.WithAuthContext(context.Background(), *adminAuthCtx())
+}
+
+func (s *ProfileChangeServiceTestSuite) TestRequest_ReportsConflictsWithoutApplying() {
+	req, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{
+		Availability: rawJSON(`{"0": [9, 10], "3": [9]}`),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.ProfileChangeStatus_Pending, req.Status)
+	s.Require().Len(req.Conflicts, 1)
+	s.Equal("wed", req.Conflicts[0].ShiftID)
+	s.Equal(aggregate.Availability{"0": {9, 10, 11}, "2": {13, 14}}, s.student.Availability)
+	s.Zero(s.studentSaves)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestRequest_SupersedesPending() {
+	first, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{MaxWeeklyHours: floatPtr(8)})
+	s.Require().NoError(err)
+	second, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{MaxWeeklyHours: floatPtr(6)})
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.ProfileChangeStatus_Superseded, s.saved[first.ID].Status)
+	s.Equal(aggregate.ProfileChangeStatus_Pending, s.saved[second.ID].Status)
+
+	mine, err := s.svc.ListMine(studentCtx("816000001"))
+	s.Require().NoError(err)
+	s.Len(mine, 2)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestRequest_Errors() {
+	_, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{MinWeeklyHours: floatPtr(4)})
+	s.ErrorIs(err, studentErrors.ErrNoProfileChanges)
+
+	_, err = s.svc.Request(s.adminCtx(), service.ProfileChangeInput{MinWeeklyHours: floatPtr(2)})
+	s.ErrorIs(err, studentErrors.ErrNotAuthorized)
+	s.Empty(s.saved)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestRequest_NoActiveSchedule() {
+	s.scheduleRepo.GetActiveFn = func(context.Context, *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return nil, scheduleErrors.ErrNotFound
+	}
+
+	req, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{Availability: rawJSON(`{"4": [8]}`)})
+	s.Require().NoError(err)
+	s.Empty(req.Conflicts)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestUpdateMine_SavesProfileAndRequest() {
+	phone := "868-555-0100"
+	student, req, err := s.svc.UpdateMine(studentCtx("816000001"),
+		service.UpdateStudentInput{PhoneNumber: &phone},
+		service.ProfileChangeInput{MaxWeeklyHours: floatPtr(6)})
+	s.Require().NoError(err)
+
+	s.Equal(phone, student.PhoneNumber)
+	s.Equal(1, s.studentSaves)
+	s.Require().NotNil(req)
+	s.Equal(aggregate.ProfileChangeStatus_Pending, s.saved[req.ID].Status)
+	s.Equal(10.0, *s.student.MaxWeeklyHours, "the hours wait for approval")
+}
+
+func (s *ProfileChangeServiceTestSuite) TestUpdateMine_UnchangedRequestIsNotAChange() {
+	phone := "868-555-0100"
+	student, req, err := s.svc.UpdateMine(studentCtx("816000001"),
+		service.UpdateStudentInput{PhoneNumber: &phone},
+		service.ProfileChangeInput{MinWeeklyHours: floatPtr(4)})
+	s.Require().NoError(err)
+
+	s.Nil(req)
+	s.Empty(s.saved)
+	s.Equal(phone, student.PhoneNumber)
+	s.Equal(1, s.studentSaves)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestUpdateMine_InvalidChangeSavesNothing() {
+	phone := "868-555-0100"
+	_, _, err := s.svc.UpdateMine(studentCtx("816000001"),
+		service.UpdateStudentInput{PhoneNumber: &phone},
+		service.ProfileChangeInput{MinWeeklyHours: floatPtr(40)})
+
+	s.ErrorIs(err, studentErrors.ErrInvalidWeeklyHours)
+	s.Empty(s.saved)
+	s.Zero(s.studentSaves)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestUpdateMine_ProfileSaveFailureFailsTheRequest() {
+	s.studentRepo.UpdateFn = func(context.Context, *sql.Tx, *aggregate.Student) error {
+		return errors.New("connection reset")
+	}
+	phone := "868-555-0100"
+
+	student, req, err := s.svc.UpdateMine(studentCtx("816000001"),
+		service.UpdateStudentInput{PhoneNumber: &phone},
+		service.ProfileChangeInput{MaxWeeklyHours: floatPtr(6)})
+
+	// The request was written in the same transaction, which rolls back.
+	s.Error(err)
+	s.Nil(student)
+	s.Nil(req)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestList_RefreshesPendingConflicts() {
+	req, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{Availability: rawJSON(`{"0": [9, 10]}`)})
+	s.Require().NoError(err)
+	s.Len(req.Conflicts, 1)
+
+	// The Wednesday shift was reassigned since the request.
+	s.assignments = `[{"assistant_id": "816000001", "shift_id": "mon", "day_of_week": 0, "start": "09:00", "end": "11:00"}]`
+
+	pending, err := s.svc.List(s.adminCtx(), "pending")
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Empty(pending[0].Conflicts)
+
+	_, err = s.svc.List(s.adminCtx(), "bogus")
+	s.ErrorIs(err, studentErrors.ErrInvalidChangeRequestStatus)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestApprove_AppliesChange() {
+	req, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{
+		Availability:   rawJSON(`{"0": [9, 10]}`),
+		MaxWeeklyHours: floatPtr(6),
+	})
+	s.Require().NoError(err)
+
+	approved, err := s.svc.Approve(s.adminCtx(), req.ID, "ok")
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.ProfileChangeStatus_Approved, approved.Status)
+	s.Equal(aggregate.Availability{"0": {9, 10}}, s.student.Availability)
+	s.Equal(6.0, *s.student.MaxWeeklyHours)
+	s.Equal(1, s.studentSaves)
+	s.Len(approved.Conflicts, 1)
+
+	_, err = s.svc.Approve(s.adminCtx(), req.ID, "")
+	s.ErrorIs(err, studentErrors.ErrProfileChangeNotPending)
+}
+
+func (s *ProfileChangeServiceTestSuite) TestReject_LeavesProfile() {
+	req, err := s.svc.Request(studentCtx("816000001"), service.ProfileChangeInput{MinWeeklyHours: floatPtr(8)})
+	s.Require().NoError(err)
+
+	rejected, err := s.svc.Reject(s.adminCtx(), req.ID, "not this semester")
+	s.Require().NoError(err)
+	s.Equal(aggregate.ProfileChangeStatus_Rejected, rejected.Status)
+	s.Equal(4.0, s.student.MinWeeklyHours)
+	s.Zero(s.studentSaves)
+
+	_, err = s.svc.Reject(s.adminCtx(), uuid.New(), "")
+	s.ErrorIs(err, studentErrors.ErrProfileChangeNotFound)
+}
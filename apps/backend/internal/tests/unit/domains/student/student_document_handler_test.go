@@ -0,0 +1,181 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type StudentDocumentHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockStudentDocumentService
+	router  *chi.Mux
+}
+
+func TestStudentDocumentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentDocumentHandlerTestSuite))
+}
+
+func (s *StudentDocumentHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockStudentDocumentService{}
+	hdl := handler.NewStudentDocumentHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *StudentDocumentHandlerTestSuite) do(req *http.Request) *httptest.ResponseRecorder {
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *StudentDocumentHandlerTestSuite) upload(path, documentType, filename string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	s.Require().NoError(mw.WriteField("document_type", documentType))
+	fw, err := mw.CreateFormFile("file", filename)
+	s.Require().NoError(err)
+	_, err = fw.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return s.do(req)
+}
+
+func sampleDocument() *aggregate.StudentDocument {
+	studentID := int32(816000001)
+	return &aggregate.StudentDocument{
+		ID:           uuid.New(),
+		StudentID:    &studentID,
+		DocumentType: aggregate.DocumentType_Transcript,
+		Filename:     "transcript.pdf",
+		ContentType:  "application/pdf",
+		SizeBytes:    int64(len(samplePDF)),
+		Checksum:     strings.Repeat("a", 64),
+		RetainUntil:  time.Date(2028, 4, 5, 9, 0, 0, 0, time.UTC),
+		CreatedAt:    time.Date(2026, 4, 5, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestUploadForStudent() {
+	s.mockSvc.UploadDocumentByStudentIDFn = func(_ context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+		s.Equal(int32(816000001), studentID)
+		s.Equal("transcript", documentType)
+		s.Equal("transcript.pdf", filename)
+		s.Equal(samplePDF, content)
+		return sampleDocument(), nil
+	}
+
+	rr := s.upload("/api/v1/students/816000001/documents", "transcript", "transcript.pdf", samplePDF)
+	s.Equal(http.StatusCreated, rr.Code)
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("transcript", resp["document_type"])
+	s.Equal(float64(816000001), resp["student_id"])
+	s.Nil(resp["purged_at"])
+	s.NotContains(resp, "storage_key")
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestUploadMine_Errors() {
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{studentErrors.ErrInvalidDocumentType, http.StatusBadRequest},
+		{studentErrors.ErrInvalidDocumentFile, http.StatusBadRequest},
+		{studentErrors.ErrDocumentTooLarge, http.StatusRequestEntityTooLarge},
+		{studentErrors.ErrNotAuthorized, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		s.mockSvc.UploadMyDocumentFn = func(_ context.Context, _, _ string, _ []byte) (*aggregate.StudentDocument, error) {
+			return nil, tc.err
+		}
+		rr := s.upload("/api/v1/students/me/documents", "id", "id.pdf", samplePDF)
+		s.Equal(tc.code, rr.Code, tc.err.Error())
+	}
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestDownload() {
+	doc := sampleDocument()
+	s.mockSvc.DownloadFn = func(_ context.Context, id uuid.UUID) (*aggregate.StudentDocument, []byte, error) {
+		s.Equal(doc.ID, id)
+		return doc, samplePDF, nil
+	}
+
+	rr := s.do(httptest.NewRequest(http.MethodGet, "/api/v1/student-documents/"+doc.ID.String()+"/content", nil))
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("application/pdf", rr.Header().Get("Content-Type"))
+	s.Equal(`attachment; filename="transcript.pdf"`, rr.Header().Get("Content-Disposition"))
+	s.Equal(doc.Checksum, rr.Header().Get("X-Checksum-SHA256"))
+	s.Equal(samplePDF, rr.Body.Bytes())
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestDownload_Errors() {
+	for err, code := range map[error]int{
+		studentErrors.ErrDocumentNotFound: http.StatusNotFound,
+		studentErrors.ErrDocumentPurged:   http.StatusGone,
+	} {
+		s.mockSvc.DownloadFn = func(_ context.Context, _ uuid.UUID) (*aggregate.StudentDocument, []byte, error) {
+			return nil, nil, err
+		}
+		rr := s.do(httptest.NewRequest(http.MethodGet, "/api/v1/student-documents/"+uuid.NewString()+"/content", nil))
+		s.Equal(code, rr.Code, err.Error())
+	}
+
+	rr := s.do(httptest.NewRequest(http.MethodGet, "/api/v1/student-documents/not-a-uuid/content", nil))
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestSetRetention() {
+	until := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockSvc.SetRetentionFn = func(_ context.Context, _ uuid.UUID, retainUntil time.Time) (*aggregate.StudentDocument, error) {
+		s.True(until.Equal(retainUntil))
+		doc := sampleDocument()
+		doc.RetainUntil = retainUntil
+		return doc, nil
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/student-documents/"+uuid.NewString()+"/retention",
+		strings.NewReader(`{"retain_until": "2030-01-01T00:00:00Z"}`))
+	rr := s.do(req)
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *StudentDocumentHandlerTestSuite) TestDelete() {
+	s.mockSvc.DeleteFn = func(_ context.Context, _ uuid.UUID) (*aggregate.StudentDocument, error) {
+		doc := sampleDocument()
+		now := time.Now()
+		doc.PurgedAt = &now
+		return doc, nil
+	}
+
+	rr := s.do(httptest.NewRequest(http.MethodDelete, "/api/v1/student-documents/"+uuid.NewString(), nil))
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.NotNil(resp["purged_at"])
+}
@@ -0,0 +1,246 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/storage"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// memoryStore is an in-memory storage.ObjectStore.
+type memoryStore struct {
+	objects map[string][]byte
+}
+
+func (m *memoryStore) Put(_ context.Context, key string, data []byte) error {
+	m.objects[key] = data
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
+
+type StudentDocumentServiceTestSuite struct {
+	suite.Suite
+	documentRepo *mocks.MockStudentDocumentRepository
+	studentRepo  *mocks.MockStudentRepository
+	store        *memoryStore
+	svc          *service.StudentDocumentService
+	now          time.Time
+	saved        map[uuid.UUID]*aggregate.StudentDocument
+}
+
+func TestStudentDocumentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentDocumentServiceTestSuite))
+}
+
+func (s *StudentDocumentServiceTestSuite) SetupTest() {
+	s.now = time.Date(2026, 4, 5, 9, 0, 0, 0, time.UTC)
+	s.saved = map[uuid.UUID]*aggregate.StudentDocument{}
+	s.store = &memoryStore{objects: map[string][]byte{}}
+	s.documentRepo = &mocks.MockStudentDocumentRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, d *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+			s.saved[d.ID] = d
+			return d, nil
+		},
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.StudentDocument, error) {
+			d, ok := s.saved[id]
+			if !ok {
+				return nil, studentErrors.ErrDocumentNotFound
+			}
+			return d, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, d *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+			s.saved[d.ID] = d
+			return d, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{
+		GetByIDIncludingDeactivatedFn: func(_ context.Context, _ *sql.Tx, id int32) (*aggregate.Student, error) {
+			return &aggregate.Student{StudentID: id}, nil
+		},
+	}
+	s.svc = service.NewStudentDocumentService(zap.NewNop(), &mocks.StubTxManager{}, s.documentRepo, s.studentRepo, s.store)
+	s.svc.WithNowFn(func() time.Time { return s.now })
+}
+
+func studentCtx(studentID string) context.Context {
+	return database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.NewString(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+}
+
+func (s *StudentDocumentServiceTestSuite) TestStorePending() {
+	doc, err := s.svc.StorePending(context.Background(), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+	s.Nil(doc.StudentID)
+	s.Equal(samplePDF, s.store.objects[doc.StorageKey])
+	s.Equal(s.now.Add(aggregate.PendingDocumentRetention), doc.RetainUntil)
+
+	_, err = s.svc.StorePending(context.Background(), "transcript.png", samplePNG)
+	s.ErrorIs(err, studentErrors.ErrInvalidDocumentFile)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestUploadMyDocument() {
+	doc, err := s.svc.UploadMyDocument(studentCtx("816000001"), "work_permit", "permit.pdf", samplePDF)
+	s.Require().NoError(err)
+	s.Equal(int32(816000001), *doc.StudentID)
+	s.NotNil(doc.UploadedBy)
+	s.Contains(s.store.objects, doc.StorageKey)
+
+	// Admins have no student ID of their own.
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	_, err = s.svc.UploadMyDocument(adminCtx, "id", "id.pdf", samplePDF)
+	s.ErrorIs(err, studentErrors.ErrNotAuthorized)
+
+	_, err = s.svc.UploadMyDocument(context.Background(), "id", "id.pdf", samplePDF)
+	s.ErrorIs(err, studentErrors.ErrMissingAuthContext)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestUpload_RemovesFileWhenInsertFails() {
+	s.studentRepo.GetByIDIncludingDeactivatedFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.Student, error) {
+		return nil, studentErrors.ErrNotFound
+	}
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+
+	_, err := s.svc.UploadDocumentByStudentID(adminCtx, 816000099, "id", "id.pdf", samplePDF)
+	s.ErrorIs(err, studentErrors.ErrNotFound)
+	s.Empty(s.store.objects)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestDownload() {
+	ctx := studentCtx("816000001")
+	doc, err := s.svc.UploadMyDocument(ctx, "id", "id.png", samplePNG)
+	s.Require().NoError(err)
+
+	got, content, err := s.svc.Download(ctx, doc.ID)
+	s.Require().NoError(err)
+	s.Equal(doc.ID, got.ID)
+	s.Equal(samplePNG, content)
+
+	_, _, err = s.svc.Download(ctx, uuid.New())
+	s.ErrorIs(err, studentErrors.ErrDocumentNotFound)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestDeletePurgesFileAndKeepsMetadata() {
+	ctx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	doc, err := s.svc.UploadDocumentByStudentID(ctx, 816000001, "id", "id.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	purged, err := s.svc.Delete(ctx, doc.ID)
+	s.Require().NoError(err)
+	s.True(purged.IsPurged())
+	s.NotContains(s.store.objects, doc.StorageKey)
+
+	_, _, err = s.svc.Download(ctx, doc.ID)
+	s.ErrorIs(err, studentErrors.ErrDocumentPurged)
+	_, err = s.svc.Delete(ctx, doc.ID)
+	s.ErrorIs(err, studentErrors.ErrDocumentPurged)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestSetRetention() {
+	ctx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	doc, err := s.svc.UploadDocumentByStudentID(ctx, 816000001, "id", "id.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	until := s.now.AddDate(3, 0, 0)
+	updated, err := s.svc.SetRetention(ctx, doc.ID, until)
+	s.Require().NoError(err)
+	s.Equal(until, updated.RetainUntil)
+
+	_, err = s.svc.SetRetention(ctx, doc.ID, s.now.Add(-time.Hour))
+	s.ErrorIs(err, studentErrors.ErrInvalidRetention)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestPurgeExpired_ContinuesPastFailures() {
+	first, _ := s.svc.StorePending(context.Background(), "a.pdf", samplePDF)
+	second, _ := s.svc.StorePending(context.Background(), "b.pdf", samplePDF)
+	s.documentRepo.ListExpiredFn = func(_ context.Context, _ *sql.Tx, now time.Time) ([]*aggregate.StudentDocument, error) {
+		s.Equal(s.now, now)
+		return []*aggregate.StudentDocument{first, second}, nil
+	}
+
+	updates := 0
+	s.documentRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, d *aggregate.StudentDocument) (*aggregate.StudentDocument, error) {
+		updates++
+		if d.ID == first.ID {
+			return nil, errors.New("connection reset")
+		}
+		return d, nil
+	}
+
+	purged, err := s.svc.PurgeExpired(context.Background())
+	s.Equal(1, purged)
+	s.ErrorContains(err, "connection reset")
+	s.Equal(2, updates)
+	s.Empty(s.store.objects)
+}
+
+func (s *StudentDocumentServiceTestSuite) TestApplyLinksTranscript() {
+	pending, err := s.svc.StorePending(context.Background(), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	studentRepo := &mocks.MockStudentRepository{
+		GetByEmailFn: func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.Student, error) {
+			return nil, studentErrors.ErrNotFound
+		},
+		CreateFn: func(_ context.Context, _ *sql.Tx, st *aggregate.Student) (*aggregate.Student, error) {
+			st.StudentID = 816000001
+			return st, nil
+		},
+	}
+	courseRepo := &mocks.MockCourseRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.Course, error) {
+			return []*scheduleAggregate.Course{}, nil
+		},
+	}
+	studentSvc := service.NewStudentService(zap.NewNop(), studentRepo, courseRepo, &mocks.MockApplicationReviewRepository{}, &mocks.MockCourseEligibilityRepository{}, s.documentRepo, &mocks.StubTxManager{})
+
+	input := service.ApplyInput{
+		Email:       "jane.doe@my.uwi.edu",
+		PhoneNumber: "868-555-0101",
+		TranscriptMetadata: types.TranscriptMetadata{
+			FirstName:        "Jane",
+			LastName:         "Doe",
+			StudentID:        "816000001",
+			CurrentProgramme: "BSc Computer Science",
+			CurrentYear:      2,
+		},
+		Availability:         json.RawMessage(`{"0": [9, 10]}`),
+		TranscriptDocumentID: &pending.ID,
+	}
+	_, err = studentSvc.Apply(context.Background(), input)
+	s.Require().NoError(err)
+	s.Require().NotNil(s.saved[pending.ID].StudentID)
+	s.Equal(int32(816000001), *s.saved[pending.ID].StudentID)
+
+	// The same transcript cannot be linked to a second application.
+	_, err = studentSvc.Apply(context.Background(), input)
+	s.ErrorIs(err, studentErrors.ErrDocumentAlreadyLinked)
+}
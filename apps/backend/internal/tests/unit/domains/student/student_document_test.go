@@ -0,0 +1,105 @@
+package student_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	samplePDF = []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\n%%EOF\n")
+	samplePNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+)
+
+type StudentDocumentTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestStudentDocumentTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentDocumentTestSuite))
+}
+
+func (s *StudentDocumentTestSuite) SetupTest() {
+	s.now = time.Date(2026, 4, 5, 9, 0, 0, 0, time.UTC)
+}
+
+func (s *StudentDocumentTestSuite) TestNewStudentDocument_Linked() {
+	studentID := int32(816000001)
+	doc, err := aggregate.NewStudentDocument(&studentID, "transcript", `C:\Users\jane\transcript.pdf`, samplePDF, nil, s.now)
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.DocumentType_Transcript, doc.DocumentType)
+	s.Equal("transcript.pdf", doc.Filename)
+	s.Equal("application/pdf", doc.ContentType)
+	s.Equal(int64(len(samplePDF)), doc.SizeBytes)
+	s.Len(doc.Checksum, 64)
+	s.Equal("documents/"+doc.ID.String(), doc.StorageKey)
+	s.Equal(s.now.Add(aggregate.DocumentRetention), doc.RetainUntil)
+}
+
+func (s *StudentDocumentTestSuite) TestNewStudentDocument_PendingHasShortRetention() {
+	doc, err := aggregate.NewStudentDocument(nil, "transcript", "transcript.pdf", samplePDF, nil, s.now)
+	s.Require().NoError(err)
+	s.Nil(doc.StudentID)
+	s.Equal(s.now.Add(aggregate.PendingDocumentRetention), doc.RetainUntil)
+}
+
+func (s *StudentDocumentTestSuite) TestNewStudentDocument_ContentTypes() {
+	_, err := aggregate.NewStudentDocument(nil, "id", "id.png", samplePNG, nil, s.now)
+	s.NoError(err)
+
+	_, err = aggregate.NewStudentDocument(nil, "transcript", "transcript.png", samplePNG, nil, s.now)
+	s.ErrorIs(err, studentErrors.ErrInvalidDocumentFile)
+
+	_, err = aggregate.NewStudentDocument(nil, "work_permit", "permit.txt", []byte("just some text"), nil, s.now)
+	s.ErrorIs(err, studentErrors.ErrInvalidDocumentFile)
+
+	_, err = aggregate.NewStudentDocument(nil, "id", "empty.pdf", nil, nil, s.now)
+	s.ErrorIs(err, studentErrors.ErrInvalidDocumentFile)
+
+	_, err = aggregate.NewStudentDocument(nil, "passport", "id.pdf", samplePDF, nil, s.now)
+	s.ErrorIs(err, studentErrors.ErrInvalidDocumentType)
+
+	big := append(bytes.Clone(samplePDF), make([]byte, aggregate.MaxDocumentSize)...)
+	_, err = aggregate.NewStudentDocument(nil, "transcript", "big.pdf", big, nil, s.now)
+	s.ErrorIs(err, studentErrors.ErrDocumentTooLarge)
+}
+
+func (s *StudentDocumentTestSuite) TestNewStudentDocument_SanitisesFilename() {
+	doc, err := aggregate.NewStudentDocument(nil, "transcript", "../../evil\"\n.pdf", samplePDF, nil, s.now)
+	s.Require().NoError(err)
+	s.Equal("evil.pdf", doc.Filename)
+
+	doc, err = aggregate.NewStudentDocument(nil, "transcript", "  ", samplePDF, nil, s.now)
+	s.Require().NoError(err)
+	s.Equal(doc.ID.String(), doc.Filename)
+}
+
+func (s *StudentDocumentTestSuite) TestLinkTo() {
+	doc, _ := aggregate.NewStudentDocument(nil, "transcript", "transcript.pdf", samplePDF, nil, s.now)
+	later := s.now.Add(time.Hour)
+
+	s.Require().NoError(doc.LinkTo(816000001, later))
+	s.Equal(int32(816000001), *doc.StudentID)
+	s.Equal(later.Add(aggregate.DocumentRetention), doc.RetainUntil)
+
+	s.ErrorIs(doc.LinkTo(816000002, later), studentErrors.ErrDocumentAlreadyLinked)
+}
+
+func (s *StudentDocumentTestSuite) TestSetRetentionAndPurge() {
+	doc, _ := aggregate.NewStudentDocument(nil, "id", "id.pdf", samplePDF, nil, s.now)
+
+	s.ErrorIs(doc.SetRetention(s.now, s.now), studentErrors.ErrInvalidRetention)
+	s.Require().NoError(doc.SetRetention(s.now.AddDate(5, 0, 0), s.now))
+	s.Equal(s.now.AddDate(5, 0, 0), doc.RetainUntil)
+
+	doc.MarkPurged(s.now)
+	s.True(doc.IsPurged())
+	s.ErrorIs(doc.SetRetention(s.now.AddDate(6, 0, 0), s.now), studentErrors.ErrDocumentPurged)
+	s.ErrorIs(doc.LinkTo(816000001, s.now), studentErrors.ErrDocumentPurged)
+}
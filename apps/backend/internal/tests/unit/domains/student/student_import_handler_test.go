@@ -0,0 +1,193 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type StudentImportHandlerTestSuite struct {
+	suite.Suite
+	mockSvc  *mocks.MockStudentService
+	mockAuth *mocks.MockAuthService
+	router   *chi.Mux
+	sent     sync.WaitGroup
+}
+
+func TestStudentImportHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentImportHandlerTestSuite))
+}
+
+func (s *StudentImportHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockStudentService{}
+	s.mockAuth = &mocks.MockAuthService{}
+	emailSender := &mocks.MockEmailSender{
+		SendFn: func(_ context.Context, _ emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+			s.sent.Done()
+			return &emailDtos.SendEmailResponse{}, nil
+		},
+	}
+	hdl := handler.NewStudentHandler(zap.NewNop(), nil, s.mockSvc, nil, s.mockAuth, emailSender, "", "")
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *StudentImportHandlerTestSuite) upload(path, filename, content string, fields map[string]string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for k, v := range fields {
+		s.Require().NoError(mw.WriteField(k, v))
+	}
+	fw, err := mw.CreateFormFile("file", filename)
+	s.Require().NoError(err)
+	_, err = fw.Write([]byte(content))
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *StudentImportHandlerTestSuite) TestPreviewImport_Success() {
+	s.mockSvc.PreviewImportFn = func(_ context.Context, r io.Reader, format spreadsheet.Format) (*service.StudentImportResult, error) {
+		s.Equal(spreadsheet.Format_XLSX, format)
+		data, _ := io.ReadAll(r)
+		s.Equal("workbook", string(data))
+		return &service.StudentImportResult{
+			Students: []*aggregate.Student{{StudentID: 816000001, FirstName: "Jane", LastName: "Doe"}},
+			Errors:   []service.StudentImportRowError{{Line: 3, StudentID: "816000002", Error: "invalid email"}},
+		}, nil
+	}
+
+	rr := s.upload("/api/v1/students/import/preview", "roster.xlsx", "workbook", nil)
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp dtos.StudentImportResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Len(resp.Students, 1)
+	s.Require().Len(resp.Errors, 1)
+	s.Equal(3, resp.Errors[0].Line)
+}
+
+func (s *StudentImportHandlerTestSuite) TestPreviewImport_UnsupportedExtension() {
+	rr := s.upload("/api/v1/students/import/preview", "roster.xls", "workbook", nil)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *StudentImportHandlerTestSuite) TestImport_InvalidFile() {
+	s.mockSvc.ImportFn = func(_ context.Context, _ io.Reader, _ spreadsheet.Format, _ bool) (*service.StudentImportResult, error) {
+		return nil, studentErrors.ErrInvalidStudentImport
+	}
+
+	rr := s.upload("/api/v1/students/import", "roster.csv", "", nil)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *StudentImportHandlerTestSuite) TestImport_RowErrors() {
+	s.mockSvc.ImportFn = func(_ context.Context, _ io.Reader, _ spreadsheet.Format, _ bool) (*service.StudentImportResult, error) {
+		return &service.StudentImportResult{
+			Students: []*aggregate.Student{},
+			Errors:   []service.StudentImportRowError{{Line: 2, Error: "invalid phone number"}},
+		}, nil
+	}
+
+	rr := s.upload("/api/v1/students/import", "roster.csv", "data", nil)
+	s.Equal(http.StatusUnprocessableEntity, rr.Code)
+}
+
+func (s *StudentImportHandlerTestSuite) TestImport_AutoAcceptInvites() {
+	s.mockSvc.ImportFn = func(_ context.Context, _ io.Reader, format spreadsheet.Format, autoAccept bool) (*service.StudentImportResult, error) {
+		s.Equal(spreadsheet.Format_CSV, format)
+		s.True(autoAccept)
+		return &service.StudentImportResult{
+			Students: []*aggregate.Student{
+				{StudentID: 816000001, EmailAddress: "jane.doe@my.uwi.edu"},
+				{StudentID: 816000002, EmailAddress: "john.roe@my.uwi.edu"},
+			},
+			Accepted: true,
+			Errors:   []service.StudentImportRowError{},
+		}, nil
+	}
+	s.mockAuth.InitiateOnboardingFn = func(_ context.Context, email, _, _ string) (string, error) {
+		if email == "john.roe@my.uwi.edu" {
+			return "", errors.New("user exists")
+		}
+		return "token", nil
+	}
+	s.sent.Add(1)
+
+	rr := s.upload("/api/v1/students/import", "roster.csv", "data", map[string]string{"auto_accept": "true"})
+	s.Equal(http.StatusCreated, rr.Code)
+	s.sent.Wait()
+
+	var resp dtos.StudentImportResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.True(resp.Accepted)
+	s.Equal(1, resp.InvitationsSent)
+	s.Require().Len(resp.InvitationFailures, 1)
+	s.Equal(int32(816000002), resp.InvitationFailures[0].StudentID)
+}
+
+func (s *StudentImportHandlerTestSuite) TestImport_InvalidAutoAccept() {
+	rr := s.upload("/api/v1/students/import", "roster.csv", "data", map[string]string{"auto_accept": "maybe"})
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *StudentImportHandlerTestSuite) TestExport_Headers() {
+	s.mockSvc.ExportFn = func(_ context.Context, w io.Writer, params service.StudentExportParams) error {
+		s.Equal("accepted", params.Status)
+		s.Equal(spreadsheet.Format_XLSX, params.Format)
+		s.Equal([]string{"student_id", "email"}, params.Columns)
+		_, err := w.Write([]byte("xlsx-bytes"))
+		return err
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/students/export?status=accepted&format=xlsx&columns=student_id,%20email", nil)
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal(spreadsheet.Format_XLSX.ContentType(), rr.Header().Get("Content-Type"))
+	s.Contains(rr.Header().Get("Content-Disposition"), `attachment; filename="students_`)
+	s.Contains(rr.Header().Get("Content-Disposition"), `.xlsx"`)
+	s.Equal("xlsx-bytes", rr.Body.String())
+}
+
+func (s *StudentImportHandlerTestSuite) TestExport_UnknownColumn() {
+	s.mockSvc.ExportFn = func(_ context.Context, _ io.Writer, _ service.StudentExportParams) error {
+		return studentErrors.ErrInvalidExportColumn
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/students/export?columns=password", nil)
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
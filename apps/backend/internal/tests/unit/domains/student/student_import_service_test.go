@@ -0,0 +1,192 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type StudentImportServiceTestSuite struct {
+	suite.Suite
+	studentRepo *mocks.MockStudentRepository
+	reviewRepo  *mocks.MockApplicationReviewRepository
+	svc         *service.StudentService
+	ctx         context.Context
+	created     []*aggregate.Student
+	updated     []*aggregate.Student
+	decisions   []*aggregate.StageChange
+}
+
+func TestStudentImportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentImportServiceTestSuite))
+}
+
+const importHeader = "student_id,first_name,last_name,email,phone,availability,courses,programme,year\n"
+
+func (s *StudentImportServiceTestSuite) SetupTest() {
+	s.created, s.updated, s.decisions = nil, nil, nil
+
+	s.studentRepo = &mocks.MockStudentRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.Student, error) {
+			return []*aggregate.Student{{StudentID: 816000009, EmailAddress: "taken@my.uwi.edu"}}, nil
+		},
+		CreateFn: func(_ context.Context, _ *sql.Tx, student *aggregate.Student) (*aggregate.Student, error) {
+			s.created = append(s.created, student)
+			return student, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, student *aggregate.Student) error {
+			s.updated = append(s.updated, student)
+			return nil
+		},
+	}
+	courseRepo := &mocks.MockCourseRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.Course, error) {
+			course, _ := scheduleAggregate.NewCourse("COMP1601", "Computer Programming I", "DCIT", 1)
+			return []*scheduleAggregate.Course{course}, nil
+		},
+	}
+	s.reviewRepo = &mocks.MockApplicationReviewRepository{
+		ListStagesFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.ReviewStage, error) {
+			return []*aggregate.ReviewStage{}, nil
+		},
+		ListStageChangesFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.StageChange, error) {
+			return []*aggregate.StageChange{}, nil
+		},
+		AddStageChangeFn: func(_ context.Context, _ *sql.Tx, change *aggregate.StageChange) (*aggregate.StageChange, error) {
+			s.decisions = append(s.decisions, change)
+			return change, nil
+		},
+	}
+	s.svc = service.NewStudentService(zap.NewNop(), s.studentRepo, courseRepo, s.reviewRepo, &mocks.MockCourseEligibilityRepository{}, &mocks.MockStudentDocumentRepository{}, &mocks.StubTxManager{})
+	s.ctx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+func (s *StudentImportServiceTestSuite) TestPreviewImport_ReportsRowErrors() {
+	file := importHeader +
+		"816000001,Jane,Doe,jane.doe@my.uwi.edu,868-555-0101,Mon 9-12,comp1601:A,BSc Computer Science,2\n" +
+		"816000002,John,Roe,john.roe@gmail.com,868-555-0102,Mon 9-12,,BSc Computer Science,2\n" +
+		"816000001,Jim,Poe,jim.poe@my.uwi.edu,868-555-0103,Tue 9-12,,BSc Computer Science,1\n" +
+		"816000009,Ann,Lee,ann.lee@my.uwi.edu,868-555-0104,Sat 9-12,,BSc Computer Science,1\n" +
+		"816000010,Ann,Lee,taken@my.uwi.edu,868-555-0104,Fri 9-12,,BSc Computer Science,1\n"
+
+	result, err := s.svc.PreviewImport(s.ctx, strings.NewReader(file), spreadsheet.Format_CSV)
+	s.Require().NoError(err)
+
+	s.Require().Len(result.Students, 1)
+	s.Equal("COMP1601", result.Students[0].TranscriptMetadata.Courses[0].Code)
+	s.Equal("Computer Programming I", result.Students[0].TranscriptMetadata.Courses[0].Title)
+
+	s.Require().Len(result.Errors, 4)
+	s.Equal(3, result.Errors[0].Line)
+	s.Equal(4, result.Errors[1].Line)
+	s.Contains(result.Errors[1].Error, "repeats line 2")
+	s.Equal(5, result.Errors[2].Line)
+	s.Contains(result.Errors[2].Error, "invalid day")
+	s.Equal(6, result.Errors[3].Line)
+	s.Contains(result.Errors[3].Error, "email already exists")
+	s.Empty(s.created)
+}
+
+func (s *StudentImportServiceTestSuite) TestPreviewImport_MissingColumn() {
+	_, err := s.svc.PreviewImport(s.ctx, strings.NewReader("student_id,email\n"), spreadsheet.Format_CSV)
+	s.ErrorIs(err, studentErrors.ErrInvalidStudentImport)
+}
+
+func (s *StudentImportServiceTestSuite) TestImport_AllOrNothing() {
+	file := importHeader +
+		"816000001,Jane,Doe,jane.doe@my.uwi.edu,868-555-0101,Mon 9-12,,BSc Computer Science,2\n" +
+		"816000009,Ann,Lee,ann.lee@my.uwi.edu,868-555-0104,Mon 9-12,,BSc Computer Science,1\n"
+
+	result, err := s.svc.Import(s.ctx, strings.NewReader(file), spreadsheet.Format_CSV, true)
+	s.Require().NoError(err)
+	s.Len(result.Errors, 1)
+	s.Empty(result.Students)
+	s.False(result.Accepted)
+	s.Empty(s.created)
+}
+
+func (s *StudentImportServiceTestSuite) TestImport_AutoAccept() {
+	file := "student_id,name,email,phone,availability,programme,year\n" +
+		"816000001,Jane Mary Doe,jane.doe@my.uwi.edu,868-555-0101,Mon 9-12,BSc Computer Science,2\n" +
+		"816000002,John Roe,john.roe@my.uwi.edu,868-555-0102,Tue 13-15,BSc Information Technology,3\n"
+
+	result, err := s.svc.Import(s.ctx, strings.NewReader(file), spreadsheet.Format_CSV, true)
+	s.Require().NoError(err)
+	s.Empty(result.Errors)
+	s.True(result.Accepted)
+	s.Require().Len(result.Students, 2)
+	s.Equal("Jane Mary", result.Students[0].FirstName)
+	s.Equal("Doe", result.Students[0].LastName)
+	s.NotNil(result.Students[0].AcceptedAt)
+	s.Len(s.created, 2)
+	s.Len(s.updated, 2)
+	s.Require().Len(s.decisions, 2)
+	s.Equal(aggregate.ApplicationDecision_Accepted, *s.decisions[0].Decision)
+}
+
+func (s *StudentImportServiceTestSuite) TestImport_PendingByDefault() {
+	file := importHeader + "816000001,Jane,Doe,jane.doe@my.uwi.edu,868-555-0101,Mon 9-12,,BSc Computer Science,2\n"
+
+	result, err := s.svc.Import(s.ctx, strings.NewReader(file), spreadsheet.Format_CSV, false)
+	s.Require().NoError(err)
+	s.Require().Len(result.Students, 1)
+	s.Nil(result.Students[0].AcceptedAt)
+	s.Empty(s.updated)
+	s.Empty(s.decisions)
+}
+
+func (s *StudentImportServiceTestSuite) TestExport_SelectedColumns() {
+	accepted := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	grade := "A"
+	s.studentRepo.ListByStatusFn = func(_ context.Context, _ *sql.Tx, status string) ([]*aggregate.Student, error) {
+		s.Equal("accepted", status)
+		return []*aggregate.Student{
+			{StudentID: 816000002, FirstName: "John", LastName: "Roe", AcceptedAt: &accepted},
+			{
+				StudentID: 816000001, FirstName: "Jane", LastName: "Doe", AcceptedAt: &accepted,
+				Availability:       aggregate.Availability{"0": {9, 10}},
+				TranscriptMetadata: types.TranscriptMetadata{Courses: []types.CourseResult{{Code: "COMP1601", Grade: &grade}}},
+			},
+		}, nil
+	}
+
+	var buf bytes.Buffer
+	err := s.svc.Export(s.ctx, &buf, service.StudentExportParams{
+		Status:  "accepted",
+		Columns: []string{"student_id", "last_name", "availability", "courses", "status"},
+		Format:  spreadsheet.Format_CSV,
+	})
+	s.Require().NoError(err)
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	s.Require().NoError(err)
+	s.Equal([][]string{
+		{"student_id", "last_name", "availability", "courses", "status"},
+		{"816000001", "Doe", "Mon 9-11", "COMP1601:A", "accepted"},
+		{"816000002", "Roe", "", "", "accepted"},
+	}, rows)
+}
+
+func (s *StudentImportServiceTestSuite) TestExport_UnknownColumn() {
+	err := s.svc.Export(s.ctx, &bytes.Buffer{}, service.StudentExportParams{Columns: []string{"password"}})
+	s.ErrorIs(err, studentErrors.ErrInvalidExportColumn)
+}
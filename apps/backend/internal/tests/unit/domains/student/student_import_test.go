@@ -0,0 +1,71 @@
+package student_test
+
+import (
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	"github.com/stretchr/testify/suite"
+)
+
+type StudentImportFormatTestSuite struct {
+	suite.Suite
+}
+
+func TestStudentImportFormatTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentImportFormatTestSuite))
+}
+
+func (s *StudentImportFormatTestSuite) TestParseAvailability_Text() {
+	avail, err := aggregate.ParseAvailability("Mon 9-12, 14; wednesday 10-12; Mon 13")
+	s.Require().NoError(err)
+	s.Equal(aggregate.Availability{
+		"0": {9, 10, 11, 13, 14},
+		"2": {10, 11},
+	}, avail)
+}
+
+func (s *StudentImportFormatTestSuite) TestParseAvailability_JSON() {
+	avail, err := aggregate.ParseAvailability(`{"4": [8, 9]}`)
+	s.Require().NoError(err)
+	s.Equal(aggregate.Availability{"4": {8, 9}}, avail)
+}
+
+func (s *StudentImportFormatTestSuite) TestParseAvailability_Invalid() {
+	for _, text := range []string{"", "Sat 9-12", "Mon", "Tue 12-9", "Thu 20-25", "Fri nine"} {
+		_, err := aggregate.ParseAvailability(text)
+		s.Error(err, text)
+	}
+}
+
+func (s *StudentImportFormatTestSuite) TestFormatAvailability_RoundTrip() {
+	avail := aggregate.Availability{"0": {9, 10, 11, 14}, "3": {16}}
+	text := aggregate.FormatAvailability(avail)
+	s.Equal("Mon 9-12, 14-15; Thu 16-17", text)
+
+	parsed, err := aggregate.ParseAvailability(text)
+	s.Require().NoError(err)
+	s.Equal(avail, parsed)
+}
+
+func (s *StudentImportFormatTestSuite) TestParseCourseList() {
+	courses, err := aggregate.ParseCourseList("comp 1601:a; COMP1602, INFO1600:B+")
+	s.Require().NoError(err)
+	s.Require().Len(courses, 3)
+	s.Equal("COMP1601", courses[0].Code)
+	s.Equal("A", *courses[0].Grade)
+	s.Nil(courses[1].Grade)
+	s.Equal("INFO1600", courses[2].Code)
+	s.Equal("COMP1601:A; COMP1602; INFO1600:B+", aggregate.FormatCourseList(courses))
+
+	_, err = aggregate.ParseCourseList("COMP1601:A; comp1601:B")
+	s.Error(err)
+}
+
+func (s *StudentImportFormatTestSuite) TestStatus() {
+	student := &aggregate.Student{}
+	s.Equal("pending", student.Status())
+	s.Require().NoError(student.Accept())
+	s.Equal("accepted", student.Status())
+	s.Require().NoError(student.Deactivate())
+	s.Equal("deactivated", student.Status())
+}
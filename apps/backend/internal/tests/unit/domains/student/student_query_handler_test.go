@@ -0,0 +1,123 @@
+package student_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type StudentQueryHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockStudentService
+	router  *chi.Mux
+}
+
+func TestStudentQueryHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentQueryHandlerTestSuite))
+}
+
+func (s *StudentQueryHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockStudentService{}
+	hdl := handler.NewStudentHandler(zap.NewNop(), nil, s.mockSvc, nil, nil, nil, "", "")
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *StudentQueryHandlerTestSuite) get(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *StudentQueryHandlerTestSuite) TestSearch_ParsesParams() {
+	s.mockSvc.QueryFn = func(_ context.Context, params service.StudentQueryParams) (*service.StudentPage, error) {
+		s.Equal("jane doe", params.Search)
+		s.Equal("accepted", params.Status)
+		s.Equal("Computer Science", params.Programme)
+		s.Require().NotNil(params.Year)
+		s.Equal(int32(2), *params.Year)
+		s.Require().NotNil(params.MinGPA)
+		s.Equal(3.0, *params.MinGPA)
+		s.Nil(params.MaxGPA)
+		s.Equal("COMP1601", params.EligibleCourse)
+		s.Require().NotNil(params.AvailableDay)
+		s.Equal(1, *params.AvailableDay)
+		s.Require().NotNil(params.AvailableHour)
+		s.Equal(14, *params.AvailableHour)
+		s.Equal("name", params.Sort)
+		s.Require().NotNil(params.Descending)
+		s.False(*params.Descending)
+		s.Equal("abc", params.Cursor)
+		s.Equal(10, params.Limit)
+		return &service.StudentPage{
+			Students:   []*aggregate.Student{{StudentID: 816000001, FirstName: "Jane", LastName: "Doe"}},
+			Total:      11,
+			NextCursor: "next",
+		}, nil
+	}
+
+	rr := s.get("/api/v1/students/search?q=jane+doe&status=accepted&programme=Computer+Science&year=2&min_gpa=3" +
+		"&eligible_course=COMP1601&day=1&hour=14&sort=name&order=asc&cursor=abc&limit=10")
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp struct {
+		Students   []map[string]any `json:"students"`
+		Total      int              `json:"total"`
+		NextCursor *string          `json:"next_cursor"`
+	}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Len(resp.Students, 1)
+	s.Equal(11, resp.Total)
+	s.Require().NotNil(resp.NextCursor)
+	s.Equal("next", *resp.NextCursor)
+}
+
+func (s *StudentQueryHandlerTestSuite) TestSearch_LastPageHasNullCursor() {
+	s.mockSvc.QueryFn = func(_ context.Context, params service.StudentQueryParams) (*service.StudentPage, error) {
+		s.Nil(params.Descending)
+		return &service.StudentPage{Students: []*aggregate.Student{}}, nil
+	}
+
+	rr := s.get("/api/v1/students/search")
+	s.Equal(http.StatusOK, rr.Code)
+	s.JSONEq(`{"students": [], "total": 0, "next_cursor": null}`, rr.Body.String())
+}
+
+func (s *StudentQueryHandlerTestSuite) TestSearch_MalformedParams() {
+	for _, query := range []string{"year=second", "min_gpa=high", "day=mon", "limit=0", "order=up"} {
+		rr := s.get("/api/v1/students/search?" + query)
+		s.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+}
+
+func (s *StudentQueryHandlerTestSuite) TestSearch_ServiceValidationErrors() {
+	for _, err := range []error{
+		studentErrors.ErrInvalidStudentStatus,
+		studentErrors.ErrInvalidStudentSort,
+		studentErrors.ErrInvalidStudentCursor,
+		studentErrors.ErrInvalidStudentFilter,
+		studentErrors.ErrInvalidCourseCode,
+	} {
+		s.mockSvc.QueryFn = func(_ context.Context, _ service.StudentQueryParams) (*service.StudentPage, error) {
+			return nil, err
+		}
+		rr := s.get("/api/v1/students/search")
+		s.Equal(http.StatusBadRequest, rr.Code, err.Error())
+	}
+}
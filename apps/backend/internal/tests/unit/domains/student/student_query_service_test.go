@@ -0,0 +1,159 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type StudentQueryServiceTestSuite struct {
+	suite.Suite
+	studentRepo     *mocks.MockStudentRepository
+	eligibilityRepo *mocks.MockCourseEligibilityRepository
+	svc             *service.StudentService
+	ctx             context.Context
+	queries         []repository.StudentQuery
+	rows            []*aggregate.Student
+}
+
+func TestStudentQueryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentQueryServiceTestSuite))
+}
+
+func (s *StudentQueryServiceTestSuite) SetupTest() {
+	s.queries = nil
+	s.rows = []*aggregate.Student{}
+	s.studentRepo = &mocks.MockStudentRepository{
+		QueryFn: func(_ context.Context, _ *sql.Tx, query repository.StudentQuery) ([]*aggregate.Student, int, error) {
+			s.queries = append(s.queries, query)
+			rows := s.rows
+			if len(rows) > query.Limit {
+				rows = rows[:query.Limit]
+			}
+			return rows, len(s.rows), nil
+		},
+	}
+	s.eligibilityRepo = &mocks.MockCourseEligibilityRepository{}
+	s.svc = service.NewStudentService(zap.NewNop(), s.studentRepo, &mocks.MockCourseRepository{}, &mocks.MockApplicationReviewRepository{}, s.eligibilityRepo, &mocks.MockStudentDocumentRepository{}, &mocks.StubTxManager{})
+	s.ctx = database.WithAuthContext(context.Background(), *adminAuthCtx())
+}
+
+func gpaStudent(id int32, gpa *float64) *aggregate.Student {
+	return &aggregate.Student{
+		StudentID:          id,
+		FirstName:          "Jane",
+		LastName:           "Doe",
+		CreatedAt:          time.Date(2026, 1, 10, 12, 0, 0, 123000, time.UTC),
+		TranscriptMetadata: types.TranscriptMetadata{OverallGPA: gpa},
+	}
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_Defaults() {
+	page, err := s.svc.Query(s.ctx, service.StudentQueryParams{Search: "  jane  "})
+	s.Require().NoError(err)
+	s.Empty(page.NextCursor)
+
+	s.Require().Len(s.queries, 1)
+	q := s.queries[0]
+	s.Equal("jane", q.Search)
+	s.Equal(repository.StudentSort_AppliedAt, q.Sort)
+	s.True(q.Descending)
+	s.Equal(26, q.Limit)
+	s.Nil(q.After)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_LimitIsCapped() {
+	_, err := s.svc.Query(s.ctx, service.StudentQueryParams{Limit: 1000})
+	s.Require().NoError(err)
+	s.Equal(101, s.queries[0].Limit)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_CursorRoundTrip() {
+	gpa := 3.45
+	s.rows = []*aggregate.Student{gpaStudent(816000003, &gpa), gpaStudent(816000001, nil), gpaStudent(816000002, nil)}
+	desc := true
+
+	page, err := s.svc.Query(s.ctx, service.StudentQueryParams{Sort: "gpa", Descending: &desc, Limit: 2})
+	s.Require().NoError(err)
+	s.Len(page.Students, 2)
+	s.Equal(3, page.Total)
+	s.Require().NotEmpty(page.NextCursor)
+
+	_, err = s.svc.Query(s.ctx, service.StudentQueryParams{Sort: "gpa", Descending: &desc, Limit: 2, Cursor: page.NextCursor})
+	s.Require().NoError(err)
+	s.Require().Len(s.queries, 2)
+	s.Equal(&repository.StudentCursor{Value: "-1", StudentID: 816000001}, s.queries[1].After)
+
+	_, err = s.svc.Query(s.ctx, service.StudentQueryParams{Sort: "gpa", Limit: 2, Cursor: page.NextCursor})
+	s.ErrorIs(err, studentErrors.ErrInvalidStudentCursor)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_AppliedAtCursor() {
+	s.rows = []*aggregate.Student{gpaStudent(816000001, nil), gpaStudent(816000002, nil)}
+
+	page, err := s.svc.Query(s.ctx, service.StudentQueryParams{Limit: 1})
+	s.Require().NoError(err)
+
+	_, err = s.svc.Query(s.ctx, service.StudentQueryParams{Limit: 1, Cursor: page.NextCursor})
+	s.Require().NoError(err)
+	s.Equal("2026-01-10T12:00:00.000123Z", s.queries[1].After.Value)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_MalformedCursor() {
+	_, err := s.svc.Query(s.ctx, service.StudentQueryParams{Cursor: "not-a-cursor"})
+	s.ErrorIs(err, studentErrors.ErrInvalidStudentCursor)
+	s.Empty(s.queries)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_EligibleCourseUsesRules() {
+	rule, _ := aggregate.NewEligibilityRule(strPtr("COMP1601"), nil, "A-")
+	s.eligibilityRepo.ListRulesFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+		return []*aggregate.EligibilityRule{rule}, nil
+	}
+
+	_, err := s.svc.Query(s.ctx, service.StudentQueryParams{EligibleCourse: "comp 1601"})
+	s.Require().NoError(err)
+	s.Equal("COMP1601", s.queries[0].EligibleCourse)
+	s.Equal([]string{"A+", "A", "A-"}, s.queries[0].EligibleGrades)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_Validation() {
+	day, hour, badDay := 2, 9, 5
+	low, high := 3.5, 2.0
+
+	cases := []struct {
+		params service.StudentQueryParams
+		err    error
+	}{
+		{service.StudentQueryParams{Status: "archived"}, studentErrors.ErrInvalidStudentStatus},
+		{service.StudentQueryParams{Sort: "email"}, studentErrors.ErrInvalidStudentSort},
+		{service.StudentQueryParams{MinGPA: &low, MaxGPA: &high}, studentErrors.ErrInvalidStudentFilter},
+		{service.StudentQueryParams{AvailableHour: &hour}, studentErrors.ErrInvalidStudentFilter},
+		{service.StudentQueryParams{AvailableDay: &badDay}, studentErrors.ErrInvalidStudentFilter},
+		{service.StudentQueryParams{EligibleCourse: "programming"}, studentErrors.ErrInvalidCourseCode},
+	}
+	for _, tc := range cases {
+		_, err := s.svc.Query(s.ctx, tc.params)
+		s.ErrorIs(err, tc.err)
+	}
+	s.Empty(s.queries)
+
+	_, err := s.svc.Query(s.ctx, service.StudentQueryParams{AvailableDay: &day, AvailableHour: &hour})
+	s.NoError(err)
+}
+
+func (s *StudentQueryServiceTestSuite) TestQuery_MissingAuthContext() {
+	_, err := s.svc.Query(context.Background(), service.StudentQueryParams{})
+	s.ErrorIs(err, studentErrors.ErrMissingAuthContext)
+}
@@ -0,0 +1,159 @@
+package student_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type StudentTimetableTestSuite struct {
+	suite.Suite
+}
+
+func TestStudentTimetableTestSuite(t *testing.T) {
+	suite.Run(t, new(StudentTimetableTestSuite))
+}
+
+var ast = time.FixedZone("AST", -4*60*60)
+
+func class(course string, day int, start, end string) aggregate.ClassSession {
+	return aggregate.ClassSession{CourseCode: course, TimeBlock: aggregate.TimeBlock{DayOfWeek: day, Start: start, End: end}}
+}
+
+func sampleICS() []byte {
+	return []byte(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"SUMMARY:COMP 1601 Lec",
+		" ture",
+		"DTSTART;TZID=America/Port_of_Spain:20260112T090000",
+		"DTEND;TZID=America/Port_of_Spain:20260112T110000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,WE",
+		"BEGIN:VALARM",
+		"SUMMARY:Reminder",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:INFO1600 Lab",
+		"DTSTART:20260113T180000Z",
+		"DURATION:PT2H",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Study group",
+		"DTSTART:20260117T100000",
+		"DTEND:20260117T120000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Holiday",
+		"DTSTART;VALUE=DATE:20260114",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n"))
+}
+
+func (s *StudentTimetableTestSuite) TestParseTimetableICS() {
+	classes, err := aggregate.ParseTimetableICS(sampleICS(), ast)
+	s.Require().NoError(err)
+
+	// The Saturday and all-day events are skipped; UTC times are converted.
+	s.ElementsMatch([]aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "11:00"),
+		class("COMP1601", 2, "09:00", "11:00"),
+		class("INFO1600", 1, "14:00", "16:00"),
+	}, classes)
+
+	_, err = aggregate.ParseTimetableICS([]byte("course,day,start,end"), ast)
+	s.ErrorIs(err, studentErrors.ErrInvalidTimetable)
+}
+
+func (s *StudentTimetableTestSuite) TestParseTimetableCSV() {
+	classes, err := aggregate.ParseTimetableCSV([][]string{
+		{"Course", "Day", "Start", "End"},
+		{"comp 1601", "Mon", "9:00", "11:00"},
+		{"INFO1600", "thursday", "13:30-15:00"},
+		{"", "", "", ""},
+	})
+	s.Require().NoError(err)
+	s.Equal([]aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "11:00"),
+		class("INFO1600", 3, "13:30", "15:00"),
+	}, classes)
+
+	cases := [][]string{
+		{"COMP1601", "Sat", "09:00", "10:00"},
+		{"COMP1601", "Mon", "11:00", "09:00"},
+		{"COMP1601", "Mon", "9am", "10am"},
+		{"COMP1601", "Mon"},
+	}
+	for _, row := range cases {
+		_, err := aggregate.ParseTimetableCSV([][]string{row})
+		s.ErrorIs(err, studentErrors.ErrInvalidTimetable, strings.Join(row, ","))
+	}
+}
+
+func (s *StudentTimetableTestSuite) TestNewStudentTimetable() {
+	timetable, err := aggregate.NewStudentTimetable(816000001, aggregate.TimetableSource_CSV, []aggregate.ClassSession{
+		class("INFO1600", 1, "14:00", "16:00"),
+		class("COMP1601", 0, "09:00", "11:00"),
+		class("COMP1601", 0, "09:00", "11:00"),
+	})
+	s.Require().NoError(err)
+	s.Equal([]aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "11:00"),
+		class("INFO1600", 1, "14:00", "16:00"),
+	}, timetable.Classes)
+
+	_, err = aggregate.NewStudentTimetable(816000001, aggregate.TimetableSource_ICS, nil)
+	s.ErrorIs(err, studentErrors.ErrEmptyTimetable)
+}
+
+func (s *StudentTimetableTestSuite) TestProposeAvailability() {
+	timetable := &aggregate.StudentTimetable{Classes: []aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "10:30"),
+		class("INFO1600", 2, "13:00", "14:00"),
+	}}
+	openings := []aggregate.TimeBlock{
+		{DayOfWeek: 0, Start: "08:00", End: "12:00"},
+		{DayOfWeek: 2, Start: "12:00", End: "15:00"},
+		{DayOfWeek: 5, Start: "08:00", End: "12:00"},
+	}
+
+	s.Equal(aggregate.Availability{
+		"0": {8, 11},
+		"2": {12, 14},
+	}, timetable.ProposeAvailability(openings))
+	s.Empty(timetable.ProposeAvailability(nil))
+}
+
+func (s *StudentTimetableTestSuite) TestOverlaps() {
+	timetable := &aggregate.StudentTimetable{Classes: []aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "10:30"),
+		class("INFO1600", 2, "13:00", "14:00"),
+	}}
+
+	overlaps := timetable.Overlaps(aggregate.Availability{"0": {11, 10, 8}, "2": {12, 13}})
+	s.Equal([]aggregate.AvailabilityOverlap{
+		{DayOfWeek: 0, Hour: 10, CourseCode: "COMP1601"},
+		{DayOfWeek: 2, Hour: 13, CourseCode: "INFO1600"},
+	}, overlaps)
+}
+
+func (s *StudentTimetableTestSuite) TestModelRoundTrip() {
+	timetable, err := aggregate.NewStudentTimetable(816000001, aggregate.TimetableSource_ICS, []aggregate.ClassSession{
+		class("COMP1601", 0, "09:00", "11:00"),
+	})
+	s.Require().NoError(err)
+
+	m := timetable.ToModel()
+	s.JSONEq(`[{"course_code": "COMP1601", "day_of_week": 0, "start": "09:00", "end": "11:00"}]`, m.Classes)
+	back, err := aggregate.StudentTimetableFromModel(&m)
+	s.Require().NoError(err)
+	s.Equal(timetable.Classes, back.Classes)
+	s.Equal(aggregate.TimetableSource_ICS, back.Source)
+}
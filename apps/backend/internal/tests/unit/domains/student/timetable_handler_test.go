@@ -0,0 +1,138 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimetableHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockTimetableService
+	router  *chi.Mux
+}
+
+func TestTimetableHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TimetableHandlerTestSuite))
+}
+
+func (s *TimetableHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockTimetableService{}
+	hdl := handler.NewTimetableHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *TimetableHandlerTestSuite) do(ctx context.Context, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req.WithContext(ctx))
+	return rr
+}
+
+func (s *TimetableHandlerTestSuite) upload(filename string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", filename)
+	s.Require().NoError(err)
+	_, err = fw.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/students/me/timetable", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return s.do(studentCtx("816000001"), req)
+}
+
+func sampleProposal() *service.TimetableProposal {
+	return &service.TimetableProposal{
+		Timetable: &aggregate.StudentTimetable{
+			StudentID: 816000001,
+			Source:    aggregate.TimetableSource_CSV,
+			Classes:   []aggregate.ClassSession{class("COMP1601", 0, "09:00", "10:00")},
+		},
+		ProposedAvailability: aggregate.Availability{"0": {8, 10}},
+		Overlaps:             []aggregate.AvailabilityOverlap{{DayOfWeek: 0, Hour: 9, CourseCode: "COMP1601"}},
+	}
+}
+
+func (s *TimetableHandlerTestSuite) TestImportMine() {
+	s.mockSvc.ImportMineFn = func(_ context.Context, filename string, data []byte) (*service.TimetableProposal, error) {
+		s.Equal("classes.csv", filename)
+		s.Equal(sampleTimetableCSV, string(data))
+		return sampleProposal(), nil
+	}
+
+	rr := s.upload("classes.csv", []byte(sampleTimetableCSV))
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("csv", resp["source"])
+	s.Equal([]any{8.0, 10.0}, resp["proposed_availability"].(map[string]any)["0"])
+	overlaps := resp["overlaps"].([]any)
+	s.Require().Len(overlaps, 1)
+	s.Equal("COMP1601", overlaps[0].(map[string]any)["course_code"])
+}
+
+func (s *TimetableHandlerTestSuite) TestImportMine_Errors() {
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{studentErrors.ErrInvalidTimetable, http.StatusBadRequest},
+		{studentErrors.ErrEmptyTimetable, http.StatusBadRequest},
+		{studentErrors.ErrNotAuthorized, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		s.mockSvc.ImportMineFn = func(context.Context, string, []byte) (*service.TimetableProposal, error) {
+			return nil, tc.err
+		}
+		rr := s.upload("classes.csv", []byte(sampleTimetableCSV))
+		s.Equal(tc.status, rr.Code, tc.err.Error())
+	}
+}
+
+func (s *TimetableHandlerTestSuite) TestApplyMine() {
+	s.mockSvc.ApplyMineFn = func(context.Context) (*aggregate.ProfileChangeRequest, error) {
+		return sampleChangeRequest(), nil
+	}
+	rr := s.do(studentCtx("816000001"), httptest.NewRequest(http.MethodPost, "/api/v1/students/me/timetable/apply", nil))
+	s.Require().Equal(http.StatusAccepted, rr.Code, rr.Body.String())
+
+	s.mockSvc.ApplyMineFn = func(context.Context) (*aggregate.ProfileChangeRequest, error) {
+		return nil, studentErrors.ErrNoProfileChanges
+	}
+	rr = s.do(studentCtx("816000001"), httptest.NewRequest(http.MethodPost, "/api/v1/students/me/timetable/apply", nil))
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *TimetableHandlerTestSuite) TestGetByStudentID() {
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	s.mockSvc.GetByStudentIDFn = func(_ context.Context, studentID int32) (*service.TimetableProposal, error) {
+		s.Equal(int32(816000002), studentID)
+		return nil, studentErrors.ErrTimetableNotFound
+	}
+
+	rr := s.do(adminCtx, httptest.NewRequest(http.MethodGet, "/api/v1/students/816000002/timetable", nil))
+	s.Equal(http.StatusNotFound, rr.Code)
+
+	rr = s.do(adminCtx, httptest.NewRequest(http.MethodGet, "/api/v1/students/abc/timetable", nil))
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
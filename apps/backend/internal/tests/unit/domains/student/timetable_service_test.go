@@ -0,0 +1,149 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimetableServiceTestSuite struct {
+	suite.Suite
+	timetables     map[int32]*aggregate.StudentTimetable
+	templates      []*scheduleAggregate.ShiftTemplate
+	profileChanges *mocks.MockProfileChangeService
+	requested      *service.ProfileChangeInput
+	svc            *service.TimetableService
+}
+
+func TestTimetableServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TimetableServiceTestSuite))
+}
+
+func shiftTemplate(day int32, start, end int) *scheduleAggregate.ShiftTemplate {
+	return &scheduleAggregate.ShiftTemplate{
+		DayOfWeek: day,
+		StartTime: time.Date(0, 1, 1, start, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(0, 1, 1, end, 0, 0, 0, time.UTC),
+		IsActive:  true,
+	}
+}
+
+func (s *TimetableServiceTestSuite) SetupTest() {
+	s.timetables = map[int32]*aggregate.StudentTimetable{}
+	s.templates = []*scheduleAggregate.ShiftTemplate{shiftTemplate(0, 8, 12), shiftTemplate(2, 13, 15)}
+	s.requested = nil
+
+	timetableRepo := &mocks.MockStudentTimetableRepository{
+		GetByStudentIDFn: func(_ context.Context, _ *sql.Tx, studentID int32) (*aggregate.StudentTimetable, error) {
+			t, ok := s.timetables[studentID]
+			if !ok {
+				return nil, studentErrors.ErrTimetableNotFound
+			}
+			return t, nil
+		},
+		UpsertFn: func(_ context.Context, _ *sql.Tx, t *aggregate.StudentTimetable) (*aggregate.StudentTimetable, error) {
+			s.timetables[t.StudentID] = t
+			return t, nil
+		},
+	}
+	studentRepo := &mocks.MockStudentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.Student, error) {
+			// Monday 9-12 and Wednesday 13-15.
+			return profileStudent(), nil
+		},
+	}
+	shiftTemplateRepo := &mocks.MockShiftTemplateRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.ShiftTemplate, error) {
+			return s.templates, nil
+		},
+	}
+	s.profileChanges = &mocks.MockProfileChangeService{
+		RequestFn: func(_ context.Context, input service.ProfileChangeInput) (*aggregate.ProfileChangeRequest, error) {
+			s.requested = &input
+			return sampleChangeRequest(), nil
+		},
+	}
+
+	s.svc = service.NewTimetableService(zap.NewNop(), &mocks.StubTxManager{}, timetableRepo, studentRepo, shiftTemplateRepo, s.profileChanges)
+}
+
+const sampleTimetableCSV = "course,day,start,end\nCOMP1601,Mon,09:00,10:00\nINFO1600,Wed,14:00,15:00\n"
+
+func (s *TimetableServiceTestSuite) TestImportMine_CSV() {
+	proposal, err := s.svc.ImportMine(studentCtx("816000001"), "classes.csv", []byte(sampleTimetableCSV))
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.TimetableSource_CSV, proposal.Timetable.Source)
+	s.Len(s.timetables[816000001].Classes, 2)
+	s.Equal(aggregate.Availability{"0": {8, 10, 11}, "2": {13}}, proposal.ProposedAvailability)
+	s.Equal([]aggregate.AvailabilityOverlap{
+		{DayOfWeek: 0, Hour: 9, CourseCode: "COMP1601"},
+		{DayOfWeek: 2, Hour: 14, CourseCode: "INFO1600"},
+	}, proposal.Overlaps)
+}
+
+func (s *TimetableServiceTestSuite) TestImportMine_ICS() {
+	proposal, err := s.svc.ImportMine(studentCtx("816000001"), "calendar.ICS", sampleICS())
+	s.Require().NoError(err)
+	s.Equal(aggregate.TimetableSource_ICS, proposal.Timetable.Source)
+	s.Len(proposal.Timetable.Classes, 3)
+}
+
+func (s *TimetableServiceTestSuite) TestImportMine_Errors() {
+	_, err := s.svc.ImportMine(studentCtx("816000001"), "classes.pdf", []byte("%PDF-"))
+	s.ErrorIs(err, studentErrors.ErrInvalidTimetable)
+
+	_, err = s.svc.ImportMine(studentCtx("816000001"), "classes.csv", []byte("course,day,start,end\n"))
+	s.ErrorIs(err, studentErrors.ErrEmptyTimetable)
+
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	_, err = s.svc.ImportMine(adminCtx, "classes.csv", []byte(sampleTimetableCSV))
+	s.ErrorIs(err, studentErrors.ErrNotAuthorized)
+	s.Empty(s.timetables)
+}
+
+func (s *TimetableServiceTestSuite) TestGetMine_RecomputesForShiftChanges() {
+	_, err := s.svc.GetMine(studentCtx("816000001"))
+	s.ErrorIs(err, studentErrors.ErrTimetableNotFound)
+
+	_, err = s.svc.ImportMine(studentCtx("816000001"), "classes.csv", []byte(sampleTimetableCSV))
+	s.Require().NoError(err)
+
+	// The Monday shift moves to the afternoon.
+	s.templates = []*scheduleAggregate.ShiftTemplate{shiftTemplate(0, 9, 11), shiftTemplate(2, 13, 15)}
+	proposal, err := s.svc.GetMine(studentCtx("816000001"))
+	s.Require().NoError(err)
+	s.Equal(aggregate.Availability{"0": {10}, "2": {13}}, proposal.ProposedAvailability)
+
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	byAdmin, err := s.svc.GetByStudentID(adminCtx, 816000001)
+	s.Require().NoError(err)
+	s.Equal(proposal.ProposedAvailability, byAdmin.ProposedAvailability)
+}
+
+func (s *TimetableServiceTestSuite) TestApplyMine_RequestsChange() {
+	_, err := s.svc.ImportMine(studentCtx("816000001"), "classes.csv", []byte(sampleTimetableCSV))
+	s.Require().NoError(err)
+
+	_, err = s.svc.ApplyMine(studentCtx("816000001"))
+	s.Require().NoError(err)
+	s.Require().NotNil(s.requested)
+	var avail aggregate.Availability
+	s.Require().NoError(json.Unmarshal(*s.requested.Availability, &avail))
+	s.Equal(aggregate.Availability{"0": {8, 10, 11}, "2": {13}}, avail)
+
+	s.templates = nil
+	_, err = s.svc.ApplyMine(studentCtx("816000001"))
+	s.ErrorIs(err, studentErrors.ErrInvalidAvailability)
+}
@@ -0,0 +1,175 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TrainingHandlerTestSuite struct {
+	suite.Suite
+	mockSvc  *mocks.MockTrainingService
+	router   *chi.Mux
+	adminCtx context.Context
+}
+
+func TestTrainingHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TrainingHandlerTestSuite))
+}
+
+func (s *TrainingHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockTrainingService{}
+	hdl := handler.NewTrainingHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+	s.adminCtx = database.WithAuthContext(context.Background(), *adminAuthCtx())
+}
+
+func (s *TrainingHandlerTestSuite) do(ctx context.Context, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req.WithContext(ctx))
+	return rr
+}
+
+func (s *TrainingHandlerTestSuite) recordCompletion(studentID string, fields map[string]string, file []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for k, v := range fields {
+		s.Require().NoError(mw.WriteField(k, v))
+	}
+	if file != nil {
+		fw, err := mw.CreateFormFile("file", "certificate.pdf")
+		s.Require().NoError(err)
+		_, err = fw.Write(file)
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/students/"+studentID+"/training", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return s.do(s.adminCtx, req)
+}
+
+func (s *TrainingHandlerTestSuite) TestCreateRequirement() {
+	s.mockSvc.CreateRequirementFn = func(_ context.Context, input service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+		s.Equal("Privacy Module", input.Name)
+		s.True(input.IsBlocking)
+		s.Equal(int32(12), *input.ValidityMonths)
+		return trainingRequirement(input.Name, input.IsBlocking, input.ValidityMonths), nil
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/training-requirements", strings.NewReader(`{"name":"Privacy Module","is_blocking":true,"validity_months":12}`))
+	rr := s.do(s.adminCtx, req)
+	s.Require().Equal(http.StatusCreated, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("Privacy Module", resp["name"])
+	s.Equal(true, resp["is_blocking"])
+
+	s.mockSvc.CreateRequirementFn = func(context.Context, service.TrainingRequirementInput) (*aggregate.TrainingRequirement, error) {
+		return nil, studentErrors.ErrDuplicateTrainingName
+	}
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/training-requirements", strings.NewReader(`{"name":"Privacy Module"}`))
+	s.Equal(http.StatusConflict, s.do(s.adminCtx, req).Code)
+}
+
+func (s *TrainingHandlerTestSuite) TestRecordCompletion() {
+	requirementID := uuid.New()
+	s.mockSvc.RecordCompletionFn = func(_ context.Context, studentID int32, input service.TrainingCompletionInput) (*aggregate.TrainingRecord, error) {
+		s.Equal(int32(816000001), studentID)
+		s.Equal(requirementID, input.RequirementID)
+		s.Equal("2026-03-01", input.CompletedOn.Format("2006-01-02"))
+		s.Equal("2027-01-31", input.ExpiresOn.Format("2006-01-02"))
+		s.Require().NotNil(input.Evidence)
+		s.Equal(samplePDF, input.Evidence.Content)
+
+		req := &aggregate.TrainingRequirement{ID: requirementID, IsActive: true}
+		rec, err := aggregate.NewTrainingRecord(req, studentID, input.CompletedOn, input.ExpiresOn, nil, input.CompletedOn)
+		s.Require().NoError(err)
+		return rec, nil
+	}
+
+	rr := s.recordCompletion("816000001", map[string]string{
+		"requirement_id": requirementID.String(),
+		"completed_on":   "2026-03-01",
+		"expires_on":     "2027-01-31",
+	}, samplePDF)
+	s.Require().Equal(http.StatusCreated, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("2026-03-01", resp["completed_on"])
+	s.Equal("2027-01-31", resp["expires_on"])
+}
+
+func (s *TrainingHandlerTestSuite) TestRecordCompletion_WithoutEvidence() {
+	s.mockSvc.RecordCompletionFn = func(_ context.Context, _ int32, input service.TrainingCompletionInput) (*aggregate.TrainingRecord, error) {
+		s.Nil(input.Evidence)
+		s.Nil(input.ExpiresOn)
+		return nil, studentErrors.ErrTrainingRequirementInactive
+	}
+
+	rr := s.recordCompletion("816000001", map[string]string{
+		"requirement_id": uuid.NewString(),
+		"completed_on":   "2026-03-01",
+	}, nil)
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *TrainingHandlerTestSuite) TestRecordCompletion_BadInput() {
+	cases := []map[string]string{
+		{"requirement_id": "nope", "completed_on": "2026-03-01"},
+		{"requirement_id": uuid.NewString(), "completed_on": "March 1"},
+		{"requirement_id": uuid.NewString(), "completed_on": "2026-03-01", "expires_on": "soon"},
+	}
+	for _, fields := range cases {
+		rr := s.recordCompletion("816000001", fields, nil)
+		s.Equal(http.StatusBadRequest, rr.Code, fields)
+	}
+	s.Equal(http.StatusBadRequest, s.recordCompletion("abc", cases[0], nil).Code)
+}
+
+func (s *TrainingHandlerTestSuite) TestGetMine() {
+	orientation := trainingRequirement("Orientation", true, nil)
+	s.mockSvc.GetMineFn = func(context.Context) ([]aggregate.TrainingStatus, error) {
+		return []aggregate.TrainingStatus{{Requirement: orientation, State: aggregate.TrainingState_Missing}}, nil
+	}
+
+	rr := s.do(studentCtx("816000001"), httptest.NewRequest(http.MethodGet, "/api/v1/students/me/training", nil))
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal("missing", resp[0]["state"])
+	s.Nil(resp[0]["record"])
+	s.Equal("Orientation", resp[0]["requirement"].(map[string]any)["name"])
+}
+
+func (s *TrainingHandlerTestSuite) TestDeleteRecord() {
+	s.mockSvc.DeleteRecordFn = func(context.Context, uuid.UUID) error {
+		return studentErrors.ErrTrainingRecordNotFound
+	}
+	rr := s.do(s.adminCtx, httptest.NewRequest(http.MethodDelete, "/api/v1/training-records/"+uuid.NewString(), nil))
+	s.Equal(http.StatusNotFound, rr.Code)
+}
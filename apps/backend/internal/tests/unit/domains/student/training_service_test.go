@@ -0,0 +1,272 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TrainingServiceTestSuite struct {
+	suite.Suite
+	requirements []*aggregate.TrainingRequirement
+	records      []*aggregate.TrainingRecord
+	uploads      []string
+	sent         dtos.SendEmailBulkRequest
+	sendErr      error
+	marked       []uuid.UUID
+	svc          *service.TrainingService
+}
+
+func TestTrainingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TrainingServiceTestSuite))
+}
+
+func (s *TrainingServiceTestSuite) SetupTest() {
+	s.requirements = nil
+	s.records = nil
+	s.uploads = nil
+	s.sent = nil
+	s.sendErr = nil
+	s.marked = nil
+
+	trainingRepo := &mocks.MockTrainingRepository{
+		CreateRequirementFn: func(_ context.Context, _ *sql.Tx, r *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+			s.requirements = append(s.requirements, r)
+			return r, nil
+		},
+		GetRequirementByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.TrainingRequirement, error) {
+			for _, r := range s.requirements {
+				if r.ID == id {
+					return r, nil
+				}
+			}
+			return nil, studentErrors.ErrTrainingRequirementNotFound
+		},
+		UpdateRequirementFn: func(_ context.Context, _ *sql.Tx, r *aggregate.TrainingRequirement) (*aggregate.TrainingRequirement, error) {
+			return r, nil
+		},
+		ListRequirementsFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.TrainingRequirement, error) {
+			return s.requirements, nil
+		},
+		UpsertRecordFn: func(_ context.Context, _ *sql.Tx, rec *aggregate.TrainingRecord) (*aggregate.TrainingRecord, error) {
+			s.records = append(s.records, rec)
+			return rec, nil
+		},
+		ListRecordsFn: func(_ context.Context, _ *sql.Tx, ids []int32) ([]*aggregate.TrainingRecord, error) {
+			var result []*aggregate.TrainingRecord
+			for _, rec := range s.records {
+				for _, id := range ids {
+					if rec.StudentID == id {
+						result = append(result, rec)
+					}
+				}
+			}
+			return result, nil
+		},
+		ListUnremindedExpiringFn: func(_ context.Context, _ *sql.Tx, before time.Time) ([]*aggregate.TrainingRecord, error) {
+			var result []*aggregate.TrainingRecord
+			for _, rec := range s.records {
+				if rec.ReminderSentAt == nil && rec.ExpiresOn != nil && !rec.ExpiresOn.After(before) {
+					result = append(result, rec)
+				}
+			}
+			return result, nil
+		},
+		MarkReminderSentFn: func(_ context.Context, _ *sql.Tx, ids []uuid.UUID, _ time.Time) error {
+			s.marked = append(s.marked, ids...)
+			return nil
+		},
+	}
+	studentRepo := &mocks.MockStudentRepository{
+		GetByIDIncludingDeactivatedFn: func(_ context.Context, _ *sql.Tx, id int32) (*aggregate.Student, error) {
+			if id == 816000099 {
+				return nil, studentErrors.ErrNotFound
+			}
+			return &aggregate.Student{StudentID: id}, nil
+		},
+		ListByIDsFn: func(_ context.Context, _ *sql.Tx, ids []int32) ([]*aggregate.Student, error) {
+			students := make([]*aggregate.Student, len(ids))
+			for i, id := range ids {
+				students[i] = &aggregate.Student{StudentID: id, FirstName: "Jane", EmailAddress: "jane@my.uwi.edu"}
+			}
+			return students, nil
+		},
+	}
+	documentSvc := &mocks.MockStudentDocumentService{
+		UploadDocumentByStudentIDFn: func(_ context.Context, studentID int32, documentType, filename string, content []byte) (*aggregate.StudentDocument, error) {
+			s.uploads = append(s.uploads, documentType)
+			return aggregate.NewStudentDocument(&studentID, documentType, filename, content, nil, time.Now())
+		},
+	}
+	emailSender := &mocks.MockEmailSender{
+		SendBatchFn: func(_ context.Context, req dtos.SendEmailBulkRequest) (*dtos.SendEmailBulkResponse, error) {
+			if s.sendErr != nil {
+				return nil, s.sendErr
+			}
+			s.sent = append(s.sent, req...)
+			return &dtos.SendEmailBulkResponse{}, nil
+		},
+	}
+
+	s.svc = service.NewTrainingService(zap.NewNop(), &mocks.StubTxManager{}, trainingRepo, studentRepo, documentSvc, emailSender, "helpdesk@uwi.edu", "https://helpdesk.example.com")
+	s.svc.WithNowFn(func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) })
+}
+
+func (s *TrainingServiceTestSuite) adminCtx() context.Context {
+	return database.WithAuthContext(context.Background(), database.AuthContext{UserID: uuid.NewString(), Role: "admin"})
+}
+
+func (s *TrainingServiceTestSuite) TestCreateRequirement() {
+	req, err := s.svc.CreateRequirement(s.adminCtx(), service.TrainingRequirementInput{Name: "Privacy Module", IsBlocking: true, ValidityMonths: int32Ptr(12)})
+	s.Require().NoError(err)
+	s.True(req.IsBlocking)
+	s.True(req.IsActive)
+
+	_, err = s.svc.CreateRequirement(s.adminCtx(), service.TrainingRequirementInput{Name: "privacy module"})
+	s.ErrorIs(err, studentErrors.ErrDuplicateTrainingName)
+
+	_, err = s.svc.CreateRequirement(context.Background(), service.TrainingRequirementInput{Name: "Orientation"})
+	s.ErrorIs(err, studentErrors.ErrMissingAuthContext)
+}
+
+func (s *TrainingServiceTestSuite) TestUpdateRequirement() {
+	privacy := trainingRequirement("Privacy Module", false, nil)
+	s.requirements = []*aggregate.TrainingRequirement{privacy, trainingRequirement("Orientation", true, nil)}
+
+	inactive := false
+	updated, err := s.svc.UpdateRequirement(s.adminCtx(), privacy.ID, service.TrainingRequirementInput{Name: "Privacy Module", IsBlocking: true, IsActive: &inactive})
+	s.Require().NoError(err)
+	s.True(updated.IsBlocking)
+	s.False(updated.IsActive)
+
+	_, err = s.svc.UpdateRequirement(s.adminCtx(), privacy.ID, service.TrainingRequirementInput{Name: "Orientation"})
+	s.ErrorIs(err, studentErrors.ErrDuplicateTrainingName)
+
+	_, err = s.svc.UpdateRequirement(s.adminCtx(), uuid.New(), service.TrainingRequirementInput{Name: "New"})
+	s.ErrorIs(err, studentErrors.ErrTrainingRequirementNotFound)
+}
+
+func (s *TrainingServiceTestSuite) TestRecordCompletion_WithEvidence() {
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	s.requirements = []*aggregate.TrainingRequirement{privacy}
+
+	rec, err := s.svc.RecordCompletion(s.adminCtx(), 816000001, service.TrainingCompletionInput{
+		RequirementID: privacy.ID,
+		CompletedOn:   date("2026-03-01"),
+		Evidence:      &service.TrainingEvidence{Filename: "privacy.pdf", Content: samplePDF},
+	})
+	s.Require().NoError(err)
+	s.Equal(date("2027-03-01"), *rec.ExpiresOn)
+	s.NotNil(rec.EvidenceDocumentID)
+	s.NotNil(rec.RecordedBy)
+	s.Equal([]string{string(aggregate.DocumentType_TrainingCertificate)}, s.uploads)
+}
+
+func (s *TrainingServiceTestSuite) TestRecordCompletion_InvalidStoresNoEvidence() {
+	privacy := trainingRequirement("Privacy Module", true, nil)
+	s.requirements = []*aggregate.TrainingRequirement{privacy}
+	evidence := &service.TrainingEvidence{Filename: "privacy.pdf", Content: samplePDF}
+
+	_, err := s.svc.RecordCompletion(s.adminCtx(), 816000001, service.TrainingCompletionInput{
+		RequirementID: privacy.ID,
+		CompletedOn:   date("2026-04-01"),
+		Evidence:      evidence,
+	})
+	s.ErrorIs(err, studentErrors.ErrInvalidTrainingDates)
+
+	_, err = s.svc.RecordCompletion(s.adminCtx(), 816000099, service.TrainingCompletionInput{
+		RequirementID: privacy.ID,
+		CompletedOn:   date("2026-03-01"),
+		Evidence:      evidence,
+	})
+	s.ErrorIs(err, studentErrors.ErrNotFound)
+
+	s.Empty(s.uploads)
+	s.Empty(s.records)
+}
+
+func (s *TrainingServiceTestSuite) TestGetMine() {
+	orientation := trainingRequirement("Orientation", true, nil)
+	s.requirements = []*aggregate.TrainingRequirement{orientation, trainingRequirement("Privacy Module", true, nil)}
+	s.records = []*aggregate.TrainingRecord{
+		trainingRecord(816000001, orientation, "2026-01-10", nil),
+		trainingRecord(816000002, orientation, "2026-01-10", nil),
+	}
+
+	statuses, err := s.svc.GetMine(studentCtx("816000001"))
+	s.Require().NoError(err)
+	s.Require().Len(statuses, 2)
+	s.Equal(aggregate.TrainingState_Complete, statuses[0].State)
+	s.Equal(aggregate.TrainingState_Missing, statuses[1].State)
+
+	_, err = s.svc.GetMine(s.adminCtx())
+	s.ErrorIs(err, studentErrors.ErrNotAuthorized)
+}
+
+func (s *TrainingServiceTestSuite) TestMissingBlocking() {
+	orientation := trainingRequirement("Orientation", true, nil)
+	firstAid := trainingRequirement("First Aid", false, nil)
+	s.requirements = []*aggregate.TrainingRequirement{orientation, firstAid}
+	s.records = []*aggregate.TrainingRecord{trainingRecord(816000001, orientation, "2026-01-10", nil)}
+
+	missing, err := s.svc.MissingBlocking(s.adminCtx(), []int32{816000001, 816000002}, date("2026-09-01"))
+	s.Require().NoError(err)
+	s.Equal(map[int32][]*aggregate.TrainingRequirement{816000002: {orientation}}, missing)
+
+	// Nothing is blocking once the requirement is retired.
+	orientation.IsActive = false
+	missing, err = s.svc.MissingBlocking(s.adminCtx(), []int32{816000002}, date("2026-09-01"))
+	s.Require().NoError(err)
+	s.Empty(missing)
+}
+
+func (s *TrainingServiceTestSuite) TestSendExpiryReminders() {
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	retired := trainingRequirement("Retired", false, int32Ptr(12))
+	retired.IsActive = false
+	s.requirements = []*aggregate.TrainingRequirement{privacy, retired}
+
+	soon, later, lapsed := date("2026-04-01"), date("2026-06-01"), date("2026-03-01")
+	due := trainingRecord(816000001, privacy, "2025-04-01", &soon)
+	s.records = []*aggregate.TrainingRecord{
+		due,
+		trainingRecord(816000002, privacy, "2025-06-01", &later),
+		trainingRecord(816000003, privacy, "2025-03-01", &lapsed),
+		trainingRecord(816000004, retired, "2025-04-01", &soon),
+	}
+
+	sent, err := s.svc.SendExpiryReminders(context.Background())
+	s.Require().NoError(err)
+	s.Equal(1, sent)
+	s.Require().Len(s.sent, 1)
+	s.Equal([]string{"jane@my.uwi.edu"}, s.sent[0].To)
+	s.Equal(templates.TemplateID_TrainingExpiry, s.sent[0].Template.ID)
+	s.Equal("1 April 2026", s.sent[0].Template.Variables["EXPIRES_ON"])
+	s.Equal([]uuid.UUID{due.ID}, s.marked)
+}
+
+func (s *TrainingServiceTestSuite) TestSendExpiryReminders_SendFailsLeavesUnmarked() {
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	s.requirements = []*aggregate.TrainingRequirement{privacy}
+	soon := date("2026-04-01")
+	s.records = []*aggregate.TrainingRecord{trainingRecord(816000001, privacy, "2025-04-01", &soon)}
+	s.sendErr = errors.New("provider down")
+
+	sent, err := s.svc.SendExpiryReminders(context.Background())
+	s.Error(err)
+	s.Zero(sent)
+	s.Empty(s.marked)
+}
@@ -0,0 +1,174 @@
+package student_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrainingTestSuite struct {
+	suite.Suite
+}
+
+func TestTrainingTestSuite(t *testing.T) {
+	suite.Run(t, new(TrainingTestSuite))
+}
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func trainingRequirement(name string, blocking bool, validityMonths *int32) *aggregate.TrainingRequirement {
+	return &aggregate.TrainingRequirement{
+		ID:             uuid.New(),
+		Name:           name,
+		IsBlocking:     blocking,
+		ValidityMonths: validityMonths,
+		IsActive:       true,
+	}
+}
+
+func trainingRecord(studentID int32, req *aggregate.TrainingRequirement, completedOn string, expiresOn *time.Time) *aggregate.TrainingRecord {
+	return &aggregate.TrainingRecord{
+		ID:            uuid.New(),
+		StudentID:     studentID,
+		RequirementID: req.ID,
+		CompletedOn:   date(completedOn),
+		ExpiresOn:     expiresOn,
+	}
+}
+
+func (s *TrainingTestSuite) TestNewTrainingRequirement() {
+	req, err := aggregate.NewTrainingRequirement("  Privacy Module ", strPtr(" "), true, int32Ptr(12))
+	s.Require().NoError(err)
+	s.Equal("Privacy Module", req.Name)
+	s.Nil(req.Description)
+	s.True(req.IsBlocking)
+	s.True(req.IsActive)
+
+	_, err = aggregate.NewTrainingRequirement("", nil, false, nil)
+	s.ErrorIs(err, studentErrors.ErrInvalidTrainingName)
+
+	_, err = aggregate.NewTrainingRequirement("Orientation", nil, false, int32Ptr(0))
+	s.ErrorIs(err, studentErrors.ErrInvalidTrainingValidity)
+}
+
+func (s *TrainingTestSuite) TestNewTrainingRecord_Expiry() {
+	now := date("2026-03-10")
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+
+	rec, err := aggregate.NewTrainingRecord(privacy, 816000001, date("2026-03-01"), nil, nil, now)
+	s.Require().NoError(err)
+	s.Require().NotNil(rec.ExpiresOn)
+	s.Equal(date("2027-03-01"), *rec.ExpiresOn)
+
+	// A certificate's own expiry wins over the requirement's validity.
+	own := date("2026-09-01")
+	rec, err = aggregate.NewTrainingRecord(privacy, 816000001, date("2026-03-01"), &own, nil, now)
+	s.Require().NoError(err)
+	s.Equal(own, *rec.ExpiresOn)
+
+	orientation := trainingRequirement("Orientation", true, nil)
+	rec, err = aggregate.NewTrainingRecord(orientation, 816000001, date("2026-03-01"), nil, nil, now)
+	s.Require().NoError(err)
+	s.Nil(rec.ExpiresOn)
+}
+
+func (s *TrainingTestSuite) TestNewTrainingRecord_Invalid() {
+	now := date("2026-03-10")
+	req := trainingRequirement("Privacy Module", true, nil)
+
+	_, err := aggregate.NewTrainingRecord(req, 816000001, date("2026-03-11"), nil, nil, now)
+	s.ErrorIs(err, studentErrors.ErrInvalidTrainingDates)
+
+	expires := date("2026-03-01")
+	_, err = aggregate.NewTrainingRecord(req, 816000001, date("2026-03-01"), &expires, nil, now)
+	s.ErrorIs(err, studentErrors.ErrInvalidTrainingDates)
+
+	req.IsActive = false
+	_, err = aggregate.NewTrainingRecord(req, 816000001, date("2026-03-01"), nil, nil, now)
+	s.ErrorIs(err, studentErrors.ErrTrainingRequirementInactive)
+}
+
+func (s *TrainingTestSuite) TestNeedsReminder() {
+	req := trainingRequirement("Privacy Module", true, nil)
+	expires := date("2026-04-09")
+	rec := trainingRecord(816000001, req, "2025-04-09", &expires)
+
+	s.False(rec.NeedsReminder(date("2026-03-09")))
+	s.True(rec.NeedsReminder(date("2026-03-10")))
+	s.True(rec.NeedsReminder(date("2026-04-08")))
+	s.False(rec.NeedsReminder(date("2026-04-09")), "already expired")
+
+	sent := date("2026-03-10")
+	rec.ReminderSentAt = &sent
+	s.False(rec.NeedsReminder(date("2026-03-11")))
+
+	s.False(trainingRecord(816000001, req, "2025-04-09", nil).NeedsReminder(date("2026-03-10")))
+}
+
+func (s *TrainingTestSuite) TestTrainingStatuses() {
+	orientation := trainingRequirement("Orientation", true, nil)
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	firstAid := trainingRequirement("First Aid", false, int32Ptr(24))
+	security := trainingRequirement("Security", false, nil)
+	retired := trainingRequirement("Retired", true, nil)
+	retired.IsActive = false
+
+	privacyExpires := date("2026-03-20")
+	firstAidExpires := date("2026-03-01")
+	records := []*aggregate.TrainingRecord{
+		trainingRecord(816000001, orientation, "2025-09-01", nil),
+		trainingRecord(816000001, privacy, "2025-03-20", &privacyExpires),
+		trainingRecord(816000001, firstAid, "2024-03-01", &firstAidExpires),
+	}
+
+	statuses := aggregate.TrainingStatuses([]*aggregate.TrainingRequirement{orientation, privacy, firstAid, security, retired}, records, date("2026-03-10"))
+	s.Require().Len(statuses, 4)
+	s.Equal(aggregate.TrainingState_Complete, statuses[0].State)
+	s.Equal(aggregate.TrainingState_Expiring, statuses[1].State)
+	s.Equal(aggregate.TrainingState_Expired, statuses[2].State)
+	s.Equal(aggregate.TrainingState_Missing, statuses[3].State)
+	s.Nil(statuses[3].Record)
+}
+
+func (s *TrainingTestSuite) TestMissingBlockingTraining() {
+	orientation := trainingRequirement("Orientation", true, nil)
+	privacy := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	firstAid := trainingRequirement("First Aid", false, nil)
+	requirements := []*aggregate.TrainingRequirement{orientation, privacy, firstAid}
+
+	privacyExpires := date("2026-09-01")
+	records := []*aggregate.TrainingRecord{
+		trainingRecord(816000001, orientation, "2025-09-01", nil),
+		trainingRecord(816000001, privacy, "2025-09-01", &privacyExpires),
+	}
+
+	s.Empty(aggregate.MissingBlockingTraining(requirements, records, date("2026-08-31")))
+	// The privacy module lapses on the day the schedule starts.
+	s.Equal([]*aggregate.TrainingRequirement{privacy}, aggregate.MissingBlockingTraining(requirements, records, date("2026-09-01")))
+	s.Equal([]*aggregate.TrainingRequirement{orientation, privacy}, aggregate.MissingBlockingTraining(requirements, nil, date("2026-08-31")))
+}
+
+func (s *TrainingTestSuite) TestModelRoundTrip() {
+	req := trainingRequirement("Privacy Module", true, int32Ptr(12))
+	rm := req.ToModel()
+	s.Equal(req, aggregate.TrainingRequirementFromModel(&rm))
+
+	evidence := uuid.New()
+	expires := date("2027-03-01")
+	rec := trainingRecord(816000001, req, "2026-03-01", &expires)
+	rec.EvidenceDocumentID = &evidence
+	m := rec.ToModel()
+	s.Equal(rec, aggregate.TrainingRecordFromModel(&m))
+}
@@ -0,0 +1,165 @@
+package student_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	transcriptErrors "github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TranscriptSubmissionHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockTranscriptSubmissionService
+	router  *chi.Mux
+}
+
+func TestTranscriptSubmissionHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TranscriptSubmissionHandlerTestSuite))
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockTranscriptSubmissionService{}
+	hdl := handler.NewTranscriptSubmissionHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) do(req *http.Request) *httptest.ResponseRecorder {
+	req = req.WithContext(database.WithAuthContext(req.Context(), *adminAuthCtx()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) submit(content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", "transcript.pdf")
+	s.Require().NoError(err)
+	_, err = fw.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/students/me/transcript-submissions", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return s.do(req)
+}
+
+func sampleSubmission() *aggregate.TranscriptSubmission {
+	next := recordedTranscript()
+	next.OverallGPA = floatPtr(3.5)
+	return &aggregate.TranscriptSubmission{
+		ID:         uuid.New(),
+		StudentID:  816000001,
+		Status:     aggregate.TranscriptSubmissionStatus_Pending,
+		Transcript: next,
+		Diff:       aggregate.DiffTranscripts(recordedTranscript(), next),
+	}
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) TestSubmit() {
+	s.mockSvc.SubmitFn = func(_ context.Context, filename string, _ []byte) (*aggregate.TranscriptSubmission, error) {
+		s.Equal("transcript.pdf", filename)
+		return sampleSubmission(), nil
+	}
+
+	rr := s.submit(samplePDF)
+	s.Require().Equal(http.StatusCreated, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("pending", resp["status"])
+	diff := resp["diff"].(map[string]any)
+	s.Equal(3.5, diff["overall_gpa"].(map[string]any)["to"])
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) TestSubmit_Errors() {
+	rr := s.submit(samplePNG)
+	s.Equal(http.StatusBadRequest, rr.Code)
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{studentErrors.ErrTranscriptUnchanged, http.StatusConflict},
+		{studentErrors.ErrTranscriptMismatch, http.StatusUnprocessableEntity},
+		{transcriptErrors.ErrTranscriptsUnavailable, http.StatusServiceUnavailable},
+		{studentErrors.ErrNotAuthorized, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		s.mockSvc.SubmitFn = func(context.Context, string, []byte) (*aggregate.TranscriptSubmission, error) {
+			return nil, tc.err
+		}
+		rr := s.submit(samplePDF)
+		s.Equal(tc.status, rr.Code, tc.err.Error())
+	}
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) TestApprove() {
+	sub := sampleSubmission()
+	sub.Status = aggregate.TranscriptSubmissionStatus_Approved
+	s.mockSvc.ApproveFn = func(_ context.Context, id uuid.UUID, note string) (*service.TranscriptReviewResult, error) {
+		s.Equal(sub.ID, id)
+		s.Equal("ok", note)
+		return &service.TranscriptReviewResult{
+			Submission:    sub,
+			GainedCourses: []string{},
+			LostCourses:   []string{"COMP1601"},
+			AffectedAssignments: []service.AffectedAssignment{{
+				StudentAssignment: aggregate.StudentAssignment{ShiftID: "shift-1", DayOfWeek: 2, Start: "09:00", End: "10:00"},
+				LostCourses:       []string{"COMP1601"},
+			}},
+		}, nil
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/transcript-submissions/"+sub.ID.String()+"/approve", strings.NewReader(`{"note":"ok"}`))
+	rr := s.do(req)
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("approved", resp["submission"].(map[string]any)["status"])
+	affected := resp["affected_assignments"].([]any)
+	s.Require().Len(affected, 1)
+	s.Equal("shift-1", affected[0].(map[string]any)["shift_id"])
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) TestReject_EmptyBody() {
+	s.mockSvc.RejectFn = func(_ context.Context, _ uuid.UUID, note string) (*aggregate.TranscriptSubmission, error) {
+		s.Empty(note)
+		return nil, studentErrors.ErrTranscriptSubmissionNotPending
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/transcript-submissions/"+uuid.NewString()+"/reject", nil)
+	rr := s.do(req)
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *TranscriptSubmissionHandlerTestSuite) TestList_InvalidStatus() {
+	s.mockSvc.ListFn = func(_ context.Context, status string) ([]*aggregate.TranscriptSubmission, error) {
+		s.Equal("done", status)
+		return nil, studentErrors.ErrInvalidSubmissionStatus
+	}
+
+	rr := s.do(httptest.NewRequest(http.MethodGet, "/api/v1/transcript-submissions?status=done", nil))
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
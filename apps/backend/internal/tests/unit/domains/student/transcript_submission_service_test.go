@@ -0,0 +1,222 @@
+package student_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TranscriptSubmissionServiceTestSuite struct {
+	suite.Suite
+	submissionRepo *mocks.MockTranscriptSubmissionRepository
+	studentRepo    *mocks.MockStudentRepository
+	scheduleRepo   *mocks.MockScheduleRepository
+	documentSvc    *mocks.MockStudentDocumentService
+	extracted      types.TranscriptMetadata
+	student        *aggregate.Student
+	saved          map[uuid.UUID]*aggregate.TranscriptSubmission
+	uploads        int
+	shiftID        uuid.UUID
+	svc            *service.TranscriptSubmissionService
+	now            time.Time
+}
+
+func TestTranscriptSubmissionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TranscriptSubmissionServiceTestSuite))
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) SetupTest() {
+	s.now = time.Date(2026, 4, 6, 9, 0, 0, 0, time.UTC)
+	s.saved = map[uuid.UUID]*aggregate.TranscriptSubmission{}
+	s.uploads = 0
+	s.shiftID = uuid.New()
+	s.student = &aggregate.Student{StudentID: 816000001, TranscriptMetadata: recordedTranscript()}
+	s.extracted = recordedTranscript()
+
+	s.submissionRepo = &mocks.MockTranscriptSubmissionRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, sub *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+			s.saved[sub.ID] = sub
+			return sub, nil
+		},
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.TranscriptSubmission, error) {
+			sub, ok := s.saved[id]
+			if !ok {
+				return nil, studentErrors.ErrTranscriptSubmissionNotFound
+			}
+			return sub, nil
+		},
+		GetPendingByStudentIDFn: func(_ context.Context, _ *sql.Tx, studentID int32) (*aggregate.TranscriptSubmission, error) {
+			for _, sub := range s.saved {
+				if sub.StudentID == studentID && sub.IsPending() {
+					return sub, nil
+				}
+			}
+			return nil, studentErrors.ErrTranscriptSubmissionNotFound
+		},
+		ListFn: func(_ context.Context, _ *sql.Tx, filter repository.TranscriptSubmissionFilter) ([]*aggregate.TranscriptSubmission, error) {
+			result := []*aggregate.TranscriptSubmission{}
+			for _, sub := range s.saved {
+				if filter.StudentID != nil && sub.StudentID != *filter.StudentID {
+					continue
+				}
+				if filter.Status != nil && sub.Status != *filter.Status {
+					continue
+				}
+				result = append(result, sub)
+			}
+			return result, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, sub *aggregate.TranscriptSubmission) (*aggregate.TranscriptSubmission, error) {
+			s.saved[sub.ID] = sub
+			return sub, nil
+		},
+	}
+	s.studentRepo = &mocks.MockStudentRepository{
+		GetByIDFn: func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.Student, error) {
+			return s.student, nil
+		},
+		UpdateFn: func(_ context.Context, _ *sql.Tx, student *aggregate.Student) error {
+			s.student = student
+			return nil
+		},
+	}
+	eligibilityRepo := &mocks.MockCourseEligibilityRepository{
+		ListRulesFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.EligibilityRule, error) {
+			return []*aggregate.EligibilityRule{}, nil
+		},
+		ListOverridesFn: func(_ context.Context, _ *sql.Tx, _ []int32) ([]*aggregate.EligibilityOverride, error) {
+			return []*aggregate.EligibilityOverride{}, nil
+		},
+	}
+	courseRepo := &mocks.MockCourseRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.Course, error) {
+			return []*scheduleAggregate.Course{}, nil
+		},
+	}
+	s.scheduleRepo = &mocks.MockScheduleRepository{
+		GetActiveFn: func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+			return &scheduleAggregate.Schedule{
+				Assignments: json.RawMessage(`[
+					{"assistant_id": "816000001", "shift_id": "` + s.shiftID.String() + `", "day_of_week": 0, "start": "09:00", "end": "10:00"},
+					{"assistant_id": "816000001", "shift_id": "other", "day_of_week": 1, "start": "09:00", "end": "10:00"}
+				]`),
+			}, nil
+		},
+	}
+	shiftTemplateRepo := &mocks.MockShiftTemplateRepository{
+		ListAllFn: func(_ context.Context, _ *sql.Tx) ([]*scheduleAggregate.ShiftTemplate, error) {
+			return []*scheduleAggregate.ShiftTemplate{
+				{ID: s.shiftID, CourseDemands: []scheduleAggregate.CourseDemand{{CourseCode: "COMP1601", TutorsRequired: 1}}},
+			}, nil
+		},
+	}
+	transcripts := &mocks.MockTranscriptsService{
+		ExtractTranscriptFn: func(_ string, _ []byte) (*dtos.ExtractTranscriptResponse, error) {
+			t := s.extracted
+			return &t, nil
+		},
+	}
+	s.documentSvc = &mocks.MockStudentDocumentService{
+		UploadMyDocumentFn: func(_ context.Context, _, _ string, _ []byte) (*aggregate.StudentDocument, error) {
+			s.uploads++
+			return sampleDocument(), nil
+		},
+	}
+
+	s.svc = service.NewTranscriptSubmissionService(zap.NewNop(), &mocks.StubTxManager{}, s.submissionRepo, s.studentRepo,
+		eligibilityRepo, courseRepo, s.scheduleRepo, shiftTemplateRepo, transcripts, s.documentSvc)
+	s.svc.WithNowFn(func() time.Time { return s.now })
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) TestSubmit_SupersedesPending() {
+	s.extracted.Courses = append(s.extracted.Courses, types.CourseResult{Code: "COMP2601", Grade: strPtr("A")})
+	first, err := s.svc.Submit(studentCtx("816000001"), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+	s.NotNil(first.DocumentID)
+	s.Len(first.Diff.NewCourses, 1)
+
+	s.extracted.OverallGPA = floatPtr(3.5)
+	second, err := s.svc.Submit(studentCtx("816000001"), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.TranscriptSubmissionStatus_Superseded, s.saved[first.ID].Status)
+	s.Equal(aggregate.TranscriptSubmissionStatus_Pending, s.saved[second.ID].Status)
+	s.Equal(2, s.uploads)
+
+	mine, err := s.svc.ListMine(studentCtx("816000001"))
+	s.Require().NoError(err)
+	s.Len(mine, 2)
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) TestSubmit_UnchangedStoresNothing() {
+	_, err := s.svc.Submit(studentCtx("816000001"), "transcript.pdf", samplePDF)
+	s.ErrorIs(err, studentErrors.ErrTranscriptUnchanged)
+	s.Zero(s.uploads)
+	s.Empty(s.saved)
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) TestSubmit_RequiresStudent() {
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	_, err := s.svc.Submit(adminCtx, "transcript.pdf", samplePDF)
+	s.ErrorIs(err, studentErrors.ErrNotAuthorized)
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) TestApprove_ReportsEligibilityAndSchedule() {
+	// COMP1601 drops below the default minimum; COMP1602 is passed on retake.
+	s.extracted.Courses = []types.CourseResult{
+		{Code: "COMP1601", Title: "Programming I", Grade: strPtr("D")},
+		{Code: "COMP1602", Title: "Programming II", Grade: strPtr("B")},
+		{Code: "MATH1115", Title: "Calculus", Grade: strPtr("A")},
+	}
+	sub, err := s.svc.Submit(studentCtx("816000001"), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	result, err := s.svc.Approve(adminCtx, sub.ID, "verified")
+	s.Require().NoError(err)
+
+	s.Equal(aggregate.TranscriptSubmissionStatus_Approved, result.Submission.Status)
+	s.Equal([]string{"COMP1602"}, result.GainedCourses)
+	s.Equal([]string{"COMP1601"}, result.LostCourses)
+	s.Require().Len(result.AffectedAssignments, 1)
+	s.Equal(s.shiftID.String(), result.AffectedAssignments[0].ShiftID)
+	s.Equal([]string{"COMP1601"}, result.AffectedAssignments[0].LostCourses)
+	s.Equal("D", *s.student.TranscriptMetadata.Courses[0].Grade)
+
+	_, err = s.svc.Approve(adminCtx, sub.ID, "")
+	s.ErrorIs(err, studentErrors.ErrTranscriptSubmissionNotPending)
+}
+
+func (s *TranscriptSubmissionServiceTestSuite) TestReject_LeavesTranscript() {
+	s.extracted.OverallGPA = floatPtr(3.9)
+	sub, err := s.svc.Submit(studentCtx("816000001"), "transcript.pdf", samplePDF)
+	s.Require().NoError(err)
+
+	adminCtx := database.WithAuthContext(context.Background(), *adminAuthCtx())
+	rejected, err := s.svc.Reject(adminCtx, sub.ID, "GPA does not match the registry")
+	s.Require().NoError(err)
+	s.Equal(aggregate.TranscriptSubmissionStatus_Rejected, rejected.Status)
+	s.Equal(3.2, *s.student.TranscriptMetadata.OverallGPA)
+
+	pending, err := s.svc.List(adminCtx, "pending")
+	s.Require().NoError(err)
+	s.Empty(pending)
+
+	_, err = s.svc.List(adminCtx, "bogus")
+	s.ErrorIs(err, studentErrors.ErrInvalidSubmissionStatus)
+}
@@ -0,0 +1,163 @@
+package student_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	studentErrors "github.com/HDR3604/HelpDeskApp/internal/domain/student/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/transcripts/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TranscriptSubmissionTestSuite struct {
+	suite.Suite
+}
+
+func TestTranscriptSubmissionTestSuite(t *testing.T) {
+	suite.Run(t, new(TranscriptSubmissionTestSuite))
+}
+
+func floatPtr(f float64) *float64 { return &f }
+
+func recordedTranscript() types.TranscriptMetadata {
+	return types.TranscriptMetadata{
+		FirstName:        "Jane",
+		LastName:         "Doe",
+		StudentID:        "816000001",
+		CurrentProgramme: "BSc Computer Science",
+		CurrentYear:      2,
+		OverallGPA:       floatPtr(3.2),
+		DegreeGPA:        floatPtr(3.4),
+		Courses: []types.CourseResult{
+			{Code: "COMP1601", Title: "Programming I", Grade: strPtr("B")},
+			{Code: "COMP1602", Title: "Programming II", Grade: strPtr("F1")},
+			{Code: "MATH1115", Title: "Calculus", Grade: strPtr("A")},
+		},
+	}
+}
+
+func (s *TranscriptSubmissionTestSuite) TestDiffTranscripts() {
+	next := recordedTranscript()
+	next.OverallGPA = floatPtr(3.35)
+	next.CurrentYear = 3
+	next.Courses = []types.CourseResult{
+		{Code: "COMP1601", Title: "Programming I", Grade: strPtr("B")},
+		// A retake is compared on its best grade.
+		{Code: "COMP1602", Title: "Programming II", Grade: strPtr("F1")},
+		{Code: "COMP1602", Title: "Programming II", Grade: strPtr("B+")},
+		{Code: "COMP2601", Title: "Computer Architecture", Grade: strPtr("A-")},
+	}
+
+	diff := aggregate.DiffTranscripts(recordedTranscript(), next)
+
+	s.Require().Len(diff.NewCourses, 1)
+	s.Equal("COMP2601", diff.NewCourses[0].Code)
+	s.Require().Len(diff.ChangedGrades, 1)
+	s.Equal("COMP1602", diff.ChangedGrades[0].Code)
+	s.Equal("F1", *diff.ChangedGrades[0].From)
+	s.Equal("B+", *diff.ChangedGrades[0].To)
+	s.Require().Len(diff.RemovedCourses, 1)
+	s.Equal("MATH1115", diff.RemovedCourses[0].Code)
+	s.Require().NotNil(diff.OverallGPA)
+	s.Equal(3.2, *diff.OverallGPA.From)
+	s.Equal(3.35, *diff.OverallGPA.To)
+	s.Nil(diff.DegreeGPA)
+	s.Equal(&aggregate.YearChange{From: 2, To: 3}, diff.CurrentYear)
+	s.Nil(diff.CurrentProgramme)
+	s.False(diff.IsEmpty())
+}
+
+func (s *TranscriptSubmissionTestSuite) TestDiffTranscripts_Unchanged() {
+	next := recordedTranscript()
+	// Rounding noise and a blank programme are not changes.
+	next.OverallGPA = floatPtr(3.2000001)
+	next.CurrentProgramme = ""
+	next.Courses[0].Code = "comp 1601"
+	next.Courses[0].Grade = strPtr("b")
+
+	s.True(aggregate.DiffTranscripts(recordedTranscript(), next).IsEmpty())
+}
+
+func (s *TranscriptSubmissionTestSuite) TestNewTranscriptSubmission() {
+	student := &aggregate.Student{StudentID: 816000001, TranscriptMetadata: recordedTranscript()}
+	next := recordedTranscript()
+	next.Courses = append(next.Courses, types.CourseResult{Code: "COMP2601", Grade: strPtr("A")})
+	docID := uuid.New()
+
+	sub, err := aggregate.NewTranscriptSubmission(student, next, &docID)
+	s.Require().NoError(err)
+	s.Equal(aggregate.TranscriptSubmissionStatus_Pending, sub.Status)
+	s.Equal(int32(816000001), sub.StudentID)
+	s.Equal(&docID, sub.DocumentID)
+	s.Len(sub.Diff.NewCourses, 1)
+
+	_, err = aggregate.NewTranscriptSubmission(student, recordedTranscript(), nil)
+	s.ErrorIs(err, studentErrors.ErrTranscriptUnchanged)
+
+	other := next
+	other.StudentID = "816999999"
+	_, err = aggregate.NewTranscriptSubmission(student, other, nil)
+	s.ErrorIs(err, studentErrors.ErrTranscriptMismatch)
+}
+
+func (s *TranscriptSubmissionTestSuite) TestReview() {
+	now := time.Date(2026, 4, 6, 9, 0, 0, 0, time.UTC)
+	reviewer := uuid.New()
+	student := &aggregate.Student{StudentID: 816000001, TranscriptMetadata: recordedTranscript()}
+	next := recordedTranscript()
+	next.DegreeGPA = floatPtr(3.6)
+
+	sub, err := aggregate.NewTranscriptSubmission(student, next, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(sub.Approve(&reviewer, "  looks right ", now))
+	s.Equal(aggregate.TranscriptSubmissionStatus_Approved, sub.Status)
+	s.Equal("looks right", *sub.ReviewNote)
+	s.Equal(&now, sub.ReviewedAt)
+
+	s.ErrorIs(sub.Reject(&reviewer, "", now), studentErrors.ErrTranscriptSubmissionNotPending)
+	s.ErrorIs(sub.Supersede(now), studentErrors.ErrTranscriptSubmissionNotPending)
+
+	s.Require().NoError(sub.ApplyTo(student))
+	s.Equal(3.6, *student.TranscriptMetadata.DegreeGPA)
+	s.Equal(2, student.TranscriptMetadata.CurrentYear)
+}
+
+func (s *TranscriptSubmissionTestSuite) TestParseTranscriptSubmissionStatus() {
+	status, err := aggregate.ParseTranscriptSubmissionStatus(" Pending ")
+	s.Require().NoError(err)
+	s.Equal(aggregate.TranscriptSubmissionStatus_Pending, status)
+
+	_, err = aggregate.ParseTranscriptSubmissionStatus("done")
+	s.ErrorIs(err, studentErrors.ErrInvalidSubmissionStatus)
+}
+
+func (s *TranscriptSubmissionTestSuite) TestModelRoundTrip() {
+	student := &aggregate.Student{StudentID: 816000001, TranscriptMetadata: recordedTranscript()}
+	next := recordedTranscript()
+	next.Major = "Software Engineering"
+	sub, err := aggregate.NewTranscriptSubmission(student, next, nil)
+	s.Require().NoError(err)
+
+	m := sub.ToModel()
+	back, err := aggregate.TranscriptSubmissionFromModel(&m)
+	s.Require().NoError(err)
+	s.Equal(sub.Transcript, back.Transcript)
+	s.Equal(sub.Diff, back.Diff)
+}
+
+func (s *TranscriptSubmissionTestSuite) TestStudentAssignments() {
+	raw := json.RawMessage(`[
+		{"assistant_id": "816000001", "shift_id": "a", "day_of_week": 0, "start": "09:00", "end": "10:00"},
+		{"assistant_id": "816000002", "shift_id": "b", "day_of_week": 1, "start": "09:00", "end": "10:00"}
+	]`)
+
+	assignments, err := aggregate.StudentAssignments(raw, 816000001)
+	s.Require().NoError(err)
+	s.Equal([]aggregate.StudentAssignment{{ShiftID: "a", DayOfWeek: 0, Start: "09:00", End: "10:00"}}, assignments)
+
+	_, err = aggregate.StudentAssignments(json.RawMessage(`{`), 816000001)
+	s.Error(err)
+}
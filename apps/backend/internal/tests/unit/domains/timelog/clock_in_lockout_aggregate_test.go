@@ -0,0 +1,82 @@
+package timelog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClockInLockoutAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestClockInLockoutAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(ClockInLockoutAggregateTestSuite))
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestLockoutDuration_Escalates() {
+	s.Equal(5*time.Minute, aggregate.LockoutDuration(0))
+	s.Equal(5*time.Minute, aggregate.LockoutDuration(1))
+	s.Equal(15*time.Minute, aggregate.LockoutDuration(2))
+	s.Equal(time.Hour, aggregate.LockoutDuration(3))
+	s.Equal(24*time.Hour, aggregate.LockoutDuration(4))
+	s.Equal(24*time.Hour, aggregate.LockoutDuration(10))
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestNewClockInAttempt_NormalizesCode() {
+	now := time.Now()
+	a, err := aggregate.NewClockInAttempt(12345, "  abc123  ", false, now)
+
+	s.Require().NoError(err)
+	s.Equal("ABC123", a.Code)
+	s.Equal(now, a.AttemptedAt)
+	s.False(a.Succeeded)
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestNewClockInAttempt_TruncatesLongCode() {
+	a, err := aggregate.NewClockInAttempt(12345, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789", false, time.Now())
+
+	s.Require().NoError(err)
+	s.Len(a.Code, 32)
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestNewClockInAttempt_InvalidStudent() {
+	_, err := aggregate.NewClockInAttempt(0, "ABC", false, time.Now())
+	s.ErrorIs(err, timelogErrors.ErrInvalidStudentID)
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestNewClockInLockout_SetsDuration() {
+	now := time.Date(2026, 3, 23, 9, 0, 0, 0, time.UTC)
+	l, err := aggregate.NewClockInLockout(12345, 2, 5, now)
+
+	s.Require().NoError(err)
+	s.Equal(int32(2), l.Level)
+	s.Equal(now.Add(15*time.Minute), l.LockedUntil)
+	s.True(l.IsActive(now.Add(14 * time.Minute)))
+	s.False(l.IsActive(now.Add(15 * time.Minute)))
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestClear() {
+	now := time.Date(2026, 3, 23, 9, 0, 0, 0, time.UTC)
+	l, err := aggregate.NewClockInLockout(12345, 1, 5, now)
+	s.Require().NoError(err)
+
+	clearedAt := now.Add(time.Minute)
+	s.Require().NoError(l.Clear(uuid.New(), clearedAt))
+
+	s.False(l.IsActive(clearedAt))
+	s.Equal(clearedAt, l.EndedAt())
+	s.ErrorIs(l.Clear(uuid.New(), clearedAt), timelogErrors.ErrLockoutAlreadyCleared)
+}
+
+func (s *ClockInLockoutAggregateTestSuite) TestEndedAt_Expired() {
+	now := time.Date(2026, 3, 23, 9, 0, 0, 0, time.UTC)
+	l, err := aggregate.NewClockInLockout(12345, 1, 5, now)
+	s.Require().NoError(err)
+
+	s.Equal(l.LockedUntil, l.EndedAt())
+}
@@ -0,0 +1,277 @@
+package timelog_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	userAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/user/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type ClockInLockoutServiceTestSuite struct {
+	suite.Suite
+	attemptRepo *mocks.MockClockInAttemptRepository
+	lockoutRepo *mocks.MockClockInLockoutRepository
+	userRepo    *mocks.MockUserRepository
+	emailSender *mocks.MockEmailSender
+	service     *service.ClockInLockoutService
+	now         time.Time
+	sentEmails  []emailDtos.SendEmailRequest
+	created     *aggregate.ClockInLockout
+	adminCtx    context.Context
+}
+
+func TestClockInLockoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ClockInLockoutServiceTestSuite))
+}
+
+func (s *ClockInLockoutServiceTestSuite) SetupTest() {
+	s.now = time.Date(2026, 3, 23, 13, 0, 0, 0, time.UTC)
+	s.sentEmails = nil
+	s.created = nil
+
+	s.attemptRepo = &mocks.MockClockInAttemptRepository{
+		CreateFn: func(_ context.Context, _ *sql.Tx, _ *aggregate.ClockInAttempt) error {
+			return nil
+		},
+		LastSuccessAtFn: func(_ context.Context, _ *sql.Tx, _ int32) (*time.Time, error) {
+			return nil, nil
+		},
+		CountFailuresSinceFn: func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (int, error) {
+			return 1, nil
+		},
+		CountStudentsFailingCodeSinceFn: func(_ context.Context, _ *sql.Tx, _ string, _ time.Time) (int, error) {
+			return 1, nil
+		},
+	}
+	s.lockoutRepo = &mocks.MockClockInLockoutRepository{
+		GetLatestByStudentIDFn: func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.ClockInLockout, error) {
+			return nil, timelogErrors.ErrLockoutNotFound
+		},
+		CreateFn: func(_ context.Context, _ *sql.Tx, l *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+			s.created = l
+			return l, nil
+		},
+	}
+	s.userRepo = &mocks.MockUserRepository{
+		ListByRoleFn: func(_ context.Context, _ *sql.Tx, role string) ([]*userAggregate.User, error) {
+			s.Equal(string(userAggregate.Role_Admin), role)
+			return []*userAggregate.User{
+				{Email: "admin@uwi.edu", IsActive: true},
+				{Email: "former@uwi.edu", IsActive: false},
+			}, nil
+		},
+	}
+	s.emailSender = &mocks.MockEmailSender{
+		SendFn: func(_ context.Context, req emailDtos.SendEmailRequest) (*emailDtos.SendEmailResponse, error) {
+			s.sentEmails = append(s.sentEmails, req)
+			return &emailDtos.SendEmailResponse{ID: "msg"}, nil
+		},
+	}
+
+	s.service = service.NewClockInLockoutService(
+		zap.NewNop(), &mocks.StubTxManager{}, s.attemptRepo, s.lockoutRepo, s.userRepo, s.emailSender, "noreply@uwi.edu",
+	)
+	s.service.WithNowFn(func() time.Time { return s.now })
+
+	s.adminCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: uuid.New().String(),
+		Role:   "admin",
+	})
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_BelowThreshold() {
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "bad", false)
+
+	s.NoError(err)
+	s.Nil(lockout)
+	s.Nil(s.created)
+	s.Empty(s.sentEmails)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_Success_SkipsChecks() {
+	s.attemptRepo.CountFailuresSinceFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (int, error) {
+		s.Fail("failures should not be counted for a successful attempt")
+		return 0, nil
+	}
+
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "GOOD", true)
+
+	s.NoError(err)
+	s.Nil(lockout)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_LocksOutAndAlerts() {
+	s.attemptRepo.CountFailuresSinceFn = func(_ context.Context, _ *sql.Tx, _ int32, since time.Time) (int, error) {
+		s.Equal(s.now.Add(-aggregate.ClockInAttemptWindow), since)
+		return aggregate.MaxFailedClockInAttempts, nil
+	}
+
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "bad", false)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(lockout)
+	s.Equal(int32(1), lockout.Level)
+	s.Equal(s.now.Add(5*time.Minute), lockout.LockedUntil)
+	s.Require().Len(s.sentEmails, 1)
+	s.Equal([]string{"admin@uwi.edu"}, s.sentEmails[0].To)
+	s.Equal(templates.TemplateID_AdminAlert, s.sentEmails[0].Template.ID)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_EscalatesAfterRecentLockout() {
+	// Level 1 lockout that expired three minutes ago
+	previous, err := aggregate.NewClockInLockout(12345, 1, 5, s.now.Add(-8*time.Minute))
+	s.Require().NoError(err)
+	s.lockoutRepo.GetLatestByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.ClockInLockout, error) {
+		return previous, nil
+	}
+	s.attemptRepo.CountFailuresSinceFn = func(_ context.Context, _ *sql.Tx, _ int32, since time.Time) (int, error) {
+		// Only failures after the previous lockout ended count
+		s.Equal(previous.EndedAt(), since)
+		return aggregate.MaxFailedClockInAttempts, nil
+	}
+
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "bad", false)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(lockout)
+	s.Equal(int32(2), lockout.Level)
+	s.Equal(s.now.Add(15*time.Minute), lockout.LockedUntil)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_AdminClearResetsLevel() {
+	previous, err := aggregate.NewClockInLockout(12345, 3, 5, s.now.Add(-2*time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(previous.Clear(uuid.New(), s.now.Add(-90*time.Minute)))
+	s.lockoutRepo.GetLatestByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.ClockInLockout, error) {
+		return previous, nil
+	}
+	s.attemptRepo.CountFailuresSinceFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (int, error) {
+		return aggregate.MaxFailedClockInAttempts, nil
+	}
+
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "bad", false)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(lockout)
+	s.Equal(int32(1), lockout.Level)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_CountsSinceLastSuccess() {
+	lastSuccess := s.now.Add(-2 * time.Minute)
+	s.attemptRepo.LastSuccessAtFn = func(_ context.Context, _ *sql.Tx, _ int32) (*time.Time, error) {
+		return &lastSuccess, nil
+	}
+	s.attemptRepo.CountFailuresSinceFn = func(_ context.Context, _ *sql.Tx, _ int32, since time.Time) (int, error) {
+		s.Equal(lastSuccess, since)
+		return 2, nil
+	}
+
+	lockout, err := s.service.RecordAttempt(context.Background(), 12345, "bad", false)
+
+	s.NoError(err)
+	s.Nil(lockout)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestRecordAttempt_CodeAbuseAlertsOnce() {
+	count := aggregate.CodeAbuseStudentThreshold
+	s.attemptRepo.CountStudentsFailingCodeSinceFn = func(_ context.Context, _ *sql.Tx, code string, _ time.Time) (int, error) {
+		s.Equal("SHARED1", code)
+		return count, nil
+	}
+
+	_, err := s.service.RecordAttempt(context.Background(), 12345, "shared1", false)
+	s.Require().NoError(err)
+	s.Len(s.sentEmails, 1)
+
+	count++
+	_, err = s.service.RecordAttempt(context.Background(), 12346, "shared1", false)
+	s.Require().NoError(err)
+	s.Len(s.sentEmails, 1)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestGetActiveLockout() {
+	active, err := aggregate.NewClockInLockout(12345, 1, 5, s.now.Add(-time.Minute))
+	s.Require().NoError(err)
+	s.lockoutRepo.GetLatestByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.ClockInLockout, error) {
+		return active, nil
+	}
+
+	result, err := s.service.GetActiveLockout(context.Background(), 12345)
+	s.NoError(err)
+	s.Equal(active, result)
+
+	s.now = s.now.Add(10 * time.Minute)
+	result, err = s.service.GetActiveLockout(context.Background(), 12345)
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestGetActiveLockout_None() {
+	result, err := s.service.GetActiveLockout(context.Background(), 12345)
+
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestListLockouts_ActiveOnly() {
+	s.lockoutRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter repository.ClockInLockoutFilter) ([]*aggregate.ClockInLockout, error) {
+		s.Require().NotNil(filter.ActiveAt)
+		s.Equal(s.now, *filter.ActiveAt)
+		return []*aggregate.ClockInLockout{}, nil
+	}
+
+	_, err := s.service.ListLockouts(s.adminCtx, nil, true)
+	s.NoError(err)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestListLockouts_NotAdmin() {
+	studentID := "12345"
+	ctx := database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+
+	_, err := s.service.ListLockouts(ctx, nil, false)
+	s.ErrorIs(err, timelogErrors.ErrNotAuthorized)
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestClearLockout() {
+	lockout, err := aggregate.NewClockInLockout(12345, 1, 5, s.now.Add(-time.Minute))
+	s.Require().NoError(err)
+	s.lockoutRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.ClockInLockout, error) {
+		return lockout, nil
+	}
+	s.lockoutRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, l *aggregate.ClockInLockout) (*aggregate.ClockInLockout, error) {
+		return l, nil
+	}
+
+	result, err := s.service.ClearLockout(s.adminCtx, lockout.ID)
+
+	s.Require().NoError(err)
+	s.NotNil(result.ClearedAt)
+	s.NotNil(result.ClearedBy)
+	s.False(result.IsActive(s.now))
+}
+
+func (s *ClockInLockoutServiceTestSuite) TestClearLockout_NotFound() {
+	s.lockoutRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.ClockInLockout, error) {
+		return nil, timelogErrors.ErrLockoutNotFound
+	}
+
+	_, err := s.service.ClearLockout(s.adminCtx, uuid.New())
+	s.ErrorIs(err, timelogErrors.ErrLockoutNotFound)
+}
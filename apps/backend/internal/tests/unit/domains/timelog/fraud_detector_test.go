@@ -0,0 +1,160 @@
+package timelog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudDetectorTestSuite struct {
+	suite.Suite
+	detector service.FraudDetector
+	now      time.Time
+}
+
+func TestFraudDetectorTestSuite(t *testing.T) {
+	suite.Run(t, new(FraudDetectorTestSuite))
+}
+
+func (s *FraudDetectorTestSuite) SetupTest() {
+	s.detector = service.DefaultFraudDetector()
+	s.now = time.Date(2026, 3, 18, 14, 0, 0, 0, time.UTC)
+}
+
+func (s *FraudDetectorTestSuite) clockIn(lat, lon float64, at time.Time) *aggregate.TimeLog {
+	return &aggregate.TimeLog{ID: uuid.New(), StudentID: 12345, Latitude: lat, Longitude: lon, EntryAt: at}
+}
+
+func codes(findings []service.FraudFinding) []aggregate.FlagCode {
+	out := make([]aggregate.FlagCode, len(findings))
+	for i, f := range findings {
+		out[i] = f.Code
+	}
+	return out
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_Clean() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+	accuracy := 8.0
+	tl.GPSAccuracyMeters = &accuracy
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{
+		RecentLogs: []*aggregate.TimeLog{
+			s.clockIn(10.642711, -61.277010, s.now.Add(-24*time.Hour)),
+			s.clockIn(10.642690, -61.276995, s.now.Add(-48*time.Hour)),
+		},
+	})
+
+	s.Empty(findings)
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_SharedDevice() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{SharedDeviceStudents: []int32{22222, 33333}})
+
+	s.Require().Len(findings, 1)
+	s.Equal(aggregate.FlagCode_SharedDevice, findings[0].Code)
+	s.Contains(findings[0].Reason, "22222, 33333")
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_ImpossibleTravel() {
+	// Port of Spain to San Fernando (~45km) in ten minutes.
+	tl := s.clockIn(10.2795, -61.4588, s.now)
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{
+		RecentLogs: []*aggregate.TimeLog{s.clockIn(10.6549, -61.5019, s.now.Add(-10*time.Minute))},
+	})
+
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_ImpossibleCoordinates}, codes(findings))
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_PlausibleTravel() {
+	tl := s.clockIn(10.2795, -61.4588, s.now)
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{
+		RecentLogs: []*aggregate.TimeLog{s.clockIn(10.6549, -61.5019, s.now.Add(-2*time.Hour))},
+	})
+
+	s.Empty(findings)
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_ZeroAccuracy() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+	accuracy := 0.0
+	tl.GPSAccuracyMeters = &accuracy
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{})
+
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_ZeroAccuracy}, codes(findings))
+}
+
+func (s *FraudDetectorTestSuite) repeatedClockIns(n int) []*aggregate.TimeLog {
+	recent := make([]*aggregate.TimeLog, n)
+	for i := range recent {
+		recent[i] = s.clockIn(10.642707, -61.277001, s.now.Add(-time.Duration(i+1)*24*time.Hour))
+	}
+	return recent
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_RepeatedCoordinates() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+
+	// A phone at a fixed desk can repeat a cached fix a few times.
+	findings := s.detector.Detect(tl, service.ClockInHistory{RecentLogs: s.repeatedClockIns(4)})
+	s.Empty(findings)
+
+	findings = s.detector.Detect(tl, service.ClockInHistory{RecentLogs: s.repeatedClockIns(5)})
+	s.Require().Len(findings, 1)
+	s.Equal(aggregate.FlagCode_RepeatedCoordinates, findings[0].Code)
+	s.Contains(findings[0].Reason, "5 previous")
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_InvalidCoordinates() {
+	for _, c := range []struct{ lat, lon float64 }{
+		{0, 0},
+		{91, -61.277001},
+		{10.642707, -181},
+	} {
+		tl := s.clockIn(c.lat, c.lon, s.now)
+
+		findings := s.detector.Detect(tl, service.ClockInHistory{
+			RecentLogs: []*aggregate.TimeLog{s.clockIn(10.642707, -61.277001, s.now.Add(-10*time.Minute))},
+		})
+
+		s.Require().Len(findings, 1, "%g, %g", c.lat, c.lon)
+		s.Equal(aggregate.FlagCode_ImpossibleCoordinates, findings[0].Code)
+		s.Contains(findings[0].Reason, "not a real location")
+	}
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_NullIslandIsNoTravelBaseline() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{
+		RecentLogs: []*aggregate.TimeLog{s.clockIn(0, 0, s.now.Add(-10*time.Minute))},
+	})
+
+	s.Empty(findings)
+}
+
+func (s *FraudDetectorTestSuite) TestDetect_MultipleFindingsInOrder() {
+	tl := s.clockIn(10.642707, -61.277001, s.now)
+	accuracy := 0.0
+	tl.GPSAccuracyMeters = &accuracy
+
+	findings := s.detector.Detect(tl, service.ClockInHistory{
+		SharedDeviceStudents: []int32{22222},
+		RecentLogs:           s.repeatedClockIns(5),
+	})
+
+	s.Equal([]aggregate.FlagCode{
+		aggregate.FlagCode_SharedDevice,
+		aggregate.FlagCode_ZeroAccuracy,
+		aggregate.FlagCode_RepeatedCoordinates,
+	}, codes(findings))
+}
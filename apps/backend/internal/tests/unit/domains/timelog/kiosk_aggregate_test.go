@@ -0,0 +1,129 @@
+package timelog_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type KioskAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestKioskAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(KioskAggregateTestSuite))
+}
+
+func (s *KioskAggregateTestSuite) newKiosk() *aggregate.Kiosk {
+	k, _, err := aggregate.NewKiosk("Library", 30, uuid.New())
+	s.Require().NoError(err)
+	return k
+}
+
+// --- NewKiosk ---
+
+func (s *KioskAggregateTestSuite) TestNewKiosk_Success() {
+	createdBy := uuid.New()
+	k, token, err := aggregate.NewKiosk("  Main Desk  ", 0, createdBy)
+
+	s.Require().NoError(err)
+	s.Equal("Main Desk", k.Name)
+	s.Equal(int32(aggregate.DefaultKioskRotationSeconds), k.RotationSeconds)
+	s.True(k.IsActive)
+	s.Equal(createdBy, k.CreatedBy)
+	s.Len(k.Secret, 40)
+	s.NotEmpty(token)
+	s.Equal(aggregate.HashKioskToken(token), k.TokenHash)
+	s.NotEqual(token, k.TokenHash)
+}
+
+func (s *KioskAggregateTestSuite) TestNewKiosk_Invalid() {
+	tests := []struct {
+		name     string
+		kiosk    string
+		rotation int
+		wantErr  error
+	}{
+		{"blank name", "  ", 30, timelogErrors.ErrInvalidKioskName},
+		{"long name", strings.Repeat("a", 101), 30, timelogErrors.ErrInvalidKioskName},
+		{"rotation too short", "Desk", 5, timelogErrors.ErrInvalidKioskRotation},
+		{"rotation too long", "Desk", 301, timelogErrors.ErrInvalidKioskRotation},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			k, token, err := aggregate.NewKiosk(tt.kiosk, tt.rotation, uuid.New())
+			s.ErrorIs(err, tt.wantErr)
+			s.Nil(k)
+			s.Empty(token)
+		})
+	}
+}
+
+// --- Codes ---
+
+func (s *KioskAggregateTestSuite) TestCodeAt_StableWithinWindow() {
+	k := s.newKiosk()
+	base := time.Unix(1_700_000_010, 0).UTC() // start of a 30s window
+
+	c1, exp1, err := k.CodeAt(base)
+	s.Require().NoError(err)
+	c2, exp2, err := k.CodeAt(base.Add(15 * time.Second))
+	s.Require().NoError(err)
+
+	s.Len(c1, 8)
+	s.Equal(c1, c2)
+	s.Equal(exp1, exp2)
+	s.Equal(base.Add(30*time.Second), exp1)
+}
+
+func (s *KioskAggregateTestSuite) TestCodeAt_RotatesAcrossWindows() {
+	k := s.newKiosk()
+	base := time.Unix(1_700_000_010, 0).UTC()
+
+	c1, _, _ := k.CodeAt(base)
+	c2, _, _ := k.CodeAt(base.Add(30 * time.Second))
+
+	s.NotEqual(c1, c2)
+}
+
+func (s *KioskAggregateTestSuite) TestCodeAt_DiffersBetweenKiosks() {
+	now := time.Unix(1_700_000_010, 0).UTC()
+	c1, _, _ := s.newKiosk().CodeAt(now)
+	c2, _, _ := s.newKiosk().CodeAt(now)
+
+	s.NotEqual(c1, c2)
+}
+
+func (s *KioskAggregateTestSuite) TestMatches_CurrentAndPreviousWindow() {
+	k := s.newKiosk()
+	now := time.Unix(1_700_000_010, 0).UTC()
+
+	current, _, _ := k.CodeAt(now)
+	previous, _, _ := k.CodeAt(now.Add(-30 * time.Second))
+	older, _, _ := k.CodeAt(now.Add(-60 * time.Second))
+	next, _, _ := k.CodeAt(now.Add(30 * time.Second))
+
+	s.True(k.Matches(current, now))
+	s.True(k.Matches(strings.ToLower(current), now))
+	s.True(k.Matches(previous, now))
+	s.False(k.Matches(older, now))
+	s.False(k.Matches(next, now))
+	s.False(k.Matches("", now))
+}
+
+func (s *KioskAggregateTestSuite) TestMatches_InactiveKiosk() {
+	k := s.newKiosk()
+	now := time.Unix(1_700_000_010, 0).UTC()
+	code, _, _ := k.CodeAt(now)
+
+	s.Require().NoError(k.Deactivate())
+
+	s.False(k.Matches(code, now))
+	s.ErrorIs(k.Deactivate(), timelogErrors.ErrKioskInactive)
+}
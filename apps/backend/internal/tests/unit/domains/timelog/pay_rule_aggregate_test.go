@@ -0,0 +1,155 @@
+package timelog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PayRuleAggregateTestSuite struct {
+	suite.Suite
+	start time.Time
+	end   time.Time
+}
+
+func TestPayRuleAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(PayRuleAggregateTestSuite))
+}
+
+func (s *PayRuleAggregateTestSuite) SetupTest() {
+	s.start = time.Date(2026, 3, 24, 13, 0, 0, 0, time.UTC)
+	s.end = time.Date(2026, 3, 24, 17, 0, 0, 0, time.UTC)
+}
+
+func (s *PayRuleAggregateTestSuite) rule(params aggregate.PayRuleParams) *aggregate.PayRule {
+	if params.Name == "" {
+		params.Name = "Test rule"
+	}
+	r, err := aggregate.NewPayRule(params)
+	s.Require().NoError(err)
+	return r
+}
+
+func (s *PayRuleAggregateTestSuite) at(offset time.Duration) time.Time {
+	return s.start.Add(offset)
+}
+
+func (s *PayRuleAggregateTestSuite) TestNewPayRule_Validation() {
+	tests := []struct {
+		name   string
+		params aggregate.PayRuleParams
+		err    error
+	}{
+		{"blank name", aggregate.PayRuleParams{Name: "  "}, timelogErrors.ErrInvalidPayRuleName},
+		{"rounding too large", aggregate.PayRuleParams{Name: "r", RoundToMinutes: 61}, timelogErrors.ErrInvalidRounding},
+		{"negative early window", aggregate.PayRuleParams{Name: "r", EarlyClockInMinutes: -1}, timelogErrors.ErrInvalidEarlyWindow},
+		{"grace too large", aggregate.PayRuleParams{Name: "r", LateGraceMinutes: 90}, timelogErrors.ErrInvalidLateGrace},
+		{"inverted term", aggregate.PayRuleParams{
+			Name:          "r",
+			EffectiveFrom: dateOf(2026, 5, 1),
+			EffectiveTo:   dateOf(2026, 1, 1),
+		}, timelogErrors.ErrInvalidEffectiveRange},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := aggregate.NewPayRule(tt.params)
+			s.ErrorIs(err, tt.err)
+		})
+	}
+}
+
+func (s *PayRuleAggregateTestSuite) TestDeactivate() {
+	r := s.rule(aggregate.PayRuleParams{})
+	s.Require().NoError(r.Deactivate())
+	s.False(r.IsActive)
+	s.ErrorIs(r.Deactivate(), timelogErrors.ErrPayRuleInactive)
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_DefaultIsRawLessBreaks() {
+	b := aggregate.NewTimeLogBreak(uuid.New(), s.at(time.Hour))
+	s.Require().NoError(b.End(s.at(time.Hour + 20*time.Minute)))
+
+	paid := aggregate.DefaultPayRule().PaidMinutes(s.at(-7*time.Minute), s.at(4*time.Hour+3*time.Minute), &s.start, &s.end, []*aggregate.TimeLogBreak{b})
+
+	s.Equal(230.0, paid)
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_EarlyArrival() {
+	entry, exit := s.at(-10*time.Minute), s.end
+
+	s.Equal(250.0, s.rule(aggregate.PayRuleParams{}).PaidMinutes(entry, exit, &s.start, &s.end, nil))
+	s.Equal(240.0, s.rule(aggregate.PayRuleParams{PayFromScheduledStart: true}).PaidMinutes(entry, exit, &s.start, &s.end, nil))
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_LateGrace() {
+	r := s.rule(aggregate.PayRuleParams{LateGraceMinutes: 5})
+
+	s.Equal(240.0, r.PaidMinutes(s.at(4*time.Minute), s.end, &s.start, &s.end, nil), "within grace")
+	s.Equal(232.0, r.PaidMinutes(s.at(8*time.Minute), s.end, &s.start, &s.end, nil), "past grace")
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_CapAtScheduledEnd() {
+	exit := s.end.Add(25 * time.Minute)
+
+	s.Equal(265.0, s.rule(aggregate.PayRuleParams{}).PaidMinutes(s.start, exit, &s.start, &s.end, nil))
+	s.Equal(240.0, s.rule(aggregate.PayRuleParams{CapAtScheduledEnd: true}).PaidMinutes(s.start, exit, &s.start, &s.end, nil))
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_BreakOutsidePaidWindowIsIgnored() {
+	r := s.rule(aggregate.PayRuleParams{CapAtScheduledEnd: true})
+	b := aggregate.NewTimeLogBreak(uuid.New(), s.end.Add(5*time.Minute))
+	s.Require().NoError(b.End(s.end.Add(20 * time.Minute)))
+
+	s.Equal(240.0, r.PaidMinutes(s.start, s.end.Add(30*time.Minute), &s.start, &s.end, []*aggregate.TimeLogBreak{b}))
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_Rounding() {
+	r := s.rule(aggregate.PayRuleParams{RoundToMinutes: 15})
+
+	s.Equal(240.0, r.PaidMinutes(s.start, s.at(247*time.Minute), nil, nil, nil))
+	s.Equal(255.0, r.PaidMinutes(s.start, s.at(248*time.Minute), nil, nil, nil))
+}
+
+func (s *PayRuleAggregateTestSuite) TestPaidMinutes_WithoutScheduleOnlyRoundsAndSubtractsBreaks() {
+	r := s.rule(aggregate.PayRuleParams{PayFromScheduledStart: true, LateGraceMinutes: 10, CapAtScheduledEnd: true})
+
+	s.Equal(61.0, r.PaidMinutes(s.start, s.at(61*time.Minute), nil, nil, nil))
+}
+
+func (s *PayRuleAggregateTestSuite) TestSelectPayRule() {
+	desk := uuid.New()
+	other := uuid.New()
+	day := time.Date(2026, 3, 24, 9, 0, 0, 0, time.UTC)
+
+	global := s.rule(aggregate.PayRuleParams{Name: "Global"})
+	term := s.rule(aggregate.PayRuleParams{
+		Name:          "Semester 2",
+		EffectiveFrom: dateOf(2026, 1, 12),
+		EffectiveTo:   dateOf(2026, 5, 8),
+	})
+	deskRule := s.rule(aggregate.PayRuleParams{Name: "Desk", LocationID: &desk})
+	expired := s.rule(aggregate.PayRuleParams{
+		Name:        "Old desk",
+		LocationID:  &desk,
+		EffectiveTo: dateOf(2025, 12, 20),
+	})
+	rules := []*aggregate.PayRule{global, term, deskRule, expired}
+
+	s.Equal(deskRule, aggregate.SelectPayRule(rules, &desk, day), "location rule beats global rules")
+	s.Equal(term, aggregate.SelectPayRule(rules, &other, day), "term rule beats open-ended rule")
+	s.Equal(term, aggregate.SelectPayRule(rules, nil, day))
+	s.Equal(global, aggregate.SelectPayRule(rules, nil, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)), "outside the term")
+
+	s.Require().NoError(deskRule.Deactivate())
+	s.Equal(term, aggregate.SelectPayRule(rules, &desk, day), "inactive rules are skipped")
+	s.Nil(aggregate.SelectPayRule(nil, &desk, day))
+}
+
+func dateOf(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
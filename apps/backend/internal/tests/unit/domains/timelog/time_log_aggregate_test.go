@@ -1,8 +1,10 @@
 package timelog_test
 
 import (
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
 	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
@@ -179,6 +181,66 @@ func (s *TimeLogAggregateTestSuite) TestFlag_OverwritesPreviousFlag() {
 	s.Equal("second reason", *tl.FlagReason)
 }
 
+func (s *TimeLogAggregateTestSuite) TestFlag_SetsManualCode() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+	s.NoError(tl.AddFlag(aggregate.FlagCode_OutsideGeofence, "too far"))
+
+	s.NoError(tl.Flag("checked by admin"))
+
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_Manual}, tl.FlagCodes)
+}
+
+// --- AddFlag ---
+
+func (s *TimeLogAggregateTestSuite) TestAddFlag_AppendsReasonsAndCodes() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+
+	s.NoError(tl.AddFlag(aggregate.FlagCode_OutsideGeofence, "too far"))
+	s.NoError(tl.AddFlag(aggregate.FlagCode_SharedDevice, "shared phone"))
+	s.NoError(tl.AddFlag(aggregate.FlagCode_SharedDevice, "shared again"))
+
+	s.True(tl.IsFlagged)
+	s.Equal("too far; shared phone; shared again", *tl.FlagReason)
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_OutsideGeofence, aggregate.FlagCode_SharedDevice}, tl.FlagCodes)
+}
+
+func (s *TimeLogAggregateTestSuite) TestAddFlag_Invalid() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+
+	s.ErrorIs(tl.AddFlag("", "reason"), timelogErrors.ErrInvalidFlagReason)
+	s.ErrorIs(tl.AddFlag(aggregate.FlagCode_ZeroAccuracy, ""), timelogErrors.ErrInvalidFlagReason)
+	s.False(tl.IsFlagged)
+	s.Empty(tl.FlagCodes)
+}
+
+// --- RecordDevice ---
+
+func (s *TimeLogAggregateTestSuite) TestRecordDevice() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+	accuracy := 12.5
+
+	tl.RecordDevice("  fp-1  ", "203.0.113.7", strings.Repeat("é", 600), &accuracy)
+
+	s.Require().NotNil(tl.DeviceFingerprint)
+	s.Equal("fp-1", *tl.DeviceFingerprint)
+	s.Require().NotNil(tl.IPAddress)
+	s.Equal("203.0.113.7", *tl.IPAddress)
+	s.Require().NotNil(tl.UserAgent)
+	s.Equal(512, utf8.RuneCountInString(*tl.UserAgent))
+	s.Equal(&accuracy, tl.GPSAccuracyMeters)
+}
+
+func (s *TimeLogAggregateTestSuite) TestRecordDevice_Blank() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+
+	tl.RecordDevice(" ", "", "", nil)
+
+	s.Nil(tl.DeviceFingerprint)
+	s.Nil(tl.IPAddress)
+	s.Nil(tl.UserAgent)
+	s.Nil(tl.GPSAccuracyMeters)
+}
+
 // --- Unflag ---
 
 func (s *TimeLogAggregateTestSuite) TestUnflag_Success() {
@@ -189,6 +251,7 @@ func (s *TimeLogAggregateTestSuite) TestUnflag_Success() {
 
 	s.False(tl.IsFlagged)
 	s.Nil(tl.FlagReason)
+	s.Empty(tl.FlagCodes)
 }
 
 func (s *TimeLogAggregateTestSuite) TestUnflag_WhenNotFlagged() {
@@ -222,6 +285,26 @@ func (s *TimeLogAggregateTestSuite) TestModelRoundTrip() {
 	s.Equal(tl.IsFlagged, restored.IsFlagged)
 	s.Require().NotNil(restored.FlagReason)
 	s.Equal(*tl.FlagReason, *restored.FlagReason)
+	s.Equal(tl.FlagCodes, restored.FlagCodes)
+}
+
+func (s *TimeLogAggregateTestSuite) TestModelRoundTrip_FraudSignals() {
+	tl, _ := aggregate.NewTimeLog(1, -61.5, 10.5, 50.0)
+	accuracy := 0.0
+	tl.RecordDevice("fp-1", "203.0.113.7", "Mozilla/5.0", &accuracy)
+	s.NoError(tl.AddFlag(aggregate.FlagCode_SharedDevice, "shared"))
+	s.NoError(tl.AddFlag(aggregate.FlagCode_ZeroAccuracy, "zero"))
+
+	m := tl.ToModel()
+	s.Require().NotNil(m.FlagCodes)
+	s.Equal("shared_device,zero_accuracy", *m.FlagCodes)
+
+	restored := aggregate.TimeLogFromModel(m)
+	s.Equal(tl.FlagCodes, restored.FlagCodes)
+	s.Equal(tl.DeviceFingerprint, restored.DeviceFingerprint)
+	s.Equal(tl.IPAddress, restored.IPAddress)
+	s.Equal(tl.UserAgent, restored.UserAgent)
+	s.Equal(tl.GPSAccuracyMeters, restored.GPSAccuracyMeters)
 }
 
 func (s *TimeLogAggregateTestSuite) TestModelRoundTrip_NilFields() {
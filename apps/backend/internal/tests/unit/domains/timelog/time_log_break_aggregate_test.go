@@ -0,0 +1,101 @@
+package timelog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeLogBreakAggregateTestSuite struct {
+	suite.Suite
+	entry time.Time
+}
+
+func TestTimeLogBreakAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeLogBreakAggregateTestSuite))
+}
+
+func (s *TimeLogBreakAggregateTestSuite) SetupTest() {
+	s.entry = time.Date(2026, 3, 24, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *TimeLogBreakAggregateTestSuite) closedBreak(startOffset, length time.Duration) *aggregate.TimeLogBreak {
+	b := aggregate.NewTimeLogBreak(uuid.New(), s.entry.Add(startOffset))
+	s.Require().NoError(b.End(s.entry.Add(startOffset + length)))
+	return b
+}
+
+func (s *TimeLogBreakAggregateTestSuite) TestEnd() {
+	b := aggregate.NewTimeLogBreak(uuid.New(), s.entry)
+	s.True(b.IsOpen())
+	s.Zero(b.Duration())
+
+	s.Require().NoError(b.End(s.entry.Add(20 * time.Minute)))
+	s.False(b.IsOpen())
+	s.Equal(20*time.Minute, b.Duration())
+	s.ErrorIs(b.End(s.entry.Add(time.Hour)), timelogErrors.ErrBreakAlreadyEnded)
+}
+
+func (s *TimeLogBreakAggregateTestSuite) TestEnd_BeforeStartClamps() {
+	b := aggregate.NewTimeLogBreak(uuid.New(), s.entry)
+	s.Require().NoError(b.End(s.entry.Add(-time.Second)))
+	s.Zero(b.Duration())
+}
+
+func (s *TimeLogBreakAggregateTestSuite) TestNewBreakPolicy() {
+	p, err := aggregate.NewBreakPolicy(4*time.Hour, 0)
+	s.Require().NoError(err)
+	s.True(p.Enabled())
+	s.Equal(aggregate.DefaultMinBreak, p.MinBreak)
+
+	_, err = aggregate.NewBreakPolicy(-time.Hour, 0)
+	s.ErrorIs(err, timelogErrors.ErrInvalidBreakPolicy)
+
+	disabled, err := aggregate.NewBreakPolicy(0, 0)
+	s.Require().NoError(err)
+	s.False(disabled.Enabled())
+}
+
+func (s *TimeLogBreakAggregateTestSuite) TestLongestContinuousWork() {
+	p := aggregate.BreakPolicy{RequiredAfter: 4 * time.Hour, MinBreak: 30 * time.Minute}
+	exit := s.entry.Add(6 * time.Hour)
+
+	tests := []struct {
+		name   string
+		breaks []*aggregate.TimeLogBreak
+		want   time.Duration
+	}{
+		{"no breaks", nil, 6 * time.Hour},
+		{"qualifying break splits shift", []*aggregate.TimeLogBreak{s.closedBreak(3*time.Hour, 30*time.Minute)}, 3 * time.Hour},
+		{"short break does not split", []*aggregate.TimeLogBreak{s.closedBreak(3*time.Hour, 10*time.Minute)}, 6 * time.Hour},
+		{"open break ignored", []*aggregate.TimeLogBreak{aggregate.NewTimeLogBreak(uuid.New(), s.entry.Add(time.Hour))}, 6 * time.Hour},
+		{"unsorted breaks", []*aggregate.TimeLogBreak{
+			s.closedBreak(4*time.Hour, 30*time.Minute),
+			s.closedBreak(time.Hour, 30*time.Minute),
+		}, 2*time.Hour + 30*time.Minute},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.want, p.LongestContinuousWork(s.entry, exit, tt.breaks))
+		})
+	}
+}
+
+func (s *TimeLogBreakAggregateTestSuite) TestViolation() {
+	p := aggregate.BreakPolicy{RequiredAfter: 4 * time.Hour, MinBreak: 30 * time.Minute}
+
+	reason, violated := p.Violation(s.entry, s.entry.Add(5*time.Hour), nil)
+	s.True(violated)
+	s.Equal("Worked 5h without a 30m break (required after 4h)", reason)
+
+	_, violated = p.Violation(s.entry, s.entry.Add(5*time.Hour), []*aggregate.TimeLogBreak{s.closedBreak(2*time.Hour, 45*time.Minute)})
+	s.False(violated)
+
+	_, violated = aggregate.BreakPolicy{}.Violation(s.entry, s.entry.Add(12*time.Hour), nil)
+	s.False(violated)
+}
@@ -116,6 +116,47 @@ func (s *TimeLogHandlerTestSuite) TestClockIn_Success() {
 	s.Equal(float64(15.3), resp["distance_meters"])
 }
 
+func (s *TimeLogHandlerTestSuite) TestClockIn_PassesDeviceSignals() {
+	s.mockSvc.ClockInFn = func(_ context.Context, input service.ClockInInput) (*aggregate.TimeLog, error) {
+		s.Equal("fp-1", input.DeviceFingerprint)
+		s.Equal("192.0.2.1", input.IPAddress) // httptest's RemoteAddr without the port
+		s.Equal("TestAgent/1.0", input.UserAgent)
+		s.Require().NotNil(input.GPSAccuracyMeters)
+		s.Equal(8.5, *input.GPSAccuracyMeters)
+		tl := sampleTimeLog()
+		s.Require().NoError(tl.AddFlag(aggregate.FlagCode_SharedDevice, "shared"))
+		return tl, nil
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/time-logs/clock-in", strings.NewReader(`{
+		"code": "A1B2C3D4",
+		"longitude": -61.277001,
+		"latitude": 10.642707,
+		"device_fingerprint": "fp-1",
+		"accuracy_meters": 8.5
+	}`))
+	req.Header.Set("User-Agent", "TestAgent/1.0")
+	req = req.WithContext(database.WithAuthContext(req.Context(), *studentContext()))
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal([]any{"shared_device"}, resp["flag_codes"])
+}
+
+func (s *TimeLogHandlerTestSuite) TestClockIn_FingerprintTooLong() {
+	rr := s.doRequest("POST", "/api/v1/time-logs/clock-in", `{
+		"code": "A1B2C3D4",
+		"longitude": -61.277001,
+		"latitude": 10.642707,
+		"device_fingerprint": "`+strings.Repeat("a", 129)+`"
+	}`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
 func (s *TimeLogHandlerTestSuite) TestClockIn_InvalidBody() {
 	rr := s.doRequest("POST", "/api/v1/time-logs/clock-in", `not json`)
 
@@ -230,6 +271,67 @@ func (s *TimeLogHandlerTestSuite) TestClockOut_NotClockedIn() {
 	s.Equal("no open time log found", resp["error"])
 }
 
+// --- Breaks ---
+
+func (s *TimeLogHandlerTestSuite) TestStartBreak_Success() {
+	start := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+	s.mockSvc.StartBreakFn = func(_ context.Context) (*aggregate.TimeLogBreak, error) {
+		return aggregate.NewTimeLogBreak(uuid.New(), start), nil
+	}
+
+	rr := s.doRequest("POST", "/api/v1/time-logs/breaks/start", "")
+
+	s.Equal(http.StatusCreated, rr.Code)
+
+	var resp map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Nil(resp["end_at"])
+	s.Equal(start.Format(time.RFC3339), resp["start_at"])
+}
+
+func (s *TimeLogHandlerTestSuite) TestStartBreak_AlreadyOnBreak() {
+	s.mockSvc.StartBreakFn = func(_ context.Context) (*aggregate.TimeLogBreak, error) {
+		return nil, timelogErrors.ErrBreakInProgress
+	}
+
+	rr := s.doRequest("POST", "/api/v1/time-logs/breaks/start", "")
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *TimeLogHandlerTestSuite) TestEndBreak_NotOnBreak() {
+	s.mockSvc.EndBreakFn = func(_ context.Context) (*aggregate.TimeLogBreak, error) {
+		return nil, timelogErrors.ErrNotOnBreak
+	}
+
+	rr := s.doRequest("POST", "/api/v1/time-logs/breaks/end", "")
+
+	s.Equal(http.StatusNotFound, rr.Code)
+
+	var resp map[string]string
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("no break in progress", resp["error"])
+}
+
+func (s *TimeLogHandlerTestSuite) TestListTimeLogBreaks_Success() {
+	id := uuid.New()
+	b := aggregate.NewTimeLogBreak(id, time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC))
+	s.Require().NoError(b.End(b.StartAt.Add(30 * time.Minute)))
+	s.mockSvc.ListTimeLogBreaksFn = func(_ context.Context, got uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+		s.Equal(id, got)
+		return []*aggregate.TimeLogBreak{b}, nil
+	}
+
+	rr := s.doRequestAs("GET", "/api/v1/time-logs/"+id.String()+"/breaks", "", adminContext())
+
+	s.Equal(http.StatusOK, rr.Code)
+
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Require().Len(resp, 1)
+	s.Equal(float64(30), resp[0]["duration_minutes"])
+}
+
 // --- GetMyStatus ---
 
 func (s *TimeLogHandlerTestSuite) TestGetMyStatus_ClockedIn() {
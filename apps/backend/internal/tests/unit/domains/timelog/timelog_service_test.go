@@ -12,6 +12,8 @@ import (
 	timelogErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/errors"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/timelog/service"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
 	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
 	"github.com/google/uuid"
@@ -23,7 +25,13 @@ type TimeLogServiceTestSuite struct {
 	suite.Suite
 	timeLogRepo     *mocks.MockTimeLogRepository
 	clockInCodeRepo *mocks.MockClockInCodeRepository
+	kioskRepo       *mocks.MockKioskRepository
 	scheduleRepo    *mocks.MockScheduleRepository
+	locationRepo    *mocks.MockLocationRepository
+	lockoutSvc      *mocks.MockClockInLockoutService
+	breakRepo       *mocks.MockTimeLogBreakRepository
+	payRuleRepo     *mocks.MockPayRuleRepository
+	timeOffRepo     *mocks.MockTimeOffRequestRepository
 	service         service.TimeLogServiceInterface
 	studentCtx      context.Context
 	adminCtx        context.Context
@@ -34,16 +42,70 @@ func TestTimeLogServiceTestSuite(t *testing.T) {
 }
 
 func (s *TimeLogServiceTestSuite) SetupTest() {
-	s.timeLogRepo = &mocks.MockTimeLogRepository{}
+	s.timeLogRepo = &mocks.MockTimeLogRepository{
+		ListRecentByStudentIDFn: func(_ context.Context, _ *sql.Tx, _ int32, _ int) ([]*aggregate.TimeLog, error) {
+			return nil, nil
+		},
+		ListStudentIDsByDeviceFn: func(_ context.Context, _ *sql.Tx, _ string, _ time.Time, _ int32) ([]int32, error) {
+			return nil, nil
+		},
+	}
 	s.clockInCodeRepo = &mocks.MockClockInCodeRepository{}
+	s.kioskRepo = &mocks.MockKioskRepository{
+		ListActiveFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.Kiosk, error) {
+			return nil, nil
+		},
+	}
 	s.scheduleRepo = &mocks.MockScheduleRepository{}
+	s.locationRepo = &mocks.MockLocationRepository{
+		ListByShiftTemplateIDsFn: func(_ context.Context, _ *sql.Tx, _ []uuid.UUID) (map[uuid.UUID]*scheduleAggregate.Location, error) {
+			return map[uuid.UUID]*scheduleAggregate.Location{}, nil
+		},
+	}
+	s.lockoutSvc = &mocks.MockClockInLockoutService{
+		GetActiveLockoutFn: func(_ context.Context, _ int32) (*aggregate.ClockInLockout, error) {
+			return nil, nil
+		},
+		RecordAttemptFn: func(_ context.Context, _ int32, _ string, _ bool) (*aggregate.ClockInLockout, error) {
+			return nil, nil
+		},
+	}
+
+	s.breakRepo = &mocks.MockTimeLogBreakRepository{
+		GetOpenByTimeLogIDFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.TimeLogBreak, error) {
+			return nil, timelogErrors.ErrBreakNotFound
+		},
+		ListByTimeLogIDFn: func(_ context.Context, _ *sql.Tx, _ uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+			return []*aggregate.TimeLogBreak{}, nil
+		},
+	}
+
+	s.payRuleRepo = &mocks.MockPayRuleRepository{
+		ListActiveFn: func(_ context.Context, _ *sql.Tx) ([]*aggregate.PayRule, error) {
+			return nil, nil
+		},
+	}
+
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{
+		ListFn: func(_ context.Context, _ *sql.Tx, _ timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+			return nil, nil
+		},
+	}
 
 	s.service = service.NewTimeLogService(
 		zap.NewNop(),
 		&mocks.StubTxManager{},
 		s.timeLogRepo,
 		s.clockInCodeRepo,
+		s.kioskRepo,
 		s.scheduleRepo,
+		s.locationRepo,
+		s.lockoutSvc,
+		s.breakRepo,
+		s.payRuleRepo,
+		s.timeOffRepo,
+		aggregate.BreakPolicy{},
+		service.DefaultFraudDetector(),
 		-61.277001, // helpDeskLon
 		10.642707,  // helpDeskLat
 	)
@@ -94,6 +156,24 @@ func (s *TimeLogServiceTestSuite) buildAssignments(studentID string, now time.Ti
 	return data
 }
 
+// buildAssignmentsFor creates assignments JSON with a shift for the given
+// student and shift template, with times expressed in the given timezone.
+func (s *TimeLogServiceTestSuite) buildAssignmentsFor(studentID string, shiftID uuid.UUID, now time.Time, tz *time.Location) json.RawMessage {
+	local := now.In(tz)
+	assignments := []map[string]any{
+		{
+			"assistant_id": studentID,
+			"shift_id":     shiftID.String(),
+			"day_of_week":  (int(local.Weekday()) + 6) % 7,
+			"start":        local.Add(-30 * time.Minute).Format("15:04:05"),
+			"end":          local.Add(30 * time.Minute).Format("15:04:05"),
+		},
+	}
+	data, err := json.Marshal(assignments)
+	s.Require().NoError(err, "failed to marshal assignments")
+	return data
+}
+
 func (s *TimeLogServiceTestSuite) activeScheduleWith(assignments json.RawMessage) *scheduleAggregate.Schedule {
 	return &scheduleAggregate.Schedule{
 		ScheduleID:  uuid.New(),
@@ -140,6 +220,197 @@ func (s *TimeLogServiceTestSuite) TestClockIn_Success() {
 	s.Equal(int32(12345), result.StudentID)
 }
 
+func (s *TimeLogServiceTestSuite) TestClockIn_UsesShiftLocationGeofence() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	shiftID := uuid.New()
+	engineering := &scheduleAggregate.Location{
+		ID:           uuid.New(),
+		Name:         "Engineering Desk",
+		Latitude:     10.640000,
+		Longitude:    -61.400000,
+		RadiusMeters: 50,
+		Timezone:     "America/Port_of_Spain",
+		IsActive:     true,
+	}
+	s.locationRepo.ListByShiftTemplateIDsFn = func(_ context.Context, _ *sql.Tx, ids []uuid.UUID) (map[uuid.UUID]*scheduleAggregate.Location, error) {
+		s.Equal([]uuid.UUID{shiftID}, ids)
+		return map[uuid.UUID]*scheduleAggregate.Location{shiftID: engineering}, nil
+	}
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(s.buildAssignmentsFor("12345", shiftID, fixedNow, time.FixedZone("AST", -4*60*60))), nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	// At the engineering desk: within its radius, not flagged.
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -61.400000,
+		Latitude:  10.640100,
+	})
+	s.Require().NoError(err)
+	s.False(result.IsFlagged)
+	s.Require().NotNil(result.LocationID)
+	s.Equal(engineering.ID, *result.LocationID)
+
+	// At the default help desk: far from the scheduled location, flagged.
+	result, err = s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -61.277001,
+		Latitude:  10.642707,
+	})
+	s.Require().NoError(err)
+	s.True(result.IsFlagged)
+	s.Contains(*result.FlagReason, "from Engineering Desk")
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_UsesShiftLocationTimezone() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	shiftID := uuid.New()
+	london := &scheduleAggregate.Location{
+		ID:           uuid.New(),
+		Name:         "London Desk",
+		Latitude:     51.5074,
+		Longitude:    -0.1278,
+		RadiusMeters: 100,
+		Timezone:     "UTC",
+		IsActive:     true,
+	}
+	s.locationRepo.ListByShiftTemplateIDsFn = func(_ context.Context, _ *sql.Tx, _ []uuid.UUID) (map[uuid.UUID]*scheduleAggregate.Location, error) {
+		return map[uuid.UUID]*scheduleAggregate.Location{shiftID: london}, nil
+	}
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	// Shift times are in UTC wall-clock time (09:30-10:30); in AST the
+	// student would be four hours outside the shift.
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(s.buildAssignmentsFor("12345", shiftID, fixedNow, time.UTC)), nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -0.1278,
+		Latitude:  51.5074,
+	})
+
+	s.Require().NoError(err)
+	s.False(result.IsFlagged)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_OutsidePolygonGeofence() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	shiftID := uuid.New()
+	library := &scheduleAggregate.Location{
+		ID:           uuid.New(),
+		Name:         "Library",
+		Latitude:     10.6420,
+		Longitude:    -61.2770,
+		RadiusMeters: 1000, // ignored when a polygon is set
+		Geofence: []scheduleAggregate.GeoPoint{
+			{-61.2775, 10.6415}, {-61.2765, 10.6415}, {-61.2765, 10.6425}, {-61.2775, 10.6425},
+		},
+		Timezone: "America/Port_of_Spain",
+		IsActive: true,
+	}
+	s.locationRepo.ListByShiftTemplateIDsFn = func(_ context.Context, _ *sql.Tx, _ []uuid.UUID) (map[uuid.UUID]*scheduleAggregate.Location, error) {
+		return map[uuid.UUID]*scheduleAggregate.Location{shiftID: library}, nil
+	}
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(s.buildAssignmentsFor("12345", shiftID, fixedNow, time.FixedZone("AST", -4*60*60))), nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	// ~300m east of the centre: inside the radius but outside the polygon.
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -61.2743,
+		Latitude:  10.6420,
+	})
+
+	s.Require().NoError(err)
+	s.True(result.IsFlagged)
+	s.Contains(*result.FlagReason, "outside the Library geofence")
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_OutsideGeofence}, result.FlagCodes)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_RecordsDeviceAndFlagsSharedDevice() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(s.buildAssignments("12345", fixedNow)), nil
+	}
+	s.timeLogRepo.ListStudentIDsByDeviceFn = func(_ context.Context, _ *sql.Tx, fingerprint string, since time.Time, excludeStudentID int32) ([]int32, error) {
+		s.Equal("fp-1", fingerprint)
+		s.Equal(fixedNow.Add(-30*24*time.Hour), since)
+		s.Equal(int32(12345), excludeStudentID)
+		return []int32{22222}, nil
+	}
+	var created *aggregate.TimeLog
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		created = tl
+		return tl, nil
+	}
+	accuracy := 6.0
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:              "A1B2C3D4",
+		Longitude:         -61.277001,
+		Latitude:          10.642707,
+		DeviceFingerprint: "fp-1",
+		IPAddress:         "203.0.113.7",
+		UserAgent:         "Mozilla/5.0",
+		GPSAccuracyMeters: &accuracy,
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(created)
+	s.Equal("fp-1", *created.DeviceFingerprint)
+	s.Equal("203.0.113.7", *created.IPAddress)
+	s.Equal("Mozilla/5.0", *created.UserAgent)
+	s.Equal(6.0, *created.GPSAccuracyMeters)
+	s.True(result.IsFlagged)
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_SharedDevice}, result.FlagCodes)
+	s.Contains(*result.FlagReason, "22222")
+}
+
 func (s *TimeLogServiceTestSuite) TestClockIn_InvalidCode() {
 	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
 		return nil, timelogErrors.ErrInvalidClockInCode
@@ -155,6 +426,119 @@ func (s *TimeLogServiceTestSuite) TestClockIn_InvalidCode() {
 	s.Nil(result)
 }
 
+func (s *TimeLogServiceTestSuite) TestClockIn_InvalidCode_RecordsFailure() {
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return nil, timelogErrors.ErrInvalidClockInCode
+	}
+	var recorded []bool
+	s.lockoutSvc.RecordAttemptFn = func(_ context.Context, studentID int32, code string, succeeded bool) (*aggregate.ClockInLockout, error) {
+		s.Equal(int32(12345), studentID)
+		s.Equal("BADCODE1", code)
+		recorded = append(recorded, succeeded)
+		return nil, nil
+	}
+
+	_, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{Code: "BADCODE1", Longitude: -61.277001, Latitude: 10.642707})
+
+	s.ErrorIs(err, timelogErrors.ErrInvalidClockInCode)
+	s.Equal([]bool{false}, recorded)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_InvalidCode_TriggersLockout() {
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return nil, timelogErrors.ErrInvalidClockInCode
+	}
+	s.lockoutSvc.RecordAttemptFn = func(_ context.Context, studentID int32, _ string, _ bool) (*aggregate.ClockInLockout, error) {
+		return aggregate.NewClockInLockout(studentID, 1, 5, time.Now())
+	}
+
+	_, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{Code: "BADCODE1", Longitude: -61.277001, Latitude: 10.642707})
+
+	s.ErrorIs(err, timelogErrors.ErrClockInLocked)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_LockedOut() {
+	s.lockoutSvc.GetActiveLockoutFn = func(_ context.Context, studentID int32) (*aggregate.ClockInLockout, error) {
+		return aggregate.NewClockInLockout(studentID, 1, 5, time.Now())
+	}
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		s.Fail("code should not be checked while locked out")
+		return nil, nil
+	}
+
+	_, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{Code: "A1B2C3D4", Longitude: -61.277001, Latitude: 10.642707})
+
+	s.ErrorIs(err, timelogErrors.ErrClockInLocked)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_KioskCode() {
+	fixedNow := time.Date(2025, 3, 17, 13, 30, 0, 0, time.UTC) // Monday 09:30 AST
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	kiosk, _, err := aggregate.NewKiosk("Library", 30, uuid.New())
+	s.Require().NoError(err)
+	// Code from the previous window is still accepted.
+	code, _, err := kiosk.CodeAt(fixedNow.Add(-30 * time.Second))
+	s.Require().NoError(err)
+
+	// The kiosk lookup must finish before the auth transaction reads the
+	// manual code, so the two never hold connections at the same time.
+	var calls []string
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		calls = append(calls, "code")
+		return nil, timelogErrors.ErrInvalidClockInCode
+	}
+	s.kioskRepo.ListActiveFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.Kiosk, error) {
+		calls = append(calls, "kiosks")
+		return []*aggregate.Kiosk{kiosk}, nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(s.buildAssignments("12345", fixedNow)), nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      code,
+		Longitude: -61.277001,
+		Latitude:  10.642707,
+	})
+
+	s.Require().NoError(err)
+	s.Equal(int32(12345), result.StudentID)
+	s.Equal([]string{"kiosks", "code"}, calls)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_StaleKioskCode() {
+	fixedNow := time.Date(2025, 3, 17, 13, 30, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	kiosk, _, err := aggregate.NewKiosk("Library", 30, uuid.New())
+	s.Require().NoError(err)
+	code, _, err := kiosk.CodeAt(fixedNow.Add(-61 * time.Second))
+	s.Require().NoError(err)
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return nil, timelogErrors.ErrInvalidClockInCode
+	}
+	s.kioskRepo.ListActiveFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.Kiosk, error) {
+		return []*aggregate.Kiosk{kiosk}, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      code,
+		Longitude: -61.277001,
+		Latitude:  10.642707,
+	})
+
+	s.ErrorIs(err, timelogErrors.ErrInvalidClockInCode)
+	s.Nil(result)
+}
+
 func (s *TimeLogServiceTestSuite) TestClockIn_ExpiredCode() {
 	expired := s.validCode()
 	expired.ExpiresAt = time.Now().UTC().Add(-10 * time.Minute)
@@ -219,6 +603,76 @@ func (s *TimeLogServiceTestSuite) TestClockIn_NoActiveShift() {
 	s.Nil(result)
 }
 
+func (s *TimeLogServiceTestSuite) TestClockIn_OnApprovedTimeOff() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+	schedule := s.activeScheduleWith(s.buildAssignments("12345", fixedNow))
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return schedule, nil
+	}
+	// Approved after the schedule was generated, so the shift is still in it
+	var filter timeoffRepo.TimeOffFilter
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, f timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		filter = f
+		return []*timeoffAggregate.TimeOffRequest{{
+			StudentID: 12345,
+			StartDate: time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC),
+			Status:    timeoffAggregate.Status_Approved,
+		}}, nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, _ *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		s.Fail("no time log should be created on a day of approved time off")
+		return nil, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -61.277001,
+		Latitude:  10.642707,
+	})
+
+	s.ErrorIs(err, timelogErrors.ErrOnApprovedTimeOff)
+	s.Nil(result)
+	s.Require().NotNil(filter.StudentID)
+	s.Equal(int32(12345), *filter.StudentID)
+	s.Equal([]timeoffAggregate.Status{timeoffAggregate.Status_Approved}, filter.Statuses)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockIn_OverrideReplacesWeeklyShift() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+	schedule := s.activeScheduleWith(s.buildAssignments("12345", fixedNow))
+	// The student was left off this Wednesday when the schedule was generated
+	schedule.AssignmentOverrides = json.RawMessage(`[{"from":"2026-03-18","to":"2026-03-18","assignments":[]}]`)
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return schedule, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, service.ClockInInput{
+		Code:      "A1B2C3D4",
+		Longitude: -61.277001,
+		Latitude:  10.642707,
+	})
+
+	s.ErrorIs(err, timelogErrors.ErrNoActiveShift)
+	s.Nil(result)
+}
+
 func (s *TimeLogServiceTestSuite) TestClockIn_5MinEarly_Allowed() {
 	// Use a fixed time to avoid race conditions between test setup and service call
 	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
@@ -309,6 +763,55 @@ func (s *TimeLogServiceTestSuite) TestClockIn_ShiftEnded_Rejected() {
 	s.Nil(result)
 }
 
+func (s *TimeLogServiceTestSuite) TestClockIn_PayRuleEarlyWindow() {
+	fixedNow := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC) // Wednesday
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+	local := fixedNow.In(time.FixedZone("AST", -4*60*60))
+
+	// Shift starts 10 minutes from now, outside the default 5-minute window
+	assignments, _ := json.Marshal([]map[string]any{
+		{
+			"assistant_id": "12345",
+			"shift_id":     uuid.New().String(),
+			"day_of_week":  (int(local.Weekday()) + 6) % 7,
+			"start":        local.Add(10 * time.Minute).Format("15:04:05"),
+			"end":          local.Add(70 * time.Minute).Format("15:04:05"),
+		},
+	})
+
+	s.clockInCodeRepo.GetByCodeFn = func(_ context.Context, _ *sql.Tx, _ string) (*aggregate.ClockInCode, error) {
+		return s.validCode(), nil
+	}
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeScheduleWith(assignments), nil
+	}
+	s.timeLogRepo.CreateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+	input := service.ClockInInput{Code: "A1B2C3D4", Longitude: -61.277001, Latitude: 10.642707}
+
+	_, err := s.service.ClockIn(s.studentCtx, input)
+	s.ErrorIs(err, timelogErrors.ErrNoActiveShift)
+
+	rule, err := aggregate.NewPayRule(aggregate.PayRuleParams{Name: "Semester 2", EarlyClockInMinutes: 15})
+	s.Require().NoError(err)
+	s.payRuleRepo.ListActiveFn = func(_ context.Context, _ *sql.Tx) ([]*aggregate.PayRule, error) {
+		return []*aggregate.PayRule{rule}, nil
+	}
+
+	result, err := s.service.ClockIn(s.studentCtx, input)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(result.PayRuleID)
+	s.Equal(rule.ID, *result.PayRuleID)
+	s.Require().NotNil(result.ScheduledStart)
+	s.Equal(fixedNow.Add(10*time.Minute), *result.ScheduledStart)
+	s.Equal(fixedNow.Add(70*time.Minute), *result.ScheduledEnd)
+}
+
 func (s *TimeLogServiceTestSuite) TestClockIn_MissingAuthContext() {
 	result, err := s.service.ClockIn(context.Background(), service.ClockInInput{
 		Code:      "A1B2C3D4",
@@ -341,6 +844,212 @@ func (s *TimeLogServiceTestSuite) TestClockOut_Success() {
 	s.NotNil(result.ExitAt)
 }
 
+func (s *TimeLogServiceTestSuite) TestClockOut_EndsOpenBreak() {
+	fixedNow := time.Date(2026, 3, 24, 17, 0, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	openLog.EntryAt = fixedNow.Add(-3 * time.Hour)
+	openBreak := aggregate.NewTimeLogBreak(openLog.ID, fixedNow.Add(-10*time.Minute))
+
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.breakRepo.GetOpenByTimeLogIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.TimeLogBreak, error) {
+		return openBreak, nil
+	}
+	var ended *aggregate.TimeLogBreak
+	s.breakRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+		ended = b
+		return b, nil
+	}
+	s.timeLogRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	_, err := s.service.ClockOut(s.studentCtx)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(ended)
+	s.Equal(fixedNow, *ended.EndAt)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockOut_FlagsMissingBreak() {
+	fixedNow := time.Date(2026, 3, 24, 17, 0, 0, 0, time.UTC)
+	policy, err := aggregate.NewBreakPolicy(4*time.Hour, 30*time.Minute)
+	s.Require().NoError(err)
+	svc := service.NewTimeLogService(
+		zap.NewNop(), &mocks.StubTxManager{}, s.timeLogRepo, s.clockInCodeRepo, s.kioskRepo, s.scheduleRepo,
+		s.locationRepo, s.lockoutSvc, s.breakRepo, s.payRuleRepo, s.timeOffRepo, policy, service.DefaultFraudDetector(), -61.277001, 10.642707,
+	)
+	svc.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	openLog.EntryAt = fixedNow.Add(-5 * time.Hour)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	// A 10-minute break is too short to satisfy the rule
+	shortBreak := aggregate.NewTimeLogBreak(openLog.ID, fixedNow.Add(-3*time.Hour))
+	s.Require().NoError(shortBreak.End(fixedNow.Add(-170 * time.Minute)))
+	s.breakRepo.ListByTimeLogIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+		return []*aggregate.TimeLogBreak{shortBreak}, nil
+	}
+	s.timeLogRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	result, err := svc.ClockOut(s.studentCtx)
+
+	s.Require().NoError(err)
+	s.True(result.IsFlagged)
+	s.Require().NotNil(result.FlagReason)
+	s.Contains(*result.FlagReason, "without a 30m break")
+	s.Equal([]aggregate.FlagCode{aggregate.FlagCode_MissedBreak}, result.FlagCodes)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockOut_AppliesPayRule() {
+	fixedNow := time.Date(2026, 3, 24, 17, 7, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	rule, err := aggregate.NewPayRule(aggregate.PayRuleParams{
+		Name:                  "Main desk",
+		RoundToMinutes:        15,
+		EarlyClockInMinutes:   10,
+		PayFromScheduledStart: true,
+		CapAtScheduledEnd:     true,
+	})
+	s.Require().NoError(err)
+
+	scheduledStart := time.Date(2026, 3, 24, 13, 0, 0, 0, time.UTC)
+	scheduledEnd := time.Date(2026, 3, 24, 17, 0, 0, 0, time.UTC)
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	openLog.EntryAt = scheduledStart.Add(-8 * time.Minute)
+	openLog.ScheduledStart = &scheduledStart
+	openLog.ScheduledEnd = &scheduledEnd
+	openLog.PayRuleID = &rule.ID
+
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.payRuleRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.PayRule, error) {
+		s.Equal(rule.ID, id)
+		return rule, nil
+	}
+	lunch := aggregate.NewTimeLogBreak(openLog.ID, scheduledStart.Add(2*time.Hour))
+	s.Require().NoError(lunch.End(scheduledStart.Add(2*time.Hour + 22*time.Minute)))
+	s.breakRepo.ListByTimeLogIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) ([]*aggregate.TimeLogBreak, error) {
+		return []*aggregate.TimeLogBreak{lunch}, nil
+	}
+	s.timeLogRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	result, err := s.service.ClockOut(s.studentCtx)
+
+	s.Require().NoError(err)
+	// Raw is 12:52-17:07; paid is 13:00-17:00 less the 22-minute break, rounded to 15.
+	s.Equal(255.0, *result.RawMinutes())
+	s.Require().NotNil(result.PaidMinutes)
+	s.Equal(225.0, *result.PaidMinutes)
+}
+
+func (s *TimeLogServiceTestSuite) TestClockOut_DefaultPayRule() {
+	fixedNow := time.Date(2026, 3, 24, 17, 0, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	openLog.EntryAt = fixedNow.Add(-2*time.Hour - 7*time.Minute)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.timeLogRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, tl *aggregate.TimeLog) (*aggregate.TimeLog, error) {
+		return tl, nil
+	}
+
+	result, err := s.service.ClockOut(s.studentCtx)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(result.PaidMinutes)
+	s.Equal(127.0, *result.PaidMinutes)
+}
+
+func (s *TimeLogServiceTestSuite) TestStartBreak_Success() {
+	fixedNow := time.Date(2026, 3, 24, 15, 0, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.breakRepo.CreateFn = func(_ context.Context, _ *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+		return b, nil
+	}
+
+	result, err := s.service.StartBreak(s.studentCtx)
+
+	s.Require().NoError(err)
+	s.Equal(openLog.ID, result.TimeLogID)
+	s.Equal(fixedNow, result.StartAt)
+	s.True(result.IsOpen())
+}
+
+func (s *TimeLogServiceTestSuite) TestStartBreak_AlreadyOnBreak() {
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.breakRepo.GetOpenByTimeLogIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.TimeLogBreak, error) {
+		return aggregate.NewTimeLogBreak(id, time.Now()), nil
+	}
+
+	_, err := s.service.StartBreak(s.studentCtx)
+
+	s.ErrorIs(err, timelogErrors.ErrBreakInProgress)
+}
+
+func (s *TimeLogServiceTestSuite) TestStartBreak_NotClockedIn() {
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return nil, timelogErrors.ErrTimeLogNotFound
+	}
+
+	_, err := s.service.StartBreak(s.studentCtx)
+
+	s.ErrorIs(err, timelogErrors.ErrNotClockedIn)
+}
+
+func (s *TimeLogServiceTestSuite) TestEndBreak_Success() {
+	fixedNow := time.Date(2026, 3, 24, 15, 30, 0, 0, time.UTC)
+	s.service.(*service.TimeLogService).WithNowFn(func() time.Time { return fixedNow })
+
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+	s.breakRepo.GetOpenByTimeLogIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.TimeLogBreak, error) {
+		return aggregate.NewTimeLogBreak(id, fixedNow.Add(-30*time.Minute)), nil
+	}
+	s.breakRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, b *aggregate.TimeLogBreak) (*aggregate.TimeLogBreak, error) {
+		return b, nil
+	}
+
+	result, err := s.service.EndBreak(s.studentCtx)
+
+	s.Require().NoError(err)
+	s.Equal(30*time.Minute, result.Duration())
+}
+
+func (s *TimeLogServiceTestSuite) TestEndBreak_NotOnBreak() {
+	openLog, _ := aggregate.NewTimeLog(12345, -61.277, 10.642, 15.0)
+	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
+		return openLog, nil
+	}
+
+	_, err := s.service.EndBreak(s.studentCtx)
+
+	s.ErrorIs(err, timelogErrors.ErrNotOnBreak)
+}
+
 func (s *TimeLogServiceTestSuite) TestClockOut_NotClockedIn() {
 	s.timeLogRepo.GetOpenByStudentIDFn = func(_ context.Context, _ *sql.Tx, _ int32) (*aggregate.TimeLog, error) {
 		return nil, timelogErrors.ErrTimeLogNotFound
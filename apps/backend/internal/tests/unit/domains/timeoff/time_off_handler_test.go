@@ -0,0 +1,81 @@
+package timeoff_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimeOffHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockTimeOffService
+	router  *chi.Mux
+}
+
+func TestTimeOffHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeOffHandlerTestSuite))
+}
+
+func (s *TimeOffHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockTimeOffService{}
+	hdl := handler.NewTimeOffHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *TimeOffHandlerTestSuite) get(path string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+	return rr
+}
+
+func (s *TimeOffHandlerTestSuite) TestListTimeOff_Filters() {
+	s.mockSvc.ListTimeOffFn = func(_ context.Context, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+		s.Require().NotNil(filter.StudentID)
+		s.Equal(int32(816000001), *filter.StudentID)
+		s.Equal([]aggregate.Status{aggregate.Status_Approved}, filter.Statuses)
+		s.Require().NotNil(filter.From)
+		s.Equal("2026-03-02", filter.From.Format("2006-01-02"))
+		s.Require().NotNil(filter.To)
+		s.Equal("2026-03-08", filter.To.Format("2006-01-02"))
+		return []*aggregate.TimeOffRequest{}, nil
+	}
+
+	rr := s.get("/api/v1/time-off?student_id=816000001&status=approved&from=2026-03-02&to=2026-03-08")
+	s.Require().Equal(http.StatusOK, rr.Code, rr.Body.String())
+
+	var resp []map[string]any
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Empty(resp)
+}
+
+func (s *TimeOffHandlerTestSuite) TestListTimeOff_MalformedFilters() {
+	s.mockSvc.ListTimeOffFn = func(context.Context, repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+		s.Fail("service should not be called for a malformed filter")
+		return nil, nil
+	}
+
+	for _, query := range []string{
+		"status=maybe",
+		"student_id=abc",
+		"student_id=99999999999",
+		"from=March+2",
+		"to=2026-13-01",
+	} {
+		rr := s.get("/api/v1/time-off?" + query)
+		s.Equal(http.StatusBadRequest, rr.Code, query)
+	}
+}
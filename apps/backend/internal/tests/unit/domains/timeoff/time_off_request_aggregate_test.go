@@ -0,0 +1,134 @@
+package timeoff_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeOffRequestAggregateTestSuite struct {
+	suite.Suite
+}
+
+func TestTimeOffRequestAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeOffRequestAggregateTestSuite))
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// --- NewTimeOffRequest ---
+
+func (s *TimeOffRequestAggregateTestSuite) TestNewTimeOffRequest_Success() {
+	req, err := aggregate.NewTimeOffRequest(1, time.Date(2025, 9, 1, 15, 30, 0, 0, time.UTC), date(2025, 9, 3), "  Family event  ")
+
+	s.Require().NoError(err)
+	s.NotEqual(uuid.Nil, req.ID)
+	s.Equal(int32(1), req.StudentID)
+	s.Equal(date(2025, 9, 1), req.StartDate)
+	s.Equal(date(2025, 9, 3), req.EndDate)
+	s.Equal("Family event", req.Reason)
+	s.Equal(aggregate.Status_Pending, req.Status)
+	s.Nil(req.ReviewedBy)
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestNewTimeOffRequest_SingleDay() {
+	req, err := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 1), "Doctor")
+
+	s.NoError(err)
+	s.NotNil(req)
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestNewTimeOffRequest_Invalid() {
+	tests := []struct {
+		name      string
+		studentID int32
+		start     time.Time
+		end       time.Time
+		reason    string
+		wantErr   error
+	}{
+		{"zero student", 0, date(2025, 9, 1), date(2025, 9, 2), "x", timeoffErrors.ErrInvalidStudentID},
+		{"end before start", 1, date(2025, 9, 2), date(2025, 9, 1), "x", timeoffErrors.ErrInvalidPeriod},
+		{"blank reason", 1, date(2025, 9, 1), date(2025, 9, 2), "   ", timeoffErrors.ErrInvalidReason},
+		{"reason too long", 1, date(2025, 9, 1), date(2025, 9, 2), strings.Repeat("a", 501), timeoffErrors.ErrReasonTooLong},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req, err := aggregate.NewTimeOffRequest(tt.studentID, tt.start, tt.end, tt.reason)
+			s.ErrorIs(err, tt.wantErr)
+			s.Nil(req)
+		})
+	}
+}
+
+// --- Review ---
+
+func (s *TimeOffRequestAggregateTestSuite) TestApprove_Success() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 2), "Trip")
+	reviewer := uuid.New()
+	note := " enjoy "
+
+	err := req.Approve(reviewer, &note)
+
+	s.Require().NoError(err)
+	s.Equal(aggregate.Status_Approved, req.Status)
+	s.Equal(reviewer, *req.ReviewedBy)
+	s.NotNil(req.ReviewedAt)
+	s.Equal("enjoy", *req.ReviewNote)
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestReject_Success() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 2), "Trip")
+
+	err := req.Reject(uuid.New(), nil)
+
+	s.Require().NoError(err)
+	s.Equal(aggregate.Status_Rejected, req.Status)
+	s.Nil(req.ReviewNote)
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestReview_NotPending() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 2), "Trip")
+	s.Require().NoError(req.Approve(uuid.New(), nil))
+
+	s.ErrorIs(req.Reject(uuid.New(), nil), timeoffErrors.ErrNotPending)
+	s.ErrorIs(req.Approve(uuid.New(), nil), timeoffErrors.ErrNotPending)
+	s.ErrorIs(req.Cancel(), timeoffErrors.ErrNotPending)
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestCancel_Success() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 2), "Trip")
+
+	s.NoError(req.Cancel())
+	s.Equal(aggregate.Status_Cancelled, req.Status)
+	s.False(req.IsActive())
+}
+
+// --- Date helpers ---
+
+func (s *TimeOffRequestAggregateTestSuite) TestCoversDate() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 1), date(2025, 9, 3), "Trip")
+
+	s.False(req.CoversDate(date(2025, 8, 31)))
+	s.True(req.CoversDate(date(2025, 9, 1)))
+	s.True(req.CoversDate(time.Date(2025, 9, 3, 23, 59, 0, 0, time.UTC)))
+	s.False(req.CoversDate(date(2025, 9, 4)))
+}
+
+func (s *TimeOffRequestAggregateTestSuite) TestOverlaps() {
+	req, _ := aggregate.NewTimeOffRequest(1, date(2025, 9, 5), date(2025, 9, 10), "Trip")
+
+	s.True(req.Overlaps(date(2025, 9, 1), date(2025, 9, 5)))
+	s.True(req.Overlaps(date(2025, 9, 10), date(2025, 9, 20)))
+	s.True(req.Overlaps(date(2025, 9, 6), date(2025, 9, 7)))
+	s.False(req.Overlaps(date(2025, 9, 1), date(2025, 9, 4)))
+	s.False(req.Overlaps(date(2025, 9, 11), date(2025, 9, 12)))
+}
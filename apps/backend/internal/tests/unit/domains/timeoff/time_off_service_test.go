@@ -0,0 +1,216 @@
+package timeoff_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	scheduleErrors "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimeOffServiceTestSuite struct {
+	suite.Suite
+	timeOffRepo  *mocks.MockTimeOffRequestRepository
+	scheduleRepo *mocks.MockScheduleRepository
+	service      service.TimeOffServiceInterface
+	studentCtx   context.Context
+	adminCtx     context.Context
+	adminID      uuid.UUID
+}
+
+func TestTimeOffServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeOffServiceTestSuite))
+}
+
+func (s *TimeOffServiceTestSuite) SetupTest() {
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{}
+	s.scheduleRepo = &mocks.MockScheduleRepository{}
+	s.service = service.NewTimeOffService(zap.NewNop(), &mocks.StubTxManager{}, s.timeOffRepo, s.scheduleRepo)
+
+	studentID := "12345"
+	s.studentCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+
+	s.adminID = uuid.New()
+	s.adminCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: s.adminID.String(),
+		Role:   "admin",
+	})
+}
+
+func (s *TimeOffServiceTestSuite) pendingRequest(studentID int32) *aggregate.TimeOffRequest {
+	req, err := aggregate.NewTimeOffRequest(studentID, date(2025, 9, 1), date(2025, 9, 2), "Trip")
+	s.Require().NoError(err)
+	return req
+}
+
+// --- RequestTimeOff ---
+
+func (s *TimeOffServiceTestSuite) TestRequestTimeOff_Success() {
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+		s.Equal(int32(12345), *filter.StudentID)
+		s.ElementsMatch([]aggregate.Status{aggregate.Status_Pending, aggregate.Status_Approved}, filter.Statuses)
+		return nil, nil
+	}
+	s.timeOffRepo.CreateFn = func(_ context.Context, _ *sql.Tx, req *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+		return req, nil
+	}
+
+	result, err := s.service.RequestTimeOff(s.studentCtx, service.RequestTimeOffInput{
+		StartDate: date(2025, 9, 1),
+		EndDate:   date(2025, 9, 2),
+		Reason:    "Trip",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(int32(12345), result.StudentID)
+	s.Equal(aggregate.Status_Pending, result.Status)
+}
+
+func (s *TimeOffServiceTestSuite) TestRequestTimeOff_Overlapping() {
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+		return []*aggregate.TimeOffRequest{s.pendingRequest(12345)}, nil
+	}
+
+	result, err := s.service.RequestTimeOff(s.studentCtx, service.RequestTimeOffInput{
+		StartDate: date(2025, 9, 2),
+		EndDate:   date(2025, 9, 3),
+		Reason:    "Trip",
+	})
+
+	s.ErrorIs(err, timeoffErrors.ErrOverlappingRequest)
+	s.Nil(result)
+}
+
+func (s *TimeOffServiceTestSuite) TestRequestTimeOff_MissingStudentContext() {
+	result, err := s.service.RequestTimeOff(s.adminCtx, service.RequestTimeOffInput{
+		StartDate: date(2025, 9, 1),
+		EndDate:   date(2025, 9, 2),
+		Reason:    "Trip",
+	})
+
+	s.ErrorIs(err, timeoffErrors.ErrMissingAuthContext)
+	s.Nil(result)
+}
+
+// --- CancelTimeOff ---
+
+func (s *TimeOffServiceTestSuite) TestCancelTimeOff_Success() {
+	req := s.pendingRequest(12345)
+	s.timeOffRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.TimeOffRequest, error) {
+		return req, nil
+	}
+	s.timeOffRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, r *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+		return r, nil
+	}
+
+	result, err := s.service.CancelTimeOff(s.studentCtx, req.ID)
+
+	s.Require().NoError(err)
+	s.Equal(aggregate.Status_Cancelled, result.Status)
+}
+
+func (s *TimeOffServiceTestSuite) TestCancelTimeOff_OtherStudent() {
+	req := s.pendingRequest(99999)
+	s.timeOffRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.TimeOffRequest, error) {
+		return req, nil
+	}
+
+	result, err := s.service.CancelTimeOff(s.studentCtx, req.ID)
+
+	s.ErrorIs(err, timeoffErrors.ErrTimeOffNotFound)
+	s.Nil(result)
+}
+
+// --- Review ---
+
+func (s *TimeOffServiceTestSuite) TestApproveTimeOff_Success() {
+	req := s.pendingRequest(12345)
+	s.timeOffRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, _ uuid.UUID) (*aggregate.TimeOffRequest, error) {
+		return req, nil
+	}
+	s.timeOffRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, r *aggregate.TimeOffRequest) (*aggregate.TimeOffRequest, error) {
+		return r, nil
+	}
+
+	result, err := s.service.ApproveTimeOff(s.adminCtx, req.ID, nil)
+
+	s.Require().NoError(err)
+	s.Equal(aggregate.Status_Approved, result.Status)
+	s.Equal(s.adminID, *result.ReviewedBy)
+}
+
+func (s *TimeOffServiceTestSuite) TestRejectTimeOff_NotAdmin() {
+	result, err := s.service.RejectTimeOff(s.studentCtx, uuid.New(), nil)
+
+	s.ErrorIs(err, timeoffErrors.ErrNotAuthorized)
+	s.Nil(result)
+}
+
+// --- ListConflicts ---
+
+func (s *TimeOffServiceTestSuite) activeSchedule(assignments string) *scheduleAggregate.Schedule {
+	return &scheduleAggregate.Schedule{
+		ScheduleID:    uuid.New(),
+		IsActive:      true,
+		Assignments:   json.RawMessage(assignments),
+		EffectiveFrom: date(2025, 8, 1),
+	}
+}
+
+func (s *TimeOffServiceTestSuite) TestListConflicts_MatchesAssignmentsByWeekday() {
+	// 2025-09-01 is a Monday (day_of_week 0), 2025-09-02 a Tuesday (1).
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return s.activeSchedule(`[
+			{"assistant_id":"12345","shift_id":"s-mon","day_of_week":0,"start":"08:00:00","end":"10:00:00"},
+			{"assistant_id":"12345","shift_id":"s-wed","day_of_week":2,"start":"08:00:00","end":"10:00:00"},
+			{"assistant_id":"22222","shift_id":"s-tue","day_of_week":1,"start":"08:00:00","end":"10:00:00"}
+		]`), nil
+	}
+	approved := s.pendingRequest(12345)
+	s.Require().NoError(approved.Approve(uuid.New(), nil))
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter repository.TimeOffFilter) ([]*aggregate.TimeOffRequest, error) {
+		s.Equal([]aggregate.Status{aggregate.Status_Approved}, filter.Statuses)
+		return []*aggregate.TimeOffRequest{approved}, nil
+	}
+
+	conflicts, err := s.service.ListConflicts(s.adminCtx, date(2025, 9, 1), date(2025, 9, 7))
+
+	s.Require().NoError(err)
+	s.Require().Len(conflicts, 1)
+	s.Equal("s-mon", conflicts[0].ShiftID)
+	s.Equal(date(2025, 9, 1), conflicts[0].Date)
+	s.Equal(approved.ID, conflicts[0].Request.ID)
+}
+
+func (s *TimeOffServiceTestSuite) TestListConflicts_NoActiveSchedule() {
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return nil, scheduleErrors.ErrNotFound
+	}
+
+	conflicts, err := s.service.ListConflicts(s.adminCtx, date(2025, 9, 1), date(2025, 9, 7))
+
+	s.NoError(err)
+	s.Empty(conflicts)
+}
+
+func (s *TimeOffServiceTestSuite) TestListConflicts_InvalidRange() {
+	conflicts, err := s.service.ListConflicts(s.adminCtx, date(2025, 9, 7), date(2025, 9, 1))
+
+	s.ErrorIs(err, timeoffErrors.ErrInvalidPeriod)
+	s.Nil(conflicts)
+}
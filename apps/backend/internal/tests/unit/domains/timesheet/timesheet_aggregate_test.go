@@ -0,0 +1,152 @@
+package timesheet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TimesheetAggregateTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestTimesheetAggregateTestSuite(t *testing.T) {
+	suite.Run(t, new(TimesheetAggregateTestSuite))
+}
+
+func (s *TimesheetAggregateTestSuite) SetupTest() {
+	s.now = time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *TimesheetAggregateTestSuite) submitted() *aggregate.Timesheet {
+	ts, err := aggregate.NewTimesheet(1, date(2026, 3, 9), aggregate.WeekSummary{ScheduledHours: 6, WorkedHours: 5.5}, s.now)
+	s.Require().NoError(err)
+	return ts
+}
+
+// --- WeekStartOf ---
+
+func (s *TimesheetAggregateTestSuite) TestWeekStartOf() {
+	tests := []struct {
+		name string
+		in   time.Time
+	}{
+		{"monday", date(2026, 3, 9)},
+		{"midweek with time", time.Date(2026, 3, 11, 17, 45, 0, 0, time.UTC)},
+		{"sunday", time.Date(2026, 3, 15, 23, 59, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(date(2026, 3, 9), aggregate.WeekStartOf(tt.in))
+		})
+	}
+}
+
+func (s *TimesheetAggregateTestSuite) TestWeekStartAt_LocalBoundary() {
+	portOfSpain, err := time.LoadLocation("America/Port_of_Spain")
+	s.Require().NoError(err)
+	// 23:30 on Sunday 15 March in Port of Spain is 03:30 Monday in UTC.
+	s.Equal(date(2026, 3, 9), aggregate.WeekStartAt(time.Date(2026, 3, 16, 3, 30, 0, 0, time.UTC), portOfSpain))
+	// 00:30 on Monday 16 March in Port of Spain.
+	s.Equal(date(2026, 3, 16), aggregate.WeekStartAt(time.Date(2026, 3, 16, 4, 30, 0, 0, time.UTC), portOfSpain))
+}
+
+func (s *TimesheetAggregateTestSuite) TestWeekStartAt_UsesLocationTimezone() {
+	// 03:30 Monday UTC is still Sunday in Port of Spain but already Monday in London.
+	at := time.Date(2026, 3, 16, 3, 30, 0, 0, time.UTC)
+	london, err := time.LoadLocation("Europe/London")
+	s.Require().NoError(err)
+	s.Equal(date(2026, 3, 16), aggregate.WeekStartAt(at, london))
+}
+
+func (s *TimesheetAggregateTestSuite) TestWeekBounds() {
+	portOfSpain, err := time.LoadLocation("America/Port_of_Spain")
+	s.Require().NoError(err)
+	from, to := aggregate.WeekBounds(date(2026, 3, 9), portOfSpain)
+	s.Equal(time.Date(2026, 3, 9, 4, 0, 0, 0, time.UTC), from.UTC())
+	s.Equal(time.Date(2026, 3, 16, 4, 0, 0, 0, time.UTC), to.UTC())
+}
+
+// --- NewTimesheet ---
+
+func (s *TimesheetAggregateTestSuite) TestNewTimesheet_Success() {
+	ts, err := aggregate.NewTimesheet(1, date(2026, 3, 9), aggregate.WeekSummary{
+		ScheduledHours:  6,
+		WorkedHours:     5.456,
+		LogCount:        3,
+		FlaggedLogCount: 1,
+	}, s.now)
+
+	s.Require().NoError(err)
+	s.NotEqual(uuid.Nil, ts.ID)
+	s.Equal(aggregate.Status_Submitted, ts.Status)
+	s.Equal(date(2026, 3, 15), ts.WeekEnd())
+	s.Equal(5.46, ts.Summary.WorkedHours)
+	s.Equal(-0.54, ts.Summary.Variance())
+	s.Equal(s.now, ts.SubmittedAt)
+}
+
+func (s *TimesheetAggregateTestSuite) TestNewTimesheet_Invalid() {
+	_, err := aggregate.NewTimesheet(0, date(2026, 3, 9), aggregate.WeekSummary{}, s.now)
+	s.ErrorIs(err, timesheetErrors.ErrInvalidStudentID)
+
+	_, err = aggregate.NewTimesheet(1, date(2026, 3, 10), aggregate.WeekSummary{}, s.now)
+	s.ErrorIs(err, timesheetErrors.ErrInvalidWeekStart)
+}
+
+// --- Review ---
+
+func (s *TimesheetAggregateTestSuite) TestApprove() {
+	ts := s.submitted()
+	reviewer := uuid.New()
+	note := "  Looks good  "
+
+	s.Require().NoError(ts.Approve(reviewer, &note, s.now))
+	s.Equal(aggregate.Status_Approved, ts.Status)
+	s.Equal(reviewer, *ts.ReviewedBy)
+	s.Equal("Looks good", *ts.ReviewNote)
+	s.Equal(s.now, *ts.ReviewedAt)
+}
+
+func (s *TimesheetAggregateTestSuite) TestReview_RequiresSubmitted() {
+	ts := s.submitted()
+	s.Require().NoError(ts.Approve(uuid.New(), nil, s.now))
+
+	s.ErrorIs(ts.Approve(uuid.New(), nil, s.now), timesheetErrors.ErrNotSubmitted)
+	s.ErrorIs(ts.Reject(uuid.New(), nil, s.now), timesheetErrors.ErrNotSubmitted)
+}
+
+// --- Resubmit ---
+
+func (s *TimesheetAggregateTestSuite) TestResubmit_AfterRejection() {
+	ts := s.submitted()
+	note := "Missing Friday"
+	s.Require().NoError(ts.Reject(uuid.New(), &note, s.now))
+
+	later := s.now.Add(time.Hour)
+	s.Require().NoError(ts.Resubmit(aggregate.WeekSummary{ScheduledHours: 6, WorkedHours: 6}, later))
+
+	s.Equal(aggregate.Status_Submitted, ts.Status)
+	s.Equal(6.0, ts.Summary.WorkedHours)
+	s.Equal(later, ts.SubmittedAt)
+	s.Nil(ts.ReviewNote)
+	s.Nil(ts.ReviewedBy)
+	s.Nil(ts.ReviewedAt)
+}
+
+func (s *TimesheetAggregateTestSuite) TestResubmit_NotRejected() {
+	ts := s.submitted()
+	s.ErrorIs(ts.Resubmit(aggregate.WeekSummary{}, s.now), timesheetErrors.ErrAlreadySubmitted)
+
+	s.Require().NoError(ts.Approve(uuid.New(), nil, s.now))
+	s.ErrorIs(ts.Resubmit(aggregate.WeekSummary{}, s.now), timesheetErrors.ErrAlreadySubmitted)
+}
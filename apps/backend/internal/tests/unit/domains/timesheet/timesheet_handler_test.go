@@ -0,0 +1,195 @@
+package timesheet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/handler"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/handler/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimesheetHandlerTestSuite struct {
+	suite.Suite
+	mockSvc *mocks.MockTimesheetService
+	router  *chi.Mux
+}
+
+func TestTimesheetHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(TimesheetHandlerTestSuite))
+}
+
+func (s *TimesheetHandlerTestSuite) SetupTest() {
+	s.mockSvc = &mocks.MockTimesheetService{}
+	hdl := handler.NewTimesheetHandler(zap.NewNop(), s.mockSvc)
+	s.router = chi.NewRouter()
+	s.router.Route("/api/v1", func(r chi.Router) {
+		hdl.RegisterRoutes(r)
+		hdl.RegisterAdminRoutes(r)
+	})
+}
+
+func (s *TimesheetHandlerTestSuite) doRequest(method, path, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body != "" {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		req = httptest.NewRequest(method, path, nil)
+	}
+	rr := httptest.NewRecorder()
+	s.router.ServeHTTP(rr, req)
+	return rr
+}
+
+func sampleTimesheet() *aggregate.Timesheet {
+	ts, _ := aggregate.NewTimesheet(12345, date(2026, 3, 9), aggregate.WeekSummary{ScheduledHours: 5, WorkedHours: 5.5}, time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC))
+	return ts
+}
+
+func (s *TimesheetHandlerTestSuite) TestSubmitTimesheet_Success() {
+	s.mockSvc.SubmitTimesheetFn = func(_ context.Context, weekStart time.Time) (*aggregate.Timesheet, error) {
+		s.Equal(date(2026, 3, 11), weekStart)
+		return sampleTimesheet(), nil
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/timesheets/me/submit", `{"week_start":"2026-03-11"}`)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.TimesheetResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("2026-03-09", resp.WeekStart)
+	s.Equal("2026-03-15", resp.WeekEnd)
+	s.Equal("submitted", resp.Status)
+	s.Equal(0.5, resp.VarianceHours)
+}
+
+func (s *TimesheetHandlerTestSuite) TestSubmitTimesheet_InvalidDate() {
+	rr := s.doRequest(http.MethodPost, "/api/v1/timesheets/me/submit", `{"week_start":"09/03/2026"}`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *TimesheetHandlerTestSuite) TestSubmitTimesheet_ErrorMapping() {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"week not over", timesheetErrors.ErrWeekNotOver, http.StatusUnprocessableEntity},
+		{"open log", timesheetErrors.ErrOpenTimeLog, http.StatusConflict},
+		{"already submitted", timesheetErrors.ErrAlreadySubmitted, http.StatusConflict},
+		{"missing auth", timesheetErrors.ErrMissingAuthContext, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.mockSvc.SubmitTimesheetFn = func(_ context.Context, _ time.Time) (*aggregate.Timesheet, error) {
+				return nil, tt.err
+			}
+
+			rr := s.doRequest(http.MethodPost, "/api/v1/timesheets/me/submit", `{"week_start":"2026-03-09"}`)
+
+			s.Equal(tt.status, rr.Code)
+		})
+	}
+}
+
+func (s *TimesheetHandlerTestSuite) TestGetMyWeek_DefaultsToCurrentWeek() {
+	s.mockSvc.GetMyWeekFn = func(_ context.Context, weekStart time.Time) (*service.WeekView, error) {
+		s.True(weekStart.IsZero())
+		return &service.WeekView{
+			StudentID: 12345,
+			WeekStart: date(2026, 3, 16),
+			Summary:   aggregate.WeekSummary{ScheduledHours: 2},
+			Shifts: []service.ScheduledShift{
+				{Date: date(2026, 3, 16), ShiftID: "s-mon", StartTime: "08:00:00", EndTime: "10:00:00", Hours: 2},
+			},
+		}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/timesheets/me/week", "")
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.WeekViewResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("2026-03-22", resp.WeekEnd)
+	s.Equal(-2.0, resp.VarianceHours)
+	s.Require().Len(resp.Shifts, 1)
+	s.Equal("2026-03-16", resp.Shifts[0].Date)
+	s.Nil(resp.Timesheet)
+	s.NotNil(resp.Logs)
+}
+
+func (s *TimesheetHandlerTestSuite) TestListTimesheets_InvalidStatus() {
+	rr := s.doRequest(http.MethodGet, "/api/v1/timesheets?status=draft", "")
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *TimesheetHandlerTestSuite) TestListTimesheets_Filters() {
+	s.mockSvc.ListTimesheetsFn = func(_ context.Context, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+		s.Equal([]aggregate.Status{aggregate.Status_Submitted}, filter.Statuses)
+		s.Equal(date(2026, 3, 9), *filter.WeekStart)
+		return []*aggregate.Timesheet{sampleTimesheet()}, nil
+	}
+
+	rr := s.doRequest(http.MethodGet, "/api/v1/timesheets?status=submitted&week_start=2026-03-09", "")
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *TimesheetHandlerTestSuite) TestRejectTimesheet_WithNote() {
+	id := uuid.New()
+	s.mockSvc.RejectTimesheetFn = func(_ context.Context, gotID uuid.UUID, note *string) (*aggregate.Timesheet, error) {
+		s.Equal(id, gotID)
+		s.Equal("Missing Friday", *note)
+		ts := sampleTimesheet()
+		s.Require().NoError(ts.Reject(uuid.New(), note, time.Now()))
+		return ts, nil
+	}
+
+	rr := s.doRequest(http.MethodPatch, "/api/v1/timesheets/"+id.String()+"/reject", `{"note":"Missing Friday"}`)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.TimesheetResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("rejected", resp.Status)
+}
+
+func (s *TimesheetHandlerTestSuite) TestApproveTimesheet_NotSubmitted() {
+	s.mockSvc.ApproveTimesheetFn = func(_ context.Context, _ uuid.UUID, _ *string) (*aggregate.Timesheet, error) {
+		return nil, timesheetErrors.ErrNotSubmitted
+	}
+
+	rr := s.doRequest(http.MethodPatch, "/api/v1/timesheets/"+uuid.New().String()+"/approve", "")
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *TimesheetHandlerTestSuite) TestSendReminders() {
+	s.mockSvc.SendRemindersFn = func(_ context.Context, weekStart time.Time) (int, error) {
+		s.Equal(date(2026, 3, 10), weekStart)
+		return 3, nil
+	}
+
+	rr := s.doRequest(http.MethodPost, "/api/v1/timesheets/reminders", `{"week_start":"2026-03-10"}`)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var resp dtos.SendRemindersResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal("2026-03-09", resp.WeekStart)
+	s.Equal(3, resp.Reminded)
+}
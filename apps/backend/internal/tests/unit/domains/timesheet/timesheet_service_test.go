@@ -0,0 +1,389 @@
+package timesheet_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	scheduleAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
+	studentAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/student/aggregate"
+	timelogAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/aggregate"
+	timelogRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timelog/repository"
+	timeoffAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/aggregate"
+	timeoffRepo "github.com/HDR3604/HelpDeskApp/internal/domain/timeoff/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/aggregate"
+	timesheetErrors "github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/errors"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/repository"
+	"github.com/HDR3604/HelpDeskApp/internal/domain/timesheet/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/database"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/templates"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
+	"github.com/HDR3604/HelpDeskApp/internal/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type TimesheetServiceTestSuite struct {
+	suite.Suite
+	timesheetRepo *mocks.MockTimesheetRepository
+	timeLogRepo   *mocks.MockTimeLogRepository
+	scheduleRepo  *mocks.MockScheduleRepository
+	timeOffRepo   *mocks.MockTimeOffRequestRepository
+	studentRepo   *mocks.MockStudentRepository
+	emailSender   *mocks.MockEmailSender
+	service       *service.TimesheetService
+	studentCtx    context.Context
+	adminCtx      context.Context
+	adminID       uuid.UUID
+	now           time.Time
+	weekStart     time.Time
+}
+
+func TestTimesheetServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TimesheetServiceTestSuite))
+}
+
+func (s *TimesheetServiceTestSuite) SetupTest() {
+	s.timesheetRepo = &mocks.MockTimesheetRepository{}
+	s.timeLogRepo = &mocks.MockTimeLogRepository{}
+	s.scheduleRepo = &mocks.MockScheduleRepository{}
+	s.timeOffRepo = &mocks.MockTimeOffRequestRepository{}
+	s.studentRepo = &mocks.MockStudentRepository{}
+	s.emailSender = &mocks.MockEmailSender{}
+
+	s.service = service.NewTimesheetService(
+		zap.NewNop(), &mocks.StubTxManager{},
+		s.timesheetRepo, s.timeLogRepo, s.scheduleRepo, s.timeOffRepo, s.studentRepo,
+		s.emailSender, "noreply@test.com", "https://helpdesk.test",
+	)
+
+	// Tuesday after the week of Monday 2026-03-09.
+	s.now = time.Date(2026, 3, 17, 10, 0, 0, 0, time.UTC)
+	s.weekStart = date(2026, 3, 9)
+	s.service.WithNowFn(func() time.Time { return s.now })
+
+	studentID := "12345"
+	s.studentCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID:    uuid.New().String(),
+		StudentID: &studentID,
+		Role:      "student",
+	})
+
+	s.adminID = uuid.New()
+	s.adminCtx = database.WithAuthContext(context.Background(), database.AuthContext{
+		UserID: s.adminID.String(),
+		Role:   "admin",
+	})
+
+	// Student 12345 works Monday 08-10 and Wednesday 08-11; 22222 works Tuesday 12-14.
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return &scheduleAggregate.Schedule{
+			ScheduleID: uuid.New(),
+			IsActive:   true,
+			Assignments: json.RawMessage(`[
+				{"assistant_id":"12345","shift_id":"s-mon","day_of_week":0,"start":"08:00:00","end":"10:00:00"},
+				{"assistant_id":"12345","shift_id":"s-wed","day_of_week":2,"start":"08:00:00","end":"11:00:00"},
+				{"assistant_id":"22222","shift_id":"s-tue","day_of_week":1,"start":"12:00:00","end":"14:00:00"}
+			]`),
+			EffectiveFrom: date(2026, 1, 1),
+		}, nil
+	}
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		return nil, nil
+	}
+	s.timesheetRepo.SummarizeWeekFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (*repository.WorkedSummary, error) {
+		return &repository.WorkedSummary{WorkedHours: 4.5, LogCount: 2}, nil
+	}
+	s.timesheetRepo.GetByStudentAndWeekFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (*aggregate.Timesheet, error) {
+		return nil, timesheetErrors.ErrTimesheetNotFound
+	}
+}
+
+func (s *TimesheetServiceTestSuite) submitted(studentID int32) *aggregate.Timesheet {
+	ts, err := aggregate.NewTimesheet(studentID, s.weekStart, aggregate.WeekSummary{ScheduledHours: 5, WorkedHours: 4.5}, s.now)
+	s.Require().NoError(err)
+	return ts
+}
+
+// --- SubmitTimesheet ---
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_CreatesSnapshot() {
+	s.timesheetRepo.CreateFn = func(_ context.Context, _ *sql.Tx, ts *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+		return ts, nil
+	}
+
+	// Any day of the week selects that week.
+	result, err := s.service.SubmitTimesheet(s.studentCtx, date(2026, 3, 12))
+
+	s.Require().NoError(err)
+	s.Equal(int32(12345), result.StudentID)
+	s.Equal(s.weekStart, result.WeekStart)
+	s.Equal(aggregate.Status_Submitted, result.Status)
+	s.Equal(5.0, result.Summary.ScheduledHours)
+	s.Equal(4.5, result.Summary.WorkedHours)
+	s.Equal(-0.5, result.Summary.Variance())
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_ExcludesApprovedTimeOff() {
+	wednesdayOff, err := timeoffAggregate.NewTimeOffRequest(12345, date(2026, 3, 11), date(2026, 3, 11), "Exam")
+	s.Require().NoError(err)
+	s.Require().NoError(wednesdayOff.Approve(uuid.New(), nil))
+
+	s.timeOffRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter timeoffRepo.TimeOffFilter) ([]*timeoffAggregate.TimeOffRequest, error) {
+		s.Equal([]timeoffAggregate.Status{timeoffAggregate.Status_Approved}, filter.Statuses)
+		s.Equal(s.weekStart, *filter.From)
+		s.Equal(date(2026, 3, 15), *filter.To)
+		return []*timeoffAggregate.TimeOffRequest{wednesdayOff}, nil
+	}
+	s.timesheetRepo.CreateFn = func(_ context.Context, _ *sql.Tx, ts *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+		return ts, nil
+	}
+
+	result, err := s.service.SubmitTimesheet(s.studentCtx, s.weekStart)
+
+	s.Require().NoError(err)
+	s.Equal(2.0, result.Summary.ScheduledHours)
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_WeekNotOver() {
+	_, err := s.service.SubmitTimesheet(s.studentCtx, date(2026, 3, 16))
+
+	s.ErrorIs(err, timesheetErrors.ErrWeekNotOver)
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_OpenTimeLog() {
+	s.timesheetRepo.SummarizeWeekFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (*repository.WorkedSummary, error) {
+		return &repository.WorkedSummary{LogCount: 1, OpenLogCount: 1}, nil
+	}
+
+	_, err := s.service.SubmitTimesheet(s.studentCtx, s.weekStart)
+
+	s.ErrorIs(err, timesheetErrors.ErrOpenTimeLog)
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_ResubmitsRejected() {
+	existing := s.submitted(12345)
+	s.Require().NoError(existing.Reject(uuid.New(), nil, s.now))
+
+	s.timesheetRepo.GetByStudentAndWeekFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (*aggregate.Timesheet, error) {
+		return existing, nil
+	}
+	var updated bool
+	s.timesheetRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, ts *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+		updated = true
+		return ts, nil
+	}
+
+	result, err := s.service.SubmitTimesheet(s.studentCtx, s.weekStart)
+
+	s.Require().NoError(err)
+	s.True(updated)
+	s.Equal(aggregate.Status_Submitted, result.Status)
+	s.Nil(result.ReviewedBy)
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_AlreadySubmitted() {
+	s.timesheetRepo.GetByStudentAndWeekFn = func(_ context.Context, _ *sql.Tx, _ int32, _ time.Time) (*aggregate.Timesheet, error) {
+		return s.submitted(12345), nil
+	}
+
+	_, err := s.service.SubmitTimesheet(s.studentCtx, s.weekStart)
+
+	s.ErrorIs(err, timesheetErrors.ErrAlreadySubmitted)
+}
+
+func (s *TimesheetServiceTestSuite) TestSubmitTimesheet_MissingStudent() {
+	_, err := s.service.SubmitTimesheet(s.adminCtx, s.weekStart)
+
+	s.ErrorIs(err, timesheetErrors.ErrMissingAuthContext)
+}
+
+// --- GetMyWeek ---
+
+func (s *TimesheetServiceTestSuite) TestGetMyWeek_DefaultsToCurrentWeek() {
+	s.timeLogRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter timelogRepo.TimeLogFilter) ([]*timelogAggregate.TimeLog, int, error) {
+		s.Equal(int32(12345), *filter.StudentID)
+		// Logs are matched to the week in their own location's time.
+		s.Equal(date(2026, 3, 16), *filter.WeekStart)
+		s.Nil(filter.From)
+		s.Nil(filter.To)
+		return []*timelogAggregate.TimeLog{}, 0, nil
+	}
+
+	view, err := s.service.GetMyWeek(s.studentCtx, time.Time{})
+
+	s.Require().NoError(err)
+	s.Equal(date(2026, 3, 16), view.WeekStart)
+	s.Nil(view.Timesheet)
+	s.Len(view.Shifts, 2)
+	s.Equal("s-mon", view.Shifts[0].ShiftID)
+	s.Equal(date(2026, 3, 16), view.Shifts[0].Date)
+	s.Equal(5.0, view.Summary.ScheduledHours)
+}
+
+// --- Review ---
+
+func (s *TimesheetServiceTestSuite) TestApproveTimesheet_Success() {
+	ts := s.submitted(12345)
+	s.timesheetRepo.GetByIDFn = func(_ context.Context, _ *sql.Tx, id uuid.UUID) (*aggregate.Timesheet, error) {
+		s.Equal(ts.ID, id)
+		return ts, nil
+	}
+	s.timesheetRepo.UpdateFn = func(_ context.Context, _ *sql.Tx, t *aggregate.Timesheet) (*aggregate.Timesheet, error) {
+		return t, nil
+	}
+
+	result, err := s.service.ApproveTimesheet(s.adminCtx, ts.ID, nil)
+
+	s.Require().NoError(err)
+	s.Equal(aggregate.Status_Approved, result.Status)
+	s.Equal(s.adminID, *result.ReviewedBy)
+	s.Equal(s.now, *result.ReviewedAt)
+}
+
+func (s *TimesheetServiceTestSuite) TestRejectTimesheet_RequiresAdmin() {
+	_, err := s.service.RejectTimesheet(s.studentCtx, uuid.New(), nil)
+
+	s.ErrorIs(err, timesheetErrors.ErrNotAuthorized)
+}
+
+// --- SendReminders ---
+
+func (s *TimesheetServiceTestSuite) TestSendReminders_SkipsSubmittedStudents() {
+	// 33333 only has time logs; 12345 and 22222 are scheduled; 12345 already submitted.
+	s.timesheetRepo.ListStudentIDsWithLogsFn = func(_ context.Context, _ *sql.Tx, _ time.Time) ([]int32, error) {
+		return []int32{12345, 33333}, nil
+	}
+	s.timesheetRepo.ListFn = func(_ context.Context, _ *sql.Tx, filter repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+		s.Equal(s.weekStart, *filter.WeekStart)
+		s.ElementsMatch([]aggregate.Status{aggregate.Status_Submitted, aggregate.Status_Approved}, filter.Statuses)
+		return []*aggregate.Timesheet{s.submitted(12345)}, nil
+	}
+	s.studentRepo.ListByIDsFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+		s.Equal([]int32{22222, 33333}, ids)
+		return []*studentAggregate.Student{
+			{StudentID: 22222, FirstName: "Bea", EmailAddress: "bea@my.uwi.edu"},
+			{StudentID: 33333, FirstName: "Cal", EmailAddress: "cal@my.uwi.edu"},
+		}, nil
+	}
+	var sent dtos.SendEmailBulkRequest
+	s.emailSender.SendBatchFn = func(_ context.Context, req dtos.SendEmailBulkRequest) (*dtos.SendEmailBulkResponse, error) {
+		sent = req
+		return &dtos.SendEmailBulkResponse{}, nil
+	}
+	var recorded []int32
+	s.timesheetRepo.RecordRemindersFn = func(_ context.Context, _ *sql.Tx, weekStart time.Time, ids []int32, sentAt time.Time) error {
+		s.Equal(s.weekStart, weekStart)
+		s.Equal(s.now, sentAt)
+		recorded = append(recorded, ids...)
+		return nil
+	}
+
+	reminded, err := s.service.SendReminders(s.adminCtx, s.weekStart)
+
+	s.Require().NoError(err)
+	s.Equal(2, reminded)
+	s.Require().Len(sent, 2)
+	s.Equal([]string{"bea@my.uwi.edu"}, sent[0].To)
+	s.Equal(templates.TemplateID_TimesheetReminder, sent[0].Template.ID)
+	s.Equal("https://helpdesk.test/timesheets", sent[0].Template.Variables["TIMESHEET_URL"])
+	s.Equal([]int32{22222, 33333}, recorded)
+}
+
+func (s *TimesheetServiceTestSuite) TestSendReminders_NothingPending() {
+	s.scheduleRepo.GetActiveFn = func(_ context.Context, _ *sql.Tx) (*scheduleAggregate.Schedule, error) {
+		return nil, nil
+	}
+	s.timesheetRepo.ListStudentIDsWithLogsFn = func(_ context.Context, _ *sql.Tx, _ time.Time) ([]int32, error) {
+		return []int32{}, nil
+	}
+	s.timesheetRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+		return nil, nil
+	}
+
+	reminded, err := s.service.SendReminders(s.adminCtx, s.weekStart)
+
+	s.Require().NoError(err)
+	s.Equal(0, reminded)
+}
+
+func (s *TimesheetServiceTestSuite) TestSendReminders_WeekNotOver() {
+	_, err := s.service.SendReminders(s.adminCtx, s.now)
+
+	s.ErrorIs(err, timesheetErrors.ErrWeekNotOver)
+}
+
+func (s *TimesheetServiceTestSuite) TestSendReminders_RequiresAdmin() {
+	_, err := s.service.SendReminders(s.studentCtx, s.weekStart)
+
+	s.ErrorIs(err, timesheetErrors.ErrNotAuthorized)
+}
+
+// --- SendDueReminders ---
+
+func (s *TimesheetServiceTestSuite) TestSendDueReminders_SkipsRemindedStudents() {
+	// The last week to end before Tuesday 17 March is the week of 9 March.
+	s.timesheetRepo.ListStudentIDsWithLogsFn = func(_ context.Context, _ *sql.Tx, weekStart time.Time) ([]int32, error) {
+		s.Equal(s.weekStart, weekStart)
+		return []int32{33333}, nil
+	}
+	s.timesheetRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+		return nil, nil
+	}
+	s.timesheetRepo.ListRemindedStudentIDsFn = func(_ context.Context, _ *sql.Tx, weekStart time.Time) ([]int32, error) {
+		s.Equal(s.weekStart, weekStart)
+		return []int32{12345, 33333}, nil
+	}
+	s.studentRepo.ListByIDsFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+		s.Equal([]int32{22222}, ids)
+		return []*studentAggregate.Student{{StudentID: 22222, FirstName: "Bea", EmailAddress: "bea@my.uwi.edu"}}, nil
+	}
+	s.emailSender.SendBatchFn = func(_ context.Context, _ dtos.SendEmailBulkRequest) (*dtos.SendEmailBulkResponse, error) {
+		return &dtos.SendEmailBulkResponse{}, nil
+	}
+	var recorded []int32
+	s.timesheetRepo.RecordRemindersFn = func(_ context.Context, _ *sql.Tx, _ time.Time, ids []int32, _ time.Time) error {
+		recorded = append(recorded, ids...)
+		return nil
+	}
+
+	reminded, err := s.service.SendDueReminders(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(1, reminded)
+	s.Equal([]int32{22222}, recorded)
+}
+
+func (s *TimesheetServiceTestSuite) TestSendDueReminders_SendFailsRecordsNothing() {
+	s.timesheetRepo.ListStudentIDsWithLogsFn = func(_ context.Context, _ *sql.Tx, _ time.Time) ([]int32, error) {
+		return []int32{}, nil
+	}
+	s.timesheetRepo.ListFn = func(_ context.Context, _ *sql.Tx, _ repository.TimesheetFilter) ([]*aggregate.Timesheet, error) {
+		return nil, nil
+	}
+	s.timesheetRepo.ListRemindedStudentIDsFn = func(_ context.Context, _ *sql.Tx, _ time.Time) ([]int32, error) {
+		return []int32{}, nil
+	}
+	s.studentRepo.ListByIDsFn = func(_ context.Context, _ *sql.Tx, ids []int32) ([]*studentAggregate.Student, error) {
+		return []*studentAggregate.Student{
+			{StudentID: 12345, FirstName: "Ada", EmailAddress: "ada@my.uwi.edu"},
+			{StudentID: 22222, FirstName: "Bea", EmailAddress: "bea@my.uwi.edu"},
+		}, nil
+	}
+	s.emailSender.SendBatchFn = func(_ context.Context, _ dtos.SendEmailBulkRequest) (*dtos.SendEmailBulkResponse, error) {
+		return nil, errors.New("provider down")
+	}
+	s.timesheetRepo.RecordRemindersFn = func(_ context.Context, _ *sql.Tx, _ time.Time, _ []int32, _ time.Time) error {
+		s.Fail("nothing was sent, so nothing should be recorded")
+		return nil
+	}
+
+	reminded, err := s.service.SendDueReminders(context.Background())
+
+	s.Error(err)
+	s.Equal(0, reminded)
+}
@@ -0,0 +1,119 @@
+package infrastructure_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/bankfile"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleBatch() bankfile.Batch {
+	return bankfile.Batch{
+		BankName:    "First Citizens",
+		PeriodStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		Entries: []bankfile.Entry{
+			{Reference: "pay-1", StudentID: 816000001, PayeeName: "Jane Doe", BranchName: "St. Augustine", AccountType: "savings", AccountNumber: "123456789", AmountCents: 25000},
+			{Reference: "pay-2", StudentID: 816000002, PayeeName: "José Ramírez", BranchName: "Port of Spain", AccountType: "chequeing", AccountNumber: "987654321", AmountCents: 12550},
+		},
+	}
+}
+
+func TestBankFileRegistry_ForBank(t *testing.T) {
+	r := bankfile.DefaultRegistry()
+
+	require.Equal(t, "republic_csv", r.ForBank("Republic Bank").Name())
+	require.Equal(t, "republic_csv", r.ForBank("  REPUBLIC bank ltd. ").Name())
+	require.Equal(t, "fixed_width", r.ForBank("First Citizens Bank").Name())
+	require.Equal(t, "fixed_width", r.ForBank("scotiabank").Name())
+	require.Equal(t, "generic_csv", r.ForBank("JMMB Bank").Name())
+	require.Equal(t, "generic_csv", r.FallbackFormat())
+	require.Equal(t, []string{"fixed_width", "generic_csv", "republic_csv"}, r.Formats())
+}
+
+func TestBankFileRegistry_Register(t *testing.T) {
+	r := bankfile.NewRegistry(bankfile.GenericCSV{})
+	r.Register(bankfile.FixedWidth{}, "RBC Royal Bank")
+
+	require.Equal(t, "fixed_width", r.ForBank("rbc royal-bank").Name())
+	require.Equal(t, map[string]string{"rbc royal bank": "fixed_width"}, r.Banks())
+}
+
+func TestNormaliseBankName(t *testing.T) {
+	require.Equal(t, "republic bank ltd", bankfile.NormaliseBankName(" Republic  Bank, Ltd. "))
+	require.Equal(t, "", bankfile.NormaliseBankName("--"))
+}
+
+func TestBatch_ControlTotals(t *testing.T) {
+	b := sampleBatch()
+	require.Equal(t, 2, b.RecordCount())
+	require.Equal(t, int64(37550), b.ControlTotalCents())
+}
+
+func TestGenericCSV_Write(t *testing.T) {
+	out, err := bankfile.GenericCSV{}.Write(sampleBatch())
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(string(out))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	require.Equal(t, "Payment Reference", rows[0][0])
+	require.Equal(t, []string{"pay-1", "816000001", "Jane Doe", "First Citizens", "St. Augustine", "savings", "123456789", "250.00"}, rows[1])
+	require.Equal(t, "125.50", rows[2][7])
+	require.Equal(t, []string{"TRAILER", "", "", "", "", "", "2", "375.50"}, rows[3])
+}
+
+func TestRepublicCSV_Write(t *testing.T) {
+	out, err := bankfile.RepublicCSV{}.Write(sampleBatch())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "\r\n")
+
+	// Header, detail and trailer rows have different widths.
+	reader := csv.NewReader(strings.NewReader(string(out)))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, []string{"H", "DCIT HELP DESK", "15/03/2026", "2"}, rows[0])
+	require.Equal(t, []string{"D", "123456789", "S", "St. Augustine", "Jane Doe", "250.00", "pay-1"}, rows[1])
+	require.Equal(t, "C", rows[2][2])
+	require.Equal(t, []string{"T", "2", "375.50"}, rows[3])
+}
+
+func TestFixedWidth_Write(t *testing.T) {
+	out, err := bankfile.FixedWidth{}.Write(sampleBatch())
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(out), "\r\n"), "\r\n")
+	require.Len(t, lines, 4)
+	for _, line := range lines {
+		require.Len(t, line, bankfile.RecordLength)
+	}
+
+	require.True(t, strings.HasPrefix(lines[0], "HDCIT HELP DESK"))
+	require.Equal(t, "20260315", lines[0][61:69])
+
+	// D | branch(20) | account(20) | type(1) | amount(12) | payee(26)
+	require.Equal(t, "ST. AUGUSTINE       ", lines[1][1:21])
+	require.Equal(t, "123456789           ", lines[1][21:41])
+	require.Equal(t, "S", lines[1][41:42])
+	require.Equal(t, "000000025000", lines[1][42:54])
+	require.Equal(t, "JANE DOE", strings.TrimSpace(lines[1][54:]))
+	require.Equal(t, "JOS? RAM?REZ", strings.TrimSpace(lines[2][54:]))
+
+	require.Equal(t, "T000002000000000037550", strings.TrimSpace(lines[3]))
+}
+
+func TestFixedWidth_RejectsOverflowAndNegativeAmounts(t *testing.T) {
+	b := sampleBatch()
+	b.Entries[0].AmountCents = 1_000_000_000_000
+	_, err := bankfile.FixedWidth{}.Write(b)
+	require.Error(t, err)
+
+	b = sampleBatch()
+	b.Entries[0].AmountCents = -1
+	_, err = bankfile.FixedWidth{}.Write(b)
+	require.Error(t, err)
+}
@@ -123,3 +123,124 @@ func (s *EmailTemplateRendererTestSuite) TestRender_MissingVariable_LeavesPlaceh
 	s.Contains(html, "John Doe")
 	s.Contains(html, "{{{CONTACT_EMAIL}}}")
 }
+
+func (s *EmailTemplateRendererTestSuite) TestRender_AdminAlert() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_AdminAlert,
+		Variables: map[string]any{
+			"ALERT_TITLE":   "Clock-in lockout",
+			"ALERT_MESSAGE": "Student 816000001 was locked out of clock-in.",
+			"ALERT_TIME":    "2026-03-23 09:00 UTC",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Clock-in lockout")
+	s.Contains(html, "Student 816000001 was locked out of clock-in.")
+	s.Contains(html, "2026-03-23 09:00 UTC")
+	s.NotContains(html, "{{{ALERT_TITLE}}}")
+	s.NotContains(html, "{{{ALERT_MESSAGE}}}")
+	s.NotContains(html, "{{{ALERT_TIME}}}")
+}
+
+func (s *EmailTemplateRendererTestSuite) TestRender_TimesheetReminder() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_TimesheetReminder,
+		Variables: map[string]any{
+			"STUDENT_NAME":  "Alice",
+			"WEEK_LABEL":    "9 Mar – 15 Mar 2026",
+			"TIMESHEET_URL": "https://helpdesk.example.com/timesheets",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Alice")
+	s.Contains(html, "9 Mar – 15 Mar 2026")
+	s.Contains(html, "https://helpdesk.example.com/timesheets")
+	s.NotContains(html, "{{{STUDENT_NAME}}}")
+	s.NotContains(html, "{{{WEEK_LABEL}}}")
+	s.NotContains(html, "{{{TIMESHEET_URL}}}")
+}
+
+func (s *EmailTemplateRendererTestSuite) TestRender_Payslip() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_Payslip,
+		Variables: map[string]any{
+			"STUDENT_NAME":      "Alice Smith",
+			"PERIOD_LABEL":      "1 Mar – 15 Mar 2026",
+			"HOURS_WORKED":      "12.50",
+			"GROSS_AMOUNT":      "$250.00",
+			"DEDUCTIONS_AMOUNT": "$10.00",
+			"NET_AMOUNT":        "$240.00",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Alice Smith")
+	s.Contains(html, "1 Mar – 15 Mar 2026")
+	s.Contains(html, "12.50")
+	s.Contains(html, "$250.00")
+	s.Contains(html, "$10.00")
+	s.Contains(html, "$240.00")
+	s.NotContains(html, "{{{")
+}
+
+func (s *EmailTemplateRendererTestSuite) TestRender_PayPeriodSummary() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_PayPeriodSummary,
+		Variables: map[string]any{
+			"HEADING":       "Pay period ready for review",
+			"MESSAGE":       "Draft payments for 12 students have been generated.",
+			"PERIOD_LABEL":  "1 Mar – 15 Mar 2026",
+			"PAYMENT_COUNT": 12,
+			"GROSS_TOTAL":   "$2400.00",
+			"REVIEW_URL":    "https://helpdesk.example.com/payroll?period_start=2026-03-01&period_end=2026-03-15",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Pay period ready for review")
+	s.Contains(html, "1 Mar – 15 Mar 2026")
+	s.Contains(html, "$2400.00")
+	s.Contains(html, "https://helpdesk.example.com/payroll?period_start=2026-03-01&period_end=2026-03-15")
+	s.NotContains(html, "{{{")
+}
+
+func (s *EmailTemplateRendererTestSuite) TestRender_InterviewInvitation() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_InterviewInvitation,
+		Variables: map[string]any{
+			"STUDENT_NAME":   "Jane Doe",
+			"INTERVIEW_DATE": "Thursday, 2 April 2026",
+			"INTERVIEW_TIME": "10:00 AM – 10:30 AM",
+			"LOCATION":       "DCIT Room 101",
+			"CONTACT_EMAIL":  "helpdesk@dcit.uwi.edu",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Jane Doe")
+	s.Contains(html, "Thursday, 2 April 2026")
+	s.Contains(html, "10:00 AM – 10:30 AM")
+	s.Contains(html, "DCIT Room 101")
+	s.NotContains(html, "{{{")
+}
+
+func (s *EmailTemplateRendererTestSuite) TestRender_TrainingExpiry() {
+	html, err := templates.Render(types.EmailTemplate{
+		ID: templates.TemplateID_TrainingExpiry,
+		Variables: map[string]any{
+			"STUDENT_NAME":  "Jane",
+			"TRAINING_NAME": "Privacy Module",
+			"EXPIRES_ON":    "1 May 2026",
+			"TRAINING_URL":  "https://helpdesk.example.com/training",
+		},
+	})
+
+	s.NoError(err)
+	s.Contains(html, "Jane")
+	s.Contains(html, "Privacy Module")
+	s.Contains(html, "1 May 2026")
+	s.Contains(html, "https://helpdesk.example.com/training")
+	s.NotContains(html, "{{{")
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/interfaces"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/service"
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -70,6 +71,38 @@ func (s *MailpitEmailSenderServiceTestSuite) TestSend_Success() {
 	s.Equal("msg-001", resp.ID)
 }
 
+func (s *MailpitEmailSenderServiceTestSuite) TestSend_Attachments() {
+	s.mux.HandleFunc("/api/v1/send", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		s.Require().NoError(json.NewDecoder(r.Body).Decode(&req))
+
+		attachments := req["Attachments"].([]any)
+		s.Require().Len(attachments, 1)
+		a := attachments[0].(map[string]any)
+		s.Equal("JVBERi0=", a["Content"])
+		s.Equal("payslip.pdf", a["Filename"])
+		s.Equal("application/pdf", a["ContentType"])
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"ID": "msg-002"}`)
+	})
+
+	resp, err := s.service.Send(s.T().Context(), dtos.SendEmailRequest{
+		From:    "onboarding@resend.dev",
+		To:      []string{"delivered@resend.dev"},
+		Subject: "Payslip",
+		HTML:    "<p>Attached</p>",
+		Attachments: []types.EmailAttachment{{
+			Content:     "JVBERi0=",
+			Filename:    "payslip.pdf",
+			ContentType: "application/pdf",
+		}},
+	})
+
+	s.NoError(err)
+	s.Equal("msg-002", resp.ID)
+}
+
 func (s *MailpitEmailSenderServiceTestSuite) TestSend_ParsesFriendlyName() {
 	s.mux.HandleFunc("/api/v1/send", func(w http.ResponseWriter, r *http.Request) {
 		body, _ := io.ReadAll(r.Body)
@@ -0,0 +1,89 @@
+package infrastructure_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/spreadsheet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpreadsheet_FormatFromFilename(t *testing.T) {
+	f, err := spreadsheet.FormatFromFilename("roster.XLSX")
+	require.NoError(t, err)
+	require.Equal(t, spreadsheet.Format_XLSX, f)
+
+	f, err = spreadsheet.FormatFromFilename("roster.csv")
+	require.NoError(t, err)
+	require.Equal(t, spreadsheet.Format_CSV, f)
+
+	_, err = spreadsheet.FormatFromFilename("roster.xls")
+	require.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
+
+func TestSpreadsheet_ReadCSV_StripsBOM(t *testing.T) {
+	rows, err := spreadsheet.Read(strings.NewReader("\ufeffstudent_id,email\n816000001,jane.doe@my.uwi.edu\n"), spreadsheet.Format_CSV)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"student_id", "email"}, {"816000001", "jane.doe@my.uwi.edu"}}, rows)
+}
+
+func TestSpreadsheet_XLSXRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"student_id", "name", "availability"},
+		{"816000001", "José <Ramírez> & co", "Mon 9-12; Wed 10-11"},
+		{"816000002", "", "  leading space"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, spreadsheet.Write(&buf, spreadsheet.Format_XLSX, rows))
+
+	got, err := spreadsheet.Read(&buf, spreadsheet.Format_XLSX)
+	require.NoError(t, err)
+	require.Equal(t, rows, got)
+}
+
+func TestSpreadsheet_ReadXLSX_SharedStringsAndGaps(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	parts := map[string]string{
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>student_id</t></si><si><r><t>em</t></r><r><t>ail</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>` +
+			`<row r="2"><c r="A2"><v>816000001</v></c><c r="B2" t="b"><v>1</v></c></row>` +
+			`</sheetData></worksheet>`,
+	}
+	for name, body := range parts {
+		fw, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	rows, err := spreadsheet.Read(&buf, spreadsheet.Format_XLSX)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"student_id", "", "email"}, {"816000001", "TRUE"}}, rows)
+}
+
+func TestSpreadsheet_ReadXLSX_NotAWorkbook(t *testing.T) {
+	_, err := spreadsheet.Read(strings.NewReader("student_id,email"), spreadsheet.Format_XLSX)
+	require.ErrorIs(t, err, spreadsheet.ErrInvalidFile)
+}
+
+func TestSpreadsheet_ReadXLSX_RejectsOversizedPart(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, err := zw.Create("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	// 65 MB of whitespace deflates to well under the upload limit.
+	_, err = fw.Write(bytes.Repeat([]byte(" "), 65<<20))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.Less(t, buf.Len(), 1<<20)
+
+	_, err = spreadsheet.Read(&buf, spreadsheet.Format_XLSX)
+	require.ErrorIs(t, err, spreadsheet.ErrInvalidFile)
+	require.ErrorContains(t, err, "too large")
+}
@@ -0,0 +1,75 @@
+package infrastructure_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := storage.NewLocalStore(root)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "documents/abc", []byte("hello")))
+
+	info, err := os.Stat(filepath.Join(root, "documents", "abc"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := store.Get(ctx, "documents/abc")
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), data)
+
+	require.NoError(t, store.Delete(ctx, "documents/abc"))
+	_, err = store.Get(ctx, "documents/abc")
+	require.ErrorIs(t, err, storage.ErrObjectNotFound)
+
+	// Deleting again is not an error.
+	require.NoError(t, store.Delete(ctx, "documents/abc"))
+}
+
+func TestLocalStore_RejectsKeysOutsideRoot(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", ".", "..", "../escape", "documents/../../escape", "/etc/passwd"} {
+		require.Error(t, store.Put(ctx, key, []byte("x")), key)
+	}
+}
+
+func TestEncryptedStore_EncryptsAtRest(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	local, err := storage.NewLocalStore(root)
+	require.NoError(t, err)
+	store, err := storage.NewEncryptedStore(local, []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	plaintext := []byte("%PDF-1.4 transcript")
+	require.NoError(t, store.Put(ctx, "documents/t1", plaintext))
+
+	raw, err := os.ReadFile(filepath.Join(root, "documents", "t1"))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, plaintext), "file on disk must not contain the plaintext")
+
+	data, err := store.Get(ctx, "documents/t1")
+	require.NoError(t, err)
+	require.Equal(t, plaintext, data)
+
+	// A store with a different key cannot read the object.
+	other, err := storage.NewEncryptedStore(local, []byte("fedcba9876543210fedcba9876543210"))
+	require.NoError(t, err)
+	_, err = other.Get(ctx, "documents/t1")
+	require.Error(t, err)
+
+	_, err = storage.NewEncryptedStore(local, []byte("short"))
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	payrollAggregate "github.com/HDR3604/HelpDeskApp/internal/domain/payroll/aggregate"
 	"github.com/HDR3604/HelpDeskApp/internal/domain/schedule/aggregate"
 	emailDtos "github.com/HDR3604/HelpDeskApp/internal/infrastructure/email/types/dtos"
 	"github.com/HDR3604/HelpDeskApp/internal/infrastructure/jobqueue/jobs"
@@ -179,6 +180,66 @@ func (s *ScheduleGenerationWorkerSuite) TestWork_Infeasible_MarksInfeasible() {
 	s.True(infeasibleCalled)
 }
 
+func (s *ScheduleGenerationWorkerSuite) TestWork_SolvesOverrides() {
+	args := s.newArgs()
+	args.Overrides = []jobs.ScheduleOverrideArgs{{
+		From:           "2026-09-07",
+		To:             "2026-09-07",
+		RequestPayload: types.GenerateScheduleRequest{Shifts: args.RequestPayload.Shifts},
+	}}
+	s.setupHappyPath(args)
+
+	var requests []types.GenerateScheduleRequest
+	s.schedulerSvc.GenerateScheduleFn = func(req types.GenerateScheduleRequest) (*types.GenerateScheduleResponse, error) {
+		requests = append(requests, req)
+		if len(req.Assistants) == 0 {
+			return &types.GenerateScheduleResponse{Status: types.ScheduleStatus_Feasible, Assignments: []types.Assignment{}}, nil
+		}
+		return s.newResponse(), nil
+	}
+	var created *aggregate.Schedule
+	s.scheduleRepo.CreateFn = func(_ context.Context, _ *sql.Tx, sched *aggregate.Schedule) (*aggregate.Schedule, error) {
+		created = sched
+		return sched, nil
+	}
+
+	err := s.worker.Work(context.Background(), s.newJob(args))
+
+	s.NoError(err)
+	s.Len(requests, 2)
+	s.Require().NotNil(created)
+	overrides, err := aggregate.ParseAssignmentOverrides(created.AssignmentOverrides)
+	s.Require().NoError(err)
+	s.Equal([]aggregate.AssignmentOverride{{From: "2026-09-07", To: "2026-09-07", Assignments: []aggregate.Assignment{}}}, overrides)
+}
+
+func (s *ScheduleGenerationWorkerSuite) TestWork_InfeasibleOverride_MarksInfeasible() {
+	args := s.newArgs()
+	args.Overrides = []jobs.ScheduleOverrideArgs{{From: "2026-09-07", To: "2026-09-08"}}
+	s.setupHappyPath(args)
+
+	s.schedulerSvc.GenerateScheduleFn = func(req types.GenerateScheduleRequest) (*types.GenerateScheduleResponse, error) {
+		if len(req.Assistants) == 0 {
+			return &types.GenerateScheduleResponse{Status: types.ScheduleStatus_Infeasible}, nil
+		}
+		return s.newResponse(), nil
+	}
+	var infeasibleMsg string
+	s.generationSvc.MarkInfeasibleFn = func(_ context.Context, _ uuid.UUID, _ string, msg string) error {
+		infeasibleMsg = msg
+		return nil
+	}
+	s.scheduleRepo.CreateFn = func(_ context.Context, _ *sql.Tx, _ *aggregate.Schedule) (*aggregate.Schedule, error) {
+		s.Fail("no schedule should be created when an override is infeasible")
+		return nil, nil
+	}
+
+	err := s.worker.Work(context.Background(), s.newJob(args))
+
+	s.NoError(err)
+	s.Contains(infeasibleMsg, "2026-09-07 to 2026-09-08")
+}
+
 func (s *ScheduleGenerationWorkerSuite) TestWork_InvalidDate_MarksFailed() {
 	args := s.newArgs()
 	args.EffectiveFrom = "not-a-date"
@@ -312,3 +373,259 @@ func (s *EmailNotificationWorkerSuite) TestWork_EmptyBatch_Success() {
 
 	s.NoError(err)
 }
+
+// ── Payslip Email Worker ───────────────────────────────────────────────
+
+type PayslipEmailWorkerSuite struct {
+	suite.Suite
+	payslipSvc *mocks.MockPayslipService
+	worker     *jobs.PayslipEmailWorker
+}
+
+func TestPayslipEmailWorkerSuite(t *testing.T) {
+	suite.Run(t, new(PayslipEmailWorkerSuite))
+}
+
+func (s *PayslipEmailWorkerSuite) SetupTest() {
+	s.payslipSvc = &mocks.MockPayslipService{}
+	s.worker = jobs.NewPayslipEmailWorker(zap.NewNop(), s.payslipSvc)
+}
+
+func (s *PayslipEmailWorkerSuite) TestWork_Success() {
+	paymentID := uuid.New()
+	var sentFor uuid.UUID
+	s.payslipSvc.SendPayslipEmailFn = func(_ context.Context, id uuid.UUID) error {
+		sentFor = id
+		return nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.PayslipEmailArgs]{
+		Args: jobs.PayslipEmailArgs{PaymentID: paymentID},
+	})
+
+	s.NoError(err)
+	s.Equal(paymentID, sentFor)
+}
+
+func (s *PayslipEmailWorkerSuite) TestWork_SendFails_ReturnsError() {
+	s.payslipSvc.SendPayslipEmailFn = func(_ context.Context, _ uuid.UUID) error {
+		return fmt.Errorf("email service down")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.PayslipEmailArgs]{
+		Args: jobs.PayslipEmailArgs{PaymentID: uuid.New()},
+	})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "email service down")
+}
+
+// ── Pay Calendar Worker ────────────────────────────────────────────────
+
+type PayCalendarWorkerSuite struct {
+	suite.Suite
+	calendarSvc *mocks.MockPayCalendarService
+	worker      *jobs.PayCalendarWorker
+}
+
+func TestPayCalendarWorkerSuite(t *testing.T) {
+	suite.Run(t, new(PayCalendarWorkerSuite))
+}
+
+func (s *PayCalendarWorkerSuite) SetupTest() {
+	s.calendarSvc = &mocks.MockPayCalendarService{}
+	s.worker = jobs.NewPayCalendarWorker(zap.NewNop(), s.calendarSvc)
+}
+
+func (s *PayCalendarWorkerSuite) TestWork_Success() {
+	called := false
+	s.calendarSvc.GenerateDuePeriodsFn = func(_ context.Context) ([]*payrollAggregate.PayPeriod, error) {
+		called = true
+		return []*payrollAggregate.PayPeriod{{Status: payrollAggregate.PayPeriodStatus_Generated, PaymentCount: 3}}, nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.PayCalendarArgs]{})
+
+	s.NoError(err)
+	s.True(called)
+}
+
+func (s *PayCalendarWorkerSuite) TestWork_GenerationFails_ReturnsError() {
+	s.calendarSvc.GenerateDuePeriodsFn = func(_ context.Context) ([]*payrollAggregate.PayPeriod, error) {
+		return nil, fmt.Errorf("database unavailable")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.PayCalendarArgs]{})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "database unavailable")
+}
+
+// ── Document Retention Worker ──────────────────────────────────────────
+
+type DocumentRetentionWorkerSuite struct {
+	suite.Suite
+	documentSvc *mocks.MockStudentDocumentService
+	worker      *jobs.DocumentRetentionWorker
+}
+
+func TestDocumentRetentionWorkerSuite(t *testing.T) {
+	suite.Run(t, new(DocumentRetentionWorkerSuite))
+}
+
+func (s *DocumentRetentionWorkerSuite) SetupTest() {
+	s.documentSvc = &mocks.MockStudentDocumentService{}
+	s.worker = jobs.NewDocumentRetentionWorker(zap.NewNop(), s.documentSvc)
+}
+
+func (s *DocumentRetentionWorkerSuite) TestWork_Success() {
+	called := false
+	s.documentSvc.PurgeExpiredFn = func(_ context.Context) (int, error) {
+		called = true
+		return 2, nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.DocumentRetentionArgs]{})
+
+	s.NoError(err)
+	s.True(called)
+}
+
+func (s *DocumentRetentionWorkerSuite) TestWork_PurgeFails_ReturnsError() {
+	s.documentSvc.PurgeExpiredFn = func(_ context.Context) (int, error) {
+		return 1, fmt.Errorf("storage unavailable")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.DocumentRetentionArgs]{})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "storage unavailable")
+}
+
+// ── Training Reminder Worker ───────────────────────────────────────────
+
+type TrainingReminderWorkerSuite struct {
+	suite.Suite
+	trainingSvc *mocks.MockTrainingService
+	worker      *jobs.TrainingReminderWorker
+}
+
+func TestTrainingReminderWorkerSuite(t *testing.T) {
+	suite.Run(t, new(TrainingReminderWorkerSuite))
+}
+
+func (s *TrainingReminderWorkerSuite) SetupTest() {
+	s.trainingSvc = &mocks.MockTrainingService{}
+	s.worker = jobs.NewTrainingReminderWorker(zap.NewNop(), s.trainingSvc)
+}
+
+func (s *TrainingReminderWorkerSuite) TestWork_Success() {
+	called := false
+	s.trainingSvc.SendExpiryRemindersFn = func(_ context.Context) (int, error) {
+		called = true
+		return 3, nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.TrainingReminderArgs]{})
+
+	s.NoError(err)
+	s.True(called)
+}
+
+func (s *TrainingReminderWorkerSuite) TestWork_SendFails_ReturnsError() {
+	s.trainingSvc.SendExpiryRemindersFn = func(_ context.Context) (int, error) {
+		return 0, fmt.Errorf("email provider down")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.TrainingReminderArgs]{})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "email provider down")
+}
+
+// ── Timesheet Reminder Worker ──────────────────────────────────────────
+
+type TimesheetReminderWorkerSuite struct {
+	suite.Suite
+	timesheetSvc *mocks.MockTimesheetService
+	worker       *jobs.TimesheetReminderWorker
+}
+
+func TestTimesheetReminderWorkerSuite(t *testing.T) {
+	suite.Run(t, new(TimesheetReminderWorkerSuite))
+}
+
+func (s *TimesheetReminderWorkerSuite) SetupTest() {
+	s.timesheetSvc = &mocks.MockTimesheetService{}
+	s.worker = jobs.NewTimesheetReminderWorker(zap.NewNop(), s.timesheetSvc)
+}
+
+func (s *TimesheetReminderWorkerSuite) TestWork_Success() {
+	called := false
+	s.timesheetSvc.SendDueRemindersFn = func(_ context.Context) (int, error) {
+		called = true
+		return 2, nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.TimesheetReminderArgs]{})
+
+	s.NoError(err)
+	s.True(called)
+}
+
+func (s *TimesheetReminderWorkerSuite) TestWork_SendFails_ReturnsError() {
+	s.timesheetSvc.SendDueRemindersFn = func(_ context.Context) (int, error) {
+		return 0, fmt.Errorf("email provider down")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.TimesheetReminderArgs]{})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "email provider down")
+}
+
+// ── Interview Invitation Worker ────────────────────────────────────────
+
+type InterviewInvitationWorkerSuite struct {
+	suite.Suite
+	reviewSvc *mocks.MockApplicationReviewService
+	worker    *jobs.InterviewInvitationWorker
+}
+
+func TestInterviewInvitationWorkerSuite(t *testing.T) {
+	suite.Run(t, new(InterviewInvitationWorkerSuite))
+}
+
+func (s *InterviewInvitationWorkerSuite) SetupTest() {
+	s.reviewSvc = &mocks.MockApplicationReviewService{}
+	s.worker = jobs.NewInterviewInvitationWorker(zap.NewNop(), s.reviewSvc)
+}
+
+func (s *InterviewInvitationWorkerSuite) TestWork_Success() {
+	slotID := uuid.New()
+	var sentFor uuid.UUID
+	s.reviewSvc.SendInterviewInvitationFn = func(_ context.Context, id uuid.UUID) error {
+		sentFor = id
+		return nil
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.InterviewInvitationArgs]{
+		Args: jobs.InterviewInvitationArgs{SlotID: slotID},
+	})
+
+	s.NoError(err)
+	s.Equal(slotID, sentFor)
+}
+
+func (s *InterviewInvitationWorkerSuite) TestWork_SendFails_ReturnsError() {
+	s.reviewSvc.SendInterviewInvitationFn = func(_ context.Context, _ uuid.UUID) error {
+		return fmt.Errorf("email service down")
+	}
+
+	err := s.worker.Work(context.Background(), &river.Job[jobs.InterviewInvitationArgs]{
+		Args: jobs.InterviewInvitationArgs{SlotID: uuid.New()},
+	})
+
+	s.Error(err, "should return error so River retries")
+	s.Contains(err.Error(), "email service down")
+}
@@ -153,6 +153,7 @@ class SchedulerConfig:
     solver_time_limit: Optional[int] = None
     solver_gap: Optional[float] = None
     log_solver_output: bool = False
+    weekly_cost_ceiling: Optional[float] = None  # Hard cap on sum(cost_per_hour x hours)
 
 
 @dataclass
@@ -300,6 +301,12 @@ def solve_helpdesk_schedule(
     ):
         problem += constraint
 
+    if config.weekly_cost_ceiling is not None:
+        problem += (
+            _build_weekly_cost(assistants, shifts, assignment_vars) <= config.weekly_cost_ceiling,
+            "weekly_cost_ceiling",
+        )
+
     if solver is None:
         solver = pulp.PULP_CBC_CMD(
             msg=int(config.log_solver_output),
@@ -457,6 +464,20 @@ def _build_objective_terms(
     return objective_terms
 
 
+def _build_weekly_cost(
+    assistants: Sequence[Assistant],
+    shifts: Sequence[Shift],
+    assignment_vars: Dict[Tuple[str, str], pulp.LpVariable],
+) -> pulp.LpAffineExpression:
+    cost_terms: List[pulp.LpAffineExpression] = []
+    for assistant in assistants:
+        for shift in shifts:
+            var = assignment_vars.get((assistant.id, shift.id))
+            if var is not None:
+                cost_terms.append(assistant.cost_per_hour * shift.duration_hours * var)
+    return pulp.lpSum(cost_terms)
+
+
 def _build_shift_constraints(
     assistants: Sequence[Assistant],
     shifts: Sequence[Shift],
@@ -152,3 +152,15 @@ class TestScheduleGenerate:
             assert val > 0
         for val in body["metadata"]["staff_shortfalls"].values():
             assert val > 0
+
+    def test_weekly_cost_ceiling_caps_assigned_hours(self, client, valid_payload):
+        # At 20/hour a ceiling of 20 leaves room for one of the two shifts
+        for assistant in valid_payload["assistants"]:
+            assistant["cost_per_hour"] = 20.0
+        valid_payload["scheduler_config"]["weekly_cost_ceiling"] = 20.0
+        resp = client.post("/api/v1/schedules/generate", json=valid_payload)
+        assert resp.status_code == 201
+
+        body = resp.json()
+        assert len(body["assignments"]) == 1
+        assert body["metadata"]["staff_shortfalls"]
@@ -0,0 +1,74 @@
+-- +goose Up
+-- Migration: add_time_off_requests
+-- Description: Dated time-off requests with admin approval. Approved time off is
+-- removed from availability during schedule generation and surfaced as conflicts
+-- against the active schedule.
+
+CREATE TABLE "schedule"."time_off_requests" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "start_date" date NOT NULL,
+    "end_date" date NOT NULL,                        -- inclusive
+    "reason" varchar(500) NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'pending', -- pending, approved, rejected, cancelled
+    "review_note" varchar(500),
+    "reviewed_by" uuid,
+    "reviewed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_time_off_requests_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id"),
+    CONSTRAINT "fk_time_off_requests_reviewed_by" FOREIGN KEY ("reviewed_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_time_off_requests_period" CHECK (end_date >= start_date),
+    CONSTRAINT "chk_time_off_requests_status"
+        CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled'))
+);
+
+COMMENT ON TABLE "schedule"."time_off_requests" IS 'Dated time-off requests submitted by students and reviewed by admins.';
+COMMENT ON COLUMN "schedule"."time_off_requests"."end_date" IS 'Last day of the time off (inclusive).';
+
+-- Indexes
+CREATE INDEX "time_off_requests_idx_student_id" ON "schedule"."time_off_requests" ("student_id");
+CREATE INDEX "time_off_requests_idx_approved_period"
+    ON "schedule"."time_off_requests" ("start_date", "end_date") WHERE status = 'approved';
+
+-- Triggers
+CREATE TRIGGER trg_time_off_requests_updated_at
+    BEFORE UPDATE ON "schedule"."time_off_requests"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Grants: students submit and read their own requests; reviews and
+-- cancellations are performed by the service under the internal role.
+GRANT SELECT, INSERT ON "schedule"."time_off_requests" TO authenticated;
+GRANT ALL ON "schedule"."time_off_requests" TO internal;
+
+-- RLS
+ALTER TABLE "schedule"."time_off_requests" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."time_off_requests" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_time_off_requests ON "schedule"."time_off_requests"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY time_off_requests_select ON "schedule"."time_off_requests"
+    FOR SELECT TO authenticated
+    USING (
+        user_has_role('admin') OR student_owns_record(student_id)
+    );
+
+CREATE POLICY time_off_requests_insert ON "schedule"."time_off_requests"
+    FOR INSERT TO authenticated
+    WITH CHECK (
+        user_has_role('admin') OR student_owns_record(student_id)
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS time_off_requests_insert ON "schedule"."time_off_requests";
+DROP POLICY IF EXISTS time_off_requests_select ON "schedule"."time_off_requests";
+DROP POLICY IF EXISTS internal_bypass_time_off_requests ON "schedule"."time_off_requests";
+REVOKE ALL ON "schedule"."time_off_requests" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_time_off_requests_updated_at ON "schedule"."time_off_requests";
+DROP INDEX IF EXISTS "schedule"."time_off_requests_idx_approved_period";
+DROP INDEX IF EXISTS "schedule"."time_off_requests_idx_student_id";
+DROP TABLE IF EXISTS "schedule"."time_off_requests";
@@ -0,0 +1,47 @@
+-- +goose Up
+-- Migration: add_kiosks
+-- Description: Device-bound kiosks that display a rotating, TOTP-style clock-in
+-- code derived from a per-kiosk secret. Codes are computed on demand and are
+-- never stored in clock_in_codes.
+
+CREATE TABLE "schedule"."kiosks" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "secret" bytea NOT NULL,                      -- AES-256-GCM encrypted HMAC secret
+    "token_hash" varchar(64) NOT NULL UNIQUE,     -- SHA-256 of the device token
+    "rotation_seconds" int NOT NULL DEFAULT 30,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "last_seen_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "created_by" uuid NOT NULL,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_kiosks_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_kiosks_rotation_seconds" CHECK (rotation_seconds BETWEEN 10 AND 300)
+);
+
+COMMENT ON COLUMN "schedule"."kiosks"."token_hash" IS 'Hex SHA-256 of the bearer token issued to the kiosk device. The token itself is shown once on creation.';
+
+CREATE INDEX "kiosks_idx_is_active" ON "schedule"."kiosks" ("is_active");
+
+CREATE TRIGGER trg_kiosks_updated_at
+    BEFORE UPDATE ON "schedule"."kiosks"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Kiosk secrets are only ever read by the service under the internal role.
+GRANT ALL ON "schedule"."kiosks" TO "internal";
+
+ALTER TABLE "schedule"."kiosks" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."kiosks" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY "internal_bypass_kiosks" ON "schedule"."kiosks"
+    FOR ALL TO "internal"
+    USING (true) WITH CHECK (true);
+
+-- +goose Down
+DROP POLICY IF EXISTS "internal_bypass_kiosks" ON "schedule"."kiosks";
+REVOKE ALL ON "schedule"."kiosks" FROM "internal";
+DROP TRIGGER IF EXISTS trg_kiosks_updated_at ON "schedule"."kiosks";
+DROP INDEX IF EXISTS "schedule"."kiosks_idx_is_active";
+DROP TABLE IF EXISTS "schedule"."kiosks";
@@ -0,0 +1,82 @@
+-- +goose Up
+-- Migration: add_locations
+-- Description: Help desk locations with their own geofence and timezone.
+-- Shift templates are linked to a location so clock-ins are checked against
+-- the desk the student is actually scheduled at.
+
+CREATE TABLE "schedule"."locations" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL UNIQUE,
+    "latitude" double precision NOT NULL,
+    "longitude" double precision NOT NULL,
+    "radius_meters" double precision NOT NULL DEFAULT 100,
+    "geofence" jsonb,                                     -- optional polygon: [[lon, lat], ...]
+    "timezone" varchar(64) NOT NULL DEFAULT 'America/Port_of_Spain',
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "chk_locations_latitude" CHECK (latitude BETWEEN -90 AND 90),
+    CONSTRAINT "chk_locations_longitude" CHECK (longitude BETWEEN -180 AND 180),
+    CONSTRAINT "chk_locations_radius_meters" CHECK (radius_meters > 0)
+);
+
+COMMENT ON COLUMN "schedule"."locations"."geofence" IS 'Optional polygon of [longitude, latitude] pairs. When set it replaces the radius check.';
+
+CREATE TRIGGER trg_locations_updated_at
+    BEFORE UPDATE ON "schedule"."locations"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+ALTER TABLE "schedule"."shift_templates"
+    ADD COLUMN "location_id" uuid,
+    ADD CONSTRAINT "fk_shift_templates_location" FOREIGN KEY ("location_id")
+        REFERENCES "schedule"."locations" ("id");
+
+CREATE INDEX "shift_templates_idx_location_id" ON "schedule"."shift_templates" ("location_id");
+
+ALTER TABLE "schedule"."time_logs"
+    ADD COLUMN "location_id" uuid,
+    ADD CONSTRAINT "fk_time_logs_location" FOREIGN KEY ("location_id")
+        REFERENCES "schedule"."locations" ("id");
+
+-- locations: admins can manage, everyone authenticated can view
+GRANT SELECT, INSERT, UPDATE, DELETE ON "schedule"."locations" TO authenticated;
+GRANT ALL ON "schedule"."locations" TO internal;
+
+ALTER TABLE "schedule"."locations" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."locations" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_locations ON "schedule"."locations"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY locations_select ON "schedule"."locations"
+    FOR SELECT TO authenticated
+    USING (TRUE);
+
+CREATE POLICY locations_insert ON "schedule"."locations"
+    FOR INSERT TO authenticated
+    WITH CHECK (user_has_role('admin'));
+
+CREATE POLICY locations_update ON "schedule"."locations"
+    FOR UPDATE TO authenticated
+    USING (user_has_role('admin'));
+
+CREATE POLICY locations_delete ON "schedule"."locations"
+    FOR DELETE TO authenticated
+    USING (user_has_role('admin'));
+
+-- +goose Down
+DROP POLICY IF EXISTS locations_delete ON "schedule"."locations";
+DROP POLICY IF EXISTS locations_update ON "schedule"."locations";
+DROP POLICY IF EXISTS locations_insert ON "schedule"."locations";
+DROP POLICY IF EXISTS locations_select ON "schedule"."locations";
+DROP POLICY IF EXISTS internal_bypass_locations ON "schedule"."locations";
+REVOKE ALL ON "schedule"."locations" FROM authenticated;
+REVOKE ALL ON "schedule"."locations" FROM internal;
+ALTER TABLE "schedule"."time_logs" DROP CONSTRAINT IF EXISTS "fk_time_logs_location";
+ALTER TABLE "schedule"."time_logs" DROP COLUMN IF EXISTS "location_id";
+DROP INDEX IF EXISTS "schedule"."shift_templates_idx_location_id";
+ALTER TABLE "schedule"."shift_templates" DROP CONSTRAINT IF EXISTS "fk_shift_templates_location";
+ALTER TABLE "schedule"."shift_templates" DROP COLUMN IF EXISTS "location_id";
+DROP TRIGGER IF EXISTS trg_locations_updated_at ON "schedule"."locations";
+DROP TABLE IF EXISTS "schedule"."locations";
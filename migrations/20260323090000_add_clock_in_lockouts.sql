@@ -0,0 +1,88 @@
+-- +goose Up
+-- Migration: add_clock_in_lockouts
+-- Description: Per-student tracking of clock-in attempts and progressive
+-- lockouts after repeated invalid codes. Complements the per-IP rate limit,
+-- which is ineffective behind campus NAT.
+
+CREATE TABLE "schedule"."clock_in_attempts" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "code" varchar(32) NOT NULL,                  -- normalised code as submitted
+    "succeeded" boolean NOT NULL DEFAULT false,
+    "attempted_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_clock_in_attempts_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id")
+);
+
+CREATE INDEX "clock_in_attempts_idx_student_attempted_at" ON "schedule"."clock_in_attempts" ("student_id", "attempted_at");
+CREATE INDEX "clock_in_attempts_idx_code_attempted_at" ON "schedule"."clock_in_attempts" ("code", "attempted_at") WHERE NOT succeeded;
+
+CREATE TABLE "schedule"."clock_in_lockouts" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "level" int NOT NULL,                         -- 1-based; higher levels lock for longer
+    "failed_attempts" int NOT NULL,
+    "locked_at" timestamptz NOT NULL,
+    "locked_until" timestamptz NOT NULL,
+    "cleared_at" timestamptz,
+    "cleared_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_clock_in_lockouts_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id"),
+    CONSTRAINT "fk_clock_in_lockouts_cleared_by" FOREIGN KEY ("cleared_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_clock_in_lockouts_level" CHECK (level >= 1),
+    CONSTRAINT "chk_clock_in_lockouts_period" CHECK (locked_until > locked_at)
+);
+
+CREATE INDEX "clock_in_lockouts_idx_student_locked_until" ON "schedule"."clock_in_lockouts" ("student_id", "locked_until");
+
+CREATE TRIGGER trg_clock_in_lockouts_updated_at
+    BEFORE UPDATE ON "schedule"."clock_in_lockouts"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Attempts and lockouts are written by the service under the internal role so
+-- a failed clock-in transaction cannot roll them back. Students may read their
+-- own lockouts; admins may read everything.
+GRANT SELECT ON "schedule"."clock_in_attempts" TO authenticated;
+GRANT SELECT ON "schedule"."clock_in_lockouts" TO authenticated;
+GRANT ALL ON "schedule"."clock_in_attempts" TO internal;
+GRANT ALL ON "schedule"."clock_in_lockouts" TO internal;
+
+ALTER TABLE "schedule"."clock_in_attempts" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."clock_in_attempts" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."clock_in_lockouts" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."clock_in_lockouts" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_clock_in_attempts ON "schedule"."clock_in_attempts"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY internal_bypass_clock_in_lockouts ON "schedule"."clock_in_lockouts"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY clock_in_attempts_select ON "schedule"."clock_in_attempts"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+
+CREATE POLICY clock_in_lockouts_select ON "schedule"."clock_in_lockouts"
+    FOR SELECT TO authenticated
+    USING (
+        user_has_role('admin') OR student_owns_record(student_id)
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS clock_in_lockouts_select ON "schedule"."clock_in_lockouts";
+DROP POLICY IF EXISTS clock_in_attempts_select ON "schedule"."clock_in_attempts";
+DROP POLICY IF EXISTS internal_bypass_clock_in_lockouts ON "schedule"."clock_in_lockouts";
+DROP POLICY IF EXISTS internal_bypass_clock_in_attempts ON "schedule"."clock_in_attempts";
+REVOKE ALL ON "schedule"."clock_in_lockouts" FROM authenticated, internal;
+REVOKE ALL ON "schedule"."clock_in_attempts" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_clock_in_lockouts_updated_at ON "schedule"."clock_in_lockouts";
+DROP INDEX IF EXISTS "schedule"."clock_in_lockouts_idx_student_locked_until";
+DROP TABLE IF EXISTS "schedule"."clock_in_lockouts";
+DROP INDEX IF EXISTS "schedule"."clock_in_attempts_idx_code_attempted_at";
+DROP INDEX IF EXISTS "schedule"."clock_in_attempts_idx_student_attempted_at";
+DROP TABLE IF EXISTS "schedule"."clock_in_attempts";
@@ -0,0 +1,74 @@
+-- +goose Up
+-- Migration: add_time_log_breaks
+-- Description: Breaks taken within an open time log. Breaks are unpaid and are
+-- subtracted from a log's duration when calculating paid hours.
+
+CREATE TABLE "schedule"."time_log_breaks" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "time_log_id" uuid NOT NULL,
+    "start_at" timestamptz NOT NULL,
+    "end_at" timestamptz,                         -- NULL while the break is in progress
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_time_log_breaks_time_log_id" FOREIGN KEY ("time_log_id")
+        REFERENCES "schedule"."time_logs" ("id") ON DELETE CASCADE,
+    CONSTRAINT "chk_time_log_breaks_period" CHECK (end_at IS NULL OR end_at >= start_at)
+);
+
+CREATE INDEX "time_log_breaks_idx_time_log_id" ON "schedule"."time_log_breaks" ("time_log_id");
+
+-- Only one break in progress per time log
+CREATE UNIQUE INDEX "time_log_breaks_unique_open_per_log"
+    ON "schedule"."time_log_breaks" ("time_log_id")
+    WHERE end_at IS NULL;
+
+GRANT SELECT, INSERT ON "schedule"."time_log_breaks" TO authenticated;
+GRANT UPDATE(end_at) ON "schedule"."time_log_breaks" TO authenticated;
+GRANT ALL ON "schedule"."time_log_breaks" TO internal;
+
+ALTER TABLE "schedule"."time_log_breaks" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."time_log_breaks" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_time_log_breaks ON "schedule"."time_log_breaks"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+-- Breaks inherit access from their parent time log
+CREATE POLICY time_log_breaks_select ON "schedule"."time_log_breaks"
+    FOR SELECT TO authenticated
+    USING (
+        EXISTS (
+            SELECT 1 FROM "schedule"."time_logs" tl
+            WHERE tl.id = time_log_id
+              AND (user_has_role('admin') OR student_owns_record(tl.student_id))
+        )
+    );
+
+CREATE POLICY time_log_breaks_insert ON "schedule"."time_log_breaks"
+    FOR INSERT TO authenticated
+    WITH CHECK (
+        EXISTS (
+            SELECT 1 FROM "schedule"."time_logs" tl
+            WHERE tl.id = time_log_id
+              AND (user_has_role('admin') OR student_owns_record(tl.student_id))
+        )
+    );
+
+CREATE POLICY time_log_breaks_update ON "schedule"."time_log_breaks"
+    FOR UPDATE TO authenticated
+    USING (
+        EXISTS (
+            SELECT 1 FROM "schedule"."time_logs" tl
+            WHERE tl.id = time_log_id
+              AND (user_has_role('admin') OR student_owns_record(tl.student_id))
+        )
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS time_log_breaks_update ON "schedule"."time_log_breaks";
+DROP POLICY IF EXISTS time_log_breaks_insert ON "schedule"."time_log_breaks";
+DROP POLICY IF EXISTS time_log_breaks_select ON "schedule"."time_log_breaks";
+DROP POLICY IF EXISTS internal_bypass_time_log_breaks ON "schedule"."time_log_breaks";
+REVOKE ALL ON "schedule"."time_log_breaks" FROM authenticated, internal;
+DROP INDEX IF EXISTS "schedule"."time_log_breaks_unique_open_per_log";
+DROP INDEX IF EXISTS "schedule"."time_log_breaks_idx_time_log_id";
+DROP TABLE IF EXISTS "schedule"."time_log_breaks";
@@ -0,0 +1,60 @@
+-- +goose Up
+-- Migration: add_timesheets
+-- Description: Weekly timesheets. A student submits each Monday-to-Sunday week
+-- for admin sign-off, and payroll only counts time logs from approved weeks.
+
+CREATE TABLE "schedule"."timesheets" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "week_start" date NOT NULL,                   -- always a Monday
+    "status" varchar(16) NOT NULL DEFAULT 'submitted',
+    "scheduled_hours" double precision NOT NULL DEFAULT 0,
+    "worked_hours" double precision NOT NULL DEFAULT 0,
+    "log_count" int NOT NULL DEFAULT 0,
+    "flagged_log_count" int NOT NULL DEFAULT 0,
+    "submitted_at" timestamptz NOT NULL,
+    "review_note" text,
+    "reviewed_by" uuid,
+    "reviewed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_timesheets_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id"),
+    CONSTRAINT "fk_timesheets_reviewed_by" FOREIGN KEY ("reviewed_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "uq_timesheets_student_week" UNIQUE ("student_id", "week_start"),
+    CONSTRAINT "chk_timesheets_status" CHECK (status IN ('submitted', 'approved', 'rejected')),
+    CONSTRAINT "chk_timesheets_week_start" CHECK (EXTRACT(ISODOW FROM week_start) = 1)
+);
+
+CREATE INDEX "timesheets_idx_week_start_status" ON "schedule"."timesheets" ("week_start", "status");
+
+CREATE TRIGGER trg_timesheets_updated_at
+    BEFORE UPDATE ON "schedule"."timesheets"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Submissions and reviews are written by the service under the internal role
+-- after it checks ownership. Students may read their own timesheets.
+GRANT SELECT ON "schedule"."timesheets" TO authenticated;
+GRANT ALL ON "schedule"."timesheets" TO internal;
+
+ALTER TABLE "schedule"."timesheets" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."timesheets" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_timesheets ON "schedule"."timesheets"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY timesheets_select ON "schedule"."timesheets"
+    FOR SELECT TO authenticated
+    USING (
+        user_has_role('admin') OR student_owns_record(student_id)
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS timesheets_select ON "schedule"."timesheets";
+DROP POLICY IF EXISTS internal_bypass_timesheets ON "schedule"."timesheets";
+REVOKE ALL ON "schedule"."timesheets" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_timesheets_updated_at ON "schedule"."timesheets";
+DROP INDEX IF EXISTS "schedule"."timesheets_idx_week_start_status";
+DROP TABLE IF EXISTS "schedule"."timesheets";
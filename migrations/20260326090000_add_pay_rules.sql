@@ -0,0 +1,94 @@
+-- +goose Up
+-- Migration: add_pay_rules
+-- Description: Configurable rules for converting attendance into paid time:
+-- rounding, early clock-in window, paying from the scheduled start, lateness
+-- grace and capping at the scheduled end. A rule applies to one location or
+-- to all locations (location_id NULL), optionally for a term bounded by
+-- effective_from/effective_to. Time logs record the shift window and rule
+-- used at clock-in and the paid minutes calculated at clock-out.
+
+CREATE TABLE "schedule"."pay_rules" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "location_id" uuid,                           -- NULL applies to every location
+    "effective_from" date,                        -- NULL is open-ended
+    "effective_to" date,                          -- inclusive; NULL is open-ended
+    "round_to_minutes" int NOT NULL DEFAULT 0,    -- 0 disables rounding
+    "early_clock_in_minutes" int NOT NULL DEFAULT 5,
+    "pay_from_scheduled_start" boolean NOT NULL DEFAULT false,
+    "late_grace_minutes" int NOT NULL DEFAULT 0,
+    "cap_at_scheduled_end" boolean NOT NULL DEFAULT false,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_pay_rules_location" FOREIGN KEY ("location_id")
+        REFERENCES "schedule"."locations" ("id"),
+    CONSTRAINT "chk_pay_rules_round_to_minutes" CHECK (round_to_minutes BETWEEN 0 AND 60),
+    CONSTRAINT "chk_pay_rules_early_clock_in_minutes" CHECK (early_clock_in_minutes BETWEEN 0 AND 120),
+    CONSTRAINT "chk_pay_rules_late_grace_minutes" CHECK (late_grace_minutes BETWEEN 0 AND 60),
+    CONSTRAINT "chk_pay_rules_effective_range" CHECK (
+        effective_from IS NULL OR effective_to IS NULL OR effective_to >= effective_from
+    )
+);
+
+CREATE INDEX "pay_rules_idx_location_id" ON "schedule"."pay_rules" ("location_id");
+
+CREATE TRIGGER trg_pay_rules_updated_at
+    BEFORE UPDATE ON "schedule"."pay_rules"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+ALTER TABLE "schedule"."time_logs"
+    ADD COLUMN "scheduled_start" timestamptz,
+    ADD COLUMN "scheduled_end" timestamptz,
+    ADD COLUMN "pay_rule_id" uuid,
+    ADD COLUMN "paid_minutes" double precision,   -- NULL while the log is open
+    ADD CONSTRAINT "fk_time_logs_pay_rule" FOREIGN KEY ("pay_rule_id")
+        REFERENCES "schedule"."pay_rules" ("id");
+
+COMMENT ON COLUMN "schedule"."time_logs"."paid_minutes" IS 'Minutes payable after breaks and pay rules are applied. Raw minutes are simply exit_at - entry_at.';
+
+-- Existing closed logs are paid exactly what they were paid before: raw time less breaks.
+UPDATE "schedule"."time_logs" tl
+SET "paid_minutes" = GREATEST(
+    EXTRACT(EPOCH FROM (tl.exit_at - tl.entry_at)) / 60.0
+    - COALESCE((
+        SELECT SUM(EXTRACT(EPOCH FROM (b.end_at - b.start_at)))
+        FROM "schedule"."time_log_breaks" b
+        WHERE b.time_log_id = tl.id AND b.end_at IS NOT NULL
+    ), 0) / 60.0,
+    0)
+WHERE tl.exit_at IS NOT NULL;
+
+-- Students set paid_minutes when clocking out
+GRANT UPDATE(paid_minutes) ON "schedule"."time_logs" TO authenticated;
+
+-- pay_rules: admins manage through the service, everyone authenticated can
+-- read them because clock-in and clock-out run as the student.
+GRANT SELECT ON "schedule"."pay_rules" TO authenticated;
+GRANT ALL ON "schedule"."pay_rules" TO internal;
+
+ALTER TABLE "schedule"."pay_rules" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."pay_rules" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_pay_rules ON "schedule"."pay_rules"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY pay_rules_select ON "schedule"."pay_rules"
+    FOR SELECT TO authenticated
+    USING (TRUE);
+
+-- +goose Down
+DROP POLICY IF EXISTS pay_rules_select ON "schedule"."pay_rules";
+DROP POLICY IF EXISTS internal_bypass_pay_rules ON "schedule"."pay_rules";
+REVOKE ALL ON "schedule"."pay_rules" FROM authenticated, internal;
+REVOKE UPDATE(paid_minutes) ON "schedule"."time_logs" FROM authenticated;
+ALTER TABLE "schedule"."time_logs" DROP CONSTRAINT IF EXISTS "fk_time_logs_pay_rule";
+ALTER TABLE "schedule"."time_logs"
+    DROP COLUMN IF EXISTS "paid_minutes",
+    DROP COLUMN IF EXISTS "pay_rule_id",
+    DROP COLUMN IF EXISTS "scheduled_end",
+    DROP COLUMN IF EXISTS "scheduled_start";
+DROP TRIGGER IF EXISTS trg_pay_rules_updated_at ON "schedule"."pay_rules";
+DROP INDEX IF EXISTS "schedule"."pay_rules_idx_location_id";
+DROP TABLE IF EXISTS "schedule"."pay_rules";
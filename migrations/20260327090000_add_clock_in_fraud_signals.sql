@@ -0,0 +1,41 @@
+-- +goose Up
+-- Migration: add_clock_in_fraud_signals
+-- Description: Record the device, network and GPS accuracy reported at
+-- clock-in so shared phones and spoofed locations can be detected, and store
+-- machine-readable reason codes alongside the human-readable flag_reason.
+-- flag_codes is a comma-separated list, e.g. 'outside_geofence,shared_device'.
+
+ALTER TABLE "schedule"."time_logs"
+    ADD COLUMN "device_fingerprint" varchar(128),
+    ADD COLUMN "ip_address" inet,
+    ADD COLUMN "user_agent" varchar(512),
+    ADD COLUMN "gps_accuracy_meters" double precision,
+    ADD COLUMN "flag_codes" varchar(255);
+
+COMMENT ON COLUMN "schedule"."time_logs"."gps_accuracy_meters" IS 'Accuracy radius reported by the device at clock-in. Spoofing tools often report exactly 0.';
+
+-- Shared-device lookups scan recent logs by fingerprint
+CREATE INDEX "time_logs_idx_device_fingerprint" ON "schedule"."time_logs" ("device_fingerprint", "entry_at")
+    WHERE "device_fingerprint" IS NOT NULL;
+
+-- Existing flags get the code matching the rule that raised them
+UPDATE "schedule"."time_logs"
+SET "flag_codes" = CASE
+    WHEN "flag_reason" LIKE 'Clocked in %' THEN 'outside_geofence'
+    WHEN "flag_reason" LIKE 'Worked % without a % break%' THEN 'missed_break'
+    ELSE 'manual'
+END
+WHERE "is_flagged";
+
+-- Students add codes when a log is flagged at clock-out
+GRANT UPDATE(flag_codes) ON "schedule"."time_logs" TO authenticated;
+
+-- +goose Down
+REVOKE UPDATE(flag_codes) ON "schedule"."time_logs" FROM authenticated;
+DROP INDEX IF EXISTS "schedule"."time_logs_idx_device_fingerprint";
+ALTER TABLE "schedule"."time_logs"
+    DROP COLUMN IF EXISTS "flag_codes",
+    DROP COLUMN IF EXISTS "gps_accuracy_meters",
+    DROP COLUMN IF EXISTS "user_agent",
+    DROP COLUMN IF EXISTS "ip_address",
+    DROP COLUMN IF EXISTS "device_fingerprint";
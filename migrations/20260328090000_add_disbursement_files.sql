@@ -0,0 +1,45 @@
+-- +goose Up
+-- Migration: add_disbursement_files
+-- Description: Bank disbursement files generated per bank and pay period.
+-- The file content holds decrypted account numbers, so it is stored encrypted
+-- (AES-256-GCM, same key as banking details) alongside its SHA-256 checksum,
+-- record count and control total for reconciliation with the bank.
+
+CREATE TABLE "auth"."disbursement_files" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "bank_name" varchar(100) NOT NULL,
+    "format" varchar(32) NOT NULL,
+    "filename" varchar(255) NOT NULL,
+    "period_start" date NOT NULL,
+    "period_end" date NOT NULL,
+    "record_count" int NOT NULL,
+    "control_total" numeric(12, 2) NOT NULL,
+    "checksum" char(64) NOT NULL,                  -- hex SHA-256 of the plaintext file
+    "content" bytea NOT NULL,
+    "generated_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_disbursement_files_generated_by" FOREIGN KEY ("generated_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_disbursement_files_period" CHECK (period_end > period_start),
+    CONSTRAINT "chk_disbursement_files_record_count" CHECK (record_count > 0),
+    CONSTRAINT "chk_disbursement_files_control_total" CHECK (control_total >= 0)
+);
+
+CREATE INDEX "disbursement_files_idx_period" ON "auth"."disbursement_files" ("period_start", "period_end");
+
+-- Files are generated and read by the service under the internal role; no
+-- other role may see the content.
+GRANT ALL ON "auth"."disbursement_files" TO internal;
+
+ALTER TABLE "auth"."disbursement_files" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."disbursement_files" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_disbursement_files ON "auth"."disbursement_files"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+-- +goose Down
+DROP POLICY IF EXISTS internal_bypass_disbursement_files ON "auth"."disbursement_files";
+REVOKE ALL ON "auth"."disbursement_files" FROM internal;
+DROP INDEX IF EXISTS "auth"."disbursement_files_idx_period";
+DROP TABLE IF EXISTS "auth"."disbursement_files";
@@ -0,0 +1,84 @@
+-- +goose Up
+-- Migration: add_payment_adjustments
+-- Description: Adjustment line items on payments (bonuses, stipends, back pay,
+-- deductions, overpayment recovery). Gross pay is hours x rate plus credits;
+-- net pay is gross less deductions. An adjustment that targets an already
+-- processed payment is carried forward to the student's next unprocessed
+-- payment, and waits with no payment_id until one exists.
+
+ALTER TABLE "auth"."payments"
+    ADD COLUMN "deductions_amount" numeric(8, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN "net_amount" numeric(8, 2);
+
+UPDATE "auth"."payments" SET "net_amount" = "gross_amount";
+
+ALTER TABLE "auth"."payments"
+    ALTER COLUMN "net_amount" SET NOT NULL,
+    ADD CONSTRAINT "chk_payments_deductions_amount" CHECK (deductions_amount >= 0),
+    ADD CONSTRAINT "chk_payments_net_amount" CHECK (net_amount >= 0);
+
+CREATE TABLE "auth"."payment_adjustments" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "payment_id" uuid,                             -- payment it is applied to; NULL while waiting to carry forward
+    "original_payment_id" uuid,                    -- processed payment it was raised against, when carried forward
+    "original_period_end" date,
+    "type" varchar(32) NOT NULL,
+    "amount" numeric(8, 2) NOT NULL,               -- always positive; the type decides credit or deduction
+    "reason" text NOT NULL,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_payment_adjustments_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id"),
+    CONSTRAINT "fk_payment_adjustments_payment_id" FOREIGN KEY ("payment_id")
+        REFERENCES "auth"."payments" ("payment_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_payment_adjustments_original_payment_id" FOREIGN KEY ("original_payment_id")
+        REFERENCES "auth"."payments" ("payment_id"),
+    CONSTRAINT "fk_payment_adjustments_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_payment_adjustments_type" CHECK (type IN ('bonus', 'stipend', 'back_pay', 'deduction', 'overpayment_recovery')),
+    CONSTRAINT "chk_payment_adjustments_amount" CHECK (amount > 0),
+    CONSTRAINT "chk_payment_adjustments_target" CHECK (payment_id IS NOT NULL OR original_payment_id IS NOT NULL)
+);
+
+CREATE INDEX "payment_adjustments_idx_payment_id" ON "auth"."payment_adjustments" ("payment_id");
+CREATE INDEX "payment_adjustments_idx_pending" ON "auth"."payment_adjustments" ("student_id")
+    WHERE payment_id IS NULL;
+
+CREATE TRIGGER trg_payment_adjustments_updated_at
+    BEFORE UPDATE ON "auth"."payment_adjustments"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Adjustments are written by the service under the internal role. Students may
+-- read their own so payslips can list them.
+GRANT SELECT ON "auth"."payment_adjustments" TO authenticated;
+GRANT ALL ON "auth"."payment_adjustments" TO internal;
+
+ALTER TABLE "auth"."payment_adjustments" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."payment_adjustments" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_payment_adjustments ON "auth"."payment_adjustments"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY payment_adjustments_select ON "auth"."payment_adjustments"
+    FOR SELECT TO authenticated
+    USING (
+        user_has_role('admin') OR student_owns_record(student_id)
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS payment_adjustments_select ON "auth"."payment_adjustments";
+DROP POLICY IF EXISTS internal_bypass_payment_adjustments ON "auth"."payment_adjustments";
+REVOKE ALL ON "auth"."payment_adjustments" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_payment_adjustments_updated_at ON "auth"."payment_adjustments";
+DROP INDEX IF EXISTS "auth"."payment_adjustments_idx_pending";
+DROP INDEX IF EXISTS "auth"."payment_adjustments_idx_payment_id";
+DROP TABLE IF EXISTS "auth"."payment_adjustments";
+
+ALTER TABLE "auth"."payments"
+    DROP CONSTRAINT IF EXISTS "chk_payments_net_amount",
+    DROP CONSTRAINT IF EXISTS "chk_payments_deductions_amount",
+    DROP COLUMN IF EXISTS "net_amount",
+    DROP COLUMN IF EXISTS "deductions_amount";
@@ -0,0 +1,46 @@
+-- +goose Up
+-- Migration: add_budgets
+-- Description: Help desk budgets per term or calendar month. A schedule's
+-- projected cost (assigned hours x hourly rate x weeks) is compared against
+-- the budget and the payroll generated so far, and schedule generation can
+-- be given a budget so the solver respects a weekly cost ceiling.
+
+CREATE TABLE "schedule"."budgets" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "period_type" varchar(16) NOT NULL,
+    "period_start" date NOT NULL,
+    "period_end" date NOT NULL,                   -- inclusive
+    "amount" numeric(10, 2) NOT NULL,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_budgets_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_budgets_period_type" CHECK (period_type IN ('term', 'month')),
+    CONSTRAINT "chk_budgets_period" CHECK (period_end >= period_start),
+    CONSTRAINT "chk_budgets_amount" CHECK (amount > 0)
+);
+
+CREATE INDEX "budgets_idx_period" ON "schedule"."budgets" ("period_start", "period_end");
+
+CREATE TRIGGER trg_budgets_updated_at
+    BEFORE UPDATE ON "schedule"."budgets"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Budgets are admin-only and managed through the service as internal.
+GRANT ALL ON "schedule"."budgets" TO internal;
+
+ALTER TABLE "schedule"."budgets" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."budgets" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_budgets ON "schedule"."budgets"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+-- +goose Down
+DROP POLICY IF EXISTS internal_bypass_budgets ON "schedule"."budgets";
+REVOKE ALL ON "schedule"."budgets" FROM internal;
+DROP TRIGGER IF EXISTS trg_budgets_updated_at ON "schedule"."budgets";
+DROP INDEX IF EXISTS "schedule"."budgets_idx_period";
+DROP TABLE IF EXISTS "schedule"."budgets";
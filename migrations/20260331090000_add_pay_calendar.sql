@@ -0,0 +1,85 @@
+-- +goose Up
+-- Migration: add_pay_calendar
+-- Description: Pay calendar for automatic payroll periods. At most one calendar
+-- is active; its frequency and anchor date define consecutive, non-overlapping
+-- periods. A periodic job generates draft payments for each period once it has
+-- closed and records the run in pay_periods, refusing any period that overlaps
+-- payments already generated for a different range.
+
+CREATE TABLE "auth"."pay_calendars" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "frequency" varchar(20) NOT NULL,
+    "anchor_date" date NOT NULL,                   -- start of the first period
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_pay_calendars_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_pay_calendars_frequency" CHECK (frequency IN ('weekly', 'biweekly', 'semimonthly', 'monthly'))
+);
+
+CREATE UNIQUE INDEX "pay_calendars_idx_active"
+    ON "auth"."pay_calendars" ("is_active") WHERE is_active = true;
+
+CREATE TRIGGER trg_pay_calendars_updated_at
+    BEFORE UPDATE ON "auth"."pay_calendars"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE "auth"."pay_periods" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "calendar_id" uuid,
+    "period_start" date NOT NULL,
+    "period_end" date NOT NULL,                    -- inclusive, like payment periods
+    "status" varchar(20) NOT NULL,
+    "payment_count" int NOT NULL DEFAULT 0,
+    "gross_total" numeric(12, 2) NOT NULL DEFAULT 0,
+    "note" text,                                   -- why a period was blocked
+    "notified_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_pay_periods_calendar_id" FOREIGN KEY ("calendar_id")
+        REFERENCES "auth"."pay_calendars" ("id") ON DELETE SET NULL,
+    CONSTRAINT "chk_pay_periods_status" CHECK (status IN ('generated', 'blocked')),
+    CONSTRAINT "chk_pay_periods_range" CHECK (period_end > period_start),
+    -- Generated periods never overlap; blocked ones record the overlap.
+    CONSTRAINT "excl_pay_periods_overlap" EXCLUDE USING gist (
+        daterange(period_start, period_end, '[]') WITH &&
+    ) WHERE (status = 'generated')
+);
+
+CREATE INDEX "pay_periods_idx_period_end" ON "auth"."pay_periods" ("period_end" DESC);
+
+-- Calendars and periods are written by the service under the internal role.
+GRANT SELECT ON "auth"."pay_calendars", "auth"."pay_periods" TO authenticated;
+GRANT ALL ON "auth"."pay_calendars", "auth"."pay_periods" TO internal;
+
+ALTER TABLE "auth"."pay_calendars" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."pay_calendars" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."pay_periods" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."pay_periods" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_pay_calendars ON "auth"."pay_calendars"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_pay_periods ON "auth"."pay_periods"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY pay_calendars_select ON "auth"."pay_calendars"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY pay_periods_select ON "auth"."pay_periods"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+
+-- +goose Down
+DROP POLICY IF EXISTS pay_periods_select ON "auth"."pay_periods";
+DROP POLICY IF EXISTS pay_calendars_select ON "auth"."pay_calendars";
+DROP POLICY IF EXISTS internal_bypass_pay_periods ON "auth"."pay_periods";
+DROP POLICY IF EXISTS internal_bypass_pay_calendars ON "auth"."pay_calendars";
+REVOKE ALL ON "auth"."pay_periods", "auth"."pay_calendars" FROM authenticated, internal;
+DROP INDEX IF EXISTS "auth"."pay_periods_idx_period_end";
+DROP TABLE IF EXISTS "auth"."pay_periods";
+DROP TRIGGER IF EXISTS trg_pay_calendars_updated_at ON "auth"."pay_calendars";
+DROP INDEX IF EXISTS "auth"."pay_calendars_idx_active";
+DROP TABLE IF EXISTS "auth"."pay_calendars";
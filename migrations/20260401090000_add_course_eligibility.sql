@@ -0,0 +1,81 @@
+-- +goose Up
+-- Migration: add_course_eligibility
+-- Description: Rules deciding which transcript courses qualify a student to
+-- tutor them. A rule sets the minimum grade for one course or for every course
+-- at a level (the first digit of the course number); course rules take
+-- precedence over level rules. Admins can override eligibility for a student
+-- and course either way, including courses not on the transcript.
+
+CREATE TABLE "auth"."course_eligibility_rules" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "course_code" varchar(20),                     -- normalised, e.g. COMP1601
+    "course_level" int,                            -- 1 for COMP1601, 2 for COMP2603
+    "min_grade" varchar(3) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "chk_course_eligibility_rules_target" CHECK ((course_code IS NULL) <> (course_level IS NULL)),
+    CONSTRAINT "chk_course_eligibility_rules_level" CHECK (course_level BETWEEN 1 AND 9)
+);
+
+CREATE UNIQUE INDEX "course_eligibility_rules_idx_course_code"
+    ON "auth"."course_eligibility_rules" ("course_code") WHERE course_code IS NOT NULL;
+CREATE UNIQUE INDEX "course_eligibility_rules_idx_course_level"
+    ON "auth"."course_eligibility_rules" ("course_level") WHERE course_level IS NOT NULL;
+
+CREATE TRIGGER trg_course_eligibility_rules_updated_at
+    BEFORE UPDATE ON "auth"."course_eligibility_rules"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE "auth"."course_eligibility_overrides" (
+    "student_id" int NOT NULL,
+    "course_code" varchar(20) NOT NULL,
+    "eligible" boolean NOT NULL,
+    "reason" text NOT NULL,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("student_id", "course_code"),
+    CONSTRAINT "fk_course_eligibility_overrides_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_course_eligibility_overrides_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id")
+);
+
+CREATE TRIGGER trg_course_eligibility_overrides_updated_at
+    BEFORE UPDATE ON "auth"."course_eligibility_overrides"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Rules and overrides are written by the service under the internal role.
+GRANT SELECT ON "auth"."course_eligibility_rules", "auth"."course_eligibility_overrides" TO authenticated;
+GRANT ALL ON "auth"."course_eligibility_rules", "auth"."course_eligibility_overrides" TO internal;
+
+ALTER TABLE "auth"."course_eligibility_rules" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."course_eligibility_rules" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."course_eligibility_overrides" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."course_eligibility_overrides" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_course_eligibility_rules ON "auth"."course_eligibility_rules"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_course_eligibility_overrides ON "auth"."course_eligibility_overrides"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY course_eligibility_rules_select ON "auth"."course_eligibility_rules"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY course_eligibility_overrides_select ON "auth"."course_eligibility_overrides"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+
+-- +goose Down
+DROP POLICY IF EXISTS course_eligibility_overrides_select ON "auth"."course_eligibility_overrides";
+DROP POLICY IF EXISTS course_eligibility_rules_select ON "auth"."course_eligibility_rules";
+DROP POLICY IF EXISTS internal_bypass_course_eligibility_overrides ON "auth"."course_eligibility_overrides";
+DROP POLICY IF EXISTS internal_bypass_course_eligibility_rules ON "auth"."course_eligibility_rules";
+REVOKE ALL ON "auth"."course_eligibility_overrides", "auth"."course_eligibility_rules" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_course_eligibility_overrides_updated_at ON "auth"."course_eligibility_overrides";
+DROP TABLE IF EXISTS "auth"."course_eligibility_overrides";
+DROP TRIGGER IF EXISTS trg_course_eligibility_rules_updated_at ON "auth"."course_eligibility_rules";
+DROP INDEX IF EXISTS "auth"."course_eligibility_rules_idx_course_level";
+DROP INDEX IF EXISTS "auth"."course_eligibility_rules_idx_course_code";
+DROP TABLE IF EXISTS "auth"."course_eligibility_rules";
@@ -0,0 +1,67 @@
+-- +goose Up
+-- Migration: add_courses
+-- Description: Course catalogue. Shift template course demands must name an
+-- active catalogue course, and transcript course codes are normalised to
+-- catalogue codes when a student's transcript is saved.
+
+CREATE TABLE "schedule"."courses" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "code" varchar(20) NOT NULL UNIQUE,                   -- normalised: upper case, no spaces
+    "title" varchar(255) NOT NULL,
+    "department" varchar(100) NOT NULL DEFAULT '',
+    "level" integer NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT true,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "chk_courses_code" CHECK (code = upper(code) AND code !~ '\s'),
+    CONSTRAINT "chk_courses_level" CHECK (level BETWEEN 1 AND 9)
+);
+
+CREATE TRIGGER trg_courses_updated_at
+    BEFORE UPDATE ON "schedule"."courses"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Backfill courses already used by shift templates so existing demands stay
+-- valid. Titles default to the code until the catalogue is imported.
+INSERT INTO "schedule"."courses" ("code", "title", "level")
+SELECT DISTINCT
+    upper(regexp_replace(d->>'course_code', '\s', '', 'g')),
+    upper(regexp_replace(d->>'course_code', '\s', '', 'g')),
+    substring(regexp_replace(d->>'course_code', '\s', '', 'g') FROM '[1-9]')::integer
+FROM "schedule"."shift_templates" t,
+    jsonb_array_elements(t.course_demands) AS d
+WHERE substring(regexp_replace(d->>'course_code', '\s', '', 'g') FROM '[0-9]') ~ '[1-9]'
+ON CONFLICT ("code") DO NOTHING;
+
+-- courses: admins can manage, everyone authenticated can view
+GRANT SELECT, INSERT, UPDATE ON "schedule"."courses" TO authenticated;
+GRANT ALL ON "schedule"."courses" TO internal;
+
+ALTER TABLE "schedule"."courses" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."courses" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_courses ON "schedule"."courses"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY courses_select ON "schedule"."courses"
+    FOR SELECT TO authenticated
+    USING (TRUE);
+
+CREATE POLICY courses_insert ON "schedule"."courses"
+    FOR INSERT TO authenticated
+    WITH CHECK (user_has_role('admin'));
+
+CREATE POLICY courses_update ON "schedule"."courses"
+    FOR UPDATE TO authenticated
+    USING (user_has_role('admin'));
+
+-- +goose Down
+DROP POLICY IF EXISTS courses_update ON "schedule"."courses";
+DROP POLICY IF EXISTS courses_insert ON "schedule"."courses";
+DROP POLICY IF EXISTS courses_select ON "schedule"."courses";
+DROP POLICY IF EXISTS internal_bypass_courses ON "schedule"."courses";
+REVOKE ALL ON "schedule"."courses" FROM authenticated;
+REVOKE ALL ON "schedule"."courses" FROM internal;
+DROP TRIGGER IF EXISTS trg_courses_updated_at ON "schedule"."courses";
+DROP TABLE IF EXISTS "schedule"."courses";
@@ -0,0 +1,262 @@
+-- +goose Up
+-- Migration: add_application_reviews
+-- Description: Review pipeline for student applications. Admins configure the
+-- stages an application moves through (screening, interview, decision) and
+-- the rubric reviewers score applicants on. Every stage move and the final
+-- accept/reject decision is kept in the stage history. Interview slots are
+-- created by admins and booked for one applicant each.
+
+CREATE TABLE "auth"."review_stages" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(50) NOT NULL,
+    "position" int NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT TRUE,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uq_review_stages_name" UNIQUE ("name"),
+    CONSTRAINT "chk_review_stages_position" CHECK (position >= 1)
+);
+
+CREATE TRIGGER trg_review_stages_updated_at
+    BEFORE UPDATE ON "auth"."review_stages"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+INSERT INTO "auth"."review_stages" ("name", "position") VALUES
+    ('Screening', 1),
+    ('Interview', 2),
+    ('Decision', 3);
+
+CREATE TABLE "auth"."review_criteria" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "description" text NOT NULL DEFAULT '',
+    "max_score" int NOT NULL,
+    "position" int NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT TRUE,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uq_review_criteria_name" UNIQUE ("name"),
+    CONSTRAINT "chk_review_criteria_max_score" CHECK (max_score BETWEEN 1 AND 10),
+    CONSTRAINT "chk_review_criteria_position" CHECK (position >= 1)
+);
+
+CREATE TRIGGER trg_review_criteria_updated_at
+    BEFORE UPDATE ON "auth"."review_criteria"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+INSERT INTO "auth"."review_criteria" ("name", "description", "max_score", "position") VALUES
+    ('Technical knowledge', 'Command of the courses the applicant would tutor', 5, 1),
+    ('Communication', 'Ability to explain concepts clearly to other students', 5, 2),
+    ('Professionalism', 'Reliability, punctuality and conduct', 5, 3);
+
+-- The current stage of an application is the target of its latest stage move.
+-- Decision rows have no target stage and record the accept/reject outcome.
+CREATE TABLE "auth"."application_stage_history" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "from_stage_id" uuid,
+    "to_stage_id" uuid,
+    "decision" varchar(10),
+    "note" text NOT NULL DEFAULT '',
+    "changed_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_application_stage_history_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_stage_history_from_stage_id" FOREIGN KEY ("from_stage_id")
+        REFERENCES "auth"."review_stages" ("id"),
+    CONSTRAINT "fk_application_stage_history_to_stage_id" FOREIGN KEY ("to_stage_id")
+        REFERENCES "auth"."review_stages" ("id"),
+    CONSTRAINT "fk_application_stage_history_changed_by" FOREIGN KEY ("changed_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_application_stage_history_decision" CHECK (decision IN ('accepted', 'rejected')),
+    CONSTRAINT "chk_application_stage_history_target" CHECK ((to_stage_id IS NULL) <> (decision IS NULL))
+);
+
+CREATE INDEX "application_stage_history_idx_student_id"
+    ON "auth"."application_stage_history" ("student_id", "created_at");
+
+CREATE TABLE "auth"."application_reviewers" (
+    "student_id" int NOT NULL,
+    "reviewer_id" uuid NOT NULL,
+    "assigned_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("student_id", "reviewer_id"),
+    CONSTRAINT "fk_application_reviewers_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_reviewers_reviewer_id" FOREIGN KEY ("reviewer_id")
+        REFERENCES "auth"."users" ("user_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_reviewers_assigned_by" FOREIGN KEY ("assigned_by")
+        REFERENCES "auth"."users" ("user_id")
+);
+
+CREATE TABLE "auth"."application_notes" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "stage_id" uuid,
+    "author_id" uuid,
+    "body" text NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_application_notes_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_notes_stage_id" FOREIGN KEY ("stage_id")
+        REFERENCES "auth"."review_stages" ("id"),
+    CONSTRAINT "fk_application_notes_author_id" FOREIGN KEY ("author_id")
+        REFERENCES "auth"."users" ("user_id")
+);
+
+CREATE INDEX "application_notes_idx_student_id"
+    ON "auth"."application_notes" ("student_id", "created_at");
+
+-- One score per reviewer per criterion; rescoring replaces it.
+CREATE TABLE "auth"."application_scores" (
+    "student_id" int NOT NULL,
+    "criterion_id" uuid NOT NULL,
+    "reviewer_id" uuid NOT NULL,
+    "score" int NOT NULL,
+    "comment" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("student_id", "criterion_id", "reviewer_id"),
+    CONSTRAINT "fk_application_scores_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_scores_criterion_id" FOREIGN KEY ("criterion_id")
+        REFERENCES "auth"."review_criteria" ("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_application_scores_reviewer_id" FOREIGN KEY ("reviewer_id")
+        REFERENCES "auth"."users" ("user_id") ON DELETE CASCADE,
+    CONSTRAINT "chk_application_scores_score" CHECK (score >= 0)
+);
+
+CREATE TRIGGER trg_application_scores_updated_at
+    BEFORE UPDATE ON "auth"."application_scores"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE "auth"."interview_slots" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "starts_at" timestamptz NOT NULL,
+    "ends_at" timestamptz NOT NULL,
+    "location" varchar(255) NOT NULL,
+    "interviewer_id" uuid,
+    "student_id" int,
+    "booked_at" timestamptz,
+    "invited_at" timestamptz,
+    "created_by" uuid,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_interview_slots_interviewer_id" FOREIGN KEY ("interviewer_id")
+        REFERENCES "auth"."users" ("user_id") ON DELETE SET NULL,
+    CONSTRAINT "fk_interview_slots_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE SET NULL,
+    CONSTRAINT "fk_interview_slots_created_by" FOREIGN KEY ("created_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_interview_slots_times" CHECK (ends_at > starts_at),
+    CONSTRAINT "chk_interview_slots_booking" CHECK ((student_id IS NULL) = (booked_at IS NULL))
+);
+
+-- An applicant holds at most one interview slot at a time.
+CREATE UNIQUE INDEX "interview_slots_idx_student_id"
+    ON "auth"."interview_slots" ("student_id") WHERE student_id IS NOT NULL;
+CREATE INDEX "interview_slots_idx_starts_at"
+    ON "auth"."interview_slots" ("starts_at");
+
+CREATE TRIGGER trg_interview_slots_updated_at
+    BEFORE UPDATE ON "auth"."interview_slots"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- All review data is written by the service under the internal role.
+GRANT SELECT ON "auth"."review_stages", "auth"."review_criteria", "auth"."application_stage_history",
+    "auth"."application_reviewers", "auth"."application_notes", "auth"."application_scores",
+    "auth"."interview_slots" TO authenticated;
+GRANT ALL ON "auth"."review_stages", "auth"."review_criteria", "auth"."application_stage_history",
+    "auth"."application_reviewers", "auth"."application_notes", "auth"."application_scores",
+    "auth"."interview_slots" TO internal;
+
+ALTER TABLE "auth"."review_stages" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."review_stages" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."review_criteria" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."review_criteria" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_stage_history" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_stage_history" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_reviewers" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_reviewers" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_notes" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_notes" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_scores" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."application_scores" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."interview_slots" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."interview_slots" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_review_stages ON "auth"."review_stages"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_review_criteria ON "auth"."review_criteria"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_application_stage_history ON "auth"."application_stage_history"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_application_reviewers ON "auth"."application_reviewers"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_application_notes ON "auth"."application_notes"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_application_scores ON "auth"."application_scores"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+CREATE POLICY internal_bypass_interview_slots ON "auth"."interview_slots"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY review_stages_select ON "auth"."review_stages"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY review_criteria_select ON "auth"."review_criteria"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY application_stage_history_select ON "auth"."application_stage_history"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY application_reviewers_select ON "auth"."application_reviewers"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY application_notes_select ON "auth"."application_notes"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY application_scores_select ON "auth"."application_scores"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+CREATE POLICY interview_slots_select ON "auth"."interview_slots"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin'));
+
+-- +goose Down
+DROP POLICY IF EXISTS interview_slots_select ON "auth"."interview_slots";
+DROP POLICY IF EXISTS application_scores_select ON "auth"."application_scores";
+DROP POLICY IF EXISTS application_notes_select ON "auth"."application_notes";
+DROP POLICY IF EXISTS application_reviewers_select ON "auth"."application_reviewers";
+DROP POLICY IF EXISTS application_stage_history_select ON "auth"."application_stage_history";
+DROP POLICY IF EXISTS review_criteria_select ON "auth"."review_criteria";
+DROP POLICY IF EXISTS review_stages_select ON "auth"."review_stages";
+DROP POLICY IF EXISTS internal_bypass_interview_slots ON "auth"."interview_slots";
+DROP POLICY IF EXISTS internal_bypass_application_scores ON "auth"."application_scores";
+DROP POLICY IF EXISTS internal_bypass_application_notes ON "auth"."application_notes";
+DROP POLICY IF EXISTS internal_bypass_application_reviewers ON "auth"."application_reviewers";
+DROP POLICY IF EXISTS internal_bypass_application_stage_history ON "auth"."application_stage_history";
+DROP POLICY IF EXISTS internal_bypass_review_criteria ON "auth"."review_criteria";
+DROP POLICY IF EXISTS internal_bypass_review_stages ON "auth"."review_stages";
+REVOKE ALL ON "auth"."interview_slots", "auth"."application_scores", "auth"."application_notes",
+    "auth"."application_reviewers", "auth"."application_stage_history", "auth"."review_criteria",
+    "auth"."review_stages" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_interview_slots_updated_at ON "auth"."interview_slots";
+DROP INDEX IF EXISTS "auth"."interview_slots_idx_starts_at";
+DROP INDEX IF EXISTS "auth"."interview_slots_idx_student_id";
+DROP TABLE IF EXISTS "auth"."interview_slots";
+DROP TRIGGER IF EXISTS trg_application_scores_updated_at ON "auth"."application_scores";
+DROP TABLE IF EXISTS "auth"."application_scores";
+DROP INDEX IF EXISTS "auth"."application_notes_idx_student_id";
+DROP TABLE IF EXISTS "auth"."application_notes";
+DROP TABLE IF EXISTS "auth"."application_reviewers";
+DROP INDEX IF EXISTS "auth"."application_stage_history_idx_student_id";
+DROP TABLE IF EXISTS "auth"."application_stage_history";
+DROP TRIGGER IF EXISTS trg_review_criteria_updated_at ON "auth"."review_criteria";
+DROP TABLE IF EXISTS "auth"."review_criteria";
+DROP TRIGGER IF EXISTS trg_review_stages_updated_at ON "auth"."review_stages";
+DROP TABLE IF EXISTS "auth"."review_stages";
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Migration: add_student_search_indexes
+-- Description: Indexes for the student directory query. Search matches
+-- name, email and student ID by substring, so it uses a trigram index; the
+-- sort indexes end in student_id to serve keyset (cursor) pagination. The
+-- expressions must match those in the student repository exactly.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX "students_idx_search" ON "auth"."students"
+    USING gin ((first_name || ' ' || last_name || ' ' || email_address || ' ' || student_id::text) gin_trgm_ops);
+CREATE INDEX "students_idx_programme" ON "auth"."students"
+    USING gin ((transcript_metadata->>'current_programme') gin_trgm_ops);
+CREATE INDEX "students_idx_year" ON "auth"."students" ((transcript_metadata->>'current_year'));
+CREATE INDEX "students_idx_availability" ON "auth"."students" USING gin (availability jsonb_path_ops);
+
+CREATE INDEX "students_idx_created_at" ON "auth"."students" ("created_at", "student_id");
+CREATE INDEX "students_idx_name" ON "auth"."students" ((lower(last_name || ', ' || first_name)), "student_id");
+CREATE INDEX "students_idx_gpa" ON "auth"."students"
+    ((COALESCE((transcript_metadata->>'overall_gpa')::numeric, -1)), "student_id");
+
+-- +goose Down
+DROP INDEX IF EXISTS "auth"."students_idx_gpa";
+DROP INDEX IF EXISTS "auth"."students_idx_name";
+DROP INDEX IF EXISTS "auth"."students_idx_created_at";
+DROP INDEX IF EXISTS "auth"."students_idx_availability";
+DROP INDEX IF EXISTS "auth"."students_idx_year";
+DROP INDEX IF EXISTS "auth"."students_idx_programme";
+DROP INDEX IF EXISTS "auth"."students_idx_search";
@@ -0,0 +1,73 @@
+-- +goose Up
+-- Migration: add_student_documents
+-- Description: Files kept for a student: transcripts, IDs and work permits.
+-- The file itself is encrypted (AES-256-GCM) in the document store; this
+-- table holds its metadata and the key it is stored under. A transcript
+-- uploaded for extraction before the applicant exists has no student_id until
+-- the application links it. Every document has a retain_until date; the
+-- retention job deletes the stored file after it and sets purged_at, keeping
+-- the metadata.
+
+CREATE TABLE "auth"."student_documents" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int,
+    "document_type" varchar(20) NOT NULL,
+    "filename" varchar(255) NOT NULL,
+    "content_type" varchar(100) NOT NULL,
+    "size_bytes" bigint NOT NULL,
+    "checksum" char(64) NOT NULL,                  -- hex SHA-256 of the plaintext file
+    "storage_key" varchar(255) NOT NULL UNIQUE,
+    "uploaded_by" uuid,
+    "retain_until" timestamptz NOT NULL,
+    "purged_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_student_documents_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_student_documents_uploaded_by" FOREIGN KEY ("uploaded_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_student_documents_type" CHECK (document_type IN ('transcript', 'id', 'work_permit')),
+    CONSTRAINT "chk_student_documents_size" CHECK (size_bytes > 0)
+);
+
+CREATE INDEX "student_documents_idx_student_id" ON "auth"."student_documents" ("student_id");
+CREATE INDEX "student_documents_idx_retain_until" ON "auth"."student_documents" ("retain_until")
+    WHERE purged_at IS NULL;
+
+CREATE TRIGGER trg_student_documents_updated_at
+    BEFORE UPDATE ON "auth"."student_documents"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Students see and upload their own documents, admins all. Linking, retention
+-- changes and purging run under the internal role.
+GRANT SELECT, INSERT ON "auth"."student_documents" TO authenticated;
+GRANT ALL ON "auth"."student_documents" TO internal;
+
+ALTER TABLE "auth"."student_documents" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."student_documents" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_student_documents ON "auth"."student_documents"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY student_documents_select ON "auth"."student_documents"
+    FOR SELECT TO authenticated
+    USING (
+        user_has_role('admin') OR (student_id IS NOT NULL AND student_owns_record(student_id))
+    );
+
+CREATE POLICY student_documents_insert ON "auth"."student_documents"
+    FOR INSERT TO authenticated
+    WITH CHECK (
+        user_has_role('admin') OR (student_id IS NOT NULL AND student_owns_record(student_id))
+    );
+
+-- +goose Down
+DROP POLICY IF EXISTS student_documents_insert ON "auth"."student_documents";
+DROP POLICY IF EXISTS student_documents_select ON "auth"."student_documents";
+DROP POLICY IF EXISTS internal_bypass_student_documents ON "auth"."student_documents";
+REVOKE ALL ON "auth"."student_documents" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_student_documents_updated_at ON "auth"."student_documents";
+DROP INDEX IF EXISTS "auth"."student_documents_idx_retain_until";
+DROP INDEX IF EXISTS "auth"."student_documents_idx_student_id";
+DROP TABLE IF EXISTS "auth"."student_documents";
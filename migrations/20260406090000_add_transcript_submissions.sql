@@ -0,0 +1,63 @@
+-- +goose Up
+-- Migration: add_transcript_submissions
+-- Description: Transcripts re-submitted by students after they applied. Each
+-- submission holds the re-extracted transcript and its diff against the
+-- transcript stored on the student at submission time. An admin approves
+-- the submission before it is applied to the student; a student has at most
+-- one pending submission, and a new one supersedes it.
+
+CREATE TABLE "auth"."transcript_submissions" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "document_id" uuid,
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "transcript_metadata" jsonb NOT NULL,
+    "diff" jsonb NOT NULL,
+    "reviewed_by" uuid,
+    "reviewed_at" timestamptz,
+    "review_note" text,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_transcript_submissions_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_transcript_submissions_document_id" FOREIGN KEY ("document_id")
+        REFERENCES "auth"."student_documents" ("id") ON DELETE SET NULL,
+    CONSTRAINT "fk_transcript_submissions_reviewed_by" FOREIGN KEY ("reviewed_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_transcript_submissions_status" CHECK (status IN ('pending', 'approved', 'rejected', 'superseded'))
+);
+
+CREATE INDEX "transcript_submissions_idx_student_id" ON "auth"."transcript_submissions" ("student_id");
+CREATE INDEX "transcript_submissions_idx_status" ON "auth"."transcript_submissions" ("status");
+CREATE UNIQUE INDEX "transcript_submissions_uq_pending" ON "auth"."transcript_submissions" ("student_id")
+    WHERE status = 'pending';
+
+CREATE TRIGGER trg_transcript_submissions_updated_at
+    BEFORE UPDATE ON "auth"."transcript_submissions"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Students see their own submissions, admins all. Submitting supersedes the
+-- previous pending submission, so writes run under the internal role.
+GRANT SELECT ON "auth"."transcript_submissions" TO authenticated;
+GRANT ALL ON "auth"."transcript_submissions" TO internal;
+
+ALTER TABLE "auth"."transcript_submissions" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."transcript_submissions" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_transcript_submissions ON "auth"."transcript_submissions"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY transcript_submissions_select ON "auth"."transcript_submissions"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin') OR student_owns_record(student_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS transcript_submissions_select ON "auth"."transcript_submissions";
+DROP POLICY IF EXISTS internal_bypass_transcript_submissions ON "auth"."transcript_submissions";
+REVOKE ALL ON "auth"."transcript_submissions" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_transcript_submissions_updated_at ON "auth"."transcript_submissions";
+DROP INDEX IF EXISTS "auth"."transcript_submissions_uq_pending";
+DROP INDEX IF EXISTS "auth"."transcript_submissions_idx_status";
+DROP INDEX IF EXISTS "auth"."transcript_submissions_idx_student_id";
+DROP TABLE IF EXISTS "auth"."transcript_submissions";
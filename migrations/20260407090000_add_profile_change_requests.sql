@@ -0,0 +1,68 @@
+-- +goose Up
+-- Migration: add_profile_change_requests
+-- Description: Changes students ask for to the profile fields the active
+-- schedule depends on: availability and weekly hour bounds. A NULL requested
+-- value leaves that field unchanged. Conflicts holds the student's schedule
+-- assignments outside the requested availability when the request was made.
+-- A student has at most one pending request; a new one supersedes it.
+
+CREATE TABLE "auth"."profile_change_requests" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "availability" jsonb,
+    "min_weekly_hours" float,
+    "max_weekly_hours" float,
+    "conflicts" jsonb NOT NULL DEFAULT '[]',
+    "reviewed_by" uuid,
+    "reviewed_at" timestamptz,
+    "review_note" text,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_profile_change_requests_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_profile_change_requests_reviewed_by" FOREIGN KEY ("reviewed_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_profile_change_requests_status" CHECK (status IN ('pending', 'approved', 'rejected', 'superseded')),
+    CONSTRAINT "chk_profile_change_requests_fields" CHECK (
+        availability IS NOT NULL OR min_weekly_hours IS NOT NULL OR max_weekly_hours IS NOT NULL
+    ),
+    CONSTRAINT "chk_profile_change_requests_hours" CHECK (
+        (min_weekly_hours IS NULL OR min_weekly_hours >= 0) AND (max_weekly_hours IS NULL OR max_weekly_hours >= 0)
+    )
+);
+
+CREATE INDEX "profile_change_requests_idx_student_id" ON "auth"."profile_change_requests" ("student_id");
+CREATE INDEX "profile_change_requests_idx_status" ON "auth"."profile_change_requests" ("status");
+CREATE UNIQUE INDEX "profile_change_requests_uq_pending" ON "auth"."profile_change_requests" ("student_id")
+    WHERE status = 'pending';
+
+CREATE TRIGGER trg_profile_change_requests_updated_at
+    BEFORE UPDATE ON "auth"."profile_change_requests"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Students see their own requests, admins all. Requesting supersedes the
+-- previous pending request, so writes run under the internal role.
+GRANT SELECT ON "auth"."profile_change_requests" TO authenticated;
+GRANT ALL ON "auth"."profile_change_requests" TO internal;
+
+ALTER TABLE "auth"."profile_change_requests" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."profile_change_requests" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_profile_change_requests ON "auth"."profile_change_requests"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY profile_change_requests_select ON "auth"."profile_change_requests"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin') OR student_owns_record(student_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS profile_change_requests_select ON "auth"."profile_change_requests";
+DROP POLICY IF EXISTS internal_bypass_profile_change_requests ON "auth"."profile_change_requests";
+REVOKE ALL ON "auth"."profile_change_requests" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_profile_change_requests_updated_at ON "auth"."profile_change_requests";
+DROP INDEX IF EXISTS "auth"."profile_change_requests_uq_pending";
+DROP INDEX IF EXISTS "auth"."profile_change_requests_idx_status";
+DROP INDEX IF EXISTS "auth"."profile_change_requests_idx_student_id";
+DROP TABLE IF EXISTS "auth"."profile_change_requests";
@@ -0,0 +1,53 @@
+-- +goose Up
+-- Migration: add_student_timetables
+-- Description: Class timetables students import from an ICS file or a CSV of
+-- course, day and times. Classes holds the weekly class sessions, kept so the
+-- proposed availability can be recomputed when shift times change. A student
+-- has one timetable; a new import replaces it.
+
+CREATE TABLE "auth"."student_timetables" (
+    "student_id" int NOT NULL,
+    "source" varchar(10) NOT NULL,
+    "classes" jsonb NOT NULL DEFAULT '[]',
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("student_id"),
+    CONSTRAINT "fk_student_timetables_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "chk_student_timetables_source" CHECK (source IN ('ics', 'csv'))
+);
+
+CREATE TRIGGER trg_student_timetables_updated_at
+    BEFORE UPDATE ON "auth"."student_timetables"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Students import and read their own timetable, admins all.
+GRANT SELECT, INSERT, UPDATE ON "auth"."student_timetables" TO authenticated;
+GRANT ALL ON "auth"."student_timetables" TO internal;
+
+ALTER TABLE "auth"."student_timetables" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."student_timetables" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_student_timetables ON "auth"."student_timetables"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY student_timetables_select ON "auth"."student_timetables"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin') OR student_owns_record(student_id));
+
+CREATE POLICY student_timetables_insert ON "auth"."student_timetables"
+    FOR INSERT TO authenticated
+    WITH CHECK (student_owns_record(student_id));
+
+CREATE POLICY student_timetables_update ON "auth"."student_timetables"
+    FOR UPDATE TO authenticated
+    USING (student_owns_record(student_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS student_timetables_update ON "auth"."student_timetables";
+DROP POLICY IF EXISTS student_timetables_insert ON "auth"."student_timetables";
+DROP POLICY IF EXISTS student_timetables_select ON "auth"."student_timetables";
+DROP POLICY IF EXISTS internal_bypass_student_timetables ON "auth"."student_timetables";
+REVOKE ALL ON "auth"."student_timetables" FROM authenticated, internal;
+DROP TRIGGER IF EXISTS trg_student_timetables_updated_at ON "auth"."student_timetables";
+DROP TABLE IF EXISTS "auth"."student_timetables";
@@ -0,0 +1,106 @@
+-- +goose Up
+-- Migration: add_training
+-- Description: Training new hires must complete, such as orientation and the
+-- privacy module, and each student's completion records. A blocking
+-- requirement keeps a student out of generated schedules until it is
+-- completed. validity_months sets how long a completion lasts (NULL: it never
+-- expires); expires_on is computed from it unless the certificate states its
+-- own date. A student has one record per requirement; recording a renewal
+-- replaces it and clears reminder_sent_at so the next expiry is reminded too.
+-- Evidence is a student document of type training_certificate.
+
+CREATE TABLE "auth"."training_requirements" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(100) NOT NULL,
+    "description" text,
+    "is_blocking" boolean NOT NULL DEFAULT FALSE,
+    "validity_months" int,
+    "is_active" boolean NOT NULL DEFAULT TRUE,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uq_training_requirements_name" UNIQUE ("name"),
+    CONSTRAINT "chk_training_requirements_validity" CHECK (validity_months IS NULL OR validity_months > 0)
+);
+
+CREATE TABLE "auth"."training_records" (
+    "id" uuid NOT NULL DEFAULT gen_random_uuid(),
+    "student_id" int NOT NULL,
+    "requirement_id" uuid NOT NULL,
+    "completed_on" date NOT NULL,
+    "expires_on" date,
+    "evidence_document_id" uuid,
+    "recorded_by" uuid,
+    "reminder_sent_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uq_training_records_student_requirement" UNIQUE ("student_id", "requirement_id"),
+    CONSTRAINT "fk_training_records_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "fk_training_records_requirement_id" FOREIGN KEY ("requirement_id")
+        REFERENCES "auth"."training_requirements" ("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_training_records_evidence_document_id" FOREIGN KEY ("evidence_document_id")
+        REFERENCES "auth"."student_documents" ("id") ON DELETE SET NULL,
+    CONSTRAINT "fk_training_records_recorded_by" FOREIGN KEY ("recorded_by")
+        REFERENCES "auth"."users" ("user_id"),
+    CONSTRAINT "chk_training_records_expiry" CHECK (expires_on IS NULL OR expires_on > completed_on)
+);
+
+CREATE INDEX "training_records_idx_expires_on" ON "auth"."training_records" ("expires_on")
+    WHERE reminder_sent_at IS NULL;
+
+CREATE TRIGGER trg_training_requirements_updated_at
+    BEFORE UPDATE ON "auth"."training_requirements"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TRIGGER trg_training_records_updated_at
+    BEFORE UPDATE ON "auth"."training_records"
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+ALTER TABLE "auth"."student_documents" DROP CONSTRAINT "chk_student_documents_type";
+ALTER TABLE "auth"."student_documents" ADD CONSTRAINT "chk_student_documents_type"
+    CHECK (document_type IN ('transcript', 'id', 'work_permit', 'training_certificate'));
+
+-- Everyone signed in can read the requirements; students read their own
+-- records, admins all. Requirements and records are written under the
+-- internal role.
+GRANT SELECT ON "auth"."training_requirements" TO authenticated;
+GRANT ALL ON "auth"."training_requirements" TO internal;
+GRANT SELECT ON "auth"."training_records" TO authenticated;
+GRANT ALL ON "auth"."training_records" TO internal;
+
+ALTER TABLE "auth"."training_requirements" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."training_requirements" FORCE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."training_records" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."training_records" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_training_requirements ON "auth"."training_requirements"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY training_requirements_select ON "auth"."training_requirements"
+    FOR SELECT TO authenticated
+    USING (TRUE);
+
+CREATE POLICY internal_bypass_training_records ON "auth"."training_records"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+CREATE POLICY training_records_select ON "auth"."training_records"
+    FOR SELECT TO authenticated
+    USING (user_has_role('admin') OR student_owns_record(student_id));
+
+-- +goose Down
+DROP POLICY IF EXISTS training_records_select ON "auth"."training_records";
+DROP POLICY IF EXISTS internal_bypass_training_records ON "auth"."training_records";
+DROP POLICY IF EXISTS training_requirements_select ON "auth"."training_requirements";
+DROP POLICY IF EXISTS internal_bypass_training_requirements ON "auth"."training_requirements";
+REVOKE ALL ON "auth"."training_records" FROM authenticated, internal;
+REVOKE ALL ON "auth"."training_requirements" FROM authenticated, internal;
+ALTER TABLE "auth"."student_documents" DROP CONSTRAINT "chk_student_documents_type";
+ALTER TABLE "auth"."student_documents" ADD CONSTRAINT "chk_student_documents_type"
+    CHECK (document_type IN ('transcript', 'id', 'work_permit'));
+DROP TRIGGER IF EXISTS trg_training_records_updated_at ON "auth"."training_records";
+DROP TRIGGER IF EXISTS trg_training_requirements_updated_at ON "auth"."training_requirements";
+DROP INDEX IF EXISTS "auth"."training_records_idx_expires_on";
+DROP TABLE IF EXISTS "auth"."training_records";
+DROP TABLE IF EXISTS "auth"."training_requirements";
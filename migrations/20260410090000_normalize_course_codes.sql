@@ -0,0 +1,41 @@
+-- +goose Up
+-- Migration: normalize_course_codes
+-- Description: Rewrite legacy course codes such as "COMP 1601" in student
+-- transcripts and shift template course demands to the catalogue form
+-- ("COMP1601"): upper case, no whitespace. Until then the scheduler never
+-- matches a legacy transcript course to a compact demand, or the reverse.
+
+UPDATE "auth"."students" s
+SET transcript_metadata = jsonb_set(s.transcript_metadata, '{courses}', (
+    SELECT jsonb_agg(
+        CASE WHEN jsonb_typeof(c->'code') = 'string'
+            THEN jsonb_set(c, '{code}', to_jsonb(upper(regexp_replace(c->>'code', '[[:space:]]', '', 'g'))))
+            ELSE c
+        END ORDER BY ord)
+    FROM jsonb_array_elements(s.transcript_metadata->'courses') WITH ORDINALITY AS e(c, ord)
+))
+WHERE jsonb_typeof(s.transcript_metadata->'courses') = 'array'
+    AND EXISTS (
+        SELECT 1 FROM jsonb_array_elements(s.transcript_metadata->'courses') c
+        WHERE c->>'code' ~ '[[:space:][:lower:]]'
+    );
+
+UPDATE "schedule"."shift_templates" t
+SET course_demands = (
+    SELECT jsonb_agg(
+        CASE WHEN jsonb_typeof(d->'course_code') = 'string'
+            THEN jsonb_set(d, '{course_code}', to_jsonb(upper(regexp_replace(d->>'course_code', '[[:space:]]', '', 'g'))))
+            ELSE d
+        END ORDER BY ord)
+    FROM jsonb_array_elements(t.course_demands) WITH ORDINALITY AS e(d, ord)
+)
+WHERE jsonb_typeof(t.course_demands) = 'array'
+    AND EXISTS (
+        SELECT 1 FROM jsonb_array_elements(t.course_demands) d
+        WHERE d->>'course_code' ~ '[[:space:][:lower:]]'
+    );
+
+-- +goose Down
+-- The original spacing and case are not kept, and the normalised codes are
+-- valid in both forms' place, so there is nothing to undo.
+SELECT 1;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Migration: add_disbursement_file_payments
+-- Description: Record which payments each disbursement file paid out. A
+-- payment can be in at most one file, so regenerating files for a period
+-- leaves out payments that were already sent to the bank.
+
+CREATE TABLE "auth"."disbursement_file_payments" (
+    "disbursement_file_id" uuid NOT NULL,
+    "payment_id" uuid NOT NULL,
+    PRIMARY KEY ("disbursement_file_id", "payment_id"),
+    CONSTRAINT "fk_disbursement_file_payments_file" FOREIGN KEY ("disbursement_file_id")
+        REFERENCES "auth"."disbursement_files" ("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_disbursement_file_payments_payment" FOREIGN KEY ("payment_id")
+        REFERENCES "auth"."payments" ("payment_id"),
+    CONSTRAINT "uq_disbursement_file_payments_payment" UNIQUE ("payment_id")
+);
+
+-- Like the files themselves, only the internal role reads or writes these.
+GRANT ALL ON "auth"."disbursement_file_payments" TO internal;
+
+ALTER TABLE "auth"."disbursement_file_payments" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "auth"."disbursement_file_payments" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_disbursement_file_payments ON "auth"."disbursement_file_payments"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+-- +goose Down
+DROP POLICY IF EXISTS internal_bypass_disbursement_file_payments ON "auth"."disbursement_file_payments";
+REVOKE ALL ON "auth"."disbursement_file_payments" FROM internal;
+DROP TABLE IF EXISTS "auth"."disbursement_file_payments";
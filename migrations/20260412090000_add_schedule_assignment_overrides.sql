@@ -0,0 +1,14 @@
+-- +goose Up
+-- Migration: add_schedule_assignment_overrides
+-- Description: Store dated overrides of a schedule's weekly assignments. When
+-- approved time off falls inside a schedule's period, the days it covers are
+-- solved again without the absent assistants and the result replaces the
+-- weekly assignments on those days.
+
+ALTER TABLE "schedule"."schedules"
+    ADD COLUMN "assignment_overrides" jsonb NOT NULL DEFAULT '[]'::jsonb;
+
+COMMENT ON COLUMN "schedule"."schedules"."assignment_overrides" IS 'Dated replacements for the weekly assignments: [{from, to, assignments}]';
+
+-- +goose Down
+ALTER TABLE "schedule"."schedules" DROP COLUMN IF EXISTS "assignment_overrides";
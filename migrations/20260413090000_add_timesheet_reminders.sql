@@ -0,0 +1,48 @@
+-- +goose Up
+-- Migration: add_timesheet_reminders
+-- Description: Record which students were reminded to submit each week's
+-- timesheet, so the hourly reminder job emails each student once per week.
+-- Also adds time_log_local_date, which places a time log on the calendar
+-- date it started at its own location, for grouping logs into weeks.
+
+CREATE TABLE "schedule"."timesheet_reminders" (
+    "student_id" int NOT NULL,
+    "week_start" date NOT NULL,                   -- always a Monday
+    "sent_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY ("student_id", "week_start"),
+    CONSTRAINT "fk_timesheet_reminders_student_id" FOREIGN KEY ("student_id")
+        REFERENCES "auth"."students" ("student_id") ON DELETE CASCADE,
+    CONSTRAINT "chk_timesheet_reminders_week_start" CHECK (EXTRACT(ISODOW FROM week_start) = 1)
+);
+
+-- Only the reminder job and the admin trigger, both running as the internal
+-- role, read or write these.
+GRANT ALL ON "schedule"."timesheet_reminders" TO internal;
+
+ALTER TABLE "schedule"."timesheet_reminders" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "schedule"."timesheet_reminders" FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY internal_bypass_timesheet_reminders ON "schedule"."timesheet_reminders"
+    TO internal USING (TRUE) WITH CHECK (TRUE);
+
+-- Logs without a location fall back to the default location timezone.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION schedule.time_log_local_date(log_entry_at TIMESTAMPTZ, log_location_id UUID)
+RETURNS DATE
+LANGUAGE sql
+STABLE
+AS $$
+    SELECT (log_entry_at AT TIME ZONE COALESCE(
+        (SELECT l.timezone FROM schedule.locations l WHERE l.id = log_location_id),
+        'America/Port_of_Spain'
+    ))::date;
+$$;
+-- +goose StatementEnd
+
+GRANT EXECUTE ON FUNCTION schedule.time_log_local_date(TIMESTAMPTZ, UUID) TO authenticated, internal;
+
+-- +goose Down
+DROP FUNCTION IF EXISTS schedule.time_log_local_date(TIMESTAMPTZ, UUID);
+DROP POLICY IF EXISTS internal_bypass_timesheet_reminders ON "schedule"."timesheet_reminders";
+REVOKE ALL ON "schedule"."timesheet_reminders" FROM internal;
+DROP TABLE IF EXISTS "schedule"."timesheet_reminders";
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Migration: add_interview_slot_location
+-- Description: Link interview slots to a help desk location, so invitation
+-- emails show the interview time in that location's timezone. The free-text
+-- location stays as the room or directions shown to the applicant.
+
+ALTER TABLE "auth"."interview_slots"
+    ADD COLUMN "location_id" uuid,
+    ADD CONSTRAINT "fk_interview_slots_location_id" FOREIGN KEY ("location_id")
+        REFERENCES "schedule"."locations" ("id") ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE "auth"."interview_slots"
+    DROP CONSTRAINT IF EXISTS "fk_interview_slots_location_id",
+    DROP COLUMN IF EXISTS "location_id";